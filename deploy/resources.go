@@ -112,9 +112,9 @@ var assets = func() http.FileSystem {
 		"/crd-build.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "crd-build.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 301783,
+			uncompressedSize: 307446,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x6b\x73\x23\xb7\xb5\x28\xfa\xdd\xbf\x02\x25\xa7\x4a\xd2\x8e\x48\x79\xf6\xce\xcd\xce\x9e\xec\x8a\x4b\x1e\x69\x1c\x5d\xcf\x43\x67\xa8\xb1\xef\x29\x27\x3b\x05\x76\x83\x24\xa2\x6e\xa0\x0d\xa0\xa5\x61\x8e\xcf\x7f\xbf\x85\x05\xa0\x1f\x7c\xf5\x42\x93\xd2\xc8\x76\xe3\xcb\x8c\x48\xf6\x6a\x3c\x16\xd6\xfb\xf1\x25\x19\x1d\x6e\x7c\xf1\x25\x79\xc3\x13\x26\x34\x4b\x89\x91\xc4\x2c\x18\xb9\x28\x68\xb2\x60\x64\x22\x67\xe6\x81\x2a\x46\x5e\xcb\x52\xa4\xd4\x70\x29\xc8\xc9\xc5\xe4\xf5\x29\x29\x45\xca\x14\x91\x82\x11\xa9\x48\x2e\x15\xfb\xe2\x4b\x92\x48\x61\x14\x9f\x96\x46\x2a\x92\x39\x80\x84\xce\x15\x63\x39\x13\x46\x8f\x09\x99\x30\x06\xd0\xdf\xbd\xbf\xbd\x7e\x75\x45\x66\x3c\x63\x24\xe5\xda\x3d\xc4\x52\xf2\xc0\xcd\xe2\x8b\x2f\x89\x59\x70\x4d\x1e\xa4\xba\x23\x33\xa9\x08\x4d\x53\x6e\x5f\x4c\x33\xc2\xc5\x4c\xaa\xdc\x4d\x43\xb1\x39\x55\x29\x17\x73\x92\xc8\x62\xa9\xf8\x7c\x61\x88\x7c\x10\x4c\xe9\x05\x2f\xc6\x5f\x7c\x49\x6e\xed\x32\x26\xaf\xc3\x4c\xb4\x03\x0b\xef\x34\x92\xfc\x6f\x59\xfa\x35\x34\x96\xeb\x77\xe1\x8c\x7c\xcf\x94\xb6\x2f\xf9\xf7\xf1\x57\x5f\x7c\x49\x4e\xec\x4f\x8e\xfc\x97\x47\xa7\x7f\x26\x4b\x59\x92\x9c\x2e\x89\x90\x86\x94\x9a\x35\x20\xb3\x4f\x09\x2b\x0c\xe1\x82\x24\x32\x2f\x32\x4e\x45\xc2\xea\x65\x55\x6f\x18\x13\x98\x80\x85\x21\xa7\x86\x72\x41\x28\x2c\x83\xc8\x59\xf3\x67\x84\x9a\x2f\xbe\xfc\xe2\x4b\x02\x63\x61\x4c\xf1\xf2\xfc\xfc\xe1\xe1\x61\x4c\x61\xba\x63\xa9\xe6\xe7\x61\x75\xe7\x6f\xae\x5f\x5d\xbd\x9b\x5c\x8d\x60\xca\x5f\x7c\x49\x3e\x8a\x8c\x69\x4d\x14\xfb\xa9\xe4\x8a\xa5\x64\xba\x24\xb4\x28\x32\x9e\xd0\x69\xc6\x48\x46\x1f\xec\xc1\xc1\xe9\xc0\xa1\x73\x41\x1e\x14\x37\x5c\xcc\xcf\x88\xf6\xa7\xfe\xc5\x97\xad\xd3\xa9\xb7\x2b\x4c\x8f\xeb\xd6\x0f\xa4\x20\x54\x90\xa3\x8b\x09\xb9\x9e\x1c\x91\x6f\x2e\x26\xd7\x93\xb3\x2f\xbe\x24\x3f\x5c\xdf\xfe\xf5\xfd\xc7\x5b\xf2\xc3\xc5\x87\x0f\x17\xef\x6e\xaf\xaf\x26\xe4\xfd\x07\xf2\xea\xfd\xbb\xcb\xeb\xdb\xeb\xf7\xef\x26\xe4\xfd\x6b\x72\xf1\xee\x7f\x93\xef\xae\xdf\x5d\x9e\x11\xc6\xcd\x82\x29\xc2\x3e\x15\xca\xce\x5f\x2a\xc2\xed\x46\xb2\xd4\x9e\x69\x40\xa0\x30\x01\x8b\x1f\xf6\x6f\x5d\xb0\x84\xcf\x78\x42\x32\x2a\xe6\x25\x9d\x33\x32\x97\xf7\x4c\x09\x8b\x1e\x05\x53\x39\xd7\xf6\x38\x35\xa1\x22\xfd\xe2\x4b\x92\xf1\x9c\x1b\xc0\x22\xbd\xbe\x28\xfb\x9a\x43\xde\xad\x2f\x68\xc1\x3d\x3a\xbd\x24\xb4\xe0\xec\x93\x61\x02\x66\x33\xbe\xfb\x93\x1e\x73\x79\x7e\xff\x62\xca\x0c\x7d\xf1\xc5\x1d\x17\xe9\x4b\xf2\xaa\xd4\x46\xe6\x1f\x98\x96\xa5\x4a\xd8\x25\x9b\x71\x01\xe8\xff\x45\xce\x0c\x4d\xa9\xa1\x2f\xbf\x20\x44\xd0\x9c\xbd\x24\xd3\x92\x67\xa9\x1e\x27\x34\x67\x59\x03\x27\xbe\x20\x24\xa3\x53\x96\x69\xfb\x4b\x62\x4f\xfd\x25\x39\x82\x1f\x8d\xee\x8e\xbe\xb0\x5b\x65\xbf\xa8\xaf\xd5\x8d\xe2\xc2\x30\xf5\x4a\x66\x65\x2e\xfc\x43\x23\xf2\xff\x4e\xde\xbf\xbb\xa1\x66\xf1\x92\x8c\xb5\xa1\xa6\xd4\xe3\x62\x41\x35\xfb\xc2\x21\x63\xca\x74\xa2\x78\x61\x60\x55\xf6\xa6\xc1\x5c\x48\xf3\x27\x6e\x8e\x37\x8d\x4f\xcc\xb2\x60\x2f\x89\xc5\x17\x31\x5f\x7f\x4b\x58\xdf\x38\x51\x0c\x0e\xe7\x96\xe7\x4c\x1b\x9a\x17\xdb\xde\x69\x78\x6e\xaf\x08\x79\x58\xf0\x64\x01\x67\xe8\x66\xf1\x40\x35\x01\x20\x2c\x6d\xcd\xe5\x62\xde\x9e\x49\x4a\x0d\xdb\xba\x5a\x6d\xa8\x32\x2c\xbd\x30\x7d\xde\x9e\x51\x6d\xc8\x89\x62\xa3\x53\x0f\xa6\x35\x8f\x49\xeb\x33\xc4\x5c\xd2\x52\xc1\x8e\xec\xde\x7c\x78\x29\xfb\xc4\x92\x12\x08\xe4\xca\x43\xee\xd5\x97\xed\x0f\x77\x9f\x88\x7f\xfb\x8c\xf2\xac\x54\x6c\xac\x58\x62\x2f\xd5\x72\x4c\x8d\x61\x79\xb1\x75\x63\x44\x99\x4f\x2d\x6f\x98\x35\x26\xe3\x1f\xd1\xed\xf3\x68\x7f\xe8\x26\x63\x71\x71\xce\xd4\x17\x84\xcc\x95\x2c\x8b\x97\x64\x03\x7a\xdb\xc7\x3d\xa2\xba\x4b\xf3\x8d\xdd\x00\xf8\x3b\xe3\xda\x7c\x57\x7f\xf6\x86\x6b\x37\xcf\x22\x2b\x15\xcd\xc2\x9d\x81\x8f\xf4\x42\x2a\xf3\xae\x06\x65\x57\xcf\xef\xa6\xee\x3b\x2e\xe6\x65\x46\x95\x7f\x00\x3e\x4b\xa8\x61\x73\xa9\x78\xf3\xf7\x77\x76\x72\xd5\x5f\x89\xff\x4b\x27\xd2\x2e\x05\x60\x17\x34\x81\xa3\xd6\xe5\x54\xf9\x4b\xed\x9f\x77\xdb\xfb\x92\xfc\x9f\xff\xfb\x05\x21\xf7\x34\xe3\x8e\xc1\xba\x2f\x65\xc1\xc4\xc5\xcd\xf5\xf7\xff\x31\x49\x16\x2c\xa7\x2f\x37\x6d\x36\xac\xd0\x12\x61\x8b\x7c\xee\x77\x15\x45\x74\xeb\x24\x17\x37\xd7\xfe\xc9\x42\xc9\x82\x29\xd3\x98\xbd\x25\x0d\x15\x69\xaa\x3e\x5b\x79\xc7\xb1\x9d\x84\xe7\x86\xa9\x25\x46\xcc\xbd\xee\xde\x7d\xc6\x52\xa2\xdd\x8b\x81\x73\x71\xcb\x70\x2c\xe1\x66\xc2\x34\xf1\xcc\x0d\x39\xb3\xfc\x41\x4e\xff\xc9\x12\x33\x26\x13\xa6\x2c\x10\x7b\x0c\x65\x96\x5a\xe1\xe1\x9e\x29\x43\x2c\x96\xcd\x05\xff\x57\x05\x59\x07\x99\x24\xa3\x86\x69\xd3\x82\x08\x84\xcb\x4a\x06\xf7\x34\x2b\xd9\x99\xa5\xef\xc0\x54\x15\xb3\xef\x20\xa5\x68\x40\x83\x9f\xe8\x31\x79\x2b\x15\x03\x49\xe2\x25\xb0\x54\xfd\xf2\xfc\x7c\xce\x4d\x20\xc6\x89\xcc\xf3\x52\x70\xb3\x3c\x6f\x48\x33\xfa\x3c\x65\xf7\x2c\x3b\xd7\x7c\x3e\xa2\x2a\x59\x70\xc3\x12\x53\x2a\x76\x4e\x0b\x3e\x82\x89\x0b\xe0\x26\xe3\x3c\xfd\xb2\x3a\xe4\xe3\xc6\x4c\xd7\xee\x59\x85\xba\x5b\xf7\xdd\x22\xb1\x3d\x5b\xea\x1f\x73\xf3\xaf\xb7\xd7\x7e\x64\x77\xe5\xc3\xd5\xe4\x96\x84\x97\xc2\x11\xb4\xf7\x1c\x76\xbb\x7e\x4c\xd7\x1b\x6f\x37\x8a\x8b\x19\xb0\x3f\x2b\xbf\x28\x99\x03\x44\x26\xd2\x42\x72\x61\xe0\x8f\x24\xe3\x4c\xb4\x37\x5d\x97\xd3\x9c\x1b\x27\x5a\x30\x6d\xec\xf9\x8c\xc9\x2b\x2a\xac\x34\x34\x65\xa4\x2c\x2c\x2d\x4b\xc7\xe4\x5a\x90\x57\xf6\x42\xbc\xa2\x56\xe0\x79\xe4\x6d\xb7\x3b\xac\x47\x76\x4b\xbb\x37\xbe\xc9\x49\xdb\x3f\x74\xbb\x55\x7d\x1c\x78\xe5\xc6\x13\x82\xdb\x37\x29\x58\xd2\xba\x17\x29\xd3\x20\x6e\xd9\xcb\xcd\x2c\xc6\xd7\xa4\x69\xfb\x3d\x84\x09\x50\x7d\xb7\xf2\xd1\x2a\x4a\x5c\xbf\x9b\x5c\x7d\xb8\x25\x17\x97\x4e\x70\xba\x78\x43\x26\x37\x57\xaf\xc8\xeb\xeb\xab\x37\x97\x13\x32\x5a\x7f\x77\x92\x95\xda\x00\x2d\x6d\x8f\xeb\xbc\x90\xca\x50\x61\x5e\x92\x0f\xa5\x20\x47\x76\x46\xd4\x48\x35\xd2\xe9\x1d\x99\x33\x61\xff\x62\xe4\xee\x4f\xfa\xc8\xde\x3d\xc5\xaa\x8f\x12\x99\x32\x42\x67\x9b\x60\xe6\x32\xe5\xb3\xa5\xc3\x4a\x2f\x0d\x1f\xaf\xfc\x88\x1b\x96\xaf\x2d\x71\x95\x81\x50\x7d\x47\x46\xa3\xb5\x1f\x6d\xdb\x36\x37\x80\xda\x31\xb5\xe9\xab\x4d\xa7\xc6\xd4\xb6\xd7\x74\xbf\xca\x0e\x3a\x03\xa9\x6c\xb9\xed\xfb\x95\x77\x5e\xf8\x9f\xbb\xeb\x0c\x6c\x0d\x68\x61\xf8\xd8\xd2\xb9\xb4\xcc\xec\xde\xa9\x32\x63\x7a\xbc\x15\x6c\xf7\xd4\xec\x10\x32\x65\x17\x9d\x53\x5c\x9b\xe6\x25\xfc\x31\x65\x1a\x00\x6c\x9f\xde\x4e\x88\xa4\xe2\x40\x85\x4c\xb7\x2f\x04\xbf\x18\xf7\x4b\x36\x63\x4a\xb1\xf4\xb2\xb4\x17\x79\x52\x4d\xe8\x7a\x2e\x64\xf5\xf1\x55\x90\x36\xba\xc0\x6d\x90\x59\xfc\x1a\x99\x22\x0f\x3c\xcb\xfc\x0b\x2d\xf2\x87\x2f\x3a\x41\x12\xbb\x60\xe0\x55\x76\xf7\x2c\x31\xa0\x86\x68\x6a\xb8\x9e\x2d\x61\x3f\xaa\xfd\xf4\xaa\x8d\xa5\x5c\x08\xa8\x5e\xb5\x71\x1a\x9c\xbf\x5a\x2c\x4b\xcf\xc8\xb4\x34\x84\x1b\xa0\xe2\xc9\x42\x4a\xab\x2d\xc2\xab\x11\x30\x61\x6e\xf7\x5c\x02\x47\x6d\x2a\xf1\x41\xff\x6c\x4c\x71\x6c\xf7\x07\x01\x13\x70\x06\x00\x73\x4d\x72\xa9\x4d\x7d\x6a\x41\x44\xb1\x2f\xb2\xba\xb0\xfd\x03\x01\x71\x0e\x22\xbc\x36\x44\x97\xb9\x9d\xd8\x03\xb3\x4a\xbe\x3e\x23\x7c\xcc\xc6\x80\x66\x8c\x26\x8b\xfa\xc5\x08\x90\x39\x63\x46\x13\x9a\x65\x61\xa1\x4d\xdc\x76\x9a\x32\xd8\x2a\xac\xec\xee\x38\x2a\x02\xa8\xe7\x83\x67\x95\xae\xbd\x8a\xa5\x1b\x8f\xfe\x0c\x01\x99\x99\x64\x7c\x7a\x06\xa6\x84\xd2\xd8\xf3\xb5\x3b\x31\x5d\x5a\x42\x6a\xc5\x78\xa0\xb5\x4a\x96\x73\xa7\x7e\xb0\xcc\x4d\x1e\x01\x38\x08\x6b\x80\x4a\x20\x35\x59\x75\x50\xcc\xc9\x91\xdb\xe4\xa3\x20\x73\xd9\x17\xf2\x19\x0a\xa5\x98\x3b\x89\x9c\x9a\x64\xe1\x99\x61\x22\x95\x62\xba\x90\x02\x60\xc3\x37\x57\xf5\x0e\xfc\x39\x02\xec\x89\x3e\xad\x50\x87\x2c\xf8\x7c\x11\xf0\x82\x2a\x67\x11\x68\x63\xdc\x6e\xc2\x43\xb6\xf3\xa2\xf5\xd1\xa6\xe4\x82\x58\x8d\x65\xd9\xc0\xed\x06\x06\x19\xa6\x72\x04\x44\x52\xed\x11\x20\x22\x88\x1c\xda\xad\x0e\x4c\x1d\x09\x37\x1e\xd7\xc9\x57\xe4\xc4\x22\x3b\x0a\x28\x37\xc7\x1a\x48\xc0\x48\x16\xa7\x63\x72\x41\x44\x59\x51\xb2\x7d\x27\x2a\x64\x35\x4f\x98\x10\x70\xb2\x4c\xcb\xfa\x7d\x18\xfa\x88\xa6\xf7\xe1\xf7\x76\xea\x4c\x24\x0c\xf7\xfb\xd5\xb3\x72\xf8\xa8\x59\xc6\x12\x63\x79\x12\x53\xf9\x19\xa1\x5a\xcb\x84\x37\x4c\x03\xdd\xa3\xc2\xbb\x36\x3a\xbb\x23\xc2\x9d\x4d\xfc\xe2\x49\xd8\xfd\xc6\x85\xc1\x3f\xb9\xb6\x15\x56\x2d\xb6\xb7\xbe\xbd\x25\x4d\x92\x17\x01\x9b\x58\x1a\x64\x21\x1d\x6b\x6f\x69\xc2\xee\x02\x89\xb9\x79\x5b\x17\xb3\x75\x11\x51\x40\x89\xd7\xae\x2a\xfc\xb0\xbc\xcb\x6a\x1f\x94\x0b\xed\xb5\x45\x0c\x91\x6e\x0e\x4a\xee\xd8\xd2\xa9\xa1\x56\xd3\xf5\x62\xb5\x03\xad\x18\xb0\xdb\x48\x88\x16\xef\xee\xd8\x12\x40\x7a\x0d\x36\x0a\x42\x1f\xbc\x73\xe3\x8e\x75\x08\x8f\x9b\xc6\x9a\x60\x05\xf8\x01\x2b\x80\x4d\xc0\x71\xfe\xd5\x51\x9d\x11\x58\xb0\x19\x68\x9c\xd1\x60\x36\xaa\x82\xb8\x11\x0e\x72\xcf\xfd\xf8\x50\x29\xe1\x0e\x51\x8e\xb5\x43\x0a\x7b\xb7\x17\xbc\xe8\xb1\x31\x46\x02\x06\xc3\xd5\x0e\xf6\x8d\xef\x69\xc6\xd3\x6a\xca\xb1\xf8\x66\x87\xe5\xa9\xd7\xe2\x8c\xbc\x93\xc6\xfe\x73\xf5\x89\x6b\x2b\x76\x5d\x4a\xa6\xdf\x49\x03\x7f\xc6\x6f\x3f\x21\xdf\x1a\x77\x33\xde\xf4\x78\x7a\x8f\xc3\x73\xfb\xb2\xe7\xd1\x5d\x08\x42\x95\xa2\xe0\xa0\x69\xda\x63\x22\x6f\xa3\x1b\xd7\x4e\xf6\xac\xc8\x03\xd7\xe4\x5a\x58\x11\xdc\x6d\x78\x1f\x3c\x58\x30\x3f\x1f\x3f\xcd\xbc\xd4\x60\x8c\x11\x52\x8c\x40\x62\x39\xd0\x3c\x1d\x2e\xd8\xb9\x36\xb1\xe1\xa0\x53\x3e\xe4\x74\xbf\x35\x76\xaa\x6f\xcc\x59\x4f\xc2\xb3\x3e\xc1\x05\xbd\x07\x41\x9c\x8b\x39\x4a\x29\x5c\x1d\x5e\x48\x3f\xf3\x6e\x03\xd0\x36\xa7\xcc\x19\x32\x0b\xc5\x62\xe4\x92\x7a\x50\x6d\xd9\x8d\xb7\x9c\x5b\x85\x8d\x87\x39\x3b\x83\x6c\xe6\x6d\xd0\xb1\x23\x05\x45\xc6\x99\x20\xa9\x61\x73\x9e\x90\x9c\xa9\x39\x23\x85\x95\x4c\xe2\xcf\xa8\x07\xeb\x77\x63\x8f\xeb\x1f\x1e\x86\xfd\x88\x7a\x36\xa8\x73\xb1\x13\x1e\x59\xda\x1e\xfd\x4c\x40\xdb\xa8\x07\x37\x5a\x2d\xb1\x8f\xc5\xed\x08\x08\xa3\xaf\xad\xbe\xf8\x2c\xe5\x50\xd0\x64\x07\x39\xd4\x8f\x41\x0e\x1d\xe4\xd0\xd6\x18\xe4\xd0\x7a\x0c\x72\xa8\x1f\x83\x1c\x3a\xc8\xa1\x83\x1c\x1a\x33\x06\x39\x74\xf3\x78\x32\x39\x34\xfa\x45\xce\x4a\xdb\xcb\x80\xfc\x83\xb3\xc1\xd7\x16\x63\x67\x09\x06\x41\x38\xe6\xfc\xd7\x4d\xc7\x56\x70\x9c\x78\x59\xe2\x16\xcc\xd2\x5c\x44\x51\x06\x45\xc5\x9c\x91\x17\xa3\x17\x5f\x7d\x85\xc5\x7e\x17\x39\x0b\xf1\x55\xff\xf1\xef\x51\xbb\x5d\x47\x64\x75\x4e\x2b\x0a\x53\x47\x0d\x0b\x3f\xf2\x01\x77\x9a\x18\xbf\x51\x0c\x9e\xe0\xd1\x70\x9b\x87\xef\x20\x7e\x68\xcf\x37\x2a\x67\x61\xcb\x21\x59\xb9\x81\x11\x8b\x6f\x39\x8a\x41\x8a\x12\xd2\x90\x9c\x19\x42\x4d\xcb\x0d\xc4\x73\x86\xe5\x48\x85\x4c\x1d\x93\xf0\x51\x3e\xc1\x2f\x9e\x12\x29\xbc\xaf\xd0\x62\xf5\xd8\xaf\x02\x01\xb1\x63\x9d\x2b\xab\x48\x58\x1d\x47\xba\x6b\x18\x69\x67\x17\xd6\x2a\x73\x46\x5c\x2c\x93\x67\x21\x76\x19\x75\x54\xe2\x09\x1b\xcf\x31\xf7\x27\x2d\x21\x66\x9c\x0a\x1f\xdc\x74\x7a\xe6\x9c\xa3\x4b\x6d\x58\xee\xa2\xb8\x55\x15\x10\x6e\x14\x86\x98\x19\x49\xd8\x3d\x13\xa6\xa4\x59\xb6\x24\xec\x9e\x27\x26\x44\x4d\xb8\x48\x2c\x6e\x5c\x1c\x46\xf7\xfc\xe2\x54\xab\x55\xda\x83\xe4\x83\x2b\x0a\x84\xbb\x06\xe3\x6d\x1a\x3d\x96\xbe\xd8\xf7\x8f\x5d\x34\xad\xfd\x2f\xe0\xea\xfb\x0f\x18\xff\x2d\x89\x65\xe3\xab\xba\x7b\x99\x65\xf6\xd4\x9c\x3b\x77\xdd\x53\x18\x67\x17\x69\xb9\x48\xdd\x82\x5a\x58\xed\xa2\x0d\xf0\x40\xed\x3e\x5c\xbc\xbb\xb4\x3b\x6c\x61\xdd\xca\x42\x66\x72\xbe\x6c\x9e\x1b\x50\x2d\x70\x18\x47\xda\x4d\x28\xd1\xe5\xd4\xeb\x6a\x90\x8c\xb1\x82\x10\x83\x47\x73\xf0\x68\xd6\x63\xb0\x24\x0d\x96\xa4\xd6\x18\x2c\x49\xf5\x18\x2c\x49\x7e\x0c\x96\xa4\xc1\x92\x34\x58\x92\x62\xc6\x60\x49\xda\x3c\x06\x8f\xe6\xe0\xd1\xdc\x34\x06\x39\x74\x90\x43\x5b\x63\x90\x43\xeb\x31\xc8\xa1\x7e\x0c\x72\xe8\x20\x87\x0e\x72\x68\xcc\x18\xe4\xd0\xcd\xe3\xf9\x7a\x34\x63\x5e\x11\xb3\xd1\xa3\x75\xd7\x00\xd2\x73\x86\x98\x3c\xf2\x87\x85\x4c\xf7\x4a\x5c\x2d\x64\xba\x3d\x6f\x15\xe5\xee\x49\xe4\x28\x93\x09\x35\xbe\xa8\x8f\x05\xe8\x7c\xb3\x44\xd3\xdc\xf9\xb8\xce\xc8\xbf\xa4\x60\x67\x90\x9d\x67\x09\x84\x96\x79\x17\xbd\x92\x50\xd3\xa6\x90\xe9\x89\x3e\xed\xc8\x95\x1a\xb2\x62\x87\xac\xd8\x5d\x63\xc8\x8a\xfd\x25\x66\xc5\x2e\xa8\x76\x37\xc1\x09\x27\x5b\x93\x64\x71\x17\x2a\xd0\x48\x4b\xa4\xff\xdc\x99\x23\x8b\xf1\xc5\x3f\x69\x16\xad\x25\x2d\x1e\x6d\xa1\x0e\x42\x8d\x78\x6e\x5f\x52\x1f\x7a\xc3\xd2\x9b\xf6\x5a\x51\xfc\xd1\xd9\x4d\x60\x49\x34\x4d\x59\x4a\x0a\xa6\x46\xee\x3a\x48\x32\xe3\x22\xdd\xb0\x52\x14\x60\xbf\xc7\x98\x23\x8a\xcd\x74\x6d\x2f\xb3\x97\x4b\xbb\xe9\x93\x6f\xb1\x41\xc8\x7b\xc5\x7b\x87\x57\xa2\x9d\x3e\x47\xde\x2b\x58\x36\x82\x20\xb2\x8f\x69\x0e\x2c\x24\x3f\x95\x4c\x2d\x89\xbc\x67\xaa\xd2\xe8\x23\x85\x55\x5f\xd0\xc7\x47\x69\x71\x4d\x12\xaa\x21\x52\x23\x4a\x47\xed\x6b\x2f\xda\xc7\x67\x4e\x56\x37\x65\x15\x98\xb3\xd4\x65\x5c\xc7\x5a\xf8\x80\x5a\xba\x0d\xde\x68\xf4\xc4\x32\xc8\xf6\x68\x71\x9b\x3a\x08\x22\xd6\xfa\xd8\x4f\x19\xda\x88\x3d\x07\x30\x86\x92\x47\x31\x88\x92\x47\x31\x8a\x92\x03\x18\x46\xc9\x5e\xc6\x51\xd2\xd7\x40\x4a\x56\x8f\xd0\xae\xc1\xcb\x58\x70\x96\xbd\x20\x92\x96\x85\x75\xcd\x5e\xda\x13\x66\x1f\x2b\x2b\xd9\x57\x5b\xdf\xc7\xda\x4a\x56\x37\xb7\x42\xb6\xba\xb2\x58\xcf\xbd\xd8\x64\xb0\xad\xcc\xaf\x3d\x61\x6e\x37\xda\x5a\xaa\xd2\x13\xe8\x9a\xe1\x16\x6e\xc8\xbe\xb6\xdb\xbd\x0f\xb5\xaf\x15\x96\xac\x1e\xa9\x37\xc9\x71\x30\x79\xc5\x1b\x75\xea\xb1\x66\xc9\x5d\x35\x20\xf6\x84\xbb\x62\xcd\x5d\x33\x74\xf6\x04\xbb\x6e\xd1\x3d\xdc\x7c\xb7\x58\x75\x7b\x1a\x4d\xc9\x46\xc3\xe9\x3e\x96\x5d\x3b\x36\x1b\x35\xbd\x89\xb2\xf7\xa5\x3e\x90\x61\x93\xec\x63\xdc\x24\xfb\xdf\xae\xfe\x46\x4e\xb2\x87\xa1\x93\xf4\x34\x76\x92\xfe\x06\x4f\xd2\xdf\xe8\x49\xf6\xd8\x25\x10\x48\xdf\x34\xca\x15\xe3\x47\xb3\x82\x71\x7f\x69\x63\x0f\xfc\x58\x97\xac\xdd\x42\x9c\xb4\x97\xd3\x22\x56\xd5\xb0\xe3\xff\x58\x91\x0e\x2e\xf9\xff\x25\x05\xe5\x4a\x5b\x85\xce\x39\x45\x9a\xdf\xc5\x7b\x08\x44\xad\x61\xfb\x69\xda\x19\x72\x4d\x2c\x8e\xde\xd3\xac\x8f\x60\xeb\x82\xe2\xbd\x89\xc5\xd2\xfd\x55\xfd\x22\x5e\xaa\x7d\x58\x48\xed\xe4\x4f\x67\x86\xe1\x9a\x1c\xdd\xb1\xe5\xd1\xd9\x7e\x14\xd9\x82\xb9\x16\x47\x4e\x58\x5e\xf3\x90\x05\x59\x3c\x5e\x09\x12\xd9\x92\x1c\x01\xac\xa3\x0d\x71\xdf\x7d\x84\x8d\x5e\x2a\xcf\x5e\xee\x8a\xc8\xc7\x44\x28\x6b\xdc\x3f\x6c\xa6\x06\x51\x59\x72\xbd\x69\x2c\x6a\xdd\x0d\x30\x95\x94\x3f\x59\x8f\x68\x88\x82\x79\x52\x15\x67\x9b\x5b\x8c\x30\xa7\x7f\x5e\xc9\x15\x88\x56\x96\x73\x46\x85\x26\x47\xc1\x9f\x70\xac\xeb\x79\x1f\x45\x00\xea\xc1\x05\x7b\xd2\xb6\x3e\xb4\xdc\xf8\x14\x85\xef\xe2\x54\xb7\x15\xbb\xa0\xf7\xb8\xf8\x92\xd0\x53\x56\xbb\x63\xe2\x9c\xac\x27\xc1\xfa\x75\x6a\xcf\x4d\x48\xd3\x00\x44\x4e\xa8\x30\x7c\x54\xfd\x22\x0a\x70\x65\x13\x03\x63\x6e\x48\x05\x5c\xc7\xbe\x28\xa0\xc1\xc1\x54\xf9\x34\x6a\xbc\x3e\x23\x0f\x0b\xa6\x7a\xef\x03\xd7\xbe\x38\x71\x4a\xa8\x26\xaa\x14\xd0\x11\x41\x0a\xef\x14\x71\xc4\x36\x0a\xa2\xab\x42\xed\xcd\xb5\xce\x44\x02\x7b\x02\x5a\x72\x8d\x03\xf1\x32\x40\x70\x0e\x41\x91\x70\x9f\x91\x23\x85\xb7\x97\x53\x11\x07\x31\xf8\x31\xe0\x34\x58\xea\x4e\x8b\x57\x3b\x30\x26\x57\xf6\x2a\xc7\xdd\xa5\x7a\x71\x16\x92\xc5\x29\x9a\x65\xf2\x21\x8e\x56\xf7\xb8\x91\xf1\x32\xe4\xa8\xc7\x49\x3c\x65\x62\xed\xc3\xa3\x25\xd6\xae\x18\xd2\x7f\xb3\x79\xb5\x3d\xdc\x26\x43\x72\xed\x90\x5c\xdb\x35\x1e\x35\xb9\x16\x1e\x77\x1c\x65\x77\x96\x2d\xca\xed\x0e\x79\xb8\x87\xcc\xb2\x25\xe4\x87\x05\x03\x6a\xa2\x18\x1c\x7b\x5e\x66\x86\x17\x59\xed\x61\x76\xd3\xcd\x9c\xc1\xc7\x05\x84\x63\x14\x8a\x36\x05\xb3\x33\xa6\xc9\x62\xf5\x0a\xc3\x1b\x21\x94\x4d\x03\x47\xc3\xe8\x55\x10\x10\x40\xb3\xcc\xe7\xd6\x06\x5b\x91\x8b\xbe\xe0\x8f\xe7\xde\xbd\xf4\xed\x10\xaa\xf8\x59\xe0\xbe\x27\x56\xa8\xc9\x2c\xda\x61\x45\x8e\xed\xd2\x95\xb7\x15\xdf\xb3\xe0\x90\x9f\xf3\x7b\x26\x70\x4e\xdc\x20\x5a\x9d\xe8\xd3\xd3\x60\xd7\xdf\x57\xec\x8c\x11\x37\x81\xd7\xe1\xd4\xe2\x55\xd1\xaf\x4b\x9c\x43\x02\xb5\xfa\xf5\x2e\x31\xee\xbf\x1b\xd2\xc3\x5f\xf0\x27\xb5\x5b\x80\x83\x0d\x96\x58\xf2\x45\x5a\xa8\x53\x0b\x6e\x8f\xe0\x7b\xef\xe5\x6c\xc6\x3a\x9a\x1b\xce\x63\xb4\x0c\xd2\xdb\xc9\xfc\x59\x13\xb2\x0f\xe4\x58\x46\x38\x95\xfb\xe7\xd6\xf4\x72\x28\xef\x9f\x54\x73\x30\x47\xf2\x90\x55\xb3\x6b\xec\x9f\x55\xb3\xea\x30\xee\x69\x80\xdf\xea\x2c\xfe\x85\x26\xd7\x6c\x70\xf5\x12\xda\xcf\xab\xbf\xcd\xcd\xdb\x70\xd8\xf6\x00\xbc\xc1\xc5\xbb\xee\xac\xed\x13\x5e\xb1\xb7\x7b\xf7\x73\x27\xd7\xac\xbb\x74\xfb\xb8\x24\x48\x87\x3b\x97\xf0\x3e\xbb\xbb\xd3\x95\x0b\xd2\x6a\x0f\xa0\x3b\xdd\xb8\xfd\xe6\xb9\xc3\x85\xeb\xa7\xdc\x07\xb5\x36\xb8\x6f\x1b\x8e\xd8\x1e\x10\xd7\x5d\xb7\xeb\x4e\xd8\x1e\x60\x87\x7c\x94\x1d\xe3\xd7\x98\x8f\xd2\xdb\x25\xbb\xbf\x3b\xb6\xe7\xd9\x63\xdc\xb0\xbd\x5d\xa7\x3b\x5c\xb0\x1b\x5c\xaa\x91\x66\xef\x15\xf7\xeb\xa1\xdd\xa9\x8f\xe0\x4a\x7d\x04\x37\x6a\xb7\x0b\x35\x5e\x68\xef\x71\x5b\xa2\x1f\x89\x77\x83\x22\x5d\xa0\x8d\xaf\xa2\x4c\xe1\xdb\xdc\x9f\x1b\x5c\x9a\x78\x5f\xef\x9a\xeb\xf3\x10\xee\xcc\x48\xee\xd0\x83\x2e\xc4\xd2\xbd\x1e\xee\x4b\xbc\xeb\xb2\x61\x1f\x42\xaf\x19\x67\x47\x8a\x72\x6e\x74\xb8\x2c\xd7\xdc\x90\x3d\x02\x02\xd6\xdd\x95\x6d\x9b\x15\xde\x11\xb3\xd9\x55\xb9\xc1\x6e\x85\x06\xb9\xc9\x4d\xb9\xd5\x72\x85\x3f\xa7\x86\x8b\xb2\xd3\xed\x88\x86\x0a\xee\xc9\x03\xb8\x1c\x23\x6f\x4e\xac\x9f\x29\xce\xcd\xf8\x28\x6e\xa3\x88\xc4\x52\x61\xf8\xfe\xc9\xa5\xcd\x3b\x18\xdb\x19\x15\x3c\x22\x84\xde\x4b\x9e\x92\xa2\x34\xa6\x6a\x96\xbb\x23\xcb\xb4\x03\x64\x33\x07\x75\xc8\x32\xdd\x9e\x65\xda\x3a\xb6\x21\xd5\xf4\x37\x97\x6a\xda\x3c\x7f\x04\xc4\x66\x46\x2a\x3e\xdf\x14\x75\xf0\xb5\xbf\x10\x95\x6f\x8a\x84\xe9\x32\x52\x87\x7c\xd3\x30\x86\x7c\xd3\x9d\xbf\x1f\xf2\x4d\x9b\x63\xc8\x37\x6d\x8e\x21\xdf\x74\xc8\x37\xdd\x32\x86\x7c\xd3\x95\x31\xe4\x9b\xee\x1c\x43\xbe\xe9\x90\x6f\xda\x1a\x43\xbe\xe9\x90\x6f\x3a\xe4\x9b\x62\x00\x0c\xf9\xa6\x98\x47\x87\x7c\xd3\x21\xdf\x74\xc8\x37\x1d\xf2\x4d\x37\x8c\x21\xdf\x74\xc8\x37\x5d\x1f\x43\xbe\xe9\x90\x6f\x3a\xe4\x9b\xee\x1c\x43\xbe\xe9\xd6\x39\x0f\xf9\xa6\x9b\xc6\x90\x6f\xea\xc6\xc6\x7c\xd3\x96\xe3\xf9\xd7\x9e\x74\x8a\x58\x6c\x7b\x29\x08\xa0\x90\x9b\x1a\x95\x79\x8a\x00\xea\x22\x31\xf0\x99\xa7\x08\x90\xed\x0e\xb0\x9d\x99\xa7\x08\x88\x55\x6e\x2a\x2e\xf3\x14\x01\xb1\x9d\x9b\x8a\xcb\x3c\x45\x7a\x73\x9b\xb9\xa9\x43\xe6\xe9\xa6\x31\x64\x9e\x0e\x99\xa7\xad\x31\x64\x9e\x0e\x99\xa7\xbb\xc6\x90\x79\x1a\x35\x86\xcc\xd3\x21\xf3\x74\xcb\x18\x32\x4f\x63\xc6\x90\x79\x3a\x64\x9e\xc6\x8c\x21\xf3\x74\xc8\x3c\xdd\xf6\xd8\x90\x79\x3a\x64\x9e\x0e\x99\xa7\x07\x7e\x64\xc8\x3c\x1d\x32\x4f\x87\xcc\x53\x32\x64\x9e\x0e\x99\xa7\xcf\x3d\xf3\x14\xf1\xa3\x29\xd5\xec\x3a\xa7\x73\xb6\x7d\xed\x88\xcd\x9c\x96\x3c\x4b\x2f\xf9\x0e\x8d\x1b\x01\x24\x65\x05\x13\x29\x13\xc9\x4e\x01\xaa\x93\x86\xa2\xce\xbe\x7b\x9b\xf9\xde\x9b\x92\xd3\x7b\xb6\xc3\x71\xd7\xa2\xa3\x6f\xed\x6f\x27\x05\x4b\xc8\x68\xb4\xf5\x09\x9c\x8d\xc8\x12\xa0\xec\x03\x2b\xa4\xe6\x46\xaa\x0e\xb2\x8e\xbc\x28\x9a\x41\xbe\x70\x07\xef\x6a\xad\xe8\x7b\x4b\xb1\x26\x60\x04\xde\xb5\x26\xfc\xba\x08\x54\x2b\x15\x33\x3e\x7f\x4b\x8b\xef\xd8\xf2\x03\x9b\x45\x7a\x45\x1d\xc5\xf7\x4d\xfa\x81\xea\x91\x57\x01\x60\x37\x65\x89\xb3\xd0\xa1\xad\x72\x6b\xb9\x82\x8e\x54\x7b\x62\x8a\x23\x78\x91\xe4\xce\xf2\xad\x1e\x93\x3b\x7e\x47\xf3\x8a\x07\x41\x2e\x21\x13\x66\x4c\xde\x4a\x70\xc0\xcd\x24\x56\x80\x58\x18\x53\xe8\x97\xe7\xe7\x77\xe5\x94\x29\xc1\x0c\xd3\x63\x2e\xcf\x53\x99\xe8\xf3\x44\x8a\x84\x15\x46\x9f\xcb\x7b\xa6\xee\x39\x7b\x38\x7f\x90\xea\x8e\x8b\xf9\xc8\x72\xb1\x91\xa3\x64\xfa\x1c\x18\xef\xf9\x97\xf0\x0f\xf2\xa5\xb7\xef\x2f\xdf\xbf\x24\x17\x69\xea\xf3\xd4\x4b\xcd\x66\x65\xe6\xd3\x27\xc7\x84\x16\xfc\x7b\xa6\xac\xae\x71\x46\xee\xb8\x40\x55\xb8\xb5\xa3\xe4\xe9\xd7\xc7\x8f\x71\x46\xb2\x70\x3a\x65\x8f\x73\x9a\x80\x20\xb3\xb4\x22\x09\x2c\xd5\x1e\x57\x85\xe7\x04\xad\xf8\x70\xa3\x01\x17\x83\xb1\xc6\x4b\x33\x11\x8b\x9d\x4a\x99\x31\xda\xed\x9f\x8c\xeb\x13\x8e\x31\x3d\x44\xb0\x5c\xcd\x12\xc5\xcc\xa1\xe8\x89\x83\xf6\xbc\x88\x49\x25\x9d\xd9\xa9\xd5\xa4\x05\x02\x01\xc6\x84\xbc\xc5\x5b\x1c\xa7\x8c\x50\x2b\x8a\xf2\x34\x40\xbb\x63\xc8\x44\x8c\x81\x44\x75\x8e\xdf\x38\x89\x9a\x38\x8c\xb2\x0a\x19\xda\x6b\xf1\xab\xa7\x4f\xc8\x1f\x1a\x9e\x33\x59\x76\xc4\x27\x46\x08\xc6\x3b\x5f\x98\x33\x43\x91\x62\x2d\x98\x07\xc0\x42\xef\x76\x90\x94\xc2\xf0\xac\xba\x5e\x73\x6e\x16\xe5\x74\x9c\xc8\xbc\x71\xd3\x46\x9a\xcf\xe1\x9a\x19\x25\xb3\x8c\xa9\x91\x91\x32\xd3\xe7\x45\x99\x65\xe7\xff\xf1\x5f\xff\xcf\x8e\x25\xce\x99\xd1\xce\xe0\xbf\x1d\x0b\x50\x1b\xfa\x69\xd4\x98\x0e\xf8\xfe\xd4\x3d\x1b\x95\xe2\x4e\xc8\x07\x31\x72\x17\xf2\x25\x31\xaa\xdc\xa6\x2f\xef\x26\x5f\x88\x83\xc0\xf0\x87\x58\xc3\xf3\xa1\xce\xbf\x8a\xed\xd8\x43\x49\x5b\x29\xe6\xe0\x00\x76\xe9\x3f\x78\xb6\x99\xc8\x3c\xd8\x8e\xbb\x2e\x2d\x9e\x14\x58\x94\x64\xa2\x33\x08\x18\x4d\x45\x3d\x3c\x84\xe9\x2d\x16\x24\x42\x94\x41\x83\xcc\x65\x29\xcc\x0d\x35\x8b\x83\x41\xc4\xf0\x76\x34\x30\xf8\x61\x07\xb0\x8d\xc8\x76\xbb\x2c\x3a\x15\x53\xf4\x3c\x22\xac\x31\xbb\x6c\x0e\xca\x92\xc7\x5d\x9b\xd3\x5e\x89\xfb\xf5\x61\xac\x06\x60\x36\x4f\x20\x42\xe1\x55\x46\x75\xc7\xfd\x42\x6e\x4b\x42\x0b\x3a\xe5\x19\xef\xbe\xb0\x7d\x9c\x68\xad\xcd\x78\x15\x5e\xb5\xec\x3e\xd4\x18\xe1\x1b\x67\x94\xaa\x47\x84\x89\x7f\xdd\x00\x74\xa1\x0c\x9f\xd1\xc4\x74\x2f\x21\x7e\x21\x6e\x50\xff\x86\xeb\x08\x9f\x6f\x0f\x37\xc4\x5c\xc9\xb2\x78\xe4\x77\xdc\x3b\x61\xf8\x11\xdf\x11\xeb\x1e\x1f\x35\xb6\x17\xfd\x88\xdf\xaa\x08\xb1\x15\xed\xab\x8b\x71\x06\x59\xa1\x2e\xa5\xbb\x04\xbb\x7a\xf4\xf7\x78\x47\x1e\x41\xc4\x7a\xf1\x67\x35\x6a\xdd\x69\x14\xfd\x3f\x9c\xa0\x8e\x27\x27\x28\x42\xb2\x0f\x09\x89\x21\x1e\x71\x64\x23\xea\x94\x23\x48\x45\x14\xdc\x08\xf2\x10\x01\x37\x06\xcd\x22\x88\x01\x96\x0c\x44\xa2\x63\xd7\xd5\xc7\x5d\xfa\x3e\xd7\x3d\x4e\x7e\x43\x54\x50\x55\xf2\x9e\xa7\xac\x23\xa4\x73\x93\x78\x74\xe3\x9f\xec\xba\x10\xc8\x19\xa3\xd0\x0a\x05\x0b\x83\x4a\xa3\x35\xb9\x6c\xe7\x8f\x91\x84\x6d\x54\x6d\xe7\xce\x1f\xf9\xa5\xee\xa3\x2a\x1e\x58\x51\x9c\x0c\x6a\xe2\x21\x41\x1e\x52\x4d\x84\x0c\xac\x84\x15\x46\xaa\x38\xae\x75\xdd\x78\x10\x82\x9e\x82\x59\x91\xf0\x94\x09\xc3\x67\x9c\x29\x8d\x8a\xf7\x90\x6a\x3e\xa6\x05\x4d\x16\x6c\x9c\xd0\x9c\x65\xe3\xbb\xf1\x07\x59\x1a\xa6\xdf\x48\x9a\x3a\x7b\x2a\x84\x05\x15\x8a\x9d\x17\xae\x20\xa6\x4c\xd8\xce\x2b\xe5\x86\xc7\xe2\x8e\xdf\x21\x25\xff\x08\x66\x83\x95\xdf\x32\x2a\xe6\xe5\x4e\x6f\xb5\x1b\xad\x7d\x7f\xe3\x1f\x3a\x98\x0e\x4c\x48\x06\xfb\x1c\x37\x0b\x77\x34\x2e\x50\xbb\x71\xf2\xc1\x86\xdf\x71\xa6\xdd\xbb\xbd\xa0\xc6\xe5\x99\x02\x82\x16\xe0\x01\x59\x70\xed\xcf\x94\x50\x13\x94\xee\x67\x68\xdd\x38\x90\x55\x41\x1b\x56\x3c\x83\x80\x8a\x4e\x1b\x35\xe2\x3d\xf7\x32\x2b\x73\xf6\x56\x96\xc2\x1c\x8c\xa9\x7c\x5f\xc3\xf4\x5f\x4c\x21\x89\x13\x0c\x5f\x10\x8b\x35\x23\xd4\xff\x6a\xe7\xb9\x3e\x70\xb3\xe0\x82\xd0\x10\x0e\xca\xd4\x2e\xd7\x18\x96\x47\xa1\xed\x6f\xad\x45\xd9\x07\xc2\x84\x5c\xe1\x00\x3f\x25\x8b\xf1\x2e\x70\x0b\x43\x52\xdd\x7e\x37\x82\xfb\x60\x36\x2c\xf5\x6e\x43\x1f\xaa\x07\xa0\xc9\xf1\xcb\xe3\x2e\x57\x60\xa4\xd1\x51\xc9\x82\xce\x29\x26\xfd\xbd\x1d\x0a\xbd\xf2\x30\x49\x99\x61\x2a\x87\xdc\xdc\x85\x7c\x70\xdf\x77\x53\x7d\xcb\x8b\x0a\x0f\x85\xf9\x86\xbd\x50\x6b\xda\xb2\x0e\x23\x9b\x5b\xea\x62\x82\xbb\x19\x14\xf8\xba\x1e\xe8\x92\x50\x25\x4b\x91\xfa\xd4\x6a\xbb\x89\x9a\x99\x33\xf2\x76\x65\xe2\xef\xa4\xe8\x06\xca\xb5\x65\x6c\xa9\xcf\xa2\xa8\x02\x9e\xa7\xcc\x50\xc2\x05\x79\x31\x7e\xd1\x59\xdc\xe1\xa0\xb4\x6d\xdd\xff\x03\x0e\x3a\x08\x38\x84\xfd\x0b\x2e\xda\x70\xa7\x0e\x36\x3b\xc5\x68\xfa\x5e\x64\x9d\x62\x52\x5b\x69\x76\x08\x0d\x0f\x8f\x20\x24\x9b\xcf\xc0\xb3\x73\xe6\x3e\x7a\x50\xdc\xf8\xde\x06\x0f\x1c\x91\xc0\x7d\x32\xa3\x99\x86\x82\xfa\xa5\xa8\x22\x4a\x4f\xc7\xe4\x92\xcd\x68\x99\x19\x10\x40\xe0\x27\xb8\x65\x63\x04\x4e\x5d\x4e\xf7\xa6\x0f\xfe\xa2\x03\x92\x57\xe4\xa1\x46\xf1\xe3\xee\xeb\xb2\x9d\x54\x34\x97\x7e\x74\x44\x4e\xdc\x2f\x11\x20\x95\x94\xa6\xa3\x93\x44\x04\x7a\xf8\x6d\xba\xfa\x54\xc4\x89\x28\x57\x9f\x0a\x2a\xa0\xb2\x7a\xd7\x9e\x21\xa4\x91\xd6\x9e\xee\xd8\xb3\x6f\xd8\x82\xde\x23\x22\x07\x34\xcf\x79\x46\x55\x06\x21\x63\x13\xb7\x42\x68\x0d\xc1\xc4\x3d\x57\x52\x40\x42\xc5\x3d\x55\x9c\x4e\xb3\x2a\x22\x02\x13\x60\xff\xbb\x93\xef\x2f\x3e\xfc\xe3\xdd\xc5\xdb\xab\x53\xa0\x84\x2c\xec\x42\xa9\x43\x14\x75\x73\x25\x8d\xd7\x75\xc7\x83\x6c\x43\x08\x7f\xe0\x61\x1d\xf6\xa4\x80\xb6\xfa\xbf\x51\x14\x3b\x2f\x43\xd1\x8b\x4f\x49\x56\x6a\x7e\x7f\x28\xfa\x82\xb3\xfc\x8c\x6a\x7e\xdd\xf1\x3b\x4b\x4b\x1f\x5f\xf6\x73\x5b\x7b\x60\x59\xa9\x95\x10\x0b\x0b\x49\x03\x2a\x83\xfc\x53\xc8\xb4\xbb\x23\x47\x4e\x97\x10\x4f\x94\x58\x15\xcc\x55\x66\xa1\x62\xd9\xe0\xaa\xfe\x96\x15\x72\x67\xd4\x37\x56\x8c\xa2\x0f\xfa\x2a\xa3\xda\xf0\xe4\x9b\x4c\x26\x77\x13\x23\x55\x1c\x2b\x3b\xbe\xf8\x61\xb2\x06\xa1\xb5\x13\x82\x5c\xfc\x30\xe9\x46\x7e\xae\xef\x2a\x17\x7a\xd5\x31\x85\x1a\x43\xa1\x17\x04\x64\x06\xdf\x95\x53\x96\x31\x73\x7c\xac\x41\xda\xe8\x36\xde\xd3\x64\xc1\x05\x0b\x82\x88\xb0\xb7\x55\x6a\x07\x2d\xec\x61\x23\x08\x0a\x15\xde\xa4\x8d\x54\x74\xce\xce\x3d\x06\x7d\x49\x1f\x34\x73\xcb\x9f\xda\xe5\xdb\xaf\x59\x57\x58\x51\x8c\x89\x79\xa6\x6f\x11\xae\x5c\xb2\x76\x2c\xaf\x79\xc6\x7c\xa9\x1c\x7b\x1d\x82\xe2\xe8\xf1\x11\xd9\x18\x86\x90\xa5\x2c\xc9\x03\x75\xb9\x68\x70\x85\xc7\xe4\x96\x17\x2f\xc9\x95\xd0\xa5\x62\x75\x6e\xfb\xac\x7a\x1d\xda\xe1\x61\xcf\x57\x97\x45\x21\x95\x09\x15\x88\xbc\x14\xe9\x52\xd1\x2c\x3d\x75\x20\x71\xa1\x74\x57\x9f\x68\x5e\x64\x4c\xbf\x24\x47\xec\x93\xf9\xc3\xd1\x19\x39\xfa\x34\xd3\xf6\x1f\x61\x66\xfa\x68\x4c\xae\xf3\x22\xe3\x09\x37\x56\x98\x11\x11\x2d\x3f\x5c\x99\x23\x07\xd4\x8a\x41\x0d\x09\xe6\x51\xd0\x07\x35\x29\x17\x15\x67\xe5\xf6\x54\x92\x07\x2b\x92\x43\x65\x23\xc2\x94\x92\x4a\x07\x2a\x11\x79\x2c\xc0\xb3\xc1\xfa\x27\x73\x5e\x31\x34\x7f\x8d\x30\xc1\x72\x51\xae\x88\x82\x2a\xc3\x71\x55\xbc\x56\xb1\xfb\xd6\xde\xde\xf0\x38\x69\x0b\x1e\x80\x93\x4b\x59\xa2\x56\xbc\x82\xdb\xd7\x33\x22\x73\x6e\xa0\x5e\x92\x05\x99\x3a\x96\x0c\x65\x20\xfc\x8f\x50\x60\xa7\xcb\x30\x1b\xcb\x06\xc6\x0d\xdc\x7c\x2d\x55\xf8\xea\x3c\x65\xf7\xe7\x3a\xa5\x2f\x70\x21\x8b\xf6\x2a\x6a\x1f\x20\x68\x5a\xeb\xa7\x9a\x1c\xbd\x38\x1a\x93\x49\x90\x7a\xf0\xa5\xc5\xfd\x54\x6a\x58\x33\xa9\xaa\x89\x41\x7a\xe7\x57\x47\xe4\x44\x2a\x78\x7b\x82\x08\x0a\xb4\x23\x63\xf4\xde\xb5\x12\xf2\x94\x6e\xe9\x12\x06\x4f\xc7\x18\x1c\x8a\xad\xa5\x17\x57\x47\x0f\xab\x0d\x91\x35\x9c\x0b\xc1\x99\x47\x56\x0d\x3a\x02\x85\x45\x82\xad\x4c\xa4\x50\xb3\x02\xbb\xe5\x1f\xfc\x0c\xea\xcd\xe1\xa2\x69\x6d\x71\xf2\x1f\xbc\xc4\x22\x24\x0a\xe6\x36\xa4\x3d\x02\x9d\xea\xe8\xb3\x70\x39\x12\x1d\x50\xea\xde\x76\x7d\x19\x7f\x36\x1f\x05\xff\xa9\x64\xe4\xfa\x32\xf0\xb9\x82\x29\xcd\xb5\xb1\x14\x31\x6d\x4a\x16\xa8\xdd\xe4\x20\xb2\x90\x93\x8b\x9c\xfe\x4b\x0a\x72\xf5\xcd\xc4\x4f\xed\xf4\x33\x6f\xe4\xc1\x7d\xb1\x61\xc7\x51\xba\x00\xc2\xbf\x4a\xff\x55\x2a\x66\x45\xb9\x28\x19\xf2\x22\x3c\xb5\x2a\x37\xda\xcf\xc9\x25\x35\x14\xc4\xc3\x6e\x79\x0f\x8c\x95\x52\xd4\x92\x84\xbd\x9b\x53\x2e\x52\xff\x55\x43\xee\x3b\xa0\x88\x96\x00\x7f\x9c\xbf\x95\x69\x0f\x39\xed\xaf\x76\x9a\xaf\x1c\x04\x92\x5b\x10\xe4\x9d\x14\xec\x0c\xc8\x04\xb1\x74\x02\xc7\x1d\xe0\xe7\x3f\x28\x6e\x18\x8a\xc8\x46\x31\x6a\x7b\x83\xde\x21\x33\x0f\xd6\x52\x2d\x9a\xa9\x07\xa9\x3d\x4a\xb8\x8f\x9e\x65\x4f\x33\x39\x45\x2d\xcf\xdf\xa3\xc7\x58\xd9\xc7\x0f\xd7\xbd\x16\xf6\xf1\xc3\xf5\xf6\x45\x3d\xd6\x84\x7b\xaa\x03\xab\xda\x40\x2d\xf1\xbc\xf5\x69\x02\xb8\x0a\x4e\xb3\x15\x38\x1d\xf2\x3b\xee\x68\x9d\x8c\x4f\xae\x3e\x8d\xbb\x25\x77\x24\xfd\xf6\x1d\x36\x77\x49\xee\x87\x3e\x99\x3b\x2e\x50\xd1\x3a\xed\xeb\x7f\xf5\xa9\x70\xb9\xe1\xbe\x70\xc6\x64\x41\x2d\xe9\xae\xab\x89\xa2\x6f\x88\xc5\x40\x6d\xf9\x5e\x40\x3d\x42\x93\x04\xa8\x1e\xb9\x64\x29\x87\xec\xfb\x97\xa1\x6c\x49\x14\xd4\xcd\x40\xdf\x52\x41\xe7\x16\x24\xd0\x7c\x92\xbb\x3f\x71\x40\xab\x1b\x73\xe2\x4c\xcb\x22\x3c\x4e\xe8\x3d\xe5\x59\x88\x58\xd5\xac\xdb\xce\xe9\x21\x36\x4c\x67\x1a\xb6\xf0\xe0\x44\xb0\xb7\xe0\xb8\x66\xe2\x26\x27\x16\xd6\x39\x98\xd0\x4f\xc7\x95\x3c\x88\x5a\x28\x94\x53\x00\xc7\xad\x13\x3e\xed\x9d\xab\x04\x4a\x9f\x8e\xbc\x2a\x4f\xe2\x51\x1d\x27\xa8\x45\xc5\x14\x7a\xd6\x81\xfc\xe9\xc7\x0f\xd7\x07\x15\x45\x2c\xdd\x8b\x17\x45\xec\x53\x1b\x45\x11\xf8\x62\xc2\xd4\x3d\x47\x48\x92\x9f\x49\x1a\x19\xf0\xb4\x1e\x2e\x1b\xb3\x97\xe8\x02\x95\xb6\xbd\xe8\x12\x52\x44\x5b\x15\x36\x01\x23\x50\x7b\x31\xf1\xa4\xf3\xc2\x93\x4e\x90\x88\x2c\x2e\xc4\xd4\xc9\x40\x52\x29\x20\x7e\xbd\x16\x0c\x9c\x87\xa0\xee\xea\xa3\xa9\x23\xf5\x79\x61\x7e\x1c\x96\x7a\x28\x8a\x91\xb0\x62\x31\x8b\x4c\xaf\x60\xc5\xe2\xf5\xa4\x6d\xf8\xb7\x9f\x91\xd7\x93\xd6\xfd\xef\x5c\x8e\xf3\x9e\x43\x87\x74\xbb\x2c\xed\xdc\x05\xc7\x9a\x64\x7c\xc6\x10\x71\x38\x31\x14\x22\x97\x82\x63\xe2\xd1\xd6\x56\x7b\x1c\xda\x0b\xbf\x24\x6f\x3d\x0c\x57\x54\x2d\x91\x59\xc6\x12\x64\x99\x77\xa8\xea\x0b\x9b\x14\x26\xb2\x49\xa9\x66\xde\x62\x36\xbe\xfb\x13\xa8\xd5\x5e\x81\x3e\x77\x87\x74\xfe\xe1\xea\xe2\xf2\xed\xd5\x38\x4f\xbf\x5c\xc8\x87\x91\x91\xa3\x52\xb3\x11\x37\x18\xae\x1f\x91\x8a\xd2\x2b\x2e\x1f\x97\x58\x50\x20\x5c\xd3\x64\xed\x00\xde\x87\xdc\x62\xf2\x51\xbb\xaa\xdf\xae\x8d\xb5\x77\xd6\x4b\x69\x70\xda\xa2\xa2\x3e\xbf\x98\x7a\x3b\x71\x99\x65\xee\x4c\x8c\x62\xec\xac\x69\x42\x3a\x7f\x3e\x92\x54\x63\xf9\x1d\xcc\x2a\xce\x10\x87\x61\x56\x58\xc3\x51\x8b\xa1\x3d\x2d\x62\xf7\xe1\x8d\x18\x01\x89\xec\x38\x87\x49\x05\x25\x94\x4a\x86\x70\x00\x64\xbb\xad\x3b\xb6\x24\x50\x9a\x74\x26\x95\xc5\x68\xd5\xc6\x3c\x66\x12\xd8\x96\xf3\x52\x33\x35\x76\x13\x46\xc1\xfd\x0c\xdb\x8e\xe5\xcf\xb0\x08\x54\x4d\x8d\xae\x4d\xff\xc0\x66\x2e\x87\xdc\x07\x2f\x60\x37\x1d\x3a\xa0\x94\x66\xc1\x84\xf1\x11\xf4\x41\xc0\xd9\x74\x0a\x28\x90\xbe\x60\xed\x93\x6e\x7b\x6c\x92\x1f\xbe\x74\xc6\x23\x15\xcf\xf8\x4c\xe5\x33\x62\x0a\x68\xa0\x41\x42\xa1\x8d\x88\x12\x1a\x8f\x99\xe2\x46\xec\x82\x50\x45\xcd\x77\xf0\x52\x15\xc8\x97\xa2\xa9\x84\xd0\x41\x05\x18\x83\xdb\x92\x06\xd1\xa2\x69\xce\xc5\xb3\x25\x40\x31\xc2\x78\x90\xce\x0e\x26\x5e\x73\x11\x1b\x06\x7f\xfc\x0a\x9e\x69\xcb\xd7\x0e\x4e\xf0\x5c\x86\x08\x91\xce\x05\xd1\xa0\x77\x43\x2f\xa5\x10\x4b\xe2\x22\x49\x82\x8b\x1b\x45\xc2\xf2\xa5\xfe\x29\x1b\xb9\x59\x8c\x8a\xb4\x3e\xb9\xe7\x18\xf6\xb1\x66\xe8\x45\xe1\x33\x7d\x64\x43\x2f\x3a\x50\xe3\x70\xe6\xde\x58\x7a\x7d\xf0\xd3\x27\x83\x98\x1c\x46\x1f\x31\xf9\x00\xfb\x1e\x27\x19\xef\x29\xa3\x41\x27\x34\x1d\x3a\x5a\xb8\x12\x4e\xf8\x62\xd2\xde\xca\x04\x5d\xd4\xa8\xa2\x39\x33\x4c\xb9\x80\x76\x1f\x62\x2f\x58\x02\x06\xc4\xf7\x05\x13\x13\x43\x93\x3b\x94\xd7\x6f\x90\x99\xb0\xaf\xfd\xd5\xcb\x4c\xfd\x63\x2d\x42\x48\x6b\x5a\xe1\xa3\xcf\x48\x5c\x36\xa2\xb0\xb0\x74\xc0\x5d\xe8\xa7\xa2\x00\xcf\x3e\x7e\xa2\x2a\xe4\x1a\x67\x85\xac\xaa\x58\xb6\x05\xa5\xea\x63\x6f\x58\x94\x25\xa2\xb9\x63\x21\x8b\x32\xa3\x86\xb9\x7c\x40\xd4\x69\xc6\x15\x8e\x01\x8e\xd4\x2f\x58\xa2\x26\xaf\xb9\x4c\x19\x99\x72\x47\x61\x4b\x0d\xad\xe5\x12\xc5\xd0\x2d\xfa\x40\xbe\xb1\xb2\x8c\x9f\x4f\xc3\x11\xee\x8b\x8a\x4f\x99\x79\x60\x4c\x90\xaf\xac\xe4\x88\x82\xf9\xd5\x7f\xfe\xe7\x7f\xb6\xd3\x3a\xbe\xfa\xe3\x1f\xfe\x30\x26\x97\x5c\x41\x45\x75\xa8\xe8\x5f\xe5\x49\xa0\x40\x82\x31\x25\x74\x16\xa5\xb3\x99\xf3\x15\x87\x6e\x9d\x9e\xe7\xfa\x1c\xa7\x1c\xd9\xb8\x15\xea\x55\xda\xab\x27\xc5\x2c\xe3\x89\x71\x95\xd0\x1d\x99\x73\xa9\xa6\xbe\xfe\xb9\x7b\x1f\xda\xa6\x60\xb7\x14\xce\xe5\x8c\x64\xfc\x8e\x91\x99\xfe\x56\xc9\xb2\xa8\x7b\x43\x28\xa6\xad\x92\x84\x8d\x22\x9c\xfa\xfc\xa2\xc6\x59\x6b\x66\x9e\x41\x08\x61\xbf\x36\x98\xd7\x2d\x79\xf4\xcc\xb5\xf5\xbc\x63\xcb\x91\x43\xb7\x82\x72\x5c\x05\x5a\x1f\x64\x02\x51\x51\x2e\xad\xad\xcd\x66\x13\x96\xd6\x04\x01\x05\x11\x44\xba\x29\x04\x6b\xfe\xd3\x21\x18\x0f\xbd\x66\x83\x92\xa5\xbd\x4a\x10\xd1\x2b\x1c\x9c\x67\x5e\xa9\x0e\x1d\xe0\x7c\x82\x7d\xf8\x18\x56\x0e\x41\xb7\xf5\xbe\xa0\xd1\x2d\xe3\xda\x4e\xf5\x8e\x2d\xf5\xae\x15\xc4\xb5\x2f\xb0\xf7\x4d\x3b\x8c\x2d\xc5\xda\x1b\x7c\x4f\x5e\x4f\x62\x71\xd2\xf3\xf5\xcc\xd7\xc1\xe5\xcd\x7e\xbb\x2e\x97\xcc\x17\xf1\xf7\xf0\x08\xc7\xdd\x8b\x56\xdd\xe2\xb3\xe6\x31\x69\x66\xca\xc2\x4d\x15\xc2\xc0\xed\x1a\x30\x89\xfc\x04\x90\xda\x4e\x27\xa7\xea\xce\xea\xc7\x9e\xce\x8e\x21\x21\xae\xee\x15\x1b\xfa\xac\xe2\x94\x48\xab\x70\xfb\xe6\xbb\x21\x19\xd6\x4e\xf6\x78\x3c\x3e\x76\x84\x4d\x2a\xa2\x0d\x55\x8e\x02\xa1\x60\xda\x67\x31\xfb\xde\xbf\xdc\x58\xa1\x5d\x9f\x2c\x2b\x7a\xfb\x92\xe3\xb4\x99\xe2\x86\x14\xdd\xa8\x3f\x13\x1c\x92\xc4\x17\x2c\x8b\xea\x6e\xb8\xad\x96\xba\xbf\x2e\xf8\x3e\x14\x3d\xca\x91\xe5\x48\x46\xbf\x61\xa6\xbb\xd9\x3d\x1a\x26\x09\xad\xad\x33\x76\x56\x61\x72\x7f\x2e\xef\x86\xe3\xf5\xd7\x33\x97\xa5\x5c\x53\xf5\xc6\x6d\x6c\x48\x3a\x11\x80\x03\x29\x6b\x24\x2f\x03\x63\x5f\x61\xda\x51\x10\x77\xb1\xf7\x8a\x75\x47\x40\xc4\x31\x79\xac\xb1\xc9\x8d\xbe\xac\xde\x8d\x3e\xfd\xf7\xe3\x3b\xf0\x93\x08\xbf\xad\x1b\x6b\x37\xaf\xea\x54\xed\x08\xe0\xac\xcf\xf6\x1b\x09\xbd\xc2\x4c\x75\x91\xc7\xe4\xad\xa7\xb3\x16\xb3\x05\xa1\x53\x2d\xb3\xd2\xc4\xc0\xb4\xd3\xa9\xc1\x34\xc9\x35\x4c\x34\xf4\x20\xc3\xd2\xdf\x30\x02\xc0\x9a\xcc\x3b\xa6\xec\xe8\x6b\x1c\xb4\x27\xa8\x53\x88\x6f\xdf\x37\x82\x7d\x89\x08\x22\x78\x94\xea\x84\x58\xfb\x4c\x9c\x6d\xe6\x73\xd8\x5c\x1e\xa5\xc8\x3b\xd6\xd6\x12\x85\x59\x31\xe5\xdd\xa3\xfa\x4f\xa0\xeb\xbb\x83\x40\x1a\x5f\xde\x3d\xc6\x08\x8a\x37\x58\x68\x1e\x67\xaa\x98\x5c\x93\x93\x57\x55\x32\x73\x08\x8d\x83\x6a\x57\x33\x9a\xb0\xd3\x86\x09\xa3\xdb\x50\xeb\x9f\x0e\xd9\xc2\x0b\x2a\xd2\x2c\xe4\x4c\x13\xf6\xc9\x30\x25\x68\x06\xef\x4c\x15\xbf\x47\xd0\xf8\x93\x8b\xac\x58\x50\x32\x63\xd4\x94\xaa\xdb\x40\x1e\x65\xf8\x80\x19\xc4\x47\x65\xc2\x63\x41\x63\x12\x8d\xab\x1b\xb1\x2c\x12\x4a\x3e\xb9\x1d\xd2\x4d\xdb\xce\xd8\x22\x21\xf0\x6c\x20\xcf\x4b\x59\x2a\xe7\xc4\xc4\xd9\x51\xa4\xf2\x45\x0f\x94\xb2\xd2\x04\x4c\x90\x6a\xa2\xd8\xdc\x6a\x4f\x0a\x54\x31\xf7\x8b\xac\xb4\x1f\x1c\x3c\xee\xfe\xe0\x19\x11\x8d\x3c\x04\xd4\x16\xac\x3a\xaf\x66\x5e\xa9\x83\x52\x82\x5e\x24\x74\xfd\x12\x41\xe4\xc4\x69\x63\x9a\x14\x54\x37\x52\xd5\xa9\xd6\x32\xe1\x50\x71\xa7\x3e\x77\xaf\x46\x5a\x91\x11\xa9\xea\xf8\x82\x3f\xad\x34\xc1\xa6\xab\x4f\x42\x61\x45\x54\xef\x92\xa8\x43\x12\x32\x65\x37\xe5\x34\xe3\x7a\x31\xe9\xed\x5d\x79\xb7\x01\x88\x8b\x3f\xac\x4c\x1f\xb8\x9b\x20\x9b\x8d\x5f\x1c\x91\x6b\x7a\x5c\x34\x13\x9a\x83\x90\x66\xd9\xa1\x15\x2c\xb1\x81\x8d\x56\xa7\xa2\x5a\x87\x37\x34\x4e\x0a\xbc\x36\x79\x91\x31\xc3\xc2\x57\x28\x88\x8d\x35\xfb\x22\x13\x56\xd0\xb6\x9f\x7e\x14\x45\xeb\xf3\x84\x66\x19\xb2\x5d\x7a\xbb\x24\x52\x60\x68\x4e\x86\x0f\x05\x28\x1c\xc6\x72\x8b\xcc\x38\xe7\xae\xdb\xcd\x46\x53\x8b\xaa\xdf\xf6\xc6\x8d\xc6\xb1\xba\x5c\xba\x22\x03\x82\x48\x11\x00\x9d\x11\x9a\x65\x01\x68\x5d\xba\x85\x50\x64\x38\xb6\xbb\x58\x98\x9d\x1a\xdc\x65\xd8\xd7\xfe\xea\xdd\x65\xbd\x9d\xf1\x93\xaa\x99\x2f\x6d\x14\xd3\x72\xae\x99\x52\xe1\x49\x4b\x60\xb4\x81\x69\xef\xef\xd9\xef\x93\x9b\x7d\x61\x8c\xe2\xd3\xd2\xe0\x6e\xc4\x93\x15\x44\xdf\x50\x8c\xa7\x9e\x29\x88\x89\x4c\x7b\x4a\x3c\xf2\xc6\x9a\x24\xd2\x30\xe7\x6d\x26\x8a\xad\x30\xe6\x9a\xc6\xe3\x68\x6f\x10\xb6\x40\xce\x72\x0f\x1e\x6b\x92\xca\xa4\xb4\x0a\x36\xad\x6a\x30\x54\x01\x37\x28\xa8\x2e\x7b\x10\x7f\xea\x87\xaf\x1d\x8f\x11\x44\xd1\xef\x4e\xe5\x83\x78\xa0\x2a\xbd\xb8\xe9\x4c\xcd\x6d\x0b\xcb\xf5\x73\x4d\x57\x68\x00\x47\xec\xe7\x74\x2a\x4b\x44\xbf\x59\x97\x98\xd5\xf4\x9c\x0e\x9e\xd1\xc1\x33\x3a\x78\x46\x77\x8e\x9e\x9e\x51\xfb\x94\x13\xe4\xa1\xa3\xbc\x9c\xb5\x6f\x6c\x44\x44\x09\xd2\x92\xda\xd7\x3d\xd4\xa0\x2f\x8e\xcb\xac\x64\x8a\x22\x05\x95\x86\x5a\x01\x8a\x01\xdc\xce\x1a\x53\x1a\xaa\x08\xbe\x9f\xbb\x25\x56\x20\x6e\x3d\x92\xc3\x09\x60\x23\xb5\x36\x37\xb6\xa5\x8e\xd5\xed\x41\x83\xfb\x3a\xca\x95\x03\x74\xf9\x25\x01\x31\x8a\x0a\x21\x1d\xcf\xd4\x67\xae\xfd\xbb\x3e\xeb\xaa\x20\xb8\x3a\xec\x1d\xac\x3a\xd6\x03\x45\xa9\x58\x6f\x84\x03\x22\x7e\x43\xe1\xdd\x95\x5c\x1c\xf3\xd4\xaa\xbc\xe3\x20\x54\xfd\x4c\x93\x05\xcb\x69\x04\xe6\x84\xf1\xda\x9e\x06\x14\xca\xe4\x9a\x58\x31\xd2\x30\x57\x5a\x8b\xa9\x5c\x13\x39\xab\xb2\x42\xf0\x6a\x81\x1b\x46\x92\xa3\xfb\x17\x47\x31\x7e\x83\x5e\xae\x46\x12\xd0\x14\x53\xf6\xb5\x3d\xd6\x8b\xc0\x56\xae\x19\x8b\xa2\x55\x73\xd8\xc8\x95\x7b\xd3\x57\xed\xf7\x07\x72\xe6\xce\xeb\x09\xb6\x23\xd6\x01\x42\x40\x94\xab\xb6\x30\xd2\x33\x13\x11\xde\x3a\xb8\x85\x07\xb7\x30\x6e\xfc\x06\xdc\xc2\x0d\xd6\x18\xa8\xaf\xdf\xb2\x88\x60\x9b\x7a\x16\x2d\x1f\x01\xc8\x13\x2e\x2d\xc2\xeb\x00\xe3\x98\xc6\xd6\x04\x4c\xb7\xee\x66\x78\x9f\x32\x91\xaa\x1d\xcd\x73\x3c\x1e\x1f\xbb\x78\x9e\x28\xc7\xb0\xbf\x70\xa5\x99\x8d\xfe\x44\x98\x48\x64\xea\x10\xdb\xce\x59\x69\x03\x22\x5a\xbc\x68\xd0\x76\xaf\xe7\xa1\x16\x7f\xc3\xfd\xec\xe6\x8b\x47\xa1\x1e\x84\x37\xd4\x9a\x7b\xbd\xa7\xc8\x54\x0b\x4a\x55\x5d\x5c\x7f\xac\x55\x35\xe0\x18\xca\x0e\x12\x53\xd5\xa4\x96\x64\x3c\xe7\x50\xdb\x24\x05\x3e\xc1\xb4\xd1\xe4\xc4\x7d\x38\x4e\x8a\x12\x6f\xa8\x23\x1e\xd4\x38\x67\xb9\x54\xcb\xb3\x0a\x9c\x05\xd3\x82\xef\x7f\x71\x1a\x23\x21\x29\x46\x92\x52\x29\x26\x4c\xb6\x7c\x42\xc9\xac\xda\x61\x6c\x2d\x8d\x7a\xac\x64\xb0\x55\xbe\x4e\xb0\x0e\x57\x3c\x39\x52\x92\x98\x55\x65\x43\xf5\x59\xdd\x10\xc6\x7e\xca\xc4\x3d\xb9\xa7\x4a\xe3\x77\x84\xf4\x17\xaf\x52\x7e\xcf\xb5\x8c\x42\x3b\x2b\x61\x2f\xdf\x47\x5c\x02\x37\x46\xbd\xc8\xf0\xea\xd3\xd1\x0b\xdc\x6a\xbd\x85\xae\x3b\xa5\x29\x4a\xe3\xb9\x4a\xe4\x8c\xfc\xcd\x0d\x15\xa8\xab\x7b\x78\xd6\xac\x5d\x15\x09\xf3\xe8\xc5\x51\xd4\x13\x05\x35\x86\x29\xf1\x92\xfc\xcf\xc9\xdf\x7e\xff\xf3\xe8\xf4\xeb\x93\x93\x1f\xbf\x1a\xfd\xd7\xdf\x7f\x7f\xf2\xb7\x31\xfc\xe7\xdf\x4e\xbf\x3e\xfd\x39\xfc\xf1\xfb\xd3\xd3\x93\x93\x1f\xbf\x7b\xfb\xed\xed\xcd\xd5\xdf\xf9\xe9\xcf\x3f\x8a\x32\xbf\x73\x7f\xfd\x7c\xf2\x23\xbb\xfa\x3b\x12\xc8\xe9\xe9\xd7\xbf\x8b\x9a\x66\xab\xcd\x38\x17\x66\x24\xd5\xc8\x1d\xe4\xce\xe6\xe2\x9b\x46\xd8\xe6\x7d\x6e\x70\xcd\x9e\xeb\xf2\xe4\x41\x1f\x78\x82\x4b\xd7\x4f\x88\x8f\xaa\x77\x4a\xfa\xc8\xf0\xf1\xd1\x55\x9f\x3f\x62\x0a\x0d\x18\xbc\x9f\x97\x3c\x32\x5b\xf9\xca\x3f\xd5\x4e\xc3\x31\x2c\x2f\xa4\xa2\x6a\x49\x52\x6f\xee\xec\x0e\xec\x5a\xaf\x04\xd4\x28\x05\xb4\x77\x11\x5a\x58\x5d\xca\xd5\x21\x13\x96\x73\x96\xf2\x32\x8f\x37\x5c\xff\x00\x15\xe4\x7d\x85\xfa\x10\xd0\xe3\x80\x61\x33\x96\xec\x98\xd2\xe4\xce\x29\x74\xd5\x26\x3b\x69\xb2\x59\x0c\xf9\xc8\x07\x4c\xe4\x8c\x22\xfd\xcf\x5e\xad\x84\xd0\x1b\x99\x32\x7b\x0a\x01\xa0\x9b\x63\xc3\x3a\x8e\x53\x3d\x9d\x5f\xdd\x07\x40\x9e\x78\x60\xa7\x56\xaa\x7e\x0b\xc2\xd1\x93\x1d\x2e\x89\x2e\x5d\xc2\xff\xc5\xde\x58\x49\x0f\xe5\xfa\xc3\xf3\xfc\x3e\x9c\x3e\x9a\xbf\xaf\x94\x8f\x97\x86\x66\x84\xfa\x0a\x5d\x33\x92\xc9\x84\x66\x15\xf6\x45\x89\x68\xce\x2f\xcb\x35\xa9\x2e\x7f\xf0\xd0\x5a\xec\xb3\x7b\xe6\xc4\x63\xb0\x6f\x67\x1a\xc7\xe2\x7d\x77\xd3\x69\xc6\x00\xbe\x13\x9b\x2b\x8c\xbb\x85\x9a\xfc\x9f\x78\x5e\xe6\xa4\xd4\xb8\x22\xb3\x56\xfc\x6f\xc3\xa9\x27\xfc\x10\xda\xec\x40\x2d\x29\x2e\x2c\x60\xbc\xd7\xb1\xb2\x95\xd8\xa7\x27\x01\x47\x1a\x36\x2f\x48\x1b\xc7\x9a\x51\xc0\x5c\x56\x5a\xcd\x2f\xcc\xd6\xeb\x29\x72\x06\x61\x1f\x95\x5c\xae\xb1\xe9\x32\xd4\xb5\xf9\x58\xa1\x05\x82\x67\x4d\x62\x50\x35\xb2\x40\x81\xac\x0e\xb4\x14\x3e\xfc\x72\xed\xd6\x6e\xbe\xb4\xa5\x66\x6a\x34\x2f\x79\xda\xef\xba\x3e\x6b\xf9\x6d\x4f\xa9\x0d\xcd\x9a\x67\x49\x14\x53\x7e\xfd\xaa\xcd\x8d\x5f\xf3\xa9\x62\xe4\xd5\x82\x0a\xc1\x32\xbc\x98\xb4\xbb\x21\x4d\xbb\x8a\x48\xd5\x6f\xa6\x13\xea\x86\x7e\x34\x07\x64\xc9\xbf\xee\x1a\x22\x4f\x5a\x2c\xba\x6e\xb7\x82\x73\x1a\xa2\x5a\xb2\xac\xb7\x5b\x41\x93\xc8\xc7\x6a\xc9\x92\x95\x3d\x9a\xb1\xd4\xf6\xf8\xd7\xaf\x88\xa1\x6a\xce\x8c\x05\x44\x44\x99\x4f\x19\x8a\xb0\x3d\xd7\x96\x1f\xbf\xb4\x42\x2a\x87\xaf\x85\xe2\x8e\xf3\x87\x1f\xde\xf5\xa8\x16\xba\x09\x2f\x1e\xa4\xca\xd2\x07\x9e\xba\x50\x76\x9c\x10\x7e\x62\x5f\x7f\xfa\xcb\x29\xed\xf9\xf0\xc0\xd3\x7d\xb7\xcb\x3b\x8b\x60\xbb\x08\xec\x57\xa3\xed\x37\x6e\xd7\x60\x1a\xa7\xe4\x8a\xbb\xf6\xaa\xf6\x2f\x67\xbc\xcf\xa7\x5c\xb8\xe0\x03\x39\x6b\x1c\x30\x8e\xaa\x8b\x14\xee\x76\x70\x98\x41\x83\xd6\x69\xe9\xcc\xec\x53\x69\x16\x44\xf3\xbc\xcc\x0c\x15\x4c\x96\x3a\x5b\xa2\x50\xf2\x39\x1c\x1b\x5e\xfe\xc8\xd8\x27\x77\xe9\xe2\xe4\x90\xea\xb1\xb6\x3c\x32\x67\x82\x29\x9e\x60\x83\x5c\xd6\x3a\xe4\x41\x9e\x81\xe6\x52\xb0\xf4\xbc\x12\x4e\x5c\x33\x4a\xc8\x81\x61\xdd\x81\x8e\x53\x6a\x65\x90\x22\x2b\xe7\xbc\xd3\x2b\xfd\x59\x33\x5e\xaa\x58\x7a\x14\xaa\x58\xc5\xbd\xd2\xce\xf0\xd9\xd8\x43\x3b\x8e\x4e\x09\xab\xa1\x4b\xc5\x4e\x33\x65\x05\x13\x96\x10\x89\xe6\x8d\x70\x5b\x73\xf0\xd3\xf1\x7e\xed\xe7\x1b\xaa\xdc\xa0\xf9\x57\x9f\x8c\xa2\x96\x38\xe7\x96\xc4\x06\x8f\x3c\xc7\x39\x40\xa9\xc0\x11\xda\xa7\x09\x79\x1f\xc4\xa6\xe7\x52\xe6\xf7\xf1\x53\x9c\x1c\xd7\xf0\x17\x58\x87\xc8\x94\x46\x0a\x11\xee\x40\x66\x44\xc8\x95\xd9\x36\xeb\xa5\xac\xa4\x12\xe1\xec\x5d\xed\x74\xa3\xce\x54\x22\xa4\xfc\xb3\x96\x00\xb0\xb2\x03\x43\x19\xbe\x21\xaf\xe8\x17\x90\x61\x31\xcb\x64\x72\x17\x59\x8d\xf7\xb5\x7b\x66\xc5\x9e\xe6\x3f\x8c\x2d\xc7\xbb\xc3\x7e\xe6\xa9\x48\x43\x54\xb0\xe8\xeb\x5f\xd4\x09\xd8\x5e\x78\x25\xed\xad\x86\x36\x3d\x64\xca\x2c\xc1\x53\xa5\x10\xdd\xc7\x17\x25\xdd\x52\x43\x75\xcf\xe6\x32\xab\x3d\xf1\x34\x33\x2e\x4b\x09\x5a\x3a\xe4\xcc\x50\xfb\x29\xce\x13\xf2\x17\x2f\x22\x8b\x16\x34\x2b\xf8\x86\xa3\xa9\xfb\xe8\x27\x52\x68\x9e\x32\xac\x6f\x83\xc2\x21\x28\x96\x20\x93\x47\xe2\x5a\xef\xb9\x99\x7e\xfc\xd8\xa3\x06\xa5\x7d\x68\x65\xff\x3c\xd2\x80\x51\x9e\xff\x84\x4d\xb3\xae\x74\x6a\xf0\x2f\x54\x3b\xe6\x61\x1e\x76\xc9\xe8\xbb\x39\x4f\xd8\x4d\xd5\xbe\x34\xba\x99\xe6\xf1\xb7\xaf\xae\xda\x8f\xb7\x2f\xec\xb7\xaf\xae\x70\xdd\x34\xe3\x9a\xb1\x87\xcb\xdb\x09\xf6\x31\x9b\xb1\xcf\x13\x56\x37\x7e\x4d\xb9\xbe\x7b\x8e\x25\xb9\x87\x4e\xec\xcf\xb2\x13\xfb\x1a\xee\xa0\x66\x34\xb4\x61\x1f\xda\xb0\xff\xf2\xda\xb0\x3f\x39\x9d\x25\x8f\xee\xe4\x29\xd2\x5e\x92\x58\xe8\x1c\xde\x34\x33\xde\x5c\xd6\xbc\x0f\x19\x64\xf0\xed\xab\xab\xb1\x6b\xc9\xb5\x5a\x0e\x3b\x34\x06\x86\x5f\xf4\xec\x41\xf0\xe8\x47\xf3\x44\x7d\x09\x50\x96\x1c\x9c\x91\x72\x4b\x07\xc9\x75\x83\x93\xdb\x74\xac\x27\xf8\x29\xef\xc3\x63\xb5\x5c\x75\x37\xe1\x60\xc2\x28\x37\x1f\x58\xd1\x89\xb0\x2b\x22\xa8\x7b\x68\xc5\xd3\xc1\x8d\xfd\x40\x6a\x6e\xa4\x5a\x12\x84\xd8\x43\x1d\x41\x4c\xca\x8c\x5a\xad\xd3\xf9\x39\xc6\xe4\xf2\xea\xe6\xc3\xd5\xab\x8b\xdb\xab\xcb\x97\x24\xbc\x89\x37\x55\x95\x6e\x29\xe5\x56\xd6\x8e\x13\x57\x29\xdd\x07\xe9\x43\x8e\x48\x3d\xd7\x33\xdf\xc5\x11\x41\x71\xab\x70\x2a\x28\x3d\x4c\x05\xb9\x16\xdc\xd4\xd1\xff\xae\x79\x68\x26\x85\x8f\x25\xb7\xd0\x3b\x61\x3a\x3f\xce\x9c\x9b\x33\x27\x32\xfb\xbe\xb1\x0b\xb6\xf2\x36\xa0\x5a\x10\x24\x8a\x52\x8f\x61\x46\x9d\x96\xaa\x28\x65\x38\x04\x5d\xc6\x77\x52\x77\x7e\xe1\x0a\x80\xe7\xd4\x6f\x43\xea\x8e\x9f\x2f\xce\xe4\xa7\x5a\x99\x3e\xe3\xf1\xf1\x18\x0a\x30\x1f\x8f\x8f\x83\x88\x9b\xad\xa4\xe3\xa1\xe0\xd6\x93\x0b\x69\x78\x16\x40\x1b\x9f\xc7\x84\xbc\x37\x0b\xa6\x1e\xb8\x46\x36\x8d\xe2\xb3\x2d\x19\x82\xf0\x92\x66\x8e\xd5\x9c\x63\x43\xb2\xab\xdb\x15\x32\x4f\xcb\x69\x73\xf2\xbe\xfc\xe6\x9c\xdf\x23\x82\x93\x88\xb7\x3c\x1e\xdc\x2d\x53\x4f\x33\x1a\x5b\x3e\xd4\x2b\xfc\xf8\xe1\xcd\xe1\x27\xe6\x28\x42\xf4\xb4\x5e\xc9\x3c\xe7\x86\x2c\xa8\x5e\x54\x85\x7a\xea\x00\xc8\x8a\x72\x1d\x76\xbe\x31\x3c\xa1\xde\xf2\x83\xf1\x05\x57\xce\x2f\xb2\x5f\xee\xf1\xb7\xe1\xb1\x15\xa3\x44\xf5\x31\x4e\xb8\x6f\x76\xd5\x46\xc7\xca\x77\x42\xed\x6a\xd5\x56\xad\xf9\x71\x7a\x7e\x31\x91\xba\xae\x41\xf1\x92\xd5\x55\x78\xf4\x5d\xa3\xea\x7e\x80\x87\xaf\x88\x00\x5b\x99\x32\x43\x79\xa6\x1b\x67\x62\x64\x21\x33\x39\x8f\xeb\xf0\xb8\x61\xb3\xbe\x74\x39\xa8\x23\x3a\xb2\xa7\x74\x78\x3d\xb6\x4f\x47\xdd\x90\x72\x6b\x37\xac\x5e\x71\xa5\x8f\x61\xb3\x5a\x9f\xf3\xc6\x3c\x92\xc0\x5e\xed\x56\x8c\x86\xeb\x2c\x38\xa1\x0f\x1f\x70\xa4\xba\x2a\x5a\xc1\x54\xce\xb5\x25\x95\xc8\x42\x86\x5b\xa4\xfd\x27\x3f\x88\xc3\x0b\xf1\x15\x2d\xc0\x08\xfc\xdd\x79\x55\x68\xb2\x6e\x29\x2a\xa6\x7c\x46\x1b\x4f\xfe\xea\x9f\x6a\x13\xf5\x42\xb1\x11\xfb\xc4\x35\x68\x6a\xa8\x5a\x02\x52\x35\x64\xad\x26\x8d\x0f\x91\xd5\xc1\x14\xed\x7e\x85\x50\x16\x57\x8c\xcc\xb5\xf4\x5b\xb9\x0b\x20\x00\x8b\x66\xd9\xd2\xb5\xb3\x9a\xc9\x6e\x5b\x83\xb7\xe6\xd2\x39\x2c\x54\x2a\xef\x9c\x2c\x14\xbf\xe7\x19\x9b\xdb\xd7\x2d\xb8\x98\xd7\x35\xe3\xba\x35\x9d\x2c\x93\x0f\xcc\x17\x1a\x61\x6b\xeb\xb6\x68\xad\x4d\x33\xf9\x01\x55\xf3\xf5\xdd\xfb\x5b\x22\x98\x9b\xce\xe6\x2e\x7b\x31\x4a\xae\x9d\x10\x2a\x85\x6f\x34\x1a\x81\x5d\xf4\xe4\x9f\x56\xdb\x49\xb3\x53\xf2\x03\xf3\xf3\xb0\x2a\xa1\xa5\x5a\x89\x21\x0f\x0b\x09\xe5\x20\x4a\x44\x3f\x19\xd8\x8b\x1a\x33\x80\x7c\xb8\xd4\x75\x0f\xe5\xdc\x42\xb2\xea\x82\xd3\x90\xec\xef\xd1\x3a\x0b\xd4\x66\xd4\xa4\x8e\x4b\x39\xa8\x66\xb4\x07\x57\x0a\x0e\xae\x4d\x37\x02\xdd\x7c\xa6\x2a\xe1\x0f\xf5\xb5\xf4\x32\xcf\xb8\xb8\x3b\x23\xdc\x04\x72\x6e\x31\xcf\x37\xd4\x11\x38\x9b\xb7\xbf\x4b\x8a\xd1\x2c\xf4\x0d\x38\x0c\x66\x1d\x9c\xf3\x99\x5e\x0e\x9b\xdb\x65\xe1\x22\x07\x2b\xb2\xe6\xc3\xd4\x2e\x63\xea\x1f\x19\x49\x8e\x8e\x9e\xe9\xce\x44\x99\x93\x0e\xc8\x5d\xb8\x8e\xad\x17\x7f\x7c\x3d\x79\x35\x69\x15\x73\xa4\x82\xb8\xcf\xbc\x6b\xf3\x20\x09\x3c\x1b\x32\x78\x10\x10\xf1\x4e\xcc\x6d\x22\x08\x6c\xc8\xe3\x68\x13\xc9\x82\x16\x17\xa5\x59\x5c\x72\x9d\xc8\x7b\xd6\x43\xd9\x0e\x8d\x0a\xbc\x6b\x90\xf0\xb0\xed\x0e\x1e\x79\xf5\xd7\x8b\x1b\x5c\x1c\x41\xab\xdb\xfc\x81\xa5\xaa\x7a\xa5\x13\xa6\x7b\x69\xef\x9b\xd7\xe9\xa1\x3d\x9b\x55\x0e\xee\xe7\x5f\xb5\xfb\x19\x28\xc1\x6f\xc5\xe5\xcc\x05\x37\x9c\x1a\x89\xae\xae\xd3\xb6\xb6\x95\xda\xc8\xdc\x5f\xd3\xeb\x00\x0a\x82\xaa\xc0\x75\xdc\x82\x8e\x33\xc5\xb6\x7a\xf3\x71\x90\x96\x12\xcd\xab\xae\x24\x2b\x49\x2d\x38\xfb\xae\x60\x0f\x7e\x8e\xbc\x82\xf3\xdf\x3e\x0d\xca\xe2\x3a\xcd\xfe\xf2\xf2\xbf\x1b\xee\xe9\xbf\xe0\x3c\xe5\xde\x02\xed\xcb\x79\x35\x1a\x70\x40\x4f\xe8\xc3\x9b\x19\x09\xe1\x3f\xc5\xd3\x54\x6f\xd6\x77\xeb\xff\x5f\x25\xcd\xdc\xde\xbe\x7b\x0c\x7b\x72\xfb\xac\xa2\xa7\x1a\xf0\x28\x9c\x11\x98\xd0\x80\x9e\x95\x9a\x81\xbc\x01\xbf\xc0\x51\x08\x45\x85\xb6\x87\xdb\xf6\x44\x1e\xfb\x00\x86\x63\x72\x62\x92\x22\xa2\x3a\xfb\x23\xa6\x6d\xba\x65\xfb\x73\x7a\x53\xa5\x6b\x62\xe6\xf6\xc8\x9e\x7c\xb8\x1b\xf1\x46\xd0\xd6\x82\x6e\x00\x08\x79\xc3\xb5\x71\xf9\x2a\x0e\x2a\x96\x1a\x30\x97\x34\x67\x65\xcd\x1b\x68\xcd\x54\xfc\x83\xa6\xa9\x7a\xe9\xe4\x03\xaf\x55\xc1\xff\x71\xca\x80\xf4\x1d\x9f\xa8\xa8\x62\x59\x4e\xcc\xb2\xe0\x09\x18\x1e\x6e\x5f\xdd\x00\x34\x4d\xfe\xf4\x47\x7c\xc5\xc9\xff\xf8\xf7\x3f\x7e\x85\x42\xa5\xe7\x90\x5e\xb7\x87\x15\xf2\xb3\x44\x0d\x1c\x5c\x7c\xeb\x9f\x11\x62\x05\x50\x9f\x0b\x02\xd4\xde\xe1\xb9\x67\x24\x54\xa4\x35\xbf\x7b\x1c\x31\x75\xc8\x56\xc0\xbe\xf6\x57\x9e\xad\x10\xb2\xc1\x1d\x6d\x3d\x04\x7d\x76\xa4\xd9\xd3\x6a\x24\x29\x8d\x20\xcd\xee\x18\xb0\x9e\x2f\x1c\x69\xc6\x93\xdd\x47\xb2\xc7\xf0\x9f\xba\x2f\xed\xc8\x4a\x04\x88\x5f\x35\xcf\xf3\x50\x36\x1e\xcc\xf5\x6f\x5f\x7b\x47\x8b\x8f\x8f\x75\x33\xf6\x03\x8a\x31\x5f\xbe\x9b\xfc\xe3\xcd\xc5\x37\x57\x6f\x50\x4c\xd1\x05\xfe\x37\x1a\x49\xf4\x8a\x29\xc7\xd3\x85\xae\x1b\x89\x46\x00\x11\xeb\x45\x7f\xf7\x7a\xb2\x62\x12\xb3\x9f\xf8\x72\x56\xb5\x91\x18\x67\x14\xdb\xe6\x3a\xdf\x57\xa3\x15\xb3\xce\x1d\x7a\x22\x43\x7a\xb0\xfd\xb1\x4f\x6d\x1b\x83\xdd\x34\xcd\x14\xba\x77\xcf\xe3\x6f\x08\x79\x26\xbe\x5d\xbb\x33\x6e\xb7\x22\x0c\x23\x08\xaf\x6e\xb4\x09\x7d\xbb\x57\xf7\xd1\x76\x1e\x2f\xd1\xa9\x3e\xe5\x0d\x8e\x27\xf0\x5c\x88\x39\xb0\xf7\xd4\x05\x1f\x2b\xcb\xd0\x2c\x2b\xc3\xb6\xec\xf7\x92\x52\x03\x8b\x9f\x1f\x86\x1e\xd8\xd3\x60\x7f\xe6\xd6\x7a\x28\x76\x55\xc7\xed\x3a\x36\xf4\x2a\xa3\xbc\xb3\x8c\xe5\x0a\x8d\xd9\x04\xc2\xfd\x77\xe2\xa2\xc8\x23\x3a\xc4\xd0\x56\xa6\x37\xa1\x64\x23\xf4\x2a\xbe\x0f\x63\xe1\xaa\xba\x9b\xf4\xc6\x8f\x7a\x93\x46\x01\x55\xea\x8f\xdc\x27\x89\x9d\xd6\x41\x09\x3e\x40\xec\x17\xd4\xff\x2a\x3c\xba\x5a\x45\x64\xcb\x76\xe2\xcc\x02\xf5\x96\x37\x1b\xc6\xe8\xaa\x57\x58\xe9\xed\xa6\x51\xe5\x46\x7a\x73\x95\x83\x9d\x09\x79\x32\x9e\xf3\xc3\xee\xda\x0d\xd8\x64\x8b\x8d\xba\xfb\xe3\xe8\xed\x31\xe4\xab\xc2\xd7\x83\x91\xa6\x85\x34\x52\xec\x91\x0a\x7a\xb3\x01\x40\x3b\x44\xc7\xfd\xe2\x95\x4b\x99\xce\x30\xc6\xb9\x0a\x98\xcb\x6c\xa9\xdc\xa9\x56\x35\x0a\x02\x80\x14\xc1\xb1\xaa\x71\x72\x28\x2e\x77\xf4\x09\x92\x35\x7f\xe1\x85\x79\x1e\xa3\xf4\xe1\xa1\xa9\x47\x91\xf6\x48\xf8\xbe\xbe\xf4\x42\x7c\x48\xd8\xd6\x1e\x75\x49\x04\xee\x92\x75\xfc\xfd\x8c\xc2\x4e\x7a\x7d\x79\x30\x42\x21\x95\x79\x90\xaa\x4f\x59\xb2\x9b\xd6\xa3\x2b\xf1\x7b\xfe\x3b\x6c\xaa\x04\x8a\x16\x3c\xa3\xbb\x3e\x81\x40\x9f\xc6\x92\x57\xca\x73\xda\xfd\xc7\xea\x29\x4e\xf7\xae\x2d\x17\xbb\x08\x00\xd2\x20\xb5\xcd\x07\xbe\x4e\x00\x90\xc1\x60\xeb\x5e\xef\x27\xbd\xf8\xbd\xc5\x86\x8e\x22\x52\x71\x3e\x00\x4c\x11\xa9\x35\x07\xc1\xc1\x75\x47\x77\xa3\x7a\x10\xc2\xef\xfd\x83\xde\xd8\x65\xcf\xb2\x26\x89\xb4\xba\xce\xa8\xad\x40\x67\x40\x3d\x12\x09\x0c\x9b\x70\x30\x32\xa8\xe4\x3f\xa1\x0d\x6c\x14\x05\x74\xdd\x47\x67\x52\x41\x2d\x28\xee\xca\x43\xd5\x6d\x92\x7c\x71\xa8\x6e\x8b\xbd\x6b\xe9\x9d\xd3\x42\xbb\xae\x67\xcd\x16\xa6\x07\x24\x76\xfb\x74\x09\x7e\xbb\xbd\x35\x70\xd5\xf6\x17\x85\x39\xb8\xd6\xc0\xa1\xed\x6f\x1d\x67\x8b\x02\xde\xb4\xdf\xa2\x9a\xfc\xa2\xa0\x6e\x6d\x99\xb7\xad\x11\x1e\xce\x99\xd6\x6e\x96\x87\x68\x81\x87\x02\xeb\xda\xe4\x6d\x6c\x7e\xf7\xd9\xdd\xf3\xfe\x62\x44\x23\x5f\x68\xed\x1b\x52\x6b\xdc\x65\xb5\xdb\x7d\x58\x17\x76\x5b\xbc\xa9\xde\xe2\xe4\x47\x5f\x99\xae\xa2\x14\x48\x9f\x1a\xcd\xa4\x98\x37\x11\xa5\x66\xe7\x21\x8e\x6e\x59\x20\xd1\x3b\xbe\x1f\x99\xa3\x2c\x6f\x69\xd1\xb3\x91\x5c\xb3\xdd\x30\x74\x3f\x0f\x01\x43\x0e\x2a\x1a\xa8\xab\xd9\x04\x15\x00\xdd\xfe\xa1\x9f\xec\xd7\x83\x2d\xe2\xd0\xab\x09\xb6\x28\x7b\x4b\x9e\x39\x23\x8c\x26\x0b\x72\xc7\x96\x23\xa0\x55\x51\x70\x09\x29\x28\x24\x5b\xc3\xd6\x5d\xda\x6d\x08\xcd\x8b\x9b\xde\xea\x24\xba\xaf\xdb\xab\x70\x0a\x55\x60\x57\x85\x9b\x55\x6a\x77\x24\xc8\x50\x89\x4d\x7b\x31\x94\x3c\x2c\xa4\xf6\x26\x30\x6f\x0e\xbb\x63\x38\x3a\x5f\x0f\x4b\xc4\x12\x29\x40\x71\xf2\x40\x60\x17\x21\xe0\xae\xde\xe3\x48\xa8\x2e\xed\x40\xdb\xc5\xde\xb1\xa5\xde\xb2\x07\x3d\x80\xd6\xd1\x7c\x96\x85\x78\x8e\x5c\x8a\xc6\xbb\x22\x81\xc2\xcc\x7c\x2b\x4c\xaf\x2f\xc0\xd2\xa9\x05\xd6\x8a\x1f\x8c\x05\x0c\x4d\x48\xb8\x06\xe0\x1e\x72\xc0\xb3\x0a\x39\xfa\xec\xab\x47\x03\xcd\x4c\xe9\x91\x0b\x02\x44\xed\x2e\x30\xad\x09\x32\xa5\xbc\x1e\x50\xd7\x53\xdd\xb1\xb4\x6a\x3f\x38\x86\x7e\xa5\xba\xaa\x05\xde\xa3\x61\x29\xf1\xa8\x65\xe9\x72\x23\xdb\xdf\x75\x16\x1d\x8f\x5d\x5f\x51\x82\x8c\x6c\xa9\xc7\x6a\x15\x80\xa8\xc7\x7b\x90\x9d\x55\x39\x8b\x16\x90\x6c\xe3\x9a\x3c\x59\x04\x31\x12\x69\x38\x6a\x0e\x58\xbb\x97\x86\x68\x94\x49\xb9\x01\xa3\x17\xe5\x75\xe3\x8e\xa1\x74\xb5\xd5\xd1\x8e\x03\x75\xd4\xa6\xc1\x34\x62\x57\x40\xfa\x77\xab\x74\x23\xae\xd5\x74\x3d\x3a\x9b\x4e\xf7\x80\x49\x1a\x92\xf7\xa6\xf6\xd3\xbd\x40\x6e\x17\xbd\x5d\x23\xea\x5e\x40\x0f\xd9\xbc\xba\x1e\xbb\xdb\x58\xf7\x02\x79\x10\x39\x7e\x75\x20\xe4\xfa\x7e\x70\x37\xb5\xc3\x6e\xc8\xf9\xbd\x80\xf6\x68\x8c\x5d\x8f\x7e\x2d\xb2\xeb\xb1\x4f\x97\xd6\xd8\xb6\xd9\xf5\x58\xa3\x31\xed\xc6\xcf\x51\x7d\xa3\xeb\xd1\xec\x98\x9d\xd3\x22\x88\x4a\xc4\xc8\x31\x79\x8b\x08\x71\xdd\x34\x42\xfb\x6c\x51\x77\xd0\xf6\x39\x93\x8e\xe5\xf5\xc5\xf9\x8a\x4d\xc2\x82\x59\xc6\x72\x2b\x38\xc4\x73\xbb\x30\xfc\x74\x9a\xac\x13\x04\xa9\x7e\x34\xd7\x8e\xbe\x73\xd9\x83\xd8\xf7\xe9\x95\xea\xc6\xa8\x87\x4c\x1c\xd5\xd3\xb4\x1e\xd1\x9d\x56\xdb\x0f\xe2\xa2\xad\xeb\x11\x13\x8e\xeb\x06\x36\x28\x37\x56\x80\x7c\x84\x10\xbc\xc8\x29\x3c\x42\x80\xae\x1b\xf1\x61\xba\x6e\xf4\xc4\xf5\x20\x81\xef\x71\xae\x13\x5f\xc2\x32\x24\x3f\xb6\xf4\x8d\xc8\xd5\x4b\x45\x2c\x0b\x02\x15\x2e\x88\x32\xbe\x31\x62\x8f\xdd\xc0\xda\xd0\xdb\x4f\x45\xdd\xa7\x60\x9b\xbd\xb8\xb9\x3e\xa8\x4d\xa5\x01\xf7\xd7\x6b\x55\x01\x7b\x39\x54\x13\x08\x26\xbd\xcb\x7a\xd9\xdf\xe3\xab\xaa\xd5\x03\x55\x1b\x64\xcf\x35\xac\xfa\x94\x56\xa7\xfc\xda\xf2\xfd\x88\x38\xad\xb5\x29\x35\x10\xc2\x48\x6f\x56\xaf\x25\x8a\xba\xf7\x46\x34\xe4\x10\x5e\x04\x54\xea\x09\xd5\x3f\x78\x1f\x32\x61\x65\x75\x6c\xeb\xa0\x3e\x81\xae\xe9\xfd\x34\xa7\x15\x93\x5b\x21\xd3\x97\x04\x02\x4c\xa9\x10\xd2\xc0\xce\x23\xdc\x34\x9b\x46\x46\xa7\x2c\xd3\x67\xce\x44\x66\x45\xf4\x46\x78\x57\x34\x9b\x73\xa3\x32\x10\xf7\x12\xca\xf7\x39\x36\x02\xfd\x83\x03\x23\xeb\xf7\xfc\xaa\xd3\xd1\xc1\xf2\x3b\xdf\x13\x22\x21\x3a\x59\xb0\x9c\xba\x66\x0e\xf6\x24\x43\xe9\xad\x07\xc5\x8d\x41\x16\x24\xdc\x34\xac\x20\xcc\x54\xae\x89\x9c\x9d\x05\x0d\x15\x5c\x5c\x47\xf7\x2f\x90\xbe\xf1\xf5\xb1\x97\xc9\x81\x84\xeb\x83\x29\xa5\xb4\x6d\xb4\x1d\x19\x8d\x82\x30\x7d\x08\x41\x3d\xa0\xc4\x50\x06\x1d\x76\x44\xdb\x56\xda\x1b\xe4\xc5\xcd\x35\xb9\x77\x38\xf2\x99\xb6\xbb\xbf\xe0\x4f\x40\x8c\xaf\x0e\xab\xb7\xbe\xd2\x43\x9e\x27\x83\x71\x6a\x30\x4e\xf5\x18\x83\x71\x0a\x3b\x9e\x81\x71\xaa\x21\xfc\x04\x7e\xd7\x9b\x89\xb6\x8d\x5c\xcd\x92\xf5\xd1\x12\x74\x18\x2e\x00\xcc\x07\x81\x34\xca\x2e\xdb\xfb\xee\xed\x55\xbd\xe0\x42\xa3\xd9\x86\x2f\xe7\x78\x3c\x3e\x3e\x0e\x96\xaf\x12\x11\x12\xbc\x69\xd8\xbb\x69\x66\xa3\x3f\x11\x26\x12\x99\x32\xdf\xd5\x7f\xc6\x95\x36\xa0\x12\xf4\x9b\x69\x30\x6d\x34\xf7\x36\xf7\xfb\xd0\x8f\x3a\x35\xbc\x4f\xb0\xee\x3e\xa8\xbf\x17\x43\x0c\xe1\x4b\xaf\x0f\x26\xc2\x7b\xc1\x1d\xd2\x53\x90\x15\xb7\x36\x0d\xbf\xd9\x55\x21\x43\x2f\xc1\x57\xe1\x56\xfd\xc4\x77\x9e\x73\x5f\x0e\xd0\xca\x01\x4c\x1b\x4d\x4e\xdc\x87\xe3\xa4\x28\x7b\xea\x04\xee\xf9\x9c\xe5\x52\x2d\xcf\x2a\xc0\x16\x20\xba\x42\xc3\xea\xa8\x80\x38\xa8\xa7\x10\xda\x94\x94\x4a\x31\x81\x0d\xd9\x5e\x1d\x9f\x57\xcf\xa8\xce\x11\x9b\xa6\xb3\x69\xac\xa4\xee\x54\x15\xf3\xe3\x0d\x97\xcd\x11\x24\x42\x88\xec\xf3\x09\x32\x67\x95\xe1\xac\x37\x58\x0b\x8d\x89\x7b\x72\x4f\x15\x2a\xb1\x66\xd3\xd8\x5b\xb5\x48\xf9\x3d\xd7\x12\x95\x7a\xb8\x69\x50\xb1\x7c\xdf\x8b\x24\xb8\x31\xda\x93\xad\xae\xc2\xd9\x63\x23\x36\xda\x34\xb9\xef\xb0\x20\x4b\x53\x94\xfd\x28\x38\xa9\x64\x8f\x40\xb1\x42\x9d\xbf\x8a\x52\xf5\xa3\x2a\xa4\x0e\x9e\x74\x1a\xea\x8b\xa3\x9e\x70\x0a\x6a\x0c\x53\xe2\x25\xf9\x9f\x93\xbf\xfd\xfe\xe7\xd1\xe9\xd7\x27\x27\x3f\x7e\x35\xfa\xaf\xbf\xff\xfe\xe4\x6f\x63\xf8\xcf\xbf\x9d\x7e\x7d\xfa\x73\xf8\xe3\xf7\xa7\xa7\x27\x27\x3f\x7e\xf7\xf6\xdb\xdb\x9b\xab\xbf\xf3\xd3\x9f\x7f\x14\x65\x7e\xe7\xfe\xfa\xf9\xe4\x47\x76\xf5\x77\x24\x90\xd3\xd3\xaf\x7f\xd7\x73\xc2\x9f\x46\xb5\xc9\x7f\xc4\x85\x19\x49\x35\x72\x47\xff\x92\x18\x15\x1d\x8f\xe5\x46\x38\x8e\xc3\xd0\x9e\x5a\x54\xdb\x8b\xc9\x91\xa6\x96\xfd\x99\x88\xc4\xbe\x0a\xf1\x1e\x1b\xb0\x87\x3e\xbc\x8f\xff\xee\xb9\x7b\xe2\x7a\xbc\xcc\xc5\x9e\x1f\xd4\x43\x11\xd1\x40\xd9\xc3\xfc\x65\x39\x27\x7e\x01\x21\x9f\xbe\x94\xd3\xf6\x78\xcf\x98\xd6\x34\xf5\xe8\x88\xf7\xec\x21\xb4\x0e\xf1\x9e\x4f\x1c\xef\xe9\x30\x63\x08\xf6\x8c\x03\x39\x04\x7b\x56\x30\x86\x60\xcf\xc1\x9e\xde\x07\xe8\x60\x4f\x1f\xec\xe9\x98\xf1\x0c\xec\xe9\x43\xb0\xe7\x10\xec\xe9\xc6\x10\xec\xb9\x3e\x86\x60\xcf\x7a\x0c\xc1\x9e\x3b\x83\x3d\x27\xb1\x96\x00\xd2\x8a\xf4\xfc\x65\x04\x7a\x6a\x8b\x5b\x09\xbb\x48\x12\x59\x0a\x73\x2b\xef\x58\x44\x44\x14\xca\x9c\xb2\x06\xff\xd9\xda\x56\x68\x99\x72\x26\x62\x6d\xa5\xad\x4d\xb8\xf0\x20\x82\x49\xc0\x4a\x02\x22\x8d\xd6\x81\xc3\x4c\x02\xa5\x31\x76\xdf\xc6\xe4\x82\x28\x96\xf0\x82\x5b\xde\x16\xcd\xd4\xa9\x83\xe2\xd0\xb2\xea\xc0\xce\x8d\x66\xd9\xcc\x77\x75\x8e\x15\x39\xab\xca\x1a\xaa\x61\x5f\xf0\x8c\x38\x2c\x21\xf6\xfe\x34\x16\xec\x8c\x14\x32\x34\x0d\x26\x7a\x21\xcb\x2c\x25\x8a\xf5\x30\x9c\x36\x76\xf1\xb6\x31\xbb\xa6\xaf\xa1\x07\xc4\xc6\x06\xf8\x89\xd3\x82\xc7\x14\x4c\xad\x80\xf5\xa3\x7c\xec\x53\xc1\x15\x5c\xb9\x09\x4b\xa4\x48\xf7\xb1\x0c\x5e\xad\xc2\xf2\x38\xdc\x43\xe8\x60\x60\x54\x4a\x4b\x07\x0e\x6a\x1a\xd0\x8c\xa7\xdc\x2c\xfb\x45\x48\x7a\x22\x42\xa8\xa3\x22\xd5\x75\xd0\xf5\xb1\xc6\x5e\x86\xa2\x50\x92\x26\x0b\xa6\x1b\x7b\xe8\x74\x3b\x5f\x8c\xa9\x9f\x8d\xb1\xc8\xca\x39\x17\x4e\xb1\x83\x37\x58\x29\x3c\x5b\x12\x25\x4d\x88\x76\xf6\x8b\x89\x9d\x70\x7b\xe9\xb7\x8d\x89\xc2\xcb\x40\x50\x8d\xc5\x60\xb5\x84\x7a\x8c\xb2\x39\x3d\x47\x24\xf8\xac\xf7\xd6\x72\x4d\x64\x96\x86\x66\x0e\x7f\xfa\x8a\x14\x4c\x25\x8e\x62\x01\x6b\x34\x3c\x7a\x57\x8d\x24\x99\xd5\x66\x2c\x73\x9d\x35\x67\xd9\x7c\x55\x24\xcc\x7f\xff\x03\x59\xc8\x52\xe9\x71\xb3\x78\xd2\x0b\xf8\xcc\xd9\xe0\xe2\x63\x70\xac\x62\x63\x48\xc6\xa8\x36\xe4\xc5\x57\x24\xe7\xa2\xb4\x02\x5d\x14\x94\x86\x02\xfa\xc7\x3f\xf4\x20\x21\xf1\xaa\x67\xbc\xd2\xb9\x1e\x77\xeb\xb9\x5d\xe1\x3a\x9c\xf6\xb2\x7b\x7a\xcb\xbe\x2b\x13\xe6\xba\x32\x37\x42\xb7\xfa\x08\x01\x1e\x6e\x8d\x2d\xc2\xc8\x27\xa0\xca\x7d\xb4\xae\x48\xcd\x29\x5a\xce\x8b\x7c\x00\xaf\x5a\xc5\x54\xb3\xc2\x45\x30\xa1\xa7\xfa\x53\x29\xa7\x4b\x13\x57\xcc\xef\x7f\xb9\x67\xda\x55\xfc\xc2\x87\xcd\xea\xf0\x9d\xcb\xd9\xd2\x58\xbd\x2e\x0e\xdf\x01\x21\x46\x42\x9d\x2b\x59\xa2\x4a\xdb\xb4\xd6\x0a\x76\xb9\x60\xbb\x09\x25\x4f\x92\x84\x69\xa0\x74\x9e\xea\xa1\xb0\x07\x7c\x31\x6e\x16\x68\xe4\x79\xec\x92\x77\x3b\x8b\xb3\xfb\x13\x45\x2d\xee\x89\xda\x6e\xa3\x37\x0e\xab\xf3\x29\x36\xe7\xda\xf4\x68\x5a\xf9\xc1\x3f\xd8\xbe\x04\x9a\x8b\x79\x06\x1c\x36\x2f\x33\xc3\x0b\x64\xb4\x6c\xb8\x3b\x15\x50\x2f\xdd\x34\xdb\xc5\xd1\xda\xfb\x83\x82\x49\x5d\x1d\x4c\xd7\xaf\x04\x5c\xcc\x27\x61\x4e\x84\x09\xe3\xda\x11\x2b\x2b\x48\x15\x54\x51\x6c\x8d\x2a\x38\xd2\x44\xe6\x39\xd5\xa7\xde\xc7\x48\x21\x5e\xd3\xb1\x2e\x2b\xa1\x28\x9a\x55\xdb\x8a\x82\xd9\x88\x9c\x3b\xf4\xc5\x30\x4c\x50\x81\x8a\x6c\x68\x1b\x7f\xe1\x31\x22\x1f\x44\xe8\x50\x38\xe7\xf7\x4c\x54\xe7\x14\x21\xd4\x7a\x3d\xee\x1b\x9a\xdc\x31\x91\x92\x8f\x3a\xdc\x8b\x74\x29\x68\xee\xbb\xbf\x14\x4a\xde\x73\x7b\x31\x90\xa7\xd0\x9e\x87\x3e\xf3\xde\x1a\x57\xab\x2e\x94\xfe\x75\x42\xf4\xa1\x77\xb4\xd4\x3d\x7a\x11\x7c\xd4\x56\xba\xdc\x4d\x43\xd1\xad\x1a\x34\x53\xfc\x3e\x61\x41\x94\xb7\x13\x3a\xf4\x22\xef\x51\x05\x6e\xd7\x96\xe9\x8b\xdb\xf2\x86\x9f\x16\x78\x5b\x15\xc2\x81\x5b\x23\x15\x84\x66\x96\x6c\x2e\xab\xaa\x89\xed\x03\xb7\x27\x2c\x0e\xdf\xe1\x30\x46\x0a\x41\x5f\xf0\x11\xee\xb2\xa0\xe5\x15\x35\x8d\x2b\xbb\x79\xfc\xe1\x9b\xcb\x36\x8d\xfe\x40\x53\xa9\xc9\x37\x99\x4c\xee\xc8\x25\x03\x8d\x18\x18\x56\xe7\x52\x1a\x1d\x6f\x76\x77\xb4\x89\xea\x25\xd6\xd5\xba\x59\x4d\xd3\xc7\x69\xdc\x3c\x34\xfa\xfd\x55\x37\xfa\x55\x53\xdc\x1c\x7e\x0d\x6d\x7e\x73\x3a\xef\xd3\x7f\x7f\xc1\x88\x02\x62\x00\x00\x42\x57\x30\x7c\x57\xf5\xd6\xd5\xfc\x72\x21\x1f\x46\x46\x8e\x4a\xcd\x46\x1c\x15\x8e\x1b\xb5\xc6\x3b\xb6\x84\x18\xe6\xe8\x55\x7e\xe7\x1e\x6c\x19\x17\x8c\x04\xd7\x0a\x7c\x3e\x43\x06\x42\x7d\xf8\xe6\xd2\xb2\xf1\xba\x11\x06\xd7\xe4\x9c\x99\xe4\x3c\x61\xc5\xe2\xdc\x4f\x2f\xba\x8b\xe2\x73\xda\xe2\x5c\x0a\x6e\xa4\x8a\x2f\x39\x7b\x7c\x41\x12\x99\x65\xbe\x00\xac\x9c\x91\x57\xac\x58\x54\xe0\x22\xfc\x9a\x4f\x82\x76\xcf\xa3\x4d\x6b\x21\x65\x7c\x6b\xc7\xc6\x95\xb5\xcf\xfb\x1b\xdb\x40\x48\x35\x45\xd5\x93\xdf\xd8\x0c\xe7\x39\xa0\xe0\x23\xb5\x59\x7b\xac\xee\xb5\xcf\x0c\xb3\x9f\xaa\x53\xee\xf1\x24\x3c\x0a\x46\x9d\x46\x2c\x43\x74\xd3\xdb\x30\x0d\x50\x82\x2b\x12\x7b\x3d\x73\x2a\x61\xca\x52\x22\xef\x99\x52\x3c\x65\x3a\xb0\x80\x28\xdb\x09\x4c\x90\x67\x4f\xc9\xd6\x86\x3e\xbe\xd8\xd7\xfe\xea\xfb\xf8\xf6\x32\x16\x34\x88\xbc\x7d\x7e\x9d\xc8\xd3\x34\xe7\xc8\x02\x20\xcf\x92\xcc\x47\x75\xe1\xb5\x92\x29\xe2\x77\x41\xd8\x38\x94\x9a\xad\x13\x9a\xb1\xeb\xf7\x51\xaa\xf6\xc4\x3d\xd3\xd6\xb6\xc3\x87\x75\x43\x24\xa4\xf5\x65\x6b\x9b\x9f\xef\xaa\x4b\x4a\x84\x4c\xbb\x3d\x83\x43\x3b\xaf\xae\x76\x5e\xf5\xdd\x42\x41\x44\xf7\x02\x8a\x22\x2d\x73\x6a\xd8\x03\x8d\x97\x7c\x6e\xc3\xb6\xf8\x96\xa6\x81\x21\x04\xc4\xc3\xd6\xc8\xfb\xd6\xbd\xff\xf0\x7d\xc8\x94\x34\x4e\x33\xb8\x94\x39\xe5\xa8\xb8\xb0\xb5\x05\x36\x65\x8c\xb0\xb0\x9b\x0a\x30\x4e\x20\x80\xb7\x83\x9c\x51\xb7\x65\x28\x15\x4b\x89\xb7\x1c\x0c\x8d\x98\x1e\xb1\x11\x53\x7f\x61\xb3\x96\x35\x6b\x33\x72\x70\xf3\x47\x64\x59\xda\x73\x0f\x98\x03\x6c\xb5\x0a\x0a\x23\x9a\x09\xcd\x21\xce\xbd\x11\x7c\x88\xec\xf5\x35\x73\x19\x27\x55\x9e\x99\x13\x5a\xcf\xc8\x1b\x39\xe7\x22\x50\x2a\xe9\x42\x79\x70\xf3\xa4\x3c\xc3\xbc\x7b\x90\x32\xb1\xaf\xfd\xd5\x4b\x99\x5a\x67\x57\x82\x4e\x33\x5c\xb0\x48\x9b\x71\x67\x14\x22\xb7\x18\x3c\x7f\x9e\x72\x6d\xff\x25\x93\xc9\x1b\x70\x72\x96\x22\x46\x93\x03\xb7\x9e\x67\x23\x55\x81\x40\x47\xc0\x0e\x4f\x4f\x1c\xcd\xee\xd5\x9e\xeb\x5a\xa4\x76\x59\x4c\xb7\xe2\xb4\x3d\x44\xd7\xa1\x2c\xc6\xe7\xef\xe3\x49\xa7\x8c\xdc\x2e\x78\x72\x77\x53\xfb\x32\x89\x54\xf6\x33\xd1\xf8\x28\x5a\x79\xed\xf1\x7c\x14\xae\xf9\x65\xdf\xf4\x31\x47\xdd\x36\xb8\xf1\xc4\x6f\x9f\x05\x44\xa8\xd6\x32\xe1\x71\x4e\x75\x30\xce\x56\x2c\x9d\xa4\xc0\xae\x0f\xbf\x5c\x90\x06\xf7\x96\x41\x02\xb2\x78\x61\x95\xea\x86\x3c\x81\x75\x8b\xfb\x9d\x3b\xf8\x12\x1d\x5a\xf6\x6a\xe8\x7d\xdb\x6a\xe1\x1d\xf4\x90\xb6\x2f\x36\xc6\xeb\x1f\x90\x23\xc8\xf4\xd0\x5a\x56\x37\xd0\x03\x8e\x1e\x67\x8a\xad\xdb\x7d\xfb\x10\xb0\xcf\xe8\xfd\xf5\xb2\x3a\xe2\x97\x95\xdc\x83\xf9\x2d\xc6\xbf\x84\xd7\x60\x51\x45\x2d\x36\xd9\xf5\x56\x42\x7a\xdc\x67\xde\xe1\x6b\x49\x1d\xc2\xb6\x5d\x94\x99\x8b\x04\xae\x7b\xb4\xef\xeb\xcf\x73\xf3\x38\xa4\x03\x78\x9f\x16\x8f\x9b\x52\x97\xd7\xbb\x3d\xe2\x95\x5e\xab\xe0\xe2\xba\x3d\xa2\x60\xfa\x8e\x90\x0d\x3d\xe2\xab\x3f\xfe\xe1\x0f\xad\x1e\x91\x8d\xfe\x8f\x28\x90\xb8\x1e\x91\x8d\xf4\x63\x14\x54\x5c\x8f\x48\xff\x3e\x24\xa1\x40\xf7\x88\x24\x09\x32\xde\x7b\x4b\x8f\x48\x8c\xf0\xf7\xb8\x4d\x22\xd1\xbe\xac\x88\xea\x2d\x10\x2c\x17\x43\xe5\x77\xd5\x6c\x69\x56\x61\xc1\x6e\xf5\xd6\x4a\x2d\x1b\x6b\xaf\xe0\x66\xda\xaa\xcf\x72\xb0\x8a\x2b\xb8\x3a\x2b\x3d\xaa\x3f\x6f\xab\xae\xb2\xb1\x5e\x0a\x56\x53\x5d\xaf\xa9\xb2\xad\x4a\x0a\x7a\xf1\xbe\x92\x0a\xa2\x36\x0a\xee\x98\xcc\xa1\x2b\xa2\x60\xea\xa0\x34\xd2\xb3\x51\x30\xb1\x39\xd8\x7d\x9b\xa9\x6e\xae\x74\xd2\xac\x5c\x82\x54\x3a\xe3\xea\x9b\xc4\x67\x3b\x46\xd5\x32\x39\x58\x05\x93\x1e\xe9\x14\x71\xd5\x4a\x22\x18\x3d\x1a\x26\xd9\x58\x99\xa4\x37\x7f\x77\xa3\x59\x8f\xe4\xa0\xb5\x45\x76\x57\x14\xa9\xd8\x75\x14\xc4\x5d\x8c\xbd\x4f\x6d\x65\x1c\x7b\x27\xd3\x18\x98\x7d\x99\xbc\x1b\xfd\xaa\x7e\xf4\x49\xb8\x8a\x4b\xb6\xea\xac\xeb\xd1\x67\xfb\x37\x57\xf3\xd8\x50\x99\x23\x02\x66\xab\x86\xc7\xe1\xea\x71\xec\xac\xc2\x11\x09\x2d\x9a\xf8\xc4\xe6\x71\xc5\xd4\xcc\x88\xc8\xf7\x7a\xb4\xd4\xad\xb8\xba\x09\xc8\x6a\x09\xcd\x4e\x57\xa8\xe5\xc5\x17\x49\xe8\xe3\xbe\xe8\x65\x53\x69\xd9\xcf\xbd\x32\x1d\xb0\xda\x85\x4d\x57\x5d\x70\x70\x27\x09\xbc\xe7\x89\xd4\x69\x12\x87\xf2\x78\xe3\x84\x9b\x8d\xec\x14\x35\xda\x08\xe3\x9e\x7a\x3f\x59\x71\xb1\x57\x1f\x23\xf3\x52\x06\xcf\xfa\x53\x79\xd6\xeb\xc0\x70\xa4\x42\xe9\x82\xc7\x77\x06\x86\x0f\x6e\xda\xe7\xed\xa6\xd5\xad\xea\xe0\xc1\x7c\x08\x54\x0b\xed\xa9\x95\x53\xdf\xc5\xcd\x31\x85\xea\x82\x5f\xdc\x5c\x93\x44\x31\xa8\x97\x41\x33\x3d\xb6\x7a\x2d\xce\xfd\xd9\x96\x90\x83\x73\x0a\xe4\xef\x5a\x6f\xa7\xc6\xb0\xbc\x30\x38\x24\x1b\x3c\xb0\xd8\xd7\xfe\xea\x3d\xb0\x7b\xb8\x5c\xbe\xaf\x1e\x0d\xd6\xa8\x45\x99\x53\x31\xb2\x94\x04\x7c\xb1\x68\x1b\x57\x08\x8f\x59\xe1\x86\x63\x12\xf2\xf2\xe0\xd0\xc0\x84\x2b\xb1\x54\xa9\x14\xfc\xa7\x92\xd5\xf5\x52\x2b\x51\xe5\x11\xbd\x56\x00\x7f\x8f\x7d\x74\x0d\x05\x57\xa8\x50\x22\x8b\xa8\x5d\xf4\xea\xb3\x5f\x78\xb5\xa7\x40\x70\x88\x90\x91\x32\x5b\xcb\xe6\x66\x16\xcc\x89\x7f\x37\x90\xe9\x5f\xf7\x40\xf4\x54\x08\x77\x30\xa0\x99\x3b\xd5\x9c\x66\x99\x7c\x70\xeb\x6c\x4a\x30\x16\xa5\xec\xba\xb1\xec\xdc\xe7\xae\x73\xa5\xa4\xf2\x9e\xb9\xe6\xd2\x5d\xe4\x14\x9f\x2f\x0c\x53\x4e\x5d\x55\x11\x81\x32\x13\x66\x9a\xa8\x6e\x24\xa1\xc2\x25\x71\xda\xff\x87\x18\x6f\xd7\xdc\x35\xa2\xa6\xc0\x94\x2d\xe8\x3d\x97\xa5\x1a\xc3\x1b\x8c\x24\x47\xfe\x71\x10\x1b\x96\xb2\xac\x5c\x16\xa5\xc6\x6e\x44\x75\x22\x7a\xc3\xf9\xbf\xab\xbf\x04\x03\x46\x8a\x2b\xf8\xe4\xed\xab\x23\xf6\x89\x6b\xb3\xbe\xb7\x81\x01\x85\x06\x51\x9f\x49\x2c\xbf\xd7\x85\x95\x43\xbe\x47\xa5\xff\xb6\xaf\x5f\xf3\xc9\xb6\x78\x7e\x3f\x81\xaf\xf6\x15\xce\x7d\x9d\x22\x97\xd5\x1f\x52\xe9\x06\x01\x7d\xd7\xf8\x95\x08\xe8\x55\x88\x4a\xc6\x93\xe5\xf5\x65\xbc\x68\x5a\x85\xa6\x58\x00\xe4\x1b\xaa\x59\x4a\xde\x52\x41\xe7\xce\x9e\x74\x32\xb9\xf9\xe6\xed\x29\xd6\x46\x0e\xee\xa7\xeb\xcb\xd5\x20\x86\x26\xef\x75\x2f\x7a\x77\xf8\x0c\xf5\x95\xbd\xe8\x25\x70\x3c\xc2\x6e\x3c\x42\x2e\x7e\x10\x08\xb0\x8d\x5d\xd7\x8b\x49\xb9\x60\x93\x50\x59\x4f\xc7\x92\x21\x98\x42\x9e\xde\x1d\x76\x59\x31\x41\x26\xf5\x06\x1c\x86\xbc\xe3\xde\x3d\xea\x12\x38\x51\x6f\xeb\xb2\x1f\x76\x00\xd9\x91\xd0\xdc\x76\x64\xdb\xdf\xdd\x52\x7d\x47\x46\xa3\x8d\xbf\xee\x66\x00\x74\x36\xe3\x82\x9b\x1d\xda\x7f\xbb\x32\xa7\xff\xb9\x2b\x72\x01\xc5\x8c\x20\x58\x2a\x7c\xac\x2d\xeb\x2a\x33\xab\xb9\xaa\x32\xdb\x65\x42\xc2\xf1\x26\x21\x53\x76\xd1\x39\xc5\xb5\x69\x5e\xc2\x1f\x53\x6f\xc8\xda\x3e\xbd\x0e\xcc\x0a\xc1\xf2\x85\xec\xa0\xea\x78\x46\x5b\x80\x02\xab\x58\x7a\x59\xda\xfb\x32\xa9\x26\x74\x3d\x17\xb2\xfa\xf8\xea\x13\x4b\x4a\x83\xea\xab\xbd\xe6\xdd\xf0\x6b\x64\x2a\xd4\x4f\xb4\x2f\x84\xc2\x29\xfe\x0b\x8c\x6e\x2f\x53\x30\xeb\x80\x19\xd0\xc7\x3e\x51\xc3\xf5\xcc\xf1\xe2\x6a\x3f\xd9\x27\x2b\xe8\x40\x49\x27\x04\xd4\x5a\x07\x08\x61\x33\xa0\x02\x9f\x91\x69\x69\x08\x37\xe0\xa8\x4e\x16\x52\x6a\x66\x55\x2d\x9c\xcb\x0e\xe6\x76\xcf\x65\x06\x01\xad\x52\xb8\xe2\x4b\x52\xb1\x46\x47\xb5\x30\x45\x28\xf8\x88\x80\x09\x38\x13\x82\xf5\x72\x2b\x77\x54\xa7\x16\x94\x54\xfb\xa2\xc0\xf6\x10\x10\xe7\x20\xd7\x6a\x43\x74\x99\xdb\x89\x3d\x30\xab\x48\xe8\x33\xc2\xc7\x6c\xec\x9a\xfd\xd1\x64\x51\xbf\x18\x01\x32\x67\x56\x18\xa4\x59\x56\xd9\xd6\x1b\xb8\xed\x48\x5d\x0e\x12\xe8\x49\x44\x8f\x2d\x5f\x76\xf4\xac\x22\x96\xab\x58\xba\xf1\xe8\x31\x66\x0b\x66\x92\xf1\xe9\x19\xd4\x84\x28\x8d\x3d\x5f\xbb\x13\xd3\x25\xe1\x26\x54\xe4\x30\x0b\x25\xcb\xb9\x13\x24\xbc\xab\x0b\x83\x54\x72\xd6\x40\x25\x10\x9a\x69\x9a\x5a\x78\x47\x6e\x93\x8f\xaa\x64\x89\x32\x27\x1c\x63\x2d\xb3\x3f\x86\x93\xc8\xa9\x81\xda\xa6\x2e\x5f\x46\x29\xa6\x0b\x29\x00\x36\x7c\x73\x55\xef\xc0\x9f\x23\xc0\x9e\xe8\xd3\x5a\x62\x5a\xf0\xf9\x22\xe0\x05\x55\xcc\x17\x6f\x6c\x62\x5c\xb7\x60\xd1\x2f\x08\xea\x42\x10\x96\x17\x66\xd9\xc0\xed\x06\x06\x19\xa6\x70\x95\x3e\xc2\x1e\x01\x22\x3a\x23\x99\x5b\x1d\xf7\x92\xb5\xc7\x75\xf2\x15\x39\xb1\xc8\x8e\x13\xb3\xcd\xb1\x06\x12\x30\x92\xc5\xe9\x98\x5c\x10\x51\x56\x94\x6c\xdf\x89\x0a\x59\xcd\x13\x26\x04\x9c\x2c\xd3\xb2\x7e\xdf\x23\xd8\x3e\x8b\x2a\x22\xac\x97\x05\xf4\xc2\xe1\xa3\xeb\xf4\x67\x79\x12\x53\xf9\x59\x6c\x94\x39\x69\x46\x9a\xb7\xd1\xd9\x1d\x11\xd6\x0b\xdc\xa7\x2c\xf8\xea\x85\x89\x29\xeb\xb9\xb2\x15\x19\xd7\x50\xd4\xb4\xbd\x25\x4d\x92\x17\x01\x9b\x40\x2d\x2d\x99\xb2\x63\x4d\x32\x3a\x65\x59\x54\xa1\xd9\x7d\xdb\xc8\xad\x9e\x6b\x63\x11\x51\x40\x89\xaf\x39\x56\xe1\x87\xe5\x5d\x3e\x7e\x40\x7b\x23\x7f\x6c\xf7\x01\x88\x96\x73\x21\x25\x34\x64\x72\x05\xd0\x10\x40\x11\xdd\x28\xa1\x19\x81\xe8\xe6\x14\x57\x47\xb6\x7f\xdb\xa9\x5e\x4d\xa7\xd6\x04\x2b\xc0\x0f\x17\xed\xe1\x8b\x53\x45\xc3\x24\xf5\x19\xd1\xa2\xc8\xc0\x3c\x1b\x59\x4d\x97\xec\xd7\xcd\x24\x1c\xe4\x9e\xfb\xf1\xa1\x69\xe1\xba\x63\xcb\x63\xed\xa3\x6a\xa4\xd0\x0b\x8e\x29\x70\xba\x3a\x20\xc6\x4f\x33\xe3\xeb\x9c\x5b\xec\x20\xdf\xd3\x8c\xa7\xd5\x94\xfb\xf4\xc6\xb2\x3c\xf5\x5a\x9c\x91\x77\xd2\xd8\x7f\xae\x3e\x71\x6d\xc5\xae\x4b\xc9\xf4\x3b\x69\xe0\xcf\x3e\xed\x64\xbe\x35\xee\x66\xbc\xe9\xf1\xf4\x1e\x87\xe7\xf6\x65\xcf\xa3\xbb\x10\x4e\x31\xb5\x1b\xed\x63\x80\xfa\xdc\x46\x37\xae\x9d\xec\x59\x91\x07\xae\xc9\xb5\xb0\x22\xb8\xdb\xf0\x3e\x78\x10\x02\x92\xb5\x9f\x66\x88\x6d\x11\x52\x8c\x40\x62\x39\xd0\x3c\x1d\x2e\xd8\xb9\x36\xb1\xe1\xa0\x53\x3e\xe4\x74\xbf\x85\xa8\xa0\x37\x2e\xd8\xb8\x07\xc8\xf5\x09\x2e\xe8\x3d\xab\x6a\xd6\xf6\x80\xe8\x85\xf4\x33\x1f\x45\x1d\x6c\xf8\x5c\x18\xa6\x0a\xc5\x62\xe4\x92\x7a\x50\x6d\xd9\x8d\x0f\x07\xf4\xb1\x97\x6e\xce\xdc\x92\x98\x22\xa3\xf1\x9d\x50\xed\x48\x41\x91\x71\x61\xc5\xd4\xb0\x39\x4f\x48\xce\xd4\x1c\x42\xeb\x92\x45\xfc\x19\xf5\x6a\xe8\x48\xf6\x6d\x3b\xd8\xaf\x5b\x53\xff\x1e\x56\x7d\x3a\x58\x8d\x2a\xb4\x8d\x7a\xb0\x67\x07\xab\x3e\x3b\x02\xc2\xe8\x6b\xf0\xbe\x3f\x47\x39\xd4\xc7\x05\x0c\x72\x28\x8c\x41\x0e\x1d\xe4\xd0\xd6\x18\xe4\xd0\x7a\x0c\x72\xa8\x1f\x83\x1c\x3a\xc8\xa1\x83\x1c\x1a\x33\x06\x39\x74\xf3\x78\x32\x39\x34\xfa\x45\xce\x4a\xdb\xcb\x80\xfc\x83\xb3\xc1\xaf\xc6\x6c\x80\x20\x1c\x73\xfe\xeb\xa6\x63\x2b\x38\x4e\xbc\x2c\x71\x0b\x66\xe9\x88\x9c\x7a\x3b\x14\x15\x73\x46\x5e\x8c\x5e\x7c\xf5\x15\x16\xfb\xfb\x24\x9a\xc5\xa6\x99\xc5\x61\xea\xa8\x61\xe1\x47\x3e\xe0\x4e\x13\x1d\x5e\x81\xc4\x93\xf8\x9e\x53\x8f\xe2\x87\xf6\x7c\xa3\x72\x16\xb6\x1c\x92\x31\xa9\xe0\x2d\x47\x71\x15\xcb\x98\x33\x43\xa8\x69\xb9\x81\x78\xce\xb0\x1c\xa9\x90\x69\x2b\x8f\x3c\xf8\xc5\x53\x22\x43\xb6\xba\xc5\xea\xb1\x5f\x05\x02\x62\xc7\x3a\x57\x56\x91\x30\x8a\xab\x08\xe5\x02\x52\xfd\x5a\x65\xce\x7c\x43\x37\xcf\x42\xec\x32\x58\x38\x1f\x72\xc2\xc6\xa8\xca\xc0\x69\xe9\xa3\x4f\x49\x59\xa4\xd4\xb0\x53\x97\x2e\xeb\x43\xef\x72\x2b\x8e\xa9\x2a\x63\x1c\xd7\xc1\xc7\x48\x02\x2d\x03\x4a\xe8\x65\xc3\xee\xb9\x4f\x68\xb5\x13\x84\x46\x00\xdc\xb8\x38\x8c\xee\xf9\xc5\xa9\x56\xab\xb4\xa7\x4f\xb2\xf9\x07\x7f\x0d\xc6\xdb\x34\x7a\x2c\x7d\xb1\xef\x77\x1d\x25\xe1\xbf\x80\xab\xef\x3f\x60\x2b\x5e\x45\xb1\xf1\x55\xdd\xbd\xcc\x32\x7b\x6a\xce\x9d\xbb\xee\x29\x8c\xb3\x8b\xb4\x5c\xa4\x63\x9f\x2f\xdb\xc0\x6a\x17\x6d\x80\x07\x6a\xf7\xe1\xe2\xdd\xa5\x4b\xa4\x66\xe4\x56\x16\x32\x93\xf3\x65\xf3\xdc\x7c\xd3\x8e\xbc\xc0\x7b\xfd\x3d\x6c\xa2\xcb\xa9\xd7\xd5\x2c\xc6\xbd\x5b\x41\x88\xc1\xa3\x39\x78\x34\xeb\x31\x58\x92\x06\x4b\x52\x6b\x0c\x96\xa4\x7a\x0c\x96\x24\x3f\x06\x4b\xd2\x60\x49\x1a\x2c\x49\x31\x63\xb0\x24\x6d\x1e\x83\x47\x73\xf0\x68\x6e\x1a\x83\x1c\x3a\xc8\xa1\xad\x31\xc8\xa1\xf5\x18\xe4\x50\x3f\x06\x39\x74\x90\x43\x07\x39\x34\x66\x0c\x72\xe8\xe6\xf1\x7c\x3d\x9a\x31\xaf\x88\xd9\xe8\xd1\xba\x6b\x00\xe9\x39\x43\x4c\x1e\xf9\xc3\x42\xa6\x7b\x25\xae\x16\x32\xdd\x9e\xb7\x8a\x72\xf7\x24\x72\x94\xc9\xa4\xaa\x43\x6f\x01\xfa\xc2\x7b\x9a\xe6\xce\xc7\x75\x46\xfe\x25\x05\x3b\x83\xec\x3c\x4b\x20\xb4\xec\x4c\x0d\x77\x25\x8c\x0a\x99\x9e\xe8\xd3\x8e\x5c\xa9\x21\x2b\x76\xc8\x8a\xdd\x35\x86\xac\xd8\x5f\x62\x56\xec\x82\x6a\x77\x13\x9c\x70\xb2\x35\x49\x16\x77\xa1\x02\x8d\xb4\x44\xfa\xcf\x9d\x39\xb2\x18\x5f\xfc\x93\x66\xd1\x5a\xd2\xe2\xd1\x16\xea\x20\xd4\x88\xe7\xf6\x25\xf5\xa1\x37\x2c\xbd\x69\xaf\x15\xc5\x1f\x9d\xdd\x04\x96\x44\xd3\x94\xa5\xa4\x60\x6a\xe4\xae\x83\x24\x33\xee\xab\x3d\xb7\x57\x8a\x02\xec\xf7\x18\x73\x44\xb1\x99\xae\xed\x65\xf6\x72\x69\x37\x7d\xf2\x2d\x36\x08\x79\xaf\x78\xef\xf0\x86\x0a\x35\x4f\x9d\xf7\x0a\x96\x8d\x20\x88\xec\x63\x9a\x03\x0b\xc9\x4f\x25\x53\x4b\xa8\x16\x56\x69\xf4\x91\xc2\xaa\xa3\x79\xda\x47\x69\x71\x4d\x12\xaa\x21\x52\x23\x4a\x47\xed\x6b\x2f\xda\xc7\x67\x4e\x56\x37\x65\x15\x98\xb3\xd4\x65\x5c\xc7\x5a\xf8\x80\x5a\xba\x0d\xde\x68\xf4\xc4\x32\xc8\xf6\x68\x71\x9b\x3a\x08\x22\xd6\xfa\xd8\x4f\x19\xda\x88\x3d\x07\x30\x86\x92\x47\x31\x88\x92\x47\x31\x8a\x92\x03\x18\x46\xc9\x5e\xc6\x51\xd2\xd7\x40\x4a\x56\x8f\xd0\x37\x2e\x31\xc1\x56\xda\x0b\x22\x69\x59\x58\xd7\xec\xa5\x3d\x61\xf6\xb1\xb2\x92\x7d\xb5\xf5\x7d\xac\xad\x64\x75\x73\x2b\x64\xab\xcb\xf6\xf5\xdc\x8b\x4d\x06\xdb\xca\xfc\xda\x13\xe6\x76\xa3\xad\xa5\x2a\x3d\x81\xae\x19\x6e\xe1\x86\xec\x6b\xbb\xdd\xfb\x50\xfb\x5a\x61\xc9\xea\x91\x7a\x93\x1c\x07\x93\x57\xbc\x51\xa7\x1e\x6b\x96\xdc\x55\x03\x62\x4f\xb8\x2b\xd6\xdc\x35\x43\x67\x4f\xb0\xeb\x16\xdd\xc3\xcd\x77\x8b\x55\xb7\xa7\xd1\x94\x6c\x34\x9c\xee\x63\xd9\xb5\x63\xb3\x51\xd3\x9b\x28\x7b\x5f\xea\x03\x19\x36\xc9\x3e\xc6\x4d\xb2\xff\xed\xea\x6f\xe4\x24\x7b\x18\x3a\x49\x4f\x63\x27\xe9\x6f\xf0\x24\xfd\x8d\x9e\x64\x8f\x5d\x02\x81\xf4\x0d\x84\x5c\xc6\x6e\x92\x55\xce\x5d\x17\x91\x9b\x3d\xa4\x8d\x3d\xf0\x63\x5d\xb2\x76\x0b\x71\xd2\x5e\x4e\x8b\x58\x55\xc3\x8e\xff\x63\x45\x3a\xb8\xe4\xff\x17\x9a\xea\x69\xab\xd0\x39\xa7\x48\xf3\xbb\x78\x0f\x81\xa8\x35\x6c\x3f\x4d\x3b\x43\xae\x89\xc5\xd1\x7b\x9a\xf5\x11\x6c\x5d\x50\x7c\xe8\xb1\x23\x67\x6b\xfa\x45\xbc\x54\xeb\x9a\xf4\x59\xa1\xcb\x99\x61\xb8\x26\x47\x77\x6c\x79\x74\xb6\x1f\x45\xb6\x60\xae\xc5\x51\xdd\xee\xa9\x45\x47\x83\x2c\x1e\xaf\x04\x89\x6c\x49\x8e\x00\xd6\xd1\x86\xb8\xef\x3e\xc2\x46\x2f\x95\x67\x2f\x77\x45\xe4\x63\x75\xa9\xeb\xde\xba\x79\xa3\x5a\x76\x5d\x73\x1d\x4c\x63\x51\xeb\x16\xcd\xba\xda\xac\x6d\x37\x68\x44\x34\x44\xc1\x3c\xa9\x8a\xb3\xcd\x2d\x46\x98\xd3\x3f\xaf\xe4\x0a\x44\x2b\xcb\x39\xa3\x42\x93\xa3\xe0\x4f\x68\x96\x6f\x3f\x8a\x00\xd4\x83\x0b\xf6\xa4\x6d\x7d\x68\xb9\xf1\x29\x0a\xdf\xc5\xa9\x6e\x2b\x76\x41\xef\x71\xa9\xbb\xa3\x57\xee\x98\x38\x27\xeb\x49\xb0\x7e\x9d\xda\x73\x73\x8d\xc1\x02\x20\x72\x42\x85\xe1\xa3\xea\x17\x51\x80\xeb\xae\xe3\x32\xd5\x55\x2a\xe0\x3a\xf6\x45\x01\x0d\x0e\xa6\xca\xa7\x51\xe3\xf5\x19\x79\x80\x02\xc4\x3d\xf7\x81\xeb\xd0\xdd\x8a\x50\x4d\x54\x29\xa0\x3d\x8a\x14\xde\x29\xe2\x88\x6d\x14\x44\xd7\x7c\xd0\x9b\x6b\x9d\x89\x04\xf6\xc4\xf5\x73\xab\x70\x20\x5e\x06\x08\xce\x21\x39\x73\x55\xfe\xed\xf4\xa4\xf0\xf6\x72\x2a\xe2\x20\x56\x9d\xb3\x32\xd7\x51\x15\x4e\x8b\x57\x3b\x30\x26\x57\xf6\x2a\xc7\xdd\xa5\x7a\x71\xa1\xf7\x29\xf4\x50\x88\xa3\xd5\x3d\x6e\x64\xbc\x0c\x39\xea\x71\x12\x4f\x99\x58\xfb\xf0\x68\x89\xb5\x2b\x86\xf4\xdf\x6c\x5e\x6d\x0f\xb7\xc9\x90\x5c\x3b\x24\xd7\x76\x8d\x47\x4d\xae\x85\xc7\x1d\x47\xd9\x9d\x65\x8b\x72\xbb\x43\x1e\xee\x21\xb3\x6c\x09\xf9\xc1\xf7\xe0\x51\x0c\x8e\x3d\x2f\x33\xc3\x8b\xac\xf6\x30\x9f\x55\xad\xbd\x75\x08\x08\xc7\x28\x14\x6d\x0a\x66\x67\x4c\x93\xc5\xea\x15\x86\x37\x42\x28\x9b\x06\x8e\x86\xd1\xab\x20\x20\x80\x66\x99\xcf\xad\x0d\xb6\x22\x17\x7d\x81\xea\xb9\xd1\xcf\xbd\x7b\x09\x12\x87\xae\xe3\x67\x81\xfb\x9e\x58\xa1\x26\xb3\x68\x87\x15\x39\xb6\x4b\x57\x75\x33\x5a\x7f\x3b\xe6\xfc\x9e\xe1\xfa\x2a\x57\xa2\xd5\x89\x3e\x3d\x0d\x76\xfd\x7d\xc5\xce\x18\x71\x13\x78\x1d\x4e\x2d\x5e\x15\xfd\xba\xc4\x39\x24\x50\xab\x5f\xef\x12\xe3\xfe\xbb\x21\x3d\xfc\x05\x7f\x52\xbb\x05\x38\xd8\x60\x89\x25\x5f\xa4\x85\x3a\xb5\xe0\xf6\x08\xbe\xf7\x5e\xce\x66\xac\xa3\xb9\xe1\x3c\x46\xcb\x20\xbd\x9d\xcc\x9f\x35\x21\xfb\x40\x8e\x65\x84\x53\xb9\x7f\x6e\x4d\x2f\x87\xf2\xfe\x49\x35\x07\x73\x24\x0f\x59\x35\xbb\xc6\xfe\x59\x35\xab\x0e\xe3\x9e\x06\xf8\xad\xce\xe2\x5f\x68\x72\xcd\x06\x57\x2f\xb2\x3f\x59\x7b\xec\x70\xf3\x36\x1c\xb6\x3d\x00\x6f\x70\xf1\xae\x3b\x6b\xfb\x84\x57\xec\xed\xde\xfd\xdc\xc9\x35\xeb\x2e\xdd\x3e\x2e\x09\xd2\xe1\xce\x25\xbc\xcf\xee\xee\x74\xe5\x82\xb4\xda\x03\xe8\x4e\x37\x6e\xbf\x79\xee\x70\xe1\xfa\x29\xf7\x41\xad\x0d\xee\xdb\x86\x23\xb6\x07\xc4\x75\xd7\xed\xba\x13\xb6\x07\xd8\x21\x1f\x65\xc7\xf8\x35\xe6\xa3\xf4\x76\xc9\xee\xef\x8e\xed\x79\xf6\x18\x37\x6c\x6f\xd7\xe9\x0e\x17\xec\x06\x97\x6a\xa4\xd9\x7b\xc5\xfd\x7a\x68\x77\xea\x23\xb8\x52\x1f\xc1\x8d\xda\xed\x42\x8d\x17\xda\x7b\xdc\x96\xe8\x47\xe2\xdd\xa0\x48\x17\x68\xe3\xab\x28\x53\xf8\x36\xf7\xe7\x06\x97\x26\xde\xd7\xbb\xe6\xfa\x3c\x84\x3b\x33\x92\x3b\xf4\xa0\x0b\xb1\x74\xaf\x87\xfb\x12\xef\xba\x6c\xd8\x87\xd0\x6b\xc6\xd9\x91\xa2\x9c\x1b\x1d\x2e\xcb\x35\x37\x64\x8f\x80\x80\x75\x77\x65\xdb\x66\x85\x77\xc4\x6c\x76\x55\x6e\xb0\x5b\xa1\x41\x6e\x72\x53\x6e\xb5\x5c\xe1\xcf\xa9\xe1\xa2\xec\x74\x3b\xa2\xa1\x82\x7b\xf2\x00\x2e\xc7\xc8\x9b\x13\xeb\x67\x8a\x73\x33\x3e\x8a\xdb\x28\x22\xb1\x54\x18\xbe\x7f\x72\x69\xf3\x0e\xc6\x76\x46\x05\x8f\x08\xa1\xf7\x92\xa7\xa4\x28\x8d\x4f\x55\xdb\x9d\x65\xda\x01\xb2\x99\x83\x3a\x64\x99\x6e\xcf\x32\x6d\x1d\xdb\x90\x6a\xfa\x9b\x4b\x35\x6d\x9e\x3f\x02\x62\x33\x23\x15\x9f\x6f\x8a\x3a\xf8\xda\x5f\x88\xca\x37\x45\xc2\x74\x19\xa9\x43\xbe\x69\x18\x43\xbe\xe9\xce\xdf\x0f\xf9\xa6\xcd\x31\xe4\x9b\x36\xc7\x90\x6f\x3a\xe4\x9b\x6e\x19\x43\xbe\xe9\xca\x18\xf2\x4d\x77\x8e\x21\xdf\x74\xc8\x37\x6d\x8d\x21\xdf\x74\xc8\x37\x1d\xf2\x4d\x31\x00\x86\x7c\x53\xcc\xa3\x43\xbe\xe9\x90\x6f\x3a\xe4\x9b\x0e\xf9\xa6\x1b\xc6\x90\x6f\x3a\xe4\x9b\xae\x8f\x21\xdf\x74\xc8\x37\x1d\xf2\x4d\x77\x8e\x21\xdf\x74\xeb\x9c\x87\x7c\xd3\x4d\x63\xc8\x37\x75\x63\x63\xbe\x69\xcb\xf1\xfc\x6b\x4f\x3a\x45\x2c\xb6\xbd\x14\x04\x50\xc8\x4d\x8d\xca\x3c\x45\x00\x75\x91\x18\xf8\xcc\x53\x04\xc8\x76\x07\xd8\xce\xcc\x53\x04\xc4\x2a\x37\x15\x97\x79\x8a\x80\xd8\xce\x4d\xc5\x65\x9e\x22\xbd\xb9\xcd\xdc\xd4\x21\xf3\x74\xd3\x18\x32\x4f\x87\xcc\xd3\xd6\x18\x32\x4f\x87\xcc\xd3\x5d\x63\xc8\x3c\x8d\x1a\x43\xe6\xe9\x90\x79\xba\x65\x0c\x99\xa7\x31\x63\xc8\x3c\x1d\x32\x4f\x63\xc6\x90\x79\x3a\x64\x9e\x6e\x7b\x6c\xc8\x3c\x1d\x32\x4f\x87\xcc\xd3\x03\x3f\x32\x64\x9e\x0e\x99\xa7\x43\xe6\x29\x19\x32\x4f\x87\xcc\xd3\xe7\x9e\x79\x8a\xf8\x11\x55\xf3\x1d\x14\xa9\x93\x60\xa1\x36\xba\x7b\x4d\xd3\x92\x67\xe6\x3a\xa7\x73\xb6\xfd\x65\x88\x57\x25\x32\xcf\xa9\xd8\x71\x8c\x4f\xb5\x1e\x26\xee\xf7\x98\x44\x8b\xc2\x5e\x89\xfb\xef\x69\xdb\x06\x21\x2c\x7c\xae\xa4\x00\x71\xe9\x9e\x2a\x4e\xa7\x19\x23\xfe\x07\x3b\xd1\x86\x5b\xba\xf2\xca\x89\x2b\x4c\xed\xba\x4e\x58\x83\x94\x25\x82\x5d\xf7\xa6\xb5\xa0\x77\x34\xaf\x13\x4d\x37\xac\x63\x4c\xde\x62\x74\xe0\x29\xb3\x2b\xf9\xc7\xf5\xe5\xd5\xbb\xdb\xeb\xd7\xd7\x57\x1f\xba\x68\x03\x9a\x26\x00\xad\x8d\x5a\xd2\xf1\xf7\xe1\x0c\x20\xc5\x8e\x09\x2b\xdd\xfc\xee\xe4\xfb\x8b\x0f\xff\x78\x77\xf1\xf6\xea\x14\x84\x39\xf6\xa9\xa0\x22\x45\xb0\x97\x52\x07\x3e\x55\x28\x76\xcf\x65\x59\x73\x92\x4d\xfb\xa5\x09\xef\x76\x01\xb9\xe0\x01\x7f\xec\xde\xee\xb9\x24\x9a\xa9\x7b\x9e\x6c\x3e\x06\x84\x45\xea\x7a\x46\x68\x8d\x7e\x09\x15\xde\x97\xab\x98\x96\xd9\x3d\x4b\x9d\xbc\x5d\x6d\x09\x76\x9e\x5c\x14\xa5\x09\xa6\x97\xd0\x48\xbd\x14\xc9\x82\x8a\x39\x4b\x9d\xa8\xdc\xd8\xdc\x4e\x98\x7a\x29\x0c\xfd\x64\xe7\x07\x36\x07\x9d\xd0\x22\x04\x61\x50\x92\xca\xd2\x4e\xfe\x77\xbf\x3b\x23\x9c\xbd\x24\xbf\x6b\x00\xee\xde\x80\x2b\x0f\xab\x71\xea\xce\xa7\xcd\xee\x99\x82\x97\xf9\x33\x3f\x23\x8a\xcd\xa9\x4a\x33\xa6\xbb\x65\x01\x39\xb3\xd2\x04\x64\xd3\x3b\x4d\xc3\x6f\x30\xab\x0c\x32\x42\x9a\x31\xb9\x64\x33\x5a\x66\x06\x15\xf2\x77\x74\x34\x3e\x3e\xe8\xf5\x78\xad\x64\x67\xe2\x66\xeb\x8a\x4c\xc0\x1d\x04\x69\xdb\xdb\x2e\xfe\xb1\xb7\xe6\x77\xef\xfb\xab\x0a\xd5\x4a\x6d\x45\x2d\x6f\x44\x0d\xc2\x02\x2a\x3f\x20\xc6\xe0\x9e\x48\x31\xe3\xf3\xb7\xb4\xf8\x8e\x2d\x3f\xb0\x59\xb4\x67\xd8\x89\x9a\xda\xb9\x1d\x40\xdc\xb2\x34\xd8\x81\xc4\x88\x34\xb1\xce\x81\x08\x97\xc0\x5a\xaa\xb2\x93\x14\xbd\x2c\xf7\x48\xf2\x16\x8e\x6b\x6c\x9c\xe2\x71\x93\x7d\xf8\x7b\x67\xc6\xe4\xad\x84\x28\x80\x99\xc4\x6b\x57\x0b\x63\x0a\xfd\xf2\xfc\xfc\xae\x9c\x32\x25\x98\x61\x7a\xcc\xe5\x79\x2a\x13\x7d\x9e\x48\x91\xb0\xc2\xe8\x73\x79\x6f\x29\x24\x7b\x38\x7f\x90\xea\x8e\x8b\xf9\xc8\x52\x8d\x91\x13\xa8\xf4\x39\xc8\xff\xe7\x5f\xc2\x3f\xe8\xd7\xde\xbe\xbf\x7c\xff\x92\x5c\xa4\xa9\x2f\x98\x51\x6a\x36\x2b\x33\x9f\xc7\x3d\x26\xb4\xe0\xdf\x33\xa5\xb9\x14\x78\x9b\xc7\x1d\x17\xe9\x19\x29\x79\xfa\x75\xd7\x25\x0f\x23\xfa\xbc\x64\xe1\x4c\x5c\xbd\xce\x6c\x02\x9a\xd5\xb2\x45\xd5\x2a\xfc\x27\x11\x96\x18\x6e\x34\xe0\x67\xb0\x1f\x7b\xb6\x18\xb5\xe8\xa9\x94\x19\xa3\x98\xa0\x89\x58\xad\x00\x67\x13\x8d\xd2\x06\x00\x2b\xfa\x50\x9c\xe3\x9a\xe4\x38\x1b\x98\xbf\x31\x85\x4c\x5f\x12\x5d\x16\x85\x54\x48\xd7\x50\xce\x0c\x4d\xa9\xa1\x63\x8b\xe6\x67\xed\x3f\x41\xf9\x6d\x7c\x06\x8a\x29\xd2\x58\x57\x3d\x04\xb4\xdc\xb9\xc6\xce\x40\x09\x1f\x5b\x45\xf4\x1d\xbc\x0d\xfe\xf4\x52\xca\x45\x92\xc8\x52\x18\xf8\x02\xf5\x06\x6d\xa8\x29\xf5\x78\x21\xb5\xb9\xbe\x39\x0b\x7f\x16\x32\x5d\xfd\x4b\x77\x32\x47\xd2\x83\x08\xd7\x37\xb9\xd7\xa5\xf1\xcf\x36\x2b\x95\xe4\x14\xfe\xfb\xda\x1e\xe8\x0d\x35\xf8\xec\x01\xae\xc9\x83\xe2\xc6\x30\x01\xc6\x0e\x08\x8b\x92\xb3\x33\x7b\x7f\x82\x08\x41\x8e\xee\x5f\x1c\x3d\x1a\xb9\x9f\x85\x29\xf7\xda\x0a\xfb\x60\xd8\x07\x87\xcd\x15\x83\xc2\xc8\x94\xd5\xac\x5b\x51\xe7\x17\x37\xd7\xe4\xde\xed\xf1\x33\xb1\x2a\xcc\x22\xce\x35\x8a\x8a\x84\x60\x9c\xd7\x07\xa0\x26\x01\x56\x38\x8f\x4a\xa9\xc0\xad\x12\x8c\xde\x55\x70\x10\xc9\x78\xce\x7d\x66\xb1\x2f\xa3\xa3\xc9\x89\xfb\x70\x9c\x14\x25\xee\x9e\xfb\xdf\xe7\x2c\x97\x6a\x79\x16\xfe\x64\xc5\x82\xe5\x4c\xd1\x6c\xa4\x8d\x54\x74\xce\xce\xaa\x57\xe0\x41\x57\x4f\x38\xe0\xad\x89\xae\xbf\x01\x67\xe5\xb4\xba\x60\x52\x2a\x2b\xb7\x64\xcb\x40\x8b\x59\xfa\x28\x34\xa8\x3a\x9d\x77\xbd\xe5\xad\xca\x58\xe0\x64\xb6\x0a\xab\x41\x94\xbf\x97\x59\x99\xc7\x84\xdf\x04\x39\xc2\xd5\x6f\x12\xf7\x56\xf8\xd7\x8f\x26\xb5\xa4\xfc\x9e\x6b\x7c\x1c\x08\x15\xcb\xf7\xa8\xcb\xe1\xc6\x28\x3a\xb2\xbd\xfd\x5c\x94\x61\x7f\x83\x40\xc5\x7d\x22\x98\x2c\x8d\x55\x98\x5d\x68\x7e\x4c\x9c\x83\x2f\xf5\x25\x35\xa8\xb0\x55\xdd\x97\x16\x57\x78\x81\xf5\x6d\x14\xd4\x18\xa6\xc4\x4b\xf2\x3f\x27\x7f\xfb\xfd\xcf\xa3\xd3\xaf\x4f\x4e\x7e\xfc\x6a\xf4\x5f\x7f\xff\xfd\xc9\xdf\xc6\xf0\x9f\x7f\x3b\xfd\xfa\xf4\xe7\xf0\xc7\xef\x4f\x4f\x4f\x4e\x7e\xfc\xee\xed\xb7\xb7\x37\x57\x7f\xe7\xa7\x3f\xff\x28\xca\xfc\xce\xfd\xf5\xf3\xc9\x8f\xec\xea\xef\x48\x20\xa7\xa7\x5f\xff\x0e\x39\xc1\x4f\xa3\x5a\xd2\x1f\x71\x61\x46\x52\x8d\xdc\x11\xbc\x24\x46\x95\x38\xd3\x77\xd8\xa6\x7e\x77\x29\x54\x2d\x7a\x59\x93\xd1\x8a\x93\x3d\xd2\x25\x88\x65\x42\x11\x85\xcf\xa2\x78\x90\x66\x89\x62\xe6\x70\xfa\xb3\x83\x17\x7c\x37\xe0\x8f\x8b\xf3\x5e\x3e\x43\xb5\xbb\x72\xa3\xc0\xd2\x2a\xcc\x40\xdf\xe7\x99\x92\xf9\x98\x80\xf9\xd4\x99\x47\xef\x21\x40\xcc\xc3\xbb\x63\xe8\x9a\x09\x83\x3a\x3f\xa8\xf3\xad\xd1\xa9\xce\x4f\x1c\x8e\x49\x65\x75\x74\xfc\x4a\x7f\x3b\xba\x3c\xf2\xa7\xb8\x79\x8e\xe0\xc2\x75\xba\xb1\x50\xde\xc1\x5d\x6e\x2c\xbe\xb7\x47\x6e\x37\x61\x40\x00\xd0\x2c\x29\x15\x37\x4b\x2b\x86\xb2\x4f\x3b\x32\x30\x57\x18\x46\xeb\x29\xb2\x90\x59\xaa\x2b\x58\xde\x9e\x5b\x2a\xda\x91\xb0\x05\xde\xf1\xe0\x81\x70\x81\x25\xa9\x8b\xdd\xad\x04\xeb\x31\x99\xc8\x9c\x35\xcb\x30\x76\xbb\xe1\xb8\x20\x53\x69\x16\x6b\xb3\xb4\xda\xc5\x8d\x4c\x57\x3e\x1e\xfb\xde\x7f\xf6\x91\x1d\x40\xed\xab\x35\x6b\xc5\x67\xda\x17\xad\xbe\xc3\xd0\x3b\x98\x62\xc2\x52\x26\x92\x1d\xb6\x76\x1c\xf3\x03\xbf\xfc\x8d\xe2\xf7\x3c\x63\x73\x76\xa5\x13\xea\xa2\x9c\x23\x4a\x1c\x1f\x5f\x6c\x81\x01\x9b\xac\x64\xa6\x03\xa5\xe9\xb8\x62\xd4\xce\x39\x61\x5a\x83\xab\x67\x4e\xb9\x70\x45\x6d\x8b\x00\x1a\xc2\x1d\x04\x58\x11\x0b\x6a\x19\x4d\x78\xa0\x8b\x2f\x42\x94\x8b\x25\x36\x24\xe5\x8a\x25\x56\x6f\xab\xe6\x56\xd5\x3d\xfd\x87\x60\x0f\xff\xb0\xef\xd2\x64\x96\xd1\x2e\x3a\x1c\xb0\x0a\x82\xc0\xc5\x8a\x7f\x2e\x4c\x8b\x6c\xdb\x9a\x0e\xe0\x5c\x83\x44\x4b\x68\xf6\x40\x97\xb0\x7d\xab\x6f\xe0\xfa\x25\x79\x71\x4a\x54\x29\x08\xd5\xa4\x7a\x43\x17\xf9\xfd\xf7\x53\xa8\xed\xfa\xea\xe2\xe6\x1f\x93\xff\x3d\xf9\xc7\xc5\xe5\xdb\xeb\x77\xbb\x99\x14\x96\x50\x27\xb4\xa0\x53\x9e\xf1\x6e\x69\x6b\x4d\x74\x6a\x3e\x0a\xb7\x33\x4d\xcf\x53\x25\x0b\xb7\x6e\x5c\x06\x55\xb5\x33\xba\xe5\x58\x83\x5d\xf3\xfa\x50\x88\xd7\x6f\xbe\xae\x03\xea\x5c\x51\x61\x42\xd6\x69\x73\xfb\x55\x29\x0c\xcf\x3b\x3c\x5d\x78\xf1\x93\xa6\x08\xc6\xd6\xce\xce\x81\x5a\xb1\x11\x4b\xe9\x9b\x14\xf9\x2a\xbc\x62\x59\x87\x30\x90\x9b\xf7\x93\xeb\xff\x2f\xee\xed\xc4\xe3\x12\x9a\x2b\x23\xa5\x21\x7c\xc0\x9c\x45\xa9\xc8\x5d\xfe\xc0\x72\x79\x3f\xec\x33\x79\x84\x8a\xfa\x15\xc5\x8a\x20\x16\x1f\x4a\xd1\x24\x81\xa2\x01\x85\xe4\x90\xea\x7c\xe3\x08\x6f\xe7\x3e\xb5\x9f\xad\x89\x87\x0b\xf4\xd0\x76\xf3\xb9\x4b\xbd\xc6\x56\x9b\x32\x92\x28\x29\x2b\x5e\xb0\x90\xba\xed\xe2\x27\x33\x9a\xe9\x0e\x82\x81\xa5\xb5\x96\xbd\xbc\x95\xa5\xe8\xa8\x67\xd1\xda\xbb\xea\x19\x92\x32\x21\x8d\x37\x3d\xd9\x37\x42\xba\xaa\x92\x09\xc9\xed\xd7\xdd\xcb\x2c\x75\x1d\x06\xd0\x24\xbb\xb7\x0d\x52\xcb\x75\x58\xfb\x4d\x78\x6f\x17\x3f\x85\x60\xc4\x52\x57\x65\xc6\x57\x48\x6d\x6d\xd5\x9a\x41\x46\x17\x4d\xa5\xc8\xba\xae\x7c\x41\xcd\xc2\x59\xa7\x73\xaa\xef\x58\xea\x3e\xf0\x09\x2b\x5e\x54\x77\xef\xab\xa6\x79\xdb\x7d\x77\x66\x8c\x9a\x52\x31\x90\x12\x7c\x69\x01\x26\xe8\x34\xeb\x0a\x6f\x44\xde\x37\xbb\xb6\xf7\x22\x5b\x7e\x90\xd2\xbc\xe6\x19\x73\x75\x04\x22\x8e\xfa\x87\x4a\xaf\xe3\xba\xb1\x91\x96\xf1\x53\x80\x3e\x72\x56\x7c\x29\xbb\x0e\x65\x56\xbd\xbe\xc2\x65\x7b\xb4\x07\x44\x65\x55\x8a\x0b\xfd\xad\x92\x65\x07\x69\x5e\x13\x1a\xbe\xbd\xbe\x84\x3b\x57\x0a\x1f\x8f\x62\xd4\xd2\x15\x75\x58\x75\x6c\x74\xe1\x48\x90\xd5\x3e\x5a\xdc\x5b\xc1\x36\x2b\x1d\x96\x42\x33\x33\x26\x6f\xe9\x92\xd0\x4c\xdb\xe3\xee\x80\xa8\x9d\x5d\x6b\xa3\x26\x70\x3d\x0b\x5f\x6f\xd2\x20\xba\xc4\x84\x8d\xfa\x45\x43\x63\x68\xb8\xc7\xd6\x55\x87\xae\x9b\x4d\xef\x98\x46\x69\x16\xa4\x5d\x47\xe6\x8f\x7f\x40\x60\x42\xb7\x8d\x1d\x30\xe1\x9d\x14\x16\xed\x23\x70\xe1\x5a\xa4\x3c\xa1\x26\x84\x42\xb7\x69\x07\xd8\x26\x9c\x8c\xdc\xa9\x7b\x08\x29\x46\x40\xc1\x4b\x6d\xb5\xc2\xeb\x19\x88\xe0\x6e\x73\xbf\x2b\xa7\x2c\x63\x5e\x95\x04\xa3\x1c\x35\x5d\x58\x00\x91\x70\x56\xff\x26\xd4\x54\x68\x65\x24\x61\x42\x97\x2a\xb4\xda\x30\x24\x95\xcc\x05\x3d\xa9\xb2\x4b\x2b\xa0\x9a\x7c\xbc\xbe\x24\x5f\x91\x13\x3b\xcf\x53\x40\x87\x19\xe5\x19\x58\x1a\x0d\x55\xab\xab\xe7\x33\xc2\xbb\x4e\xdd\xbe\x1e\x16\x0b\x58\x4e\xa4\x72\x97\xfb\x8c\x08\x49\x74\x99\x2c\xc2\x6a\xad\x3a\xe7\x55\x9e\xae\xfb\xc4\x94\x45\x0e\x96\xb6\xae\x4c\xc7\xa5\xc0\xdd\xa8\x08\xa5\xbb\xcb\x5a\x87\xbb\x32\x91\x97\x22\x8a\xe8\x7d\xd4\x5d\x3e\xd7\x35\x9a\xf7\xf1\xe0\x34\xaf\x29\xa0\x58\xbc\x6f\xef\x86\x43\xdf\x10\xe8\xd1\x25\x52\x01\x0e\x85\xc7\x7f\x7b\x87\x7f\x78\x8a\xa8\xd9\x1b\x2e\xca\x4f\xef\x01\x03\x62\x95\xea\xc9\x15\x3c\x0c\x28\x01\x8b\x91\x2b\x36\xb0\xee\x0c\x9c\x86\x8d\xec\xba\x75\xb8\x67\x5b\x44\x34\x4b\x20\xba\x68\x58\xe6\xf2\x6b\xac\x24\x42\x45\x2a\xf3\xb5\x89\x56\x8d\x81\x1a\xaf\xb7\xc8\xd4\x09\x18\x83\x6a\xbf\x0e\xe6\x8b\x37\x2c\x64\xec\x9e\x21\x9c\x04\x2d\xec\x79\x63\x9f\xb1\x62\x5e\x38\x19\x00\xe2\x2b\x4b\x00\xdb\xc2\x77\x13\xf0\x16\x98\x04\x93\xb0\xe0\x9f\xc0\x2b\xa5\x4a\x66\x08\xa7\x55\x5b\x83\x94\x19\x73\xe9\xb5\x61\x71\x16\xc8\x33\x5c\x1b\xfc\x34\x6e\x6d\x56\x73\x69\xaf\x0d\xb4\xbb\xe7\xb7\xb6\xb2\x93\xf3\xad\xad\xcd\x32\xcb\xf6\xda\x80\x5b\x3d\xb7\xb5\x21\x8d\x1f\x0f\x5c\xa4\xf2\x41\xf7\x23\xec\x3f\xb8\x87\x03\xb5\x49\x2c\x49\x33\x5c\xcc\x75\x83\xb8\x77\x13\xe1\x96\xe6\xbe\x89\xba\x3b\x0f\xa3\x06\x9f\x34\x82\x57\xac\x53\xc6\x3a\xf3\x43\x5b\x71\xe0\xb7\x47\x7b\xe7\xb9\xa6\xaf\x94\x85\x69\x38\xcd\x26\x05\x4b\x22\x91\xfe\xdb\xb7\x93\x8b\x36\x00\x08\x04\x85\x44\x55\xbb\x72\xfb\x3d\x02\xdf\x69\x9a\x73\xc8\xc2\x27\x0f\x6c\xba\x90\xf2\x8e\x9c\x04\x5f\xfb\x9c\x9b\x45\x39\x1d\x27\x32\x6f\xb8\xdd\x47\x9a\xcf\xf5\xb9\xc7\xd1\x91\x5d\x05\x26\x2e\x8e\x8b\x0c\x8a\xe7\x85\xab\x55\xb7\xa8\x73\x13\x25\x49\xb5\x12\x38\x2c\x04\x48\x41\xf3\xda\xf6\xbe\xbe\x19\x10\x79\x00\x7e\xbb\x83\x52\xa7\xf5\x63\xc3\xc5\xde\x75\x1c\x1d\xcc\xd6\x17\x69\x12\x2e\x66\x02\x43\xab\xb6\xec\x9e\x97\xd7\x0f\xcb\x4e\x83\x56\xd2\x63\xbd\xa0\x9d\xf8\x47\xed\x0d\x0c\x44\x6a\xa3\xb6\x82\x58\xf7\xaa\x3e\xb3\x59\x63\xb1\x3f\x69\x6b\x2d\x28\x44\x5d\xd1\x6b\xba\x34\x17\x1c\xcc\x4d\x02\xe7\x5e\x34\x8f\x3c\x36\xdd\x23\xd1\xb4\x8f\x1c\x9c\x17\x22\x7e\xe4\x42\x54\xc1\x36\xbb\x4f\xa2\x73\x3b\x36\xbf\x86\xe9\xbf\x98\x42\xdd\x4f\xb0\x80\x43\xfa\xfe\x8c\x50\xff\xab\x9d\x8b\x7c\xe0\x66\x01\x29\xb9\x28\x69\x02\xcb\x3b\x60\x1a\x98\x68\xfb\xf5\x28\x7b\x3f\xa1\x95\x74\x51\xe3\xcd\xeb\x98\xaa\x0b\x6e\xbf\x1b\xf5\x20\x60\x36\xf6\x5e\xb8\xc0\x34\x57\xdd\x01\x40\x93\xe3\x97\xc7\x07\xcb\xda\x75\x8b\x56\xb2\xa0\x73\x44\x04\xc2\x6a\xf5\x9c\x95\x87\x49\xca\x0c\x53\x39\x70\xa4\x85\x7c\x70\xdf\x77\x0b\x87\x2e\xfc\xc3\x41\x61\x69\x25\xfb\x80\x37\xc7\x12\x9c\x76\x06\x2e\x66\x37\x5d\x50\xd9\x83\xa5\x28\x4a\x96\x22\xf5\xd5\x78\xed\x26\x42\xb0\xc7\xdb\x95\x89\xbf\x93\xa2\x1b\x28\xd7\x5e\xa8\xba\xad\xab\x3a\x73\x4d\xa6\xcc\x12\x33\x41\x5e\x8c\x5f\x74\xd6\x03\x47\x1f\x4b\x74\x7a\x38\xcc\x09\x0c\xaf\x50\xa3\x02\xf6\x2f\x04\x09\x86\x3b\x75\xb0\xd9\x05\x5f\x49\xd4\x0c\xdf\x3a\x84\x6e\xb8\x42\x78\x30\xf6\xc2\x47\x0f\x8a\x1b\xdf\x0e\xfb\x81\x23\x6a\xfe\x9e\x80\xc9\x94\x48\xd5\x64\x23\xa7\xd1\xde\xbf\x98\xa0\x38\x5d\x4e\xf7\xa6\x0f\xfe\xa2\x03\x92\x57\xe4\xa1\x46\x71\x44\x3c\xee\x76\x52\xd1\x5c\xfa\xd1\x11\x39\x71\xbf\x44\x80\x04\xcb\xf6\xc1\xd0\xc3\x6f\xd3\xd5\xa7\xa2\x53\xe1\x6c\xd7\x6c\xf0\x69\xdf\xe0\x2f\xdc\xb9\x67\xdd\xfc\xb2\xbd\xa7\x3b\xf6\xec\x1b\xb6\xa0\xf7\x08\x0d\x56\xf3\x9c\x67\x54\x65\x90\xe6\x3b\x71\x2b\x84\x6e\xe2\x1b\x8b\x4a\xd4\xa9\xed\x9d\x80\xb7\x15\x3c\x68\x14\x34\x68\xae\xa4\xf1\xba\x6e\xb1\x61\x1b\x42\xf8\x03\x0f\xeb\xb0\x27\x05\xb4\xd5\xff\x8d\xa2\xd8\x79\x19\xea\xa4\x7f\x4a\xb2\x52\xf3\xfb\x43\xd1\x17\x6c\x30\x67\xc5\xaf\x3b\x7e\xf7\x34\x41\x9f\x3e\xa7\xe7\xb0\xb2\x52\xab\x7e\x89\xd7\xcc\x3c\x2a\x83\xfc\x53\xc8\xb4\xbb\x89\x7b\x4e\x97\x60\x84\x4e\x20\x46\x03\x54\x3b\x2a\x96\xed\x90\x0e\x9f\x0d\x70\x08\x31\x8a\x3e\xe8\xab\x8c\x6a\xc3\x93\x6f\x32\x99\xdc\x4d\x8c\x54\x91\x65\x41\x2e\x7e\x98\xac\x41\x58\xa9\xe4\x72\xf1\xc3\xa4\x1b\xf9\xb9\xbe\x6b\x64\x8c\xf8\x26\xfb\xd4\x18\x0a\xed\xc3\x21\x20\xf5\xce\xb9\x18\x8f\x8f\x35\x48\x1b\x9d\x30\x73\x9a\x2c\xb8\x60\x41\x10\x11\x55\x22\x90\xd7\x8b\xec\x1e\x36\xc2\xf0\x51\xe1\xf5\x3e\x0d\xee\xdc\x63\xd0\x97\xf4\x41\x33\xb7\xfc\xa9\x5d\xbe\xfd\x9a\x75\x85\xb4\xc7\xe4\x5c\xcc\xf4\x2d\xca\xcc\xb9\x7a\x2c\x75\x54\x44\x15\xc0\xd2\x20\xce\x9d\x68\x18\xc6\x52\x96\xe4\x81\xba\xf2\x85\x70\x85\xc7\xe4\x96\x17\x2f\xc9\x55\xc3\x43\xeb\x52\x56\xc3\xeb\x50\x60\x8d\xb7\xc3\x56\x99\x81\xc1\x84\x01\x52\xa4\xab\x5e\x68\xe9\xa9\x0f\xac\x40\xc1\xbc\xfa\x44\xf3\x22\x63\xfa\x25\x39\x62\x9f\xcc\x1f\x8e\xce\xc8\xd1\xa7\x99\xb6\xff\x08\x33\xd3\x47\x63\x72\x9d\x17\x19\x4f\xb8\xb1\xc2\x8c\x88\xe8\x12\xef\xdc\x42\x0e\xe8\xba\x22\x7c\x78\xf4\x41\x4d\xca\xe5\x64\x58\xb9\x3d\x95\xe4\xc1\x95\xd1\xb1\x4c\x8d\x29\x25\x95\x0e\x54\x22\xf2\x58\x80\x67\x27\x32\x2f\x94\xcc\x79\xc5\xd0\xfc\x35\xc2\x24\x6a\x44\x85\xcb\x15\x54\x19\x8e\x6b\xfc\xb2\x8a\xdd\xb7\xf6\xf6\x86\xc7\x49\x5b\xf0\x00\x9c\x5c\xca\x12\xb5\xe2\x15\xdc\xbe\x9e\x11\x99\x73\x63\x82\x85\xb7\x11\xab\x15\x7e\x84\x02\x3b\x5d\x86\xd9\x58\x36\x30\x6e\xe0\xe6\xeb\x2a\xa3\x94\x9c\xa7\xec\xfe\x5c\xa7\xf4\x05\x2e\x6d\xc6\x5e\x45\xed\xd3\x52\x4c\x6b\xfd\x54\x93\xa3\x17\x47\x63\x32\x09\x52\x0f\xbe\x1b\xad\x9f\x4a\x0d\x6b\x26\x55\x35\x31\xa8\x08\xfa\xd5\x11\x39\x91\x0a\xde\x9e\xa0\xd2\x50\x08\xc9\x18\xbd\x67\xbe\xb8\x13\x50\xba\xa5\x2b\x57\x73\x8a\xca\xff\x8d\x6d\xbf\x14\x97\xa0\x8a\xd5\x86\xc8\x7a\x7e\xb8\xdf\xfb\x23\xab\x06\x1d\x81\xc2\x22\x2d\xaf\xb2\xdc\x45\x33\x83\xde\xf2\x0f\x7e\x06\xf5\xe6\x70\xd1\xb4\xb6\x38\xf9\x0f\x5e\x32\xee\xf6\xfc\xbb\xb1\x0d\x69\x8f\x40\xa7\x3a\xfa\x2c\x5c\x8e\x44\xa7\x30\xb9\xb7\x5d\x5f\xc6\x9f\xcd\x47\xc1\x7f\x2a\x19\xb9\xbe\xac\x6a\x80\x30\xa5\xb9\x36\x96\x22\xa6\x4d\xc9\x02\xb5\x9b\x1c\x44\x16\x72\x72\x91\xd3\x7f\x49\x41\xae\xbe\x99\xf8\xa9\x9d\x7e\xe6\x8d\xc4\xd9\xa8\xd1\x69\x60\xa3\x6a\xc7\x51\xba\x00\x22\x07\x8c\xfe\xab\x54\xcc\x8a\x72\x51\x32\xe4\x45\x78\x6a\x55\x6e\xb4\x9f\x93\x4b\x6a\x28\x88\x87\xdd\xf2\x1e\x18\x2b\x1b\xd1\xc5\x70\x37\xa7\x5c\xa4\xfe\xab\x86\xdc\x77\x40\x11\x2d\x01\xfe\x38\x7f\x2b\xd3\x1e\x72\xda\x5f\xed\x34\x5f\x39\x08\x10\xa0\xfd\x92\xbc\x93\x82\x9d\x01\x99\x20\x96\x4e\xe0\xb8\x03\xfc\xfc\x07\xc5\x0d\x43\x11\xd9\x28\x46\x6d\x6f\x10\xb6\xb8\xc2\x9a\xcb\xa3\x99\xfc\x0a\xae\x04\xb8\x8f\x9e\x65\x4f\x33\x39\x45\x2d\xcf\xdf\xa3\xc7\x58\xd9\xc7\x0f\xd7\xbd\x16\xf6\xf1\xc3\xf5\xf6\x45\x3d\xd6\x84\x7b\xaa\x03\xab\xda\x40\x2d\xf1\x54\x89\xd4\x38\x01\x71\x05\x4e\x87\xfc\x8e\x3b\x5a\x1f\x3c\x7d\xf5\x69\xdc\x2d\xb9\x23\xe9\xb7\x93\xee\x77\x4a\xee\x87\x3e\x99\x3b\xbe\xab\x28\x6b\x3d\xda\xd7\xff\xea\x53\xe1\xca\x09\xfb\x6c\xc6\xc9\x82\x42\xed\x84\xaa\x01\x1d\xfa\x86\x58\x0c\xd4\x96\xef\x05\xd4\x23\xd4\x15\x93\x22\xe4\x92\xb9\xf8\xdf\xf4\x65\xa8\x74\x1f\x05\x75\x33\xd0\xb7\x54\xd0\xb9\x05\x09\x34\x9f\xe4\xee\x4f\x1c\xd0\xea\xc6\x9c\x38\xd3\xb2\x08\x8f\x13\x7a\x4f\x79\x16\x92\x7c\x34\xeb\xb6\x73\x7a\x88\x0d\xd3\x99\x86\x2d\x3c\x38\x11\xec\x2d\x38\xae\x99\xb8\xc9\x89\x85\x75\x0e\x26\xf4\xd3\x71\x25\x0f\xa2\x16\x0a\x81\x0d\x10\x35\xe2\x84\x4f\x7b\xe7\x2a\x81\xd2\x47\xb9\xac\xca\x93\x78\x54\xc7\x09\x6a\x31\x02\x46\x60\x1d\xc8\x9f\x7e\xfc\x70\x7d\x50\x51\xc4\xd2\xbd\x78\x51\xc4\x3e\xb5\x51\x14\x81\x2f\x26\xae\x54\xdb\x73\x95\x46\x06\x3c\xad\x87\xab\x0a\xd2\x4b\x74\x69\xc4\xa0\x54\xc5\x4a\x5a\x4d\xd9\x00\x23\x50\x7b\x31\xf1\xa4\xd3\x17\xf7\x73\x12\x91\xc5\x85\x98\xd2\xea\x48\x2a\x05\xc4\xaf\xd7\x82\x81\xf3\x10\xd4\x5d\x7d\x34\x75\xa4\x3e\x2f\xcc\x8f\xc3\x52\x0f\x45\x31\x12\x56\x2c\x66\x9d\x37\xac\x9d\x91\xca\x8a\xc5\xeb\x49\xdb\xf0\x6f\x3f\x23\xaf\x27\xad\xfb\xdf\xb9\x1c\xe7\x3d\xb7\x08\x06\xcb\xd2\xce\x5d\x70\xac\x49\xc6\x67\xcc\xf0\xce\x45\xc6\x50\x88\x5c\x0a\x6e\xa4\x8a\xcf\xbf\x6d\xd4\x76\x7a\xeb\x61\xb8\x80\xd3\x44\x66\x19\x4b\x90\x9d\x81\x21\x72\x09\x36\x29\x4c\x64\x93\x52\xcd\xbc\xc5\x6c\x7c\xf7\x27\x50\xab\xbd\x02\x7d\xee\x0e\xe9\xfc\xc3\xd5\xc5\xe5\xdb\xab\x71\x9e\x7e\xb9\x90\x0f\x23\x23\x47\xa5\x66\x23\x8e\x2a\x30\x15\xd1\xa0\x24\xb2\xe6\x4c\x4c\x63\x92\x02\x59\x28\xb2\x7d\x00\xef\x43\x4d\x1b\xf2\x51\xbb\x46\xb1\x60\xa0\x0d\xce\x7a\x29\x3b\xf3\x3a\xdc\x50\xd4\x67\x3f\x52\x6f\x27\x2e\xb3\xcc\x9d\x89\x51\x8c\x9d\x35\x4d\x48\xe7\xcf\x47\x92\x6a\x2c\xbf\x83\x59\xc5\x19\xe2\x30\xcc\x0a\x6b\x38\x6a\x31\xb4\xa7\x45\xec\x3e\xbc\x11\x23\x20\x91\x1d\xe7\x30\xa9\xa0\x84\xc0\x4d\x08\x07\x40\x94\x6b\x27\xbe\x36\x12\x54\xc0\x9f\x49\x05\xb1\x91\x6d\xcc\x63\x26\x81\x6d\x39\x87\x74\x43\x37\x61\x14\xdc\xcf\xb0\xed\x58\xfe\x0c\x8b\xe8\x55\x9f\x74\x75\xd3\x3f\xb0\x99\x6b\x0c\x18\x5a\x0f\x20\x37\x1d\x9a\xe6\x97\x66\xc1\x84\xb1\x5a\x21\x97\x22\x08\x38\x9b\x4e\x01\x05\xd2\xf7\x38\x7c\xd2\x6d\x8f\x2d\x5c\x37\x94\x6f\xfb\x25\x95\x6f\x8b\xaa\xbb\x88\x4b\x96\xd9\xc9\x4b\x55\x20\x5f\x8a\xa6\x52\xbb\x88\x69\x81\xae\xc2\xdd\x20\x5a\x34\xcd\xb9\x78\xb6\x04\x28\x46\x18\x0f\xd2\xd9\xc1\xc4\x6b\x2e\xd2\xee\x73\x5a\x29\xcc\x0b\xcf\xb4\xe5\x6b\x07\x27\x78\x2e\x43\x84\x48\xe7\x82\x68\xd0\xbb\x59\x6a\x85\x72\x1f\x4b\xe2\x22\x49\x82\x8b\x1b\x45\xc2\xf2\xa5\xfe\x29\x1b\xb9\x59\x8c\x8a\xb4\x3e\xb9\xe7\x18\xf6\xb1\x66\xe8\x45\xe1\x33\x7d\x64\x43\x2f\x3a\x50\xe3\x70\xe6\xde\x58\x7a\x7d\xf0\xd3\x27\x83\x98\x1c\x46\x1f\x31\xf9\x00\xfb\x1e\x27\x19\xef\x29\xa3\x41\x16\x8f\x0e\x4d\xd0\x5d\xe1\x50\x7c\xff\x51\x6f\x65\xb2\xa2\x71\x41\x15\xcd\x99\x61\xca\x05\xb4\xfb\x10\x7b\xc1\x12\x30\x20\xbe\x2f\x98\x98\x18\x9a\xdc\x3d\x4a\x69\xf5\x41\x66\xfa\xd5\xca\x4c\xfd\x63\x2d\x42\x48\x6b\x5a\xe1\x23\x87\xb4\x3b\x1f\x96\x74\xdf\x9d\x11\x14\x06\x17\x9e\x99\x3f\x15\x05\x78\xf6\xf1\x13\x55\x13\xae\x38\x2b\x64\xd5\x67\xa8\x2d\x28\x55\x1f\x7b\xc3\xa2\x2c\xb3\x6e\x39\xa9\x90\x45\x99\x51\xc3\x5c\x69\x2c\xd4\x69\xc6\x90\x15\x2f\x2a\xf7\x0b\x96\xa8\xc9\x6b\x2e\x53\x46\xa6\xbc\xaa\xca\x62\x45\xba\x44\x31\x6a\x90\x2e\x48\x90\x6f\xac\x2c\xe3\xe7\x33\x6e\x57\x14\x2f\x19\x99\x32\xf3\xc0\x98\x20\x5f\x59\xc9\x11\x05\xf3\xab\xff\xfc\xcf\xff\x6c\xa7\x75\x7c\xf5\xc7\x3f\xfc\x61\x4c\x2e\xa1\x9e\xaa\x54\xd0\x04\xba\xca\x93\x40\x81\x04\x63\x0a\x55\xcc\xf5\x87\x9d\xcd\x9c\xaf\x18\x44\x30\xae\x03\xcf\xf5\x39\x4e\x39\x9f\x2f\x90\xd1\x89\x10\x90\x6e\xd1\x23\xe3\x89\x71\x5d\x02\x1d\x99\x0b\x19\xed\xae\x5c\x00\xbc\x0f\x6d\x53\xb0\x5b\x0a\xe7\x72\x46\x32\x7e\xc7\xc8\xcc\xd5\x2c\xab\xdb\x89\x2b\xa6\xad\x92\x84\x8d\x22\x9c\xfa\xfc\xa2\xc6\x59\x6b\x66\x9e\x41\x08\x61\xbf\xe2\x95\xab\x05\x04\xa0\x7e\xcb\x1d\x5b\x8e\x1c\xba\x15\x94\xe3\xba\x6b\xf8\x20\x13\x88\x8a\x6a\xb5\xbd\xaa\x0c\x41\x69\x4d\x10\x50\x10\x43\x11\x82\x42\xc9\x7f\x3a\x04\xe3\xc2\xfb\x26\x83\x92\xa5\xbd\x4a\xe0\x3a\x35\xa3\x65\x87\xa0\x54\xdf\x31\xd7\x56\xc6\x27\xbd\x87\x8f\x5d\x27\x44\x48\x01\xae\xf6\x05\x8d\x6e\x19\xd7\x76\xaa\x77\x6c\xa9\x77\xad\x20\xae\xe3\x35\xd4\x3e\x74\x18\x5b\x8a\xb5\x37\xf8\x7e\x8c\x9e\xc4\xe2\xa4\x67\xe8\x1f\x7a\xe7\x9a\x3c\xd7\x89\xc8\x2e\x97\xcc\xb7\x72\x0c\x95\x4c\x91\x6d\x9e\x5a\x9d\xe5\xce\x9a\xc7\xa4\x99\x29\x0b\x37\x55\x08\x03\xb7\x6b\xc0\x34\xe3\x24\x80\xd4\x76\x3a\x39\x55\x77\x56\x3f\xf6\x74\x76\x0c\x09\x71\xba\xaa\x5f\xaf\x58\x46\x0d\xbf\x47\x2a\x91\x50\x52\x72\xd9\x4a\x86\xb5\x93\x3d\x1e\x8f\x8f\x1d\x61\x93\xca\xd7\x64\xb3\x14\x08\x05\xd3\x3e\x8b\xd9\xf7\x08\x1f\x4f\x3b\xed\x91\x16\x16\xd5\x7d\x5f\x56\xdf\x26\x92\x36\x53\xdc\x90\xa2\x1b\xf5\x67\x82\x43\x92\x58\xb9\x3c\xaa\x0d\xc7\xf6\xfe\x97\xfe\xba\xe0\x5b\x97\xf7\xe8\xe9\x93\x23\x19\xfd\x86\x99\xee\x66\xf7\x68\x98\xc4\x31\x4b\x4b\xbe\xce\x2a\x4c\xee\xcf\xe5\xdd\x70\xbc\xfe\x7a\xe6\xb2\x94\xdb\x65\x61\xfc\x6d\x6c\x48\x3a\x11\x80\xdb\x15\x61\x6a\xc6\xbe\xc2\xb4\xa3\x20\xee\x62\xef\x15\xeb\x8e\x80\x88\x63\xf2\x58\x63\x93\x1b\x7d\x59\xbd\x1b\xb1\x0c\xdf\x8d\x3e\xad\xad\xb0\x7e\x5b\x37\xd6\x6e\x5e\xa0\xa0\x9e\x00\xce\xfa\x6c\xbf\x91\x24\x07\xa1\x3e\x5c\x64\x57\x8e\xc3\x73\x27\x2a\x08\x9d\x6a\x99\x95\x9d\xd5\x37\x57\x97\x55\x83\x69\x92\x6b\x98\x28\xcb\x18\xa4\xcc\x62\xe9\x6f\x18\x01\x60\x4d\xe6\x1d\x53\x76\xf4\x35\x0e\x5a\x34\xf1\x89\xeb\x87\x82\xef\x88\xe2\x7e\x5b\x60\x9b\x53\x46\xa9\xe5\x71\x41\x04\x58\xfb\x4c\x9c\x6d\xe6\x73\xd8\x5c\xf0\xf6\x16\x67\x47\x41\x01\xc5\xda\x5a\xa2\x30\x2b\xa6\xad\x50\x54\x87\x60\x74\x57\x21\x10\x48\xe3\x5b\x0a\xc5\x18\x41\xf1\x06\x0b\xcd\xe3\x4c\x15\x93\x6b\x72\x52\xb7\x5b\x0c\xa1\x71\xd7\xc2\x30\x35\xa3\x09\x3b\x6d\x98\x30\xba\x0d\xb5\xfe\xe9\x90\x2d\xbc\xa0\x22\xcd\x42\xce\x34\x61\x9f\x0c\x53\x82\x66\xf0\xce\x54\xf1\x7b\x04\x8d\x3f\xb9\xc8\x8a\x05\x0d\xd5\xcb\x3b\x0d\xe4\x51\x86\x0f\x98\x41\x7c\x54\x26\x3c\xb6\x52\x15\xcb\xe1\x0e\x7e\x59\x24\xf4\xf9\x71\x3b\xa4\x9b\xb6\x9d\xb1\x45\x42\xe0\xd9\x40\x9e\x97\xb2\x54\xce\x89\x89\xb3\xa3\x54\x45\xee\x95\xb2\xd2\x04\x4c\x90\x6a\xa2\xd8\xdc\x6a\x4f\xca\x15\x5d\x82\x5f\x64\xa5\xfd\xe0\xe0\x71\xf7\x07\xcf\x88\x68\xe4\x21\xa0\xb6\x60\xd5\x79\x35\xf3\x4a\x9d\xbc\xe7\x69\x10\x09\x21\x40\xc2\x89\x9c\x38\x6d\x4c\x93\x82\xea\x46\xaa\x3a\xd5\x5a\x26\x1c\x2a\xee\xd4\xe7\xee\xd5\x48\x44\x49\xd9\xb0\x01\xbe\xe0\x4f\x2b\x4d\xb0\xe9\xea\x93\x50\x20\x11\xd5\x43\x2f\xea\x90\x84\x4c\xd9\x4d\x39\xcd\xb8\x5e\x4c\x7a\x7b\x57\xde\x6d\x00\xe2\xe2\x0f\x2b\xd3\x07\xee\x26\xc8\x66\x0b\x42\x47\xe4\x9a\x1e\x17\xcd\x84\xe6\x20\xa4\x59\x76\x68\x05\x4b\x6c\x60\xa3\xd5\xa9\xa8\xae\xea\xae\x35\x4e\x0a\xbc\x36\x79\x91\x31\xc3\xc2\x57\x28\x88\x8d\x35\xfb\x22\x13\x56\xd0\xb6\x9f\x7e\x14\x45\xeb\xf3\x84\x66\x19\x2a\x84\x9a\xac\x94\x44\x0a\x0c\xcd\xc9\xf0\xa1\x00\x85\xc3\x58\x6e\x91\x19\xe7\xdc\xf5\xbd\x2a\xab\x9e\x11\xae\x9e\xe5\xd6\x8d\x46\x36\x66\x97\xae\xc8\x80\x20\x52\x04\x40\x67\x50\x9b\xd3\x03\xad\x4b\xb7\x10\x8a\x0c\xc7\x76\x17\x0b\xb3\x53\x83\xbb\x0c\xfb\xda\x5f\xbd\xbb\xac\xb7\x33\xbe\x6e\x63\xdc\xec\x2b\x82\x6d\xce\x57\x8f\xc0\x68\x03\xd3\xde\xdf\xb3\xdf\x27\x37\xfb\xc2\x18\xc5\xa7\xa5\xc1\xdd\x08\x9a\xa6\xdc\xd1\x96\x9b\xc8\x9b\x14\x79\x94\x1b\x8a\xf1\xd4\x33\x05\x31\x91\x69\x4f\x89\x47\xa1\x26\x70\xa4\x61\xce\xdb\x4c\x14\x5b\x61\xcc\x35\x8d\xc7\xd1\xde\x20\x6c\x81\x9c\xe5\x1e\x3c\xd6\x24\x95\x49\x69\x15\x6c\x5a\xd5\x60\xa8\x02\x6e\x50\x50\x5d\xf6\x20\xfe\xd4\x51\x58\x1f\x95\xe7\x85\x11\x44\xd1\xef\x4e\xe5\x83\x78\xa0\x2a\xbd\xb8\xe9\x4c\xcd\x6d\x0b\xcb\xf5\x73\x4d\x57\x68\x00\x47\xec\xe7\x74\x2a\xcb\xee\xfb\xee\x13\xb3\x9a\x9e\xd3\xc1\x33\x3a\x78\x46\x07\xcf\xe8\xce\xd1\xd3\x33\x6a\x9f\x72\x82\x7c\xc6\x35\x34\x6a\x69\xdd\xd8\x88\x88\x12\xa4\x25\xb5\xaf\x7b\xa8\x41\x5f\x1c\x97\x59\xc9\x14\x45\x0a\x2a\x0d\xb5\x02\x14\x03\xb8\x9d\x35\xa6\x34\x54\x11\xec\x6d\x20\x40\xac\x40\xdc\x7a\x24\x87\x13\xc0\x46\x6a\x6d\x6e\x6c\x4b\x1d\xab\x1b\xdd\x07\xf7\x75\x94\x2b\x07\xe8\xf2\x4b\x02\x62\x14\x15\x42\x3a\x9e\xa9\xcf\x5c\xa3\x03\x7d\xd6\x55\x41\x70\x75\xd8\x3b\x58\x35\xcc\x77\x1d\x86\x03\xeb\x8d\x70\x40\xc4\x6f\x28\xbc\xbb\x92\x8b\x63\x9e\x5a\x95\x77\x1c\x84\xaa\xb3\x7e\xb2\x60\x39\x8d\xc0\x9c\x30\x5e\xdb\xd3\x80\x42\x99\x5c\x13\x2b\x46\x1a\xe6\x4a\x6b\x31\x95\x6b\x22\x67\x55\x56\x08\x5e\x2d\x70\xc3\x48\x72\x74\xff\xe2\x28\xc6\x6f\xd0\xcb\xd5\x48\x02\x9a\x62\xca\xbe\xb6\xc7\x7a\x11\xd8\xca\x35\x63\x51\xd4\x48\xa2\x01\x6b\x23\x57\xee\x4d\x5f\xb5\xdf\x1f\xc8\x99\x3b\xaf\x27\xd8\x8e\x58\x07\x08\x01\x51\xae\xda\xc2\x48\xcf\x4c\x44\x78\xeb\xe0\x16\x1e\xdc\xc2\xb8\xf1\x1b\x70\x0b\x37\x58\x63\xa0\xbe\x7e\xcb\x22\x82\x6d\xea\x59\xb4\x7c\x04\x20\x4f\xb8\xb4\x08\xaf\x03\x38\x71\x3e\x02\x66\x70\x2b\x7b\x9f\x32\x91\xaa\x1d\xcd\x73\x3c\x1e\x1f\xbb\x78\x9e\x28\xc7\xb0\xbf\x70\xa5\x99\x8d\xfe\x44\x98\x48\x64\xea\x10\xdb\xce\x59\x69\x03\x22\x5a\xbc\x68\xd0\x76\xaf\xe7\xa1\x16\x7f\xc3\xfd\xec\xe6\x8b\x47\xa1\x1e\x84\x37\xd4\x9a\x7b\xbd\xa7\xc8\x54\x0b\x4a\x55\x5d\xdc\xd5\x9e\x1f\x31\x94\xdd\x35\xb4\xf5\x90\x34\xc9\x78\xce\x8d\x6b\xfc\x6b\xf9\x04\xd3\x46\x93\x13\xf7\xe1\x38\x29\x4a\xbc\xa1\x8e\x78\x50\xe3\x9c\xe5\x52\x2d\xcf\x2a\x70\x16\x4c\x0b\xbe\xff\x05\xa6\x55\x4e\x18\x56\x1e\x4b\x4a\xa5\x98\x30\xd9\xf2\x09\x25\xb3\x6a\x87\xb1\xb5\x34\xea\xb1\x92\xc1\x56\xf9\x3a\xc1\x3a\x5c\xf1\xe4\x48\x49\x62\x56\x95\x0d\xd5\x67\x95\xc5\xde\xf5\x01\x14\xf7\xe4\x9e\x2a\x8d\xdf\x11\xd2\x5f\xbc\x4a\xf9\x3d\xd7\x32\x0a\xed\xac\x84\xbd\x7c\x1f\x71\x09\xdc\x18\xf5\x22\xc3\xab\x4f\x47\x2f\x70\xab\xf5\x16\xfa\x7d\x95\xa6\x28\x8d\xe7\x2a\x91\x33\xf2\x37\x37\x54\xa0\xae\xee\xe1\x59\xb3\x76\x55\x24\xcc\xa3\x17\x47\x51\x4f\x14\xd4\x18\xa6\xc4\x4b\xf2\x3f\x27\x7f\xfb\xfd\xcf\xa3\xd3\xaf\x4f\x4e\x7e\xfc\x6a\xf4\x5f\x7f\xff\xfd\xc9\xdf\xc6\xf0\x9f\x7f\x3b\xfd\xfa\xf4\xe7\xf0\xc7\xef\x4f\x4f\x4f\x4e\x7e\xfc\xee\xed\xb7\xb7\x37\x57\x7f\xe7\xa7\x3f\xff\x28\xca\xfc\xce\xfd\xf5\xf3\xc9\x8f\xec\xea\xef\x48\x20\xa7\xa7\x5f\xff\x2e\x6a\x9a\x9f\x46\x8d\x46\x5b\x5c\x98\x91\x54\x23\x77\x90\x2f\xa1\x05\x45\x04\xb0\xb0\xcd\xfb\xdc\xe0\x9a\x3d\xd7\xe5\xc9\x83\x3e\xf0\x04\x97\xae\x9f\x10\x1f\x55\xef\x94\xf4\x91\xe1\xe3\xa3\xab\x3e\x7f\xc4\x14\x1a\x30\x78\x3f\x2f\x79\x64\xb6\xf2\x95\x7f\xaa\x9d\x86\x63\x58\x5e\x48\x45\xd5\x92\xa4\xde\xdc\xd9\x1d\xd8\xb5\x5e\x09\xa8\x51\x0a\x68\xef\x22\xb4\xb0\xba\x94\xab\x43\x26\x2c\xe7\x2c\xe5\x65\x47\x07\xfe\x4d\x7b\xf6\x03\x54\x90\xf7\x15\xea\x43\x40\x8f\x03\x86\xcd\x58\xb2\x63\x4a\x93\x3b\xa7\xd0\x55\x9b\xec\xa4\xc9\x66\x31\xe4\x23\x1f\x30\x91\x33\x8a\xf4\x3f\x7b\xb5\x12\x42\x6f\x64\xca\xec\x29\x04\x80\x6e\x8e\x0d\xeb\x38\x4e\xf5\x74\x7e\x75\x1f\x00\x79\xe2\x81\x9d\x5a\xa9\xfa\x2d\x08\x47\x4f\x76\xb8\x24\xba\x74\x09\xff\x17\x7b\x63\x25\x3d\x94\xeb\x0f\xcf\xf3\xfb\x70\xfa\x68\xfe\xbe\x52\x3e\x5e\x1a\x9a\x11\xea\x2b\x74\xcd\x48\x26\x13\x9a\x55\xd8\x17\x25\xa2\x39\xbf\x2c\xd7\xa4\xba\xfc\xc1\x43\x6b\xb1\xcf\xee\x99\x13\x8f\xc1\xbe\x9d\x69\x1c\x8b\x87\x2e\xa5\x09\xf4\xa6\xb1\xf0\x9d\xd8\x5c\x61\xdc\x2d\xd4\xe4\xff\xc4\xf3\x32\x27\xa5\xc6\x15\x99\xb5\xe2\x7f\x1b\x4e\x3d\xe1\x87\xd0\x66\x07\x6a\x49\x71\x61\x01\xe3\xbd\x8e\x95\xad\xc4\x3e\x3d\x09\x38\xd2\xb0\x79\x41\xda\x38\xd6\x8c\x02\xe6\xb2\xd2\x6a\x7e\x61\xb6\x5e\x4f\x91\xb3\x95\x96\xac\xd8\x74\x19\xea\xda\x7c\xac\xd0\x02\xc1\xb3\x26\x31\xa8\x1a\x59\xa0\x40\x56\x07\x5a\x0a\x1f\x7e\xb9\x76\x6b\x37\x5f\xda\x52\x33\x35\x9a\x97\x3c\xed\x77\x5d\x9f\xb5\xfc\xb6\xa7\xd4\x86\x66\xcd\xb3\xce\x16\xb1\xed\x38\xbf\x57\x6d\x6e\xfc\x9a\x4f\x15\x23\xaf\x16\x54\x08\x96\xe1\xc5\xa4\xdd\x0d\x69\xda\x55\x44\xaa\x7e\x33\x9d\x50\x37\xf4\xa3\x39\x20\x4b\xfe\x75\xd7\x10\x79\xd2\x62\xd1\x75\xbb\x15\x9c\xd3\x10\xd5\x92\x65\xbd\xdd\x0a\x9a\x44\x3e\x56\x4b\x96\xac\xec\xd1\x8c\xa5\xb6\xc7\xbf\x7e\x45\x0c\x55\x73\x66\x2c\x20\x22\xca\x7c\xca\x50\x84\xed\xb9\xb6\xfc\xf8\xa5\x15\x52\x39\x7c\x2d\x14\x77\x9c\x3f\xfc\xf0\xae\x47\xb5\xd0\x4d\x78\xf1\x20\x55\x96\x3e\xf0\xd4\x85\xb2\xe3\x84\xf0\x13\xfb\xfa\xd3\x5f\x4e\x69\xcf\x87\x07\x9e\xee\xbb\x5d\xde\x59\x04\xdb\x45\x60\xbf\x7c\x91\x0d\xce\x3a\x2b\x72\xb9\x71\x02\xd3\x38\x25\x57\xdc\xb5\x57\xb5\x7f\x39\xe3\x7d\x3e\xe5\xc2\x05\x1f\xc8\x59\xe3\x80\x71\x54\x5d\xa4\x70\xb7\x83\xc3\x0c\x1a\xb4\x4e\x4b\x67\x66\x87\x0e\xce\x9a\xe7\x65\x66\xa8\x60\xb2\xd4\xd9\x12\x85\x92\xcf\xe1\xd8\xf0\xf2\x47\xc6\x3e\xb9\x4b\x17\x27\x87\x54\x8f\xb5\xe5\x91\x39\x13\x4c\xf1\x04\x1b\xe4\xb2\xd6\x21\x0f\xf2\x0c\x34\x97\x82\xa5\xe7\x95\x70\xe2\x9a\x51\x42\x0e\x0c\xa2\x8b\xfc\x94\x5a\x19\xa4\xc8\xca\x39\xef\xf4\x4a\x7f\xd6\x8c\x97\x2a\x96\x1e\x85\x2a\x56\x71\xaf\xb4\x33\x7c\x36\xf6\xd0\x8e\xa3\x53\xc2\x6a\xe8\x52\xb1\xd3\x4c\x59\xc1\x84\x25\x44\xa2\x79\x23\xdc\xd6\x1c\xfc\x74\xbc\x5f\xfb\xf9\x86\x2a\x37\x68\xfe\xd5\x27\xa3\xa8\x25\xce\xb9\x25\xb1\xc1\x23\xcf\x71\x0e\x50\x2a\x70\x84\xf6\x69\x42\xde\x07\xb1\xe9\xb9\x94\xf9\x7d\xfc\x14\x27\xc7\x35\xfc\x05\xd6\x21\x32\xa5\x91\x42\x84\x3b\x90\x19\x11\x72\x65\xb6\xcd\x7a\x29\x2b\xa9\x44\x38\x7b\x57\x3b\xdd\xa8\x33\x95\x08\x29\xff\xac\x25\x00\xac\xec\xc0\x50\x86\x6f\xc8\x2b\xfa\x05\x64\x58\xcc\x32\x99\xdc\x45\x56\xe3\x7d\xed\x9e\x59\xb1\xa7\xf9\x0f\x63\xcb\xf1\xee\xb0\x9f\x79\x2a\xd2\x10\x15\x2c\xfa\xfa\x17\x75\x02\xb6\x17\x5e\x49\x7b\xab\xa1\x4d\x0f\x99\x32\x4b\xf0\x54\x29\x44\xf7\xf1\x45\x49\xb7\xd4\x50\xdd\xb3\xb9\xcc\x6a\x4f\x3c\xcd\x8c\xcb\x52\x82\x96\x0e\x39\x33\xd4\x7e\x8a\xf3\x84\xfc\xc5\x8b\xc8\xa2\x05\xcd\x0a\xbe\xe1\x68\xea\x3e\xfa\x89\x14\x9a\xa7\x0c\xeb\xdb\xa0\x70\x08\x8a\x25\xc8\xe4\x91\xb8\xd6\x7b\x6e\xa6\x1f\x3f\xf6\xa8\x41\x69\x1f\x5a\xd9\x3f\x8f\x34\x60\x94\xe7\x3f\x61\xd3\xac\x2b\x9d\x1a\xfc\x0b\xd5\x8e\x79\x98\x87\x5d\x32\xfa\x6e\xce\x13\x76\x53\xb5\x2f\x8d\x6e\xa6\x79\xfc\xed\xab\xab\xf6\xe3\xed\x0b\xfb\xed\xab\x2b\x5c\x37\xcd\xb8\x66\xec\xe1\xf2\x76\x82\x7d\xcc\x66\xec\xf3\x84\xd5\x8d\x5f\x53\xae\xef\x9e\x63\x49\xee\xa1\x13\xfb\xb3\xec\xc4\xbe\x86\x3b\xa8\x19\x0d\x6d\xd8\x87\x36\xec\xbf\xbc\x36\xec\x4f\x4e\x67\xc9\xa3\x3b\x79\x8a\xb4\x97\x24\x16\x3a\x87\x37\xcd\x8c\x37\x97\x35\xef\x43\x06\x19\x7c\xfb\xea\x6a\xec\x5a\x72\xad\x96\xc3\x0e\x8d\x81\xe1\x17\x3d\x7b\x10\x3c\xfa\xd1\x3c\x51\x5f\x02\x94\x25\x07\x67\xa4\xdc\xd2\x41\x72\xdd\xe0\xe4\x36\x1d\xeb\x09\x7e\xca\xfb\xf0\x58\x2d\x57\xdd\x4d\x38\x98\x30\xca\xcd\x07\x56\x74\x22\xec\x8a\x08\xea\x1e\x5a\xf1\x74\x70\x63\x3f\x90\x9a\x1b\xa9\x96\x04\x21\xf6\x50\x47\x10\x93\x32\xa3\x56\xeb\x74\x7e\x8e\x31\xb9\xbc\xba\xf9\x70\xf5\xea\xe2\xf6\xea\xf2\x25\x09\x6f\xe2\x4d\x55\xa5\x5b\x4a\xb9\x95\xb5\xe3\xc4\x55\x4a\xf7\x41\xfa\x90\x23\x52\xcf\xf5\xcc\x77\x71\x44\x50\xdc\x2a\x9c\x0a\x4a\x0f\x53\x41\xae\x05\x37\x75\xf4\xbf\x6b\x1e\x9a\x49\xe1\x63\xc9\x2d\xf4\x4e\x98\xce\x8f\x33\xe7\xe6\xcc\x89\xcc\xbe\x6f\xec\x82\xad\xbc\x0d\xa8\x16\x04\x89\xa2\xd4\x63\x98\x51\xa7\xa5\x2a\x4a\x19\x0e\x41\x97\xf1\x9d\xd4\x9d\x5f\xb8\x02\xe0\x39\xf5\xdb\x90\xba\xe3\xe7\x8b\x33\xf9\xa9\x56\xa6\xcf\x78\x7c\x3c\x86\x02\xcc\xc7\xe3\xe3\x20\xe2\x66\x2b\xe9\x78\x28\xb8\xf5\xe4\x42\x1a\x9e\x05\xd0\xc6\xe7\x31\x21\xef\xcd\x82\xa9\x07\xae\x91\x4d\xa3\xf8\x6c\x4b\x86\x20\xbc\xa4\x99\x63\x35\xe7\xd8\x90\xec\xea\x76\x85\xcc\xd3\x72\xda\x9c\xbc\x2f\xbf\x39\xe7\xf7\x88\xe0\x24\xe2\x2d\x8f\x07\x77\xcb\xd4\xd3\x8c\xc6\x96\x0f\xf5\x0a\x3f\x7e\x78\x73\xf8\x89\x39\x8a\x10\x3d\xad\x57\x32\xcf\xb9\x21\x0b\xaa\x17\x55\xa1\x9e\x3a\x00\xb2\xa2\x5c\x87\x9d\x6f\x0c\x4f\xa8\xb7\xfc\x60\x7c\xc1\x95\xf3\x8b\xec\x97\x7b\xfc\x6d\x78\x6c\xc5\x28\x51\x7d\x8c\x13\xee\x9b\x5d\xb5\xd1\xb1\xf2\x9d\x50\xbb\x5a\xb5\x55\x6b\x7e\x9c\x9e\x5f\x4c\xa4\xae\x6b\x50\xbc\x64\x75\x15\x1e\x7d\xd7\xa8\xba\x1f\xe0\xe1\x2b\x22\xc0\x56\xa6\xcc\x50\x9e\xe9\xc6\x99\x18\x59\xc8\x4c\xce\xe3\x3a\x3c\x6e\xd8\xac\x2f\x5d\x0e\xea\x88\x8e\xec\x29\x1d\x5e\x8f\xed\xd3\x51\x37\xa4\xdc\xda\x0d\xab\x57\x5c\xe9\x63\xd8\xac\xd6\xe7\xbc\x31\x8f\x24\xb0\x57\xbb\x15\xa3\xe1\x3a\x0b\x4e\xe8\xc3\x07\x1c\xa9\xae\x8a\x56\x30\x95\x73\x6d\x49\x25\xb2\x90\xe1\x16\x69\xff\xc9\x0f\xe2\xf0\x42\x7c\x45\x0b\x30\x02\x7f\x77\x5e\x15\x9a\xac\x5b\x8a\x8a\x29\x9f\xd1\xc6\x93\xbf\xfa\xa7\xda\x44\xbd\x50\x6c\xc4\x3e\x71\x0d\x9a\x1a\xaa\x96\x80\x54\x0d\x59\xab\x49\xe3\x43\x64\x75\x30\x45\xbb\x5f\x21\x94\xc5\x15\x23\x73\x2d\xfd\x56\xee\x02\x08\xc0\xa2\x59\xb6\x74\xed\xac\x66\xb2\xdb\xd6\xe0\xad\xb9\x74\x0e\x0b\x95\xca\x3b\x27\x0b\xc5\xef\x79\xc6\xe6\xf6\x75\x0b\x2e\xe6\x75\xcd\xb8\x6e\x4d\x27\xcb\xe4\x03\xf3\x85\x46\xd8\xda\xba\x2d\x5a\x6b\xd3\x4c\x7e\x40\xd5\x7c\x7d\xf7\xfe\x96\x08\xe6\xa6\xb3\xb9\xcb\x5e\x8c\x92\x6b\x27\x84\x4a\xe1\x1b\x8d\x46\x60\x17\x3d\xf9\xa7\xd5\x76\xd2\xec\x94\xfc\xc0\xfc\x3c\xac\x4a\x68\xa9\x56\x62\xc8\xc3\x42\x42\x39\x88\x12\xd1\x4f\x06\xf6\xa2\xc6\x0c\x20\x1f\x2e\x75\xdd\x43\x39\xb7\x90\xac\xba\xe0\x34\x24\xfb\x7b\xb4\xce\x02\xb5\x19\x35\xa9\xe3\x52\x0e\xaa\x19\xed\xc1\x95\x82\x83\x6b\xd3\x8d\x40\x37\x9f\xa9\x4a\xf8\x43\x7d\x2d\xbd\xcc\x33\x2e\xee\xce\x08\x37\x81\x9c\x5b\xcc\xf3\x0d\x75\x04\xce\xe6\xed\xef\x92\x62\x34\x0b\x7d\x03\x0e\x83\x59\x07\xe7\x7c\xa6\x97\xc3\xe6\x76\x59\xb8\xc8\xc1\x8a\xac\xf9\x30\xb5\xcb\x98\xfa\x47\x46\x92\xa3\xa3\x67\xba\x33\x51\xe6\xa4\x03\x72\x17\xae\x63\xeb\xc5\x1f\x5f\x4f\x5e\x4d\x5a\xc5\x1c\xa9\x20\xee\x33\xef\xda\x3c\x48\x02\xcf\x86\x0c\x1e\x04\x44\xbc\x13\x73\x9b\x08\x02\x1b\xf2\x38\xda\x44\xb2\xa0\xc5\x45\x69\x16\x97\x5c\x27\xf2\x9e\xf5\x50\xb6\x43\xa3\x02\xef\x1a\x24\x3c\x6c\xbb\x83\x47\x5e\xfd\xf5\xe2\x06\x17\x47\xd0\xea\x36\x7f\x60\xa9\xaa\x5e\xe9\x84\xe9\x5e\xda\xfb\xe6\x75\x7a\x68\xcf\x66\x95\x83\xfb\xf9\x57\xed\x7e\x06\x4a\xf0\x5b\x71\x39\x73\xc1\x0d\xa7\x46\xa2\xab\xeb\xb4\xad\x6d\xa5\x36\x32\xf7\xd7\xf4\x3a\x80\x82\xa0\x2a\x70\x1d\xb7\xa0\xe3\x4c\xb1\xad\xde\x7c\x1c\xa4\xa5\x44\xf3\xaa\x2b\xc9\x4a\x52\x0b\xce\xbe\x2b\xd8\x83\x9f\x23\xaf\xe0\xfc\xb7\x4f\x83\xb2\xb8\x4e\xb3\xbf\xbc\xfc\xef\x86\x7b\xfa\x2f\x38\x4f\xb9\xb7\x40\xfb\x72\x5e\x8d\x06\x1c\xd0\x13\xfa\xf0\x66\x46\x42\xf8\x4f\xf1\x34\xd5\x9b\xf5\xdd\xfa\xff\x57\x49\x33\xb7\xb7\xef\x1e\xc3\x9e\xdc\x3e\xab\xe8\xa9\x06\x3c\x0a\x67\x04\x26\x34\xa0\x67\xe5\xff\xcf\xde\xf5\x35\xb7\x6d\x6b\xf9\xaf\x82\xe9\xee\x8c\xed\xad\x65\x27\xf7\x66\x33\xd9\xbc\x74\x1c\x3b\xbd\xe3\x69\xd2\x78\x23\xb7\xfb\xd0\xdb\xbd\x03\x93\xb0\x85\x35\x45\xb0\x04\x29\xc7\x33\xfd\xf0\x3b\x38\x07\x20\x41\x89\x94\x0e\x20\xca\x71\x33\xc2\x4b\x1c\x49\x3c\xc4\xdf\xf3\x1f\xe7\xa7\x05\xe8\x1b\xf0\x0b\x1a\x87\x28\x79\xae\xcd\xe2\x76\x23\x91\x07\x36\x81\xe1\x80\x1d\x56\x49\x11\x50\x9d\x7d\x87\xd7\x36\x71\xd8\x76\x9d\x3e\x34\xd7\x35\x29\x7d\xdb\x71\x24\x1f\xce\x46\xb8\x13\xb4\x33\xa0\x2b\x20\xc2\x3e\x48\x5d\xe1\x7d\x15\xa4\x4a\xe5\x06\x02\x2f\xcd\x19\x5d\xf3\x0a\xa0\x99\x8a\x7f\xf1\x34\x2d\xdf\xa2\x7e\x60\xad\x2a\xf8\x9b\x66\x0c\x28\x8b\xf8\xc4\xf3\x26\x97\xe5\xb0\x7a\x2c\x64\x02\x8e\x87\xeb\xf3\x2b\xa0\xa6\xd9\x9b\xd7\xf4\x8a\x93\x7f\xff\xdb\xeb\x17\xa4\xad\xf4\x1c\xae\xd7\x6d\xe1\x85\xfc\x2a\x59\x03\xa3\xab\x6f\xf1\x37\x42\x8c\x02\x6a\xef\x82\x00\xb7\xc7\x7d\x6e\x05\x09\xcf\xd3\x56\xde\xed\x46\x4d\xdd\xdf\x56\xa0\xbe\xf6\x1b\xbf\xad\xe0\x6e\x83\x23\x6f\x1d\x83\x3f\x23\x6b\xb6\xbc\x9a\xc8\x4a\x03\x58\x33\x2e\x03\x35\xf2\x45\x63\xcd\x74\xb6\xbb\x23\x7f\x8c\xfc\x63\xf3\xa1\x9d\x18\x8d\x80\xf0\x2b\x7f\x3d\xc7\xf2\xf1\x50\x8e\x7f\xf7\xd8\x23\x2f\x3e\x38\xd0\x7e\xee\x07\x14\x63\xbe\xf8\x79\xfa\xaf\x0f\x67\xef\xde\x7f\x20\x09\x45\x4c\xfc\xf7\x80\x24\xa2\x72\xca\xe9\x7c\x61\xd3\x89\x24\x6f\x80\x3c\x34\x8a\xfe\xf3\x8f\xd3\x25\x97\x98\xf9\xc4\x96\xb3\x6a\x9d\xc4\x34\xa7\xd8\x50\xe8\x7c\x5b\x8b\x36\xbf\xdd\x38\x43\x4f\xe4\x48\x77\xbe\x3f\xf1\xa5\xeb\x63\x30\x93\xa6\x45\x49\xc6\xee\xd9\xfd\x84\xb0\x67\x12\xdb\x35\x33\x83\xb3\x15\xe0\x18\x21\x44\x75\x83\x5d\xe8\xc3\x51\xdd\x9d\xcd\x3c\x5d\xa3\x2b\x63\xca\x1b\x1c\x4c\xe1\x39\x97\x73\x60\xce\x29\x26\x1f\x97\x46\xa0\x19\x51\x46\x85\xec\xb7\x9a\x92\xb7\x8b\x9f\xdf\x0e\x1d\x39\xd2\x60\x7e\x86\x63\x1d\x4b\x5c\xb5\x79\xbb\x28\x86\xce\x33\x2e\x37\x96\xb1\x5c\xe2\x31\x7d\x24\xf0\xcf\x29\x66\x91\x07\x20\xc4\xf0\xce\x4d\x6f\xc6\x59\x2f\xf5\x26\xbf\x8f\xe2\xe1\x6a\xd0\x4d\xa2\xf7\x47\x3b\x49\x13\xb7\x55\xda\x8f\xf0\x93\xc4\x74\x6b\x54\x86\x0f\x14\xe3\x92\xfa\xcf\xdd\xa3\xcb\x55\x44\x06\xa6\x93\xe6\x16\x68\xa7\xdc\x07\x8c\xd1\x0d\x56\x58\x6d\xfd\xa6\x41\xe5\x46\xa2\xa5\xca\x68\x6b\xc2\x9e\x4c\xe6\xfc\xcf\xfa\xda\x0d\xd4\xcb\x16\xbd\xb6\xfb\x6e\xec\xf6\x10\xf6\xd5\xec\xd7\xd1\x58\xd3\x4c\x55\x2a\xdf\xe2\x2a\xe8\x55\x0f\x81\x6e\x8a\x0e\xfe\xe2\x1c\xaf\x4c\x67\x14\xe7\x5c\x43\x0c\x6f\xb6\x34\xe1\x54\x63\x1a\x39\x05\x40\xe5\x2e\xb0\xaa\x69\x7a\x28\xed\xee\xe8\x13\x5c\xd6\xfc\x8b\x17\xe6\xd9\x45\xe9\xc3\xb1\xb9\x47\x91\x46\x5c\xf8\xbe\xbc\xb0\x4a\xbc\xbb\xb0\xad\xed\xd6\x65\x01\x7b\x97\xad\xee\xdf\xaf\xa8\xec\xa4\x97\x17\xa3\x31\x0a\x55\x56\x0f\xaa\x8c\x29\x4b\x76\xd5\x79\x74\x29\x7f\xcf\x7e\x47\xbd\x2a\x41\xe2\x05\xcf\xe8\xac\x4f\x21\xd1\xc7\x1b\xf2\x52\x79\x4e\x33\xff\x54\x3b\x05\x6d\xef\xd6\x73\xb1\x8e\x01\x10\x1d\x52\x43\x31\xf0\x55\x06\x40\x4c\x06\x5b\x8d\x7a\x3f\xe9\xc1\x8f\x56\x1b\x36\x14\x91\x0a\x8b\x01\x50\x8a\x48\xad\x04\x08\x46\xb7\x1d\xf1\x44\x45\x30\xc2\x5f\xed\x83\xd6\xd9\x65\xd6\xb2\x65\x89\xbc\x39\xce\xa4\xa9\x20\xdf\x80\xda\x11\x0b\x74\x93\x30\x1a\x1b\x2c\xd5\xff\x01\x0c\x6c\x10\x07\x44\xf4\xd1\x5b\x55\x42\x2d\x28\x89\xe5\xa1\x5a\x98\x24\x5b\x1c\x6a\xb3\xc7\x1e\x21\xbd\xe7\xbc\xd0\x88\x7a\xe6\x43\x98\x8e\xc8\xec\xb6\x41\x09\xfe\x38\x0c\x0d\xdc\xc0\xfe\x92\x76\x0e\x0d\x1a\xd8\xc1\xfe\xb6\x79\xb6\x24\xe2\xbe\xff\x96\x04\xf2\x4b\xa2\x3a\x08\x99\x37\x04\x84\x47\x0b\xa6\x75\xc1\xf2\x08\x10\x78\x24\xb2\x08\x93\xd7\x0b\x7e\xf7\xd5\xc3\xf3\xf6\x60\x04\x6f\x3e\x07\xed\xeb\xae\xd6\xe0\x61\x35\xd3\x3d\x6e\x08\xbb\xab\xde\x34\x6f\x41\xfd\xd1\x56\xa6\x6b\x38\x05\x31\xa6\xc6\x33\x95\xdf\xf9\x1b\xa5\x15\xe7\x2e\x8f\xee\xb1\x20\x6e\xef\x70\x3c\x32\xe4\x2c\x1f\x79\x11\x09\x24\xe7\xc3\x0d\x03\xfa\xb9\x4b\x18\x42\xaa\x64\xa2\x58\xb3\x09\x2a\x00\xe2\xfc\x91\x9f\x8c\xc3\x60\x0b\x58\xf4\xa6\x83\x1d\xce\xde\xd1\x67\x8e\x99\xe0\xc9\x8c\xdd\x8b\xc7\x09\xf0\xaa\x20\xba\x8c\x15\x1c\x2e\x5b\xc3\xd4\x5d\x98\x69\x70\xe0\xc5\x7e\xb4\x3a\x09\xc6\x75\x3b\x77\xab\xd0\x24\x76\x35\x7b\xb3\xb9\xda\x1d\x48\xd2\x55\x62\xd3\x56\x0d\x65\x0f\x33\xa5\xad\x0b\xcc\xba\xc3\xee\x05\x8d\xcf\xb7\xcd\x30\xb1\x44\xe5\x60\x38\x59\x22\x30\x8b\x90\x70\xd7\xce\x71\x20\x55\xbc\x76\xa0\xcd\x60\xef\xc5\xa3\x1e\x98\x83\x08\xa2\x6d\x36\x9f\x11\x21\x56\x22\xd7\xb9\xf7\xae\x40\xa2\xd0\x33\x0b\x85\x69\xed\x05\x18\x3a\x37\xc4\x3a\xf9\x83\xa1\x84\x01\x84\x44\x6a\x20\x6e\x29\xbb\x7d\xd6\x6c\x8e\x98\x79\xb5\xdb\x40\x8b\xaa\xb6\x9b\x0b\x12\x44\xcd\x2c\x08\xad\x19\xf1\x4a\x79\xdb\xa0\xae\x67\x79\x2f\xd2\x06\x7e\xf0\x04\xf0\x4a\x75\x53\x0b\x3c\x02\xb0\x94\xd9\xad\x65\xf8\xb2\x77\xdb\x1f\x91\x45\x4f\x4e\x10\x57\x94\x11\x33\x5b\xda\xb6\x5c\x05\x20\xe8\xf1\x08\xb6\xb3\xac\x67\xf1\x02\x2e\xdb\x20\xc8\x93\xd9\x20\x95\x22\x3a\x8e\xfc\x06\x63\xb7\xda\x10\x0f\x72\x29\x7b\x34\xa2\x38\x2f\xb6\x7b\x41\xb2\xd5\x96\x5b\x37\x0f\x14\xb9\x8d\x27\x34\x42\x47\xc0\xe2\xd1\x2a\xb1\x85\x41\x4d\xb7\x6d\x23\xe8\x74\x04\x4d\xe6\x69\xde\x7d\xf0\xd3\x51\x24\x87\x55\x6f\x04\xa2\x8e\x22\x3a\x26\x78\x75\xdb\xd6\xc3\x58\x47\x91\x1c\x45\x8f\x5f\x6e\x04\xbd\x3e\x8e\x6e\x1f\x1c\xb6\xa7\xe7\x47\x11\x8d\x00\xc6\x6e\x5b\x1c\x44\x76\xdb\xb6\x41\x69\x0d\x85\xcd\x6e\xdb\x0a\x8f\xe9\x02\x3f\x07\xe1\x46\xb7\xcd\x47\xcc\x9e\xf3\xc2\xa9\x4a\xac\x52\x27\xec\x23\x21\xc5\xb5\xaf\x39\xf8\xec\xbc\x45\xd0\xb6\x77\x26\x51\xe4\xc5\xee\xf9\x46\x4c\xc2\x80\x45\x26\xe6\x46\x71\x08\x97\x76\xae\xd9\xee\xf8\xa2\x13\x14\xa9\x38\x9e\x6b\x5a\x6c\x5f\xb6\x60\xf6\x31\x58\xa9\xd8\x26\x11\x3a\x71\x10\xa6\x69\xdb\x82\x91\x56\xbb\x0f\xd2\xb2\xad\xdb\x16\x92\x8e\x8b\x8d\x9a\x94\x1b\xaa\x40\xee\x20\x05\x2f\xb0\x0b\x3b\x48\xd0\xc5\x16\x9e\xa6\x8b\x2d\x72\xaf\x3b\x0d\x7c\x8b\x75\x9d\xda\x12\x96\xee\xf2\x63\xc7\xde\x08\x1c\xbd\x2a\x99\x11\x41\x60\xc2\x39\x55\xc6\x02\x23\x46\xcc\x06\xd5\x87\xde\x7d\x2a\xe8\x3c\x39\xdf\xec\xd9\xd5\xe5\xa8\x3e\x15\x8f\xee\xb7\xeb\x55\x01\x7f\x39\x54\x13\x70\x2e\xbd\x8b\x76\xd8\xbf\xd2\xab\xaa\xb5\x8d\x54\x1b\x64\xcb\x31\x2c\xc7\x94\x96\xbb\xfc\xa3\x91\xfb\x01\x79\x5a\x2b\x5d\xf2\x36\x44\xa5\xac\x5b\xbd\xd5\x28\x5a\xec\x8d\x60\xca\x2e\xbd\x08\xb8\xd4\x13\x9a\x7f\xf0\x3e\xe2\x85\x95\xe5\x36\x84\xa0\x3e\x05\xd4\xf4\x38\xcb\x69\xc9\xe5\x56\xa8\xf4\x2d\x83\x04\x53\x9e\xe7\xaa\x82\x99\x27\x84\x69\xfa\x5a\xc6\x6f\x44\xa6\x8f\xd1\x45\x66\x54\x74\x2f\xbd\x2b\x58\xcc\x61\x6b\x1c\xc4\x51\x4a\xf9\x36\xcb\xc6\x00\x3f\xd8\x09\xb2\xb8\xe7\x97\x83\x8e\x48\xcb\xce\x7c\x24\x45\xc6\x74\x32\x13\x73\x8e\x60\x0e\x66\x25\x5d\xe9\xad\x87\x52\x56\x15\xb1\x20\x61\x5f\x33\x8a\xb0\x28\xe7\x9a\xa9\xdb\x63\x67\xa1\x42\x88\xeb\xbb\xc5\x4b\x62\x6c\x7c\xb5\x6d\xe5\x72\x60\xee\xf8\x50\x4a\x29\x0d\xb5\x6e\x20\xc3\x2b\x08\x13\xc3\x08\xda\x06\x25\x86\x32\x40\xd8\xc9\xbb\xbe\xd2\x68\x92\x67\x57\x97\x6c\x81\x7b\xe4\x2b\x4d\x77\xbc\xe2\xcf\x40\x8d\x6f\x16\x2b\xda\x5e\x89\xd0\xe7\xd9\xde\x39\xb5\x77\x4e\x45\xb4\xbd\x73\x8a\xda\x9e\x81\x73\xca\x53\x7e\x9c\xbc\x8b\x16\xa2\x5d\x27\x97\x5f\xb2\x3e\x58\x83\x76\x0d\x13\xc0\x6c\x12\x88\x57\x76\xd9\x9c\x77\xeb\xaf\x8a\xa2\x0b\x40\xb3\x5e\x2c\xe7\xe0\xe4\xe4\xe0\xc0\x79\xbe\x6a\x42\x4a\x70\x5f\x33\x67\xb3\xba\x9d\xbc\x61\x22\x4f\x54\x2a\x2c\xaa\xff\xad\x2c\x75\x05\x26\x41\x5c\x4f\x9d\x6b\xc3\x9f\xdb\xb9\x9d\x87\x38\xee\xe4\x45\x9f\x60\xdc\x31\x5b\x7f\x2b\x81\xe8\xd2\x97\x7e\x1c\x4d\x85\xb7\x8a\x3b\x5c\x4f\x21\x56\xdc\xea\x6b\x76\xb2\x9b\x42\x86\x56\x83\x6f\xd2\xad\xe2\xd4\x77\x39\x97\xb6\x1c\xa0\xd1\x03\x84\xae\x34\x3b\xc4\x0f\x4f\x92\xa2\x8e\xb4\x09\xf0\xf9\xb9\x98\xab\xf2\xf1\xb8\x21\x6c\x08\x92\x2b\x34\x2c\xb7\x86\x08\x52\x3d\x82\xd4\xa6\xa4\x2e\x4b\x91\x53\x53\xb6\x97\xdb\xd7\xb5\x33\x9a\x75\xa4\x5e\xd3\xe9\x6b\x4b\x57\x77\x9a\x8a\xf9\xe1\x8e\x4b\xbf\x39\x8d\x10\x32\xfb\xec\x05\x99\xe3\xc6\x71\x16\x4d\xd6\x50\x13\xf9\x82\x2d\x78\x49\xba\x58\xd3\xd7\xb6\x36\x2d\x52\xb9\x90\x5a\x91\xae\x1e\xf6\x35\x9e\x3f\x7e\x8a\x62\x09\xd8\x26\x5b\x8a\xd5\x65\x3a\x5b\x4c\x44\xaf\x4f\x53\x5a\x84\x05\x55\x57\x45\x1d\xc7\xc1\x59\xa3\x7b\x38\x8e\xe5\xea\xfc\x35\x9c\x2a\x8e\xab\xb0\x36\x79\x12\x2d\xd4\x97\xdf\x45\xd2\x29\x78\x55\x89\x32\x7f\xcb\xfe\xf7\xf0\x9f\xdf\xff\x39\x39\xfa\xe1\xf0\xf0\xb7\x17\x93\xff\xfa\xfd\xfb\xc3\x7f\x9e\xc0\x1f\xff\x71\xf4\xc3\xd1\x9f\xee\x3f\xdf\x1f\x1d\x1d\x1e\xfe\xf6\xd3\xc7\x7f\x5c\x5f\xbd\xff\x5d\x1e\xfd\xf9\x5b\x5e\xcf\xef\xf1\x7f\x7f\x1e\xfe\x26\xde\xff\x4e\x24\x72\x74\xf4\xc3\xbf\x47\x76\xf8\xcb\xa4\x75\xf9\x4f\x64\x5e\x4d\x54\x39\xc1\xa5\x7f\xcb\xaa\x32\x38\x1f\x0b\x9b\x5b\x8e\x71\x78\x4f\xab\xaa\x6d\x25\xe4\x98\x6f\x65\x7f\x25\x26\xb1\xad\x41\xbc\xc5\x04\x6c\x61\x0f\x6f\x13\xbf\x7b\xee\x91\xb8\x88\x97\x61\xee\xf9\xa8\x11\x8a\x00\x00\x65\x4b\xf3\xaf\x15\x9c\xf8\x0b\xa4\x7c\xda\x52\x4e\xc3\xf9\x9e\x21\xd0\x34\x6d\xdb\x90\xef\x19\xa1\xb4\xee\xf3\x3d\x9f\x38\xdf\x13\x77\xc6\x3e\xd9\x33\x8c\xe4\x3e\xd9\xb3\xa1\xb1\x4f\xf6\xdc\xfb\xd3\x63\x88\xee\xfd\xe9\x7b\x7f\x3a\xa5\x3d\x03\x7f\xfa\x3e\xd9\x73\x9f\xec\x89\x6d\x9f\xec\xb9\xda\xf6\xc9\x9e\x6d\xdb\x27\x7b\xae\x4d\xf6\x9c\x86\x7a\x02\x58\x27\xd3\xf3\xaf\x91\xe8\xa9\xcd\xde\x4a\xc4\x59\x92\xa8\x3a\xaf\xae\xd5\xbd\x08\xc8\x88\x22\xb9\x53\x56\xe8\x3f\x5b\xdf\x0a\xaf\x53\x29\xf2\x50\x5f\x69\x67\x12\xce\x2c\x09\xe7\x12\x30\x9a\x40\x9e\x06\xdb\xc0\xae\x27\x8e\xd3\x54\x66\xde\x4e\xd8\x19\x2b\x45\x22\x0b\x69\x64\x5b\xb0\x50\xe7\x48\x05\xb7\x65\x83\xc0\x2e\x2b\x2d\xb2\x5b\x8b\xea\x1c\xaa\x72\x36\x95\x35\x4a\xcf\xbf\x60\x05\xb1\x1b\x42\xe8\xf9\xf1\x06\x8c\x4e\x0a\xe5\x40\x83\x99\x9e\xa9\x3a\x4b\x59\x29\x22\x1c\xa7\xde\x2c\x5e\x7b\xbd\xf3\x63\x0d\x11\x14\xbd\x09\xb0\x1d\xe7\x85\x0c\x29\x98\xda\x10\x8b\xe3\x7c\xe2\x4b\x21\x4b\x38\x72\x53\x91\xa8\x3c\xdd\xc6\x33\xf8\x7e\x99\x96\xdd\xc3\x11\x4a\x87\x00\xa7\x52\x5a\x23\x39\xa8\x69\xc0\x33\x99\xca\xea\x31\x2e\x43\xd2\x32\x11\xc6\x91\x8b\x34\xc7\x41\xb7\xcb\x1a\x7a\x18\x8a\xa2\x54\x3c\x99\x09\xed\xcd\x21\xda\x76\xb6\x18\x53\x9c\x8f\xb1\xc8\xea\x3b\x99\xa3\x61\x07\x6f\x30\x5a\x78\xf6\xc8\x4a\x55\xb9\x6c\x67\x3b\x98\xd0\x0e\x77\x87\x7e\xed\x75\x14\x5e\x06\x8a\x6a\xe8\x0e\x2e\x1f\xa1\x1e\xa3\xf2\xbb\x87\x4c\x42\xde\x46\x4f\xad\xd4\x4c\x65\xa9\x03\x73\x78\xf3\x82\x15\xa2\x4c\x90\x63\x81\x68\xac\x64\xf0\xac\x56\x8a\x65\xc6\x9a\x31\xc2\xf5\xd6\xef\xa5\xff\xaa\x40\x9a\x7f\x7b\xc5\x66\xaa\x2e\xf5\x89\x5f\x3c\xe9\x25\x7c\x86\x3e\xb8\xf0\x1c\x1c\x63\xd8\x54\x2c\x13\x5c\x57\xec\xe5\x0b\x36\x97\x79\x6d\x14\xba\x20\x2a\x9e\x01\xfa\xfa\x55\x04\x0b\x09\x37\x3d\xc3\x8d\xce\xd5\xbc\x5b\x2b\xed\x0a\x44\x38\x8d\xf2\x7b\x5a\xcf\x3e\x96\x09\x43\x54\x66\x2f\x75\x2b\x46\x09\xb0\x74\xdb\xdd\x92\x57\xea\x09\xb8\x72\x8c\xd5\x15\x68\x39\x05\xeb\x79\x81\x0f\xd0\x4d\xab\x90\x6a\x56\xb4\x0c\x26\x72\x57\xff\xa8\xd5\xcd\x63\x15\x56\xcc\xef\xbf\xf1\x99\x6e\x15\x3f\xf7\xa1\x5f\x1d\x7e\xe3\x70\x06\x80\xd5\xdb\xe2\xf0\x1b\x28\x84\x68\xa8\x77\xa5\xaa\x49\xa5\x6d\x3a\x63\x05\xbf\x9c\xf3\xdd\xb8\x92\x27\x49\x22\x34\x70\x3a\xcb\xf5\x48\xbb\x07\x62\x31\xd8\x0b\xf2\xe6\xd9\x75\xc9\xbb\xb5\xc5\xd9\xed\x8a\x92\x06\xf7\x44\xb0\xdb\xe4\x89\xa3\xda\x7c\xa5\xb8\x93\xba\x8a\x00\xad\xfc\x6c\x1f\xec\x1e\x02\x2d\xf3\xbb\x0c\x24\xec\xbc\xce\x2a\x59\x10\xb3\x65\xdd\xd9\x69\x88\x5a\xed\xc6\x87\x8b\xe3\x6d\xf4\x87\x44\x93\x63\x1d\x4c\xc4\x2b\x81\x10\xf3\xa1\xeb\x13\x13\x79\x85\x70\xc4\xa5\x51\xa4\x0a\x5e\x72\x6a\x8d\x2a\x58\xd2\x44\xcd\xe7\x5c\x1f\xd9\x18\x23\x87\x7c\x4d\x14\x5d\x46\x43\x29\x79\xd6\x4c\x2b\x89\xa6\x97\x39\x37\xf6\xc1\xa8\x44\xce\x73\x52\x66\x43\xd7\xf9\x0b\x8f\x31\xf5\x90\x3b\x84\xc2\x3b\xb9\x10\x79\xb3\x4e\x01\x4a\xad\xb5\xe3\xde\xf1\xe4\x5e\xe4\x29\xfb\x45\xbb\x73\x91\x3e\xe6\x7c\x6e\xd1\x5f\x8a\x52\x2d\xa4\x39\x18\xc4\x55\xe8\xf6\x43\x1f\xdb\x68\x0d\xd6\xaa\x73\xa5\x7f\x51\x89\x1e\x7b\x46\x6b\x1d\x81\x45\xf0\x8b\x36\xda\xe5\x7a\x1e\x4a\x86\x6a\xd0\xa2\x94\x8b\x44\x38\x55\xde\x74\x68\xec\x41\x2e\x48\x05\x6e\x57\x86\x69\x8b\xdb\x4a\x2f\x4e\x0b\xb2\xad\x49\xe1\xa0\x8d\x91\xe7\x8c\x67\x86\x6d\x3e\x36\x55\x13\xbb\x0b\x6e\x56\x38\x1f\x1f\xe1\x30\x44\x0b\x21\x1f\xf0\x09\xed\xb0\x90\xf5\x95\xf2\x26\xac\xec\xe6\xc1\xe7\x77\x17\x5d\x1e\xfd\x99\xa7\x4a\xb3\x77\x99\x4a\xee\xd9\x85\x00\x8b\x18\x04\xd6\xc6\xa1\x78\x88\x37\xeb\x11\x6d\x82\xb0\xc4\x36\x41\x37\x97\x37\xe9\x6e\x80\x9b\xf7\x40\xbf\xdf\x34\xd0\x6f\x79\x43\xeb\xc3\xb7\x00\xf3\x3b\xe7\x77\x31\xf8\xfb\x33\xc1\x4a\x60\x06\x40\xc0\xa1\x82\xd1\x51\xd5\x3b\x47\xf3\xdf\x66\xea\x61\x52\xa9\x49\xad\xc5\x44\x92\xd2\x71\x83\xc6\x78\x2f\x1e\x21\x87\x39\x78\x94\x3f\xe1\x83\x1d\xe7\x42\xa5\x20\xb4\x02\x9f\xdf\x12\x13\xa1\x3e\xbf\xbb\x30\x62\xbc\x05\xc2\x90\x9a\x9d\x8a\x2a\x39\x4d\x44\x31\x3b\xb5\xdd\x0b\x46\x51\x7c\x4e\x53\x3c\x57\xb9\xac\x54\x19\x5e\x72\xf6\xe0\x8c\x25\x2a\xcb\x6c\x01\x58\x75\xcb\xce\x45\x31\x6b\xc8\x05\xc4\x35\x9f\x64\xdb\x3d\x0f\x98\xd6\x42\xa9\x70\x68\x47\xef\xc8\x9a\xe7\xed\x89\xf5\x36\x64\x79\x43\xaa\x27\xdf\x0b\x86\xf3\x1c\xb6\xe0\x8e\x60\xd6\x76\x85\x5e\xfb\xcc\x76\xf6\x53\x21\xe5\x1e\x4c\xdd\xa3\xe0\xd4\xf1\x72\x19\x82\x41\x6f\x5d\x37\xc0\x08\x6e\x58\xec\xe5\x2d\x9a\x84\xa9\x48\x99\x5a\x88\xb2\x94\xa9\xd0\x4e\x04\x04\xf9\x4e\xa0\x83\x32\x7b\x4a\xb1\xb6\xc7\xf1\xa5\xbe\xf6\x9b\xc7\xf1\x8d\x72\x16\x78\x4c\xde\x3c\xbf\xca\xe4\x79\x3a\x97\xc4\x02\x20\xcf\x92\xcd\x07\xa1\xf0\x1a\xcd\x94\xf0\x3b\xa7\x6c\x8c\x65\x66\xeb\x84\x67\xe2\xf2\x53\x90\xa9\x3d\xc5\x67\xba\xd6\xb6\xfb\xb0\x05\x44\x22\x7a\x5f\x06\x61\x7e\x7e\x6a\x0e\x29\xcb\x55\xba\x39\x32\xb8\x87\xf3\xda\x04\xe7\xd5\x9e\x2d\x12\x45\x32\x16\x50\x10\x6b\xb9\xe3\x95\x78\xe0\xe1\x9a\xcf\xb5\x9b\x16\x0b\x69\xea\x04\x82\xdb\x78\xd4\x1a\x79\xff\xc0\xf7\x8f\x8f\x43\x56\xaa\x0a\x2d\x83\x0b\x35\xe7\x92\x94\x17\xb6\x32\x40\x5f\xc7\x70\x03\xbb\x6a\x08\xd3\x14\x02\x78\x3b\xe8\x19\x2d\x2c\x43\x5d\x8a\x94\x59\xcf\xc1\x1e\x88\x69\x87\x40\x4c\xf1\xca\x66\xab\x6b\xb6\x6e\x64\x17\xe6\x0f\xb8\x65\x69\xd6\xdd\xed\x1c\x10\xab\x4d\x52\x18\xd3\x22\xd7\x12\xf2\xdc\xbd\xe4\x43\x22\xd6\xd7\x2d\xde\x38\x69\xee\x99\xa1\xd2\x7a\xcc\x3e\xa8\x3b\x99\x3b\x4e\xa5\x30\x95\x87\xd6\x4f\x2e\x33\xca\xbb\xf7\x5a\x26\xf5\xb5\xdf\xbc\x96\xa9\x75\xf6\x3e\xe7\x37\x19\x2d\x59\xa4\x2b\xb8\x33\x0e\x99\x5b\x02\x9e\x3f\x4d\xa5\x36\xff\xb2\xe9\xf4\x03\x04\x39\xeb\x3c\xc4\x92\x83\xb0\x9e\x15\x23\x4d\x81\x40\x64\x60\xe3\xf3\x13\xe4\xd9\x51\xf0\x5c\x97\x79\x6a\x86\x25\x74\x27\x4f\xdb\x52\x44\x84\xb2\x90\x98\xbf\xcd\x27\xbd\x11\xec\x7a\x26\x93\xfb\xab\x36\x96\xc9\x54\x69\x3e\xcb\xbd\x8f\x82\x8d\xd7\x88\xe7\x83\xf6\x9a\x1d\xf6\x55\x8c\x3b\xea\xda\x93\xc6\x53\x3b\x7d\x86\x10\xe3\x5a\xab\x44\x86\x05\xd5\xc1\x39\xdb\x88\x74\x96\x82\xb8\x1e\x7f\xb8\xa0\x0d\x6e\xad\x83\xb8\xcd\x62\x95\x55\xae\x3d\x7d\x82\x1a\x16\xb7\x33\x37\xfa\x10\x71\x5b\x46\x01\x7a\x5f\x77\x20\xbc\x9d\x1d\xd2\x8d\xc5\x86\x44\xfd\xdd\xe6\x70\x3a\x3d\x40\xcb\x6a\x6f\x7b\xc0\xd2\xd3\x5c\xb1\x2d\xdc\xb7\x4d\x01\xfb\x8a\xd1\x5f\xab\xab\x13\x7e\xd9\xe8\x3d\x94\xdf\x52\xe2\x4b\x74\x0b\x96\x54\xd4\xa2\xcf\xaf\xb7\x94\xd2\x83\x9f\xd9\x80\xaf\x61\x75\x04\xdf\x76\x51\x67\x98\x09\xdc\x62\xb4\x6f\x1b\xcf\xc3\x7e\x8c\x19\x00\xde\x06\xe2\xb1\xef\xea\xf2\x2a\xda\x23\xdd\xe8\x35\x06\x2e\x0d\xed\x91\x44\xd3\x22\x42\x7a\x76\xc4\x8b\xd7\xaf\x5e\x75\x30\x22\x3d\xfc\x47\x12\x49\x1a\x46\xa4\x77\xfd\x98\x44\x95\x86\x11\x69\xdf\x47\x64\x14\x64\x8c\x48\x96\x10\xf3\xbd\x07\x30\x22\x29\xca\xdf\x6e\x41\x22\xc9\xb1\xac\x80\xea\x2d\x90\x2c\x17\xc2\xe5\xd7\xd5\x6c\xf1\xab\xb0\x50\xa7\x7a\xb0\x52\x4b\x6f\xed\x15\x5a\x4f\x3b\xf5\x59\x46\xab\xb8\x42\xab\xb3\x12\x51\xfd\x79\xa8\xba\x4a\x6f\xbd\x14\xaa\xa5\xba\x5a\x53\x65\xa8\x4a\x0a\x79\xf0\xb6\x92\x0a\xa1\x36\x0a\x6d\x99\xaa\xb1\x2b\xa2\x50\xea\xa0\x78\xd7\xb3\x49\x34\xa9\x77\xb0\x63\xc1\x54\xfb\x2b\x9d\xf8\x95\x4b\x88\x46\x67\x58\x7d\x93\xf0\xdb\x8e\x41\xb5\x4c\x46\xab\x60\x12\x71\x9d\x22\xac\x5a\x49\x80\xa0\x27\xd3\x64\xbd\x95\x49\xa2\xe5\x3b\x36\xbf\x1e\xc9\xa8\xb5\x45\xd6\x57\x14\x69\xc4\x75\x10\xc5\x75\x82\x3d\xa6\xb6\x32\x4d\xbc\xb3\x9b\x10\x9a\xb1\x42\x1e\x5b\x5c\xd5\x8f\x98\x0b\x57\x61\x97\xad\x36\xd6\xf5\x88\x99\xfe\xfe\x6a\x1e\x3d\x95\x39\x02\x68\x76\x6a\x78\x8c\x57\x8f\x63\x6d\x15\x8e\x40\x6a\xc1\xcc\x27\xf4\x1e\x57\x48\xcd\x8c\x80\xfb\x5e\x3b\xbb\xba\x15\x56\x37\x81\x58\x2d\xc1\x47\xba\x22\x0d\x2f\xbc\x48\x42\x4c\xf8\x22\xca\xa7\xd2\xf1\x9f\x5b\x63\xda\xed\x6a\x4c\x9b\x6e\x50\x70\x68\x2b\x09\xb2\xe7\x89\xcc\x69\x16\xb6\xe5\xe9\xce\x09\xec\x8d\xda\xa8\x6a\x74\x37\x0c\x3e\xf5\x69\xba\x14\x62\x6f\x3e\x26\xde\x4b\xd9\x47\xd6\x9f\x2a\xb2\xde\x26\x86\x13\x0d\x4a\x4c\x1e\x5f\x9b\x18\xbe\x0f\xd3\x3e\xef\x30\xad\xee\x54\x07\x77\xee\x43\xe0\x5a\xe4\x48\xad\xba\xb1\x28\x6e\x28\x14\x9a\x03\x7e\x76\x75\xc9\x92\x52\x40\xbd\x0c\x9e\xe9\x13\x63\xd7\xd2\xc2\x9f\x5d\x0d\xd9\x05\xa7\x40\xff\x6e\xed\x76\x5e\x55\x62\x5e\x54\xb4\x4d\xb6\x8f\xc0\x52\x5f\xfb\xcd\x47\x60\xb7\x08\xb9\xfc\xda\x3c\xea\xbc\x51\xb3\x7a\xce\xf3\x89\xe1\x24\x10\x8b\x25\xfb\xb8\x5c\x7a\xcc\x92\x34\x3c\x61\xee\x5e\x1e\x2c\x1a\xb8\x70\x15\x95\x2b\xd5\xb9\xfc\xa3\x16\x6d\xbd\xd4\x46\x55\xd9\x61\xd4\x0a\xe8\x6f\x31\x8f\x08\x28\xb8\xc4\x85\x12\x55\x04\xcd\xa2\x35\x9f\xed\xc0\x9b\x39\x05\x86\xc3\x72\x15\xa8\xb3\x75\x7c\x6e\xd5\x4c\xa0\xfa\x77\x05\x37\xfd\x5b\x0c\x44\xcb\x85\x68\x0b\x03\x96\x39\x9a\xe6\x3c\xcb\xd4\x03\x8e\xd3\xd7\x60\xcc\x96\x32\xe3\xa6\x8a\x73\x7b\x77\x5d\x96\xa5\x2a\x6d\x64\xce\x1f\x3a\x66\x4e\xc9\xbb\x59\x25\x4a\x34\x57\xcb\x80\x44\x99\xa9\xa8\xfc\xad\x5e\x29\xc6\x73\xbc\xc4\x69\xfe\x76\x39\xde\x08\xee\x1a\x50\x53\xe0\x46\xcc\xf8\x42\xaa\xba\x3c\x81\x37\x54\x8a\x7d\x67\x1f\x07\xb5\xe1\x51\xd5\x4d\xc8\xa2\xd6\xd4\x89\x68\x56\x44\xf7\xac\xff\xcf\xed\x97\xe0\xc0\x48\x69\x05\x9f\xac\x7f\x75\x22\xbe\x48\x5d\xad\xce\xad\x13\x40\x0e\x20\xea\x2b\xa9\xe5\x0b\x5d\x18\x3d\xe4\x57\xd2\xf5\xdf\xee\xf1\xf3\x9f\xec\xaa\xe7\x8b\x29\x7c\xb5\xad\x72\x6e\xeb\x14\xe1\xad\x7e\x77\x95\x6e\xaf\xa0\xaf\x6b\xdf\x88\x82\xde\xa4\xa8\x64\x32\x79\xbc\xbc\x08\x57\x4d\x9b\xd4\x14\x43\x80\xbd\xe3\x5a\xa4\xec\x23\xcf\xf9\x1d\xfa\x93\x0e\xa7\x57\xef\x3e\x1e\x51\x7d\xe4\x10\x7e\xba\xbc\x58\x4e\x62\xf0\x65\x2f\xbe\xe8\xe7\xf1\x6f\xa8\x2f\xcd\x45\x94\xc2\xb1\x83\xd9\xd8\xc1\x5d\x7c\xa7\x10\x50\x81\x5d\x57\x8b\x49\x61\xb2\x89\xab\xac\xa7\x43\xd9\x10\x74\x61\x9e\xde\x8f\x3b\xac\x90\x24\x93\x76\x02\xc6\x61\xef\xb4\x77\x4f\x36\x29\x9c\xa4\xb7\x6d\xf6\x1f\x5a\xeb\xe2\x42\xae\xb9\x20\xb3\x71\x62\xd7\xf6\x65\xcd\x97\xfd\xdd\xeb\x7d\x40\x57\xbc\xaa\x3b\xe2\xa3\xb3\xd5\xde\xd5\x32\x4b\xa7\xf0\x1b\xeb\x7c\xb4\xa0\x58\x37\x50\xbf\x31\x85\xe7\xc1\x78\x83\x5f\x7a\x64\x86\x64\x13\x2f\x2b\x79\xcb\x93\x6a\x45\x64\x0d\x84\x13\xbb\x85\x43\xed\xc3\x6c\x32\x59\xf9\xe1\x7a\x61\x98\xcc\x44\x72\xaf\xeb\x81\xd4\xb8\x0d\x2b\x21\x07\x36\xd5\x86\xc7\x32\x85\xd9\xa5\x51\x0f\x57\xbc\xbc\x1b\xca\x6f\x5a\xfb\xe8\xba\x73\x30\x61\x72\xd5\x79\x1c\xbc\x91\x18\xbb\xe1\x5a\x5c\xf6\xdd\xca\x1f\xec\x59\xa2\xf2\x54\x42\x60\x2c\x66\xdd\x61\x73\x9d\x3b\x12\xf6\xbb\x1b\x67\xfc\xb8\x2d\xd8\x82\x4a\x32\x5e\xf5\xea\x25\x89\x28\x21\xe6\x02\x95\xf3\x56\x59\xfa\xfa\x2d\x94\x71\x5d\x5d\x97\x1c\x92\xe9\x55\x7e\x2d\x87\x84\x53\xa7\xe7\x1f\xb8\xae\xa0\x98\xa3\xbb\x95\x61\x87\x50\x35\x84\x44\x8a\xe5\x14\x54\x2e\xec\x69\x1c\x60\x18\x60\x54\x80\x87\xa1\x5f\x18\xb9\xf8\x5c\xca\x2b\x31\x19\x2c\xae\xb6\x69\xcf\x72\x5d\xfd\x52\x18\x12\xc4\x01\x5e\xcf\x04\x3c\xe4\x06\x29\xb5\x37\xca\x07\xae\x59\x0d\xd4\x06\xd4\xa6\x51\xfa\x3c\x17\x5a\x0f\x56\x88\xe8\xf2\x0f\xf4\x3e\xb0\xc6\xfb\x60\x1f\x65\x12\x73\xa6\x65\x7e\xc7\x52\x51\x71\x99\x69\xac\xce\x3c\xb4\x14\x33\xe1\xad\x60\xff\xd0\x36\x74\xba\x14\x5c\x0f\xb1\x86\x9e\x88\xa6\xf9\xb1\x7f\xb9\x07\x27\xf8\x40\xdb\xb9\xdf\xae\x2f\xab\x42\x60\xa0\x2f\x56\x12\xb4\xf0\xab\xd8\x8d\x63\xd8\xbc\xea\x96\x5d\x97\xb5\x38\x66\x3f\xf2\x4c\x8b\x63\xf6\x4b\x7e\x9f\xab\x87\xb8\x1e\x55\x83\xb6\x4a\x77\x6e\x6c\x81\x1a\xcf\x5e\x6f\x7b\x15\xf1\xe2\xf5\xbc\x73\xf0\x74\x22\x04\xe5\x18\x8c\x35\x95\x77\x42\xaf\x70\xfd\xc1\x3e\xbb\xe2\xc1\xcb\x0f\x74\x26\xe9\x7c\xc6\xf3\x3b\xb0\xdc\x2e\x5c\xad\xe1\x53\x76\x39\xfd\xc4\xde\xbc\x7e\xf1\x92\x3d\xcc\x44\xce\xce\x3f\x5f\xb0\x5a\x0b\xcd\x3e\x15\x22\x3f\xbb\xba\x04\x77\xce\xca\x78\x16\x7f\x6f\x3c\xac\x77\xb2\x9a\xd5\x37\x27\x89\x9a\x9f\x7e\x3a\xbb\x3c\xb5\x8f\x4d\x2c\x72\x26\x8a\xbd\x53\xa9\x75\x2d\xf4\xe9\x9b\x57\xff\x49\x1d\x0f\x64\x54\x91\x47\x7f\xcb\x65\x56\x97\x2b\xfb\xa4\x6b\xbf\xe2\x6f\x56\x15\x86\x75\xbc\x7e\xf8\x6c\x6e\xd8\x3d\x89\x5a\x88\xfe\x62\x83\x7d\x9d\xfa\x6c\x7f\xdf\xa7\xcd\x6c\x36\xee\xad\x33\x7f\x48\xbd\xdc\x9c\x77\x61\x09\x7c\xe4\x5f\xb6\xa6\x31\x2c\x2b\xa8\x2c\x9e\xc0\x2f\xd7\xa9\xf7\x13\xd7\x93\xf5\xdf\x7e\xe4\x5f\x06\x17\x75\x48\xcd\x1e\x18\x1a\x65\x58\x6b\x86\x34\x3c\x9c\x89\xdd\x7e\xbd\x5f\xe0\x86\xe9\xf9\xaa\xa7\x07\x83\xc3\xea\xad\xa7\x34\xd8\xd7\x62\xc6\xf5\xfa\x33\x06\xda\xd9\x95\xf9\xd9\xea\x4e\x1e\x26\x9b\xf1\xca\xcc\x21\xb9\x1f\x90\xde\x22\xd2\xb3\x95\x1d\xbf\x69\x25\x06\x28\xf6\x4c\xcf\xd2\x47\x0b\x8c\xda\xbc\x65\x8b\x97\xed\xff\xec\x69\x44\x3b\xd2\x7e\xc5\x6c\xf5\x75\x91\x76\x30\x68\xad\x33\xc3\x7e\xf6\xff\x01\x00\x00\xff\xff\x29\x83\x5b\x1f\xd7\x9a\x04\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x6b\x73\xe3\xc6\xb1\x00\xfa\x7d\x7f\x05\x4a\x4e\x95\xc4\x63\x92\xda\xcd\xf1\x4d\x9c\x4d\x2a\x2e\x59\xd2\x3a\xba\xde\xd5\xea\x2c\x65\xfb\x9e\x72\x7c\x52\x10\x39\x94\x10\x81\x00\x83\x87\xb4\xcc\xf1\xf9\xef\xb7\xbb\xe7\x81\x01\x1f\x42\xcf\x10\x94\xb4\x6b\xa8\x2a\xf1\x8a\x22\x1a\x33\x3d\x3d\xfd\x7e\x7c\x11\x0c\xda\xfb\x79\xf1\x45\xf0\x36\x1a\x8b\x24\x17\x93\xa0\x48\x83\xe2\x46\x04\x47\xf3\x70\x0c\xff\x19\xa5\xd3\xe2\x3e\xcc\x44\xf0\x26\x2d\x93\x49\x58\x44\x69\x12\x1c\x1c\x8d\xde\xf4\x02\xf8\x55\x64\x41\x9a\x88\x20\xcd\x82\x59\x9a\x09\x00\x32\x4e\x93\x22\x8b\xae\xca\x02\x3e\x8a\x25\xc0\x20\xbc\xce\x84\x98\x89\xa4\xc8\x87\x41\x30\x12\x82\xa0\x9f\xbf\xbf\x3c\x3b\x3e\x0d\xa6\x51\x2c\x82\x49\x94\xcb\x87\xe0\xe5\xf7\x51\x71\x03\x70\x8a\x9b\x28\x0f\xee\xd3\xec\x36\x98\x02\xa4\x70\x32\x89\xf0\xc5\x61\x1c\x44\x09\x7c\x30\x93\xcb\xc8\xc4\x75\x98\x4d\xa2\xe4\x1a\x5e\x3b\x5f\x64\xd1\xf5\x4d\x11\xa4\xf7\x89\xc8\xf2\x9b\x68\x3e\x04\x28\x97\xb8\x8d\xd1\x1b\xbd\x92\x5c\x82\xa5\x77\xc2\x26\xff\x3b\x2d\xd5\x1e\xac\xed\x2a\x2c\xf4\x83\x1f\x01\x0c\xbe\xe4\xf7\xc3\x97\x00\xe9\x00\xbf\xb2\xa7\xfe\xb8\xd7\xfb\x73\xb0\x80\x87\x67\xe1\x22\x48\xd2\x22\x28\x73\x61\x41\x16\x1f\xc7\x62\x5e\xc0\x42\x61\x55\xb3\x79\x1c\x85\xc9\x58\x54\xdb\x32\x6f\x00\x5c\xfc\xb7\x82\x91\x5e\x15\x21\x7c\x3d\xa4\x6d\x04\xe9\xd4\xfe\x5a\x10\x16\x2f\xbe\x80\x27\xe9\xe7\xa6\x28\xe6\xaf\x0f\x0f\xef\xef\xef\x87\x21\x2d\x77\x98\x66\xd7\x87\x7a\x77\x87\x6f\x01\xa3\xe7\xa3\xd3\x01\x2d\x19\x9e\xf9\x21\x89\x45\x9e\x03\x9a\xfe\x55\x46\x19\xe0\xf6\x6a\x11\x84\x73\x58\xd1\x38\xbc\x82\x75\xc6\xe1\x3d\x1e\x1c\x9d\x0e\x1d\x3a\x2c\xe1\x3e\x03\x3c\x27\xd7\xfd\x20\x57\xa7\x0e\x50\xec\xd3\xa9\xd0\xa5\x97\x07\xbb\xb6\xbf\x00\x08\x0b\x93\x60\xef\x68\x14\x9c\x8d\xf6\x82\x6f\x8f\x46\x67\xa3\x3e\xc0\xf8\xe9\xec\xf2\x6f\xef\x7f\xb8\x0c\x7e\x3a\xfa\xf0\xe1\xe8\xfc\xf2\xec\x74\x14\xbc\xff\x10\x1c\xbf\x3f\x3f\x39\xbb\x3c\x7b\x7f\x0e\xbf\xbd\x09\x8e\xce\xff\x3b\xf8\xfe\xec\xfc\xa4\x1f\x08\x40\x16\xbc\x46\x7c\x9c\x67\xb8\x7e\x58\x64\x84\x88\x14\x13\x3c\x53\x4d\x40\x7a\x01\x48\x1f\xf8\x7b\x3e\x17\xe3\x68\x1a\x8d\x61\x5f\xc9\x75\x19\x5e\x8b\xe0\x3a\xbd\x13\x59\x82\xe4\x31\x17\xd9\x2c\xca\xf1\x38\x73\x58\xde\x04\xa0\xc4\xd1\x2c\x2a\x88\x8a\xf2\xd5\x4d\xe1\x6b\xda\xbc\x5b\x2f\xc2\x79\xa4\xc8\xe9\x35\x9c\x40\x24\x3e\x16\xf0\x1a\x7c\xf7\xf0\xf6\xeb\x7c\x18\xa5\x87\x77\xaf\xae\x44\x11\xbe\x7a\x71\x1b\x25\x93\xd7\xc1\x71\x99\x17\xe9\xec\x83\xc8\xd3\x32\x1b\x8b\x13\x31\x8d\x12\x22\xff\x17\x33\xf8\x12\x5c\xc1\xf0\xf5\x8b\x20\x48\xc2\x99\x78\x1d\x5c\x95\x51\x3c\xc9\x87\x63\xf8\x25\xb6\x68\x02\xfe\x1e\x87\x57\x22\xce\xf1\x9b\x01\x9e\xfa\xeb\x60\x8f\xbe\x34\xb8\xdd\x7b\x81\xa8\xc2\x3f\x54\xd7\xea\x22\x8b\x92\x42\x64\xc7\x69\x5c\xce\x12\xf5\xd0\x20\xf8\x7f\x47\xef\xcf\x2f\xc2\xe2\xe6\x75\x30\xcc\x01\x59\x65\x3e\x9c\xdf\x84\xb9\x78\x21\x89\x71\x22\xf2\x71\x16\xcd\x0b\xda\x15\xde\x34\x5a\x4b\x60\x7f\x45\xae\xf1\xc2\xfa\xa4\x58\xcc\xe1\x13\xa4\x97\xe4\x7a\xf5\x2d\x7a\x7f\xc3\x71\x26\xe8\x70\x2e\xa3\x99\x80\x57\xcf\xe6\x9b\xde\x59\xc0\x17\xe0\x8a\x04\xf7\x37\xd1\xf8\x86\xce\x50\xae\xe2\x3e\xcc\x03\x02\x22\x26\xb5\xb5\x1c\x5d\xd7\x57\x02\x6f\x13\x1b\x77\x0b\xff\xc9\x00\xc0\x51\xe1\xf3\xf6\x38\xcc\x8b\xe0\x20\x13\x83\x9e\x02\x53\x5b\xc7\xa8\xf6\x19\x63\x2d\x93\x32\x23\x8c\x3c\x8c\x7c\x7a\xa9\xf8\x28\xc6\x25\x31\xc8\xa5\x87\xe4\xab\x4f\xea\x1f\x3e\x7c\x22\xea\xed\xd3\x30\x8a\xcb\x4c\x0c\x33\x31\xc6\x4b\xb5\x18\x86\x45\x21\x66\xf3\x8d\x88\x49\xca\xd9\x15\xca\x86\xa9\xb5\x18\xf5\x48\x5e\x3f\x8f\xfa\x87\x72\x31\x48\x8b\xd7\x22\x83\xcf\xae\xb3\xb4\x04\xd2\x5d\x43\xde\xf8\xb8\x22\x54\x79\x69\xbe\x45\x04\xd0\xef\x31\xf0\xa3\xef\xab\xcf\xde\xc2\xaf\xf4\xf9\x1c\xb6\x10\xc6\xfa\xce\xd0\x47\xf9\x4d\x9a\x15\xe7\x15\x28\xdc\x7d\x74\x7b\x25\xff\x06\x08\x29\xe3\x30\x53\x0f\xd0\x67\x63\x38\xa2\xeb\x34\x8b\xec\xef\xdf\xe2\xe2\xcc\x6f\x63\xf5\x5b\x0e\xbc\x1c\xb6\x42\xb0\x61\xdd\x74\xd4\x79\x79\x95\xa9\x4b\xad\x9e\x97\xe8\x7d\x1d\xfc\xef\xff\xc1\xaf\x77\x61\x1c\x49\x01\x2b\xff\x08\x00\x92\xa3\x8b\xb3\x1f\xff\x73\x04\xdb\x9e\x85\xaf\xd7\x21\x9b\x76\x88\x4c\x18\x89\x4f\x7e\xcf\x70\x44\xb9\xcf\x00\x40\xa8\x27\xe7\x19\x80\xcc\x0a\x6b\xf5\xc8\x1a\x0c\x6b\x32\x9f\x2d\xbd\x63\x1f\x17\xa1\xa4\xe1\x04\x99\x91\x90\xaf\xbb\x93\x9f\x01\xd7\xcf\xe5\x8b\x49\x72\x45\x28\x70\x90\x71\x83\xc0\xb7\xe9\x4c\xfe\xc0\x57\x40\x3e\xa4\x57\xff\x14\xe3\x62\x08\xbc\x3c\x43\x20\x78\x0c\x25\x6c\x03\x94\x07\xf8\xb5\x08\x90\xca\xae\x93\xe8\xdf\x06\x72\xae\x75\x92\x18\xf0\x9f\x17\x35\x88\xc4\xb8\x50\x33\x00\xf4\x95\x20\xb7\x81\xbf\x93\x50\xcd\x04\xbe\x03\x98\xbb\x05\x8d\xbe\x02\x5a\xc8\x3b\x50\x56\x48\x93\x78\x4d\x22\x35\x07\x99\x7a\x1d\x15\x9a\x19\x83\xd8\x9e\x95\xc0\x71\x17\x87\x96\x36\x93\x1f\x4e\xc4\x9d\x88\x0f\xf3\xe8\x7a\x10\x66\xe3\x9b\xa8\x00\xe8\x70\x25\x0e\x01\x81\x03\x5a\x78\x42\xd2\x64\x38\x9b\x7c\x61\x0e\x79\xdf\x5a\xe9\xca\x3d\x33\xa4\xbb\x11\xef\x48\xc4\x78\xb6\xa1\x7a\x4c\xae\xbf\x42\x2f\x7e\x84\x58\xf9\x70\x3a\xba\x0c\xf4\x4b\xe9\x08\xea\x38\x27\x6c\x57\x8f\xe5\x15\xe2\x11\x51\x80\x07\x12\x7f\xa8\xbf\x64\xe9\x8c\x20\x8a\x64\x32\x4f\x01\xb3\xf4\xcb\x18\x44\x6f\x52\x47\x3a\x50\x32\xc8\x4f\xa9\x5a\xc0\x81\xe0\xf9\x0c\x83\xe3\x30\x41\x6d\xe8\x4a\x04\xe5\x1c\x79\xd9\x64\x18\x9c\x25\xf0\x29\x5c\x88\xe3\x10\x15\x9e\x1d\xa3\x1d\x31\x9c\x0f\x10\xa5\xcd\x88\xb7\x25\x69\xfd\x8b\x12\x5b\xe6\x63\x2d\x2b\xd7\x9e\x10\xdd\xbe\x11\x7c\xa3\x76\x2f\xe0\x2b\xa4\x6e\xe1\xe5\x16\x48\xf1\x15\x6b\xda\x7c\x0f\xe9\xa0\x32\x50\x44\x46\x45\x86\x4c\x66\x51\xff\xd3\x1a\x1e\x9b\xab\x2f\xa2\xfa\x49\xfa\x1b\x3d\x6e\x4b\x21\x50\xa3\x90\x2c\xe6\x78\xfa\x45\xba\x04\x2f\xa0\x6f\xa6\xa4\x69\x29\x4e\xa1\x34\xcf\x44\xf3\xac\xa5\x27\xd6\x22\x52\x72\x31\xdf\x25\x67\x65\x52\x2d\xb8\x1f\x44\xa0\xbf\x03\x5d\x66\xd1\x44\xa2\x72\x65\xc9\x72\x63\x06\x8c\x5a\x30\xc9\x0b\x29\xd4\x80\xc9\x87\x05\x5a\x08\xdc\xb5\x17\x61\x7e\x9b\x3f\xb8\xf0\xfd\x33\xd0\xa9\x3f\x5c\x06\x47\x27\x52\x59\x3d\x7a\x1b\x8c\x2e\x4e\x8f\x83\x37\x67\xa7\x6f\x4f\x46\xc0\xf2\x57\xce\x7b\x1c\x83\x02\x47\xf2\xab\xfe\x73\x36\x9b\x83\xb8\x09\x93\xe2\x75\xf0\x01\x36\xbe\x87\x54\x10\x02\x91\x0f\xf2\xc9\x6d\x70\x2d\x12\xfc\x4d\x04\x70\x1d\xf6\x08\x37\xc2\x7c\x34\x4e\x27\xa0\x67\x4c\xd7\xc1\x9c\xa5\x93\x68\xba\x90\x9c\x40\x59\x20\xfb\x4b\x5f\x82\x3b\x33\x5b\xd9\xe2\xf2\xe9\x00\x1a\x40\xe7\x5d\xf9\xd2\x26\x52\xb5\x8e\x43\x64\xeb\xfe\xb4\xee\xa6\x88\x6c\xd3\x6b\x9a\x5f\x45\xb2\x6a\x4a\x9a\xf0\x62\xd3\xdf\x97\xde\x79\xa4\xbe\x2e\x59\x28\xa9\x12\x24\x7f\xf4\xc7\x28\x5b\x26\x65\x8c\xb8\xcb\x4a\xb0\x94\x86\x1b\xc1\x36\x2f\x8d\x34\x1a\x38\xa5\xa3\xc6\x25\xae\x2c\xf3\x84\x7e\xb9\x02\x8a\x4f\xe4\x31\x6f\x58\xde\x83\x10\x03\x23\xf5\xe7\xe9\x64\xf8\xe0\x57\x79\x9b\x91\xdf\x14\x20\x1a\x80\xb4\x41\x65\x84\x65\x8c\xcc\x82\xce\xae\x93\xd4\x7c\x7c\xaa\x35\xbc\x26\x70\xeb\x18\x82\x04\x09\x2c\xe8\x3e\x8a\x63\xf5\x42\x24\x7e\xfd\x87\x46\x90\x01\x6e\x98\xf4\x03\xc4\x1e\x72\x0d\xd0\xc7\x73\xe0\x05\xf9\x74\x41\xf8\x30\xf8\x54\xe6\x24\x4a\x0b\x06\x54\x65\x4e\x4a\xab\x59\x5d\x2d\x81\x3c\x0a\xc4\x12\xf2\x29\x94\x9c\xe3\x9b\x34\x45\x0b\x9d\x5e\xcd\x80\x49\x6b\xbb\x8b\x52\xd2\x62\x6c\xc7\x89\x66\x64\xd6\x12\x87\x88\x1f\x06\x4c\xa2\x19\x02\x0c\x4b\x9c\xa5\xa0\xfe\x9b\x53\xd3\x6a\x21\xbe\x88\x64\x41\xc1\x82\x78\x4d\x66\x13\x00\xca\xcb\x19\x2e\xec\x5e\xa0\x63\x25\x07\xee\x3c\x04\x19\x8e\x64\x26\x40\x0f\xaf\x5e\xcc\x00\x39\x13\x02\x74\x84\x10\x4e\x58\x6d\xd4\xa6\x6d\xe9\x9d\x20\xff\x10\xda\x4b\x52\x8b\x61\x00\x55\xba\x47\xdf\xf8\x37\x96\xa9\x74\xed\xd1\xf7\x19\x90\x45\x31\x1e\xf6\xfa\xe4\xbe\x29\x0b\x3c\x5f\xc4\x04\x90\x01\x30\x52\x94\x32\xc4\x6b\x81\x9b\x5c\x4b\x93\x4f\xc4\x72\xf1\x0c\xc0\x5a\x41\x26\x52\x22\x4d\x15\x4d\x70\x80\xb7\x27\x91\xbc\xa7\xf5\x5c\x7c\x61\x34\x65\x91\x94\x90\x27\x31\x0b\x0b\xd8\xb8\x3c\xf0\x71\x9a\xa1\xd8\x4f\x13\x82\x4d\x7f\x39\xad\x30\xf0\x67\x07\xb0\x07\x79\xcf\x90\x4e\x70\x03\x4b\xd4\x74\x81\x0e\x41\xfc\xac\x4e\x71\xc3\x46\xd0\x1b\x64\x51\x13\x27\x4f\x02\xb4\x12\x17\x16\x6d\x5b\x14\x04\xa7\x32\x63\x40\x0c\x0c\x8e\x88\x10\x49\xcd\xcb\xe5\xee\xc8\xbd\x34\x86\x5b\x2d\x8f\x21\x78\x19\x1c\x20\xb1\xb3\x80\x46\xc5\x7e\x4e\x2c\x60\x90\xce\x7b\xc3\xe0\x08\x6c\x5f\xc3\xc9\xb6\x5d\x68\x92\x9a\x75\xd2\x82\x48\x92\xc5\x79\x5a\xbd\x8f\xc3\x1f\xd9\xfc\xde\xe6\xfa\x22\x19\x0b\xde\xf7\x97\xcf\x4a\xd2\x63\x0e\xd7\x62\x8c\x5e\x60\xdc\x34\x58\x65\x79\x9e\x8e\x23\xcb\x1d\xd3\xfc\x63\xe8\xae\x4e\xce\xf2\x88\x86\x4c\x30\xae\x9b\x37\xd8\xb7\x2e\x0c\xff\xc9\x15\x54\xa0\x2b\x02\x6f\x7d\x1d\x25\x36\xcb\x73\x80\x1d\x20\x0f\x42\x48\xfb\xb9\xf2\xee\x0d\x1d\x9e\x66\xdf\x3c\xe6\xb9\x5a\x9b\x70\x02\x1a\x28\x8b\xd6\xd0\x07\xca\x2e\xb4\xf8\xc2\x28\xc9\x95\x85\xde\x77\x84\x18\x06\xb7\x62\x21\x4d\x7f\xf4\x2e\x28\xb5\x5a\x82\xce\x04\x89\x5b\x47\x88\x48\x77\x00\x93\x40\x2a\xaf\x81\x13\x04\x1f\xba\x53\x2e\x01\xb1\x70\x7d\x64\x8d\x62\x45\xf4\x41\x3b\x20\x24\xf0\x24\xff\x8a\x0e\xa4\xcf\x88\xa2\x06\x82\xac\x7c\x67\x30\x1b\x2d\x2f\x86\xb8\x54\x07\xb9\x25\x3e\x3e\x18\xc7\x87\x24\x94\xfd\x5c\x12\x05\xde\xed\x9b\x68\xee\x81\x98\x22\x25\x0a\xa6\xab\xad\x7d\x4a\x3f\xa2\xf7\xce\x2c\x39\xf7\x80\x8a\x32\xf5\x2c\xe9\x07\xe7\x69\x81\xff\x39\xfd\x08\xcc\x03\xd4\xae\x93\x54\xe4\xf0\x11\xfd\x3a\xf4\x00\xfb\x5d\x21\x6f\xc6\xdb\xe2\x51\x0f\x4f\xe2\x65\xcb\xa3\x03\xc1\x1f\x66\x59\x48\x96\xbe\xed\x03\xcb\x7d\x10\x71\x26\x75\x4f\xc3\x1e\x80\x0f\x9d\x25\xa8\x82\x4b\x84\xfb\xd0\x01\x7a\x41\x69\x3d\x6a\x99\x33\x30\xfc\xd1\x01\x96\xa4\xc9\x80\x34\x96\x96\xd6\x29\x69\x01\xd7\x6a\x53\x43\xab\x4b\x6e\x73\xb9\xdf\x15\xb8\xd4\xb7\x40\x78\x7e\x8c\x67\x75\x81\x37\xe1\x1d\x29\xe2\x40\x01\xb1\x0f\x44\xa5\xa4\xf7\x55\xa8\x86\xac\xcd\x2b\xe9\x39\xca\x80\x3b\xb8\xe8\x25\xd6\x85\xc5\x68\xa2\x8e\x56\xa0\xc1\x16\xe9\x35\x4b\x27\x78\xac\xfc\xfe\xce\x57\x80\x0c\x19\xe9\xf6\x45\x47\x57\x34\x06\x0b\x2a\xbb\x06\xdb\x1e\x35\x13\xf7\x33\xf2\x10\xfd\x5b\x5f\x7f\xfd\x30\xe1\xc3\xe9\x59\x6d\xce\xb9\x2e\x78\x80\xbc\xdd\xf9\x19\x4d\xb6\x2f\xdc\xb7\xb6\xe4\x29\xde\x0d\x46\x48\x19\x7d\x83\xf6\xe2\xb3\xd4\x43\xc9\x92\xed\xf4\xd0\x4e\x0f\xed\xf4\xd0\x4e\x0f\xed\xf4\xd0\x4e\x0f\xed\xf4\xd0\x4e\x0f\xed\xf4\xd0\xcf\x4d\x0f\x75\x7e\x91\xf4\xd2\x7a\x39\x90\x7f\x92\x3e\xf8\xca\x63\x2c\x3d\xc1\xa4\x08\xbb\x9c\xff\xaa\xeb\x18\x15\xc7\x91\xd2\x25\x2e\xc9\x2d\x1d\x25\x4e\x9c\x21\x0b\x13\xa0\xfe\x57\x83\x57\x2f\x5f\x72\xa9\x5f\x66\x2b\x53\x4e\xdb\x7f\xfe\xde\x09\xdb\x55\x16\x5c\xbb\x94\x3a\xb0\x3c\xfc\xcc\x07\xe4\x69\xbe\x68\x99\x4e\xf8\x64\xb8\x29\xc2\xd7\x4a\x1c\x5a\xc9\x0d\x13\x2c\xac\x05\x24\x4d\x18\x98\xb1\xf9\x5a\xa0\x98\xb4\x28\x4c\x87\x9a\x81\x7a\x86\x21\x69\x2b\x0c\x14\xcd\x04\x57\x22\xcd\xd3\x89\x14\x12\x2a\xb3\x4a\xc7\xc5\x31\xd3\x5a\xc5\x0a\x91\xaa\x87\x6a\x17\x0c\x88\x0d\xfb\x5c\xda\xc5\x58\x54\xb9\xbb\x0d\x9a\x28\xac\x4e\xef\x35\x9d\x61\x1e\x02\xe6\x8f\x29\x11\x82\xdb\xa8\x32\x41\x0f\xc4\xf0\x9a\x73\x7f\x26\x25\xe5\xe9\x83\x6c\x93\x09\x65\xbd\xbe\x0c\x8e\x2e\x72\x10\x24\x32\x73\x3e\x33\x49\xf8\x45\xb6\xe0\xad\x53\x60\xce\x58\x19\xc6\xf1\x02\xfe\x19\x8d\x0b\x9d\x35\x21\xb3\xdf\x30\xab\x8d\x30\xda\x08\xcc\xcd\xb4\x5a\xe6\x3d\x4c\x39\xb8\x64\x40\xc8\x6b\x30\xdc\x64\xd1\x73\xf9\x0b\xbe\x7f\x28\x33\x98\xf1\x9f\x44\xab\xef\x3f\x70\xe2\xb7\xce\x62\x7c\xd9\x76\xc7\xe0\x28\xa6\x10\x50\x38\x77\x35\x52\xe8\xe6\x17\xa9\x85\x48\xe5\x86\x6a\x54\x2d\xb3\x0d\xf8\x40\x11\x0f\x47\xe7\x27\x88\x61\x84\x75\x99\xce\xd3\x38\xbd\x5e\xd8\xe7\x46\x5c\x8b\x02\xc6\x8e\x7e\x13\xcc\x22\xb8\x52\xb6\x1a\x15\xc0\x2c\x11\x44\x17\xd1\xec\x22\x9a\x9d\x27\xa9\xf3\x24\x75\x9e\xa4\xce\x93\xd4\x79\x92\x3a\x4f\x52\xe7\x49\xea\x3c\x49\x5d\x44\xf3\xf1\xf5\xd0\x2e\xa2\xd9\xe9\xa1\x9d\x1e\xda\xe9\xa1\x9d\x1e\xda\xe9\xa1\x9d\x1e\xda\xe9\xa1\x9d\x1e\xda\x45\x34\x5d\x5f\xe1\x82\xe8\xc1\x6a\x68\xe0\x45\x6b\x8b\x67\x7e\x71\x9e\x4e\xb6\x2a\x5c\xc5\xe8\xc9\xc6\xba\x55\x56\xb8\x67\x9c\x0e\xe2\x14\xbb\x6b\xc8\xe0\x13\x02\x94\xb1\xd9\x20\x0f\x67\x32\xc6\xd5\x0f\xfe\x9d\x26\xf0\xff\x58\x9d\x87\x0c\x02\xa3\x4c\x0d\x50\x65\x75\x3b\x00\x3b\xc8\x7b\xbd\xae\x2a\xb6\xab\x8a\xed\xaa\x62\x7f\x5b\x55\xb1\x37\x61\x2e\x6f\x82\x54\x4e\x36\x16\xc9\xf2\x2e\x94\xe6\x91\xc8\xa4\xff\xdc\x58\x23\xcb\x89\xc5\x3f\x6a\x15\x2d\xb2\x16\x45\xb6\xd4\x07\xa1\x22\x3c\x89\x97\x89\x4a\xbd\x11\x93\x8b\xfa\x5e\x59\xf2\x51\xfa\x4d\x68\x4b\x70\x7c\x00\x0c\xd8\xe9\x40\x5e\x87\x14\xfe\x08\xa7\xba\xba\x53\x16\x60\x85\xe3\x5d\x54\xba\xd6\xb7\xe9\x15\xd2\xb6\x63\xf2\x35\x31\x48\x75\xaf\xfc\xe8\xf0\x52\xb6\xd3\x53\xd4\xbd\x92\x67\x43\x2b\x22\xdb\xb8\xe6\xc8\x43\x02\x6c\x28\x5b\x50\x3b\x17\x63\xd1\x3b\x2a\xab\xaa\x89\x92\xca\xd2\x02\x06\x31\x0e\x73\xca\xd4\x70\xb2\x51\x7d\xfd\x45\xdb\xc4\xcc\x57\x90\xb2\x0c\x4c\x7a\xea\xe2\x28\x2f\x9c\x95\x7f\xb8\xb2\x12\xc1\x6b\x9d\x9e\x5c\x01\xb9\x56\x5f\x95\xd2\xa6\x4a\x82\x70\xf5\x3e\xfa\x19\x43\x6b\xa9\xa7\x05\x67\xe8\x6e\x1c\xa2\xbb\x71\x8a\xb6\xe1\x18\xdd\xce\x39\xea\xed\x20\x5d\x39\x42\xdc\x83\xd2\xb1\xe8\x2c\xbd\x20\x06\x35\x0f\xeb\x8a\xbf\xd4\x13\xa6\x8f\x97\x75\x6b\x6b\x7d\x1b\x6f\xeb\x0a\x72\x0d\xb1\x55\xdd\xdc\x3c\x71\xb1\xce\x61\x6b\xdc\xaf\x9e\x30\x37\x3b\x6d\x91\xab\x78\x02\x5d\x71\xdc\xd2\x0d\xd9\xd6\x77\xbb\xf5\xa1\xfa\x7a\x61\x57\x8e\x54\xb9\xe4\x22\x72\x79\xb9\x3b\x75\x6a\xd8\xaf\x7b\x72\x97\x1d\x88\x9e\x70\x97\xbc\xb9\x2b\x8e\x4e\x4f\xb0\xab\x1e\xdd\xf6\xd6\xbb\xc1\xab\xeb\xe9\x34\x5d\xef\x38\xdd\xc6\xb3\x8b\x3f\xeb\x9d\x9a\xca\x45\xe9\x7d\xa9\x5b\x72\x6c\x6e\xe5\xdc\x6c\xe1\x76\xf9\x3b\x39\xb7\x71\x74\xfa\x3a\x3b\xb7\x70\x78\x6e\xe1\xf4\xdc\x06\x4b\xa4\x90\xbe\xb5\x5a\x44\x3b\xd0\x98\xd5\x35\xda\x5f\xdb\xd8\x82\x3e\x56\x35\x6b\xb9\x11\xa9\xed\xcd\xc2\xb9\xab\xa9\x81\x3f\xff\x8b\x2a\x1d\x5d\xf2\xff\x83\x3b\x13\x65\x39\x1a\x74\x32\x28\x62\xff\xcd\x3d\x42\x90\x54\x16\xb6\x5a\x26\xae\x10\x96\x8a\x34\x0a\x40\x7d\x14\x5b\x99\x14\xaf\x5c\x2c\xc8\xf7\x97\xed\x0b\x77\xad\xf6\xfe\x06\x9d\x76\xa8\x74\x49\x37\x0c\x2c\x70\x0f\x7e\xdb\xeb\x6f\xc7\x91\x11\xcc\x59\xb2\x27\x95\xe5\x95\x08\x99\xd6\xc5\xdd\x8d\xa0\x24\x5e\x04\x7b\x04\x6b\x6f\x4d\xde\xb7\x8f\xb2\xe1\x65\xf2\x6c\x15\xae\x70\x7c\xcc\xb4\x65\xf5\x4f\x9b\xa9\x40\x18\x4f\xae\x72\x8d\x39\xed\xdb\x02\x63\xb4\xfc\xd1\x6a\x46\x83\x13\xcc\x03\xd3\x9c\xed\x1a\x29\xa2\xe8\xfd\x79\xa9\x56\xc0\xd9\x58\x9e\x89\x10\xac\xbc\x3d\x1d\x4f\x00\x55\xd7\xac\x7b\x6f\xb7\xd9\x3d\x9e\xbc\xcd\x87\x97\x17\xaa\x44\xe1\x7b\x37\xd3\x6d\xc9\x2f\xa8\x22\x2e\xaa\x0d\xf7\x95\xa8\xc2\x31\x6e\x41\xd6\x03\xed\xfd\xea\xe1\xb9\x61\x55\x4e\x05\x08\xfe\x98\x14\xd1\xc0\x7c\xc3\x09\xb0\xf1\x89\x91\x33\x57\x97\x02\xae\x52\xdf\x0b\x0f\xd6\x5c\xc5\x34\x2a\xba\xc6\x68\xb6\xc8\xbc\xf1\x80\x43\x31\xa8\x21\xf4\x04\x03\x55\x59\x99\xd0\x14\x0a\xec\x81\x2f\xdd\xd2\xc4\x6c\x9d\x20\xca\xce\xdf\xca\x5d\x2b\x5d\x24\x84\x13\xb2\x92\x2b\x1a\x70\xd7\x01\x74\x70\x88\x1a\xb3\xab\x8a\x1c\x58\xa8\xf4\x97\xc3\x27\x4e\x10\x75\x1c\x83\x4e\x03\x5d\xc0\x78\x5a\x91\xc1\xc0\x30\x38\xc5\xab\xec\x76\x97\xaa\xcd\x21\x24\xa4\xa9\x30\x8e\xd3\x7b\x37\x5e\xed\x71\x23\xdd\x75\xc8\x81\xc7\x49\x3c\x66\x61\xed\xfd\xce\x0a\x6b\x97\x1c\xe9\xbf\xd9\xba\x5a\x8f\xb0\x49\x57\x5c\xdb\x15\xd7\x32\xd4\xfd\xdd\x15\xd7\xd2\xe3\x52\xa2\x3c\x5c\x65\xcb\x0a\xbb\x53\x1d\x6e\x9b\x55\xb6\x41\xf0\xd3\x8d\x20\x6e\x82\x81\x45\xf8\xdf\xac\x8c\x8b\x68\x1e\x57\x11\x66\xb9\xdc\x58\x3a\x7c\x64\x42\x38\xc7\xa0\xa8\x73\x30\x5c\x31\xc6\xef\x97\xae\x30\xbd\x91\x52\xd9\x72\x92\x68\x1c\xbb\x8a\x12\x02\x30\xb8\x2a\x6b\x6b\xb5\xaf\x48\x66\x5f\x44\xbb\x0b\xef\x9e\xa8\x11\x14\x26\x7f\x96\xa4\xef\x01\x2a\x35\x31\x92\x1d\x57\xe5\xd8\xac\x5d\x29\x5f\xf1\x9d\xd0\x01\xf9\x6b\xf8\x77\xc2\x0b\xe2\x6a\xd5\x0a\x33\x71\xb4\x5f\x7f\x5b\xb5\xd3\x45\xdd\x24\x59\xc7\x33\x8b\x97\x55\xbf\x26\x75\x8e\x09\x14\xed\xeb\x87\xd4\xb8\xbf\x58\xda\xc3\x5f\x9d\xda\x44\x3f\xa0\xc0\x11\x82\xd3\x29\x5b\x1a\xdb\xa4\x53\x29\x6e\x3b\x88\xbd\x7b\x05\x9b\xb9\x81\x66\x2b\x78\xcc\xd6\x41\xbc\x83\xcc\x4f\x5a\x90\xdd\x52\x60\x99\x11\x54\xf6\xaf\xad\xf1\x0a\x28\x6f\x5f\x54\xd3\x5a\x20\xb9\xab\xaa\x79\xe8\x67\xfb\xaa\x9a\xe5\x80\xb1\xa7\x03\x7e\x63\xb0\xf8\x13\x2d\xae\x59\x13\xea\x0d\x42\xbf\xa8\xfe\xa6\x30\xaf\x15\xb0\xf5\x00\xbc\x26\xc4\xbb\x1a\xac\xf5\x49\xaf\xd8\x3a\xbc\xfb\xd4\xc5\x35\xab\x21\x5d\x9f\x90\x44\xd0\x10\xce\x0d\x22\x1f\xec\x3e\x18\xca\x25\x6d\xd5\x03\xe8\x83\x61\x5c\xbf\x75\x3e\x10\xc2\x55\x4b\xf6\x2a\x04\x5b\x0d\xdf\x5a\x81\x58\xbf\x0c\xa3\xa5\xd0\xed\x6a\x10\xd6\x03\x6c\x57\x8f\xf2\xa0\xcf\xe4\xb3\xad\x8b\x76\x0f\xc9\x6e\x1f\x8e\xf5\x3c\x7b\x4e\x18\xd6\x3b\x74\xfa\x40\x08\x76\x4d\x48\xd5\xd1\xed\xbd\x14\x7e\x6d\x3b\x9c\xba\x83\x50\xea\x0e\xc2\xa8\xcd\x21\x54\x77\xa5\xdd\xe3\xb6\x38\x3f\xe2\x1e\x06\x65\x86\x40\xad\x3f\x39\xb9\xc2\x37\x85\x3f\xd7\x84\x34\xf9\xb1\xde\x95\xd0\x67\x1b\xe1\x4c\x47\xe9\xe0\xc1\x17\x5c\xf9\x9e\x47\xf8\x92\x1f\xba\xb4\xfc\x43\xec\x3d\xf3\xfc\x48\x4e\xc1\x8d\x86\x90\xe5\x4a\x18\xd2\x23\x21\xa0\xdf\xe0\xb3\xe2\x07\x62\xd6\x87\x2a\xd7\xf8\xad\xdc\xc6\x78\x2d\x85\x29\x37\x7a\xae\xf8\xe7\x64\x85\x28\x1b\xc3\x8e\x6c\xa8\x14\x9e\x6c\x21\xe4\xe8\x78\x73\x5c\xe3\x4c\x6e\x61\xc6\x9d\x84\x8d\x1c\x0a\x4b\xe1\xfa\x6c\x5f\x5c\x6a\xdf\x41\xd7\xc9\xa8\x14\x11\x09\xc2\xbb\x14\xac\xd5\x79\x59\x14\x66\x58\xee\x03\x55\xa6\x0d\x20\xed\x1a\xd4\xae\xca\x74\x73\x95\x69\xed\xd8\xba\x52\xd3\xdf\x5c\xa9\xa9\x7d\xfe\x9c\x12\x53\x4b\xe5\xe6\xd7\x9b\x32\x0b\x42\x75\xbc\x90\x55\x6f\xca\x84\x29\x2b\x52\xbb\x7a\xd3\x8d\x4c\xa6\xab\x37\xdd\x7c\xa4\x5d\xbd\x69\x57\x6f\xda\x4e\x48\x70\x57\x61\x41\x66\x68\xb0\xab\x37\xdd\x5d\xa8\x70\x37\xe1\xc2\x36\x42\x86\xdb\x85\x0d\xbd\x43\x87\x8d\xe1\xc3\xae\xde\xb4\xab\x37\xed\xea\x4d\x5d\x82\x93\x5d\xbd\x69\x57\x6f\xea\x7b\xa9\xbb\x7a\xd3\xa0\xab\x37\x65\x6a\xb7\x5d\xbd\x69\xf5\xd3\xd5\x9b\x76\xf5\xa6\x5d\xbd\xa9\xeb\x4a\xba\x7a\xd3\x16\x79\x5b\x57\x6f\xba\x99\x3b\x75\xf5\xa6\x5d\xbd\x69\x57\x6f\x1a\x74\xf5\xa6\x26\xdc\xd0\xd5\x9b\xb6\x24\x4c\x1e\xa5\xde\xb4\x16\x78\xfe\xdc\x8b\x4e\x19\x9b\xad\x6f\x85\x53\x1e\x89\xb5\xa9\x4e\x95\xa7\x0c\xa0\x32\x13\x83\x5f\x79\xca\x69\x1e\x5c\x9b\x00\xdb\x58\x79\xca\xa9\xdf\xd4\xb5\xa9\xbc\xca\x53\x06\xc4\x7a\x6d\x2a\xaf\xf2\x94\x19\xcd\xb5\x6b\x53\xbb\xca\xd3\x07\x8d\x87\xae\xf2\xb4\xab\x3c\xa5\xdb\xd8\x55\x9e\x76\x95\xa7\xed\x1a\xe0\x5d\xe5\x69\x57\x79\xda\x55\x9e\xae\x3b\xa0\xae\xf2\xd4\x31\xe2\xd7\x55\x9e\xba\xfd\x74\x95\xa7\x5d\xe5\x69\x57\x79\xba\x1d\x46\xba\xca\xd3\x1a\xfd\x77\x95\xa7\x75\x2e\xd3\x55\x9e\x2e\x79\x12\xba\xca\xd3\xae\xf2\xb4\xab\x3c\xed\x2a\x4f\xbb\xca\xd3\x27\x08\x20\xb1\x80\x32\xbe\x74\x15\xe6\xe2\x6c\x16\x5e\x8b\xd7\x2f\xb6\x40\xe6\x55\x19\xc5\x93\x93\x28\xdb\x0a\xc8\x44\xcc\x45\x32\x11\xc9\xf8\x41\x05\xaa\x91\x87\xb2\xce\xbe\x19\xcd\xd1\xd6\x48\x21\x08\x67\x20\x77\xb2\x72\x5c\x3c\xec\x64\x5c\x29\x18\x3b\x49\xc7\xb7\x22\x9b\x46\x31\xc6\x36\x2a\x08\x2a\xcc\x44\x51\x32\xa4\xc2\x17\x0f\x7a\x14\xf1\x4e\xe2\xf9\xca\x95\x3c\x39\x46\x67\xe1\x9d\x48\x98\x38\x78\x87\xdf\x1d\x01\x47\x0e\x06\x83\x17\xdb\xf9\xcc\x90\x21\xc7\x1f\xc4\x3c\xcd\x23\xe0\xf6\x0d\x62\x8e\xc9\x38\x72\x41\xf5\xd3\xb9\x43\x25\xf7\x8f\xc8\xc1\x47\xe4\x14\x7f\x68\x4f\x6e\xbe\x40\x50\x3c\xa7\xd1\xf5\xbb\x70\x0e\x9c\xe8\x83\x98\x3a\x46\x89\xa5\x04\xcc\xa5\x37\x94\xa4\x40\x70\xac\x01\x36\x73\x5a\x37\x8f\x25\xdb\x4b\xb9\x72\x15\xa4\xe8\x52\xc2\x65\xf8\x62\x07\xec\x1f\xe5\xb8\xc7\xe2\xf6\xcf\xb1\x5a\x5e\xdd\x33\xaa\xad\x04\xc5\x7d\x18\xbc\x4b\x29\x20\x39\x4d\xb9\x0a\xd5\x4d\x51\xcc\xf3\xd7\x87\x87\xb7\xe5\x95\xc8\x12\x51\x88\x7c\x18\xa5\x87\x93\x74\x9c\x1f\xc2\xf9\x8e\xc5\xbc\xc8\x0f\x31\xba\x72\x17\x89\xfb\xc3\xfb\x34\xbb\x85\x5d\x0d\x50\xaa\x0f\x24\x67\xcf\x0f\x49\x11\x39\xfc\x82\xfe\xc3\x7c\xe9\xe5\xfb\x93\xf7\xaf\x83\xa3\xc9\x44\xd5\xed\x97\xb9\x98\x96\xb1\x2a\x27\x1d\x82\x16\x1d\xfd\x28\x32\xb4\xbd\xfa\x01\xbc\x6f\xc2\x35\xc0\xca\x68\xf2\xcd\xfe\x2e\xce\x28\x9d\x4b\x1b\xdb\xe3\x9c\x46\xa4\xd8\x2d\x50\x45\xa3\xad\xe2\x71\x19\x3a\x0f\xd8\x86\x20\x06\xc4\x91\x16\xb5\xf3\x4a\x69\x77\x0e\x9b\xbd\x4a\xd3\x18\x8c\x88\x96\xe7\xa6\x73\x5c\x31\x0e\x2a\x48\x2e\xc6\x99\x28\xda\xe2\x27\x12\xda\xf3\x62\x26\x46\x5b\xc5\xa5\x55\xac\x85\x12\x23\x86\x41\xf0\x8e\xef\x81\xbd\xc2\x7a\xfc\x3b\xf2\xc2\x2b\x68\x00\xbf\x63\x51\x1d\x8b\x6a\x83\x45\x8d\x24\x45\xa1\x81\xca\x8e\xe2\x7c\xf6\xfc\x89\xf9\x45\xcc\x44\x4b\xcb\xa2\x05\x5d\x8f\xf1\x42\xd2\x6b\xdf\x45\x59\x96\x6e\x67\xff\x10\x1c\x8e\x0f\xd9\xd5\xe3\xcc\xda\xe8\x6a\x63\x1c\x99\x7f\x56\x71\xe6\x60\x1e\xe6\x39\x98\x1e\x2a\xf7\x88\x54\xf4\x07\xde\x4a\x36\x21\x70\x99\x3b\x74\x88\x3c\x94\x18\xc7\x41\xb1\x28\x42\xb6\xf5\x14\x49\xdf\x83\x22\xd2\xa0\x4c\x8a\x28\x36\x1c\xec\x1a\x98\x52\x79\x35\x1c\xa7\x33\x8b\x99\x0d\xf2\xe8\x9a\x38\x59\x91\xa5\x71\x2c\xb2\x41\x01\x37\x21\x3f\x9c\x97\x71\x7c\xf8\x9f\x7f\xfa\x7f\x1e\xd8\xe4\x35\x36\x94\xa1\x18\xd3\xe4\xc5\x56\x34\xfb\x71\x60\x2d\x87\xc2\xcd\xd9\x9d\x18\x94\xc9\x6d\x92\xde\x27\x03\xc9\xf3\x5e\x07\x60\x08\x6e\xb2\xe3\x1e\x96\x10\x0c\x12\x98\x3f\x15\xe5\x31\xf0\x93\x89\xeb\x08\xa0\x2c\xc0\x9a\x06\xfb\x37\x13\x4c\x5a\xf8\xc9\x62\xa7\x64\x03\x1b\x40\x14\x23\x19\x8f\x05\x11\x74\xa4\xa0\xc6\x8b\x87\x64\x08\xca\xb7\x2c\x9a\x68\x1f\x22\xe5\x33\x67\x44\xd9\xc1\x3c\x0e\x0b\xcc\x8c\xae\xc0\x4b\xc3\xac\xcc\x38\x94\xff\x30\xdb\xd5\x20\xa5\x38\x70\xf1\x21\x48\x95\x24\x47\xb9\x49\x97\x36\x2c\x61\xdd\x70\x19\xd0\x3f\xc9\xf1\xa2\xd6\x30\x16\xa1\x93\x53\x12\x3a\x6e\x9d\xf2\x17\x64\x64\x48\xba\x2a\x98\x3b\x7e\x2c\x5f\x43\xa6\xed\xfd\x67\xe1\x4b\xd2\xd9\x70\x1f\xac\x00\x8f\xc3\x41\xea\xde\x4f\x26\xa9\xae\x62\x6e\x57\xb2\xc3\x18\xf1\xda\x17\x0f\xfa\x09\x30\x40\x25\x32\x72\x54\x27\xd5\x33\xe8\xa2\xcd\xd1\x03\x4e\x89\x8a\xdb\x7a\x5a\xa2\x59\x54\x34\x28\xf0\x3e\xd1\xd2\x30\x59\xbc\x67\xd8\x22\x03\xc7\x4a\x83\x81\x8b\x82\x37\x0f\x8b\x02\xd8\xf3\xeb\xe0\x7f\x0e\xfe\xfe\xe5\xaf\x83\xde\x37\x07\x07\x3f\xbf\x1c\xfc\xe9\x97\x2f\x0f\xfe\x3e\xa4\x7f\xfc\x47\xef\x9b\xde\xaf\xfa\x97\x2f\x7b\x3d\xf8\xfb\xf7\xef\xbe\xbb\xbc\x38\xfd\x25\xea\xfd\xfa\x73\x52\xce\x6e\xe5\x6f\xbf\x1e\xfc\x2c\x4e\x7f\x61\x02\xe9\xf5\xbe\xf9\x5d\xc3\xc2\x6a\x82\x03\xb6\x3e\x48\xb3\x81\xdc\xd1\x83\xe2\x62\x9d\xb1\xf0\x96\xce\x4f\x7d\x78\xa5\xca\x0c\x67\xe1\xc7\x68\x56\xce\x82\x70\x96\x96\x8d\x99\x7b\x60\x69\xac\x12\xab\xf6\xdd\x5b\x66\x07\xcb\xa0\xa8\x71\x94\xc3\x59\x98\x00\x3b\x1a\x28\xf0\x03\x03\x7e\x60\x68\xfb\x70\xbf\x0d\xd5\x51\xb5\x73\xeb\xc8\xf8\x93\x25\xe3\x0f\xea\x04\x97\x09\x39\x4a\xb6\x24\xe4\xcc\x34\x1d\x3b\x9b\x06\xe6\x2d\xa0\x49\xa4\x70\x6f\x9a\x73\xe6\xb1\x65\x61\x68\xb3\xe2\xa8\x40\x23\x2d\x2c\xe3\x82\xc2\xd5\xea\xfa\x51\x23\x3d\xd9\x47\x51\x7c\x9c\xc7\xd1\x38\x2a\xe2\x26\x0b\xca\xc4\x2e\xfb\xd2\x56\xbe\x8f\x64\xd5\x4a\x98\x80\x14\x9f\xcb\xbc\x0a\xba\x46\x03\x1d\x93\xa4\x80\x62\x93\xab\xe2\x13\xb8\xb0\x2c\xe5\x51\xbd\x79\x0b\x45\x60\xa9\xf9\x9c\x04\xd8\x14\x9f\xe0\xbb\xb5\x10\x49\x2a\xd7\xa5\x89\x41\xf0\x4d\x75\xc4\x33\x9c\x3b\x0f\x20\x83\x7b\x28\x78\x8c\x54\x01\x57\x90\x0c\x57\x23\x1b\x24\xdd\xee\x8b\xb0\xb8\x69\x0d\x22\xc7\xf7\xc6\x06\x46\x5f\x7c\xe1\xe0\x1a\xd2\xc4\x76\x09\x0f\x36\x05\x8e\xd8\xeb\x70\xb8\x5a\x0f\xea\xb5\x68\x5b\xcf\xb8\xd6\xd8\x07\xf9\xed\x76\xa2\x7a\x94\xe6\x23\x1d\x0b\xc7\x71\x98\xe7\xad\x84\xf5\xc6\xe1\x3c\xbc\x8a\xe2\xa8\xf9\xc2\xfa\xc8\xff\x1a\x32\x8e\xf5\xab\x16\xcd\x87\xea\xe2\x1c\xe7\x05\xd1\x1d\x38\xdf\x43\x01\xda\x23\x58\xd4\x34\x1c\x17\xcd\x5b\xf0\xf1\xf2\x13\x9e\xd5\x1b\xce\x26\x3b\x4d\x9b\xba\xce\xd2\x72\xbe\xe3\x77\xdc\x49\x67\xf5\x0e\xdf\xe1\x9a\xce\x3b\xb0\xd0\xcb\x7e\x44\xa1\xca\xc1\xad\xcc\xce\x2d\x74\x49\x5e\x43\x8f\xe0\x24\x7c\xc8\x2b\xb8\xdd\x65\xf5\x3a\x02\x87\xfd\xf2\xcf\x6a\x50\xbb\xd3\x2f\x5a\x5a\x01\xf3\x8b\x7c\x76\xc2\x62\x24\xdb\xb0\x10\x17\xe6\xe1\xc6\x36\x9c\x4e\xd9\x81\x55\x38\xc1\x75\x60\x0f\x0e\x70\x5d\xc8\xcc\x81\x19\x70\xd9\x80\x23\x39\x36\x5d\x7d\xde\xa5\xf7\xb9\xee\x6e\xfa\x1b\x63\xe2\x43\x96\xde\x45\x13\x91\x39\x24\x08\x29\xf5\xe8\x42\x3d\xd9\x74\x21\x98\x2b\x66\x91\x15\x0b\x16\x87\x94\x06\x2b\x7a\xd9\x8b\x16\x18\xdb\xc0\xa0\xf3\xc1\x2f\xa9\xad\x6e\x63\x2a\xb6\x6c\x28\x8e\x3a\x33\xf1\xb9\x9a\x89\xd4\x31\x02\xdd\x16\x69\xe6\x26\xb5\xce\xac\x07\xa9\x48\x43\x87\xfd\x03\x20\x50\xb8\xc1\xd3\x08\xc8\x90\x95\x9f\x9e\x66\xd7\x43\xb0\x02\xc6\x37\x62\x38\xc6\x1e\x11\xc3\xdb\xe1\x87\xb4\x2c\x44\xfe\x36\x0d\x27\x32\xdf\x81\xfc\x42\x70\xe4\x87\x73\xd9\xc0\x3f\xc5\x88\x55\x23\x60\x45\xc5\x2f\x5a\xd1\xfc\x1d\x84\x0d\x57\x7f\x8b\xc3\xe4\xba\x7c\x30\xbb\x76\x0d\xde\xdf\xaa\x87\x5a\xb3\x81\x31\x33\x34\x6c\x64\xd1\xcb\xab\x90\x47\x23\x0b\x4b\xad\x93\xd7\x39\x36\x0d\x67\xda\x8c\x6d\xf4\xfc\x51\x5f\x1c\x22\xd0\x39\x65\x28\x61\x44\x5b\x9e\x29\xf6\x9f\x51\x46\xf7\x33\xf4\x6e\xb4\xe4\x55\xc8\x0b\x31\x7f\x06\x41\xbb\xc6\x1c\x12\xc6\x7b\xee\xd2\xb8\x9c\x89\x77\xe8\x92\x6a\x4d\xa8\xfc\x58\xc1\xb4\x3c\xdc\xa1\x74\x7c\x51\xed\x08\x26\xde\xc9\x6f\x3d\x78\xae\x18\x02\xa6\xc8\x9f\x71\xc8\x0e\x5b\x90\x51\x6c\xff\x5b\x6d\x53\xf8\x80\x5e\x90\x6c\x74\xa6\x96\x84\x14\x2f\x0b\x4d\x38\x2c\x55\xe2\xdb\x2a\x46\xa2\xd5\xa0\xc7\x9e\xd2\xfa\x54\x69\x11\x81\x0e\xf6\x5f\xef\x0f\xdb\x75\x3a\x02\x86\xc2\xeb\x90\xd3\xae\xab\x5e\xba\xb9\xf4\x30\xfc\x15\x3b\x17\x51\x2f\xa1\x9b\xf4\x5e\xfe\x3d\x67\xd8\x3c\x82\x0e\x09\xa1\x08\xd9\xe6\x49\xce\xc6\x41\xd1\x01\x62\xc4\x42\xa9\xac\x61\x6c\x16\x50\x94\x3c\x71\x1f\x2e\x00\x36\xac\x61\xa2\x5a\x41\x21\x12\x73\x51\xf4\x83\x77\x4b\x0b\x3f\x4f\x93\x66\xa0\x91\x4c\x48\x50\x55\xdf\xa6\x40\xf3\x0a\x54\x7b\x8c\x43\xbf\x1a\xbe\x7a\x39\x7c\x4c\xde\xb6\x9a\x3c\x44\x09\x74\x54\x20\x45\xf8\xd3\x29\x94\xfa\x4e\xb5\xb6\xba\x4c\x84\x93\xf7\x49\xbc\x70\x33\x9a\x25\x41\xd3\xc3\x03\x2a\x21\xc5\x78\x51\x56\x8a\xbe\xfc\xe8\x3e\x03\x6e\x52\x05\x81\x1a\x8f\xe3\x60\x1a\xc6\x39\x0d\x00\x2b\x13\x13\x45\xea\x0d\xb1\x99\x95\x09\x4c\xd1\x57\x86\xad\x29\x9c\x79\x79\xb5\x35\x7f\x50\x17\x9d\x88\xdc\xb0\x87\x8a\xc4\xf7\xf3\x2d\x58\x85\xbd\xf5\xbd\xbd\xe0\x40\x7e\x93\x01\x32\x4b\xd3\xa2\xd7\x1a\x79\x28\x34\x61\x15\xb4\x13\xaa\xe0\x01\xb8\xe0\x58\x85\xd7\x84\x33\x86\x36\x52\xc3\xe9\x03\x38\xfb\x56\xdc\x84\x77\x8c\xcc\xde\x3c\x9a\x45\x71\x98\xc5\x54\xd2\x31\x92\x3b\xa4\x51\x76\x22\xb9\x8b\xb2\x34\xa1\x02\xf0\xbb\x30\x8b\xc2\xab\xd8\x64\x2c\x73\x0a\x82\x7f\x77\xf0\xe3\xd1\x87\x7f\x9c\x1f\xbd\x3b\xed\x11\x27\x14\x1a\x0b\x65\xae\x33\xb6\xec\x9d\x58\xaf\x6b\xce\xd7\xde\x44\x10\xea\xc0\xf5\x3e\xf0\xa4\x88\xb7\xaa\xdf\x59\x1c\x7b\x56\xea\x26\x7d\x1f\xc7\x31\xac\xf5\xae\x2d\xfe\xc2\xf3\xfc\x0c\x2a\x79\xdd\xf0\x3d\xe4\xa5\xbb\xd7\xfd\x24\x6a\x5b\xd6\x95\x6a\x0d\x7c\x68\x23\x13\x4d\xca\x3a\xf3\xa9\x79\x82\x20\xb6\x57\xc4\x7c\x7f\x9d\x34\x78\xb5\xa0\xd2\xd7\x4a\xaa\xaa\x5b\x06\xc0\xda\x50\xa3\xc2\xfb\xfc\x34\x0e\xf3\x22\x1a\x7f\x1b\xa7\xe3\xdb\x11\x98\x9b\x6e\xa2\x6c\xff\xe8\xa7\xd1\x0a\x84\x1a\x26\x92\x00\xbe\xd2\x4c\xfc\x51\x7e\x6b\x42\xe8\x26\x33\x21\x2c\x8a\x90\x66\xd7\x51\x26\x20\x26\x05\xc4\xa2\xd8\xdf\xcf\x49\xdb\x68\x76\xde\x87\x58\x8b\x2d\xb4\x22\x92\xe0\x6d\x4d\xad\xd4\x62\xc4\xa1\x6b\xf2\x41\x0e\xfb\x03\xf3\xf0\x50\x51\xd0\x17\x80\x40\x21\xb7\x7f\x85\xdb\xc7\x3f\x8b\xfd\x16\x5d\xcc\xd3\xfc\x92\x11\xca\x5d\x3d\x96\x37\x51\x2c\x54\x6a\x35\x5e\x07\x6d\x38\x2a\x7a\x64\x0e\xb2\x0c\x82\x45\x5a\x82\x66\x26\x7b\x67\xd0\x15\x06\x85\x2a\x9a\x03\xf7\x4f\xf2\x32\x13\x55\x2f\xae\xa9\x79\x1d\x3b\xe0\x81\xe7\x9b\x97\xf3\x79\x9a\x15\x55\x86\x21\x69\x91\xb2\x75\x06\xf2\x53\x09\x92\x57\xea\x72\xfa\x31\xc4\x1c\x94\xfc\x75\xb0\x27\x3e\x16\x5f\xed\xf5\x83\xbd\x8f\xd3\x1c\xff\x93\x14\xf0\xdf\x61\x70\x36\xd3\x69\x2e\x78\xde\xfc\x11\x85\xb2\x2d\xab\x04\x8a\x6a\x90\xa5\xc1\xec\x84\x7c\x58\x8b\x92\x55\x2b\xa8\xb7\x4f\xd2\xe0\x1e\x55\x72\xea\xc4\x1a\x08\xac\x0f\xc8\x35\x97\x70\x3c\x16\x92\xd9\xe4\xfd\x4b\x67\x91\x11\x68\xea\x1a\xed\xb7\x1d\x8a\x98\x63\x24\xa0\x60\x06\x23\xea\xd4\x8d\xe9\xaa\xe6\xf1\xa0\xae\x78\x10\x4d\x02\xdd\xf2\xda\x75\xd6\x69\xfb\x6c\xaa\x93\xac\x64\x13\x17\x95\x37\x45\x6d\xeb\xd4\x97\x58\x60\x81\x9a\xd5\x6a\x50\x0c\x0c\x2d\xda\x7c\x03\x2a\xb0\xfa\xd3\xe1\x44\xdc\x1d\xe6\x93\xf0\x55\x9f\x7d\x15\x73\x55\xc0\x53\xd4\xf6\x1f\xe6\xc1\xde\x2b\x20\xf0\x91\xd6\x7a\xf8\xa3\x90\xd4\x52\x2a\x58\x98\x3d\xa6\x17\x46\xed\x68\x5e\x82\x26\x02\x9f\xe1\xdb\xc7\x21\xaf\x09\x2c\xa8\xe6\x77\x72\xf4\xa9\xe2\x74\x0b\xd9\xe0\xa4\x37\xe4\xd0\x90\x6b\xef\x6f\xb7\x34\x46\xae\x35\xb4\x4a\x73\xba\x78\x6a\x0f\xcd\xa0\x3d\x32\x58\x52\xf2\x95\x25\x13\xea\xb1\xc7\x45\xf9\x07\xb5\x82\x0a\x39\x40\xbf\x96\xb7\x45\xea\x7f\xf4\x12\x24\x48\x16\xcc\x4d\x44\xbb\x47\x36\xd5\xde\x93\x48\x39\x37\x6b\xad\x22\xc6\xb3\x13\xf7\xb3\xf9\x21\x89\xfe\x55\x8a\xe0\xec\x44\xcb\xb9\x39\x06\x69\x72\xf4\xe6\x07\x13\x5b\xb3\x60\x61\x33\x22\x95\x25\x38\x38\x9a\x85\xff\x86\x2b\x71\xfa\xed\x48\x2d\xad\xf7\xc4\x88\x6c\x3d\x16\xab\x31\xde\x56\x7c\x35\xfc\x37\x68\x05\xa8\xca\x39\xe9\x90\x47\xfa\xa9\x65\xbd\x11\x3f\x0f\x4e\xc2\x22\x24\xf5\xb0\x59\xdf\x23\x67\x65\x9a\x54\x9a\x04\xde\xcd\x2b\x9c\x4e\x2c\xff\x64\xe9\x7d\x2d\xaa\x68\x63\x92\x8f\xd7\xef\xd2\x89\x87\x9e\xf6\x37\x5c\xe6\xb1\x84\x00\xab\x04\x10\x01\xfa\xb7\xfa\xc4\x26\x02\xe4\x13\x7d\x36\x57\x09\x7e\x42\xaf\xcc\xb0\x75\x41\x8d\x37\xe8\x9c\x59\x19\xbc\x52\x55\x62\x97\x06\x63\x50\x5d\xde\x47\x25\xb2\xe1\x12\x5c\xf1\xfa\x55\xcb\x7b\xb4\x8b\x9d\xfd\xf0\xe1\xcc\x6b\x63\xf0\xdc\xe6\x4d\xed\x6a\xc1\x9e\xe6\xc0\xb2\x35\x50\x69\x3c\xef\x54\x19\x6f\xc8\x1c\x03\x5e\x87\xd3\xa0\xbf\xf3\x8e\x56\xea\xf8\xa0\x23\x0d\x9b\x35\x77\x26\xff\x96\xda\xfd\x83\x9a\x7b\xdb\x27\x83\xb5\xe1\xee\xd7\xff\xf4\xe3\x5c\xf6\xb2\x52\x8d\xfe\x46\x37\x21\xb2\xee\x6a\xfa\x01\xfb\x86\x20\x05\xe6\x28\xf7\x34\xe9\xa1\x0f\x81\xb8\x5e\x70\x22\x26\x54\x8d\x07\x70\x55\x31\x9d\x13\xd4\xf5\x40\xdf\x51\xde\x3d\x80\x24\x9e\x1f\xc8\x34\x7c\x9e\x41\x55\xdd\x98\x03\xe9\x5a\x4e\xf4\xe3\x41\x78\x17\x82\x0a\xab\x32\x56\x41\xab\xea\x0d\x99\x39\xa3\x95\xeb\x2c\x27\x14\xb6\xce\x04\xbd\x15\xc7\x15\x17\x77\x70\x80\xb0\x0e\xc9\x85\x0e\x1a\x85\xd6\x07\x59\x1b\xa5\xf6\x6f\x14\xb8\x95\xca\x27\xde\x39\xa3\x50\xaa\x76\x41\xcb\xfa\xe4\xb0\x65\x45\xcd\x29\xa7\x50\x89\x0e\xe6\x57\x81\xa7\xb6\xaa\x8a\x20\xdf\x73\x57\x45\xf0\xa9\xb5\xaa\x08\xfd\x61\x84\xfd\x27\x18\x9a\xe4\x13\x69\x23\x1d\x9d\x5a\xa2\x85\x4a\x93\xbd\x54\x17\x9a\x0c\xa4\x54\x17\xdd\xc2\xa5\x36\x11\x80\x28\x82\x85\x8b\x91\x62\x9d\x47\x8a\x75\x92\x46\x84\xb4\xe0\xd2\xd7\x8f\xc9\xa5\x88\xf9\x79\x6d\x98\x24\x4f\xc0\xba\xab\x3b\x33\x47\xaa\xf3\xe2\x7c\x59\x6f\xb5\x2d\x8e\x01\x16\xdb\xcd\xd4\xb1\xbc\x02\x1e\x79\x33\xaa\x3b\xfe\xf1\xb3\x00\x3e\xb4\xef\x7f\xe3\x76\x64\xf4\x1c\x09\x8c\xb6\x95\xcb\x70\xc1\x7e\x1e\xc4\xd1\x54\x30\xf2\x70\x5c\x38\xc4\x2c\x4d\x22\x4e\x3e\xda\xaa\xb6\xf2\x41\x9f\x25\x98\xc1\x12\x86\x6c\x02\x3d\xc6\x76\x16\x63\xe6\x58\x2a\xaa\x7a\x24\x24\xe9\x85\xac\x33\xaa\x85\xf2\x98\x0d\x6f\xbf\x26\xb3\x5a\x19\xd0\x87\xf2\x90\x0e\x3f\x9c\x1e\x9d\xbc\x3b\x1d\xce\x26\x5f\xdc\xa4\xf7\x83\x22\x1d\x94\xb9\x18\x44\xc5\x7e\xbb\xa5\x28\x5e\x79\xf9\xbc\xc2\x82\x39\x23\x34\xbd\x7a\x00\xef\x75\xef\x9f\xe0\x87\x5c\x4e\x29\x22\x07\xad\x0e\xd6\xa7\x69\xc1\xb3\x16\x41\x3d\x97\x0d\x2b\x42\xe5\x27\xc6\x3e\xc4\x74\x26\x45\x26\xc0\xf8\xb4\x5c\x48\x87\xcf\x47\x93\xb2\xb6\xdf\x20\xac\xdc\x1c\x71\x1c\x61\xc5\x75\x1c\xd5\x04\xda\xe3\x12\xb6\x8f\x6c\xe4\x28\x48\x0f\x9d\xc3\xc8\x40\xd1\xa3\x5d\x28\x1d\x80\x39\x1e\x18\xfb\x48\xd1\x28\x05\xf4\x3a\x03\x45\x67\x75\xca\x13\xc5\x98\xd0\x72\x08\x38\xc8\x86\x72\xc1\x2c\xb8\x4f\x80\x76\xae\x7c\xa6\x4d\xb0\x7a\xde\x35\x21\x1d\x80\xc8\x06\x44\x2a\x79\x81\x8b\x74\x9a\xd8\x58\xf5\x68\x41\xa7\xbf\x52\x70\xd6\x9d\x02\x0b\xa4\x1a\xb0\xf1\xa8\x68\x77\x2d\xf2\xe3\xb7\xb6\xdb\x51\x73\xbb\x27\x6a\x6f\xe7\xd2\xe0\x8e\x0d\x92\x1a\xe1\x39\xb4\xb8\xdb\x65\x89\x5b\x80\x1b\xca\xb6\x94\xa5\x99\x66\x5f\x59\x38\x49\x29\x75\x30\x23\x8a\xe9\xbb\xb8\x1f\x48\x19\x9a\xcc\x40\x0a\x3c\x57\x06\xe4\xa2\x8c\x6b\xed\xac\x35\xf5\x1a\x68\xc6\x31\x0d\x7e\xff\x98\x9e\xa9\xeb\xd7\x12\x8e\x8e\x5c\xea\x0c\x91\xe6\x2c\x28\x6d\x77\xcb\xae\xd6\x2a\x97\x44\x66\x92\xe8\x10\x37\x8b\x85\xcd\x16\xf9\xbf\xe2\x81\x5c\xc5\x60\x3e\xa9\x4e\xee\x39\xa6\x7d\xac\x38\x7a\x59\xf4\x1c\xee\xd8\xd1\xcb\x4e\xd4\x68\xcf\xdd\xeb\xca\xaf\x5b\x3f\xfd\x4e\x4d\xde\x4a\x4d\x6e\x01\xef\x6e\x9a\xf1\x96\x3a\x1a\x4d\x6e\xce\xf5\x04\x3e\xd9\x62\x95\x3f\xfc\x46\x79\x99\x68\xea\x73\x98\x81\x1c\xc2\xb9\xc3\xa6\xc3\x1e\xfc\x35\xc1\x4e\xc2\xf0\xcf\xf7\x73\x91\x8c\x80\x03\xde\x0e\x3b\x9d\xa9\xd3\x99\x1c\x74\x26\xff\x5c\x0b\x9d\xd2\x3a\x31\xf4\xa8\x2a\x12\x17\x56\x16\x16\x97\x0f\xc8\x0b\xfd\x58\x1c\xe0\xd9\xe7\x4f\x98\x41\x0b\x6e\x5e\x48\xd3\x65\xbe\xae\x28\x99\x8f\x95\x63\x11\xd3\xe9\x9b\x1d\x53\xe9\xbc\xc4\xc9\xb4\xb2\x1e\x90\x75\x9a\x6e\x8d\x63\x48\x22\xf9\x25\x4b\x54\xec\x15\x33\x25\x82\xab\x48\x72\xd8\x32\xa7\x51\xd8\xc0\x64\xd9\x23\xc5\x49\xbf\x41\x5d\x46\xad\xc7\x0a\x84\xab\x21\x48\x57\xa2\xb8\x17\x22\x09\x5e\xa2\xe6\xc8\x82\xf9\xf2\x8f\x7f\xfc\x63\xbd\xac\xe3\xe5\x1f\xbe\xfa\x0a\x3e\x01\x6a\x1a\xcb\x1e\xa1\x56\x9d\x04\xaf\x93\x0d\x3a\x53\xd0\x2f\x4f\xc3\x89\xa6\x53\x19\x2b\x26\x15\x0c\xb3\x67\xa5\xcc\x55\x35\x4e\xb3\xe8\xfa\x86\x99\x9d\x48\x09\xe9\x48\x1e\xa0\x66\x15\xb2\x47\xab\x64\x73\xb2\xd4\x54\xcd\x6b\x92\xef\x63\xfb\x14\x68\x8a\x0c\x9e\x4b\x3f\x88\xa3\x5b\xf8\x3d\xff\x0e\x9b\x37\x54\xb3\xec\x80\x32\xd1\x48\xe2\x66\x11\x5e\xa9\xfa\x22\xeb\xac\x61\xc3\xcf\x20\x85\x90\xed\x40\xae\x17\x74\xd7\xf4\xd1\x7e\x20\xc0\xe4\x40\x07\xd8\x40\x92\x1b\x0e\x42\xe4\x32\x4d\x44\x26\x65\x45\xc9\xb2\xb6\xba\x98\xc5\x01\xa5\x86\x21\xf0\x72\x60\x51\xa5\xbb\xa2\x64\xcd\x7f\x4a\x02\xa3\xce\xbb\x56\x4a\x6d\x98\x2b\x93\x40\x8e\x09\x63\xeb\x0e\xda\xa8\xd6\x13\xab\x55\x81\xbd\xfe\x58\x36\x07\x44\xcc\x54\x78\x61\x93\x1b\x0e\xcb\x83\xa5\x02\xe8\xfc\xa1\x1d\xb8\x8d\x5b\xc3\xfb\x96\x4b\x8a\x2d\x93\x95\x37\xe0\x25\xa4\xb7\x10\x8b\xe5\x69\xcf\x67\x53\x35\xa7\x22\xaa\x06\x11\x4e\x54\x2d\x99\x1a\x3a\xa6\xe0\xc1\x9a\xd9\x9b\x37\x07\xdc\xb7\x8f\x09\xae\x47\x39\x97\x4b\xa5\x34\x70\xdc\x03\xa7\x90\x5f\x12\x35\x2e\x67\x16\x66\xb7\x68\x1f\x2b\x3e\x3b\xa4\x82\xb8\xdc\xf4\xfa\x97\xc3\xa5\xef\x98\x46\x24\x1a\xdc\xe1\xa2\x56\x0c\x8b\x8b\xdd\x1f\x0e\xf7\x25\x63\x4b\x31\xad\x24\xcc\x24\x07\x62\xc1\xc4\x67\x87\x3b\x6d\x37\x36\xcf\xe5\x5c\x5f\x54\xbd\xd5\x48\xa0\xd0\x2e\x71\xe3\x36\x14\x53\x67\x32\xdc\x51\xc3\x32\xa7\x69\xec\x9b\x66\x1d\xa9\xeb\x32\xdc\x65\x3b\xb2\x19\x53\xd0\x3b\x8b\x7b\x97\x71\xa5\xb2\x04\x38\x06\xc9\x34\xdb\x5a\xca\xdb\xb2\x1e\x2e\x37\x55\x29\x57\x5c\xdd\xba\x8d\x96\xa6\xe3\x00\x58\xb3\x32\xab\x78\x99\x04\xfb\x92\xd0\x76\x82\xf8\x90\x78\x37\xa2\xdb\x01\x22\x4f\xc8\x73\x9d\x4d\x2a\x26\xec\x29\xea\xfd\x04\xbe\x8f\xd8\x77\x8b\xdb\x6e\xb8\x79\x9a\x83\x2a\x06\x38\xf5\x41\x3f\xfa\xf4\x48\xa9\xd7\x17\x19\x94\x57\xc5\x67\x91\xb2\x93\x20\xbc\xca\x81\x04\x0b\x17\x98\xb8\x9c\x0a\x8c\xcd\xae\x69\xa1\x7a\x66\x32\x97\xff\x9a\x40\x9c\x02\x58\xb1\x79\x29\x94\x25\x7f\x75\x83\xf6\x08\x7d\x0a\xf9\xe3\xc6\x07\x84\x97\xa7\xee\x4e\x98\xf8\x24\xfa\x34\xfa\x66\x9e\xc2\xe7\xb2\x93\x21\x4c\x5c\x5f\x8b\x13\x65\xb9\x8c\x5f\x72\x9a\x0f\xc7\x9e\xbf\x44\x0a\xa9\xfb\xf8\x25\x17\x27\x28\xdf\x61\x91\x47\x6e\xae\x8a\xd1\x59\x70\x70\x6c\x8a\x99\x75\x6a\x1c\x75\xbb\x9a\x86\x63\xd1\xb3\x5c\x18\xcd\x8e\x5a\xf5\xb4\xae\x16\xbe\x01\x21\x14\xeb\x9a\xe9\x40\x7c\xc4\x16\xf4\x61\x4c\xef\x9c\x64\xc0\x74\x9b\x79\xfc\xc1\x51\x3c\xbf\x01\x53\x47\x84\x45\x99\x35\x3b\xc8\x9d\x1c\x1f\xb4\x02\xf7\xac\x4c\x7a\x4c\x5b\x4c\x89\x75\x75\x1d\xb6\x25\x95\x20\x40\x92\xc4\x50\x6e\xfb\x76\x86\x48\x84\x24\xb3\x89\x3d\x2f\xd2\x32\x93\x41\x4c\x9e\x1f\x25\xcd\x54\xd3\x83\x0c\x9d\x1c\x72\x81\x61\xae\xc6\xb9\x88\x8c\x4c\x31\xf9\x8d\xb8\xc4\x0f\x86\xcf\xbe\x22\xc2\xaa\x43\x60\xa1\x60\x39\x78\x35\x55\x46\x1d\xb5\x12\x54\x2a\xa1\x9c\xef\x4e\x2a\x27\xcf\x1a\x5b\x9e\x82\x05\xbf\xa4\xe3\x88\x3a\xee\x54\xe7\xae\xcc\x48\x54\x19\x99\xa6\x8e\x6a\xf8\x53\x2b\x13\xb4\x43\x7d\x29\x35\x56\x5c\xb4\x7e\x48\x38\xf3\xfb\xa2\xbc\x02\x8b\xfa\x66\xe4\x1d\x5d\x39\x5f\x03\x44\xe6\x1f\x1a\xd7\x07\xb7\x9e\xdb\x1a\xcc\x28\x99\x9c\x1d\x71\x01\xe6\x93\x47\xa4\xa4\xa1\x38\x44\xc5\x92\x9b\xd8\x88\x36\x15\x9c\x94\x7e\x83\x75\x52\x14\xb5\x41\x77\x76\x21\xf4\x9f\x58\x10\xad\x3d\xab\x26\x13\xa8\x68\xe3\xa7\x3f\x24\xf3\xda\xe7\xe3\x30\x8e\x73\x9e\x36\x55\x6f\x89\xa4\x05\x9a\xd4\xe1\x75\x03\x0a\x49\xb1\x11\x12\x33\x2f\xb8\x2b\xb1\x19\x2d\x8d\xaf\xd8\x88\x68\x9e\xa8\x9b\xa5\xb2\xc9\x40\x02\x06\x9f\x06\xd4\xc7\x49\x2f\x1a\x68\xd5\xba\x05\xdd\xa4\x4c\x1f\x0f\x5e\xac\x61\x17\x2e\xeb\xc2\x65\x7c\xbd\xdc\x3b\x18\x3f\x52\xbe\x01\xc9\xa6\x74\x33\x2d\xde\x14\xb3\x75\x82\x56\x0b\xed\xed\x23\xfb\x3e\xb5\xd9\x47\x05\x60\xf7\x0a\x1b\x4d\x3e\xab\x86\xe8\x6b\x9a\xf1\x54\x2b\x25\x35\x11\xfe\x23\x39\xf1\x40\x39\x6b\xc6\x8e\x8e\x39\xe5\x33\xc1\xe6\x77\x35\xc1\x5c\xf1\x78\x1e\xef\xd5\xca\x16\xe9\x59\xf2\xc1\x7d\x58\x5b\x3a\x2e\xcd\xf0\x1c\x3a\x6b\x93\x70\xc3\x6b\xe3\x40\xd5\x83\xc3\x27\xec\x1d\xcf\x51\x44\xd9\xef\x9e\xa4\xf7\xc9\x7d\x98\x4d\x8e\x2e\xce\x9c\xac\x8b\x93\xea\x39\x3b\x14\xaa\xc1\x05\xf8\x79\x78\x95\x96\x05\xab\x25\x99\xee\xd7\xa4\x1b\x91\x75\x91\xd1\x2e\x32\xda\x45\x46\x77\x10\x19\xc5\xa7\xa4\x22\x8f\x21\x37\xd4\x83\x6a\x37\xd6\x21\xa3\x84\xe9\x49\xf5\x0d\x0f\x59\xfc\x45\x4a\x99\xa5\x4a\x51\xa6\xa2\x62\x99\x15\x64\x18\xd0\xed\xac\x28\xc5\x32\x45\xb8\xb7\x21\x20\x66\x45\xea\xd6\x8e\x02\x4e\x04\x9b\x69\xb5\xad\x63\x50\x55\xe9\xd8\x48\x60\xad\x98\x8c\x28\xcb\xf0\xb5\x53\x28\x87\xf8\x32\x48\x11\x54\xa3\xc2\x04\x6e\x3f\xe1\x31\x87\xab\x15\x5e\x89\x18\xfe\x9b\x84\x33\x17\xcf\x37\xde\x41\x52\x76\xe7\x21\x36\xf3\xc9\xac\x5c\x57\x87\x00\x84\x3b\x42\xe9\xdd\x46\x2f\x76\x79\x6a\x59\xdf\x91\x10\xb4\xf9\x90\x8f\x6f\xc4\x2c\x74\xa0\x1c\xfd\xf3\x06\x4f\x83\x1a\x65\xc2\x55\x44\x35\xb2\x10\xb2\xb5\x96\xc8\xe0\x7a\xa6\x53\x53\x15\x92\x3b\x02\xc6\x76\x46\x77\xaf\xf6\x86\x4e\x8f\x79\x84\x1a\x0d\x99\x5e\x38\xc5\x68\x56\x10\x7a\x51\x0b\xcd\x20\x89\x62\x57\x01\xa2\x5a\xc7\x9d\x2b\xd7\x57\x15\xf7\x27\x76\x26\xcf\xeb\x11\xd0\xe1\x1a\x00\x91\xaa\x9c\x41\xa1\x63\x64\xc6\x21\xbd\xb5\x0b\x0b\x77\x61\xe1\x2e\x2c\xbc\x2a\x1a\x35\xf7\x55\x28\x73\x48\xb6\xb1\x94\x62\x3b\x46\x40\xfa\x84\x2c\x8b\x50\x36\x80\x54\xe7\x1d\x60\xea\xb0\xb2\x8a\x29\x63\xa0\xaa\x96\xcd\x83\x41\x5c\x99\xcf\xe3\x14\x18\x56\x17\xae\x2c\xa6\x83\xaf\x03\x91\x8c\xe1\x84\x89\xb0\x71\xcd\x19\xfc\x0d\x55\x34\x77\xd5\xa0\x1e\x5e\x9f\xe9\x5e\xfc\x56\xf8\x59\xae\x77\x7f\x97\x29\x2f\xba\xd7\xdc\x9b\x2d\x55\xa6\x4a\x51\x32\x7d\x71\xd5\xb1\x9a\x6e\xc0\x2e\x9c\x9d\x34\xa6\x6a\xca\xb0\x9c\xa2\x4e\x17\x54\xcf\xa2\x0e\x0e\xe4\x87\xc3\xf1\xbc\xec\x3b\x40\x56\x4f\xcd\xc4\x2c\xcd\x16\x7d\x03\x0e\xc1\xd4\xe0\xab\x6f\xf4\x5c\x34\x24\xd0\xc7\xc6\x65\x86\xfe\x50\x2c\x32\x79\x34\xcd\xcc\x60\xf8\xdc\xc1\xa7\xbb\xee\x10\xab\x58\x27\x79\x87\x8d\x4c\x76\xd4\x24\xa6\xa6\x6d\x28\x68\xb8\x66\x20\x0c\x7e\x2a\x92\x3b\xec\x64\x9e\xef\x3f\x86\x7a\x35\x89\xee\xa2\x3c\xcd\xdc\x10\xc2\x1c\x48\xbe\xac\x87\xf8\xb0\xe1\xe5\xa7\x9d\x37\xb8\xd1\x7b\x4b\x53\x77\xca\x62\x5e\x16\x4a\xaa\x38\xae\x48\xdd\x5c\xdd\x81\xda\xdc\xc3\xbe\xdd\xbb\xca\x11\xe6\xde\xab\x3d\xa7\x27\x9e\xed\x54\xf6\xfa\xcf\x56\x33\xda\xd7\xb3\xe2\x6d\x6e\x70\x25\x9e\xab\xf6\xe4\xda\x1e\xd8\x7f\xae\x4a\xbc\x53\xbf\x53\x2f\x1d\xde\x3d\xbb\xea\xe9\x33\xa6\xd8\x80\x29\xfa\x79\x12\x39\x56\x2b\x9f\xaa\xa7\xea\x65\x38\xa0\xc8\x80\xd0\x0a\xb3\x05\x70\x4f\xe9\xee\x5c\xf0\xe6\x73\xd5\x3a\x01\x59\xad\x80\xb6\x6e\x42\x4b\xbb\x83\xc5\xb4\x59\xb0\x3c\x13\x93\xa8\x9c\xb9\x3b\xae\x7f\xa2\x0e\xf2\xaa\x43\xbd\x4e\xe8\x91\xc0\xb8\x15\x4b\xe4\x12\x0d\xc7\xb7\xd2\xa0\x33\x48\x96\xda\xa4\xdd\x0c\x79\x4f\x25\x4c\xcc\x44\xc8\x8c\x3f\x2b\xb3\x92\x52\x6f\x40\x43\xc5\x53\xd0\x00\xe5\x1a\x2d\xef\x78\xc2\x6f\x95\xa1\x13\x20\x0f\x14\xb0\x1e\x6a\xd5\xef\x48\x39\x7a\xb4\xc3\x75\x66\x48\x79\xf4\x6f\xf1\x16\x35\x3d\x56\xe8\x8f\x2f\xf3\x7d\x24\xfd\x60\xab\x08\xe1\xfe\x65\x5a\x80\xf6\x14\xaa\x0e\x5d\xd3\x20\x4e\xc7\xf0\xbb\xa6\x3e\x27\x15\x4d\xc6\x65\x81\xba\xcc\xe5\xd7\x11\x5a\xa4\x3e\xc4\x99\x54\x8f\xc9\xbf\x1d\xe7\x3c\x11\xaf\xa6\x9b\xe2\x6c\x1a\x84\x2f\xd5\x66\x43\x71\x97\xd4\x93\xff\x63\x34\x83\x2b\x52\xe6\xbc\x26\xb3\xa8\xfe\xd7\xe1\x54\x0b\xbe\xd7\x63\x76\xa8\x97\x54\x94\x20\x60\x7e\xd4\xd1\xf8\x4a\xf0\xe9\x91\xa6\x11\xcb\xe7\x45\x65\xe3\x5c\x37\x0a\xb9\xcb\x4a\xb4\xfc\xf4\x6a\x95\x9d\x82\x93\xb1\xe2\xb8\xd2\xcb\x73\x6e\xb9\x4c\x28\xc7\x7c\x2c\xf1\x82\x24\x8a\x6d\x66\x60\x06\x59\xf0\xfa\xdb\xeb\x03\x2d\x13\x95\x7e\xb9\x72\x6b\xd7\x5f\x5a\x6c\x2b\x32\xb8\x2e\xa3\x89\xdf\x75\x7d\xd6\xfa\xdb\x96\x5a\x1b\x5b\x34\x4f\xc7\x4e\x42\xf9\xcd\x71\x5d\x1a\xbf\x89\xae\xe0\xa0\x8e\x6f\xc2\x24\x11\x31\x5f\x4d\x7a\x78\x20\x4d\xbd\x8b\x88\x99\x37\xd3\x08\x75\xcd\x3c\x9a\xae\x87\xc8\xc3\x42\xe8\x69\x9a\x45\x57\xe3\x56\x78\x41\x43\xd6\x48\x96\xd5\x71\x2b\x6c\x16\xb9\xab\x91\x2c\x71\x99\x6c\x93\x7b\x00\x97\xad\x08\xb3\x6b\x51\x20\xa0\x00\xf8\x0d\x30\x84\x4f\x79\xe4\xc7\xa7\xd6\x48\xa5\xfd\x5e\x28\xf2\x38\x7f\xfa\xe9\x3c\x6f\x87\x2e\xee\xd3\x2c\x9e\xdc\x83\x00\x0c\xf8\x09\x86\x07\xf8\xfa\xde\xa7\xd3\xda\xf3\x1e\xf6\xb7\x2d\xba\x54\xb0\x88\xd0\x15\x10\xbe\xac\xb1\xdf\x3c\xac\xd1\x32\x7a\xc1\x69\x24\xc7\xab\xe2\x6f\xd2\x79\x3f\xbb\x8a\x12\x99\x7c\x80\x4e\x29\x73\xc0\xec\xa2\x5e\xbc\xdb\x3a\x60\x46\x03\x5a\x71\xb0\x22\x85\x09\xd2\xe2\x06\x47\x2f\xc2\x45\x09\x13\x91\x96\x79\xbc\x18\x7e\x2a\xc7\xc6\xd7\x3f\x62\xf1\x51\x5e\x3a\x37\x3d\xc4\x3c\x56\xd7\x47\xae\x05\x28\xb3\xd1\x98\x9b\xe4\xb2\x32\x21\x8f\xea\x0c\x30\x9e\x2c\x26\x87\x46\x39\x91\xc3\x28\xa9\x06\x46\x34\x27\x3a\x5e\x85\xa8\x83\xcc\xe3\xf2\x3a\x4a\x9e\x75\xc5\x8b\xc9\xa5\xe7\x55\x7f\xe5\xa2\xb2\xce\xf8\xd5\xd8\xdd\x38\x8e\x46\x0d\xcb\xb2\xa5\x5c\x97\x39\x11\x73\x91\x20\x23\x4a\xec\x1b\x21\x51\x33\xdc\x4d\xa9\xde\x33\x4e\x55\xb6\x78\xfe\xe9\xc7\x22\xc3\x96\x3d\xb3\x19\xb2\x58\x1d\x91\x8f\xa6\x4c\xae\xbc\x70\x90\xfd\xbb\x4e\x79\xef\xd4\xa6\xe7\xd2\xe6\x77\xf7\x25\x4e\x52\x6a\xa8\x0b\x9c\xeb\xcc\x14\xab\x84\x88\x77\x20\x98\x30\xb3\xb4\x5a\xbb\x5f\xca\x52\x29\x11\xcf\xdf\x55\x2f\x37\x6a\x2c\x25\x62\xea\x3f\x2b\x05\x00\x4b\x18\xe8\xda\xf0\x75\x75\x45\x9f\x40\x85\xc5\x14\xa7\x0f\x3a\x76\xe3\x7d\x23\x9f\x59\xf2\xa7\xa9\x0f\x5d\xdb\xf1\x3e\xe0\x3f\x53\x5c\xc4\x52\x15\x90\x7c\xd5\x8b\x9a\xa7\xf1\xc1\x85\xcf\x52\xbc\xd5\x34\xa6\x07\x18\x11\x32\xbc\xac\x4c\x92\xe6\xe3\x73\xd2\x6e\xc3\x02\xf4\x66\xbf\xe1\x32\xcb\x33\xf1\x70\x7c\x28\x55\x29\xd1\x48\x87\x99\x28\x42\xfc\x94\x17\x09\xf9\xab\x52\x91\x93\x1a\x34\x54\x7c\xf5\xd1\xa8\xf2\x95\x2b\xca\x1b\xca\xc1\x8e\xe4\xc6\x36\x42\x3a\x84\x4c\x8c\x99\xc5\x23\x6e\xa3\xf7\xe4\x4a\x7f\xf8\xc1\xa3\x07\x25\x3e\xb4\x84\x3f\x45\x34\xe4\x94\xc7\x59\xa0\x3c\xb9\x63\x6c\x6a\x8a\x2f\x18\x8c\x29\x98\xed\x6e\x99\x7d\x37\xaf\xc7\xe2\xc2\x8c\x2f\x75\x1e\xa6\xb9\xff\xdd\xf1\x69\xfd\xf1\xfa\x85\x85\x3f\xf3\xa6\x69\xba\x0d\x63\xd7\x97\x97\xd5\x87\x7b\x57\xc3\xd8\x01\x73\xd5\xe0\x57\x9c\x27\xd6\x4d\x62\xe7\x10\x70\x37\x89\x7d\x1d\xed\x74\x63\xd8\x9b\xe3\xe8\xdd\x18\xf6\x4f\x72\x0c\xfb\xa3\xf3\xd9\xdd\x07\x79\xe6\x13\x2f\x4d\x4c\x4f\x0e\xb7\xdd\x8c\x17\x27\x95\xec\x63\x26\x19\x80\x50\x1d\xca\x91\x5c\xcb\xed\xb0\xf5\x60\x60\xfa\x86\xe7\x0c\x82\x9d\x1f\xcd\x23\xcd\x25\x60\x79\x72\x98\xdd\x46\xd6\x4f\x90\x5c\x75\x38\x49\xa4\x73\x23\xc1\x8f\x79\x1f\x76\x35\x72\x55\xde\x84\xd6\x94\xd1\xa8\xf8\x20\xe6\xa9\xa3\x0a\x2a\x1f\x5a\x8a\x74\x44\xd8\xae\x05\x74\x3e\x9c\x2a\xb3\x08\x18\x6a\x4f\x28\x19\xe2\xb8\x04\x6e\x0a\x8f\xca\x38\x07\x9c\xf1\xe9\xc5\x87\xd3\xe3\xa3\xcb\xd3\x93\xd7\x81\x7e\x53\x64\x9b\x2a\xcd\x5a\xca\x65\x5a\x05\x4e\x64\xa7\x74\x95\xa4\x4f\x35\x22\xd5\x5a\xfb\x6a\x8a\x23\x83\xe3\x9a\x74\x2a\x6a\x3d\x1c\x26\xc1\x59\x12\x15\x55\xf6\xbf\x1c\x1e\x1a\xa7\x89\xca\x25\x47\xe8\x8c\xc1\x46\x48\xe4\xb0\x98\xbe\x54\x99\xd5\xdc\x58\x78\xbc\xfe\x36\xe2\x5a\x94\x24\xca\x32\x8f\x69\x45\xc3\x36\x75\x63\x93\x74\xe9\x3e\x49\x5d\xc6\x85\x0d\x00\x25\xa9\xdf\xe9\xd2\x1d\xb5\x5e\x9e\xcb\x2f\xab\x55\xfa\x60\x77\x49\x6a\xc0\xbc\x3f\xdc\xd7\x2a\x6e\xbc\x54\x8e\xc7\x1c\x82\xad\x17\xa7\xcb\xf0\x10\x40\x9d\x9e\xe1\x4d\xef\xd1\x45\x75\x1f\xe5\xcc\xa1\x51\xd1\x74\x43\x85\x20\xbd\xc4\xae\xb1\x82\x37\x31\x53\xb2\xcd\xed\xd2\x95\xa7\xe5\x95\xbd\x78\xd5\x7e\xf3\x3a\xba\x13\x09\xdb\xf3\x38\x6c\x5f\x80\xe8\x65\x3a\x53\xcb\x87\x6a\x87\x3f\x7c\x78\xdb\xfe\xc2\x24\x47\x70\x5e\xd6\x71\x3a\xc3\x8c\xc0\x9b\x30\xbf\x31\x8d\x7a\xaa\x04\x48\xc3\xb9\x9e\x6e\x4e\x45\x85\xf2\xd6\xe4\x82\x6c\xe7\xe7\x38\x2f\x77\xff\x3b\xfd\xd8\x92\x53\xc2\x7c\xcc\x53\xee\xed\xa9\xda\xec\x5c\xf9\xe6\x21\xbc\x0d\xa3\xda\xcc\x9e\x77\x33\xf3\x4b\x24\x13\x39\x35\xc8\x5d\xb3\x3a\xd5\x8f\x9e\x5b\x5d\xf7\x35\x3c\x7e\x47\x04\x42\xe5\x44\x00\xe3\x89\x73\xeb\x4c\x8a\x74\x9e\xc6\xe9\xb5\xdb\x84\xc7\x35\xc8\xfa\x42\xd6\xa0\x0e\xc2\x01\x9e\x52\xfb\x76\xac\xcf\x44\x5d\x5d\x72\x8b\x08\xab\x76\x6c\xec\x31\x6e\x55\xeb\x73\x46\xcc\x8e\x14\x76\x83\x2d\x17\x0b\x57\x7a\x70\xf4\x1c\x3e\x92\x48\x55\x57\xb4\x39\x76\xa7\xcc\x91\x55\x32\x1b\x19\x6e\xd0\xf6\x1f\xfd\x20\xda\x57\xe2\x0d\x2f\xe0\x28\xfc\xcd\x75\x55\x6c\xb6\x8e\x1c\x95\xd3\x3e\xa3\x4e\x27\x7f\x53\x4f\xd5\x99\x3a\xfc\x73\x20\x3e\x82\x51\x44\x13\x7e\x39\xbd\x04\x40\x74\x56\xea\x8a\xcd\xe3\x75\x66\xb5\x76\x45\xcb\x6f\x31\x8c\xc5\x25\x27\x73\xa5\xfd\x9a\x70\x01\x25\x60\x85\x31\x90\x1f\x8d\xb3\x02\xfa\x6e\x8e\xee\x4b\x6f\x2e\x58\x80\xb8\x51\x58\xb4\x0c\x4e\xce\xb3\xe8\x0e\x76\x79\x8d\xaf\x83\xd5\x5e\x57\x3d\xe3\x9a\x2d\x9d\x38\x4e\xef\x85\x6a\x34\x22\x56\xf6\x8d\x64\x9d\x17\x76\xf1\x03\xab\xe7\xeb\xf9\xfb\xcb\x20\x11\x72\x39\xf9\xd6\x4e\x1f\x5c\x10\xab\x84\x6f\x30\x18\x90\x5f\xf4\xe0\x9f\x68\xed\x4c\xe2\x5e\xf0\x93\x50\xeb\x40\x93\x10\xb9\x16\xf6\xd6\xba\x49\xa9\x1d\x44\xc9\x98\x27\x43\xb8\xa8\x28\x83\xd8\x87\x2c\x5d\x57\x50\x0e\x11\x12\x9a\x0b\xd2\x42\xc2\xef\xb3\x6d\x16\xea\xcd\x98\x07\x55\x5e\x4a\xab\x96\xd1\x16\x52\x49\x07\xb8\xd6\xdd\x08\xf6\xf0\x19\xd3\xc2\x9f\xfa\x6b\xe5\x8b\x59\x1c\x25\xb7\x7d\x1c\xf7\xa2\xd8\x39\x52\x9e\x1a\xa8\x93\xdc\xba\xf4\xcf\x05\x84\xc5\x7a\x6e\x40\x3b\x94\xd5\xba\xe4\x2b\xbc\x02\x36\x18\xe6\x21\x35\xde\xb0\x35\x95\xa6\x76\xe2\xd2\xff\x08\xbb\x1e\xed\x3d\x53\xcc\x38\xb9\x93\x5a\x94\x2e\x51\xee\xda\x2f\x7e\xff\x6c\x84\x1d\x36\x6d\xb9\x92\x04\xf2\x33\x15\xda\x6c\xa5\x80\x67\x4d\x05\x0f\xa7\x45\x24\x3b\x88\xb9\x49\x05\x21\x84\xec\xc6\x9a\x18\xdf\x84\xf3\xa3\xb2\xb8\x01\x34\x8d\x31\x33\xc7\x5d\xfd\xd3\x83\x0a\x54\x68\x30\x88\x34\xda\x25\xbc\xe0\xf8\x6f\x47\x17\xbc\x3c\x82\xda\xb4\xf9\xd6\x93\xed\xf4\x4e\x47\x22\xf7\xb2\xde\xd7\xef\x53\x41\x7b\x36\xbb\xec\xc2\xcf\x9f\x75\xf8\x99\x38\xc1\x6f\x25\xe4\x1c\x25\x51\x11\x85\x80\x01\xaf\x98\xdd\x31\x98\x6d\xb0\x5a\x79\x4d\xcf\x34\x28\x4a\xaa\xa2\xd0\x71\x0d\x3a\x77\xdc\x82\x35\x9b\x2f\x22\x6d\x09\x8e\xc3\x4c\x25\x59\x2a\x6a\xe1\xf9\x77\x13\x71\xaf\xd6\x18\x19\x38\x7f\x51\x65\x50\x48\xeb\x61\xfc\xd7\xd7\x7f\xb1\xc2\xd3\x7f\x75\x1a\xd6\xa8\xda\x79\x59\x03\x38\x68\x26\x74\xfb\x6e\x46\xc0\xce\xbf\x12\x5f\xb7\xbe\xdc\xff\x7f\x95\x61\x2c\x71\x7b\xbe\x0b\x7f\x72\xfd\xac\x9c\x97\xaa\xe9\x48\x9f\x11\xb9\xd0\x88\x9f\x81\x69\x42\xfa\x06\x7d\x83\xc7\x21\xb2\x10\x78\x02\x1c\x6e\x3d\x12\xb9\xaf\x12\x18\xf6\x83\x83\x62\x3c\xef\x0d\x9f\x43\xd9\xa6\xdc\xb6\x3a\xa7\xb7\xa6\x5c\x73\xf8\xf4\x91\x7c\xba\x1b\xf9\x76\x1b\xba\x20\x20\xc1\xdb\x28\x2f\x64\xbd\x8a\x84\xca\xe5\x06\x42\x16\xcd\xa1\xae\x79\x41\xa3\x99\xe6\xff\x08\x27\x93\xec\xb5\xd4\x0f\x94\x55\x45\xff\xe6\x19\x03\xa9\x9a\xf8\x04\x00\x75\x2e\xcb\x01\x60\x25\x1a\x93\xe3\xe1\xf2\xf8\x82\xa0\xe5\xc1\xd7\x7f\xe0\x77\x9c\xfc\xcf\xdf\xff\xe1\x65\x6f\xf8\xc9\x54\x45\x7a\x7b\x21\x9f\x24\x6b\xe0\x19\x55\x84\xa0\x02\xaa\x6a\x41\x88\xdb\x4b\x3a\x57\x82\x04\x7d\x20\x46\xde\xed\x46\x4d\xed\xaa\x15\xb8\xaf\xfd\xec\xa7\xa0\x48\xa2\x93\xbc\xb5\x0d\xfe\x2c\x59\xb3\xe2\xd5\x4c\x56\xea\xc0\x9a\xe5\x31\x70\x23\x5f\x3c\xd6\xcc\x67\xbb\x3b\xf2\xc7\x80\x36\xc6\xf8\x16\x68\x04\x8c\x6f\xd9\xe7\xd9\x96\x8f\x87\x73\xfd\xeb\xd7\x5e\xf2\x62\xf4\xc1\x58\xb9\x1f\xd4\x8c\xf9\xe4\x7c\xf4\x8f\xb7\x47\xdf\x9e\xbe\x65\x09\x45\x99\xf8\x6f\x0d\x92\xf0\xca\x29\xe7\xf3\x85\xfd\x17\x2d\x11\x40\xe2\x1a\x45\x3f\x7f\x33\x5a\x72\x89\xe1\x27\xaa\x9d\x55\xe5\x24\xde\xae\xcd\xdc\xb6\x16\x2d\xec\xea\x99\x38\xd2\xb5\xef\x0f\x9d\x75\xb6\x8f\x01\x91\x86\x65\x42\xdc\xd9\x3d\xbb\x47\xc8\x73\x89\xed\x22\x66\x24\xb6\x1c\x1c\x23\x8c\xa8\xae\xb3\x0b\x7d\x73\x54\x77\x67\x98\xe7\x6b\x74\x99\x4f\x7b\x83\xfd\x11\x3d\xa7\x73\x0e\xf0\x9e\xca\xe4\xe3\x0c\x05\x1a\x8a\x32\x91\x33\x8d\x8a\xe9\x32\x15\x3f\x3f\x0a\x6d\x39\xd2\x80\x5f\x93\x7b\x6d\x4b\x5c\x55\x79\xbb\x52\x0c\x1d\xc7\x61\x34\x73\xe3\xc6\x17\xeb\x40\xc8\x7f\x8e\x64\x16\xb9\xc3\x84\x98\xb0\x56\xe9\x0d\xbf\xae\x85\x6e\xf2\xfb\x38\x1e\x2e\x33\xdd\xc4\x9b\x3e\x2a\x24\x0d\x34\xa9\x54\x1f\xc9\x4f\xc6\xb8\xac\x56\x19\x3e\x41\xf4\x4b\xea\x3f\xd6\x8f\x2e\x77\x11\xd9\x80\x4e\x6e\xd7\x00\x8d\x72\x7b\x60\x4c\x6e\x66\x85\x95\xca\x6f\xea\xd4\x6e\x24\x78\xf2\x33\x79\x3c\x99\xf3\xd3\xc3\xbd\x1b\xb8\xc5\x16\x6b\x6d\xf7\xdd\xd8\xed\x2e\xec\xcb\xd0\x6b\x6b\xac\xe9\x26\x2d\xd2\x64\x8b\x52\xd0\x8b\x35\x00\xea\x29\x3a\xf2\x1b\xc7\xb2\x64\x3a\xe6\x38\xe7\x0c\x30\x59\xd9\x62\xc2\xa9\x68\x1a\x69\x05\x00\x54\x50\x15\x58\xcd\x79\x7a\x28\xaf\x76\xf4\x11\x8a\x35\x3f\xf1\xc6\x3c\xbb\x68\x7d\xd8\x7a\x9a\xe6\xc4\xa3\xe0\xfb\xec\x44\x29\xf1\xba\x60\x3b\x57\xa4\x1b\x38\xd0\xee\x1a\xfa\x7d\x42\x65\x07\xd0\xd0\x1a\xa3\x00\xd2\x02\x6b\xd5\xa7\x2d\xd9\x45\xed\xd1\xa5\xfc\x3d\xf5\x37\x6e\xa9\x04\x8b\x17\x3c\xa3\xbb\x3e\xa2\x44\x1f\x6b\xcb\x4b\xed\x39\x11\xff\x5c\x3b\x45\xda\xde\x95\xe7\xe2\x21\x06\xc0\x6e\xdf\xb3\x3e\x06\xbe\xca\x00\x98\xc9\x60\xab\x51\xef\x47\xbd\xf8\xde\x6a\x43\x43\x13\x29\xb7\x18\x00\xa7\x89\xd4\x4a\x80\x60\x47\xd3\x94\x3d\x18\xe1\x8f\xea\x41\xe5\xec\xc2\xb3\xac\x58\x62\x68\xae\xb3\x43\x4a\xf6\xd3\xb1\x40\x8d\x84\xd6\xd8\x60\x96\xfe\x93\xc6\xc0\x3a\x71\x40\x39\x7d\x14\x03\x2b\xd8\x0b\x2a\x92\xed\xa1\xaa\x31\x49\xaa\x39\x54\x9f\x53\x58\x37\x8d\xae\x67\xe1\x3c\x97\x53\xcf\xec\x11\xa6\xcf\x64\x4a\xf0\xbb\xcd\xa3\x81\xcd\xd8\x5f\xe6\x50\x54\xce\x68\x60\x3d\xf6\xb7\xca\xb3\x65\x66\x38\x54\xfe\x5b\xd6\x90\x5f\x5e\xd6\xcc\xa6\x91\x79\x9b\x06\xe1\xf1\x82\x69\xf5\x61\x79\x8c\x11\x78\x2c\xb0\x72\x4c\xde\xda\xe1\x77\x4f\x1e\x9e\x57\x17\xc3\x99\xf8\xf4\x68\x5f\x5d\x5a\x23\x2f\x2b\xa2\x7b\x87\x03\x7b\x2f\xcc\x5b\xa4\xfe\xa8\x3a\xd3\x19\x4e\xc1\x8c\xa9\x85\x71\x0a\x52\xc1\x22\x94\x4a\x9c\xeb\x3c\x3a\xc0\x61\xbe\xa3\xd1\xbb\x92\xb3\xbc\x0b\xe7\x9e\x83\xe4\xec\x71\xc3\x34\xfd\x5c\x27\x0c\x49\xa8\x2e\xe3\xbf\xc2\x22\xa4\x0e\x80\x12\x7f\x3b\x9e\xc1\xe6\x70\xe8\xeb\x39\x7b\x4d\x9f\xe9\x07\x02\x34\xcf\xe0\x56\x2c\x06\xc4\xab\x1c\x67\x6d\xcd\x43\x2a\xb6\x26\xd4\x9d\x20\x1a\xf4\xf0\x62\x3b\x5a\x3d\x76\x9e\xeb\x76\xac\x4f\xc1\x24\x76\x19\xda\x34\xa5\xdd\x8e\x20\x75\x27\xb6\x5c\xa9\xa1\x58\x23\x91\x2b\x17\x98\x72\x87\x01\x12\x1c\x81\x22\x13\xc3\xf2\x13\x34\x9c\x14\x10\xc2\x22\x25\xdc\x55\x38\x76\x9d\x0c\x4c\x65\x07\x39\x6e\x16\x96\x94\x6f\xc0\x81\x07\xd0\x2a\x9b\x0f\x45\x88\x92\xc8\x65\x62\xbd\xcb\x11\x28\xad\x4c\x8d\xc2\x54\xf6\x02\x6d\x3d\x44\x60\xb5\xfc\x41\x57\xc0\x34\x84\x04\x27\x92\x00\x70\x05\x59\xd3\x99\x21\x0e\x1f\xbc\x2a\x32\x00\x99\x51\x2a\xe2\xa2\x04\x51\xc4\x82\xc8\xf3\x20\x72\x9e\x65\x8c\x7d\x3d\xb3\x5b\x31\x31\xe3\x07\x87\x34\xaf\x34\x37\xbd\xc0\x3d\x06\x96\x6a\xd2\x42\xbe\x6c\x55\xfb\xcb\xc9\xa2\xc3\xa1\x9c\x2b\x1a\xa4\xae\xe3\xff\x96\xbb\x00\x38\x3d\xee\xc1\x76\x96\xf5\x2c\x50\x02\xb1\xd8\x46\x0e\x79\x42\x02\x41\x4f\xbe\x23\x44\x35\xcd\x55\x69\x43\xa1\x93\x4b\x79\x5b\xce\x2b\x7f\x60\xe1\xee\x0f\x2d\xe7\x81\x4a\x6e\x63\x09\x8d\xa1\x07\x48\xef\x61\x8e\xe4\x5c\x74\x1a\x35\xbd\x29\xa4\xb0\x66\xe8\xb4\x07\xcc\xc0\xd2\xbc\xd7\x8d\x9f\xf6\x02\xb9\x59\xf5\x96\x83\xa8\xbd\x80\xb6\x39\xbc\x7a\x89\x8d\x6e\x1c\x63\xed\x05\xb2\x15\x3d\xde\x43\xaf\xf7\x83\xbb\x6e\x1c\xb6\xa5\xe7\x7b\x01\xf5\x18\x8c\xed\x6f\x2c\x6c\x67\x3c\xac\x63\x71\xad\xf0\x98\xfa\xe0\x67\xa7\xb9\xd1\x75\xb1\xa9\x27\x66\x83\x21\xaf\x55\x25\xf8\x15\x2c\xdd\x70\xe1\x05\x53\x8f\xcf\x4e\xaa\x09\xda\xaa\x66\x52\x8a\x3c\x5f\x9a\x37\x62\x92\x36\x2c\x62\x31\x43\xc5\xc1\x5d\xda\x99\x28\xa4\x92\xc0\x96\xe8\x24\x45\xca\x8f\xe7\xe2\x8f\xef\x5a\xb6\x60\xf6\x3e\xb3\x52\xb5\x37\xca\x5d\x27\x76\x9a\x69\xea\xe1\xcf\x5a\xff\x20\x2f\xdb\xda\x22\xc0\x2d\x07\x58\x6f\x4e\xca\x75\x55\x20\x77\x90\x82\xe7\xb8\x84\x1d\x24\xe8\x2a\x55\xc9\x39\x4d\x77\x2b\x5a\xd7\x1a\xf8\xeb\x6d\x27\x5b\x2f\x4c\xf1\x63\xcd\xde\x70\x9d\x6d\x0d\x96\x31\x88\x20\x32\xe1\xb4\x2a\xa3\x06\x23\x7a\x60\x83\xeb\x43\xdf\xe2\x3e\x69\xdf\xec\xd1\xc5\x59\xab\x3e\x15\x0b\xee\xe7\xeb\x55\x21\x7f\x39\x75\x13\xd0\x2e\xbd\x93\x6a\xdb\x3f\xf2\xbb\xaa\x59\x3a\x08\xa7\x37\x48\xbb\x26\xda\xca\x92\x31\xf2\xef\x92\xa7\xb5\x8e\xbb\x69\x82\x80\xb3\x94\x6e\xf5\x4a\xa3\xa8\x66\x6f\x38\x43\xd6\xe9\x45\xc4\xa5\x1e\xd1\xfc\xa3\xf7\x31\x0b\x56\x1e\x16\x21\xd5\x04\xf5\x11\x4d\x4d\xf7\xb3\x9c\x96\x5c\x6e\x80\x12\xb8\xf5\x18\xb5\x0b\x13\x50\x5c\x08\xf3\xb9\x9f\x56\x1e\x87\x57\x22\xce\xfb\xd2\x45\x86\x2a\xba\x95\xde\x95\xf9\xe9\xe4\xc6\x41\xec\xa5\x94\x6f\x73\x6c\x84\x2a\x23\xc8\xfc\x9e\x5f\x0e\x3a\x4a\x58\x0a\xf3\x9e\x10\x01\x27\xe3\x1b\x31\x0b\xe5\x30\x07\x3c\x49\xdd\x7a\x0b\xe3\xb8\x85\x48\xbc\xe1\xa2\x22\x2c\xb2\x19\xce\x08\xee\x6b\x0b\x95\x42\x5c\x7b\x77\xaf\xf6\x86\x9e\x60\xb7\x72\x39\x98\xeb\x73\xe1\x69\xdf\xac\x06\x32\xac\x86\x30\x3e\x8c\xa0\xe6\x80\xc8\xe9\x16\x9a\x84\x46\x4f\xcf\x65\xf5\x03\x4c\x34\xb8\x93\x34\xf2\x44\xe8\xf6\x57\xfc\xa5\x1a\x6f\x0e\xcb\xdb\x5e\xf1\xd0\xe7\x3b\xe7\x54\xe7\x9c\xea\x9c\x53\x9f\xb9\x73\xca\x52\x7e\xb4\xbc\xf3\x16\xa2\x75\x27\x97\xdd\xb2\xde\x59\x83\xb6\x6e\x7c\xd5\x4c\xc2\x6a\xbb\x8c\xf7\x5d\xf9\xab\xbc\xe0\xd2\xa0\x59\x2b\x96\x83\xce\xa0\x7d\xed\xf9\x2a\x73\x3f\xc2\xc7\xbb\x59\x4c\x07\x5f\x07\x22\x19\x03\x3d\x4e\x64\xf9\xe8\x34\xca\x60\xd1\x11\xb7\xd3\xc9\xca\x4a\xb5\x6b\xc3\xc6\xed\x4c\xe1\xc1\x8f\x3b\x59\xd1\x27\xda\xf7\xfe\x63\x87\x3c\x74\xfa\xd2\x9b\xd6\x54\x78\xa5\xb8\x53\x79\x0a\xb3\xe3\xd6\x03\xc8\x36\x8d\x0c\x95\x06\x6f\xd2\xad\xfc\xd4\xf7\x68\x16\xa9\x76\x80\xa8\x07\x88\x1c\x7e\x39\x90\x1f\x0e\xc7\xf3\xb2\xbf\x05\xd0\xe1\x4c\xcc\xd2\x6c\xd1\x37\x80\x11\x20\xbb\x43\xc3\x3a\x2d\x85\x80\x48\xa8\x3d\x4a\x6d\x1a\x97\x19\xba\xd4\xe2\xc5\x27\x68\x67\x98\x73\x3c\x77\x76\x32\x6e\x22\xb4\xaa\x63\x7e\xb2\x05\xcc\x4a\x23\xa4\xcc\x3e\x55\x20\xd3\x37\x8e\x33\x6f\xb0\x08\x4d\x24\x77\xa0\xd6\x64\xf9\xfe\x53\x99\x16\x93\xe8\x2e\xca\xd3\xcc\x17\x39\x61\xb2\x78\x3f\xf5\xc7\xec\x60\x4b\xb1\xba\x0c\x67\x0b\x44\xac\xf5\x69\x46\x6a\xc2\x42\x5a\x16\xf3\xb2\xd8\xe6\xa4\x41\xf7\xd0\x1c\x4b\xf7\xf9\x33\x9c\xaa\xef\x0d\xb8\x66\xa1\xbe\xda\xf3\x84\x03\x62\x0a\x8c\x5e\xd8\xf4\xff\x1c\xfc\xfd\xcb\x5f\x07\xbd\x6f\x0e\x0e\x7e\x7e\x39\xf8\xd3\x2f\x5f\x1e\xfc\x7d\x48\xff\xf8\x8f\xde\x37\xbd\x5f\xf5\x2f\x5f\xf6\x7a\xf0\xf7\xef\xdf\x7d\x77\x79\x71\xfa\x4b\xd4\xfb\xf5\xe7\xa4\x9c\xdd\xca\xdf\x7e\x3d\xf8\x59\x9c\xfe\xc2\x04\xd2\xeb\x7d\xf3\x3b\xcf\x05\x7f\x1c\x54\x2e\xff\x01\xd0\xce\x20\xcd\x06\xf2\xe8\x5f\x07\x45\x56\xfa\x6a\x44\xf2\x38\xda\xe1\x3d\x95\xaa\xb6\x95\x90\xab\x59\xd9\xfb\x9f\xa6\x41\xbc\x05\x02\xb6\xb0\x87\xb7\x89\xdf\x3d\xf7\x48\x9c\xc7\xcb\x64\xee\x79\xab\x11\x0a\x87\x01\xca\x0a\x66\x97\xf2\xd9\x72\xca\xa7\x6a\xe5\xb4\x39\xdf\xd3\x65\x34\x8d\x25\xd5\x1f\xce\xf7\xf4\x50\x5a\xbb\x7c\xcf\x47\xce\xf7\x94\x94\xd1\x25\x7b\xfa\x9b\xdb\x5d\xb2\x67\x97\xec\xd9\xf9\xd3\x3b\x7f\x7a\xe7\x4f\xff\x5c\xfd\xe9\x5d\xb2\x67\x97\xec\xb9\xbd\xb1\xd8\x25\x7b\xae\x17\x7c\x5d\xb2\x27\x47\x55\xfa\x2c\x92\x3d\x47\xae\x9e\x00\x5a\x8b\xc9\xf4\xfc\x34\x12\x3d\xb1\x57\x5c\x34\x16\x47\xe3\x31\x36\x72\xb8\x4c\x6f\x45\xd2\xb2\x3b\x65\x05\xfe\xb3\xf5\xad\x84\xe5\x24\x42\x7f\xc5\x16\x74\x74\xa4\x40\x68\x97\x00\x6a\x02\xc9\xc4\xd9\x06\xd6\x2b\xd1\x9c\xa6\x40\xbc\x0d\x83\x23\x60\x39\xe3\x68\x1e\xa1\x6c\x73\x16\xea\xa1\x84\x22\xc9\xd2\x4c\x60\x07\x62\x15\xf1\x54\x4d\x75\x76\x55\x39\x4d\x67\x8d\xcc\xf2\x2f\x28\x41\xac\xb7\xe0\x7a\x7f\xac\x0d\x4b\x27\x45\xaa\x87\x06\x07\xf9\x4d\x5a\xc6\x18\x61\xf0\x70\x9c\x5a\x58\xbc\xb4\x56\x67\xc7\x1a\x3c\x20\x5a\x08\x50\x0b\x07\xa6\xe9\xd2\x30\x75\x4b\xce\x27\x3e\xce\xa3\x8c\xae\x1c\x30\xab\x34\x99\x6c\xe3\x19\x3c\x5d\x86\xa5\x68\xd8\x43\xe9\x10\xe4\x54\x9a\x94\x12\x1c\xf5\x34\x08\xe3\x68\x12\x15\x0b\xbf\x0c\x49\xc5\x44\x82\x50\x72\x11\x73\x1d\xf2\xea\x58\x5d\x2f\xc3\x1c\x58\x04\xf6\x62\xca\x2d\x1c\x4a\xdb\x4e\x35\x63\xf2\xf3\x31\xce\xe3\xf2\x1a\x2e\x00\x19\x76\xf4\x06\xd4\xc2\x31\x7e\x8f\xf9\xb7\xc2\xe6\x88\xae\x0b\xae\x6f\xfd\xd2\x5a\x28\xbd\x8c\x14\x55\x57\x0a\xce\x16\xd4\x8f\x31\xb5\x97\x27\x99\x44\x34\xf5\x46\x2d\x76\x15\x8f\x27\x7a\x98\xc3\xd7\x2f\xb1\xa3\xd8\x58\x72\x2c\x12\x8d\xd8\x39\xd9\x75\xa1\x29\x18\x9b\x77\xd4\x01\xd6\x5e\x58\xfd\x55\x8e\x30\x7f\xff\x55\x00\xec\x24\xcb\x87\x76\xf3\xa4\x57\xf4\x99\xf4\xc1\xb9\xe7\xe0\xa0\x61\x53\x04\x20\xb1\x81\xc1\xbe\x7a\x09\xa6\x7c\x52\xa2\x42\xe7\x04\xc5\x32\x40\xff\xf0\x95\x07\x0b\x71\x37\x3d\xdd\x8d\xce\xd5\xbc\x5b\x25\xed\xe6\x72\xc2\xa9\x97\xdf\x53\x79\xf6\x65\x9b\x30\x39\x95\xd9\x4a\xdd\xf2\x51\x02\x96\x18\x3f\x79\xce\x1f\x81\x2b\xfb\x58\x5d\x8e\x96\x93\xb3\x9e\xe7\xf8\x00\xdf\xb4\x72\xe9\x66\xc5\xcb\x60\x62\x2f\xf5\x5f\x65\x7a\xb5\x28\xdc\x9a\xf9\xfd\x97\x7c\xa6\xde\xc5\x4f\x7f\x68\x77\x87\xe7\x0d\x5c\x5d\xed\x0e\x5f\x35\x87\x6f\xb1\xa3\xd5\x35\x7a\xd8\x9c\xdb\x09\x91\x5f\x4e\xfb\x6e\x74\xcb\x93\xf1\x18\xa3\x1d\xf0\xa1\xe2\x7a\xdc\x71\x3f\x49\x2a\x57\xf1\x6c\x5a\xde\x3d\xd8\x9c\x5d\x9d\xe8\x73\x1a\xbb\xdd\x7a\x83\xbc\x4c\x5c\x47\x80\x64\x1f\xcc\xc9\x07\xeb\x97\x00\x3b\x33\xc7\x24\x61\x71\x8e\x5b\x34\x67\x66\xcb\xea\xbb\x63\x80\x2a\xed\xc6\x1e\x17\x17\x56\xd1\x1f\x5e\xcb\x30\xd9\x07\x53\xce\x2b\xa1\x10\xf3\x81\x5e\x53\x00\xab\x95\xe3\x88\x33\x54\xa4\xe6\x61\x16\x72\x7b\x54\xd1\x91\x8e\xd3\xd9\x2c\xcc\x7b\x2a\xc6\x18\x52\xbe\xa6\x14\x5d\xa8\xa1\x64\x61\x6c\xd0\xca\x6b\x62\x5b\x65\xce\xb5\x3e\x98\x57\x24\x61\x52\xb8\x4f\x95\xa3\xc7\x82\xf4\x3e\xd1\x13\x0a\xaf\x41\x14\x27\xe6\x9c\x1c\x94\x5a\x65\xc7\x7d\x1b\x8e\x41\x78\x4e\x82\x1f\x72\x7d\x2f\x26\x8b\x24\x9c\xa9\xe9\x2f\xc0\xc6\xee\x22\xbc\x18\xcc\x53\xa8\xaf\x23\xef\xab\x68\x8d\xec\x55\xa7\x5b\xff\x4a\x25\xba\x6d\x8c\x96\xb9\xc7\x2c\x02\xd8\x75\xd6\xc4\x43\xd9\xa3\x1a\x00\x56\x74\x37\x16\x5a\x95\xc7\x05\xb5\xbd\xc9\x3b\x56\x83\xdb\x95\x6d\xaa\xe6\xb6\x91\x15\xa7\x25\xd9\x66\x52\x38\x78\x7b\x44\xb7\x7e\x8c\x6c\x73\x61\xba\x26\xd6\x0f\x1c\x4f\x38\x69\x7f\xc2\xa1\x8b\x16\xc2\xbe\xe0\x03\xde\x65\x61\xeb\x2b\xd9\x95\x5b\xdb\xcd\xfd\x0f\xdf\x9e\xd4\x79\xf4\x87\x70\x92\xe6\xc1\xb7\x71\x3a\xbe\x05\xda\x23\x8b\x98\x04\x56\xe3\x56\xac\x89\x37\x0f\x4f\xb4\x71\x9a\x25\xd6\x34\xba\x19\x36\xbc\x9b\xc1\xcd\xdd\xa0\xdf\xcf\x7a\xd0\x2f\xd0\xcd\x6f\x66\xcc\xef\x0c\x36\xee\x4e\xc8\x14\xe2\x25\x66\x40\x00\xf4\x54\x30\xfe\x54\xf5\xda\xd5\xfc\x02\x50\x38\x28\xd2\x01\xc8\xa3\x41\x54\xb4\xbe\xc7\x5b\xb1\xa0\x1c\x66\xe7\x5d\x7e\x2f\x1f\xac\x39\x17\x80\xee\x30\xb4\x42\x9f\x4f\x99\x89\x50\xc0\x47\x51\x8c\x57\x83\x30\x00\xe0\xa1\x28\xc6\x87\x40\x7e\x37\x87\x6a\x79\xce\x53\x14\x9f\x13\x8a\x67\x69\x12\xc1\x25\x72\x6f\x39\xbb\x7f\x04\xb4\x1e\xc7\xaa\x01\x2c\xf0\xc3\x63\x40\x89\x01\xe7\x10\xd7\x7c\x14\xb2\x7b\x1e\x63\x5a\xe7\x60\xa0\x6d\x73\x65\xf1\x79\x75\x63\x2d\x82\x04\xd4\x78\x0f\xc3\x79\x0e\x24\xb8\xa3\x31\x6b\xbb\x9a\x5e\xfb\xcc\x28\xfb\xb1\x26\xe5\xee\x8f\xf4\xa3\xe4\xd4\xb1\x72\x19\x9c\x87\xde\xea\x65\x90\x11\x6c\x58\xec\xd9\x54\x9a\x84\x13\x4c\x8c\xbd\x03\x49\x0c\xff\xca\xb5\x08\x70\xf2\x9d\xd0\x02\xa3\xf8\x31\xc5\x5a\x37\xc7\x97\xfb\xda\xcf\x7e\x8e\xaf\x97\xb3\xc0\x62\xf2\xf8\xfc\x2a\x93\x0f\x27\xb3\x28\xf9\x84\xd9\xbc\xd3\x14\x5e\xd4\x4c\x19\xdf\xd3\xca\x46\x5b\x66\x76\x3e\x0e\x63\x71\xf6\xde\xc9\xd4\x1e\xc9\x67\xea\xd6\xb6\xfe\xb0\x1a\x88\xc4\xf4\xbe\x6c\x1c\xf3\xf3\xbd\xb9\xa4\x41\x92\x4e\x9a\x23\x83\xdd\x38\xaf\xa6\x71\x5e\xd5\xdd\x62\x41\x64\xcf\x02\x72\x62\x2d\xd7\x61\x21\xee\x43\x77\xcd\xe7\x52\xa3\x45\x8d\x34\xd5\x02\x41\x13\x1e\xb7\x47\xde\x77\xf2\xfd\xed\xcf\x21\xcb\xd2\x42\x5a\x06\x27\xe9\x2c\x8c\x12\xaf\x0d\xda\x3a\x86\xde\xd8\x85\x01\xcc\x53\x08\xe8\xed\xa4\x67\x54\x63\x19\x4a\xac\x5c\x57\x9e\x83\x6e\x10\xd3\x0e\x07\x31\xf9\x2b\x9b\x95\xae\x59\xb9\x91\x75\x98\xdf\xa1\xca\x12\xcf\x5d\x53\x0e\x89\x55\x93\x14\x06\x50\x92\x3c\xa2\x3c\x77\x2b\xf9\x90\x39\xeb\x6b\x2a\x2b\x4e\x4c\x9d\x99\x54\x5a\xfb\xc1\xdb\x14\x13\x78\x14\xa7\x4a\x65\x2a\x0f\x6f\x9d\x21\x68\xab\x9d\x96\xd9\x69\x99\x7c\x2d\x33\xcf\xe3\xd3\x24\xbc\x8a\x79\xc9\x22\x75\xc1\x1d\x87\x94\xb9\x25\xe8\xf9\xc3\x49\x94\xe3\x7f\x83\xd1\xe8\x2d\x05\x39\xcb\xc4\xc5\x92\xa3\xb0\x9e\x12\x23\xa6\x41\xa0\x64\x60\xed\xf3\x13\xc9\xb3\xbd\xc6\x73\x9d\x25\x13\xdc\x16\xb0\x11\x3b\x4f\x5b\x41\x94\x13\xca\x5c\x62\xfe\x2a\x9f\x14\x94\x9c\xcb\x9b\x68\x7c\x7b\x51\xc5\x32\x31\x0e\x0e\x9f\x25\xd6\x47\xce\xc6\xab\xc7\xf3\x4e\xb4\xa6\xb6\x7d\xe1\xe3\x8e\xba\xb4\xa4\xf1\x48\xa1\x0f\x01\x05\x61\x9e\xa7\xe3\xc8\x2d\xa8\x4e\xce\x59\x23\xd2\x83\x09\x89\xeb\xf6\xb7\x4b\xda\xe0\xd6\x3a\x88\x26\x16\xa5\xac\x86\xb9\xa5\x4f\x70\xc3\xe2\x0a\x73\xc3\xdd\x04\x6f\xbd\x06\x7a\x5f\xd6\x46\x78\x6b\x3b\xa4\x1e\x8b\x75\x89\xfa\x6b\xe2\xd0\x3a\x3d\x8d\x96\xcd\x2d\xf2\xa0\xa3\x67\xce\xe9\x34\xe3\xbe\x55\x0a\xd8\x13\x46\x7f\x95\xae\xce\xc9\x56\xd3\xda\x0b\xe7\xbb\x9c\xf8\x12\xdf\x82\x65\x35\xb5\x58\xe7\xd7\x5b\x4a\xe9\x91\x9f\xa9\x80\x2f\xb2\x3a\x86\x6f\x7b\x5e\xc6\x32\x13\xb8\x9a\xd1\xbe\x6d\x3c\x4f\xae\x63\xff\x99\x8c\x78\x5c\x57\xba\xbc\x3a\xed\x91\x6f\xf4\xa2\x81\xcb\x9b\xf6\xc8\x82\xa9\x26\x42\x5a\x76\xc4\xcb\x3f\x7c\xf5\x55\x6d\x46\xa4\x35\xff\x91\x37\x4f\x8f\x35\x23\xd2\x2a\x3f\x66\x41\xe5\xcd\x88\x54\xef\x63\x0f\xf4\x65\xce\x88\xc4\x52\x61\xee\x2a\xd7\xcd\x88\xdc\x7f\xf2\x21\x91\xec\x58\x96\x43\xf7\x16\x4a\x96\x73\xe1\xf2\x0f\xf5\x6c\xb1\xbb\xb0\x70\x51\xbd\xb1\x53\xcb\xda\xde\x2b\xdc\xdc\x53\xab\x3f\x4b\x6b\x1d\x57\x78\x7d\x56\x3c\xba\x3f\x6f\xea\xae\xb2\xb6\x5f\x0a\xd7\x52\x5d\xed\xa9\xb2\xa9\x4b\x0a\x7b\xf3\xaa\x93\x0a\xa3\x37\x0a\xef\x98\x8a\xb6\x3b\xa2\x70\xfa\xa0\x58\xe5\xd9\x2c\x98\xdc\x1a\x6c\xdf\x61\xaa\xeb\x3b\x9d\xd8\x9d\x4b\xb8\xe3\x54\x9d\xfa\x9b\xb8\x57\x3b\x3a\xf5\x32\x69\xad\x83\x89\x47\x39\x85\x5b\xb7\x12\x07\x41\xef\x50\x9c\xb1\xae\x33\x89\xb7\x7c\xb7\xa5\xbc\xec\x47\xd2\x6a\x6f\x91\x87\x3b\x8a\x18\x71\xed\x04\xf1\x21\xc1\xee\xd3\x5b\x99\x27\xde\x61\xc5\x2e\x25\xe0\x9e\x42\xde\x4f\xd4\xfb\x08\xfc\x4a\x3c\x6c\x71\xf3\x96\xfb\x7a\xf8\xa0\x7f\x7d\x37\x8f\x35\x9d\x39\x1c\x6b\xc8\x2a\x30\xad\xf5\xe3\x78\xb0\x0b\x87\x23\x34\x67\xe6\xe3\x5a\xc7\xe5\xd2\x33\xc3\xa1\xde\x6b\x67\xa5\x5b\x6e\x7d\x13\x98\xdd\x12\xec\x49\x57\xac\xed\xb9\x37\x49\xf0\x09\x5f\x78\xf9\x54\x6a\xfe\x73\x65\x4c\x6b\xaa\x96\x69\xd3\x66\x0a\x0e\x37\x67\xb6\xcc\x1f\xcb\x9c\x76\x24\x79\xbe\x73\x42\xae\x26\xcd\xdd\x02\xec\xf2\xa9\xf7\xa3\xa5\x10\xbb\xf9\x98\x59\x97\xd2\x45\xd6\x1f\x2b\xb2\x5e\x25\x86\x73\xb3\xe4\x28\x79\xfc\xc1\xc4\xf0\x2e\x4c\xfb\xbc\xc3\xb4\x79\xad\x3b\xb8\x76\x1f\x12\xd7\x62\x47\x6a\xd3\x2b\x35\xc5\x4d\x0a\x05\x73\xc1\x71\x02\x12\xc0\xa3\x7e\x19\x80\xf1\x21\xda\xb5\xbc\xf0\x67\x5d\x43\xd6\xc1\x29\xd2\xbf\x2b\xbb\x1d\x7b\x7d\xcf\xe6\x05\x8f\xc8\xba\x08\x6c\x17\x81\xdd\x3e\xe4\xf2\xa3\x79\x54\x7b\xa3\x6e\xca\x59\x98\x0c\x90\x93\x50\x2c\x96\xed\xe3\xd2\xe9\x31\x4b\xd2\x10\xee\x88\xaa\xcb\xa3\x43\x23\x17\x6e\xca\xe5\x4a\x65\x12\xfd\xab\x14\x55\xbf\x54\xa3\xaa\xec\x30\x6a\x45\xf0\xb7\xc0\xa3\x1c\x28\xb8\xc4\x85\xc6\x70\x57\x5d\xb0\xa8\xcc\x67\xb5\x71\x83\x53\x62\x38\x58\xc6\xee\xa6\xb3\xd5\x7c\x6e\x98\x21\x4d\xef\xb8\xa0\x4a\xff\x6a\x06\xa2\xe2\x42\xbc\x83\x21\xcb\x5c\x9a\xe6\x61\x1c\xa7\xf7\x72\x9f\xb6\x06\x83\x24\x85\xfb\xe6\x8a\x73\x55\xbb\x1e\xa1\xd3\x4e\x45\xe6\xec\xad\xcb\xcc\x29\xf4\x02\x88\x4c\x9a\xab\x99\x43\xa2\xcc\x08\x64\x80\x45\xea\xe8\xd0\x4a\x64\x11\x27\xfe\x5b\xe7\x78\xcb\xe1\xae\x0e\x3d\x05\xae\xc4\x4d\x78\x17\xa5\x65\x36\xa4\x37\xe0\xa4\x07\xf5\x38\xa9\x0d\x58\x63\xa7\x43\x16\x65\xce\x45\x84\x39\x91\x7c\xcd\xf9\x9f\x57\x7f\x24\x07\xc6\x24\x65\xcb\x1f\x50\x59\x07\xe2\x23\xce\x72\x5d\xc1\xad\x16\x40\x7a\x40\xd4\x13\xa9\xe5\x77\xf9\x1c\xf5\x90\x1f\x59\xe5\xbf\xf5\xeb\x67\x3f\x59\x57\xcf\xef\x46\xf4\xa7\x6d\x95\x73\xd5\xa7\x48\x56\xf5\xeb\x52\xba\x4e\x41\xff\x0d\x28\xe8\x26\x45\x05\x56\xba\x38\x3b\x71\x57\x4d\x4d\x6a\x0a\x02\x08\xbe\x0d\xb1\x07\xc1\xbb\x30\x81\xcf\xc8\x9f\x74\x30\xba\xf8\xf6\x5d\x8f\xeb\x23\xa7\xf0\xd3\xd9\xc9\x72\x12\x83\x2d\x7b\xe5\x8b\xce\xdb\xaf\x50\x5f\xc2\x85\x97\xc2\xb1\x03\x6c\x24\xbb\xd8\xa9\x64\x17\xdc\xc1\xae\xab\xcd\xa4\x64\xb2\x89\xee\xac\x97\xbb\xb2\x21\x5a\xc2\x6c\x72\xfb\x74\x49\x26\x15\x02\xda\x61\xef\xbc\x77\x0f\x9a\x14\x4e\xd6\xdb\x9a\xfc\x87\x0d\x40\x1e\x28\x68\xae\x07\xb2\xf1\x7b\x97\x61\x7e\x1b\x0c\x06\x2f\xfc\x04\x40\x38\x9d\x82\xa1\x59\x3c\x60\xfd\xd7\x3b\x73\xaa\xaf\xcb\x26\x17\xd4\xcc\x88\x92\xa5\xf4\xc7\x38\x7b\x79\x52\xc6\x68\xb9\x66\x65\xfc\x90\x0b\x89\x27\x9b\xd0\x0f\x75\xd4\xb8\xc4\x35\xae\x09\xfc\xe5\x4a\x39\xb2\x36\x2f\xef\x45\xb3\x29\xae\x1c\x95\x0f\xdf\x6f\xbe\xa0\x9d\x93\x01\x0b\x64\x78\x52\xe2\x7d\x19\x99\x05\x9d\x5d\x27\xa9\xf9\xf8\xf4\xa3\x18\x97\x05\x6b\xae\xf6\x4a\x74\x43\xed\x11\xb4\x54\xd5\x3f\x11\x5f\x48\x8d\x53\xd4\x1f\x38\xb6\x7d\x3a\x21\xb7\x0e\xb9\x01\x55\xee\x13\xc8\xdd\x7c\x2a\x65\xb1\xc1\xa7\xf8\x88\x8a\x0e\xb5\x74\x62\x40\xad\x6c\x00\x9d\x36\x43\x26\x70\x3f\xb8\x2a\x71\xb0\x26\x05\xaa\xc7\x37\x29\x66\x36\x84\xf4\x6a\x0e\xf3\xc1\xb5\x81\x0a\x1c\x53\x42\x6b\x9a\xc8\xe6\x4b\xe8\x1a\xa8\x26\xaa\xe9\x25\x52\xc3\x47\x06\x4c\xa2\x19\x9d\xac\x37\x43\xbd\xc3\x9c\x9a\x36\x52\xf1\x45\x5a\xec\x31\x20\x5e\x93\x5e\x0b\x80\xf2\x72\x86\x0b\xbb\x17\x68\x48\xe4\xfd\x20\x1a\x82\x81\x4a\xc3\xfe\x30\x07\xc5\xbc\x98\x01\x72\x26\x50\x19\x04\xdb\xc7\xf8\xd6\x2d\xda\x96\xac\x6e\x46\x1a\xe8\x81\xc3\x8c\x2d\xd5\x76\xb4\x6f\x98\xe5\x32\x95\xae\x3d\x7a\x8e\xdb\x42\x14\xe3\x61\xaf\x4f\x3d\x21\xb0\x5d\x7d\x48\x98\xb8\xc2\xce\xb9\xba\x23\x47\x71\x03\xdc\xe4\x5a\x2a\x12\x2a\xd4\xc5\x21\xaa\x74\x6a\x91\x12\x29\xcd\xe1\x64\x82\xf0\xf6\x24\x92\xf7\x4c\xb1\x04\xbc\x30\x9a\xb2\x48\x4a\xc8\x93\x98\x85\x05\xf5\x36\x95\xf5\x32\x70\xfc\xf9\x3c\x4d\x08\x36\xfd\xe5\xb4\xc2\xc0\x9f\x1d\xc0\x1e\x60\xe7\x2c\xad\x31\xdd\xc0\x12\x35\x5d\x84\x99\x50\xcd\x1b\x6d\x8a\x1b\xee\x28\x09\xea\x28\x09\xd0\xd7\xb7\xb0\x68\xdb\xa2\x20\x1c\x74\xcf\x8b\x3c\x29\x1c\x11\x21\x4a\x27\x99\xdc\x5d\xa4\x34\x6b\x45\xeb\xc1\xcb\xe0\x00\x89\x9d\x99\x07\xb3\x9f\x13\x0b\x18\xa4\xf3\x1e\x36\x6c\x4e\x4a\xc3\xc9\xb6\x5d\x68\x92\x9a\x75\xd2\x82\x48\x92\xc5\x79\x5a\xbd\x6f\x07\xbe\xcf\xb9\xc9\x08\xf3\xf2\x80\x1e\x49\x7a\x94\x93\xfe\x50\x26\xc1\xa6\xfb\xae\x59\xe6\x26\x0d\x61\x95\x9c\xe5\x11\x71\xa3\xc0\x3e\x6d\xc1\x97\x2f\x8c\x4b\x5b\xcf\x25\x54\x60\x3a\x18\xde\xfa\x3a\x4a\x6c\x96\xe7\xd4\x9d\x14\x7b\x69\x01\x24\x6c\xdf\x14\x82\x85\xed\xd4\x68\x76\xdb\x31\x72\x47\x9b\x37\xe1\xde\x2e\x38\xb4\xe8\x03\x65\x97\xca\x1f\xc8\x95\x93\xbf\xef\xdc\xe8\xfc\x56\x2c\x64\x4a\x49\xa8\x2b\xb9\x34\x68\x4a\xa0\x70\x1e\x94\x60\x67\x20\xca\x35\xb9\xf5\x91\xf5\x1f\x3b\xe5\x35\x74\x6a\x45\xb1\x22\xfa\x90\xd9\x1e\xaa\x39\x95\xc7\x20\x12\x73\x46\xe1\x1c\xf8\x23\x95\xf2\xb9\xcf\x33\xd9\x62\x9a\x89\x3e\xc8\x2d\xf1\xf1\xc1\xf6\x70\x01\x4a\xe0\xfa\xc8\xac\x1a\xb8\xdb\x37\xd1\xdc\x67\x42\x4b\x4a\x14\x5c\xa8\x3e\xe7\x48\x1d\xc1\x8f\xd8\xef\xdc\x2c\xd9\x67\x36\x16\xca\xd4\xb3\xa4\x1f\x9c\xa7\x05\xfe\xe7\x14\xdd\x8f\xa0\x76\x9d\xa4\x22\x87\x8f\xe8\x57\x9f\x71\x32\xdf\x15\xf2\x66\xbc\x2d\x1e\xf5\xf0\x24\x5e\xb6\x3c\x3a\x10\xfc\x64\x98\x22\xa2\x55\x0e\x90\xcf\x6d\x94\x3f\x67\x52\xf7\x34\xec\x01\xf8\xd0\x59\x82\x2a\xb8\x44\xb8\x0f\x1d\xe8\x84\xe4\x5c\x2d\x53\xe7\xb6\x24\x69\x32\x20\x8d\xa5\xa5\x75\x4a\x5a\xc0\xb5\xda\xd4\xd0\xea\x92\xdb\x5c\xee\x77\x94\x15\xf4\x56\x26\x1b\x7b\x80\x5c\x5d\xe0\x4d\x78\x27\x4c\xcf\x5a\x0f\x88\x4a\x49\xef\xab\x2c\x6a\xed\xc3\xc7\xd8\x48\x06\xdc\xa1\x10\x3e\xd3\xeb\x31\xc5\x3d\xd1\xe9\x80\x2a\xf7\x52\xae\x19\xdb\x3b\x89\x79\x1c\x8e\xbd\xe0\x4e\xc8\x90\x91\x69\xc5\x20\xbb\xae\xa3\x31\x58\x50\xd9\x35\xa5\xd6\x8d\x6f\xdc\xcf\xc8\x6b\xa0\xe3\x96\xd7\xdf\x77\x5a\x93\xff\x0c\x2b\x9f\x09\x56\x03\x43\xb6\x1e\x5d\xe2\x9d\x27\x58\xf9\x60\x84\x94\xd1\x37\x14\x7d\x7f\x8e\x7a\xa8\xca\x0b\xe8\xf4\xd0\x4e\x0f\xed\xf4\xd0\x4e\x0f\xed\xf4\xd0\x4e\x0f\xed\xf4\xd0\x4e\x0f\xed\xf4\xd0\xcf\x4b\x0f\x75\x7e\x91\xf4\xd2\x7a\x39\x90\x7f\x92\x3e\xf8\xe5\x9c\x0d\x52\x84\x5d\xce\x7f\xd5\x75\x8c\x8a\xe3\x48\xe9\x12\x97\xe4\x96\x76\xa8\xa9\x27\x92\x08\x13\xa0\xfe\x57\x83\x57\x2f\x5f\x72\xa9\xdf\xa7\xd0\xcc\xb5\xcc\xcc\x8d\x52\x07\x96\x87\x9f\xf9\x80\x3c\xcd\xb6\x33\x71\xdd\x67\x4e\xed\x24\x0e\xad\xe4\x86\x09\x16\xd6\x02\x92\x2e\xa5\xe0\xb5\x40\xb1\xc9\x65\x9c\x81\x7a\x86\x21\x69\x2b\x0c\x14\xcd\x04\x57\x22\xcd\xd3\x49\xad\x8e\x5c\xc7\xc5\x31\xcd\x4e\xc5\x0a\x91\xaa\x87\x6a\x17\x0c\x88\x0d\xfb\x5c\xda\xc5\x58\x84\xbc\x8e\x50\x32\x21\x55\xed\x35\x9d\x09\x35\xd0\x4d\x89\x10\xdc\x86\xd0\xe7\x13\x1c\x88\x21\xab\x33\xf0\xa4\x54\xd9\xa7\x41\x39\x9f\x00\x27\xe8\xc9\x72\x59\x95\x7a\x87\x11\x78\x8c\x9f\xab\x8a\x41\xde\x04\x1f\xec\x9f\x85\x23\x03\x4a\x9a\x65\x83\x13\x35\x0a\x9d\x35\x21\x07\x01\x60\x29\x1b\x61\x94\xd3\x2c\xd1\xc1\xb4\x5a\xe6\x3d\x3e\xc5\xe6\x1f\xd4\x35\x18\x6e\xb2\xe8\xb9\xfc\x05\xdf\x2f\x27\x4a\xd2\x3f\x89\x56\xdf\x7f\xe0\x76\xbc\x72\x12\xe3\xcb\xb6\x3b\x06\x47\x31\x85\x80\xc2\xb9\xab\x91\x42\x37\xbf\x48\x2d\x44\x3a\x54\xf5\xb2\x16\x55\xcb\x6c\x03\x3e\x50\xc4\xc3\xd1\xf9\x89\x2c\xa4\x16\xc1\x65\x3a\x4f\xe3\xf4\x7a\x61\x9f\x9b\x1a\xda\x81\x6d\x79\xdd\xfc\x26\x98\x45\x70\xa5\x6c\x35\xa4\xb8\xf3\x25\x82\xe8\x22\x9a\x5d\x44\xb3\xf3\x24\x75\x9e\xa4\xce\x93\xd4\x79\x92\x3a\x4f\x52\xe7\x49\xea\x3c\x49\x9d\x27\xa9\x8b\x68\x3e\xbe\x1e\xda\x45\x34\x3b\x3d\xb4\xd3\x43\x3b\x3d\xb4\xd3\x43\x3b\x3d\xb4\xd3\x43\x3b\x3d\xb4\xd3\x43\xbb\x88\xa6\xeb\x2b\x5c\x10\x3d\x58\x0d\x0d\xbc\x68\x6d\xf1\xcc\x2f\xce\xd3\xc9\x56\x85\xab\x18\x3d\xd9\x58\xb7\xca\x0a\xf7\x8c\xd3\x41\x9c\x8e\x4d\x1f\x7a\x04\xa8\x1a\xef\xe5\xd8\x77\x24\xa1\x06\xa2\xff\x4e\x13\xf8\x7f\xac\xce\x43\x06\x81\x51\xa6\x06\xa8\xb2\x85\x11\x00\x3b\xc8\x7b\xbd\xae\x2a\xb6\xab\x8a\xed\xaa\x62\x7f\x5b\x55\xb1\x37\x61\x2e\x6f\x82\x54\x4e\x36\x16\xc9\xf2\x2e\x94\xe6\x91\xc8\xa4\xff\xdc\x58\x23\xcb\x89\xc5\x3f\x6a\x15\x2d\xb2\x16\x45\xb6\xd4\x07\xa1\x22\x3c\x89\x97\x89\x4a\xbd\x11\x93\x8b\xfa\x5e\x99\x4d\x71\xd0\x6f\x42\x5b\x82\xe3\x03\x60\xc0\x4e\x07\xf2\x3a\xa4\xf0\x47\xd5\xed\xb9\xbe\x53\x66\x3b\x26\xc2\xf1\x2e\x2a\x5d\xeb\xdb\xf4\x0a\x69\xdb\x31\xf9\x9a\x18\xa4\xba\x57\x7e\x74\x78\x4d\x87\x9a\xc7\xae\x7b\x25\xcf\x86\x56\x44\xb6\x71\xcd\x91\x87\x04\xd8\x50\xb6\xa0\x6e\x61\xc6\xa2\x77\x54\x56\x25\xcf\xcb\x55\x96\x16\x30\x88\x71\x98\x53\xa6\x86\x93\x8d\xea\xeb\x2f\xda\x26\x66\xbe\x82\x94\x65\x60\xd2\x53\x87\x3e\x4c\x67\xe5\x1f\xae\xac\x44\xf0\x5a\xa7\x27\x57\x40\xae\xd5\x57\xa5\xb4\xa9\x92\x20\x5c\xbd\x8f\x7e\xc6\xd0\x5a\xea\x69\xc1\x19\xba\x1b\x87\xe8\x6e\x9c\xa2\x6d\x38\x46\xb7\x73\x8e\x7a\x3b\x48\x57\x8e\x50\x0d\x2e\x29\xb4\xaf\xd4\x0b\x62\x50\xf3\xb0\xae\xf8\x4b\x3d\x61\xfa\x78\x59\xb7\xb6\xd6\xb7\xf1\xb6\xae\x20\xd7\x10\x5b\xd5\xb6\xcf\x13\x17\xeb\x1c\xb6\xc6\xfd\xea\x09\x73\xb3\xd3\x16\xb9\x8a\x27\xd0\x15\xc7\x2d\xdd\x90\x6d\x7d\xb7\x5b\x1f\xaa\xaf\x17\x76\xe5\x48\x95\x4b\x2e\x22\x97\x97\xbb\x53\xa7\x86\xfd\xba\x27\x77\xd9\x81\xe8\x09\x77\xc9\x9b\xbb\xe2\xe8\xf4\x04\xbb\xea\xd1\x6d\x6f\xbd\x1b\xbc\xba\x9e\x4e\xd3\xf5\x8e\xd3\x6d\x3c\xbb\xf8\xb3\xde\xa9\xa9\x5c\x94\xde\x97\xba\x25\xc7\xe6\x56\xce\xcd\x16\x6e\x97\xbf\x93\x73\x1b\x47\xa7\xaf\xb3\x73\x0b\x87\xe7\x16\x4e\xcf\x6d\xb0\x44\x0a\xe9\x5b\x4a\xb9\x74\x45\x12\x1a\xe7\x72\x8a\xc8\xc5\x16\xda\xc6\x16\xf4\xb1\xaa\x59\xcb\x8d\x48\x6d\x0f\x27\xdd\x38\x9a\x1a\xf8\xf3\xbf\xa8\xd2\xd1\x25\xff\x3f\x1a\xaa\x97\xa3\x41\x27\x83\x22\xf6\xdf\xdc\x23\x04\x49\x65\x61\xab\x65\xe2\x0a\x61\xa9\x48\xa3\x00\xd4\x47\xb1\x95\x49\xf1\x7a\xc6\x0e\xf0\xfd\x65\xfb\xc2\x5d\xab\x95\x43\xfa\x50\xe9\x92\x6e\x18\x58\xe0\x1e\xfc\xb6\xd7\xdf\x8e\x23\x23\x98\xb3\x64\xaf\x1a\xf7\x54\xe3\xa3\x5a\x17\x77\x37\x82\x70\xd2\xc2\x1e\xc1\xda\x5b\x93\xf7\xed\xa3\x6c\x78\x99\x3c\x5b\x85\x2b\x1c\x1f\xab\x5a\x5d\x7b\xdb\xe6\x56\xb7\xec\xaa\xe7\x3a\xb9\xc6\x9c\xf6\x9d\xd8\x7d\xb5\x45\xdd\x6f\x60\x65\x34\x38\xc1\x3c\x30\xcd\xd9\xae\x91\x22\x8a\xde\x9f\x97\x6a\x05\x9c\x8d\xe5\x99\x08\xc1\xca\xdb\xd3\xf1\x04\xbb\x7d\xfb\xde\x6e\xb3\x7b\x3c\x79\x9b\x0f\x2f\x2f\x54\x89\xc2\xf7\x6e\xa6\xdb\x92\x5f\x50\x45\x5c\xaa\xe9\xe8\x26\x1c\xe3\x16\x64\x3d\xd0\xde\xaf\x1e\x9e\x9b\x1c\x0c\xa6\x01\xc1\x1f\x93\x22\x1a\x98\x6f\x38\x01\xae\xa6\x8e\xa3\x33\x57\x97\x02\xae\x52\xdf\x0b\x0f\xd6\x5c\xc5\x34\x2a\xba\xc6\x68\x36\x36\x20\xf6\xc4\x03\xe0\x53\x4d\xb7\xc2\x40\x55\x56\x26\x34\x1e\x25\x4d\x54\x50\x44\x32\x5b\x27\x88\x72\xf8\xa0\x72\xd7\x4a\x17\x09\xe1\x44\xce\x73\x33\x34\xe0\xae\x03\xe8\xe0\x10\x3a\x84\x13\x55\x91\x03\x0b\x95\xfe\x72\xf8\xc4\x09\xa2\x99\x9c\x15\xcb\x89\xaa\x74\x5a\x91\xc1\xc0\x30\x38\xc5\xab\xec\x76\x97\xaa\xcd\xe9\xd9\xa7\x34\x43\xc1\x8d\x57\x7b\xdc\x48\x77\x1d\x72\xe0\x71\x12\x8f\x59\x58\x7b\xbf\xb3\xc2\xda\x25\x47\xfa\x6f\xb6\xae\xd6\x23\x6c\xd2\x15\xd7\x76\xc5\xb5\x0c\x75\x7f\x77\xc5\xb5\xf4\xb8\x94\x28\x0f\x57\xd9\xb2\xc2\xee\x54\x87\xdb\x66\x95\x6d\x10\xfc\xa4\x66\xf0\x60\x60\x11\xfe\x37\x2b\xe3\x22\x9a\xc7\x55\x84\xb9\x6f\x46\x7b\xe7\x3a\x21\x9c\x63\x50\xd4\x39\x18\xae\x18\xe3\xf7\x4b\x57\x98\xde\x48\xa9\x6c\x39\x49\x34\x8e\x5d\x45\x09\x01\x18\x5c\x95\xb5\xb5\xda\x57\x24\xb3\x2f\xa2\xdd\x85\x77\x4f\x48\xe3\xc8\xab\xfc\x59\x92\xbe\x07\xa8\xd4\xc4\x48\x76\x5c\x95\x63\xb3\x76\x55\x0d\xa3\x55\xb7\xe3\x1a\xfe\x9d\xb8\xcd\xe9\xc1\x4c\x1c\xed\xd7\xdf\x56\xed\x74\x51\x37\x49\xd6\xf1\xcc\xe2\x65\xd5\xaf\x49\x9d\x63\x02\x45\xfb\xfa\x21\x35\xee\x2f\x96\xf6\xf0\x57\xa7\x36\xd1\x0f\x28\x70\x84\xe0\x74\xca\x96\xc6\x36\xe9\x54\x8a\xdb\x0e\x62\xef\x5e\xc1\x66\x6e\xa0\xd9\x0a\x1e\xb3\x75\x10\xef\x20\xf3\x93\x16\x64\xb7\x14\x58\x66\x04\x95\xfd\x6b\x6b\xbc\x02\xca\xdb\x17\xd5\xb4\x16\x48\xee\xaa\x6a\x1e\xfa\xd9\xbe\xaa\x66\x39\x60\xec\xe9\x80\xdf\x18\x2c\xfe\x44\x8b\x6b\xd6\x84\x7a\x99\xf3\xc9\x56\x0e\x68\x53\x98\xd7\x0a\xd8\x7a\x00\x5e\x13\xe2\x5d\x0d\xd6\xfa\xa4\x57\x6c\x1d\xde\x7d\xea\xe2\x9a\xd5\x90\xae\x4f\x48\x22\x68\x08\xe7\x02\x7c\x9f\xa2\x92\x87\x42\xb9\xa4\xad\x7a\x00\x7d\x30\x8c\xeb\xb7\xce\x07\x42\xb8\x6a\xc9\x5e\x85\x60\xab\xe1\x5b\x2b\x10\xeb\x97\x61\xb4\x14\xba\x5d\x0d\xc2\x7a\x80\xed\xea\x51\x1e\xf4\x99\x7c\xb6\x75\xd1\xee\x21\xd9\xed\xc3\xb1\x9e\x67\xcf\x09\xc3\x7a\x87\x4e\x1f\x08\xc1\xae\x09\xa9\x3a\xba\xbd\x97\xc2\xaf\x6d\x87\x53\x77\x10\x4a\xdd\x41\x18\xb5\x39\x84\xea\xae\xb4\x7b\xdc\x16\xe7\x47\xdc\xc3\xa0\xcc\x10\xa8\xf5\x27\x27\x57\xf8\xa6\xf0\xe7\x9a\x90\x26\x3f\xd6\xbb\x12\xfa\x6c\x23\x9c\xe9\x28\x1d\x3c\xf8\x82\x2b\xdf\xf3\x08\x5f\xf2\x43\x97\x96\x7f\x88\xbd\x67\x9e\x1f\xc9\x29\xb8\xd1\x10\xb2\x5c\x09\x43\x7a\x24\x04\xf4\x1b\x7c\x56\xfc\x40\xcc\xfa\x50\xe5\x1a\xbf\x95\xdb\x18\xaf\xa5\x30\xe5\x46\xcf\x15\xff\x9c\xac\x10\x65\x63\xd8\x91\x0d\x95\xc2\x93\x2d\x84\x1c\x1d\x6f\x8e\x6b\x9c\xc9\x2d\xcc\xb8\x93\xb0\x91\x43\x61\x29\x5c\x9f\xed\x8b\x4b\xed\x3b\xe8\x3a\x19\x95\x22\x22\x41\x78\x97\x82\xb5\x3a\x2f\x0b\x55\xaa\xf6\x70\x95\x69\x03\x48\xbb\x06\xb5\xab\x32\xdd\x5c\x65\x5a\x3b\xb6\xae\xd4\xf4\x37\x57\x6a\x6a\x9f\x3f\xa7\xc4\xd4\x52\xb9\xf9\xf5\xa6\xcc\x82\x50\x1d\x2f\x64\xd5\x9b\x32\x61\xca\x8a\xd4\xae\xde\x74\x23\x93\xe9\xea\x4d\x37\x1f\x69\x57\x6f\xda\xd5\x9b\xb6\x13\x12\xdc\x55\x58\x90\x19\x1a\xec\xea\x4d\x77\x17\x2a\xdc\x4d\xb8\xb0\x8d\x90\xe1\x76\x61\x43\xef\xd0\x61\x63\xf8\xb0\xab\x37\xed\xea\x4d\xbb\x7a\x53\x97\xe0\x64\x57\x6f\xda\xd5\x9b\xfa\x5e\xea\xae\xde\x34\xe8\xea\x4d\x99\xda\x6d\x57\x6f\x5a\xfd\x74\xf5\xa6\x5d\xbd\x69\x57\x6f\xea\xba\x92\xae\xde\xb4\x45\xde\xd6\xd5\x9b\x6e\xe6\x4e\x5d\xbd\x69\x57\x6f\xda\xd5\x9b\x06\x5d\xbd\xa9\x09\x37\x74\xf5\xa6\x2d\x09\x93\x47\xa9\x37\xad\x05\x9e\x3f\xf7\xa2\x53\xc6\x66\xeb\x5b\xe1\x94\x47\x62\x6d\xaa\x53\xe5\x29\x03\xa8\xcc\xc4\xe0\x57\x9e\x72\x9a\x07\xd7\x26\xc0\x36\x56\x9e\x72\xea\x37\x75\x6d\x2a\xaf\xf2\x94\x01\xb1\x5e\x9b\xca\xab\x3c\x65\x46\x73\xed\xda\xd4\xae\xf2\xf4\x41\xe3\xa1\xab\x3c\xed\x2a\x4f\xe9\x36\x76\x95\xa7\x5d\xe5\x69\xbb\x06\x78\x57\x79\xda\x55\x9e\x76\x95\xa7\xeb\x0e\xa8\xab\x3c\x75\x8c\xf8\x75\x95\xa7\x6e\x3f\x5d\xe5\x69\x57\x79\xda\x55\x9e\x6e\x87\x91\xae\xf2\xb4\x46\xff\x5d\xe5\x69\x9d\xcb\x74\x95\xa7\x4b\x9e\x84\xae\xf2\xb4\xab\x3c\xed\x2a\x4f\xbb\xca\xd3\xae\xf2\xf4\x09\x02\x48\x2c\xa0\x8c\x2f\x85\xd9\xf5\x03\x1c\xa9\x91\x61\xb1\x10\xdd\xbc\xa7\xab\x32\x8a\x8b\xb3\x59\x78\x2d\x5e\xbf\xd8\xe2\x55\xe3\x74\x36\x03\x01\xfd\xf4\xfb\x11\xc9\xdd\x16\x8b\xa8\x71\xd8\xd3\xe4\xee\xc7\xb0\xee\x83\x48\x10\x7e\x94\xa5\x09\xa9\x4b\x77\x61\x16\x85\x57\xa0\xa2\xa9\x2f\xbc\x68\x70\xb2\x86\xc1\xb1\x54\x57\x44\xf6\xd0\x75\xe2\x3a\xa4\x90\x09\x36\xdd\x9b\xda\x86\xce\xb1\xc6\x58\x17\x9a\xae\xd9\xc7\x30\x78\xc7\xb1\x81\xaf\xb0\x16\xf2\xf8\x1f\x67\x27\xa7\xe7\x97\x67\x6f\xce\x4e\x3f\x34\xf1\x06\x36\x4f\x20\x5e\xeb\xb4\xa5\xfd\x1f\xf5\x19\x50\x89\x9d\x48\x50\xbb\xf9\xdd\xc1\x8f\x47\x1f\xfe\x71\x7e\xf4\xee\xb4\x47\xca\x9c\xf8\x38\x07\xda\x64\x88\x97\x32\xd7\x72\x0a\x4e\xf4\x2e\x4a\xcb\x4a\x92\xac\xc3\x17\x70\xda\xe6\x10\x90\x4c\x1e\x50\xc7\xae\xfc\x9e\x0b\x60\xd8\xd9\x5d\x34\x5e\x7f\x0c\x0c\x8f\xd4\x19\xc8\x8d\x8a\xfc\xc6\x61\xa2\x62\xb9\xe8\xfc\x8f\xef\x30\x14\x57\xdc\x58\x28\xe1\xae\x33\x4a\xe6\x65\xa1\x5d\x2f\x7a\x90\x7a\x99\x8c\x6f\x30\x5d\x61\x22\x55\x65\x0b\xb9\x8d\x30\xf3\x05\xec\xfb\x23\xae\x8f\x7c\x0e\xf9\x38\x9c\xeb\x24\x8c\x30\x98\xa4\x25\x2e\xfe\x77\xbf\xeb\x07\x11\x90\xc7\xef\x2c\xc0\xcd\x08\x38\x55\xb0\xac\x53\x97\x31\x6d\x81\xc1\x90\xab\xea\xcc\xb1\x38\xf8\x3a\xcc\x26\x80\xd6\x66\x5d\x00\x6b\x9d\x6f\x04\x55\xd3\x4b\x4b\x43\x21\x58\x18\x87\x0c\xe0\x79\x88\xe1\xc6\xb0\x8c\x0b\x56\xca\xdf\xde\xde\x70\xbf\xd5\xeb\xf1\x26\x4b\x67\x4e\x57\x64\x44\xe1\x20\x2a\xdb\xde\x74\xf1\xf7\x95\x37\xbf\x19\xef\xc7\x86\xd4\xca\x1c\x55\x2d\xe5\x44\xd5\xca\x02\xab\x3e\xc0\xc5\xe1\x0e\x37\x67\x1a\x5d\xbf\x0b\xe7\x20\xf0\x3f\x88\xa9\x73\x64\x58\xaa\x9a\xb9\x0c\x3b\x90\xba\x85\x3c\x58\x82\x1c\xee\x20\xb6\x77\xeb\xad\xcd\x0b\xa5\x29\x2a\x5d\x6e\x47\xfa\x16\x4f\x6a\xac\x67\xb5\xb6\xf8\x50\xf7\x0e\x2e\xc3\xbb\x94\xb2\x00\xa6\x29\xdf\xba\xba\x29\x8a\x79\xfe\xfa\xf0\xf0\xb6\xbc\x12\x59\x22\x0a\xe0\x78\x51\x7a\x38\x49\xc7\xf9\x21\x9c\xf7\x58\xcc\x8b\xfc\x10\x83\x9a\x77\x91\xb8\x3f\xbc\x4f\xb3\x5b\xd8\xdb\x00\xb9\xc6\x40\x2a\x54\xf9\x21\xe9\xff\x87\x5f\xd0\x7f\xd8\xaf\xbd\x7c\x7f\xf2\xfe\x75\x70\x34\x99\xa8\x86\x19\x40\xc1\xd3\x32\x56\x75\xdc\x43\x30\x5f\xa3\x1f\x45\x86\x4e\x0f\xbe\xcf\x03\x56\x06\x1c\xa6\x8c\x26\xdf\xec\xef\xea\xbc\xd2\xb9\x74\x71\x79\x9d\xd9\x88\x2c\xab\x45\x8d\xab\x19\xfa\x0f\x1c\x3c\x31\x98\x93\x82\xf4\xa9\xfd\xc7\x4a\x2c\x3a\x6d\xfa\x2a\x4d\x63\xb0\xe4\x77\x60\x15\xdc\xb6\x6f\x0d\x10\x55\xf8\x70\x9c\xfd\x8a\xe5\x48\x1f\x98\xba\x31\x60\x99\xc1\xb9\x97\xf3\x79\x9a\x31\x43\x43\x33\x51\x84\x93\xb0\x08\x87\x48\xe6\xfd\xfa\xaf\x64\xfc\x5a\x9f\x91\x61\xca\x74\xd6\x99\x87\x88\x97\xcb\xd0\x58\x9f\x8c\xf0\x21\x1a\xa2\xe7\xf4\x36\xfa\x55\x69\x29\x47\xe3\x71\x5a\x26\x05\xfd\x81\x97\x4a\x01\x50\xcb\x7c\x08\xf6\x73\x71\x76\xd1\xd7\xbf\x02\x06\x96\x7f\xcb\x87\xfb\x3b\x60\xc2\xd5\x4d\xf6\xba\x34\xea\x59\xbb\x53\xc9\x2c\xa4\x7f\xbe\xc1\x03\xbd\x08\x0b\x7e\xf5\x00\xc8\xc5\xfb\x2c\x2a\x0a\x91\x90\xb3\x83\xd2\xa2\xd2\x69\x1f\xef\x8f\x56\x21\x82\xbd\xbb\x57\x7b\x3b\x63\xf7\x53\xbd\x64\x2f\x54\xe0\x83\x1a\x0f\x92\x9a\x8d\x80\xe2\xe8\x94\xb6\x6e\x59\x65\x02\x1e\x5d\x9c\x05\x77\x12\xc7\xcf\xc4\xab\x30\x75\x38\x57\x27\x2e\xa2\x93\x71\xde\xb4\xc0\x4d\x34\x2c\x7d\x1e\xc6\xa8\xe0\xed\x92\x9c\xde\x26\x39\x28\x88\xa3\x59\xa4\x2a\x8b\x55\x1b\x9d\x3c\x38\x90\x1f\x0e\xc7\xf3\x92\x77\xcf\xd5\xf7\x67\x62\x96\x66\x8b\xbe\xfe\x55\xcc\xe1\xc2\x88\x2c\x8c\x07\x79\x91\x66\xe1\xb5\xe8\x9b\x57\xf0\x41\x9b\x27\x24\xf0\xda\x42\x57\xdf\xc0\xf3\x72\xa2\x2d\x38\x2e\x33\xd4\x5b\x00\x17\x8a\x17\x83\x5d\xb3\x0b\x1e\x64\x4e\xe7\xdc\x5b\xdf\x32\xce\x02\xa9\xb3\x19\xaa\x26\x55\xfe\x2e\x8d\xcb\x99\x4b\xfa\x8d\xd6\x23\x64\xff\xa6\xe4\x0e\x95\xff\x7c\x67\x5a\xcb\x24\xba\x8b\x72\x7e\x1e\x08\x98\xc4\xef\xa7\x4e\x85\x07\x8e\x99\xed\xf5\xe7\x9c\x1c\xfb\x6b\x14\xaa\x48\x15\x82\xa5\x65\x81\x06\xb3\x4c\xcd\x77\xc9\x73\x50\xad\xbe\xd2\x9c\x4c\x58\xd3\xf7\xa5\x26\x15\x5e\x71\x63\x1b\xf3\x10\xe4\x4b\x06\x8b\xfb\x9f\x83\xbf\x7f\xf9\xeb\xa0\xf7\xcd\xc1\xc1\xcf\x2f\x07\x7f\xfa\xe5\xcb\x83\xbf\x0f\xe9\x1f\xff\xd1\xfb\xa6\xf7\xab\xfe\xe5\xcb\x5e\x0f\xfe\xfe\xfd\xbb\xef\x2e\x2f\x4e\x7f\x89\x7a\xbf\xfe\x9c\x94\xb3\x5b\xf9\xdb\xaf\x07\x3f\x8b\xd3\x5f\x98\x40\x7a\xbd\x6f\x7e\xc7\x5c\xe0\xc7\x41\xa5\xe9\x0f\xe0\xcc\x06\x69\x36\x90\x47\xf0\x3a\x28\xb2\x52\x30\x19\x82\x44\x93\xdf\x5d\xd2\x5d\x8b\x5e\x57\x6c\xd4\x48\xb2\xfd\xe7\x21\x84\x1c\x1a\x9f\x39\xc9\xa0\x5c\x8c\x33\x51\xb4\x67\x3f\x4b\x78\x3a\x76\x43\xf1\x38\xb7\xe8\xe5\x33\x34\xbb\x4d\x18\x85\xb6\x66\x28\x83\x7d\x9f\x31\xb3\x7f\x18\x90\xfb\x54\xba\x47\xef\x28\x41\x4c\xc1\x83\x37\x74\xe6\x7c\x67\xce\xef\xc6\x9c\x1f\x49\x1a\xc3\x68\xaa\x43\xca\xe1\x6f\xc8\x96\x67\x7e\x95\xb7\xce\x01\x5d\xb8\x17\x5b\xbe\xad\x39\x8c\x15\x6d\x1d\x91\x7b\x98\x31\x30\x00\x68\x79\xf4\xc1\xca\x8d\xd9\x0c\x70\x85\xad\xea\xb6\x99\x95\xc9\x61\xf4\xd7\xab\x45\xdd\x80\x79\xb1\x9d\x18\x90\x66\xc7\xc3\xe7\xe6\x93\x2a\xc6\x54\x4a\x5d\x95\x51\x27\x25\xf4\xd9\xea\x76\x5b\xe9\x74\x75\x41\xf4\x56\x9a\xa2\x13\xd3\x70\x59\xa6\xb8\x7d\x8c\x66\xe5\x2c\x08\x67\xe8\x7a\x7a\xd1\x18\xb7\x59\x25\x37\x9d\xb8\x60\x89\x34\x96\xa8\x92\x21\x87\x32\x23\xc7\xd8\xe1\x2c\x4c\xe0\x26\x0e\x14\xf8\x81\x01\x3f\x30\xd4\x7b\xb8\xff\xa2\x25\xf6\x83\xb6\x6d\x47\xc6\x9f\x2a\x19\x7f\xd0\x6e\x94\x25\x42\x8e\x92\x2d\x09\x39\x33\x1d\x57\xcf\xa6\x81\x79\x4b\x94\x07\xe9\x0c\x3d\x8b\x4d\x82\x1b\xed\xfd\xb0\x62\xb6\x7d\xec\x5d\x6d\x1b\x9a\xea\xfa\x51\x17\x61\xd9\x44\x1a\x2c\xd3\x38\x1a\x47\x45\xdc\x24\x89\x8d\x4f\xaf\x2f\x75\xb0\xfb\x48\x96\xec\x86\x09\x08\xb0\xb9\x4c\x2a\xa5\x6b\x34\xd0\x61\x74\x56\x98\xf1\x13\xb8\xb0\x8c\x2f\x81\xf2\x5f\x66\x51\xb1\x40\x37\x8e\xf8\x58\x30\x45\xe7\xa8\xfe\x54\x70\x93\x62\x93\x61\x0d\x2b\xa8\xed\xf5\x21\x35\x04\x8f\x52\x47\xf0\x65\x62\xe6\x44\xd6\xbe\x18\x34\x0c\x83\x11\xd6\x53\x5b\x6d\x8c\x9b\xd3\x58\xc0\xe8\xbb\x82\x93\x5e\x59\x25\x7a\xe7\x2e\xd2\xc9\xd2\xc7\x43\x35\x3b\x17\x1f\x79\xf1\xb0\x5b\x2e\x17\xb5\xfa\x06\x7c\xd1\xf2\x3b\x8a\xf0\x96\x96\x38\x16\x13\x0c\xf8\x0f\xb7\xd4\x1a\x48\x3c\x5c\x64\xd1\x5d\x14\x03\xbf\xc3\x7c\x02\x59\x25\xe4\x30\x22\x60\xff\x68\x03\x0c\x42\x72\x96\xc6\xb9\xd6\xd4\x9b\x58\x34\xae\x19\x68\x34\xa7\x54\x09\x4c\x99\x95\x4d\xe1\xe7\x1a\x34\xa5\x0b\x26\x14\x85\x9b\x87\x68\xa8\xe9\x07\x9a\xae\x12\x65\x89\xa2\xb2\x1e\x4c\x80\x85\x8c\xd1\xef\x69\xd6\x66\xfa\x86\xff\x23\x11\xf7\xff\xc0\x77\xe5\xc1\x34\x0e\x9b\x78\xb9\xa6\x2a\x2a\xa2\x4a\x96\xf2\x5b\xf4\xb2\x82\x4d\xa8\x79\xd1\x18\x2f\x41\xb6\x0b\xa7\x73\x1f\x2e\x08\x7d\xcb\x6f\x88\xf2\xd7\xc1\xab\x1e\x26\x38\x62\x45\xb2\x79\x43\x13\x17\xfc\x7d\x8f\x7a\xa3\x1f\x1f\x5d\xfc\x63\xf4\xdf\xa3\x7f\x1c\x9d\xbc\x3b\x3b\xe7\xf0\x81\x66\x43\x67\x1c\xce\xc3\xab\x28\x8e\x9a\x65\xf1\xaa\x8e\x6c\x3d\x4a\xb7\x73\x32\x39\x9c\x00\xf5\xca\x7d\xf3\x2a\x90\x0d\x66\xf2\x5a\x62\x0a\x61\x4d\xb1\x79\x5d\xef\x66\xbf\xae\x01\xea\x75\x16\x26\xc5\x1a\x55\x1d\x17\x85\xcd\x26\xda\x9a\x32\x01\x3b\x76\x6c\xb0\x71\x44\xbd\xd6\x1d\xb6\xe2\xdb\x54\xe0\x58\xbf\x62\x51\xa5\x00\x06\x17\xef\x47\x67\xff\x9f\xdb\xdb\x35\x2d\xb1\xad\x5a\xa6\x37\x81\x9f\x70\x8e\x24\xe5\x88\xe5\x0f\x62\x96\xde\x75\x78\x76\xc1\x33\x77\x22\x8d\xe1\x58\x0e\xcc\xe2\x43\x99\xd8\x2c\x30\xb1\xa0\x80\xa4\xc0\x56\x21\x17\x92\xf1\x36\xe2\xa9\xfe\x6c\xc5\x3c\x64\xa2\x64\x8e\xc8\x8f\x64\xeb\x12\x6e\xb7\x46\xe0\x35\x59\x9a\x1a\x59\x80\x41\xff\x3a\x27\x9a\x86\x71\xde\xc0\x30\xb8\xbc\x16\xc5\xcb\x3b\xd4\xa3\x1d\x70\x67\x9e\x81\x8f\x93\xb4\x50\x6a\x39\xbe\x91\xda\x3d\xc0\x5f\x03\x8e\x6a\x0e\x1b\x29\xf3\x2a\x8d\xce\x66\xbb\x97\x16\xab\x05\x01\xa6\xf6\x7e\xa1\xdf\xdb\x24\x4f\x29\x99\x1f\x60\xe7\xeb\x59\x6d\xa5\xac\x4f\xa9\x22\x3a\x9c\x60\x28\xb7\xd9\xe0\xba\x91\xd1\xdd\x59\x98\xdf\x62\x61\x00\x7e\xa0\x0a\x3e\x95\x41\x21\xdf\x67\x96\x79\xd9\x7c\x77\xa6\x22\x2c\x4a\xa0\x13\xd4\x12\x54\x6b\x1e\x91\x60\x02\xe1\x84\x73\xbc\x8d\xf7\x0d\xf7\xf6\x1e\xf6\xf6\x01\xc8\xe9\x0d\x50\xa8\xec\xc3\xe3\x70\xd4\x3f\x19\xbf\x28\x76\xb3\x30\x88\x44\xc1\x1f\x12\xf4\x81\x8c\x82\x03\xfc\xa6\x9d\x9a\xd7\x1b\x5a\xc6\xa3\x6d\x91\x94\xe1\x74\x8f\xf2\xef\xb2\xb4\x9c\x3b\x2a\x0d\xdf\x9d\x9d\xd0\x9d\x2b\x13\x95\xcf\x59\x64\x0b\xd9\x14\x69\x39\x31\xa0\x39\x0f\x53\xea\x6a\x3f\x20\xed\x2d\x51\x1b\x6a\x87\x65\x02\x8a\xc3\x30\x78\x17\x2e\x40\x1f\xcb\xf1\xb8\x9b\xec\x41\x19\x17\x5a\x6b\x09\x80\xed\xaa\xfe\xbc\xce\x82\x68\xf4\x61\xac\xb3\x2f\x2c\x8b\xc1\x4a\x2f\x59\x35\x1d\x9a\x6e\x36\x18\x16\x39\xcb\xb2\x50\xe6\xb4\xee\xc3\xf6\x87\xaf\x18\x94\xd0\xec\x4f\x21\x4a\x38\x4f\x13\x24\x7b\x07\x5a\x38\x4b\x26\xd1\x38\x2c\x74\x29\x51\x9d\x77\x90\x6f\x5f\xea\xc8\x8d\xb6\x07\x96\xaf\x13\x07\x07\x1e\x94\xc9\x12\x76\x50\xc1\x25\x72\xbf\x07\xbb\x3b\x16\xca\x94\xa4\xa0\x16\xbc\xb1\x09\x9f\x98\x49\x8e\xfe\x6b\xec\xd9\xa5\xc9\x0a\xbb\x4c\x25\x79\x99\xe9\x51\x55\xc0\x8d\x53\x21\x93\x86\xe1\x2b\x4d\x6b\xcc\x83\x1f\x80\xea\x5f\x06\x07\xb8\xce\x1e\x91\xc3\x34\x8c\x62\x8a\xd4\x15\x61\xb6\xbc\x7b\xa0\xdd\xa8\xe9\xd4\xf1\xf5\xb4\x59\xa2\x72\x8c\x9f\xd0\xe5\xee\xc3\x92\x82\xbc\x04\x8e\xac\x76\x8b\xe6\x9c\x32\x79\x9a\xee\x93\xc8\x90\x38\xc8\xe5\x58\x5d\x99\x86\x4b\xc1\xbb\x51\x0e\x46\x77\x9f\x71\x3a\xcd\x57\xc6\xf1\x52\x38\x31\x3d\x60\x37\x99\x23\xcf\xfb\xa1\x75\x9e\x67\x2b\x28\x48\xf7\x75\x6c\x48\xf2\xd5\x89\x92\x4d\x2a\x15\xd1\x90\x7e\xfc\xb7\x77\xf8\xed\x73\xc4\x5c\xbc\x8d\x92\xf2\xe3\x7b\xa2\x00\x57\xa3\x7a\x74\x4a\x0f\x13\x49\xd0\x66\xd2\x25\x1f\x58\x73\x05\xab\xe5\x23\x3b\xab\x1d\x6e\x7f\x83\x8a\x86\x0c\xa2\x89\x87\xc5\xb2\x3e\x15\x35\x11\x38\xbc\x74\xb6\xb2\x50\x33\x58\xcf\x7a\x3d\x12\x53\x23\x60\x0e\xa9\x7d\x1e\xc2\x97\xef\x58\x88\xc5\x9d\x88\x1d\x8d\xde\xb7\xf8\x0c\xaa\x79\xfa\x64\x08\x88\xea\xcc\x44\x62\x8b\x3f\x8d\x47\x79\x60\xc6\x9c\x82\x3f\x67\xa3\x34\x03\x3e\xeb\x6a\xd0\xc3\x23\xb2\x3d\x85\xde\x1c\x02\x79\x86\x7b\xa3\xaf\x3a\x0e\x13\x45\x5b\xae\xb6\x37\xb2\xee\x9e\xdf\xde\xca\x9c\x93\xad\x5b\xdb\x1b\x0a\xcb\xfa\xde\x48\x5a\x3d\xb7\xbd\x31\x9d\x1f\xf7\x11\x70\xbe\xfb\xdc\x8f\xb1\xff\x24\x1f\xd6\xdc\x66\x8c\x2c\x0d\xc7\x70\xe6\x16\x73\x6f\x66\xc2\x35\xcb\x7d\x1d\x77\x97\x19\x3a\x39\xe5\x74\x31\x64\xc5\x2a\x67\xac\x2a\x27\x73\x15\xb4\xfb\x8d\xf1\xde\xeb\x59\x1e\x1e\x67\x08\x13\x7d\x49\x98\xb4\xe4\x48\xf4\xdf\xbd\x1b\x1d\xd5\x01\x50\x21\x05\x35\x7a\xc0\x9d\xe3\xdf\x39\xfd\x69\x27\xb3\x88\xba\xd8\x04\xf7\xe2\xea\x26\x4d\x6f\x83\x03\x1d\x4f\xbc\x8e\x8a\x9b\xf2\x6a\x38\x4e\x67\x56\x68\x71\x90\x47\xd7\xf9\xa1\xa2\xd1\x01\xee\x82\x93\x57\x1e\x25\x31\x35\x9f\xd5\x57\xab\x1a\xf1\x2a\x17\x1a\x8c\xcd\x4e\xe8\xb0\x38\x23\x7d\xc3\x59\xe5\x7b\x5f\x45\x06\x65\xee\x51\xdc\xae\x55\xee\xb4\x7a\x6c\xbc\xdc\xf5\x86\xa3\xa3\xd5\xaa\x26\x87\x89\xcc\x39\x64\x0e\xb7\x5d\x87\x3d\xa5\xaf\xb7\x2b\x4e\xb5\x55\xe2\xb1\x5f\xb2\x4e\xd4\xa3\x78\x03\x35\x93\x5a\x6b\xad\x70\xda\x49\x4f\x37\x45\xd4\x96\x83\x3b\x75\xab\x85\x45\xa8\x4b\x76\x4d\x93\xe5\xc2\x83\xb9\x4e\xe1\xdc\x8a\xe7\xed\x9c\xef\xb9\xf3\xbe\xf6\x65\x21\xe3\x4b\xb2\xc4\x83\x7c\xb3\x79\x5b\x3d\x2d\x7e\xac\x60\x5a\x79\x2a\xa1\xf4\x80\x53\xfb\x1b\x4c\xf1\x96\xdf\x7a\xf1\x70\x14\xb8\xb8\xa1\x96\x16\xe3\x36\x5b\x5a\xd0\x32\x38\xd5\x6a\xab\x55\x6a\x6a\x41\x4b\xed\x16\x0a\xe5\x5e\xe7\x74\x2d\x92\xf8\xb6\xfa\x29\xd1\x6a\xf0\x5e\xc8\xc4\x6e\xd9\x1d\x89\x40\x07\xfb\xaf\xf7\x5b\xeb\x7a\x21\x37\x0d\x18\x0a\xaf\x43\xce\xc4\x81\x7a\xf7\xb9\xa5\x87\xe1\xaf\x58\x65\x48\x12\xe9\x26\xbd\x97\x7f\x6f\x56\x0e\x65\xfa\x87\x84\x22\x26\x46\xf7\xa1\x68\x0e\x32\x9c\x7a\x07\x0b\x0e\x36\x65\x52\xf6\x3d\x72\x94\x0c\xd6\x30\x51\xdd\xec\x11\x89\x94\xec\xf1\x6e\x69\xe1\xe7\x69\xd2\x0c\x14\xc4\x88\x54\xaa\x2e\xab\xa9\x08\x98\xe0\x20\x90\x99\x25\xc1\xab\xe1\xab\x97\xad\x1d\x8b\x73\x7b\x15\x5a\x13\x39\x5e\xa9\xc7\x13\xe1\x4f\x27\xd9\xeb\x3b\xd5\xda\xea\x74\xac\xc4\x69\x85\xef\x24\x41\x5b\xa1\x90\x48\x3b\x7b\xe9\x23\x2c\x55\x15\x55\x2a\x57\xe3\x71\x1c\x90\xcb\x14\x7d\xa7\x96\x18\xe9\x39\x47\xff\x5c\x92\xca\xf3\xf2\x6a\x6b\xfe\xa0\x2e\x3a\x11\xb9\x61\x0f\x15\x89\x33\xea\x59\x36\xb3\x0a\x7b\xeb\x7b\x7b\xc1\x81\xfc\x26\x03\x24\x79\xb6\x5b\x23\x0f\x85\x26\x6c\xe4\xe8\x84\xaa\x53\xd5\x36\x85\xe2\x85\x0f\xe2\xcc\xad\xf1\x0d\x76\x18\xd9\x88\xb3\x6f\xc5\x4d\x78\xc7\xb0\x60\xf3\x68\x16\xc5\x61\x16\x53\x9b\x8c\x91\xdc\x61\x70\x55\x16\xeb\x9b\x32\x55\xad\x61\x1a\x01\x6f\x6a\x18\x64\x35\x04\xb2\x77\x62\xbd\xae\x59\x6d\xd8\x44\x10\xea\xc0\xf5\x3e\xf0\xa4\x88\xb7\xaa\xdf\x59\x1c\x7b\x56\xea\x39\x23\x1f\xc7\x31\xac\xf5\xae\x2d\xfe\xc2\x2d\x86\x30\xf2\xfa\x59\x14\x4d\xa8\x9a\xd8\x76\x75\xa5\x5a\xff\x2f\x65\x99\x29\x52\x26\xfd\x07\x5b\x30\xa2\x1f\xe6\x61\x11\x0f\x62\x10\x9d\xd0\x63\xca\xd1\x20\xd3\x0e\xbb\x40\xd5\x52\x3a\x54\x35\x5d\x1b\x6a\x54\x78\x9f\x9f\xc6\x61\x5e\x44\xe3\x6f\xe3\x74\x7c\x3b\x2a\xd2\xcc\xb1\xad\xd6\xd1\x4f\xa3\x15\x08\x4b\x9d\xd0\xe0\x2b\xcd\xc4\x1f\xe5\xb7\x56\xc5\xa5\xca\x2f\x0e\x8b\x22\xc4\xa1\x43\x32\x21\xf5\x56\x86\x18\xf7\xf7\x73\xd2\x36\x1a\x61\xce\x42\x6c\x27\x29\xb4\x22\x92\x98\x42\x5a\x65\x17\x21\x0e\x5d\x53\x88\x55\x19\xf9\xa1\xa2\xa0\x2f\x00\x81\x42\x6e\xff\x0a\xb7\x8f\x7f\x16\xfb\x2d\xb6\x35\x9a\xe6\x97\x2c\x37\xe7\xf2\xb1\x54\x59\x11\x26\x81\xc5\x62\xce\x8d\x64\xa8\x7f\x16\x69\x09\x9a\x99\x6c\xff\x4b\x57\x18\x14\xaa\x68\x8e\x1d\xef\xaa\x08\xad\x6c\xf9\xa0\x5f\xc7\xce\xbe\xc2\xf3\x35\x95\xf5\xda\x85\x41\x5a\xa4\xec\xfe\x8b\xfc\x54\x25\x56\xb0\x60\x9e\x7e\x0c\x31\x93\x3c\x7f\x1d\xec\x81\x61\xf7\xd5\x5e\x3f\xd8\xfb\x38\xcd\xf1\x3f\x49\x01\xff\x05\x53\x73\xa6\x93\xd5\xf1\xbc\x45\x96\x31\x2b\xd9\x64\x58\x48\x02\x5d\x35\x84\xdb\x27\x1f\xd6\xa2\x64\x4d\x23\xea\xed\x93\x34\xb8\x97\x6d\xe8\x50\xa8\xc1\xb6\x70\xcc\x80\xe2\x12\x8e\xc7\x42\x32\x1b\xf3\xe1\xe1\xbf\x91\x11\x68\xea\x1a\xed\xb7\x9d\x2e\x37\x0f\xe1\x0e\x14\xcc\xde\x2b\x75\xea\x46\x5f\x8a\x79\x3c\xa8\x2b\x1e\x44\x93\x40\xb7\xbc\x89\x43\x75\xda\x3e\x9b\xea\x52\x89\x7e\x2d\x2d\x36\xca\xcd\x97\x58\x60\x81\x9a\xd5\x6a\x50\x0c\x0c\x2d\xda\x7c\x63\x3a\x32\x04\x87\x13\x71\x77\x98\x4f\xc2\x57\x7d\xf6\x55\xcc\x55\x59\x67\x51\xdb\x7f\x98\x63\x0f\x00\x50\x10\xb4\xd6\xc3\x9f\xe6\xae\x96\x52\xc1\xc2\xd0\xa2\x5e\x18\x75\xd4\x7e\x09\x9a\x08\x7c\x86\x6f\x1f\x87\xbc\x46\x2e\xa0\x9a\xdf\x09\xd5\x1c\x91\x38\xdd\x42\xb6\x7b\xeb\xb1\xfa\x67\xb8\x8e\x2f\x74\x2b\x46\xe2\x5a\x43\xab\x34\xa7\x4b\x6a\xf7\xd0\x0c\xda\x23\x83\x25\x45\x59\x85\xd2\x05\x5d\x58\x5c\x94\x7f\x50\x2b\xa8\x90\x03\xf4\x6b\x79\x5b\xa4\xfe\x47\x2f\x19\x36\x47\xfe\x95\x09\xbd\x81\x68\xf7\xc8\xa6\xda\x7b\x12\x29\xe7\x66\xad\x55\xc4\x78\x76\xe2\x7e\x36\x3f\x24\xd1\xbf\x4a\x11\x9c\x9d\x98\x1e\x5a\x58\xd0\x9d\xa3\x87\x3d\x98\xd8\x9a\x05\x0b\x9b\x11\xa9\x2c\xc1\xc1\xd1\x2c\xfc\x37\x5c\x89\xd3\x6f\x47\x6a\x69\xbd\x27\x46\x24\xcf\x47\xcd\x2e\xa3\x1e\x18\x8c\xb7\x53\x18\x0d\xea\xe4\xbf\x41\x2b\x40\x55\xce\x49\x87\x3c\xd2\x4f\x2d\xeb\x8d\xf8\x79\x70\x82\xfe\x67\xfc\x6b\xb3\xbe\x47\xce\x4a\x2b\xbb\x98\xee\xe6\x55\x84\xb9\xad\xf4\x27\x4b\xef\x6b\xb3\xf3\x24\xc9\xc7\xeb\x77\xe9\xc4\x43\x4f\xfb\x1b\x2e\xf3\x58\x42\xa0\x04\xed\xd7\x01\xfa\xb7\xfa\xc4\x26\x02\xe4\x13\x7d\x36\x57\x09\x7e\x42\xaf\xcc\xb0\x75\x41\x8d\x37\x88\xdb\x9c\x68\x25\xe4\x61\x37\x8f\xa0\x50\x02\xdd\x47\x25\xb2\xe1\x12\x5c\x31\xfb\xc4\xd1\x3d\xda\xc5\xce\x7e\xf8\x70\xe6\xb5\x31\x78\x6e\xf3\xa6\x76\xb5\x60\x4f\x73\x60\xd9\x1a\xa8\x34\x1e\xd3\x88\x84\xd9\x23\xae\x0e\xa7\x41\x7f\xe7\x1d\xad\x4a\x9e\x3e\xfd\x38\x6c\xd6\xdc\x99\xfc\x5b\x6a\xf7\x0f\x6a\xee\x6d\x9f\x0c\x76\x04\x71\xbf\xfe\xa7\x1f\xe7\xb2\x1d\xbf\xaa\x66\x1c\xdd\x84\xd4\x7b\xc8\x0c\x70\x65\xdf\x10\xa4\xc0\x1c\xe5\x9e\x26\x3d\xf4\x21\x10\xd7\x0b\x4e\x84\xcc\xff\xc5\xb6\x92\x72\x52\x8c\x13\xd4\xf5\x40\xdf\x51\xf5\x2c\x80\x24\x9e\x1f\xc8\x62\x5a\x9e\x41\x55\xdd\x98\x03\xe9\x5a\x4e\xf4\xe3\x41\x78\x17\x82\x0a\xab\x8a\x7c\x40\xab\xea\xf1\x6c\x3f\xbb\x4a\x39\x27\x14\xb6\xce\x04\xbd\x15\xc7\x15\x17\x77\x70\x80\xb0\x0e\xc9\x85\x0e\x1a\x85\xd6\x07\x59\x1b\xa5\xc4\x06\xca\x1a\x91\xca\x27\xde\x39\xa3\x50\xaa\x2c\x97\x65\x7d\x72\xd8\xb2\xa2\xe6\xa2\x60\x68\xd1\xc1\xfc\x2a\xf0\xd4\x56\x55\x11\xe4\x7b\xee\xaa\x08\x3e\xb5\x56\x15\xa1\x3f\x8c\x64\xab\xd3\xe7\xaa\x8d\x74\x74\x6a\x89\x16\xea\x78\xe4\xa5\xba\x58\x39\x28\xa6\xd9\x57\x6d\xa8\x29\x51\x04\x0b\x17\x23\xc5\x3a\x55\x73\x5c\xa9\x11\x21\x2d\xb8\x8c\x26\x61\x72\x29\x62\x7e\x5e\x1b\x26\xc9\x13\xb0\xee\xea\xce\xcc\x91\xea\xbc\x38\x5f\xd6\x5b\x6d\x8b\x63\x80\xc5\x76\x33\xcd\x9d\xd8\xc5\x31\x3c\xf2\x66\x54\x77\xfc\xe3\x67\x01\x7c\x68\xdf\xff\xc6\xed\xc8\xe8\x39\x12\x18\x6d\x2b\x97\xe1\x82\x7d\xec\xbe\x3a\x15\x98\x38\xde\x22\x87\x98\xa5\x49\x84\xb3\x48\xdd\xb5\x95\xaa\x37\xe2\x3b\x05\x43\x26\x9c\x8e\xd3\x18\x9b\xe0\x35\x17\xed\x2b\x6f\xc5\x54\x22\x49\x2f\x64\x9d\x51\x2d\x94\xc7\x6c\x78\xfb\x35\x99\xd5\xca\x80\x3e\x94\x87\x74\xf8\xe1\xf4\xe8\xe4\xdd\xe9\x70\x36\xf9\xe2\x26\xbd\x1f\x14\xe9\xa0\xcc\xc5\x20\x62\x35\x68\x74\x18\xf0\xe5\xd8\xb3\xcd\x65\xb0\xd7\x9c\xd9\x68\xb9\x7e\x00\xef\x75\x4f\x38\xcc\x24\xa3\x41\xeb\xe4\xa0\xd5\xc1\xfa\x34\x2d\x98\x3d\x73\x43\x55\xfd\x18\x2a\x3f\x31\x8e\x52\xa3\x33\x29\x32\x21\xfa\xb6\x0b\xe9\xf0\xf9\x68\x52\xd6\xf6\x1b\x84\x95\x9b\x23\x8e\x23\xac\xb8\x8e\xa3\x9a\x40\x7b\x5c\xc2\xf6\x91\x8d\x1c\x05\xe9\xa1\x73\x18\x19\x28\x3a\x71\x93\xd2\x01\x18\xe3\x4e\xc8\x66\x12\x8b\x80\x26\xc8\xa0\xd7\x19\x73\x23\xeb\x94\x27\x8a\x31\xa1\xe5\x90\xca\x0d\xe5\x82\x59\x70\x9f\x00\xed\x5c\xf9\x4c\x9b\xf0\xea\xef\xbd\x8c\x74\x00\x22\x07\xeb\xea\xd1\x3d\x4c\xa4\xe3\x21\x85\x25\xc6\x43\x0b\xb4\x0a\xd1\xe9\xaf\x14\x9c\x75\xa7\xc0\x02\xa9\x66\x04\x3f\x2a\xda\x5d\x1b\xbf\x76\xed\x4f\x3f\xa5\xf6\xa7\x4e\x7d\x8b\x79\xc5\x32\x0f\xca\xd2\x4c\xb3\xaf\x2c\x9c\xa4\xb9\xcc\x98\x4e\xd8\x53\x2c\x2c\xa6\x85\x95\x04\xc9\xb3\x65\x40\x2e\xca\xb8\xd6\xce\x5a\x53\xaf\x81\x66\x84\x5b\x8e\xd9\xfe\x31\x3d\x53\xd7\xaf\x25\x1c\x1d\xb9\xd4\x19\x22\xcd\x59\x50\xda\xee\x06\x45\x09\x58\x9e\xca\x25\x91\x99\x24\x3a\xc4\xcd\x62\x61\xb3\x45\xfe\xaf\x78\x20\x57\x31\x98\x4f\xaa\x93\x7b\x8e\x69\x1f\x2b\x8e\x5e\xde\xf8\x83\x1d\x3b\x7a\xd9\x89\x1a\xed\xb9\x7b\x5d\xf9\x75\xeb\xa7\xdf\xa9\xc9\x5b\xa9\xc9\x2d\xe0\xdd\x4d\x33\xde\x52\x47\xa3\x2a\x1e\xd9\xaa\x4d\xab\x57\x0e\xf3\xbb\x95\x97\x09\x55\xe3\x79\x98\x81\x1c\x2a\xb0\xf1\x52\xa9\x72\xd7\xe0\xaf\x09\xce\xd5\x81\x7f\xbe\x9f\x8b\x64\x04\x1c\xf0\x76\xd8\xe9\x4c\x9d\xce\xe4\xa0\x33\xf9\xe7\x5a\xe8\x94\xd6\x89\xa1\xc7\x88\xca\xee\x54\x5a\xd2\x5d\x73\x45\x90\xc5\x07\xe4\x85\x7e\x2c\x0e\xf0\xec\xf3\x27\xcc\x10\x4b\x37\x2f\xa4\x99\xd3\x57\x57\x94\xcc\xc7\xca\xb1\x88\xe9\xf4\xcd\x8e\xa9\x74\x5e\xc6\xd8\x7d\x82\x5a\x63\xb1\x4e\xd3\x85\xad\x28\x55\xd9\x2f\x59\xa2\x62\xaf\x98\x29\x11\x5c\x45\xa6\x2b\x0b\xaa\x74\xc0\x64\xc3\x82\x19\x82\x24\xfd\x06\x75\x19\xb5\x9e\x61\x7d\x22\x47\x29\xb0\x52\xe8\x5e\x88\x24\x78\x89\x9a\x23\x0b\xe6\xcb\x3f\xfe\xf1\x8f\xf5\xb2\x8e\x97\x7f\xf8\xea\x2b\xf8\x84\xfa\xa9\xa6\x59\x44\xa3\x67\x75\x9d\x04\x0b\x24\x39\x53\xd0\x2f\x4f\xf3\xd5\xa7\x53\x19\x2b\x26\x15\x0c\xb3\x67\xa5\xcc\x55\x35\x4e\xb3\xe8\xfa\x86\x99\x9d\x48\x09\xe9\x48\x1e\xa0\x66\x15\x72\xca\xae\x64\x73\xba\xa2\x5d\xb6\x0b\xa0\xf7\xb1\x7d\x0a\x88\x52\x3a\x17\x1c\xef\x75\x0b\xbf\xcb\x9e\x65\x7d\x9d\x7e\x8d\x39\x58\x68\x24\x71\xb3\x08\xaf\x54\x7d\x91\x75\xd6\xd8\x64\xec\xe9\x53\x08\xfd\x9a\x57\x2e\x37\x10\xa0\xfe\x2d\xb7\x62\x31\x90\xe4\x36\x0f\xa3\x8c\xcb\x34\x11\x99\x94\x15\x55\x1b\x1b\x69\x1c\x41\x93\x8a\x21\xf0\x72\x60\x55\x13\x02\xb8\xc6\xff\x94\x04\x06\x98\x48\xed\x94\xda\x30\x57\x26\x41\x70\x0f\x7a\xbf\x60\xeb\x0e\xda\xa8\x46\x3f\x1f\x52\x81\x2a\x7a\xd7\x1f\xcb\x16\xdf\x54\x02\x6c\xf0\xc2\x26\xb7\x18\xd3\xfb\x26\x08\x3a\x7f\x68\x07\x2e\x65\xcf\xaa\x19\xa2\xa4\xd8\x32\x59\x79\x83\x9a\x67\xac\x58\x2c\x4f\x7b\xa6\xf9\xdb\xb8\xff\x28\xb7\x0a\x91\x65\x2d\x99\x1a\x85\xac\x3b\x99\x32\xc7\x24\xd6\x26\xb3\xf6\xed\x63\x82\xeb\x51\xce\xe5\x52\x29\x0d\x1c\xf7\xc0\x19\x66\x2d\x89\x1a\x97\x33\x0b\x33\x6c\x01\xa9\x47\xcf\x0c\xa9\x20\x2e\x37\xf3\x5f\x32\x81\xad\x99\xef\x98\x46\x24\xb5\x94\x5c\xd4\x8a\x61\x71\xb1\xfb\xc3\xe1\xbe\x64\x6c\x69\xa6\x7a\xb2\x21\x07\x62\xc1\xc4\x67\x87\xed\xc6\x78\xea\x65\x8f\xe1\x1c\x49\x5d\xcd\x35\x57\x63\x96\x43\xbb\xc4\x8d\x3b\xa6\x4e\x9d\x09\x8f\x48\x5c\xf5\x72\xa7\x31\x56\x9b\xe7\x47\xab\xeb\x32\xe4\x0f\xa3\x73\x9f\x89\x37\x63\x0a\x7a\x67\x71\xcf\x86\x19\x48\x61\x89\xec\xab\x6f\x28\xd9\x5f\xca\xdb\xb2\x1e\x2e\x37\x55\x29\xd7\xdb\xc2\xa8\xdb\x68\x69\x3a\x0e\x80\xeb\x1d\x61\x2a\xc1\xbe\x24\xb4\x9d\x20\x3e\x24\xde\x8d\xe8\x76\x80\xc8\x13\xf2\x5c\x67\x93\x8a\x09\x7b\x8a\x7a\x3f\x81\xef\x23\xf6\xdd\xe2\xb6\x1b\x6e\x9e\xe6\xa0\x8a\x01\x4e\x7d\xd0\x8f\x3e\x3d\x52\xea\xf5\x45\x96\xed\x38\x94\x74\x02\xc4\x87\x57\x39\x90\x60\xe1\x02\x13\x97\x53\x81\xb1\xd9\x35\x2d\x54\xc8\x09\x1d\x6c\xfe\x6b\x02\x71\x0a\x60\xc5\xe6\xa5\x50\x96\xfc\xd5\x0d\x9a\x33\xf3\x71\x9b\x27\xc6\x9f\x28\x26\xbf\x3b\xe7\x0e\x77\x76\x32\xcb\xdd\x92\x08\x12\x9f\x44\x9f\x46\xdf\xcc\x53\xf8\x5c\xf8\xfe\x16\xe9\x47\x61\x01\xe5\xfa\x5a\x9c\x28\xcb\x65\x2c\x5f\xe3\x48\xbe\xca\x72\x77\x98\xca\x47\x0a\xa9\xfb\x48\x3e\x17\x27\x28\xdf\x61\x91\x47\x6e\xae\x8a\xd1\x59\x70\x50\x8d\x2b\xd6\xa9\x71\x67\xc0\x83\xb3\x69\x38\x16\x3d\xcb\x85\xd1\xec\xa8\x55\x4f\xeb\x6a\xe1\x1b\x10\x42\xb1\xae\x99\x0e\xc4\x47\x1c\x24\x15\xc6\xf4\xce\x49\x06\x4c\xb7\x99\xc7\x1f\x1c\xc5\xf3\x9b\x50\x77\x2f\xef\xb5\x99\x97\x29\x57\xe0\x9e\x95\x49\x8f\x2d\x75\xc5\x92\xb4\xc3\xdf\x96\x54\x82\x00\x49\x12\x43\xb9\xed\xdb\x19\x22\x11\x92\xcc\x26\xf6\xbc\x48\xcb\x4c\x06\x31\x79\x7e\x14\xd3\xe4\x3e\x43\x27\x87\x5c\x60\x88\x59\x09\xd7\x68\x3d\x65\xb2\xe9\x12\x7d\x23\x2e\xf1\x83\xe1\xb3\xaf\x88\xb0\xea\x10\x58\x28\x58\x0e\x5e\x4d\x95\x51\x97\xde\x45\x13\xad\x12\x52\x82\x84\x54\x39\x79\xd6\x18\x4e\xef\xc9\xad\x52\x75\xf8\x25\x1d\x47\xd4\x71\xa7\x3a\x77\x65\x46\x32\x5a\xca\x6a\x04\xa8\x86\x3f\xb5\x32\x41\x3b\xd4\x97\x52\x83\xc4\x45\xeb\x87\x94\x80\x5e\x77\x51\x5e\x81\x45\x7d\x33\xf2\x8e\xae\x9c\xaf\x01\x22\xf3\x0f\x8d\xeb\x83\x5b\xcf\x6d\x8d\xf0\x95\x4c\xce\x8e\xb8\x00\xf3\xc9\x23\x52\xd2\x50\x1c\xa2\x62\xc9\x4d\x6c\x44\x9b\x0a\x4e\x4a\xbf\xc1\x3a\x29\x8a\xda\xa0\x3b\xbb\x10\xfa\x4f\x2c\x88\xd6\x9e\x55\x93\x09\x54\xb4\xf1\xd3\x1f\x92\x79\xed\xf3\x71\x18\xc7\x39\x4f\x9b\xaa\xb7\x44\xd2\x02\x4d\xea\xf0\xba\x01\x85\xa4\xd8\x08\x89\x99\x17\xdc\x55\xb3\x9e\x97\x86\xd0\x6d\x44\x34\x4f\xd4\xd1\x6c\x2b\xca\x52\x4c\x13\x0d\xa8\x4f\xbd\x39\x15\xd0\xaa\x75\x0b\xba\x49\x99\x3e\x1e\xbc\x58\xc3\x2e\x5c\xd6\x85\xcb\xf8\x7a\xb9\x77\x30\x7e\xa4\x7c\x03\xf5\xb9\x22\xdc\xe1\x7c\xab\x82\x56\x0b\xed\xed\x23\xfb\x3e\xb5\xd9\x47\x05\x60\xf7\x0a\x4c\x4b\xd6\x8d\xf0\x99\x41\xea\x75\x94\x6b\x9a\xf1\x54\x2b\x25\x35\x11\xfe\x23\x39\xf1\x40\xf7\x04\x76\x74\xcc\x29\x9f\x09\x36\xbf\xab\x09\xe6\x8a\xc7\xf3\x78\xaf\x56\xb6\x48\xcf\x92\x0f\xee\xc3\xda\xd2\x71\x69\x46\x60\xd2\x59\x9b\x84\x1b\x5e\x1b\x07\xaa\x1e\x1c\xb6\x4b\xf5\x4e\x75\x5e\x1c\x45\x94\xfd\xee\x49\x7a\x9f\xdc\x87\xd9\xe4\xe8\xe2\xcc\xc9\xba\x38\xa9\x9e\xb3\x43\xa1\x1a\x5c\x80\x9f\x87\x57\x69\x59\xb0\x5a\x92\xe9\x7e\x4d\xba\x11\x59\x17\x19\xed\x22\xa3\x5d\x64\x74\x07\x91\x51\x7c\x4a\x2a\xf2\x18\x72\x43\x3d\xa8\x76\x63\x1d\x32\x4a\x98\x9e\x54\xdf\xf0\x90\xc5\x5f\xa4\x94\x59\xaa\x14\x65\x2a\x2a\x96\x59\x41\x86\x01\xdd\xce\x8a\x52\x2c\x53\x84\x7b\x1b\x02\x62\x56\xa4\x6e\xed\x28\xe0\x44\xb0\x99\x56\xdb\x3a\x06\x55\x95\x8e\x8d\x04\xd6\x8a\xc9\x88\xb2\x0c\x5f\x3b\x85\x72\x88\x2f\x83\x14\x41\x35\x2a\x4c\xe0\xf6\x13\x1e\xf3\xbe\x1c\x74\x00\xff\x4d\xc2\x99\x8b\xe7\x1b\xef\x20\x29\xbb\xf3\x10\x9b\xf9\x64\x56\xae\xab\x43\x00\xc2\x1d\xa1\xf4\x6e\xa3\x17\xbb\x3c\xb5\xac\xef\x48\x08\xda\x7c\xc8\xc7\x37\x62\x16\x3a\x50\x8e\xfe\x79\x83\xa7\x41\x8d\x32\xb1\x83\x7e\x86\x0d\x85\x64\x6b\x2d\x91\xcd\xb0\x4d\xbd\xa9\x0a\xc9\x1d\x01\x63\x3b\xa3\xbb\x57\x7b\x43\xa7\xc7\x3c\x42\x8d\x86\x4c\x2f\x9c\x62\x34\x2b\x08\xbd\xa8\x85\x66\x90\x44\xb1\xab\x00\x51\xad\xe3\xce\x95\xeb\xab\x8a\xfb\x13\x3b\x93\xe7\xf5\x08\xe8\x70\x0d\x80\x48\x55\xce\xa0\xd0\x31\x32\xe3\x90\xde\xda\x85\x85\xbb\xb0\x70\x17\x16\x5e\x15\x8d\x9a\xfb\x2a\x94\x39\x24\xdb\x58\x4a\xb1\x1d\x23\x20\x7d\x42\x96\x45\x28\x1b\x40\xaa\xf3\x0e\x30\x75\x58\x59\xc5\x94\x31\x50\x55\xcb\xe6\xc1\x20\xae\xcc\xe7\x71\x0a\x0c\xab\x0b\x57\x16\xd3\xc1\xd7\x81\x48\xc6\x70\xc2\x13\x39\x1f\x77\x1a\x65\x79\x41\x2a\x9a\xbb\x6a\x50\x0f\xaf\xcf\x74\x2f\x7e\x2b\xfc\x2c\xd7\xbb\xbf\xcb\x94\x17\xdd\x6b\xee\xcd\x96\x2a\x53\xa5\x28\x99\xbe\xb8\xcb\x33\x3f\x5c\x38\xbb\x1c\x68\xab\x20\x61\x63\x81\x59\x54\xc8\xc1\xbf\x28\x27\x44\x0e\xbf\x1c\xc8\x0f\x87\xe3\x79\xd9\x77\x80\xac\x9e\x9a\x89\x59\x9a\x2d\xfa\x06\x1c\x82\xa9\xc1\x57\xdf\xe8\xb9\x68\x48\xa0\x8f\x8d\xcb\x0c\xfd\xa1\x58\x64\xf2\x68\x9a\x99\xc1\xf0\xb9\x83\x4f\x77\xdd\x21\x56\xb1\x4e\xf2\x0e\x1b\x99\xec\xa8\x49\x4c\x4d\xdb\x50\xd0\x70\xb5\xc7\x5e\xce\x01\x4c\xee\xb0\x93\x79\xbe\xff\x18\xea\xd5\x24\xba\x8b\xf2\x34\x73\x43\x48\x98\x2c\xde\x4f\x5f\x3b\x6e\x78\xe0\xc5\x86\x97\x9f\x76\xde\xe0\x46\xef\x2d\xcd\xfb\x2a\x8b\x79\x59\x28\xa9\xe2\xb8\x22\x75\x73\x75\x07\x6a\x73\x0f\xfb\x76\xef\x2a\x47\x98\x7b\xaf\xf6\x9c\x9e\x00\xa6\x88\x21\xf1\xd7\xc1\xff\x1c\xfc\xfd\xcb\x5f\x07\xbd\x6f\x0e\x0e\x7e\x7e\x39\xf8\xd3\x2f\x5f\x1e\xfc\x7d\x48\xff\xf8\x8f\xde\x37\xbd\x5f\xf5\x2f\x5f\xf6\x7a\xf0\xf7\xef\xdf\x7d\x77\x79\x71\xfa\x4b\xd4\xfb\xf5\xe7\xa4\x9c\xdd\xca\xdf\x7e\x3d\xf8\x59\x9c\xfe\xc2\x04\xd2\xeb\x7d\xf3\x3b\xa7\x65\x7e\x1c\x58\x83\xb6\xe0\xfc\x07\x69\x36\x90\x07\xf9\x9a\x46\x50\x38\x00\xd3\x68\xde\xe6\x06\x57\xe2\xb9\x6a\x4f\xae\xed\x81\xfd\xe7\xaa\xc4\x3b\xf5\x3b\xf5\xd2\xe1\xdd\xb3\xab\x9e\x3e\x63\x8a\x0d\x98\xa2\x9f\x27\x91\x63\xb5\xf2\xa9\x7a\xaa\x5e\x86\x03\x8a\x0c\x08\xad\x30\x5b\x00\xf7\x94\xee\xce\x05\xc3\xfd\xbc\xdc\x09\xc8\x6a\x05\xb4\x75\x13\x5a\xda\x1d\x2c\xa6\xcd\x82\xe5\x99\x98\x44\xe5\xcc\xdd\x71\xfd\x13\x75\x90\x57\x1d\xea\x75\x42\x8f\x04\xc6\xad\x58\x22\x97\x68\x38\xbe\x95\x06\x9d\x41\xb2\xd4\x26\xed\x66\xc8\x7b\x2a\x61\x62\x26\x42\x66\xfc\x59\x99\x95\x94\x7a\x03\x1a\x2a\x9e\x82\x06\x28\xd7\x68\x79\xc7\x13\x7e\xab\x0c\x9d\x00\x79\xa0\x80\xf5\x50\xab\x7e\x47\xca\xd1\xa3\x1d\xae\x33\x43\xca\xa3\x7f\x8b\xb7\xa8\xe9\xb1\x42\x7f\x7c\x99\xef\x23\xe9\x07\x5b\x45\x08\xf7\x2f\xd3\x02\xb4\xa7\x50\x75\xe8\x9a\x06\x38\x6f\x39\x36\xd4\xe7\xa4\xa2\xc9\xb8\x2c\x50\x97\xb9\xfc\x3a\x42\x8b\xd4\x87\x38\x93\xea\x31\xf9\xb7\xe3\x9c\x27\xe2\x69\x4a\xe9\x98\x66\xd3\x20\x7c\xa9\x36\x1b\x8a\xbb\xa4\x9e\xfc\x1f\xa3\x19\x5c\x91\x32\xe7\x35\x99\x45\xf5\xbf\x0e\xa7\x5a\xf0\xbd\x1e\xb3\x43\xbd\xa4\xa2\x04\x01\xf3\xa3\x8e\xc6\x57\x82\x4f\x8f\x34\x8d\x58\x3e\x2f\x2a\x1b\xe7\xba\x51\xc8\x5d\x56\xa2\xe5\xa7\x57\xab\xec\x14\x9c\x8c\x55\x1b\xc9\xca\x2d\x97\x09\xe5\x98\x8f\x25\x5e\x90\x44\xb1\xcd\x0c\xcc\x20\x0b\x5e\x7f\x7b\x7d\xa0\x65\xa2\xd2\x2f\x57\x6e\xed\xfa\x4b\x8b\x6d\x45\x06\xd7\x65\x34\xf1\xbb\xae\xcf\x5a\x7f\xdb\x52\x6b\x63\x8b\xe6\xe9\xd8\x49\x28\xbf\x39\xae\x4b\xe3\x37\xd1\x15\x1c\xd4\xf1\x4d\x98\x24\x22\xe6\xab\x49\x0f\x0f\xa4\xa9\x77\x11\x31\xf3\x66\x1a\xa1\xae\x99\x47\xd3\xf5\x10\x79\x58\x08\x3d\x4d\xb3\xe8\x6a\xdc\x0a\x2f\x68\xc8\x1a\xc9\xb2\x3a\x6e\x85\xcd\x22\x77\x35\x92\x25\x2e\x93\x6d\x72\x0f\xe0\xb2\x15\x61\x76\x2d\x0a\x04\x14\x00\xbf\x01\x86\xf0\x29\x8f\xfc\xf8\xd4\x1a\xa9\xb4\xdf\x0b\x45\x1e\xe7\x4f\x3f\x9d\xe7\xed\xd0\xc5\x7d\x9a\xc5\x93\x7b\x10\x80\x01\x3f\xc1\xf0\x00\x5f\xdf\xfb\x74\x5a\x7b\xde\xc3\xfe\xb6\x45\x97\x0a\x16\x11\xba\x02\xc2\x97\x6a\xb2\x11\x89\x8c\x89\x35\x5a\x46\x2f\x38\x8d\xe4\x78\x55\xfc\x4d\x3a\xef\x67\x57\x51\x22\x93\x0f\xd0\x29\x65\x0e\x98\x5d\xd4\x8b\x77\x5b\x07\xcc\x68\x40\x2b\x0e\x56\xa4\x30\x01\x4e\x70\xce\x23\xec\x5d\x1f\x26\x22\x2d\xf3\x78\x31\xfc\x54\x8e\x8d\xaf\x7f\xc4\xe2\xa3\xbc\x74\x6e\x7a\x88\x79\xac\xae\x8f\x5c\x0b\x50\x66\xa3\x31\x37\xc9\x65\x65\x42\x1e\xd5\x19\x60\x3c\x59\x4c\x0e\x8d\x72\x22\x87\x51\x52\x0d\x0c\x63\x8a\xfc\x55\x88\x3a\xc8\x3c\x2e\xaf\xa3\xe4\x59\x57\xbc\x98\x5c\x7a\x5e\xf5\x57\x2e\x2a\xeb\x8c\x5f\x8d\xdd\x8d\xe3\x68\xd4\xb0\x2c\x5b\xca\x75\x99\x13\x31\x17\x09\x32\xa2\xc4\xbe\x11\x12\x35\xc3\xdd\x94\xea\x3d\xe3\x54\x65\x8b\xe7\x9f\x7e\x2c\x32\x6c\xd9\x33\x9b\x21\x8b\xd5\x11\xf9\x68\xca\xe4\xca\x0b\x07\xd9\xbf\xeb\x94\xf7\x4e\x6d\x7a\x2e\x6d\x7e\x77\x5f\xe2\x24\xa5\x86\xba\xc0\xb9\xce\x4c\xb1\x4a\x88\x78\x07\x82\x09\x33\x4b\xab\xb5\xfb\xa5\x2c\x95\x12\xf1\xfc\x5d\xf5\x72\xa3\xc6\x52\x22\xa6\xfe\xb3\x52\x00\xb0\x84\x81\xae\x0d\x5f\x57\x57\xf4\x09\x54\x58\x4c\x71\xfa\xa0\x63\x37\xde\x37\xf2\x99\x25\x7f\x9a\xfa\xd0\xb5\x1d\xef\x03\xfe\x33\xc5\x45\x2c\x55\x01\xc9\x57\xbd\xa8\x79\x1a\x1f\x5c\xf8\x2c\xc5\x5b\x4d\x63\x7a\x80\x11\x21\xc3\xcb\xca\x24\x69\x3e\x3e\x27\xed\x36\x2c\x40\x6f\xf6\x1b\x2e\xb3\x3c\x13\x0f\xc7\x87\x52\x95\x12\x8d\x74\x98\x89\x22\xc4\x4f\x79\x91\x90\xbf\x2a\x15\x39\xa9\x41\x43\xc5\x57\x1f\x8d\x2a\x5f\xb9\xa2\xbc\xa1\x1c\xec\x48\x6e\x6c\x23\xa4\x43\xc8\xc4\x98\x59\x3c\xe2\x36\x7a\x4f\xae\xf4\x87\x1f\x3c\x7a\x50\xe2\x43\x4b\xf8\x53\x44\x43\x4e\x79\x9c\x05\xca\x93\x3b\xc6\xa6\xa6\xf8\x82\xc1\x98\x82\xd9\xee\x96\xd9\x77\xf3\x7a\x2c\x2e\xcc\xf8\x52\xe7\x61\x9a\xfb\xdf\x1d\x9f\xd6\x1f\xaf\x5f\x58\xf8\x33\x6f\x9a\xa6\xdb\x30\x76\x7d\x79\x59\x7d\xb8\x77\x35\x8c\x1d\x30\x57\x0d\x7e\xc5\x79\x62\xdd\x24\x76\x0e\x01\x77\x93\xd8\xd7\xd1\x4e\x37\x86\xbd\x39\x8e\xde\x8d\x61\xff\x24\xc7\xb0\x3f\x3a\x9f\xdd\x7d\x90\x67\x3e\xf1\xd2\xc4\xf4\xe4\x70\xdb\xcd\x78\x71\x52\xc9\x3e\x66\x92\x01\x08\xd5\xa1\x1c\xc9\xb5\xdc\x0e\x5b\x0f\x06\xa6\x6f\x78\xce\x20\xd8\xf9\xd1\x3c\xd2\x5c\x02\x96\x27\x87\xd9\x6d\x64\xfd\x04\xc9\x55\x87\x93\x44\x3a\x37\x12\xfc\x98\xf7\x61\x57\x23\x57\xe5\x4d\x68\x4d\x19\x8d\x8a\x0f\x62\x9e\x3a\xaa\xa0\xf2\xa1\xa5\x48\x47\x84\xed\x5a\x40\xe7\xc3\xa9\x32\x8b\x80\xa1\xf6\x84\x92\x21\x8e\x4b\xe0\xa6\xf0\xa8\x8c\x73\xc0\x19\x9f\x5e\x7c\x38\x3d\x3e\xba\x3c\x3d\x79\x1d\xe8\x37\x45\xb6\xa9\xd2\xac\xa5\x5c\xa6\x55\xe0\x44\x76\x4a\x57\x49\xfa\x54\x23\x52\xad\xb5\xaf\xa6\x38\x32\x38\xae\x49\xa7\xa2\xd6\xc3\x61\x12\x9c\x25\x51\x51\x65\xff\xcb\xe1\xa1\x71\x9a\xa8\x5c\x72\x84\xce\x18\x6c\x84\x44\x0e\x8b\xe9\x4b\x95\x59\xcd\x8d\x85\xc7\xeb\x6f\x23\xae\x45\x49\xa2\x2c\xf3\x98\x56\x34\x6c\x53\x37\x36\x49\x97\xee\x93\xd4\x65\x5c\xd8\x00\x50\x92\xfa\x9d\x2e\xdd\x51\xeb\xe5\xb9\xfc\xb2\x5a\xa5\x0f\x76\x97\xa4\x06\xcc\xfb\xc3\x7d\xad\xe2\xc6\x4b\xe5\x78\xcc\x21\xd8\x7a\x71\xba\x0c\x0f\x01\xd4\xe9\x19\xde\xf4\x1e\x5d\x54\xf7\x51\xce\x1c\x1a\x15\x4d\x37\x54\x08\xd2\x4b\xec\x1a\x2b\x78\x13\x33\x25\xdb\xdc\x2e\x5d\x79\x5a\x5e\xd9\x8b\x57\xed\x37\xaf\xa3\x3b\x91\xb0\x3d\x8f\xc3\xf6\x05\x88\x5e\xa6\x33\xb5\x7c\xa8\x76\xf8\xc3\x87\xb7\xed\x2f\x4c\x72\x04\xe7\x65\x1d\xa7\x33\xcc\x08\xbc\x09\xf3\x1b\xd3\xa8\xa7\x4a\x80\x34\x9c\xeb\xe9\xe6\x54\x54\x28\x6f\x4d\x2e\xc8\x76\x7e\x8e\xf3\x72\xf7\xbf\xd3\x8f\x2d\x39\x25\xcc\xc7\x3c\xe5\xde\x9e\xaa\xcd\xce\x95\x6f\x1e\xc2\xdb\x30\xaa\xcd\xec\x79\x37\x33\xbf\x44\x32\x91\x53\x83\xdc\x35\xab\x53\xfd\xe8\xb9\xd5\x75\x5f\xc3\xe3\x77\x44\x20\x54\x4e\x04\x30\x9e\x38\xb7\xce\xa4\x48\xe7\x69\x9c\x5e\xbb\x4d\x78\x5c\x83\xac\x2f\x64\x0d\xea\x20\x1c\xe0\x29\xb5\x6f\xc7\xfa\x4c\xd4\xd5\x25\xb7\x88\xb0\x6a\xc7\xc6\x1e\xe3\x56\xb5\x3e\x67\xc4\xec\x48\x61\x37\xd8\x72\xb1\x70\xa5\x07\x47\xcf\xe1\x23\x89\x54\x75\x45\x9b\x63\x77\xca\x1c\x59\x25\xb3\x91\xe1\x06\x6d\xff\xd1\x0f\xa2\x7d\x25\xde\xf0\x02\x8e\xc2\xdf\x5c\x57\xc5\x66\xeb\xc8\x51\x39\xed\x33\xea\x74\xf2\x37\xf5\x54\x9d\xa9\xc3\x3f\x07\xe2\x23\x18\x45\x34\xe1\x97\xd3\x4b\x00\x44\x67\xa5\xae\xd8\x3c\x5e\x67\x56\x6b\x57\xb4\xfc\x16\xc3\x58\x5c\x72\x32\x57\xda\xaf\x09\x17\x50\x02\x56\x18\x03\xf9\xd1\x38\x2b\xa0\xef\xe6\xe8\xbe\xf4\xe6\x82\x05\x88\x1b\x85\x45\xcb\xe0\xe4\x3c\x8b\xee\x60\x97\xd7\xf8\x3a\x58\xed\x75\xd5\x33\xae\xd9\xd2\x89\xe3\xf4\x5e\xa8\x46\x23\x62\x65\xdf\x48\xd6\x79\x61\x17\x3f\xb0\x7a\xbe\x9e\xbf\xbf\x0c\x12\x21\x97\x93\x6f\xed\xf4\xc1\x05\xb1\x4a\xf8\x06\x83\x01\xf9\x45\x0f\xfe\x89\xd6\xce\x24\xee\x05\x3f\x09\xb5\x0e\x34\x09\x91\x6b\x61\x6f\xad\x9b\x94\xda\x41\x94\x8c\x79\x32\x84\x8b\x8a\x32\x88\x7d\xc8\xd2\x75\x05\xe5\x10\x21\xa1\xb9\x20\x2d\x24\xfc\x3e\xdb\x66\xa1\xde\x8c\x79\x50\xe5\xa5\xb4\x6a\x19\x6d\x21\x95\x74\x80\x6b\xdd\x8d\x60\x0f\x9f\x31\x2d\xfc\xa9\xbf\x56\xbe\x98\xc5\x51\x72\xdb\xc7\x71\x2f\x8a\x9d\x23\xe5\xa9\x81\x3a\xc9\xad\x4b\xff\x5c\x40\x58\xac\xe7\x06\xb4\x43\x59\xad\x4b\xbe\xc2\x2b\x60\x83\x61\x1e\x52\xe3\x0d\x5b\x53\x69\x6a\x27\x2e\xfd\x8f\xb0\xeb\xd1\xde\x33\xc5\x8c\x93\x3b\xa9\x45\xe9\x12\xe5\xae\xfd\xe2\xf7\xcf\x46\xd8\x61\xd3\x96\x2b\x49\x20\x3f\x53\xa1\xcd\x56\x0a\x78\xd6\x54\xf0\x70\x5a\x44\xb2\x83\x98\x9b\x54\x10\x42\xc8\x6e\xac\x89\xf1\x4d\x38\x3f\x2a\x8b\x1b\x40\xd3\x18\x33\x73\xdc\xd5\x3f\x3d\xa8\x40\x85\x06\x83\x48\xa3\x5d\xc2\x0b\x8e\xff\x76\x74\xc1\xcb\x23\xa8\x4d\x9b\x6f\x3d\xd9\x4e\xef\x74\x24\x72\x2f\xeb\x7d\xfd\x3e\x15\xb4\x67\xb3\xcb\x2e\xfc\xfc\x59\x87\x9f\x89\x13\xfc\x56\x42\xce\x51\x12\x15\x51\x08\x18\xf0\x8a\xd9\x1d\x83\xd9\x06\xab\x95\xd7\xf4\x4c\x83\xa2\xa4\x2a\x0a\x1d\xd7\xa0\x73\xc7\x2d\x58\xb3\xf9\x22\xd2\x96\xe0\x38\xcc\x54\x92\xa5\xa2\x16\x9e\x7f\x37\x11\xf7\x6a\x8d\x91\x81\xf3\x17\x55\x06\x85\xb4\x1e\xc6\x7f\x7d\xfd\x17\x2b\x3c\xfd\x57\xa7\x61\x8d\xaa\x9d\x97\x35\x80\x83\x66\x42\xb7\xef\x66\x04\xec\xfc\x2b\xf1\x75\xeb\xcb\xfd\xff\x57\x19\xc6\x12\xb7\xe7\xbb\xf0\x27\xd7\xcf\xca\x79\xa9\x9a\x8e\xf4\x19\x91\x0b\x8d\xf8\x19\x98\x26\xa4\x6f\xd0\x37\x78\x1c\x22\x0b\x81\x27\xc0\xe1\xd6\x23\x91\xfb\x2a\x81\x61\x3f\x38\x28\xc6\xf3\xde\xf0\x39\x94\x6d\xca\x6d\xab\x73\x7a\x6b\xca\x35\x87\x4f\x1f\xc9\xa7\xbb\x91\x6f\xb7\xa1\x0b\x02\x12\xbc\x8d\xf2\x42\xd6\xab\x48\xa8\x5c\x6e\x20\x64\xd1\x1c\xea\x9a\x17\x34\x9a\x69\xfe\x8f\x70\x32\xc9\x5e\x4b\xfd\x40\x59\x55\xf4\x6f\x9e\x31\x90\xaa\x89\x4f\x00\x50\xe7\xb2\x1c\x00\x56\xa2\x31\x39\x1e\x2e\x8f\x2f\x08\x5a\x1e\x7c\xfd\x07\x7e\xc7\xc9\xff\xfc\xfd\x1f\x5e\xf6\x86\x9f\x4c\x55\xa4\xb7\x17\xf2\x49\xb2\x06\x9e\x51\x45\x08\x2a\xa0\xaa\x16\x84\xb8\xbd\xa4\x73\x25\x48\xd0\x07\x62\xe4\xdd\x6e\xd4\xd4\xae\x5a\x81\xfb\xda\xcf\x7e\x0a\x8a\x24\x3a\xc9\x5b\xdb\xe0\xcf\x92\x35\x2b\x5e\xcd\x64\xa5\x0e\xac\x59\x1e\x03\x37\xf2\xc5\x63\xcd\x7c\xb6\xbb\x23\x7f\x0c\x68\x63\x8c\x6f\x81\x46\xc0\xf8\x96\x7d\x9e\x6d\xf9\x78\x38\xd7\xbf\x7e\xed\x25\x2f\x46\x1f\x8c\x95\xfb\x41\xcd\x98\x4f\xce\x47\xff\x78\x7b\xf4\xed\xe9\x5b\x96\x50\x94\x89\xff\xd6\x20\x09\xaf\x9c\x72\x3e\x5f\xd8\x7f\xd1\x12\x01\x24\xae\x51\xf4\xf3\x37\xa3\x25\x97\x18\x7e\xa2\xda\x59\x55\x4e\xe2\xed\xda\xcc\x6d\x6b\xd1\xc2\xae\x9e\x89\x23\x5d\xfb\xfe\xd0\x59\x67\xfb\x18\x10\x69\x58\x26\xc4\x9d\xdd\xb3\x7b\x84\x3c\x97\xd8\x2e\x62\x46\x62\xcb\xc1\x31\xc2\x88\xea\x3a\xbb\xd0\x37\x47\x75\x77\x86\x79\xbe\x46\x97\xf9\xb4\x37\xd8\x1f\xd1\x73\x3a\xe7\x00\xef\xa9\x4c\x3e\xce\x50\xa0\xa1\x28\x13\x39\xd3\xa8\x98\x2e\x53\xf1\xf3\xa3\xd0\x96\x23\x0d\xf8\x35\xb9\xd7\xb6\xc4\x55\x95\xb7\x2b\xc5\xd0\x71\x1c\x46\x33\x37\x6e\x7c\xb1\x0e\x84\xfc\xe7\x48\x66\x91\x3b\x4c\x88\x09\x6b\x95\xde\xf0\xeb\x5a\xe8\x26\xbf\x8f\xe3\xe1\x32\xd3\x4d\xbc\xe9\xa3\x42\xd2\x40\x93\x4a\xf5\x91\xfc\x64\x8c\xcb\x6a\x95\xe1\x13\x44\xbf\xa4\xfe\x63\xfd\xe8\x72\x17\x91\x0d\xe8\xe4\x76\x0d\xd0\x28\xb7\x07\xc6\xe4\x66\x56\x58\xa9\xfc\xa6\x4e\xed\x46\x82\x27\x3f\x93\xc7\x93\x39\x3f\x3d\xdc\xbb\x81\x5b\x6c\xb1\xd6\x76\xdf\x8d\xdd\xee\xc2\xbe\x0c\xbd\xb6\xc6\x9a\x6e\xd2\x22\x4d\xb6\x28\x05\xbd\x58\x03\xa0\x9e\xa2\x23\xbf\x71\x2c\x4b\xa6\x63\x8e\x73\xce\x00\x93\x95\x2d\x26\x9c\x8a\xa6\x91\x56\x00\x40\x05\x55\x81\xd5\x9c\xa7\x87\xf2\x6a\x47\x1f\xa1\x58\xf3\x13\x6f\xcc\xb3\x8b\xd6\x87\xad\xa7\x69\x4e\x3c\x0a\xbe\xcf\x4e\x94\x12\xaf\x0b\xb6\x73\x45\xba\x81\x03\xed\xae\xa1\xdf\x27\x54\x76\x00\x0d\xad\x31\x0a\x20\x2d\xb0\x56\x7d\xda\x92\x5d\xd4\x1e\x5d\xca\xdf\x53\x7f\xe3\x96\x4a\xb0\x78\xc1\x33\xba\xeb\x23\x4a\xf4\xb1\xb6\xbc\xd4\x9e\x13\xf1\xcf\xb5\x53\xa4\xed\x5d\x79\x2e\x1e\x62\x00\xec\xf6\x3d\xeb\x63\xe0\xab\x0c\x80\x99\x0c\xb6\x1a\xf5\x7e\xd4\x8b\xef\xad\x36\x34\x34\x91\x72\x8b\x01\x70\x9a\x48\xad\x04\x08\x76\x34\x4d\xd9\x83\x11\xfe\xa8\x1e\x54\xce\x2e\x3c\xcb\x8a\x25\x86\xe6\x3a\x3b\xa4\x64\x3f\x1d\x0b\xd4\x48\x68\x8d\x0d\x66\xe9\x3f\x69\x0c\xac\x13\x07\x94\xd3\x47\x31\xb0\x82\xbd\xa0\x22\xd9\x1e\xaa\x1a\x93\xa4\x9a\x43\xf5\x39\x85\x75\xd3\xe8\x7a\x16\xce\x73\x39\xf5\xcc\x1e\x61\xfa\x4c\xa6\x04\xbf\xdb\x3c\x1a\xd8\x8c\xfd\x65\x0e\x45\xe5\x8c\x06\xd6\x63\x7f\xab\x3c\x5b\x66\x86\x43\xe5\xbf\x65\x0d\xf9\xe5\x65\xcd\x6c\x1a\x99\xb7\x69\x10\x1e\x2f\x98\x56\x1f\x96\xc7\x18\x81\xc7\x02\x2b\xc7\xe4\xad\x1d\x7e\xf7\xe4\xe1\x79\x75\x31\x9c\x89\x4f\x8f\xf6\xd5\xa5\x35\xf2\xb2\x22\xba\x77\x38\xb0\xf7\xc2\xbc\x45\xea\x8f\xaa\x33\x9d\xe1\x14\xcc\x98\x5a\x18\xa7\x20\x15\x2c\x42\xa9\xc4\xb9\xce\xa3\x03\x1c\xe6\x3b\x1a\xbd\x2b\x39\xcb\xbb\x70\xee\x39\x48\xce\x1e\x37\x4c\xd3\xcf\x75\xc2\x90\x84\xea\x32\xfe\x2b\x2c\x42\xea\x00\x28\xf1\xb7\xe3\x19\x6c\x0e\x87\xbe\x9e\xb3\xd7\xf4\x99\x7e\x20\x40\xf3\x0c\x6e\xc5\x62\x40\xbc\xca\x71\xd6\xd6\x3c\xa4\x62\x6b\x42\xdd\x09\xa2\x41\x0f\x2f\xb6\xa3\xd5\x63\xe7\xb9\x6e\xc7\xfa\x14\x4c\x62\x97\xa1\x4d\x53\xda\xed\x08\x52\x77\x62\xcb\x95\x1a\x8a\x35\x12\xb9\x72\x81\x29\x77\x18\x20\xc1\x11\x28\x32\x31\x2c\x3f\x41\xc3\x49\x01\x21\x2c\x52\xc2\x5d\x85\x63\xd7\xc9\xc0\x54\x76\x90\xe3\x66\x61\x49\xf9\x06\x1c\x78\x00\xad\xb2\xf9\x50\x84\x28\x89\x5c\x26\xd6\xbb\x1c\x81\xd2\xca\xd4\x28\x4c\x65\x2f\xd0\xd6\x43\x04\x56\xcb\x1f\x74\x05\x4c\x43\x48\x70\x22\x09\x00\x57\x90\x35\x9d\x19\xe2\xf0\xc1\xab\x22\x03\x90\x19\xa5\x22\x2e\x4a\x10\x45\x2c\x88\x3c\x0f\x22\xe7\x59\xc6\xd8\xd7\x33\xbb\x15\x13\x33\x7e\x70\x48\xf3\x4a\x73\xd3\x0b\xdc\x63\x60\xa9\x26\x2d\xe4\xcb\x56\xb5\xbf\x9c\x2c\x3a\x1c\xca\xb9\xa2\x41\xea\x3a\xfe\x6f\xb9\x0b\x80\xd3\xe3\x1e\x6c\x67\x59\xcf\x02\x25\x10\x8b\x6d\xe4\x90\x27\x24\x10\xf4\xe4\x3b\x42\x54\xd3\x5c\x95\x36\x14\x3a\xb9\x94\xb7\xe5\xbc\xf2\x07\x16\xee\xfe\xd0\x72\x1e\xa8\xe4\x36\x96\xd0\x18\x7a\x80\xf4\x1e\xe6\x48\xce\x45\xa7\x51\xd3\x9b\x42\x0a\x6b\x86\x4e\x7b\xc0\x0c\x2c\xcd\x7b\xdd\xf8\x69\x2f\x90\x9b\x55\x6f\x39\x88\xda\x0b\x68\x9b\xc3\xab\x97\xd8\xe8\xc6\x31\xd6\x5e\x20\x5b\xd1\xe3\x3d\xf4\x7a\x3f\xb8\xeb\xc6\x61\x5b\x7a\xbe\x17\x50\x8f\xc1\xd8\xfe\xc6\xc2\x76\xc6\xc3\x3a\x16\xd7\x0a\x8f\xa9\x0f\x7e\x76\x9a\x1b\x5d\x17\x9b\x7a\x62\x36\x18\xf2\x5a\x55\x82\x5f\xc1\xd2\x0d\x17\x5e\x30\xf5\xf8\xec\xa4\x9a\xa0\xad\x6a\x26\xa5\xc8\xf3\xa5\x79\x23\x26\x69\xc3\x22\x16\x33\x54\x1c\xdc\xa5\x9d\x89\x42\x2a\x09\x6c\x89\x4e\x52\xa4\xfc\x78\x2e\xfe\xf8\xae\x65\x0b\x66\xef\x33\x2b\x55\x7b\xa3\xdc\x75\x62\xa7\x99\xa6\x1e\xfe\xac\xf5\x0f\xf2\xb2\xad\x2d\x02\xdc\x72\x80\xf5\xe6\xa4\x5c\x57\x05\x72\x07\x29\x78\x8e\x4b\xd8\x41\x82\xae\x52\x95\x9c\xd3\x74\xb7\xa2\x75\xad\x81\xbf\xde\x76\xb2\xf5\xc2\x14\x3f\xd6\xec\x0d\xd7\xd9\xd6\x60\x19\x83\x08\x22\x13\x4e\xab\x32\x6a\x30\xa2\x07\x36\xb8\x3e\xf4\x2d\xee\x93\xf6\xcd\x1e\x5d\x9c\xb5\xea\x53\xb1\xe0\x7e\xbe\x5e\x15\xf2\x97\x53\x37\x01\xed\xd2\x3b\xa9\xb6\xfd\x23\xbf\xab\x9a\xa5\x83\x70\x7a\x83\xb4\x6b\xa2\xad\x2c\x19\x23\xff\x2e\x79\x5a\xeb\xb8\x9b\x26\x08\x38\x4b\xe9\x56\xaf\x34\x8a\x6a\xf6\x86\x33\x64\x9d\x5e\x44\x5c\xea\x11\xcd\x3f\x7a\x1f\xb3\x60\xe5\x61\x11\x52\x4d\x50\x1f\xd1\xd4\x74\x3f\xcb\x69\xc9\xe5\x06\x28\x81\x5b\x8f\x51\xbb\x30\x01\xc5\x85\x30\x9f\xfb\x69\xe5\x71\x78\x25\xe2\xbc\x2f\x5d\x64\xa8\xa2\x5b\xe9\x5d\x99\x9f\x4e\x6e\x1c\xc4\x5e\x4a\xf9\x36\xc7\x46\xa8\x32\x82\xcc\xef\xf9\xe5\xa0\xa3\x84\xa5\x30\xef\x09\x11\x70\x32\xbe\x11\xb3\x50\x0e\x73\xc0\x93\xd4\xad\xb7\x30\x8e\x5b\x88\xc4\x1b\x2e\x2a\xc2\x22\x9b\xe1\x8c\xe0\xbe\xb6\x50\x29\xc4\xb5\x77\xf7\x6a\x6f\xe8\x09\x76\x2b\x97\x83\xb9\x3e\x17\x9e\xf6\xcd\x6a\x20\xc3\x6a\x08\xe3\xc3\x08\x6a\x0e\x88\x9c\x6e\xa1\x49\x68\xf4\xf4\x5c\x56\x3f\xc0\x44\x83\x3b\x49\x23\x4f\x84\x6e\x7f\xc5\x5f\xaa\xf1\xe6\xb0\xbc\xed\x15\x0f\x7d\xbe\x73\x4e\x75\xce\xa9\xce\x39\xf5\x99\x3b\xa7\x2c\xe5\x47\xcb\x3b\x6f\x21\x5a\x77\x72\xd9\x2d\xeb\x9d\x35\x68\xeb\xc6\x57\xcd\x24\xac\xb6\xcb\x78\xdf\x95\xbf\xca\x0b\x2e\x0d\x9a\xb5\x62\x39\xe8\x0c\xda\xd7\x9e\xaf\x32\xf7\x23\x7c\xbc\x9b\xc5\x74\xf0\x75\x20\x92\x31\xd0\xe3\x44\x96\x8f\x4e\xa3\x0c\x16\x1d\x71\x3b\x9d\xac\xac\x54\xbb\x36\x6c\xdc\xce\x14\x1e\xfc\xb8\x93\x15\x7d\xa2\x7d\xef\x3f\x76\xc8\x43\xa7\x2f\xbd\x69\x4d\x85\x57\x8a\x3b\x95\xa7\x30\x3b\x6e\x3d\x80\x6c\xd3\xc8\x50\x69\xf0\x26\xdd\xca\x4f\x7d\x8f\x66\x91\x6a\x07\x88\x7a\x80\xc8\xe1\x97\x03\xf9\xe1\x70\x3c\x2f\xfb\x5b\x00\x1d\xce\xc4\x2c\xcd\x16\x7d\x03\x18\x01\xb2\x3b\x34\xac\xd3\x52\x08\x88\x84\xda\xa3\xd4\xa6\x71\x99\xa1\x4b\x2d\x5e\x7c\x82\x76\x86\x39\xc7\x73\x67\x27\xe3\x26\x42\xab\x3a\xe6\x27\x5b\xc0\xac\x34\x42\xca\xec\x53\x05\x32\x7d\xe3\x38\xf3\x06\x8b\xd0\x44\x72\x07\x6a\x4d\x96\xef\x3f\x95\x69\x31\x89\xee\xa2\x3c\xcd\x7c\x91\x13\x26\x8b\xf7\x53\x7f\xcc\x0e\xb6\x14\xab\xcb\x70\xb6\x40\xc4\x5a\x9f\x66\xa4\x26\x2c\xa4\x65\x31\x2f\x8b\x6d\x4e\x1a\x74\x0f\xcd\xb1\x74\x9f\x3f\xc3\xa9\xfa\xde\x80\x6b\x16\xea\xab\x3d\x4f\x38\x20\xa6\xc0\xe8\x85\x4d\xff\xcf\xc1\xdf\xbf\xfc\x75\xd0\xfb\xe6\xe0\xe0\xe7\x97\x83\x3f\xfd\xf2\xe5\xc1\xdf\x87\xf4\x8f\xff\xe8\x7d\xd3\xfb\x55\xff\xf2\x65\xaf\x07\x7f\xff\xfe\xdd\x77\x97\x17\xa7\xbf\x44\xbd\x5f\x7f\x4e\xca\xd9\xad\xfc\xed\xd7\x83\x9f\xc5\xe9\x2f\x4c\x20\xbd\xde\x37\xbf\xf3\x5c\xf0\xc7\x41\xe5\xf2\x1f\x00\xed\x0c\xd2\x6c\x20\x8f\xfe\x75\x50\x64\xa5\xaf\x46\x24\x8f\xa3\x1d\xde\x53\xa9\x6a\x5b\x09\xb9\x9a\x95\xbd\xff\x69\x1a\xc4\x5b\x20\x60\x0b\x7b\x78\x9b\xf8\xdd\x73\x8f\xc4\x79\xbc\x4c\xe6\x9e\xb7\x1a\xa1\x70\x18\xa0\xac\x60\x76\x29\x9f\x2d\xa7\x7c\xaa\x56\x4e\x9b\xf3\x3d\x5d\x46\xd3\x58\x52\xfd\xe1\x7c\x4f\x0f\xa5\xb5\xcb\xf7\x7c\xe4\x7c\x4f\x49\x19\x5d\xb2\xa7\xbf\xb9\xdd\x25\x7b\x76\xc9\x9e\x9d\x3f\xbd\xf3\xa7\x77\xfe\xf4\xcf\xd5\x9f\xde\x25\x7b\x76\xc9\x9e\xdb\x1b\x8b\x5d\xb2\xe7\x7a\xc1\xd7\x25\x7b\x72\x54\xa5\xcf\x22\xd9\x73\xe4\xea\x09\xa0\xb5\x98\x4c\xcf\x4f\x23\xd1\x13\x7b\xc5\x45\x63\x71\x34\x1e\x63\x23\x87\xcb\xf4\x56\x24\x2d\xbb\x53\x56\xe0\x3f\x5b\xdf\x4a\x58\x4e\x22\xf4\x57\x6c\x41\x47\x47\x0a\x84\x76\x09\xa0\x26\x90\x4c\x9c\x6d\x60\xbd\x12\xcd\x69\x0a\xc4\xdb\x30\x38\x02\x96\x33\x8e\xe6\x11\xca\x36\x67\xa1\x1e\x4a\x28\x92\x2c\xcd\x04\x76\x20\x56\x11\x4f\xd5\x54\x67\x57\x95\xd3\x74\xd6\xc8\x2c\xff\x82\x12\xc4\x7a\x0b\xae\xf7\xc7\xda\xb0\x74\x52\xa4\x7a\x68\x70\x90\xdf\xa4\x65\x8c\x11\x06\x0f\xc7\xa9\x85\xc5\x4b\x6b\x75\x76\xac\xc1\x03\xa2\x85\x00\xb5\x70\x60\x9a\x2e\x0d\x53\xb7\xe4\x7c\xe2\xe3\x3c\xca\xe8\xca\x01\xb3\x4a\x93\xc9\x36\x9e\xc1\xd3\x65\x58\x8a\x86\x3d\x94\x0e\x41\x4e\xa5\x49\x29\xc1\x51\x4f\x83\x30\x8e\x26\x51\xb1\xf0\xcb\x90\x54\x4c\x24\x08\x25\x17\x31\xd7\x21\xaf\x8e\xd5\xf5\x32\xcc\x81\x45\x60\x2f\xa6\xdc\xc2\xa1\xb4\xed\x54\x33\x26\x3f\x1f\xe3\x3c\x2e\xaf\xe1\x02\x90\x61\x47\x6f\x40\x2d\x1c\xe3\xf7\x98\x7f\x2b\x6c\x8e\xe8\xba\xe0\xfa\xd6\x2f\xad\x85\xd2\xcb\x48\x51\x75\xa5\xe0\x6c\x41\xfd\x18\x53\x7b\x79\x92\x49\x44\x53\x6f\xd4\x62\x57\xf1\x78\xa2\x87\x39\x7c\xfd\x12\x3b\x8a\x8d\x25\xc7\x22\xd1\x88\x9d\x93\x5d\x17\x9a\x82\xb1\x79\x47\x1d\x60\xed\x85\xd5\x5f\xe5\x08\xf3\xf7\x5f\x05\xc0\x4e\xb2\x7c\x68\x37\x4f\x7a\x45\x9f\x49\x1f\x9c\x7b\x0e\x0e\x1a\x36\x45\x00\x12\x1b\x18\xec\xab\x97\x60\xca\x27\x25\x2a\x74\x4e\x50\x2c\x03\xf4\x0f\x5f\x79\xb0\x10\x77\xd3\xd3\xdd\xe8\x5c\xcd\xbb\x55\xd2\x6e\x2e\x27\x9c\x7a\xf9\x3d\x95\x67\x5f\xb6\x09\x93\x53\x99\xad\xd4\x2d\x1f\x25\x60\x89\xf1\x93\xe7\xfc\x11\xb8\xb2\x8f\xd5\xe5\x68\x39\x39\xeb\x79\x8e\x0f\xf0\x4d\x2b\x97\x6e\x56\xbc\x0c\x26\xf6\x52\xff\x55\xa6\x57\x8b\xc2\xad\x99\xdf\x7f\xc9\x67\xea\x5d\xfc\xf4\x87\x76\x77\x78\xde\xc0\xd5\xd5\xee\xf0\x55\x73\xf8\x16\x3b\x5a\x5d\xa3\x87\xcd\xb9\x9d\x10\xf9\xe5\xb4\xef\x46\xb7\x3c\x19\x8f\x31\xda\x01\x1f\x2a\xae\xc7\x1d\xf7\x93\xa4\x72\x15\xcf\xa6\xe5\xdd\x83\xcd\xd9\xd5\x89\x3e\xa7\xb1\xdb\xad\x37\xc8\xcb\xc4\x75\x04\x48\xf6\xc1\x9c\x7c\xb0\x7e\x09\xb0\x33\x73\x4c\x12\x16\xe7\xb8\x45\x73\x66\xb6\xac\xbe\x3b\x06\xa8\xd2\x6e\xec\x71\x71\x61\x15\xfd\xe1\xb5\x0c\x93\x7d\x30\xe5\xbc\x12\x0a\x31\x1f\xe8\x35\x05\xb0\x5a\x39\x8e\x38\x43\x45\x6a\x1e\x66\x21\xb7\x47\x15\x1d\xe9\x38\x9d\xcd\xc2\xbc\xa7\x62\x8c\x21\xe5\x6b\x4a\xd1\x85\x1a\x4a\x16\xc6\x06\xad\xbc\x26\xb6\x55\xe6\x5c\xeb\x83\x79\x45\x12\x26\x85\xfb\x54\x39\x7a\x2c\x48\xef\x13\x3d\xa1\xf0\x1a\x44\x71\x62\xce\xc9\x41\xa9\x55\x76\xdc\xb7\xe1\x18\x84\xe7\x24\xf8\x21\xd7\xf7\x62\xb2\x48\xc2\x99\x9a\xfe\x02\x6c\xec\x2e\xc2\x8b\xc1\x3c\x85\xfa\x3a\xf2\xbe\x8a\xd6\xc8\x5e\x75\xba\xf5\xaf\x54\xa2\xdb\xc6\x68\x99\x7b\xcc\x22\x80\x5d\x67\x4d\x3c\x94\x3d\xaa\x01\x60\x45\x77\x63\xa1\x55\x79\x5c\x50\xdb\x9b\xbc\x63\x35\xb8\x5d\xd9\xa6\x6a\x6e\x1b\x59\x71\x5a\x92\x6d\x26\x85\x83\xb7\x47\x74\xeb\xc7\xc8\x36\x17\xa6\x6b\x62\xfd\xc0\xf1\x84\x93\xf6\x27\x1c\xba\x68\x21\xec\x0b\x3e\xe0\x5d\x16\xb6\xbe\x92\x5d\xb9\xb5\xdd\xdc\xff\xf0\xed\x49\x9d\x47\x7f\x08\x27\x69\x1e\x7c\x1b\xa7\xe3\x5b\xa0\x3d\xb2\x88\x49\x60\x35\x6e\xc5\x9a\x78\xf3\xf0\x44\x1b\xa7\x59\x62\x4d\xa3\x9b\x61\xc3\xbb\x19\xdc\xdc\x0d\xfa\xfd\xac\x07\xfd\x02\xdd\xfc\x66\xc6\xfc\xce\x60\xe3\xee\x84\x4c\x21\x5e\x62\x06\x04\x40\x4f\x05\xe3\x4f\x55\xaf\x5d\xcd\x2f\x00\x85\x83\x22\x1d\x80\x3c\x1a\x44\x45\xeb\x7b\xbc\x15\x0b\xca\x61\x76\xde\xe5\xf7\xf2\xc1\x9a\x73\x01\xe8\x0e\x43\x2b\xf4\xf9\x94\x99\x08\x05\x7c\x14\xc5\x78\x35\x08\x03\x00\x1e\x8a\x62\x7c\x08\xe4\x77\x73\xa8\x96\xe7\x3c\x45\xf1\x39\xa1\x78\x96\x26\x11\x5c\x22\xf7\x96\xb3\xfb\x47\x40\xeb\x71\xac\x1a\xc0\x02\x3f\x3c\x06\x94\x18\x70\x0e\x71\xcd\x47\x21\xbb\xe7\x31\xa6\x75\x0e\x06\xda\x36\x57\x16\x9f\x57\x37\xd6\x22\x48\x40\x8d\xf7\x30\x9c\xe7\x40\x82\x3b\x1a\xb3\xb6\xab\xe9\xb5\xcf\x8c\xb2\x1f\x6b\x52\xee\xfe\x48\x3f\x4a\x4e\x1d\x2b\x97\xc1\x79\xe8\xad\x5e\x06\x19\xc1\x86\xc5\x9e\x4d\xa5\x49\x38\xc1\xc4\xd8\x3b\x90\xc4\xf0\xaf\x5c\x8b\x00\x27\xdf\x09\x2d\x30\x8a\x1f\x53\xac\x75\x73\x7c\xb9\xaf\xfd\xec\xe7\xf8\x7a\x39\x0b\x2c\x26\x8f\xcf\xaf\x32\xf9\x70\x32\x8b\x92\x4f\x98\xcd\x3b\x4d\xe1\x45\xcd\x94\xf1\x3d\xad\x6c\xb4\x65\x66\xe7\xe3\x30\x16\x67\xef\x9d\x4c\xed\x91\x7c\xa6\x6e\x6d\xeb\x0f\xab\x81\x48\x4c\xef\xcb\xc6\x31\x3f\xdf\x9b\x4b\x1a\x24\xe9\xa4\x39\x32\xd8\x8d\xf3\x6a\x1a\xe7\x55\xdd\x2d\x16\x44\xf6\x2c\x20\x27\xd6\x72\x1d\x16\xe2\x3e\x74\xd7\x7c\x2e\x35\x5a\xd4\x48\x53\x2d\x10\x34\xe1\x71\x7b\xe4\x7d\x27\xdf\xdf\xfe\x1c\xb2\x2c\x2d\xa4\x65\x70\x92\xce\xc2\x28\xf1\xda\xa0\xad\x63\xe8\x8d\x5d\x18\xc0\x3c\x85\x80\xde\x4e\x7a\x46\x35\x96\xa1\xc4\xca\x75\xe5\x39\xe8\x06\x31\xed\x70\x10\x93\xbf\xb2\x59\xe9\x9a\x95\x1b\x59\x87\xf9\x1d\xaa\x2c\xf1\xdc\x35\xe5\x90\x58\x35\x49\x61\x00\x25\xc9\x23\xca\x73\xb7\x92\x0f\x99\xb3\xbe\xa6\xb2\xe2\xc4\xd4\x99\x49\xa5\xb5\x1f\xbc\x4d\x31\x81\x47\x71\xaa\x54\xa6\xf2\xf0\xd6\x19\x82\xb6\xda\x69\x99\x9d\x96\xc9\xd7\x32\xff\xff\xf6\xae\xaf\xb7\x6d\x1b\x88\xbf\xe7\x53\xe8\xad\x1b\x10\xdb\x2d\x96\x15\xc5\xde\x9a\x78\x05\x0c\x34\x8d\x51\xbb\x7d\x97\x2d\x35\x11\xa2\x48\x9e\x69\x39\xdd\x3e\xfd\x78\xc7\xa3\x44\xd9\xa4\x7c\xa4\xe5\xa6\x40\xfd\xd4\x54\x12\x69\x1e\x45\xdd\xff\xfb\x9d\x10\xf9\xdf\x45\xbc\xc8\x79\xc9\x22\x6d\xc1\x9d\xc7\x98\xb9\x95\xe2\xf8\x51\x92\x09\xf8\x37\x9a\xcd\x3e\x62\x90\xb3\x2a\x7c\x2c\x39\x0c\xeb\x91\x18\xa9\x01\x02\x15\x03\xeb\x9f\x9f\x28\x9e\x1d\xd4\x9e\x6b\x52\x24\x40\x96\x64\x23\x66\x9e\x36\xcd\xa8\x3a\x94\xf9\xc4\xfc\x29\x9f\x54\x2a\x39\xf3\x87\x6c\xf9\x38\x6d\x62\x99\x10\x07\x97\xd7\x0a\xe3\x92\xb7\xf1\x1a\x30\xde\xeb\xac\x11\xd9\xd3\x10\x77\xd4\xdc\x90\xc6\x33\xda\x3e\x98\x28\x8a\x85\x28\x97\x99\x5f\x50\x1d\x9d\xb3\xb5\x48\x8f\x12\x14\xd7\xfd\x93\x8b\xda\xe0\xd1\x3a\x88\x3e\x2c\xa4\xac\xc6\xc2\xd0\x27\xb8\x61\x71\xda\xb9\xe1\x69\x82\xb7\x41\x0d\xbd\xe7\xad\x16\xde\xda\x0e\x69\xc7\x62\x7d\xa2\xfe\xfa\x70\x68\x9d\x1e\x5b\xcb\x0a\xe3\x78\xe0\xab\x67\xf6\xe9\xac\xdb\x7d\x53\x0a\xd8\x0b\x46\x7f\x49\x57\xe7\x64\xab\x69\xed\x85\xf3\x2c\x27\xbe\xc4\xb7\x60\x59\xa0\x16\x36\xbf\xde\x4e\x4a\x8f\xba\x46\x01\x5f\x60\x75\x0c\xdf\xf6\xaa\xca\x55\x26\x70\xd3\xa3\xfd\xd8\x78\x9e\x5a\xc7\xab\x9f\xa4\xc5\xa3\xad\x74\x79\xbf\xdb\x23\xdf\xe8\x05\x03\x97\xd7\xed\x91\x35\x27\x75\x84\x34\xec\x88\xd7\x6f\xaf\xae\x5a\x3d\x22\x8d\xfe\x8f\xbc\x7e\x7a\xac\x1e\x91\x46\xf9\x31\x6b\x56\x5e\x8f\x48\xfa\x3d\x76\x43\x5f\x66\x8f\x48\x28\x15\xe6\xae\xd2\xd6\x23\xf2\xd5\x8b\x37\x89\x64\xc7\xb2\x3c\xd0\x5b\x30\x59\xce\x87\xcb\x77\x61\xb6\x98\x28\x2c\xdc\xad\x76\x22\xb5\x58\xb1\x57\xb8\xb9\xa7\x06\x3e\x4b\x6f\x88\x2b\x3c\x9c\x95\x00\xf4\x67\x17\xba\x8a\x15\x2f\x85\x6b\xa9\xee\x63\xaa\xb8\x50\x52\xd8\xc4\x13\x92\x0a\x03\x1b\x85\xf7\x9a\x36\x7d\x23\xa2\x70\x70\x50\x8c\xf2\x6c\xd6\x9c\xdc\x1a\xec\xd0\x66\xaa\x76\xa4\x13\x13\xb9\x84\xdb\x4e\xd5\x0b\xdf\xc4\xbf\xda\xd1\x0b\xcb\xa4\x37\x04\x93\x80\x72\x0a\x3f\xb4\x12\x0f\x41\xef\x51\x9c\x61\x43\x26\x09\x96\xef\xa6\x94\x57\x78\x24\xbd\x62\x8b\x74\x23\x8a\xd4\xe2\xda\x6b\xc6\x2e\xc1\x1e\x82\xad\xcc\x13\xef\x72\xc5\x3e\x25\xe0\x81\x42\x3e\x4c\xd4\x87\x08\xfc\x46\x3c\x1c\xf1\xe5\xed\xe2\x7a\x84\x6c\xbf\x1d\xcd\xc3\x82\xcc\xe1\x59\x43\xd6\x4c\xd3\x1b\x1e\x47\x27\x0a\x87\xe7\x6c\xde\xcc\xc7\xb7\x8e\xcb\x07\x33\xc3\xa3\xde\xeb\x64\xa5\x5b\x7e\xb8\x09\x4c\xb4\x04\xb3\xd3\x15\x8b\x3c\x7f\x90\x84\x90\xf0\x45\x90\x4f\xa5\xe5\x3f\x27\x63\x5a\x9f\x6a\x95\x36\x5d\x77\xc1\xe1\xe6\xcc\x56\xe2\x47\x99\xd3\x9e\x47\x9e\xef\x9c\x50\xab\x29\x85\x5f\x80\x5d\x8d\xba\x9b\xed\x84\xd8\xeb\xcb\xcc\xba\x94\x73\x64\xfd\x47\x45\xd6\x9b\xc4\x70\x6e\x96\x1c\x26\x8f\x77\x26\x86\x9f\xc3\xb4\x3f\x77\x98\x56\xb4\xd0\xc1\xb5\xfb\x10\xb9\x16\x3b\x52\x5b\x2e\xa8\x8b\x9b\x12\x0a\xf5\x07\x0e\x1d\x90\xe4\x7c\x88\x97\x21\x77\x7c\x08\x76\x2d\x2f\xfc\xd9\xd6\x90\x75\x70\x0a\xf5\xef\xc6\x6e\x07\xac\xef\xa7\xd5\x86\x77\xc8\xce\x11\xd8\x73\x04\xf6\xf8\x90\xcb\xd7\x7a\xa8\xf6\x46\x3d\x54\x4f\x71\x31\x00\x4e\x82\xb1\x58\xb6\x8f\x4b\xa7\xc7\xec\x48\x43\xf9\x8d\x50\x5d\x1e\xbe\x34\x74\xe1\x96\x5c\xae\x54\x15\xd9\x3f\x55\xda\xe0\xa5\xd6\xaa\xca\x09\xa3\x56\x38\xff\x11\xfb\xa8\x1a\x0a\xee\x70\xa1\xa5\xfc\x56\x7d\x76\x91\xcc\x67\x22\xbc\xde\x53\x64\x38\x50\xc6\xee\xa7\xb3\xb5\x7c\x6e\x90\x21\x8d\xbf\x31\xc5\x4a\xff\xa6\x07\x22\x71\x21\xde\x8b\x41\xcb\x5c\x99\xe6\x71\x9e\x97\xcf\x8a\x4e\x53\x83\x81\x23\x05\x74\x73\xc5\x39\xd5\xae\x67\xe0\xb4\xa3\xc8\x9c\x49\xba\xca\x9c\x02\x2f\x40\xba\x56\xe6\xea\xda\x23\x51\x66\x26\x65\x80\x71\xd4\xc1\xa1\x55\xa8\x22\x4e\xf8\x5b\xe7\x78\xab\xe6\xae\x1e\x98\x02\x8b\xf4\x21\xde\x66\x65\xb5\x1e\xe2\x2f\x40\xa7\x07\x1a\x8e\x6a\x03\xd4\xd8\xe9\x90\x45\x25\xb8\x1b\x51\xbf\x11\x61\x79\xff\x9f\x9a\x9b\xe8\xc0\x48\x4a\xb6\xfc\x91\x2a\xeb\x20\xfd\x0e\xbd\x5c\xf7\xf6\x56\x0b\x20\xdd\x20\xea\x85\xd4\xf2\xad\x58\x81\x1e\xf2\x95\x55\xfe\xdb\xfe\xfc\xcc\x91\x6d\xf5\x7c\x3b\xc3\x5b\xc7\x2a\xe7\x84\x53\xa4\xaa\xfa\x75\x29\xdd\x59\x41\xff\x05\x14\xf4\x3a\x45\x45\xae\xf4\xdf\xc9\xd8\x5f\x35\xad\x53\x53\x60\x82\xe8\x3a\x06\x0c\x82\xdb\xb8\x90\xd7\xd0\x9f\xf4\xdb\x6c\x7a\x7d\xfb\x3b\xd7\x47\x8e\xe1\xa7\xc9\x78\x37\x89\xc1\x94\xbd\xea\x87\x3e\xf5\x5f\xa1\xbe\xb3\x17\x41\x0a\xc7\x09\x76\xa3\x38\x05\xa5\x8a\x5d\x70\x1b\xbb\xee\x83\x49\xa9\x64\x13\x8d\xac\x27\x7c\xd9\x10\x2e\xe1\x29\x79\x7c\xb9\x24\x93\x66\x03\xfa\x61\xef\xbc\xdf\x1e\x1c\x52\x38\x59\xbf\x76\xd8\x7f\x48\xd6\xc5\x38\xeb\x28\x90\x39\xb8\xb1\x9d\x6b\xe9\xb8\x69\x5f\x9e\x75\x80\xd8\xc4\x9b\xaa\x25\x3e\x5a\x47\xed\xba\xca\xf2\x64\x86\xcf\x90\xf3\x91\x9a\x62\x2d\x10\xbf\x31\xc1\xf1\x68\xbc\xe1\x93\x17\x87\x65\x53\x2c\xaf\x7d\x03\x4c\x99\xdd\x7d\x71\x84\x13\xdb\xc0\xa1\x34\x38\x1a\x0c\x2e\xfc\x84\xa1\x14\xb9\xcb\x47\x51\x39\x52\xe3\x0e\xbc\x89\x2c\x09\x1a\x96\x97\x2a\xbb\x34\x68\xf0\x26\x5e\xdf\xbb\xf2\x9b\x3a\x87\x76\x7d\x07\x03\x49\xca\xf1\x07\x49\xea\xa5\x92\xab\x4e\x6c\x55\xf9\xce\x95\x01\x56\x66\x86\x81\xb1\x90\xf7\x8e\x87\xeb\x46\x4f\x41\xf7\x16\xda\xf8\xd1\x47\xb0\x69\x2a\x09\x08\x83\x36\xbd\x64\x29\x0f\x08\xc4\x5c\x10\x39\x6f\xe8\x79\x84\xf2\x58\x6c\xe6\xeb\x18\x93\xe9\xcb\x62\x9e\xb9\x84\x53\x6b\xe5\x1f\x01\xe6\x10\x40\x43\x74\x55\x06\x91\xb0\xa9\x27\x82\xde\x82\x00\xa7\x20\xff\xa2\xaf\xf1\xc2\x69\xc0\xc4\x05\x7a\x18\xec\xc2\x48\xc7\xe7\x12\xb9\x1d\x03\x27\xb8\xda\xa1\x33\x2b\xd7\xfb\x65\x05\x53\x30\x09\x84\x80\x5b\x6e\x10\x99\x09\x83\xca\xe7\x58\x44\x15\xce\x96\x9c\x70\xcd\xd2\x62\x11\x4e\x84\x88\x36\xff\x50\xde\x87\xa8\xf6\x3e\xd0\x50\xa9\xf8\x61\xce\x34\x78\xe4\x92\x54\x1e\x91\x5c\x28\x74\x66\xd7\xab\x00\xb8\xc4\xfa\x0d\x0e\x43\x16\x2d\x97\x20\x5c\xac\xc1\x12\xd1\x84\x87\xcd\xe2\x1e\xb5\xc1\x00\x4a\x83\x7b\x7f\xdc\x5a\xf6\x85\x80\x53\xb9\x42\x49\xd0\xb4\x5f\x55\xcb\xb8\xc4\xc3\x2b\xaf\xce\xd7\x55\x7a\x19\x7d\x00\x67\xf1\x65\xf4\xa5\x78\x2c\xca\xe7\xb0\x15\x6d\x9c\xb6\x4a\x7b\x6f\x08\xa0\xc6\xb0\xd7\x9b\x55\x0d\xfb\xe6\x9d\xce\xaf\x53\xb5\xa0\xec\x83\xb1\x26\xd9\x7d\x2a\x36\x6c\xae\xaa\xc1\x83\x77\x07\xb4\x36\xe9\xe6\x21\x2e\xee\xd1\x72\x1b\x6b\xac\xe1\x51\x34\x99\xdd\x45\xef\xde\xbe\x7e\x03\xd1\xc9\x22\xba\xf9\x3c\x06\x97\x8b\x88\xee\x56\x69\x01\x4e\x68\xb0\x76\xf6\xe8\xd9\xfe\x51\x7b\x58\xef\xa5\x49\x50\x2d\x86\xcb\xf2\x69\x74\xf7\x7e\x32\xa2\x61\x03\xea\x9c\xa9\xc4\xde\x28\x13\xa2\x4a\xc5\xe8\xdd\xd5\x9f\x5c\x7a\x30\xa3\x8a\x4d\x3d\xd4\x12\x55\xeb\xb4\x93\xf8\x0f\xea\x99\x7d\x85\xa1\x8b\xd7\xbb\xbf\xcd\x03\xa7\x67\x09\x9e\x1e\x6b\xa4\xc5\xb6\xa8\xcf\xf4\xbc\x4d\x9b\x39\x6c\xdc\x93\x33\xdf\xa5\x5e\x1e\xce\xbb\xa0\x09\x6e\xe3\xef\x47\xcf\xe1\x96\x15\x5c\x16\xcf\xe0\x97\x5d\xea\xfd\x40\xaf\xa4\xfb\xae\xa4\xf5\xc2\x57\xcd\x76\x90\xc6\x21\xab\x83\x24\x37\x39\x03\x3a\x7e\xd6\x1b\xea\xc0\x58\x6e\x59\x56\xe0\x24\x2b\xf3\xd2\xdc\x56\x0f\x52\xd9\xeb\xfc\xc6\x50\x3b\x9b\xc2\x63\xfb\x27\xd9\x3d\x6d\x1e\x6f\x60\x0f\xd9\xeb\xd0\x90\x74\x2a\x12\x47\x11\x95\xce\x75\x29\xc9\x49\x1a\xe1\x56\x0d\x68\xe2\x4c\x04\xc5\x49\x19\x19\x84\x46\x66\x7d\x91\x70\x63\x01\x34\xa2\x3e\x93\x15\x19\x04\xe7\xb2\xff\xf6\xf2\x30\x9c\x4b\xc7\xcc\x9c\x34\x79\xbf\xf7\xb1\x1e\x3a\x44\x8e\x19\x2d\x6f\x76\xe7\x12\x51\xfb\x57\xb4\x7d\xd3\xfc\x8f\x18\x89\x32\x81\xe9\x96\x8a\x8a\x82\x29\xd7\x6a\x9f\x4b\x7e\x18\xba\xf6\x3f\x4f\xa8\x6c\x73\xf6\xb0\x04\x00"),
 		},
 		"/crd-camel-catalog.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "crd-camel-catalog.yaml",
@@ -144,6 +144,20 @@ var assets = func() http.FileSystem {
 
 			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3a\x4f\x8f\xe2\x38\xf6\xf7\x7c\x8a\xa7\xe2\xd0\x33\x52\x01\xbf\x9e\xb9\xfc\xc4\x9e\x58\xba\x4b\xcb\x76\x37\x55\x02\x7a\x46\x73\x34\xce\x23\x78\xcb\xb1\xb3\xb6\x03\x5d\xbb\xda\xef\xbe\x7a\x76\x12\x92\x90\x50\x14\xd5\xad\x95\x46\x95\x1b\xf1\xfb\xff\xdf\x2f\x0c\x60\xf8\xfd\x9e\x68\x00\x9f\x05\x47\x65\x31\x06\xa7\xc1\xed\x10\xa6\x19\xe3\x3b\x84\x95\xde\xba\x03\x33\x08\x77\x3a\x57\x31\x73\x42\x2b\xf8\x69\xba\xba\xfb\x19\x72\x15\xa3\x01\xad\x10\xb4\x81\x54\x1b\x8c\x06\xc0\xb5\x72\x46\x6c\x72\xa7\x0d\xc8\x40\x10\x58\x62\x10\x53\x54\xce\x8e\x00\x56\x88\x9e\xfa\xe2\x7e\x3d\x9f\x7d\x84\xad\x90\x08\xb1\xb0\x01\x09\x63\x38\x08\xb7\x8b\x06\xe0\x76\xc2\xc2\x41\x9b\x47\xd8\x6a\x03\x2c\x8e\x05\x31\x66\x12\x84\xda\x6a\x93\x06\x31\x0c\x26\xcc\xc4\x42\x25\xc0\x75\xf6\x64\x44\xb2\x73\xa0\x0f\x0a\x8d\xdd\x89\x6c\x14\x0d\x60\x4d\x6a\xac\xee\x4a\x49\x6c\x20\xeb\x79\x3a\x0d\x7f\xe8\xbc\xd0\xa1\xa6\x6e\x61\x85\x5b\xf8\x0d\x8d\x25\x26\xbf\x8c\xfe\x2f\x1a\xc0\x4f\x04\x72\x53\x1c\xde\xfc\xfc\x17\x78\xd2\x39\xa4\xec\x09\x94\x76\x90\x5b\xac\x51\xc6\x6f\x1c\x33\x07\x42\x01\xd7\x69\x26\x05\x53\x1c\x8f\x6a\x55\x1c\x46\xe0\x05\x20\x1a\x7a\xe3\x98\x50\xc0\xbc\x1a\xa0\xb7\x75\x30\x60\x2e\x1a\x44\x03\xf0\xcf\xce\xb9\x6c\x32\x1e\x1f\x0e\x87\x11\xf3\xe2\x8e\xb4\x49\xc6\xa5\x76\xe3\xcf\xf3\xd9\xc7\xc5\xea\xe3\xd0\x8b\x1c\x0d\xe0\xab\x92\x68\x2d\x18\xfc\x67\x2e\x0c\xc6\xb0\x79\x02\x96\x65\x52\x70\xb6\x91\x08\x92\x1d\xc8\x71\xde\x3b\xde\xe9\x42\xc1\xc1\x08\x27\x54\x72\x0b\xb6\xf0\x7a\x34\x68\x78\xe7\x68\xae\x52\x3c\x61\x1b\x00\x5a\x01\x53\x70\x33\x5d\xc1\x7c\x75\x03\x7f\x9d\xae\xe6\xab\xdb\x68\x00\xbf\xcf\xd7\x7f\xbb\xff\xba\x86\xdf\xa7\xcb\xe5\x74\xb1\x9e\x7f\x5c\xc1\xfd\x12\x66\xf7\x8b\x0f\xf3\xf5\xfc\x7e\xb1\x82\xfb\x3b\x98\x2e\xfe\x80\x4f\xf3\xc5\x87\x5b\x40\xe1\x76\x68\x00\xbf\x65\x86\xe4\xd7\x06\x04\x19\x12\x63\xf2\x69\x19\x40\xa5\x00\x14\x1f\xf4\xdb\x66\xc8\xc5\x56\x70\x90\x4c\x25\x39\x4b\x10\x12\xbd\x47\xa3\x28\x3c\x32\x34\xa9\xb0\xe4\x4e\x0b\x4c\xc5\xd1\x00\xa4\x48\x85\xf3\x51\x64\x4f\x95\x22\x36\xdf\x33\xb7\x22\x96\x89\x22\x9c\x26\xc0\x32\x81\xdf\x1c\x2a\x2f\xcd\xe8\xf1\xff\xed\x48\xe8\xf1\xfe\xfd\x06\x1d\x7b\x1f\x3d\x0a\x15\x4f\x60\x96\x5b\xa7\xd3\x25\x5a\x9d\x1b\x8e\x1f\x70\x2b\x94\x0f\xff\x28\x45\xc7\x62\xe6\xd8\x24\x02\x50\x2c\xc5\x09\x08\xe5\x30\x31\x41\x91\x11\x67\x29\xca\x5a\x64\x44\x00\x92\x6d\x50\x5a\x82\x07\xf2\xfd\x04\x6e\x3c\xd0\xf0\xf1\x26\x22\x83\xd1\xc1\x31\xb9\x1e\x0c\x91\x33\x33\x2d\xf3\x54\x15\x48\x43\xf8\xfb\xea\x7e\xf1\xc0\xdc\x6e\x02\x23\xeb\x98\xcb\xed\x28\xdb\x31\x8b\x51\x08\xc9\x18\x2d\x37\x22\x73\x5e\x37\xca\xb7\x9a\x44\x50\x07\x0c\xf2\x3e\xd4\xde\xb8\xa7\x0c\x27\x40\xb1\xa3\x92\x5e\x5e\x8f\xc2\x5d\xc2\xe9\x08\x16\xf8\x7c\xaa\x7e\x5f\xc4\xc5\xa0\x4f\x0b\xdb\xc7\x4a\xe5\xe9\x86\x6a\xdd\x16\x32\x1d\xdb\x06\xa7\x65\x13\x35\xb0\xf3\xa2\xa1\x89\x00\x12\xa3\xf3\x6c\x02\x1d\xae\x21\xf4\xc2\xc8\xc1\xed\xf3\xa3\x3e\xfe\xad\x14\xd6\x7d\x6a\x9f\x7c\x16\x36\x68\x96\xc9\xdc\x30\xd9\x8c\x00\x7f\x60\x77\xda\xb8\xc5\x91\x38\x69\x5c\x58\xc3\x0a\x95\xe4\x92\x99\x06\x96\x3f\xe1\xcc\x61\xa2\x8d\xa8\x23\x3d\x92\xcc\xd5\x2f\x5e\xfc\xb2\x5c\x93\x86\x9e\x41\xc6\x38\xc6\xf4\x2e\xdf\x98\x22\x5a\x0b\x7c\xcb\x99\xc4\x92\x94\x0f\xc2\x15\x4a\xe4\x4e\x9b\xa6\xe1\x6d\xf1\xb6\x80\xa4\x98\x2c\x0d\x5a\x02\x66\xc8\xdb\xfe\x09\xc8\x6d\xc0\x0e\x57\x86\x77\x13\xf8\xf7\x7f\x22\x80\x3d\x93\x22\x34\xb0\x20\x98\xce\x50\x4d\x1f\xe6\xbf\xfd\xba\xe2\x3b\x4c\xd9\xa4\xcb\xf9\x35\xcb\x53\xa9\xa3\x22\x11\xa0\xab\xba\x53\xb7\x3f\x4c\x1f\xe6\x05\x95\xcc\xe8\x0c\x8d\xab\x19\x94\x12\xb0\x2a\x03\xd5\xbb\x16\xbf\x77\x24\x50\xd1\x79\x62\x4a\x7c\x0c\x4c\xf7\xe1\x1d\xc6\x60\x03\x7b\xdf\x25\x04\x15\x77\x2a\x92\xa8\xdc\xd1\x97\xe5\xa3\xb7\x54\x8b\xf5\xe6\x1f\xc8\xdd\x08\x56\x68\x88\x08\x85\x47\x2e\x63\x6a\xd4\x7b\x34\x0e\x0c\x72\x9d\x28\xf1\xaf\x8a\xb2\x2d\xfb\xbf\x64\x0e\xad\x6b\x50\xf4\xe5\x81\xba\xf0\x9e\xc9\x1c\x6f\xa9\x96\xfa\x06\x66\x90\x78\x40\xae\x6a\xd4\x3c\x88\x1d\xc1\x17\x6d\xd0\x77\xed\x89\x6f\x5f\x76\x32\x1e\x27\xc2\x95\x85\x8f\xeb\x34\xcd\x95\x70\x4f\xe3\xda\xe4\x60\xc7\x31\xee\x51\x8e\xad\x48\x86\xcc\xf0\x9d\x70\xc8\x5d\x6e\x70\xcc\x32\x31\xf4\x82\xab\x50\xf0\xd2\x78\x50\xc5\xdd\xbb\x9a\xa4\x27\x39\x5f\x25\x59\xaf\xdd\x29\xd1\xc8\xc3\xac\x40\x0b\xf2\x1f\xcd\x4b\xaf\xc8\x2a\xcb\x8f\xab\x35\x94\x4c\xbd\x0b\x9a\x36\xf7\xd6\x3e\xa2\xd9\xa3\xe1\xc9\x50\x42\x6d\x7d\xab\xa1\x59\xc1\xe8\xd4\x53\x44\x15\x67\x5a\x28\xe7\x7f\x70\x29\x50\x35\x8d\x6e\xf3\x4d\x2a\x5c\x68\xe3\x68\x1d\xf9\x67\x04\x33\xa6\x68\xf2\xd8\x20\xe4\x59\xcc\x1c\xc6\x23\x98\x2b\x98\x51\x8e\xce\x18\x0d\x17\x3f\xd8\xec\x64\x61\x3b\x24\x93\x3e\x6f\xf8\x7a\xd7\x6a\x02\x06\x6b\x55\xaf\xcb\x8e\xd4\xe9\xa1\x5a\x26\xae\x32\xe4\x8d\xec\x88\xd1\xfa\x01\x87\xd2\x1d\x29\xee\xdb\xa5\xb4\x3f\x27\xe9\xe1\x5a\x6d\x45\x92\x9b\x5a\x6d\xa8\xc5\xbc\xc3\xd4\xb6\x5f\xb6\x64\x9b\xd5\x09\x78\xe9\x86\xc3\x13\x8c\x3e\xee\x35\x83\x74\xbc\xef\xb1\xe9\xf1\xf1\x71\x7a\x05\x66\x39\x15\x76\xa1\x0e\x3d\x6a\xe7\x81\x67\x77\x72\xd2\xe9\xce\xfa\x11\x33\x86\x3d\x35\x4e\x62\xcc\x50\xc5\xa8\x78\x87\x41\x7a\x6c\x7e\x46\x9f\x3e\x2e\x5b\xa9\x0f\x2f\x23\xff\x22\x25\x1e\x85\x6b\xd3\xe9\x15\x32\x33\x9a\xee\x07\x6d\xf8\xe6\xac\x61\x98\x70\x0f\x01\xb0\x56\x44\xfc\x28\x60\x7d\xcd\x27\x00\x0a\x7a\xe6\x80\x2e\x65\xa8\x68\x96\x8f\x4f\x74\x39\x99\x8a\x85\xb2\x8e\x49\xe9\x23\x74\xdc\xee\xfe\x17\x48\x5f\xb6\xd5\xb6\xf8\xe1\x36\xe6\x07\x8a\x5f\x7f\xe9\x24\x76\x9c\x85\x1a\xd4\xb4\x15\xae\x31\x70\x94\xcf\xf7\x73\x7e\x6b\x26\x79\x96\x45\xc3\x15\xe5\xf8\x7d\x5d\x3a\xd3\xd5\x8f\x2e\x2f\x1d\x05\xa5\x2e\xf3\x46\x6b\x89\xac\xed\x84\x40\x40\x39\x54\x27\xd1\xf5\xac\x2d\x6a\xb8\x9f\xf0\xe9\x35\xe8\x4b\xdc\x5e\x85\x9e\xea\x5c\x39\x3f\x93\x5d\x83\xed\x87\xea\x6b\x10\xfb\x0b\x68\xa7\x5b\xd7\x4f\x19\x76\xb9\xf5\x59\x4e\x57\x54\x09\x8b\x66\x2f\x38\x4e\x39\x27\xd3\x2c\x3a\x34\xec\xe5\xf8\x8a\x00\x5e\xbd\x85\xef\x35\xe8\x7e\xc6\xe5\x98\xd1\x40\x74\x41\x3c\xcd\x6b\xe0\xbe\x22\xeb\xac\xdc\x54\xc5\x34\x2f\x6d\x05\xcd\x7e\x54\x8a\xb5\x49\xca\x0b\x60\xb8\x0d\x3e\x8e\x96\x3a\x77\x68\x3f\x6b\x16\xb7\xea\xe3\xf1\xc9\xfd\xda\x4a\x43\x66\x70\x9c\x69\xeb\xc8\x71\x1c\xad\x2d\x23\xa3\x13\xad\x27\x3c\x2e\xd2\xbf\x3f\x8c\xc3\x53\xae\x57\x2e\xb0\xcd\xe7\x72\x13\x73\x4d\x9e\x01\x48\x6f\x97\x4b\xf8\x78\x40\x3f\xbd\xab\xba\xfd\xcb\x55\xda\x75\x96\xf7\x3d\xf6\x20\xa4\x0c\x21\x91\x19\x74\x61\x72\x2f\x66\x7f\xe6\xc0\xe4\xca\x89\xb4\x6b\x50\xfa\x51\x75\xee\x8a\xea\x13\x46\x86\x36\xaf\xfa\xd6\xa7\xbf\x12\x34\xcc\x3c\x0d\xe3\x89\xaf\x28\x94\x64\x4c\xa8\x10\xd9\x8d\xf9\xd9\x5f\x3b\x03\xd3\x17\x17\x9d\x33\x63\xf8\xb3\xea\x3f\x37\xd1\x36\xa8\xbf\xdc\xae\x27\x47\xdd\x37\x98\xb0\x6f\xb8\xe4\x0e\xe3\x21\x1b\xb7\x18\xbd\xa1\x46\xf1\x8a\x6b\x0c\xcb\xd8\x46\x48\xd1\x65\xdf\xef\x37\x54\x71\xad\x42\xe4\x5c\xd5\x94\x6a\x1a\xcd\x4a\x42\x05\xc4\xa6\x30\x43\xa5\x3d\xab\x06\xb8\x0e\x87\xd2\xfc\x0b\x1c\x8d\xdf\x9c\xfb\xfb\xf3\xe8\x85\xe1\x26\x99\x75\x6b\xc3\x94\xf5\x42\xac\x45\x5f\x3e\xb6\x4a\x9a\x75\x40\x39\x5f\x06\x7e\xa1\x82\xab\x08\x61\x1c\xae\xf6\x5a\x61\x11\x0e\x7d\xf5\x45\x03\x53\xda\xed\xd0\x9c\x4a\x0e\xb5\xc9\x9a\xae\xf7\xc3\x6b\xcb\x0c\x29\xf9\xd5\x6f\x08\x2e\x54\x70\xed\x37\x3f\x47\x25\x85\xad\x69\x79\x60\xb6\xda\x37\xfc\x38\x99\x53\xb4\xf6\xb2\x06\x33\x85\x5d\x9e\x32\x05\x06\x59\xec\xbf\x69\x14\xa8\x20\x54\x2c\x38\xf3\x4b\x9b\x18\x1d\x13\xd2\x02\xdb\xe8\xfc\xb4\x66\x14\x02\xed\xb0\xe6\xc1\x6e\xd5\x9e\x11\xda\x20\xb3\x7d\x55\xeb\xc4\xc0\x01\xb8\xba\xa4\x55\x06\x7e\x67\x0b\xdb\xbf\x4e\x96\xd3\x2a\xd4\x23\x4b\x51\x84\x8a\x36\x59\x89\x71\x1b\x3e\xea\x6d\x61\x6d\x72\xbc\x85\x3b\x26\x2d\xde\xc2\x57\xf5\xa8\xf4\xe1\x3a\x89\x2e\x1c\xce\xfd\x50\xae\xb7\x8d\xcd\x7e\x25\xd5\x15\x8c\xcf\x77\x84\xde\xec\xec\x59\x7f\x5c\xd1\x7b\xdf\x36\x4b\xad\xe7\xcf\xb3\x59\x8a\x45\x82\xf6\xf2\xed\xcf\x96\x09\x99\x9b\xf3\xdb\x9f\xbb\x00\x73\xea\xe2\x73\x0e\xee\x2f\x3c\xcf\x38\x89\xeb\x3d\x9a\xce\x2b\x56\x97\x50\xcb\x02\xbe\x7b\x96\x3f\x1f\x81\xd4\xad\x1d\xa6\x59\xcf\x75\xf0\xdc\xa2\xa8\x45\xe0\x0b\xfb\xf6\x6a\x1a\xfd\x8d\xf0\xd2\xfe\x75\x41\x33\xe8\xcf\x00\x0a\xf5\x42\x92\xf3\xa7\x5f\xd8\xb7\x5e\xa7\xf6\x4c\xc0\xae\x47\xb5\x4b\xd4\x3a\xa3\x52\xbf\x3a\xc3\x22\xfc\x3a\x0f\x42\xc0\x74\x1c\x75\x48\xd0\xab\x56\x82\x0a\x0d\x0d\x1c\xcb\xb7\x65\xde\xff\x60\x1b\xf2\xb6\xcc\x6b\xd7\xfd\x2a\x20\x57\x6f\xab\xb9\x2b\xd1\xdf\x56\x73\xd7\xb5\x95\xb7\xd5\x5c\x29\xdd\x9f\x64\x35\x27\xd2\x0e\x6f\xf5\x32\x79\xd1\xc7\xc6\x1d\xb3\xe7\x87\xcd\xda\x26\xc6\xff\x29\xeb\x34\x12\xfa\x89\x4b\xe6\x68\xa4\x78\xfb\xf4\x79\xcd\xa7\xcf\x10\x98\x0f\x46\xef\x45\x47\x0e\x35\xfb\x5b\x13\xf6\x05\x2e\x2a\xb8\x74\xfc\xdd\xe8\x2c\x5a\xf9\x77\xac\x8b\x11\xf6\x2f\x62\xd0\x91\x22\xad\x57\x25\x3d\xd8\xbf\x3f\xfe\xaa\xfe\x8a\x18\xfe\x71\xe7\x8f\xa0\xf8\xae\x86\xf1\x04\x9c\xa9\x2e\x8e\xd6\x69\x43\x39\x15\xde\xfd\x37\x00\x00\xff\xff\x81\xc4\xd3\x45\x23\x2d\x00\x00"),
 		},
+		"/operator-cluster-role-binding-priorityclasses.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "operator-cluster-role-binding-priorityclasses.yaml",
+			modTime:          time.Time{},
+			uncompressedSize: 1256,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xad\x53\x4d\x6f\xdb\x30\x0c\xbd\xfb\x57\x10\xe9\xa5\x05\x12\x67\xdd\x69\xc8\x4e\xee\x47\x36\x63\x85\x03\xc4\xe9\x8a\x1c\x69\x99\xb1\xb5\xc8\x92\x27\xc9\x75\xb3\x5f\x3f\xca\x71\xd6\x00\xc5\x06\x0c\xa8\x0f\x32\x24\x91\x8f\xef\x3d\x52\x17\x30\x7b\xbf\x2f\xba\x80\x07\x29\x48\x3b\x2a\xc1\x1b\xf0\x35\x41\xd2\xa2\xe0\x5f\x6e\x76\xbe\x47\x4b\xb0\x34\x9d\x2e\xd1\x4b\xa3\xe1\x32\xc9\x97\x57\xc0\x5b\xb2\x60\x34\x81\xb1\xd0\x18\x4b\x0c\x22\x8c\xf6\x56\x16\x9d\xe7\x23\x75\x04\x04\xac\x2c\x51\x43\xda\xbb\x18\x20\x27\x1a\xd0\xb3\xd5\x26\xbd\xbd\x87\x9d\x54\x04\xa5\x74\xc7\x24\x2e\xde\x4b\x5f\x33\x8e\xaf\xa5\x83\xde\xd8\x3d\xec\x18\x09\xcb\x52\x86\xc2\xa8\x40\x6a\x3e\x68\x8e\x34\x2c\x55\x68\x4b\xa9\x2b\x2e\xdb\x1e\xac\xac\x6a\x0f\xa6\xd7\x64\x5d\x2d\xdb\x98\x51\x36\x41\x46\xbe\x3c\x31\x71\x47\xd8\xa1\x26\x8b\xdc\x9a\x6e\xd4\x70\x26\x77\x74\x61\x0a\xdf\x19\x26\x14\xf9\x18\x7f\x60\xa4\xcb\x10\x32\x19\x2f\x27\x57\x9f\xe1\xc0\xc9\x0d\x1e\x40\x1b\x0f\x9d\xa3\x33\x64\x7a\x11\xd4\x7a\x26\xca\xac\x9a\x56\x49\xd4\x82\x5e\x65\xfd\xa9\xc0\x5e\x6c\x47\x0c\x53\x78\xe4\x70\x1c\x64\x80\xd9\x9d\x87\x01\xfa\xe8\x82\x33\x87\xaf\xf6\xbe\x5d\xcc\xe7\x7d\xdf\xc7\x38\xd0\x8d\x8d\xad\xe6\x27\x75\xf3\x07\x76\x34\xcb\xef\x67\x03\x65\xce\x79\xd4\x8a\x9c\x63\x9b\x7e\x76\xd2\xb2\xb7\xc5\x01\xb0\x65\x46\x02\x0b\xe6\xa9\xb0\x0f\x8d\x1b\xba\x33\x34\x9d\x29\xf4\x96\x7d\xd6\xd5\x14\xdc\xd8\x75\x46\x39\xef\xce\xab\x5d\x27\x7a\xac\xfa\x3c\x80\x0d\x43\x0d\x93\x24\x87\x34\x9f\xc0\x4d\x92\xa7\xf9\x94\x31\x9e\xd2\xcd\xd7\xd5\xe3\x06\x9e\x92\xf5\x3a\xc9\x36\xe9\x7d\x0e\xab\x35\xdc\xae\xb2\xbb\x74\x93\xae\x32\xde\x2d\x21\xc9\xb6\xf0\x2d\xcd\xee\xa6\x40\x6c\x16\x97\xa1\x97\xd6\x06\xfe\x4c\x52\x06\x23\xa9\x0c\x3d\x3d\x0d\xd0\x89\x40\x98\x8f\xb0\x77\x2d\x09\xb9\x93\x82\x75\xe9\xaa\xc3\x8a\xa0\x32\xcf\x64\x75\x18\x8f\x96\x6c\x23\x5d\x68\xa7\x63\x7a\x25\xa3\x28\xd9\x48\x3f\x4c\x91\x7b\x2b\x2a\x94\x79\xcf\xb7\x15\xed\xa5\x2e\x17\x70\xab\x3a\xe7\xc9\xae\x8d\xa2\x1b\x3e\x60\x62\x11\xb6\x72\x9c\xb3\x05\xd8\x02\x45\x8c\x9d\xaf\x8d\x95\xbf\x06\x6a\xf1\xfe\x93\x8b\xa5\x99\x3f\x5f\x17\xe4\xf1\x3a\x6a\x78\xe5\x17\x88\x8b\x08\x40\x63\x43\x0b\x10\xbc\xaa\xd9\x7e\x66\x58\x21\xf2\x9b\x9b\xb5\x56\x72\xba\x3f\x08\x85\x8e\x47\x82\x03\x15\x16\xa4\x5c\x48\x81\xd0\xfd\x05\x4c\xc6\xa4\x49\xe4\xba\xe2\x07\x09\xcf\x97\x33\x38\x52\xcc\xc9\x3e\xb3\x07\x89\x10\xfc\xd8\xfd\x5f\xcb\x44\x96\x35\xac\x69\x17\x50\xdf\x68\xfb\x1f\x72\xac\xff\x8b\x35\x5d\xfb\x0f\xf5\xd1\x6f\x4a\x3a\x47\x73\xe8\x04\x00\x00"),
+		},
+		"/operator-cluster-role-priorityclasses.yaml": &vfsgen۰CompressedFileInfo{
+			name:             "operator-cluster-role-priorityclasses.yaml",
+			modTime:          time.Time{},
+			uncompressedSize: 1177,
+
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xad\x53\xc1\x6e\xa3\x30\x10\xbd\xf3\x15\x23\x72\x69\xa5\x40\xb6\x7b\x5a\xd1\x13\xdb\x36\xbb\x68\x2b\x22\x85\x74\xab\x1e\x07\x98\x80\x15\x63\xb3\xb6\x29\xcd\x7e\xfd\x8e\x09\xd9\x46\xea\xb5\x1c\x8c\x6c\xcf\xbc\x79\xef\xcd\x78\x01\xd1\xe7\x7d\xc1\x02\x1e\x45\x45\xca\x52\x0d\x4e\x83\x6b\x09\xd2\x1e\x2b\xfe\x15\x7a\xef\x46\x34\x04\x6b\x3d\xa8\x1a\x9d\xd0\x0a\xae\xd2\x62\x7d\x0d\xbc\x25\x03\x5a\x11\x68\x03\x9d\x36\xc4\x20\x95\x56\xce\x88\x72\x70\x7c\x24\x4f\x80\x80\x8d\x21\xea\x48\x39\x1b\x03\x14\x44\x13\x7a\xbe\xd9\x65\x77\x0f\xb0\x17\x92\xa0\x16\xf6\x94\xc4\xc5\x47\xe1\x5a\xc6\x71\xad\xb0\x30\x6a\x73\x80\x3d\x23\x61\x5d\x0b\x5f\x18\x25\x08\xc5\x07\xdd\x89\x86\xa1\x06\x4d\x2d\x54\xc3\x65\xfb\xa3\x11\x4d\xeb\x40\x8f\x8a\x8c\x6d\x45\x1f\x33\xca\xce\xcb\x28\xd6\x67\x26\xf6\x04\x3b\xd5\x64\x91\x2f\x7a\x98\x35\x5c\xc8\x9d\x5d\x58\xc2\x6f\x86\xf1\x45\xbe\xc6\x5f\x18\xe9\xca\x87\x84\xf3\x65\x78\x7d\x0b\x47\x4e\xee\xf0\x08\x4a\x3b\x18\x2c\x5d\x20\xd3\x5b\x45\xbd\x63\xa2\xcc\xaa\xeb\xa5\x40\x55\xd1\xbb\xac\xff\x15\xd8\x8b\x97\x19\x43\x97\x0e\x39\x1c\x27\x19\xa0\xf7\x97\x61\x80\x2e\x58\x70\xe6\xf4\xb5\xce\xf5\xc9\x6a\x35\x8e\x63\x8c\x13\xdd\x58\x9b\x66\x75\x56\xb7\x7a\x64\x47\xf3\xe2\x21\x9a\x28\x73\xce\x93\x92\x64\x2d\xdb\xf4\x67\x10\x86\xbd\x2d\x8f\x80\x3d\x33\xaa\xb0\x64\x9e\x12\x47\xdf\xb8\xa9\x3b\x53\xd3\x99\xc2\x68\xd8\x67\xd5\x2c\xc1\xce\x5d\x67\x94\xcb\xee\xbc\xdb\x75\xa6\xc7\xaa\x2f\x03\xd8\x30\x54\x10\xa6\x05\x64\x45\x08\xdf\xd3\x22\x2b\x96\x8c\xf1\x9c\xed\x7e\x6e\x9e\x76\xf0\x9c\x6e\xb7\x69\xbe\xcb\x1e\x0a\xd8\x6c\xe1\x6e\x93\xdf\x67\xbb\x6c\x93\xf3\x6e\x0d\x69\xfe\x02\xbf\xb2\xfc\x7e\x09\xc4\x66\x71\x19\x7a\xeb\x8d\xe7\xcf\x24\x85\x37\x92\x6a\xdf\xd3\xf3\x00\x9d\x09\xf8\xf9\xf0\x7b\xdb\x53\x25\xf6\xa2\x62\x5d\xaa\x19\xb0\x21\x68\xf4\x2b\x19\xe5\xc7\xa3\x27\xd3\x09\xeb\xdb\x69\x99\x5e\xcd\x28\x52\x74\xc2\x4d\x53\x64\x3f\x8a\xf2\x65\x3e\xf3\x6d\x05\x07\xa1\xea\x04\xee\xe4\x60\x1d\x99\xad\x96\x14\x60\x2f\xe6\x01\x4b\xc0\x94\x58\xc5\x38\xb8\x56\x1b\xf1\x77\xe2\x14\x1f\xbe\xd9\x58\xe8\xd5\xeb\x4d\x49\x0e\x6f\x82\x8e\x57\x7e\x7a\x98\x04\x00\x0a\x3b\x4a\xa0\xe2\x55\x46\x87\x48\xb3\x34\xe4\xc7\x16\xf5\x46\x70\xba\x3b\x56\x12\x2d\xcf\x02\x07\x4a\x2c\x49\x5a\x9f\x02\xbe\xed\x09\x84\x73\x52\x18\x98\x81\x07\x23\x09\x22\x3e\x17\x3f\x8c\x1e\xfa\x29\x2c\x82\xd0\xf2\x4c\xd5\x83\x64\xcf\x66\x0a\x21\x9f\x73\x13\xf4\x60\x2a\x9a\x83\x3e\x56\x62\x9b\xcb\xf9\xb2\x21\x17\xfc\x03\x0b\x9b\x9f\x1e\x99\x04\x00\x00"),
+		},
 		"/operator-deployment.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-deployment.yaml",
 			modTime:          time.Time{},
@@ -196,37 +210,37 @@ var assets = func() http.FileSystem {
 		"/operator-role-kubernetes.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-kubernetes.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 2249,
+			uncompressedSize: 2582,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x54\xc1\x6e\x1b\x37\x10\xbd\xf3\x2b\x1e\xb4\x97\xa4\xb0\xe4\xa6\xa7\x42\x3d\xa9\x8e\xdd\x0a\x0d\x24\xc0\xab\x34\xc8\x71\x96\x3b\x5a\xb1\xe6\x72\x58\x92\x2b\xd9\xfd\xfa\x82\xd4\x2a\x91\xa3\x04\xe8\x21\xa8\xf7\xa2\x21\x77\xf6\xcd\x9b\xf7\x46\x53\x61\xfa\xfd\x1e\x55\xe1\x9d\xd1\xec\x22\xb7\x48\x82\xb4\x63\x2c\x3c\xe9\x1d\xa3\x96\x6d\x3a\x50\x60\xdc\xc9\xe0\x5a\x4a\x46\x1c\x5e\x2d\xea\xbb\xd7\x18\x5c\xcb\x01\xe2\x18\x12\xd0\x4b\x60\x55\x41\x8b\x4b\xc1\x34\x43\x92\x00\x7b\x04\x04\x75\x81\xb9\x67\x97\xe2\x0c\xa8\x99\x0b\xfa\x6a\xbd\x59\xde\xdc\x62\x6b\x2c\xa3\x35\xf1\xf8\x11\xb7\x38\x98\xb4\x53\x15\xd2\xce\x44\x1c\x24\x3c\x60\x2b\x01\xd4\xb6\x26\x17\x26\x0b\xe3\xb6\x12\xfa\x23\x8d\xc0\x1d\x85\xd6\xb8\x0e\x5a\xfc\x53\x30\xdd\x2e\x41\x0e\x8e\x43\xdc\x19\x3f\x53\x15\x36\xb9\x8d\xfa\xee\xc4\x24\x1e\x61\x4b\xcd\x24\xf8\x28\xc3\xd8\xc3\x59\xbb\xa3\x0a\x57\xf8\x93\x43\xcc\x45\x7e\x9a\xfd\xa8\x2a\xbc\xca\x29\x93\xf1\xe5\xe4\xf5\x2f\x78\x92\x01\x3d\x3d\xc1\x49\xc2\x10\xf9\x0c\x99\x1f\x35\xfb\x04\xe3\xa0\xa5\xf7\xd6\x90\xd3\xfc\xb9\xad\x4f\x15\x66\x28\x04\x32\x86\x34\x89\x8c\x03\x95\x36\x20\xdb\xf3\x34\x50\x52\x95\xaa\x50\x9e\x5d\x4a\x7e\x7e\x7d\x7d\x38\x1c\x66\x54\xe8\xce\x24\x74\xd7\xa7\xee\xae\xdf\x2d\x6f\x6e\x57\xf5\xed\xb4\x50\x56\x15\xde\x3b\xcb\x31\x22\xf0\xdf\x83\x09\xdc\xa2\x79\x02\x79\x6f\x8d\xa6\xc6\x32\x2c\x1d\xb2\x71\xc5\x9d\x62\xba\x71\x38\x04\x93\x8c\xeb\xae\x10\x47\xd7\x55\xf5\xcc\x9d\xcf\x72\x9d\xe8\x99\xf8\x2c\x41\x1c\xc8\x61\xb2\xa8\xb1\xac\x27\xf8\x75\x51\x2f\xeb\x2b\x55\xe1\xc3\x72\xf3\xfb\xfa\xfd\x06\x1f\x16\xf7\xf7\x8b\xd5\x66\x79\x5b\x63\x7d\x8f\x9b\xf5\xea\xed\x72\xb3\x5c\xaf\x6a\xac\xef\xb0\x58\x7d\xc4\x1f\xcb\xd5\xdb\x2b\xb0\x49\x3b\x0e\xe0\x47\x1f\x32\x7f\x09\x30\x59\x48\x6e\xb3\xa7\xa7\x01\x3a\x11\xc8\xf3\x91\xcf\xd1\xb3\x36\x5b\xa3\x61\xc9\x75\x03\x75\x8c\x4e\xf6\x1c\x5c\x1e\x0f\xcf\xa1\x37\x31\xdb\x19\x41\xae\x55\x15\xac\xe9\x4d\x2a\x53\x14\x2f\x9b\xca\x65\xbe\xe7\x7f\x4b\x3d\x18\xd7\xce\x71\x2f\x96\x15\x79\x33\x4e\xd6\x1c\xa1\x21\x3d\xa3\x21\xed\x24\x98\x7f\x0a\x99\xd9\xc3\xcf\x71\x66\xe4\x7a\xff\xa6\xe1\x44\x6f\x54\xcf\x89\x5a\x4a\x34\x57\x80\xa3\x9e\xe7\xd0\xd4\xb3\x9d\x3e\x4c\xc5\x73\xa0\x24\x41\x01\x96\x1a\xb6\x31\xa7\x20\xfb\x3b\xc7\x64\x4c\x9a\xa8\x30\x58\x8e\x73\x35\x05\x79\xf3\x5b\x90\xc1\x97\xb4\xe9\x11\xe5\x6c\x86\x14\x10\x38\xca\x10\x34\x8f\x19\x93\x1f\x26\x0a\xd8\x73\x68\xce\x2e\x2e\x70\x26\x93\xcb\x2f\xbd\xb4\xb1\x04\x91\xc3\xde\x68\x3e\x1e\xd8\xb5\x5e\x8c\x4b\xc7\x93\xcf\x12\xc4\xc4\x2e\xed\xc5\x0e\x3d\x6b\x4b\xa6\x3f\xbe\xd2\xe2\xb6\xa6\xeb\xc9\x9f\x40\x74\xe0\xf4\x0c\x90\xb4\x96\xe1\x88\x74\xc6\x4f\x07\xa6\xc4\x25\x6c\xd9\xf2\xb3\x50\x8b\xb5\xac\xb3\xc0\xe5\xb2\xe3\x54\x7e\xad\x89\xc7\xc0\x53\xd2\xbb\x12\x0d\xbe\x3d\xa1\x1c\xca\xe5\x45\xcb\xdf\x34\xed\x52\x89\x20\x76\xec\x3e\x47\x8d\x71\x79\x57\xbd\x10\xed\xaf\x39\xc5\x7b\xbe\x90\xf1\xa2\xc8\x37\xf0\xc8\x17\x83\xbe\x40\x6c\xd9\x5b\x79\xea\xf9\xe4\x73\xe0\xb2\x6e\xe2\x27\x07\x13\x25\xde\x0e\x76\xbc\x78\x01\x1d\x9a\x31\xf7\x0b\xe2\x3a\x88\xfb\x4b\x9a\x17\x22\x35\x8a\x49\x69\xdc\xa3\xf7\x9c\x37\x6a\x01\x8f\x73\xb8\xc1\xda\xaf\x48\x4d\xdc\x8b\xbb\x14\xf2\xbf\x1a\xc8\x8f\x89\x5d\x59\x89\x97\xd8\xc6\x75\x79\xf3\xf2\xff\x23\xc7\xbf\x01\x00\x00\xff\xff\x25\x6a\xb3\x25\xc9\x08\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc5\x55\xc1\x8e\xdb\x36\x10\xbd\xfb\x2b\x06\xda\x4b\x52\xac\xed\xa6\xa7\xc2\x3d\x39\xc9\x6e\x6b\x34\xb0\x01\xcb\x69\xb0\x47\x8a\x1a\xcb\xec\x52\xa4\x4a\x52\xd6\xba\x5f\x9f\x47\x4a\xde\x38\xd5\xe6\x52\x04\xb1\x0e\x12\x39\x1a\xbe\x79\xf3\x66\x48\xde\xd0\xf4\xfb\x3d\x93\x1b\xfa\xa0\x24\x1b\xcf\x25\x05\x4b\xe1\xc0\xb4\x6c\x84\xc4\x27\xb7\xfb\xd0\x09\xc7\x74\x6f\x5b\x53\x8a\xa0\xac\xa1\x57\xcb\xfc\xfe\x35\x61\xca\x8e\xac\x61\xb2\x8e\x6a\xeb\x18\x20\xd2\x9a\xe0\x54\xd1\x06\x98\x74\x0f\x48\xa2\x72\xcc\x35\x9b\xe0\x67\x44\x39\x73\x42\x5f\x6f\x76\xab\x77\x77\xb4\x57\x9a\xa9\x54\xbe\x5f\x84\xe0\x9d\x0a\x07\xe0\x84\x83\xf2\xd4\x59\xf7\x48\x7b\x20\x89\xb2\x54\x31\xb0\xd0\xa4\x0c\x0c\x75\x4f\xc3\x71\x25\x5c\xa9\x4c\x85\xb0\xcd\xc9\xa9\xea\x10\xc8\x76\x86\x9d\x3f\xa8\x66\x06\x94\x5d\x4c\x23\xbf\x3f\x33\xf1\x3d\x6c\x8a\x89\x24\x1f\x6c\x3b\xe4\x70\x91\xee\xa0\xc2\x2d\xfd\x05\x98\x18\xe4\x97\xd9\xcf\x40\x7a\x15\x5d\xb2\xe1\x67\xf6\xfa\x37\x3a\x61\x71\x2d\x4e\x64\x6c\xa0\xd6\xf3\x05\x32\x3f\x49\x6e\x02\x88\x82\x55\xdd\x68\x25\x8c\xe4\x2f\x69\x3d\x47\x80\x16\x0f\x03\x86\x2d\x82\x80\xbb\x48\x69\x90\xdd\x5f\xba\x91\x08\x93\x1b\xac\x4c\xcf\x21\x84\x66\x31\x9f\x77\x5d\x37\x13\x89\xee\xcc\xba\x6a\x7e\xce\x6e\xfe\x01\x8a\xae\xf3\xbb\x69\xa2\x8c\x35\x1f\x8d\x66\xef\x21\xd3\x3f\xad\x72\xd0\xb6\x38\x91\x68\xc0\x48\x8a\x02\x3c\xb5\xe8\x62\xe1\x52\x75\x52\xd1\x41\xa1\x73\xd0\xd9\x54\xb7\xe4\x87\xaa\x03\xe5\xb2\x3a\x5f\xe4\x3a\xd3\x43\xd6\x97\x0e\x10\x4c\x18\xca\x96\x39\xad\xf2\x8c\xde\x2e\xf3\x55\x7e\x0b\x8c\x4f\xab\xdd\x1f\x9b\x8f\x3b\xfa\xb4\xdc\x6e\x97\xeb\xdd\xea\x2e\xa7\xcd\x96\xde\x6d\xd6\xef\x57\xbb\xd5\x66\x8d\xd9\x3d\x2d\xd7\x0f\xf4\xe7\x6a\xfd\xfe\x96\x18\x62\x21\x0c\x3f\x35\x2e\xf2\x07\x49\x15\x85\xe4\x32\xd6\xf4\xdc\x40\x67\x02\xb1\x3f\xe2\xdc\x37\x2c\xd5\x5e\x49\xe4\x65\xaa\x56\x54\x4c\x95\x3d\xb2\x33\xb1\x3d\x1a\x76\xb5\xf2\xb1\x9c\x1e\xf4\x4a\xa0\x68\x55\xab\x90\xba\xc8\x8f\x93\x8a\x61\xbe\xe7\xde\x9a\x3c\x2a\x53\x2e\x68\x6b\x35\x4f\x44\xa3\x86\xce\x5a\x90\x2b\x84\x9c\x89\x36\x1c\xac\x53\xff\x26\x32\xb3\xc7\x5f\xfd\x4c\xd9\xf9\xf1\x4d\xc1\x41\xbc\x99\xd4\x78\x63\xcf\x89\xc5\x84\xc8\x88\x9a\x17\x24\xf1\xd6\xd3\xc7\xa9\x45\x4e\x02\xbb\x0c\x3f\xb4\x28\x58\xfb\xe8\x42\xb1\xbe\x0b\xca\x06\xa7\x6c\xe2\x5a\x74\xc0\x62\x32\x85\x5d\xfd\xee\x6c\xdb\x24\xb7\x69\x8f\x72\xd1\x43\x30\x42\x6a\xdb\x3a\xc9\x83\x47\xf6\x53\x86\x2f\x04\x2c\x2e\x0c\x23\x9c\x2c\x1b\xaf\x6c\x6c\xe9\xd3\xc0\xb3\x3b\x42\xd0\x7e\xc2\xa6\x6c\xac\xc2\x19\xd0\xfb\x44\x09\x7c\xc0\x99\x70\xb4\xba\xad\x59\x6a\xa1\xea\xfe\x17\x4e\x90\xbd\xaa\x6a\xd1\x9c\x41\xa4\xe3\xf0\x15\xa0\x90\x12\x47\x51\xb2\x5d\xf0\x83\x9b\x08\x9c\x86\x25\x6b\xfe\x6a\x28\xad\xd6\x2c\xa3\xc0\xc9\x58\x71\x48\x5f\x0d\x0a\x3d\x1d\x11\xe4\x21\x8d\xda\xa6\x3c\xa3\x74\xc9\x38\x4a\xf9\x9b\x45\x1b\x2b\xe1\x50\x70\xff\x3c\x2a\xd0\x04\x68\xc6\x2b\xd1\x7e\xa9\x52\x7c\xe4\x91\x8c\xa3\x20\xdf\xc0\x43\xa3\xf9\x31\x62\xc9\x8d\xb6\xa7\x9a\xcf\x75\x76\x9c\x8e\x1b\xff\x5c\x41\xec\x39\xde\xb7\x7a\x30\x5c\x41\x87\x62\xf0\xfd\x0f\x71\xe9\xac\xf9\xdb\x16\x57\x22\xd5\x58\xa8\x74\x7a\x71\x2b\xe1\x70\x75\x6d\x13\xc3\x14\x6d\x59\x5d\x4d\x36\x34\xbc\xf5\x52\x68\x34\xf0\x98\x66\xda\x0a\xb8\xf8\x85\x06\xe1\xb3\x27\x76\xf8\x95\xa8\xf6\x9d\x29\xc2\x70\x29\x6d\x39\x5e\x4f\x09\xdc\x2f\xc8\xb4\x5a\xbf\xd0\xb7\x82\x6b\xfc\xe6\xff\xbb\x1b\xf8\x09\x67\x59\xba\x5f\xc6\xd8\x90\x2c\x5e\x63\xfc\x63\xe4\xf8\x0c\x4e\x8a\xcb\x43\x16\x0a\x00\x00"),
 		},
 		"/operator-role-olm-cluster.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-olm-cluster.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 1321,
+			uncompressedSize: 1406,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xc1\x72\xdb\x36\x10\xbd\xe3\x2b\xde\x88\x87\x24\x1d\x8b\x6a\x7a\xea\xa8\x27\xd5\xb1\x5b\x4e\x33\xd2\x8c\xa9\x34\x93\x23\x44\xae\xc8\x1d\x83\x58\x74\x01\x9a\x71\xbf\xbe\x03\x4a\x6a\xac\xf1\xd5\xb8\x70\x09\x3e\xee\xbe\x87\xf7\x50\x60\xf9\x76\xcb\x14\xf8\xcc\x0d\xf9\x48\x2d\x92\x20\xf5\x84\x4d\xb0\x4d\x4f\xa8\xe5\x98\x26\xab\x84\x7b\x19\x7d\x6b\x13\x8b\xc7\xfb\x4d\x7d\xff\x01\xa3\x6f\x49\x21\x9e\x20\x8a\x41\x94\x4c\x81\x46\x7c\x52\x3e\x8c\x49\x14\xee\xd4\x10\xb6\x53\xa2\x81\x7c\x8a\x25\x50\x13\xcd\xdd\xb7\xbb\x7d\x75\x7b\x87\x23\x3b\x42\xcb\xf1\xf4\x13\xb5\x98\x38\xf5\xa6\x40\xea\x39\x62\x12\x7d\xc4\x51\x14\xb6\x6d\x39\x0f\xb6\x0e\xec\x8f\xa2\xc3\x89\x86\x52\x67\xb5\x65\xdf\xa1\x91\xf0\xac\xdc\xf5\x09\x32\x79\xd2\xd8\x73\x28\x4d\x81\x7d\x96\x51\xdf\x5f\x98\xc4\x53\xdb\x79\x66\x12\x7c\x93\xf1\xac\xe1\x85\xdc\xf3\x29\xdc\xe0\x6f\xd2\x98\x87\xfc\x52\xfe\x6c\x0a\xbc\xcf\x90\xc5\xf9\xe3\xe2\xc3\x6f\x78\x96\x11\x83\x7d\x86\x97\x84\x31\xd2\x8b\xce\xf4\xbd\xa1\x90\xc0\x1e\x8d\x0c\xc1\xb1\xf5\x0d\xfd\x90\xf5\xff\x84\x12\x33\x81\xdc\x43\x0e\xc9\xb2\x87\x9d\x65\x40\x8e\x2f\x61\xb0\xc9\x14\xa6\xc0\xbc\xfa\x94\xc2\x7a\xb5\x9a\xa6\xa9\xb4\x33\xdd\x52\xb4\x5b\x5d\xd4\xad\x3e\x57\xb7\x77\xdb\xfa\x6e\x39\x53\x36\x05\xbe\x78\x47\x31\x42\xe9\x9f\x91\x95\x5a\x1c\x9e\x61\x43\x70\xdc\xd8\x83\x23\x38\x3b\x65\xe3\x66\x77\x66\xd3\xd9\x63\x52\x4e\xec\xbb\x1b\xc4\xb3\xeb\xa6\xb8\x72\xe7\xc7\x71\x5d\xe8\x71\xbc\x02\x88\x87\xf5\x58\x6c\x6a\x54\xf5\x02\xbf\x6f\xea\xaa\xbe\x31\x05\xbe\x56\xfb\x3f\x77\x5f\xf6\xf8\xba\x79\x78\xd8\x6c\xf7\xd5\x5d\x8d\xdd\x03\x6e\x77\xdb\x4f\xd5\xbe\xda\x6d\x6b\xec\xee\xb1\xd9\x7e\xc3\x5f\xd5\xf6\xd3\x0d\x88\x53\x4f\x0a\xfa\x1e\x34\xf3\x17\x05\xe7\x83\xa4\x36\x7b\x7a\x09\xd0\x85\x40\xce\x47\x7e\x8f\x81\x1a\x3e\x72\x03\x67\x7d\x37\xda\x8e\xd0\xc9\x13\xa9\xcf\xf1\x08\xa4\x03\xc7\x6c\x67\x84\xf5\xad\x29\xe0\x78\xe0\x34\xa7\x28\xbe\x16\x95\xc7\xbc\xe5\xdd\x32\x8f\xec\xdb\x35\x6e\xdd\x18\x13\xe9\x83\x38\x32\x36\xf0\x39\x60\x6b\xe8\xc1\x36\xa5\x1d\x53\x2f\xca\xff\xce\x9c\xca\xc7\x5f\x63\xc9\xb2\x7a\xfa\x78\xa0\x64\x3f\x9a\x81\x92\x6d\x6d\xb2\x6b\x03\x78\x3b\xd0\x1a\x8d\x1d\xc8\x2d\x1f\x97\x12\x48\x6d\x12\x35\x80\xb3\x07\x72\x31\x43\x90\x6d\x5e\x63\x71\x06\x2d\x8c\x8e\x8e\xe2\xda\x2c\x61\x03\xff\xa1\x32\x86\x19\xb6\xcc\xb7\x35\x8a\xa3\x52\x02\xf9\xd8\xf3\x31\x95\x2c\x06\x50\x8a\x32\x6a\x43\xd7\xa8\xc6\x71\x2b\x93\x77\x62\xdb\x68\x80\x27\xd2\xc3\x05\xa0\x64\x13\xcd\x65\x4b\x8e\xae\xca\x46\x9c\xa3\x26\x8b\x9a\x37\x3b\x4a\xf3\xd3\x71\x3c\x15\xc1\xa6\xa6\x9f\xab\x31\xb4\x97\x2e\xd3\xbc\xf9\x9a\x6e\xd6\xf3\x22\xf9\xaf\xa9\xbe\xfb\xe9\xdd\x35\xb5\xbc\xf1\x5f\x00\x00\x00\xff\xff\x8f\x77\x93\x45\x29\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xad\x53\x4d\x6f\xdb\x30\x0c\xbd\xfb\x57\x10\xe9\xa1\xed\x90\x38\xeb\x4e\x43\x76\xca\xda\x66\x33\x56\x24\x40\x9c\xae\xe8\x91\xb6\x19\x5b\x88\x2c\x69\x92\x5c\x37\xfb\xf5\xa3\x14\x67\x4d\x91\x6b\x7d\xb0\x25\x9a\x7c\x7c\x8f\x1f\x17\x30\xf9\xb8\x27\xb9\x80\x07\x51\x92\x72\x54\x81\xd7\xe0\x1b\x82\xb9\xc1\x92\x3f\xb9\xde\xfa\x1e\x2d\xc1\x42\x77\xaa\x42\x2f\xb4\x82\xab\x79\xbe\xb8\x06\xbe\x92\x05\xad\x08\xb4\x85\x56\x5b\x62\x90\x52\x2b\x6f\x45\xd1\x79\x36\xc9\x03\x20\x60\x6d\x89\x5a\x52\xde\xa5\x00\x39\x51\x44\x5f\xae\x36\xd9\xed\x3d\x6c\x85\x24\xa8\x84\x3b\x04\x71\xf2\x5e\xf8\x86\x71\x7c\x23\x1c\xf4\xda\xee\x60\xcb\x48\x58\x55\x22\x24\x46\x09\x42\xb1\xa1\x3d\xd0\xb0\x54\xa3\xad\x84\xaa\x39\xad\xd9\x5b\x51\x37\x1e\x74\xaf\xc8\xba\x46\x98\x94\x51\x36\x41\x46\xbe\x38\x32\x71\x07\xd8\x98\x93\x45\x3e\xeb\x6e\xd0\x70\x22\x77\xa8\xc2\x18\x7e\x33\x4c\x48\xf2\x25\xfd\xcc\x48\x57\xc1\x65\x34\xfc\x1c\x5d\x7f\x83\x3d\x07\xb7\xb8\x07\xa5\x3d\x74\x8e\x4e\x90\xe9\xb5\x24\xe3\x99\x28\xb3\x6a\x8d\x14\xa8\x4a\x7a\x93\xf5\x3f\x03\xd7\xe2\x79\xc0\xd0\x85\x47\x76\xc7\x28\x03\xf4\xf6\xd4\x0d\xd0\x27\x17\x1c\x19\x9f\xc6\x7b\x33\x9b\x4e\xfb\xbe\x4f\x31\xd2\x4d\xb5\xad\xa7\x47\x75\xd3\x07\xae\xe8\x32\xbf\x9f\x44\xca\x1c\xf3\xa8\x24\x39\xc7\x65\xfa\xd3\x09\xcb\xb5\x2d\xf6\x80\x86\x19\x95\x58\x30\x4f\x89\x7d\x68\x5c\xec\x4e\x6c\x3a\x53\xe8\x2d\xd7\x59\xd5\x63\x70\x43\xd7\x19\xe5\xb4\x3b\x6f\xe5\x3a\xd2\x63\xd5\xa7\x0e\x5c\x30\x54\x30\x9a\xe7\x90\xe5\x23\xf8\x3e\xcf\xb3\x7c\xcc\x18\x4f\xd9\xe6\xe7\xea\x71\x03\x4f\xf3\xf5\x7a\xbe\xdc\x64\xf7\x39\xac\xd6\x70\xbb\x5a\xde\x65\x9b\x6c\xb5\xe4\xdb\x02\xe6\xcb\x67\xf8\x95\x2d\xef\xc6\x40\x5c\x2c\x4e\x43\xaf\xc6\x06\xfe\x4c\x52\x84\x42\x52\x15\x7a\x7a\x1c\xa0\x23\x81\x30\x1f\xe1\xee\x0c\x95\x62\x2b\x4a\xd6\xa5\xea\x0e\x6b\x82\x5a\xbf\x90\x55\x61\x3c\x0c\xd9\x56\xb8\xd0\x4e\xc7\xf4\x2a\x46\x91\xa2\x15\x3e\x4e\x91\x3b\x17\x15\xd2\x7c\xe4\x6e\x25\x3b\xa1\xaa\x19\xdc\xca\xce\x79\xb2\x6b\x2d\x29\x41\x23\x86\x01\x9b\x81\x2d\xb0\x4c\xb1\xf3\x8d\xb6\xe2\x6f\xe4\x94\xee\xbe\xba\x54\xe8\xe9\xcb\x4d\x41\x1e\x6f\x92\x96\xdf\xbc\x7a\x38\x4b\x00\x14\xb6\x34\x83\x92\xdf\x72\xb2\x9b\x68\x96\x86\xbc\x6c\xfc\x43\x62\x41\xd2\x05\x17\x08\x6d\x9e\xc1\x68\x70\x1a\x25\xb6\xe3\x41\x98\x25\x13\xb6\x8b\x1f\x56\x77\x26\xba\x4d\xc2\xb6\x3a\x66\x93\x32\x8a\xe2\x9d\xd9\x7a\x4e\xca\x3f\xb8\xea\xba\xb3\x25\xbd\xf7\x2a\xa5\xa8\x78\xbb\xa4\xc6\xca\xb1\x9d\x6b\x5b\x1c\x1d\x2c\xa1\xa7\x78\xac\x48\xd2\xbb\x63\xa9\x25\x87\x06\x51\xd1\x58\x93\x8f\x5f\xc9\x33\x13\x0f\x06\x7d\xd9\xc4\x53\x67\xaa\x23\x4a\x1f\x8d\xe7\x74\x83\x9e\x93\xc9\x3f\xa7\x7a\xf9\xe9\xf2\x3d\xb5\x60\x38\xc3\x71\x1c\x5f\x75\x92\x27\x63\x28\xf4\x39\x90\xb1\x82\xbb\xe1\xf7\xa5\x44\xc7\xab\xf5\x1e\x34\x88\xf8\x07\x9e\xd2\x73\x9f\x7e\x05\x00\x00"),
 		},
 		"/operator-role-olm.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-olm.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 3856,
+			uncompressedSize: 4205,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\xc1\x8e\xdb\x36\x10\xbd\xeb\x2b\x06\xf6\x25\x29\xd6\x72\xd3\x53\xe1\x9e\xdc\x64\xb7\x35\x1a\x78\x81\xf5\xa6\x41\x8e\x23\x6a\x2c\x4d\x97\xe4\xb0\x24\x65\xc7\xfd\xfa\x82\xb4\x9c\xc8\xd1\x6e\x37\x05\x82\xba\xbe\x98\x22\xc7\x6f\xde\xbc\x37\x1a\x73\x0a\xb3\x6f\xf7\x29\xa6\xf0\x96\x15\xd9\x40\x35\x44\x81\xd8\x12\x2c\x1d\xaa\x96\x60\x23\xdb\xb8\x47\x4f\x70\x23\x9d\xad\x31\xb2\x58\x78\xb1\xdc\xdc\xbc\x84\xce\xd6\xe4\x41\x2c\x81\x78\x30\xe2\xa9\x98\x82\x12\x1b\x3d\x57\x5d\x14\x0f\xfa\x08\x08\xd8\x78\x22\x43\x36\x86\x12\x60\x43\x94\xd1\xd7\xb7\xf7\xab\xd7\xd7\xb0\x65\x4d\x50\x73\x38\xfe\x88\x6a\xd8\x73\x6c\x8b\x29\xc4\x96\x03\xec\xc5\x3f\xc0\x56\x3c\x60\x5d\x73\x4a\x8c\x1a\xd8\x6e\xc5\x9b\x23\x0d\x4f\x0d\xfa\x9a\x6d\x03\x4a\xdc\xc1\x73\xd3\x46\x90\xbd\x25\x1f\x5a\x76\x65\x31\x85\xfb\x54\xc6\xe6\xe6\xc4\x24\x1c\x61\x73\xce\x28\xf0\x41\xba\xbe\x86\x41\xb9\xbd\x0a\x57\xf0\x3b\xf9\x90\x92\xfc\x50\x7e\x5f\x4c\xe1\x45\x0a\x99\xf4\x87\x93\x97\x3f\xc1\x41\x3a\x30\x78\x00\x2b\x11\xba\x40\x03\x64\xfa\xa8\xc8\x45\x60\x0b\x4a\x8c\xd3\x8c\x56\xd1\xe7\xb2\x3e\x65\x28\x21\x13\x48\x18\x52\x45\x64\x0b\x98\xcb\x00\xd9\x0e\xc3\x00\x63\x31\x2d\xa6\x90\x3f\x6d\x8c\x6e\x31\x9f\xef\xf7\xfb\x12\x33\xdd\x52\x7c\x33\x3f\x55\x37\x7f\xbb\x7a\x7d\xbd\xde\x5c\xcf\x32\xe5\x62\x0a\xef\xac\xa6\x10\xc0\xd3\x9f\x1d\x7b\xaa\xa1\x3a\x00\x3a\xa7\x59\x61\xa5\x09\x34\xee\x93\x71\xd9\x9d\x6c\x3a\x5b\xd8\x7b\x8e\x6c\x9b\x2b\x08\xbd\xeb\xc5\xf4\xcc\x9d\xcf\x72\x9d\xe8\x71\x38\x0b\x10\x0b\x68\x61\xb2\xdc\xc0\x6a\x33\x81\x9f\x97\x9b\xd5\xe6\xaa\x98\xc2\xfb\xd5\xfd\xaf\xb7\xef\xee\xe1\xfd\xf2\xee\x6e\xb9\xbe\x5f\x5d\x6f\xe0\xf6\x0e\x5e\xdf\xae\xdf\xac\xee\x57\xb7\xeb\x0d\xdc\xde\xc0\x72\xfd\x01\x7e\x5b\xad\xdf\x5c\x01\x71\x6c\xc9\x03\x7d\x74\x3e\xf1\x17\x0f\x9c\x84\xa4\x3a\x79\x7a\x6a\xa0\x13\x81\xd4\x1f\xe9\x39\x38\x52\xbc\x65\x05\x1a\x6d\xd3\x61\x43\xd0\xc8\x8e\xbc\x4d\xed\xe1\xc8\x1b\x0e\xc9\xce\x00\x68\xeb\x62\x0a\x9a\x0d\xc7\xdc\x45\x61\x5c\x54\x4a\xf3\x2d\xdf\xad\xe2\x81\x6d\xbd\x80\x3b\xd1\x54\xa0\xe3\xbe\xb3\x16\xe0\x2b\x54\x25\x76\xb1\x15\xcf\x7f\x65\x32\xe5\xc3\x8f\xa1\x64\x99\xef\x5e\x55\x14\xf1\x55\x61\x28\x62\x8d\x11\x17\x05\x80\x45\x43\x0b\x50\x68\x48\xcf\x1e\x66\xe2\xc8\x63\x14\x5f\x00\x68\xac\x48\x87\x14\x02\xc9\xdf\x05\x4c\xfa\xa0\x49\xe1\x3b\x4d\x61\x51\xcc\x00\x1d\xff\xe2\xa5\x73\x39\x6c\x76\x44\x19\xf4\x50\x01\xe0\x29\x48\xe7\x15\xf5\x11\x93\xef\x26\x05\xc0\x8e\x7c\x35\xd8\x18\xe1\x4c\x26\xe3\x5f\x3a\xa9\x43\x5e\x04\xf2\x3b\x56\x74\x7c\x20\x5b\x3b\x61\x1b\x8f\x4f\x2e\x49\x10\x22\xd9\xb8\x13\xdd\x19\x52\x1a\xd9\x1c\x8f\x94\xd8\x2d\x37\x06\xdd\x09\x44\x79\x8a\x67\x80\xa8\x94\x74\x47\xa4\x01\x3f\xe5\x09\x23\xe5\x65\x4d\x9a\xce\x96\x4a\xb4\x26\x95\x04\xce\x9b\x0d\xc5\xfc\xad\x39\x1c\x17\x0e\xa3\x6a\xf3\xaa\x73\xf5\x09\x65\x9f\x37\x47\x25\x3f\x69\xda\x58\x09\x2f\xba\xaf\x3e\xad\x2a\xb6\x69\x56\x5d\x88\xf6\x63\x4e\xd1\x8e\xfe\x49\xc6\xcf\xf0\xa3\xcc\x4f\x24\x41\x97\x5d\xfb\x22\x4d\x4d\x4e\xcb\xc1\xd0\xc9\x7c\x4f\x79\x06\x85\x4f\xb6\x46\x8c\xb4\xed\x74\xbf\x71\x01\x71\xaa\x3e\xf6\x0b\xe2\xca\x8b\xfd\x43\xaa\x0b\x91\xea\xc5\xc4\xd8\x0f\xd7\x3b\x4a\x63\x36\x83\x87\x05\xd8\x4e\xeb\x47\xa4\x46\x32\x62\xc7\x42\x7e\xad\x81\xf4\x31\x92\xcd\x73\x72\x8c\xcd\xb6\x49\xe3\x98\x2e\xd9\xc0\x33\x98\x54\x1d\xeb\xba\x14\x47\x36\xb4\xbc\x8d\x25\xcb\x23\x9d\x9d\x83\x8e\x93\x24\x8c\x36\xe6\x7b\xaa\x5a\x91\x87\xc1\xc9\x85\x6b\x62\x83\x0d\x3d\x57\x53\x0e\x0a\xd1\x13\x9a\xe3\xf2\xcb\x5d\x83\xce\xf5\x03\xe6\x6c\x7f\xbc\x31\x1f\x4e\xd5\xc1\x41\xc4\x8b\x8e\xa7\xde\x8c\x33\x21\xfe\xed\x1b\x70\x66\x34\xdb\x10\xd1\x46\x3e\x25\x7f\xea\xb0\x62\x8b\xfe\x30\x68\x87\xb9\xd2\x62\xe9\x51\x29\x9e\x34\xd1\x4b\x17\x9f\x35\x31\x07\x5d\x56\xe3\x31\xcf\xa7\x68\xce\x55\x17\xa2\x98\x59\x2b\x39\xdb\x57\x68\x91\xff\xa1\x6d\x53\x3e\x58\x8c\xbc\xa3\xb2\xa6\xdd\x18\x7c\x70\x2f\xb8\x80\x0a\xf9\x4f\x6f\xcc\x71\x06\x86\x42\xc0\xe6\x59\xf6\xa3\x8b\xd1\xff\xf0\xe2\xa1\x74\x17\x22\xf9\xd3\xfd\x63\x40\x36\x5d\x42\x06\xf1\x6b\x34\x9f\x5c\x39\x84\x48\x66\x91\xa7\xc1\x2c\xbf\x05\xe4\xc7\x24\x8c\x58\x8e\xe2\x93\x4a\x4a\x3c\x49\x28\x95\x98\x27\x2d\xee\xa3\xff\x1b\xa7\xff\x0e\x00\x00\xff\xff\xf6\x75\x9c\xad\x10\x0f\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcd\x57\xc1\x72\xdb\x36\x10\xbd\xeb\x2b\x76\xe4\x4b\xd2\xb1\xa4\xa6\xa7\x8e\x7a\x52\x13\xbb\xd5\x34\x23\xcf\x58\x4a\x33\x3e\x82\xe0\x8a\x42\x05\x02\x2c\x00\x8a\x56\xbf\xbe\x8f\x20\x69\xd3\xa1\x54\xa7\x33\xe9\xa8\x3a\x88\xe4\x62\xb9\xfb\xf6\xed\x62\xb1\xbc\xa2\xc9\xb7\xfb\x8d\xae\xe8\xa3\x92\x6c\x3c\xa7\x14\x2c\x85\x1d\xd3\xa2\x10\x12\x97\xb5\xdd\x86\x4a\x38\xa6\x5b\x5b\x9a\x54\x04\x65\x0d\xbd\x59\xac\x6f\xdf\x12\x1e\xd9\x91\x35\x4c\xd6\x51\x6e\x1d\xc3\x88\xb4\x26\x38\x95\x94\x01\x22\xdd\x18\x24\x91\x39\xe6\x9c\x4d\xf0\x53\xa2\x35\x73\xb4\xbe\xba\xdb\x2c\xdf\xdf\xd0\x56\x69\xa6\x54\xf9\xe6\x25\x38\xaf\x54\xd8\xc1\x4e\xd8\x29\x4f\x95\x75\x7b\xda\xc2\x92\x48\x53\x55\x3b\x16\x9a\x94\x81\x20\x6f\x60\x38\xce\x84\x4b\x95\xc9\xe0\xb6\x38\x3a\x95\xed\x02\xd9\xca\xb0\xf3\x3b\x55\x4c\x61\x65\x53\x87\xb1\xbe\xed\x90\xf8\xc6\x6c\xf4\x89\x20\x1f\x6c\xd9\xc6\xd0\x0b\xb7\x65\xe1\x9a\x7e\x87\x99\xda\xc9\x0f\xd3\xef\x61\xe9\x4d\xad\x32\x6e\x17\xc7\x6f\x7f\xa2\x23\x5e\xce\xc5\x91\x8c\x0d\x54\x7a\xee\x59\xe6\x47\xc9\x45\x00\x50\xa0\xca\x0b\xad\x84\x91\xfc\x1c\xd6\x93\x07\x70\xf1\xd0\xda\xb0\x49\x10\x50\x17\x31\x0c\xb2\xdb\xbe\x1a\x89\x30\xba\xc2\x9b\xf1\xb7\x0b\xa1\x98\xcf\x66\x55\x55\x4d\x45\x84\x3b\xb5\x2e\x9b\x75\xd1\xcd\x3e\x82\xd1\xd5\xfa\x66\x12\x21\xe3\x9d\x4f\x46\xb3\xf7\xa0\xe9\xcf\x52\x39\x70\x9b\x1c\x49\x14\x40\x24\x45\x02\x9c\x5a\x54\x75\xe2\x62\x76\x62\xd2\x01\xa1\x72\xe0\xd9\x64\xd7\xe4\xdb\xac\xc3\x4a\x3f\x3b\xcf\x74\x75\xf0\x10\x75\x5f\x01\x84\x09\x43\xe3\xc5\x9a\x96\xeb\x31\xfd\xbc\x58\x2f\xd7\xd7\xb0\xf1\x79\xb9\xf9\xf5\xee\xd3\x86\x3e\x2f\xee\xef\x17\xab\xcd\xf2\x66\x4d\x77\xf7\xf4\xfe\x6e\xf5\x61\xb9\x59\xde\xad\xf0\x74\x4b\x8b\xd5\x03\xfd\xb6\x5c\x7d\xb8\x26\x06\x59\x70\xc3\x8f\x85\xab\xf1\x03\xa4\xaa\x89\xe4\xb4\xce\x69\x57\x40\x1d\x80\xba\x3e\xea\x67\x5f\xb0\x54\x5b\x25\x11\x97\xc9\x4a\x91\x31\x65\xf6\xc0\xce\xd4\xe5\x51\xb0\xcb\x95\xaf\xd3\xe9\x01\x2f\x85\x15\xad\x72\x15\x62\x15\xf9\x61\x50\xb5\x9b\x6f\xb9\xb7\x46\x7b\x65\xd2\x39\xdd\x5b\xcd\x23\x51\xa8\xb6\xb2\xe6\xe4\x12\x21\xa7\xa2\x0c\x3b\xeb\xd4\x5f\x11\xcc\x74\xff\xa3\x9f\x2a\x3b\x3b\xbc\x4b\x38\x88\x77\xa3\x1c\xff\xd8\x73\x62\x3e\x22\x32\x22\xe7\x39\x49\xfc\xeb\xc9\x7e\x62\x11\x93\xc0\x2e\xc3\x82\x16\x09\x6b\x5f\xab\x50\x9d\xdf\x39\x8d\x5b\xa5\xf1\xc8\x95\xa8\x80\xf9\x68\x02\xb9\xfa\xc5\xd9\xb2\x88\x6a\x93\xc6\x4a\xaf\x86\x20\x04\xd5\xb6\x74\x92\x5b\x8d\xf1\x77\x63\x5c\x41\x60\xd2\x13\x0c\xec\x8c\xc7\xc3\x37\x0b\x9b\xfa\x78\xe3\xd9\x1d\x40\x68\xf3\xc0\x26\x2d\xac\x42\x0f\x68\x74\x6a\x0a\x7c\x40\x4f\x38\x58\x5d\xe6\x2c\xb5\x50\x79\xb3\x84\x0e\xb2\x55\x59\x2e\x8a\xce\x88\x74\x1c\x5e\x18\x14\x52\xa2\x15\x45\x59\x0f\x1f\xd4\x44\xe0\x78\x9b\xb2\xe6\x17\xb7\xd2\x6a\xcd\xb2\x26\x38\x0a\x33\x0e\xf1\xaa\x01\xa1\x81\x23\x82\xdc\xc5\xbb\xb2\x48\x3b\x2b\x55\x14\x0e\x42\x3e\x9b\xb4\x21\x13\x0e\x09\xf7\x4f\x77\x09\x8a\x00\xc5\x78\x21\xd8\xa7\x32\xc5\x07\xfe\x27\x1a\x9f\xcd\x0f\x3c\x9f\x71\x82\xea\xf3\x43\x37\x29\x17\xda\x1e\x73\xee\x92\xef\x38\xf6\x20\xff\x94\x56\x6c\x44\xde\x96\xba\x15\x5c\x80\x9c\xa4\xd5\xfd\x02\xb8\x74\xd6\xfc\x61\x93\x0b\x81\x2a\x2c\x58\x3a\x9e\xdc\x5f\xe8\xb8\xae\x2c\x6a\x37\x49\x99\x66\x17\xa3\x0d\xbb\xc0\x7a\x29\x34\xaa\x7a\x08\x33\xee\x0f\x4c\x03\x42\x03\x70\xa7\x89\x6d\x7f\x21\xa8\x4d\x65\x8a\xd0\x9e\x54\xf7\x5c\x9f\x59\xd1\xb8\x9f\x93\x29\xb5\x3e\x51\xb7\x82\x73\x2c\x0f\xe8\xfd\xda\xdd\xc0\x8f\x68\x70\xf1\xd0\x19\xda\x06\x65\xf5\xd9\xc6\x97\xec\x06\xb8\x24\xa5\xd2\xe9\x14\x67\x89\xc1\xc8\xb4\x0d\xe8\x62\x27\xda\x44\x54\x6a\xda\xb2\x1f\x08\x66\x15\x27\x3b\x6b\xf7\xbd\x95\x0b\xc7\xa4\x72\x1c\xfe\xaf\xc5\x14\x95\x50\x02\x2c\xf2\xe6\xf6\x4b\x29\x0e\xa0\xa2\xed\xd6\x2f\xe4\x43\xc1\xac\x7f\x44\xf5\x16\x82\xc8\x2e\xcb\xc4\x30\xb9\xff\x76\x07\xbc\x48\xb4\x32\x68\xd4\x26\xa8\xce\xf9\xb9\x45\x9c\x73\xc2\x1d\x7b\xe5\x30\x93\x1a\x1f\x09\x27\xa9\x38\x9b\x44\x88\xc2\xab\x49\x8c\x4a\x97\xe5\x78\x88\xf3\x1c\xcc\x99\x2c\x7d\xb0\xf9\x64\x67\xa3\xb7\xaf\xe0\x22\x8e\x3b\x26\x9b\xee\x0d\xc6\x8c\x03\x4f\x53\x3e\x0c\x8d\xf7\x86\xac\x0b\xb0\x10\x27\x88\x21\xc6\x09\xe5\x68\x6e\x22\x7b\x15\xfd\x60\xca\xfc\x1f\x4e\x71\x52\x23\x71\xec\xba\x61\xae\x07\xb6\x9e\xe8\x7a\xfa\x2b\x0c\xd5\x5d\x56\x8e\x78\x25\x9f\xc7\x6e\x30\x89\xbb\x80\xdd\x10\x04\x4e\x17\x85\x11\xbe\x66\x49\xe2\xf3\xd9\x7a\x5c\xf2\xb3\x29\x6e\xb5\x7d\x37\x04\xf4\x9e\xff\x7b\x02\xff\x06\x9a\x3a\x8d\x0c\x6d\x10\x00\x00"),
 		},
 		"/operator-role-openshift.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-openshift.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 3027,
+			uncompressedSize: 3360,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x54\xc1\x8e\xdb\x46\x0c\xbd\xeb\x2b\x08\xe9\x92\x14\x6b\xb9\xe9\xa9\x70\x4f\x6e\xb2\xdb\x1a\x0d\xbc\xc0\xca\x69\x90\x23\x35\xa2\x25\x76\x47\xc3\xe9\xcc\xc8\x8a\xfb\xf5\x85\x46\x72\x62\xaf\x76\x91\x16\x08\x60\x5f\x4c\x71\xa8\xc7\xc7\xf7\xa8\xc9\x60\xf1\xfd\x7e\x49\x06\xef\x59\x91\xf1\x54\x41\x10\x08\x0d\xc1\xda\xa2\x6a\x08\x0a\xd9\x87\x1e\x1d\xc1\x9d\x74\xa6\xc2\xc0\x62\xe0\xd5\xba\xb8\x7b\x0d\x9d\xa9\xc8\x81\x18\x02\x71\xd0\x8a\xa3\x24\x03\x25\x26\x38\x2e\xbb\x20\x0e\xf4\x08\x08\x58\x3b\xa2\x96\x4c\xf0\x39\x40\x41\x14\xd1\xb7\xf7\xbb\xcd\xdb\x5b\xd8\xb3\x26\xa8\xd8\x8f\x2f\x51\x05\x3d\x87\x26\xc9\x20\x34\xec\xa1\x17\xf7\x08\x7b\x71\x80\x55\xc5\x43\x63\xd4\xc0\x66\x2f\xae\x1d\x69\x38\xaa\xd1\x55\x6c\x6a\x50\x62\x8f\x8e\xeb\x26\x80\xf4\x86\x9c\x6f\xd8\xe6\x49\x06\xbb\x61\x8c\xe2\xee\xc4\xc4\x8f\xb0\xb1\x67\x10\xf8\x24\xdd\x34\xc3\xd9\xb8\x93\x0a\x37\xf0\x27\x39\x3f\x34\xf9\x29\xff\x31\xc9\xe0\xd5\x50\x92\x4e\x87\xe9\xeb\x5f\xe0\x28\x1d\xb4\x78\x04\x23\x01\x3a\x4f\x67\xc8\xf4\x59\x91\x0d\xc0\x06\x94\xb4\x56\x33\x1a\x45\x5f\xc7\xfa\xd2\x21\x87\x48\x60\xc0\x90\x32\x20\x1b\xc0\x38\x06\xc8\xfe\xbc\x0c\x30\x24\x59\x92\x41\xfc\x35\x21\xd8\xd5\x72\xd9\xf7\x7d\x8e\x91\x6e\x2e\xae\x5e\x9e\xa6\x5b\xbe\xdf\xbc\xbd\xdd\x16\xb7\x8b\x48\x39\xc9\xe0\x83\xd1\xe4\x3d\x38\xfa\xbb\x63\x47\x15\x94\x47\x40\x6b\x35\x2b\x2c\x35\x81\xc6\x7e\x30\x2e\xba\x13\x4d\x67\x03\xbd\xe3\xc0\xa6\xbe\x01\x3f\xb9\x9e\x64\x17\xee\x7c\x95\xeb\x44\x8f\xfd\x45\x81\x18\x40\x03\xe9\xba\x80\x4d\x91\xc2\xaf\xeb\x62\x53\xdc\x24\x19\x7c\xdc\xec\x7e\xbf\xff\xb0\x83\x8f\xeb\x87\x87\xf5\x76\xb7\xb9\x2d\xe0\xfe\x01\xde\xde\x6f\xdf\x6d\x76\x9b\xfb\x6d\x01\xf7\x77\xb0\xde\x7e\x82\x3f\x36\xdb\x77\x37\x40\x1c\x1a\x72\x40\x9f\xad\x1b\xf8\x8b\x03\x1e\x84\xa4\x6a\xf0\xf4\xb4\x40\x27\x02\xc3\x7e\x0c\xcf\xde\x92\xe2\x3d\x2b\xd0\x68\xea\x0e\x6b\x82\x5a\x0e\xe4\xcc\xb0\x1e\x96\x5c\xcb\x7e\xb0\xd3\x03\x9a\x2a\xc9\x40\x73\xcb\x21\x6e\x91\x9f\x0f\x35\xb4\xf9\x9e\xdf\x56\xf2\xc8\xa6\x5a\xc1\x83\x68\x4a\xd0\xf2\xb4\x59\x2b\x70\x25\xaa\x1c\xbb\xd0\x88\xe3\x7f\x22\x99\xfc\xf1\x67\x9f\xb3\x2c\x0f\x6f\x4a\x0a\xf8\x26\x69\x29\x60\x85\x01\x57\x09\x80\xc1\x96\x56\xa0\xb0\x25\xbd\x78\x5c\x88\x25\x87\x41\x5c\x02\xa0\xb1\x24\xed\x87\x12\x18\xfc\x5d\x41\x3a\x15\xa5\x89\xeb\x34\xf9\x55\xb2\x00\xb4\xfc\x9b\x93\xce\xc6\xb2\xc5\x88\x72\xb6\x43\x09\x80\x23\x2f\x9d\x53\x34\x55\xa4\x3f\xa4\x09\xc0\x81\x5c\x79\x96\x98\xe1\xa4\xe9\xfc\x4d\x2b\x95\x8f\x81\x27\x77\x60\x45\xe3\x03\x99\xca\x0a\x9b\x30\x3e\xd9\x41\x02\x1f\xc8\x84\x83\xe8\xae\x25\xa5\x91\xdb\xf1\x48\x89\xd9\x73\xdd\xa2\x3d\x81\x28\x47\xe1\x02\x10\x95\x92\x6e\x44\x3a\xe3\xa7\x1c\x61\xa0\x18\x56\xa4\xe9\x22\x54\xa2\x35\xa9\x41\xe0\x98\xac\x29\xc4\x7f\xcd\x7e\x0c\x2c\x06\xd5\xc4\xa8\xb3\xd5\x09\xa5\x8f\xc9\xd9\xc8\x2f\x9a\x36\x57\xc2\x89\x9e\xa6\x1f\xa2\x92\xcd\x70\x57\x5d\x89\xf6\x73\x4e\xd1\x81\x66\x32\xce\x9a\xbc\x80\x87\x36\x1a\xf4\x04\xb1\x22\xab\xe5\xd8\xd2\xc9\x67\x47\xf1\xba\xf1\x5f\x1c\x0c\x18\x68\xdf\xe9\x29\x71\x05\x1d\xca\xa9\xf6\x09\x71\xe5\xc4\xfc\x25\xe5\x95\x48\x4d\x62\x62\x98\xee\xd1\x07\x1a\x6e\xd4\x08\xee\x57\x60\x3a\xad\x9f\x91\x1a\xa9\x15\x33\x17\xf2\xbf\x1a\x18\x17\x62\x01\x69\xd9\xb1\xae\x72\xb1\x64\x7c\xc3\xfb\x90\xb3\x3c\xb3\x29\xb1\x68\xfc\x32\xfd\x2c\xb1\xec\xa9\x6c\x44\x1e\xcf\x4e\xae\xb9\xe4\x0b\x48\xb9\xc5\x9a\xbe\x35\x53\x2c\xf2\xc1\x11\xb6\x63\xf8\x34\xdb\xa2\xb5\xd3\x07\x7b\x91\x9f\x27\x96\xe7\xb7\xd4\xd9\x41\xc0\xab\x7e\xee\x93\x19\x17\x42\xfc\xdf\x35\xbb\x30\x9a\x8d\x0f\x68\x02\x9f\x9a\xbf\x74\x58\xb2\x41\x77\x3c\x5b\x87\xa5\xd2\x62\xe8\x59\x29\x5e\x34\xd1\x49\x17\xbe\x69\x62\x2c\xba\xae\xc6\x73\x9e\x2f\xd1\x5c\xaa\xce\x07\x69\x17\x8d\xc4\x6e\x73\xca\xff\x06\x00\x00\xff\xff\x2e\xce\xda\xac\xd3\x0b\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc5\x56\xc1\x8e\xdb\x36\x10\xbd\xfb\x2b\x06\xde\x4b\x52\xac\xed\xa6\xa7\xc2\x3d\xb9\xc9\x6e\x6b\x34\xb0\x01\xcb\x69\xb0\x47\x8a\x1a\xcb\xac\x29\x52\x25\x29\x2b\xee\xd7\xf7\x89\x92\x37\xda\xc8\x46\x90\x20\x80\x7d\xb0\xa8\xe1\xe8\xcd\x9b\x37\xc3\x91\xee\x68\xf2\xe3\x7e\xa3\x3b\x7a\xaf\x24\x1b\xcf\x19\x05\x4b\x61\xcf\xb4\x28\x85\xc4\x25\xb1\xbb\x50\x0b\xc7\xf4\x68\x2b\x93\x89\xa0\xac\xa1\x57\x8b\xe4\xf1\x35\xe1\x96\x1d\x59\xc3\x64\x1d\x15\xd6\x31\x40\xa4\x35\xc1\xa9\xb4\x0a\x30\xe9\x16\x90\x44\xee\x98\x0b\x36\xc1\x4f\x89\x12\xe6\x88\xbe\x5a\x6f\x97\x6f\x1f\x68\xa7\x34\x53\xa6\x7c\xfb\x10\x82\xd7\x2a\xec\x81\x13\xf6\xca\x53\x6d\xdd\x81\x76\x40\x12\x59\xa6\x9a\xc0\x42\x93\x32\x30\x14\x2d\x0d\xc7\xb9\x70\x99\x32\x39\xc2\x96\x27\xa7\xf2\x7d\x20\x5b\x1b\x76\x7e\xaf\xca\x29\x50\xb6\x4d\x1a\xc9\xe3\x99\x89\x6f\x61\x63\x4c\x24\xf9\x64\xab\x2e\x87\x5e\xba\x9d\x0a\xf7\xf4\x37\x60\x9a\x20\xbf\x4c\x7f\x06\xd2\xab\xc6\x65\xdc\x6d\x8e\x5f\xff\x46\x27\x3c\x5c\x88\x13\x19\x1b\xa8\xf2\xdc\x43\xe6\x4f\x92\xcb\x00\xa2\x60\x55\x94\x5a\x09\x23\xf9\x73\x5a\xcf\x11\xa0\xc5\x53\x87\x61\xd3\x20\xe0\x2e\x62\x1a\x64\x77\x7d\x37\x12\x61\x74\x87\x27\xe3\x6f\x1f\x42\x39\x9f\xcd\xea\xba\x9e\x8a\x48\x77\x6a\x5d\x3e\x3b\x67\x37\x7b\x0f\x45\x57\xc9\xc3\x24\x52\xc6\x33\x1f\x8c\x66\xef\x21\xd3\xbf\x95\x72\xd0\x36\x3d\x91\x28\xc1\x48\x8a\x14\x3c\xb5\xa8\x9b\xc2\xc5\xea\xc4\xa2\x83\x42\xed\xa0\xb3\xc9\xef\xc9\x77\x55\x07\x4a\xbf\x3a\x9f\xe5\x3a\xd3\x43\xd6\x7d\x07\x08\x26\x0c\x8d\x17\x09\x2d\x93\x31\xfd\xbe\x48\x96\xc9\x3d\x30\x3e\x2e\xb7\x7f\xae\x3f\x6c\xe9\xe3\x62\xb3\x59\xac\xb6\xcb\x87\x84\xd6\x1b\x7a\xbb\x5e\xbd\x5b\x6e\x97\xeb\x15\xee\x1e\x69\xb1\x7a\xa2\xbf\x96\xab\x77\xf7\xc4\x10\x0b\x61\xf8\x53\xe9\x1a\xfe\x20\xa9\x1a\x21\x39\x6b\x6a\x7a\x6e\xa0\x33\x81\xa6\x3f\x9a\x7b\x5f\xb2\x54\x3b\x25\x91\x97\xc9\x2b\x91\x33\xe5\xf6\xc8\xce\x34\xed\x51\xb2\x2b\x94\x6f\xca\xe9\x41\x2f\x03\x8a\x56\x85\x0a\xb1\x8b\xfc\x30\xa9\x26\xcc\x8f\x3c\x5b\xa3\x83\x32\xd9\x9c\x36\x56\xf3\x48\x94\xaa\xeb\xac\x39\xb9\x54\xc8\xa9\xa8\xc2\xde\x3a\xf5\x5f\x24\x33\x3d\xfc\xea\xa7\xca\xce\x8e\x6f\x52\x0e\xe2\xcd\xa8\xc0\x3f\xce\x9c\x98\x8f\x88\x8c\x28\x78\x4e\x12\xff\x7a\x72\x98\x58\xe4\x24\x70\xca\xb0\xa1\x45\xca\xda\x37\x2e\xd4\xd4\x77\x4e\xe3\xce\x69\x3c\x72\x15\x3a\x60\x3e\x9a\xc0\xae\xfe\x70\xb6\x2a\xa3\xdb\xa4\x45\xe9\xf5\x10\x8c\x90\xda\x56\x4e\x72\xe7\x31\xfe\x69\x8c\x2b\x04\x4c\x7b\x86\x01\xce\x78\x3c\x7c\xb2\xb4\x99\x8f\x0b\xcf\xee\x08\x41\xdb\x1b\x36\x59\x69\x15\x66\x40\xeb\xd3\x48\xe0\x03\x66\xc2\xd1\xea\xaa\x60\xa9\x85\x2a\xda\x2d\x4c\x90\x9d\xca\x0b\x51\x9e\x41\xa4\xe3\xf0\x02\x50\x48\x89\x51\x14\x6d\x3d\x7e\x70\x13\x81\xe3\x32\x63\xcd\x2f\x96\xd2\x6a\xcd\xb2\x11\x38\x1a\x73\x0e\xf1\xaa\x41\xa1\xa5\x23\x82\xdc\xc7\x55\x55\x66\x67\x94\x3a\x1a\x07\x29\x5f\x2d\xda\x50\x09\x87\x82\xfb\xe7\x55\x8a\x26\x40\x33\xde\x88\xf6\xa5\x4a\xf1\x91\x07\x32\x0e\x82\x5c\xc1\x43\xa3\xf9\x21\x62\xc6\xa5\xb6\xa7\x82\xcf\x75\x76\x1c\xc7\x8d\x7f\xae\x20\xce\x1c\xef\x2a\xdd\x19\x6e\xa0\x43\xda\xf9\x7e\x41\x5c\x3a\x6b\xfe\xb1\xe9\x8d\x48\x95\x16\x2a\x9d\x2e\x1e\x25\x0c\x57\x57\x95\x4d\x98\xb4\xca\xf2\x9b\xc9\x86\x86\xb7\x5e\x0a\x8d\x06\x1e\xd2\x8c\x47\x01\x2f\x7e\xa1\x41\xf8\xec\x89\x13\x7e\x23\xaa\x6d\x67\x8a\xd0\xbd\x94\x36\xdc\xbc\x9e\x22\xb8\x9f\x93\xa9\xb4\xbe\xd0\xb7\x82\x0b\x6c\xf3\xf7\x9e\x86\x78\xba\x70\x49\x2b\xa5\xb3\x29\x66\xb3\xc1\x27\xc8\x2e\x60\x2a\x5c\x38\x76\xd1\xa9\x1d\x73\x7e\x60\x98\xd5\x9c\xee\xad\x3d\xf4\x76\x6e\x39\x31\x70\x51\x05\x5e\xa6\x5f\xcb\x29\x3a\x41\x67\x16\x45\xbb\xfc\xd2\x8a\x81\x5e\x76\xd3\xef\x85\x7d\x68\x98\xf5\x47\x7e\x6f\x23\x88\xfc\xb6\x4a\x0c\x8b\xfb\xad\x6d\xf6\xa2\xd0\xca\x60\x1a\x9a\xa0\xce\xc1\xaf\x6d\xe2\xbd\x21\xdc\xa9\xd7\x0e\x33\xa9\xf1\xd1\x7d\x51\x8a\xab\x45\x84\x29\x7c\xb5\x88\xd1\xe9\xb6\x1a\x0f\x79\x5e\xa3\x39\x93\x95\x0f\xb6\x98\xec\x6d\x8c\x36\xa4\xfc\x3f\x6c\xfa\x29\x81\x20\x0d\x00\x00"),
 		},
 		"/operator-role-servicemonitors.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-servicemonitors.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 1255,
+			uncompressedSize: 1271,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xc1\x6e\xdb\x46\x10\xbd\xef\x57\x3c\x88\x97\x04\xb0\xa8\xa6\xa7\x42\x3d\xa9\x8e\xdd\x12\x0d\x24\xc0\x54\x1a\xe4\xb8\x5a\x8e\xc8\x81\x97\x3b\xec\xec\xd2\x8c\xfb\xf5\x05\x57\x54\x62\x23\x57\xef\x45\xa3\xdd\x99\x79\xef\xcd\x1b\x16\x58\xbf\xdd\x31\x05\x3e\xb1\xa3\x10\xa9\x41\x12\xa4\x8e\xb0\x1b\xac\xeb\x08\xb5\x9c\xd3\x64\x95\x70\x2f\x63\x68\x6c\x62\x09\x78\xb7\xab\xef\xdf\x63\x0c\x0d\x29\x24\x10\x44\xd1\x8b\x92\x29\xe0\x24\x24\xe5\xd3\x98\x44\xe1\x2f\x0d\x61\x5b\x25\xea\x29\xa4\x58\x02\x35\x51\xee\xbe\x3f\x1c\xab\xdb\x3b\x9c\xd9\x13\x1a\x8e\x97\x22\x6a\x30\x71\xea\x4c\x81\xd4\x71\xc4\x24\xfa\x88\xb3\x28\x6c\xd3\xf0\x0c\x6c\x3d\x38\x9c\x45\xfb\x0b\x0d\xa5\xd6\x6a\xc3\xa1\x85\x93\xe1\x59\xb9\xed\x12\x64\x0a\xa4\xb1\xe3\xa1\x34\x05\x8e\xb3\x8c\xfa\xfe\xca\x24\x5e\xda\x66\xcc\x24\xf8\x2a\xe3\xa2\xe1\x85\xdc\x65\x0a\x37\xf8\x87\x34\xce\x20\xbf\x96\xbf\x98\x02\xef\xe6\x94\xd5\xf2\xb8\x7a\xff\x3b\x9e\x65\x44\x6f\x9f\x11\x24\x61\x8c\xf4\xa2\x33\x7d\x73\x34\x24\x70\x80\x93\x7e\xf0\x6c\x83\xa3\x1f\xb2\xbe\x23\x94\xc8\x04\xe6\x1e\x72\x4a\x96\x03\x6c\x96\x01\x39\xbf\x4c\x83\x4d\xa6\x30\x05\xf2\xe9\x52\x1a\xb6\x9b\xcd\x34\x4d\xa5\xcd\x74\x4b\xd1\x76\x73\x55\xb7\xf9\x54\xdd\xde\xed\xeb\xbb\x75\xa6\x6c\x0a\x7c\x0e\x9e\x62\x84\xd2\xbf\x23\x2b\x35\x38\x3d\xc3\x0e\x83\x67\x67\x4f\x9e\xe0\xed\x34\x1b\x97\xdd\xc9\xa6\x73\xc0\xa4\x9c\x38\xb4\x37\x88\x8b\xeb\xa6\x78\xe5\xce\x8f\x71\x5d\xe9\x71\x7c\x95\x20\x01\x36\x60\xb5\xab\x51\xd5\x2b\xfc\xb1\xab\xab\xfa\xc6\x14\xf8\x52\x1d\xff\x3a\x7c\x3e\xe2\xcb\xee\xe1\x61\xb7\x3f\x56\x77\x35\x0e\x0f\xb8\x3d\xec\x3f\x56\xc7\xea\xb0\xaf\x71\xb8\xc7\x6e\xff\x15\x7f\x57\xfb\x8f\x37\x20\x4e\x1d\x29\xe8\xdb\xa0\x33\x7f\x51\xf0\x3c\x48\x6a\x66\x4f\xaf\x0b\x74\x25\x30\xef\xc7\xfc\x3f\x0e\xe4\xf8\xcc\x0e\xde\x86\x76\xb4\x2d\xa1\x95\x27\xd2\x30\xaf\xc7\x40\xda\x73\x9c\xed\x8c\xb0\xa1\x31\x05\x3c\xf7\x9c\xf2\x16\xc5\x9f\x45\xcd\x30\x6f\xf9\x6d\x99\x47\x0e\xcd\x16\x0f\xe2\xc9\xd8\x81\x97\xcd\xda\x42\x4f\xd6\x95\x76\x4c\x9d\x28\xff\x97\xc9\x94\x8f\xbf\xc5\x92\x65\xf3\xf4\xe1\x44\xc9\x7e\x30\x3d\x25\xdb\xd8\x64\xb7\x06\x08\xb6\xa7\x2d\x9c\xed\xc9\xaf\x1f\xd7\x32\x90\xda\x24\xba\x8e\xa4\x4f\xec\xa8\x97\xc0\x49\x34\x1a\xc0\xdb\x13\xf9\x38\x97\x60\xf6\x7b\x8b\xd5\x52\xb4\x32\x3a\x7a\x8a\x5b\xb3\x86\x1d\xf8\x4f\x95\x71\xc8\x69\x6b\x2c\xd5\x1c\xda\xd2\x89\x92\xc4\xd2\x49\x6f\x00\xa5\x28\xa3\x3a\x5a\xd2\x7e\xc6\x7a\x22\x3d\x2d\x8f\x4e\xc9\x26\xca\x61\x43\x9e\x5e\x85\x4e\xbc\x27\x37\x2b\xcc\x97\x2d\xa5\xfc\xeb\x39\x5e\x82\xc1\x26\xd7\xe5\x68\x1c\x9a\x6b\x97\x29\x5f\xfe\x1f\x00\x00\xff\xff\xd5\x77\x74\x82\xe7\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xad\x53\xc1\x6e\xdb\x30\x0c\xbd\xfb\x2b\x88\xe4\xd2\x02\xb1\xb3\xee\x34\x64\x27\xaf\x6d\x36\x63\x85\x03\xc4\xe9\x8a\x1e\x65\x99\xb1\x85\xc8\x92\x26\xc9\x75\xb3\xaf\x1f\xa5\x38\x6b\x86\x5e\xeb\x83\x2d\xc9\xe4\xe3\x7b\x8f\xd4\x1c\xd2\x8f\x7b\x92\x39\x3c\x08\x8e\xca\x61\x03\x5e\x83\xef\x10\x72\xc3\x38\x7d\x2a\xbd\xf7\x23\xb3\x08\x6b\x3d\xa8\x86\x79\xa1\x15\x5c\xe5\xd5\xfa\x1a\x68\x8b\x16\xb4\x42\xd0\x16\x7a\x6d\x91\x40\xb8\x56\xde\x8a\x7a\xf0\x74\x24\x4f\x80\xc0\x5a\x8b\xd8\xa3\xf2\x2e\x03\xa8\x10\x23\x7a\xb9\xd9\x15\xb7\xf7\xb0\x17\x12\xa1\x11\xee\x94\x44\xc5\x47\xe1\x3b\xc2\xf1\x9d\x70\x30\x6a\x7b\x80\x3d\x21\xb1\xa6\x11\xa1\x30\x93\x20\x14\x1d\xf4\x27\x1a\x16\x5b\x66\x1b\xa1\x5a\x2a\x6b\x8e\x56\xb4\x9d\x07\x3d\x2a\xb4\xae\x13\x26\x23\x94\x5d\x90\x51\xad\xcf\x4c\xdc\x09\x36\xd6\x24\x91\xcf\x7a\x98\x34\x5c\xc8\x9d\x5c\x58\xc0\x2f\x82\x09\x45\x3e\x67\x9f\x08\xe9\x2a\x84\xcc\xa6\x9f\xb3\xeb\xaf\x70\xa4\xe4\x9e\x1d\x41\x69\x0f\x83\xc3\x0b\x64\x7c\xe5\x68\x3c\x11\x25\x56\xbd\x91\x82\x29\x8e\x6f\xb2\xfe\x55\x20\x2f\x9e\x27\x0c\x5d\x7b\x46\xe1\x2c\xca\x00\xbd\xbf\x0c\x03\xe6\x93\x39\x65\xc6\xa7\xf3\xde\xac\x96\xcb\x71\x1c\x33\x16\xe9\x66\xda\xb6\xcb\xb3\xba\xe5\x03\x39\x5a\x56\xf7\x69\xa4\x4c\x39\x8f\x4a\xa2\x73\x64\xd3\xef\x41\x58\xf2\xb6\x3e\x02\x33\xc4\x88\xb3\x9a\x78\x4a\x36\x86\xc6\xc5\xee\xc4\xa6\x13\x85\xd1\x92\xcf\xaa\x5d\x80\x9b\xba\x4e\x28\x97\xdd\x79\xb3\xeb\x4c\x8f\x54\x5f\x06\x90\x61\x4c\xc1\x2c\xaf\xa0\xa8\x66\xf0\x2d\xaf\x8a\x6a\x41\x18\x4f\xc5\xee\xc7\xe6\x71\x07\x4f\xf9\x76\x9b\x97\xbb\xe2\xbe\x82\xcd\x16\x6e\x37\xe5\x5d\xb1\x2b\x36\x25\xed\xd6\x90\x97\xcf\xf0\xb3\x28\xef\x16\x80\x64\x16\x95\xc1\x57\x63\x03\x7f\x22\x29\x82\x91\xd8\x84\x9e\x9e\x07\xe8\x4c\x20\xcc\x47\xd8\x3b\x83\x5c\xec\x05\x27\x5d\xaa\x1d\x58\x8b\xd0\xea\x17\xb4\x2a\x8c\x87\x41\xdb\x0b\x17\xda\xe9\x88\x5e\x43\x28\x52\xf4\xc2\xc7\x29\x72\xef\x45\x85\x32\x1f\x79\xb7\x92\x83\x50\xcd\x0a\xb6\x5a\x62\xc2\x8c\x98\x26\x6b\x05\xb6\x66\x3c\x63\x83\xef\xb4\x15\x7f\x22\x99\xec\xf0\xc5\x65\x42\x2f\x5f\x6e\x6a\xf4\xec\x26\xe9\xe9\x4d\x77\x8e\xad\x12\x00\xc5\x7a\x5c\x01\xa7\xb7\x4c\x0f\xa9\x26\x4d\x8c\x6e\x59\xea\xd0\xbe\x10\xed\x5e\x2b\x41\x5b\x47\x81\x92\xd5\x28\x5d\x48\x81\xd0\xef\x15\xcc\xa6\xa4\x59\x62\x07\x9a\x88\x55\x92\xd2\xb9\xf8\x6e\xf5\x60\x62\x58\x0a\x53\x36\x79\x95\x71\xba\xc8\xda\xd1\xa7\xa7\x3f\xe4\xbf\x1e\x2c\xc7\x29\xec\x7d\xad\x14\x8c\x6e\x2e\xf6\xe4\x78\x3d\x05\x73\x8b\xcc\x63\x5c\x36\x28\xf1\xbf\x25\xd7\x52\x22\x0f\x8a\xe3\x61\x8b\x3e\x7e\x25\x4d\xd2\x09\x94\x79\xde\xc5\xd5\x60\x9a\x33\xca\x18\x0f\xff\x02\x5c\xf2\x59\x7f\xf7\x04\x00\x00"),
 		},
 		"/operator-service-account.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-service-account.yaml",
@@ -354,9 +368,9 @@ var assets = func() http.FileSystem {
 		"/traits.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "traits.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 32177,
+			uncompressedSize: 75516,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x6d\x73\x1b\x37\x92\xf0\xf7\xfc\x0a\x94\x9e\xa7\x4a\xa2\x8a\x1c\xc9\xd9\xca\x26\xab\xbb\x5c\x4a\xeb\x38\xbb\x72\x62\x5b\x67\x39\xc9\x5d\xe5\xb6\x96\xe0\x4c\x93\x84\x85\x01\x66\x01\x0c\x65\xe6\xea\xfe\xfb\x55\x37\x5e\x06\x33\x1c\x49\x94\x63\xa5\xb4\x55\x57\xf9\x10\x93\x9a\x69\x34\x1a\xfd\xfe\x02\x3a\xc3\x85\xb3\x67\x9f\xcd\x98\xe2\x35\x9c\x31\xbe\x5c\x0a\x25\xdc\xf6\x33\xc6\x1a\xc9\xdd\x52\x9b\xfa\x8c\x2d\xb9\xb4\x80\xdf\x18\xbd\x14\x12\xec\xd9\x67\x8c\xcd\xd8\xf7\xed\x02\x8c\x02\x07\xd6\x7f\x54\xdc\x89\x0d\xd0\xbf\xdf\x34\xa0\xae\xd6\x62\xe9\x3e\x63\xac\x02\x5b\x1a\xd1\x38\xa1\xd5\x19\x3b\x97\x52\xdf\x58\x56\x6a\x65\x71\x65\x25\xd4\x8a\xdd\xac\x45\xb9\x66\x4a\x57\x60\x99\x5b\x03\x13\xca\xc1\xca\x70\x7c\x81\x35\xba\x3a\xb2\x13\xc6\x0d\x30\x90\x62\x25\x16\x12\x98\xd3\x6c\x01\xcc\x96\x6b\xa8\x5a\x09\x15\xd3\x6a\xca\x16\xdc\xd2\xbf\x98\xe4\x0b\x90\x16\xff\x85\xa0\x10\xe8\x94\x69\xc3\x6e\x84\x5b\x13\x60\x33\x6b\x74\x95\x76\xc9\xb8\xaa\x18\x57\x4e\xcc\xe2\x37\xa3\xa0\x1a\x5d\x21\x6a\xdc\x11\x22\x5c\x1a\xe0\xd5\x96\x99\x56\x11\xfe\xd9\x5a\xb6\x60\x17\xee\xd0\xb2\x4a\x58\xbe\x40\xdc\x16\x5b\x56\xc1\x92\xb7\xd2\x15\x9e\x7e\x0d\x18\x27\x22\x05\x3d\xc9\x41\xd1\xb3\x9f\x31\xc6\x98\xdb\x36\x70\xc6\x16\x5a\x4b\xfa\xd8\xa3\xdd\x73\xae\x70\xe3\x2d\xa2\xe7\x74\x78\x0d\x37\x17\x56\x63\x9c\xd1\x69\x16\x48\x65\xff\x4f\xcb\xec\x1a\x51\x76\x6b\x81\x44\xaf\x6b\xdc\x8c\x47\x62\x5b\x64\x28\x34\xba\x9a\x65\x27\x7f\x37\x1e\xe7\xf2\x86\x6f\x11\xdc\x4c\xea\x92\x3b\xb0\xac\x6e\xa5\x13\x8d\x04\x66\xa0\x91\xa2\xe4\x96\xe9\xe5\xce\x51\x0a\x4f\x26\xcb\x6b\x4f\x2b\x76\x14\x28\xc3\x8e\x89\xbf\x8e\x27\x3b\x18\xe5\x07\x73\x2f\x5a\xaf\x61\x03\xe6\x91\xb1\xc2\x27\x12\x46\x33\xcf\x20\x19\x62\x87\xbf\xfc\xcd\x3a\x23\xd4\xea\x70\x17\xbd\x6f\x61\x29\x14\x58\xc6\x99\x05\x87\x98\x3c\x1a\xc3\xdf\x76\xb0\xbf\x11\x5f\x12\x82\x23\x04\x2b\xb7\xcc\xad\xb5\x05\x56\x73\x57\xae\x51\x04\x70\x69\x82\xce\x2c\x48\x28\x9d\x36\x53\x66\x40\x92\x42\x40\xf4\xf1\xef\x2b\xb1\x01\x45\x68\xd9\x86\x97\x30\xf1\x02\xe5\xd6\x30\xb2\x7d\xbb\xd6\xad\xac\x70\xd7\xe9\x3c\x2b\x92\xe1\x3b\x59\xe4\x9f\x6f\x83\x4a\xbb\xd1\x4d\xc6\x2d\x2e\x5a\x21\x2b\x30\x3d\x65\xec\x4c\xfb\x69\x74\xf1\xbb\x35\xc4\x05\xbc\xb6\x60\xc2\x7a\x1d\xa9\xb8\x94\xdb\xa4\x68\x2a\x70\x60\x6a\xa1\x80\x76\xb9\x00\xeb\x18\x2a\x6f\x07\xab\x2d\xb1\x26\x82\x20\x45\x5a\x6a\xb5\x14\xab\xd6\x00\xbb\xe8\x76\xfc\xbd\x70\xf6\x49\xeb\xbe\x0d\x98\x85\x26\xf3\x76\x37\x0a\x2f\xfc\x9a\xe1\x71\x26\xf5\x6a\x15\xb4\xbf\xa7\x40\xa9\xeb\x46\x2b\x50\x2e\x98\x0a\xdb\x36\x8d\x36\x8e\x09\xc7\x8e\xa0\x58\x15\xec\x7b\xae\xc4\x75\xa4\x57\xa3\xab\x49\x77\xce\x25\x32\xdd\xe3\x9d\xf2\x73\x04\x1f\xce\xb8\xec\x53\xb2\x3b\xb3\x0d\x18\x2b\xb4\x22\x2d\x79\xde\xf0\x32\xbd\xf7\x3d\x5a\x3a\x27\x6a\xa0\x43\x26\xae\x87\x8a\x49\xb1\x30\xdc\x08\xb0\x53\xe6\xa1\x06\x5e\x8e\xa6\xef\x49\x9f\x79\xd8\xd0\x2c\xec\x39\x43\xc5\xab\x8b\x5d\x64\x90\x8c\x74\x4a\xb3\xeb\x59\x24\x47\x78\x1b\x91\x6b\x2d\xb0\xa5\x36\x43\x75\x8e\xee\x00\xd3\x1b\x30\x46\x44\x65\x1f\xcd\x4b\x7c\x19\x95\x4f\x30\x42\x99\xd4\xb0\xcb\xc0\x09\x19\x8f\x68\xe5\xb8\x50\x8f\xa9\x0d\x9e\xc7\x25\xee\xe3\x95\xee\x60\xa3\x4d\x4d\xd8\xb1\x9b\x35\x18\xd8\xb1\x6b\x37\x42\x4a\x24\x3b\x51\x84\x4b\xab\x11\xc6\x86\xa8\x12\xc1\xfa\x07\x91\x8a\x57\x60\x36\xa2\x44\xdd\x6c\xad\x2e\x05\xf1\x5b\x50\xb2\x69\x9d\x27\xcd\x5f\xbc\x75\xfa\xde\xf5\x0f\x0e\x72\x8e\x84\x7f\xb4\x60\xdd\xac\x6c\xda\x3d\xb9\xb1\x16\x4a\xd4\x6d\xcd\x78\xad\x5b\x45\x06\xec\xf9\xe5\x8f\x04\x47\x18\x2f\x7e\x43\xd8\x35\xd4\xda\x6c\x3f\x1a\xbc\x7f\x7d\x74\x05\x29\x6a\xf1\x20\xdc\xf9\x87\x3d\x71\xf7\x90\x1f\x86\xf9\x0e\xf0\x3b\x30\x87\x0f\xcd\x3e\xca\x7f\x94\x57\x4e\x22\xa3\x10\x10\xd2\xa1\x82\xb3\xeb\x24\x7c\x91\x8f\xfb\x4e\x8b\x71\xd9\x6a\x42\xb9\x91\x4d\xe4\xa2\xc6\x59\x25\x96\x4b\x30\xa0\x1c\xbd\x1c\x30\xa6\xd0\xa2\x2f\x78\xc9\x73\x9d\x7f\x75\xfa\xd5\xe9\x7c\x32\x5c\x76\x86\xff\xdc\x87\x86\x77\x2e\x8f\x40\x92\xaa\xdb\x17\xa1\xb5\x73\x4d\x1f\x21\xeb\x49\x33\x7b\x30\x3d\x5a\x55\x91\x92\xc1\x98\x31\x00\xf1\x68\xf4\xd7\xf6\xa6\xd7\x06\xdf\x39\xa2\x98\x93\xe8\x76\x7c\x3e\x8a\x50\xb7\xe2\x45\x04\x7b\x18\x72\xbb\xe4\xda\x17\x23\x62\x7f\xa1\xb2\xb5\xf0\xcd\x10\x95\xe2\x3f\x2b\x36\xcf\xd4\xf2\x7c\x10\xa0\x26\x76\x31\x7a\x01\x76\xb6\xaf\x26\xbd\xa4\xc7\xbd\x83\x54\x0d\x85\xc3\xc3\x8a\x01\xca\x18\x77\x50\xa0\x35\x9f\x0c\xd7\x9f\x35\xdc\xad\xf7\xd8\xf4\x25\x77\x6b\x24\x25\x2f\x4b\xb0\x69\x21\x02\xc1\x8e\x92\xbd\x9d\x9f\xac\x81\x4b\xb7\x9e\x4f\x0a\xf6\x5a\x3b\x88\xde\xb9\xb0\x49\x83\xe3\x91\xa0\x17\x13\x3c\x37\xa8\xb4\x62\xff\x68\xb9\xb9\x6e\x6d\xcf\x05\xb2\xe0\x1c\xba\x7e\xc2\x05\xb3\x06\x16\x57\x08\x56\x3c\xb7\x7a\x4b\x2e\x24\x85\x0f\x9a\x59\xc7\x8d\xeb\x6b\xb6\x0d\x28\xb0\x76\x86\xb1\x8b\xe0\x72\x56\x81\xe4\xdb\xbe\x2c\xfc\xe1\xf3\x91\x38\xb7\xad\x17\x60\x50\xad\x59\x28\xb5\xaa\x2c\xe3\x4b\x07\x66\x40\xdd\x35\xb7\x7e\x49\x14\x4c\x58\x6a\x03\x69\xc1\x78\x22\x68\xc8\xfc\xda\x6e\xa8\x73\x03\x66\xb8\x63\xdd\xba\x8f\xc7\xc9\x8b\x43\x77\x1c\x08\xd0\x32\xdd\xa2\x4d\x6d\x1a\x89\xfe\x43\xb0\xeb\x7d\xe4\x46\xb1\x69\xc0\x08\x5d\xdd\x8f\xcc\x5f\xf5\x0d\xd3\x4b\x07\xe4\x98\x35\x60\xd0\x51\xea\x70\xf8\x98\x95\x6d\x4b\xac\x35\x73\x6b\x03\x76\xad\xe5\x1e\x48\xbc\x0a\xe6\xb3\xd4\xca\x42\xd9\x52\x9c\x18\xc0\x80\xed\xf4\xa7\xa7\x8a\xf6\x41\xa0\xb2\xa2\x02\x03\x55\x7c\x70\xd9\xca\x40\xc7\x35\xdf\x20\x1b\x21\x3b\x41\x55\x3c\x7c\x03\xf8\x62\x6b\xe0\xb7\x6e\x20\x80\xb9\x17\x7f\x8f\x67\x1f\x77\xda\x13\x54\x0f\x41\xdf\x00\xaf\xc4\xef\x2a\x22\x69\xc5\x7b\x65\xa4\xc3\xed\x77\x14\x92\x01\x7a\xe3\xf8\x3c\x92\x98\xec\xb5\xf6\xd3\x16\x94\xbd\xb6\xf0\x94\x45\x65\x67\x03\x29\x36\x34\x14\xc4\x3e\x46\xc6\xfe\x90\x02\x43\x83\x56\x75\x34\x26\x6c\xad\xd3\xb5\xf8\x35\x26\x87\x70\x0b\xba\x25\x2e\xf7\x8c\x28\x4a\x62\x68\x73\x82\x38\x86\xb4\x65\x66\x22\x6d\xc1\x7e\x5e\x0b\x09\x4c\x69\x53\x53\xda\x89\xab\x9e\x09\x0d\x4e\xbb\x65\x9c\x35\xba\x0a\x04\xe4\x3e\x05\xdd\x36\x3e\x25\xe1\x13\xf1\x53\x66\x75\x0d\xd9\xb2\xdc\x5e\xdb\x29\x52\x73\xcd\xb8\x65\x0b\xee\xca\x35\x7b\xaf\x17\x76\x1a\x81\x46\x68\xa5\x13\x1b\x0a\x32\xb9\x63\xb6\x81\x52\x2c\x45\xc9\xd6\xba\x35\x29\xbc\xad\xf8\x36\x95\x11\x78\xb7\x04\xe9\x23\x8a\x30\x84\x6a\x1d\xd8\x82\x7d\xa7\x8d\x5f\x31\xac\x4e\x2a\xa7\x4f\xbd\x9a\x3b\x30\x82\xcb\x48\xb4\x7c\xb7\x1c\xf7\xd9\x1d\x13\x11\xfe\xa5\x5e\x30\xa1\xac\x03\x5e\xe1\x52\x1c\x95\x96\xaa\xb8\xa9\x58\x05\x8d\xd4\xdb\x1a\x94\x9b\xa2\xf7\xa1\x0d\xa5\xf2\x34\xb3\x7c\x83\xcc\x62\x75\x6b\x30\x8a\xbe\x59\xc3\xae\x6b\x52\x69\xf0\xde\x8e\x02\xa8\x92\x27\x8a\xec\x0b\x55\x91\xa7\x22\x62\x3a\x0b\x35\x25\x5b\x1a\xed\x95\xc4\x52\x4b\xa9\x6f\x90\x5b\xb3\xbc\x17\x65\xad\x37\x5c\xb6\x44\xcc\x18\x0f\xa4\xdd\x9f\xb1\x39\xb1\xc2\x7c\xca\xe6\xf8\x2d\xfe\x1f\xfd\x2b\xf7\xeb\xbc\x20\xd7\xd5\xb4\x32\x48\x4c\x6b\x11\xf4\x28\x29\x78\xc8\x2e\x24\x0c\xce\xd8\x2c\x02\x3e\xf3\x7b\xf5\xe7\x63\x23\xaf\xde\x18\xe1\x50\xcf\x71\xeb\x91\x81\x0f\x8d\x01\x6b\x3d\xf7\xbd\x28\x56\x45\x78\xfd\xcc\x89\xf2\xfa\x1b\xff\xf2\xd7\x7f\x3c\x3d\x3d\x3d\x9d\x17\x08\x7b\x80\xeb\x59\x47\xd0\x01\xb8\x8e\xa8\xc1\xca\x24\x4d\x7f\x14\xb4\xc0\x41\xf8\xe2\x80\x35\xdc\xc7\x00\x16\x1c\x52\xff\x74\x12\x51\x41\x98\x67\x8e\x2f\xbe\x89\x09\xff\xaf\x4f\x4f\x3e\xff\xff\xff\xdd\xc8\xd6\xfe\xcf\xf1\xd8\xff\xbe\x99\x23\x6b\x06\xec\xce\x9c\x11\xab\x15\x98\x6f\x10\xcc\xd7\xa7\xfe\x89\xd3\x93\xcf\xef\x7c\xbf\x38\x7c\xca\xc9\x94\x48\x87\x3d\xe3\x9f\xc8\x27\xf1\xb5\xa4\x81\x6f\xd6\x5a\x0e\xb3\x73\xcb\xac\x4a\xa4\x51\x82\x89\xbd\x2a\x28\x25\x37\x50\x91\xf8\x6e\x59\xdd\x5a\x87\xba\x19\xba\x52\xd1\x00\xb8\xb0\x35\x94\x6b\xae\x84\xad\x71\xf7\x37\xda\x5c\xb3\x52\x1b\x03\xa5\x93\xbd\xbd\x74\xc2\xb2\xc7\x6e\x0e\xcf\x89\x2c\x9c\x59\x68\xb8\x09\x59\x57\xeb\xa2\x4e\xf2\xd9\xd9\x61\xda\x39\x13\xe7\xa4\x9b\xab\x4e\x3b\x04\x62\x74\x68\x26\x5e\x4e\x5b\xc2\xc0\xd4\x33\x11\x06\x73\x1f\x52\x3d\x60\xb1\xcd\xc4\xb1\x38\xef\x34\x65\x5a\xc7\xe0\xbb\x9d\x36\xc5\xb5\x80\x63\x3c\xec\x9f\x84\x2c\x49\x1e\xb8\x3d\x9e\x4d\x90\xdf\xee\xef\x5e\x73\x92\x30\xcc\xe2\xdf\xf2\x65\xba\x55\x8e\x84\x3b\x3c\x44\x8b\x08\x16\x54\x8a\xc2\xe6\xda\xac\x0a\x4e\x69\xec\x82\xf2\xb6\xc5\xf5\xd9\x20\x7f\x3b\x23\xb9\x0e\x89\xec\xed\xa4\xb8\x8a\x61\xdf\x50\xa5\x95\xad\x31\xa0\x9c\xdc\x9e\x75\xba\x20\xe0\x84\xe6\x27\xe9\xb0\xc3\xec\xa0\x97\x5c\xca\x05\x2f\xaf\xef\x15\x9c\x1f\x2d\xf4\xf2\xc2\xfe\x54\x45\xdd\x48\x40\xc5\xee\x95\x75\x38\x71\xbf\x3a\x03\x55\x35\x5a\x28\xc7\x8e\xe2\xd2\x93\xdc\x40\x38\xb3\x0d\x31\xe7\x1d\x96\x86\xdb\x11\xdd\xda\xe7\x54\xe5\xf7\x5d\x6e\x67\x8d\x96\xa2\xdc\x27\xfd\x76\x78\x15\x4e\xda\xb2\xb5\xbe\x21\xb7\xc5\x00\x77\x1d\x30\x17\x6c\x4c\x2c\x34\x70\x86\xcb\xfe\xc4\xa5\xa8\x18\x1a\x0e\x2f\x80\x67\x33\x76\x40\x9d\x02\x07\x67\x8c\xfb\x8e\x81\x80\x21\xb9\x42\xa6\x55\x19\x44\xb9\xfd\x97\x19\x3b\xf8\x4e\x9b\x85\xa8\x0e\x52\x56\x61\x72\x86\xbc\xb5\x10\x95\xcd\x17\x37\xad\x42\x8f\xe0\x5a\x34\x0d\x92\x48\xc1\x07\xfa\x8e\x89\x25\xf2\x0f\x7a\x2e\x14\xe9\x63\x68\xa0\x0e\x0f\x1d\x5b\x0a\x25\xec\x1a\x2a\xb6\x05\x87\xab\xbc\x85\x46\xf2\x12\x0e\xce\xd0\xb4\x94\x20\x6d\xc7\x1b\xa9\x1d\xe0\x3d\xda\x28\x2a\x94\xd0\xb3\xd6\xa7\x09\xc8\x6f\x50\x70\xc3\xb4\x82\xc3\x87\x66\x8a\xcf\x5b\xa7\x6b\xee\x44\x49\x72\xe8\xad\xfe\x98\xeb\x10\x55\x1f\xc9\x34\x97\x32\xd3\x69\x20\xdc\x1a\x8c\xb7\xe2\xe4\x21\xa3\x21\xcf\x3c\x19\x74\x49\xdb\x1a\x0c\x3b\xd2\x4a\x6e\xef\xe4\x73\x92\x89\x94\x23\x99\xa0\x92\xe7\xac\xe1\xd6\xa2\xdf\xdb\xc1\xa1\x9a\xd7\xbc\x12\xa8\x04\xe7\xa4\x18\x76\x1e\x9a\x14\x94\x97\x8a\x79\xd9\xd0\x62\x21\xe5\x2e\x5a\x76\xa0\x7f\xfd\x03\x84\x56\xe7\x93\x06\x43\x8c\x7e\x5c\xb0\xf4\x49\xa7\x05\x6c\x9e\xd5\xf3\xd1\x87\xe7\xa7\x27\xcf\xd8\xb1\xff\x6f\x3e\xbd\x21\x87\x74\xfe\x87\x2f\x6a\x6f\x59\xbf\x38\xb5\xf3\x50\xe1\xca\x6a\x76\x15\x34\xa0\x2a\x50\xa5\x20\x77\xfa\x91\x4a\x32\xdf\x66\xab\xdc\x59\xa5\xe5\x3d\x1e\xe1\x55\x95\x52\x56\x39\xa2\x5d\xdf\xc0\x90\x7d\x62\xb1\x1a\x01\x1a\x76\xc3\xc9\xa0\x90\xac\x0d\x2a\x2d\xec\x97\xbf\xe5\x34\x90\x7a\xfb\x98\x25\xa9\xb8\xc2\x78\xf4\x01\x1f\x1a\x29\x4a\x81\xe2\xe7\xeb\xf2\xb4\x83\x6b\xa1\x48\x11\xae\xc5\x6a\xcd\x24\x6c\x40\x26\x67\xd8\x6f\x93\xb2\x76\xe3\x62\xf4\xa4\xcb\x4a\xb8\xb1\x3d\xb4\x70\x68\xb2\xba\x95\x3e\x15\x58\x12\xb7\x2e\x7c\xf0\x24\x5b\x80\xbb\x01\x50\x6c\xde\xfd\x21\xba\xea\xb3\xf7\x7a\xe1\x85\xe1\xda\x9f\xdc\x2c\xe4\xb8\xe7\x5e\xd9\x94\xa8\xe6\x63\xa3\x44\x17\x79\xa0\x79\x8f\x7a\x71\x87\xd0\x7d\x26\xc2\xd5\x1e\x55\x8c\xe2\x56\x93\x10\x19\xb0\x0d\x06\xe2\x8b\xe0\xc6\xad\x40\x81\xe9\x76\x91\x99\xc7\x8c\x50\x1d\xff\xd4\xfc\x1a\xd5\xe0\x1d\xb5\xce\xe8\x8b\x94\xb2\xb5\x6e\xa7\x62\x99\xcb\x11\xa8\x8d\x30\x5a\x3d\x2e\x0d\xb2\x45\x3a\x22\xb4\x31\x1e\x0f\xea\xc4\x69\x26\xd4\x7b\xe4\x94\x14\x65\xe6\xef\x6d\xb8\x11\xc8\xc4\x76\x2c\xdb\x9d\x52\x6b\x5d\xd0\x3d\x7f\x7d\xfe\xea\xc5\xd5\xe5\xf9\xf3\x17\xc8\x49\x97\x6f\xbe\xfd\x3b\x7e\xe1\xed\x89\x46\x8b\xf4\xb4\x7b\x43\xd2\x8e\x66\x35\x38\xbe\x4f\x45\x37\xbe\xb9\x2a\x1f\x29\x1f\x83\x27\xf9\x97\xe7\xec\x1d\x1d\xe0\x8a\x9b\x05\x5f\xc1\xac\xd4\x12\xa5\xdb\x7a\xa3\x9f\xc4\x2f\xb5\x2c\x2a\xcd\xa4\x56\x2b\x30\x4c\x41\x09\xd6\x72\xb3\x65\x6d\xa3\xfb\x99\x96\xb6\xa9\x38\x7a\xf6\x4f\xf9\x40\x2a\x61\x4b\xbd\x01\xb3\x9d\x95\xe8\xda\x67\xa8\x14\x27\xcd\xf5\xea\xc4\xc3\x4d\x4f\x3d\xc7\x87\xde\x6d\x1b\x18\x69\xff\x8a\xcf\xb0\x52\x0a\x64\x6d\x02\x18\x22\x27\x44\x7d\xca\x82\xcf\x34\x8f\x2d\x2d\xc8\xc2\x95\xb0\xd7\x5e\x11\xfa\xa2\xf2\x3c\xab\x63\x85\x6f\x26\x89\x09\x84\x5a\xa1\x8f\xf1\x50\x4e\xd8\x39\xef\x0b\x0f\xe7\x56\x1b\xa8\x43\x0c\x11\xeb\x8e\x59\xdb\x04\x79\x9e\x3b\xb6\xde\xa7\x13\x75\xeb\xac\xa8\x00\xe3\x40\x59\x45\x1f\x35\x53\x7b\x61\xd9\x50\x3d\x0c\xa7\x9f\x55\x0c\x49\xf5\x53\xd7\x25\x8f\xa5\x6e\xf2\xf3\xaa\xaa\xeb\xd7\xc8\x97\x3d\x72\x6b\xa3\xdb\x95\xc7\x67\x9e\x0c\x08\xed\x6a\xf2\xa4\xd9\x6e\xad\xad\xdb\x27\xfc\x39\x3e\x7e\x1b\x7c\xd9\xe3\xe3\xa2\x5f\x1f\xc6\x3d\x23\x98\x61\xb9\x3c\xf0\x48\xf1\xe0\xa0\xe0\xdd\x98\xcf\x47\xc9\x53\xcf\x2c\xe9\x70\x86\xc7\xd0\x5a\xca\xa6\xfe\xf5\xdd\xbb\xcb\x2e\x94\x8c\x8e\x76\x67\x96\x85\x75\x42\x3f\xa2\x12\xbb\x40\xf8\x81\xa5\x79\xf2\x58\x46\x7b\x8c\x62\xcf\x59\xe0\x29\xff\x66\x64\xf6\x1a\xec\xba\x33\x38\xc8\xd0\x25\x37\xc1\x88\x51\x5c\x84\xa6\xa6\x75\x0b\xdd\xaa\x8a\x5d\x5c\x32\xc3\xd5\xea\x89\x6b\x39\x22\xc7\x1e\xfc\xf6\x3c\x12\x0b\xcf\xf3\x88\x72\x45\xb3\x94\x2b\x9a\xa4\x64\xd1\xf3\x8b\x6f\xdf\x32\xdb\x2e\x14\xa4\xde\xc4\x5e\xfb\x29\x99\xff\x12\x9a\x2c\x69\xeb\x49\xdc\x18\xfd\x61\xcb\x8e\xe6\xcf\x4e\x0b\xfa\xef\xe4\xab\xe9\xb3\x2f\x3f\x2f\x9e\xfd\x91\x3e\x3c\xfb\x7c\xfa\xec\x4f\xf8\xe9\x2b\xff\xf1\x8f\x79\x37\x41\xaf\x7b\xc1\x1f\xc6\xbd\x14\xfd\x4e\x07\xab\x05\x3e\x17\x40\x5e\x59\xe8\x6f\x9e\x87\x83\x2d\x88\x2d\x0b\xa1\x4f\x3c\xd0\x79\xc1\xfe\xdc\x29\xa4\xae\x4d\xb7\xcb\xac\xce\xd1\x89\x9a\x63\xc8\x93\xb9\x71\xc8\x14\x54\xea\x07\x87\x7f\x09\x4c\xdb\x35\xec\x44\xcc\xdf\x6b\xa9\xaf\x05\x7f\x44\x31\x78\xe9\x57\x88\x82\x10\xd2\x5a\xb6\xdf\x50\xeb\x89\x12\x1f\x7d\xc9\x37\x9c\xf1\x15\x65\xd1\xae\x00\xd8\xda\xb9\xc6\x9e\x9d\x9c\x04\x64\x0b\x6d\x56\x27\x06\xa8\x69\xa7\x84\x93\xb5\xab\xe5\x09\x3d\x6d\x0b\xfc\xf7\x53\x66\xfc\x92\xcf\x4a\x30\xfb\xa8\x5a\x24\xdd\xe5\x8b\x57\x0c\x54\xa9\xd1\xdc\x3c\x3f\x67\xf8\xa6\x58\x8a\x32\xb4\x5e\x60\x0c\xdf\x70\xb7\x9e\x26\x4c\x37\x60\xc4\xb2\xb3\xf7\xe9\x71\xb0\x53\xde\xd0\x88\x00\x62\x4f\xf1\xcc\xbc\x31\xda\xe9\x52\x4b\xca\x5c\x50\x43\x8e\x0d\x59\x90\xd6\xc2\xcc\x5a\x39\xf3\x60\x66\xbc\x75\x6b\x50\x2e\x2c\xeb\x1f\x27\x8e\xeb\xfc\x82\x93\x0d\x37\x27\xa6\x55\x27\x16\x4a\x03\xce\x9e\x74\x2d\x62\xc8\xc8\x41\x91\xf1\xb2\xd4\xad\x72\xf1\xe3\xac\xe4\x45\x69\xdc\x9c\x84\x20\x71\x50\x4f\xac\x02\x06\x8d\x11\xaa\x14\x0d\x97\x7b\x36\xbe\xbf\xa3\x6a\x64\x78\xe7\xc8\x4e\x42\x01\x9a\xb2\xdc\x8b\xd8\xc6\x2e\x14\xe3\x23\x94\xa2\x4c\x03\x6a\xa7\xd8\x6d\x13\x54\x72\x64\xcd\x68\x4f\x1e\x97\xa0\xfe\xc9\xcb\xb8\x87\xaf\x4b\xf5\xb5\xdd\x5a\x07\xf5\x59\xcd\x2d\xcd\xf8\xa0\xe2\xa2\xd8\x55\x7d\xbd\xe6\x37\x4e\xe8\x99\x56\x52\x28\x28\xfc\xa7\xc2\x6e\xca\xb0\x7a\xa9\xbe\x5e\x22\x06\x68\x00\xb5\x84\x02\x3f\xf8\x3f\xdf\x4e\xf8\xce\x0b\xdd\x57\x66\x7e\x10\xd6\x81\x6f\x6e\xa5\x82\x43\xc9\xad\x8b\x7d\x99\xf6\xce\x56\x28\xf8\xe0\x40\x55\x50\x45\xf2\x94\x6b\xd8\x23\xab\xfc\x0a\x63\x37\x17\xfa\xce\x76\x4f\x31\xc4\x35\xb6\x3b\xe3\xa5\xe4\xab\x18\xd3\xc5\x25\xd9\x35\x6c\x59\x6b\xf9\x0a\x3d\x4b\x32\xa6\x8f\x7b\xac\x5e\x51\xdf\x4e\xf6\x3d\xbd\x30\xe4\xef\xbf\xa2\xa7\xc5\xab\xca\x04\x1e\xed\x7a\x2c\x22\xa7\x92\x46\x4c\x83\x26\x42\xa1\x86\xa0\x82\xd0\xfc\xe0\xbf\x8e\x0f\xbc\x8b\x7f\x10\xec\xde\x01\xa1\x4b\x82\x31\x8d\x7e\x36\x18\x4b\xaf\xf9\x3c\x0b\x05\x12\x0a\x1c\x95\x54\xc8\x9e\x2e\x79\x99\x0d\x13\xcd\x0f\x8e\x0f\x06\x1d\x99\xdc\xda\x1b\x6d\xf6\xc9\xe7\x90\xc0\x86\xc7\xbd\x32\x43\x1a\xf5\x09\x3a\x65\xc3\xa3\x21\x27\xbb\xb5\x60\xe6\x4c\x37\x7e\xd6\x89\x6c\xe2\x83\xbb\x51\x47\xc4\xdb\x77\x30\x66\xdd\x94\x5f\x7e\xf9\xd5\x60\x7b\x81\x2f\xf6\xdd\x5e\x78\x3c\xf4\xd2\x77\xb1\x17\xb5\x42\xd2\x61\x04\xde\xea\x77\x49\xda\x21\xbf\x64\x28\xe0\xde\xf7\x5c\x9e\x72\x9e\x5d\xe8\x37\x42\xdf\x3e\xdc\xdb\x19\xfb\x5e\xc9\xfc\x79\x0d\xb4\xb3\x11\x2b\x94\x8d\x3d\xdd\x82\x05\xdb\x5f\x58\xfc\x99\xef\x3b\x0c\x75\x9e\x9c\x45\x5e\x55\x82\x0a\x44\x32\x9d\x7a\x00\x85\xee\x75\x45\x43\x53\x95\x50\x0f\x74\x3a\xfe\x1f\xfd\x7b\xf6\x7e\x53\xcf\xbc\x53\xf3\xcb\xcb\x9f\x5e\x05\x19\xec\xf7\xff\x87\xc5\xba\xdc\xd8\xfb\x4d\xfd\x78\x39\x31\xc4\xa2\x9f\x0b\x73\xc3\xa0\x8d\x1e\x41\xa7\xd9\xb4\x6a\x67\x5e\xef\x49\x87\x11\x15\x2c\xda\xd5\xfd\xc5\xa5\xe4\x72\x1a\xa8\xb5\x03\xff\xda\x2a\x34\xd4\x84\x1c\x52\xf8\x12\xf9\xd6\xe3\xcb\x9d\xe3\xe5\xba\x8b\xc9\x5e\xfe\xf4\x6a\xca\xa0\x58\x15\xd3\xd0\xbb\x41\x8d\xd4\x4b\x6d\x6e\xb8\xa9\xbc\xdc\xf5\xd0\x9a\xd9\xd6\x36\xa0\xee\xa7\xcf\x95\x7f\xce\x53\xde\x71\xb3\x02\x47\x47\x22\xea\x1a\x2a\xc1\x1d\xc8\x6d\xec\xde\x73\xa9\xfb\x59\x72\x6b\xf1\x44\xa5\xe6\x15\x9d\x41\xa7\x96\x04\xda\x50\x8c\x94\xf6\x58\x1b\x3d\x0c\x67\x43\xdb\x20\xbd\x12\xce\x09\x6d\x00\xf5\xc3\x44\x06\x11\xc3\xee\x66\xa9\x57\x76\x28\xad\x93\x1d\x22\x04\x0b\xb5\x8f\x96\x32\x5c\x59\xd2\xba\xd1\xaa\x71\x17\xad\x9a\x26\xe1\x0d\xee\x05\x4d\x81\xc2\x8d\xdc\x32\xc9\x5b\x45\x47\x84\x08\x76\xa8\x1c\x9f\x7d\x71\x7a\xfa\x45\x0f\x99\x8f\xd5\x15\x08\x38\xbe\x9b\x6a\x14\xfd\xfa\xc0\x3e\x91\x53\x12\xd6\x1d\xf1\x1c\xc4\x65\x77\x64\x0b\xa2\x8e\x22\xd3\x77\x4b\xc9\x01\x15\x58\x84\x18\xb3\x07\xe3\x8d\x55\x59\x12\xac\xab\x1c\x14\xec\x6d\x80\x9b\xf7\xaf\xe5\x40\xbb\xb9\xa5\xaa\xb2\x94\x9d\x99\xd9\x92\x53\x07\xf8\x11\x35\x8e\xfb\x0f\x33\xa7\x67\xbf\x82\xd1\x13\xb6\x04\xee\x30\xbc\x9b\xb2\x45\xeb\xc2\xcc\x69\xfc\x8e\x12\xb7\x54\x8c\xad\x81\xe3\xb2\xcb\x56\x76\x96\x3d\x54\x76\x5b\x0b\x77\xa4\x72\x9e\xb0\x6e\x42\xe2\x44\x72\x90\xb8\x3e\x2c\xdd\xe1\x32\xe6\xc8\x40\x05\xc9\x4f\x0d\xfd\xbe\xec\xeb\x34\xc5\xf9\xf3\x75\xc3\x8b\xec\xe1\x22\xb0\x6a\x51\xc1\x26\xd4\xb6\xee\x7a\x20\xfb\xc3\xa4\x78\x8b\x96\x2e\xea\xbe\x88\x48\xa5\xcb\xb6\xeb\xd9\x20\x5f\x5f\x53\xff\x30\x72\x7f\x32\x17\x63\x14\xa8\xc1\x19\x51\x7e\x1a\x12\x78\x58\xb7\xd1\x20\x6b\xeb\x98\xc7\x62\xb0\x61\xf3\xb2\x69\xe3\xc7\xc7\xdc\xa7\xd7\xdf\xf7\x79\x9c\x57\x10\x94\x2e\x09\x3a\xf5\xe3\x24\xa4\x43\x3d\x57\x1b\x9a\x18\x6b\xc0\x94\x88\xc8\x8a\x5c\x6d\xb4\x13\xd9\x85\x0c\xbb\x44\x99\x74\x2d\x49\x97\xba\xfa\x14\x9b\xab\x85\x22\x11\x87\x7d\xbc\xe8\x38\x52\xa7\x52\x23\xf8\x65\xba\x58\xa2\x73\xfd\xa2\xf2\x42\xb3\xab\xb6\xd4\x3c\x7b\xdb\x68\xe9\xa1\x65\xc7\xc7\xa8\x49\x8e\x8f\xb3\xd4\xdb\x34\x2a\x0c\x82\xbc\x73\xe1\x81\x25\x35\x04\x15\xab\xf4\x0d\x95\x02\x10\x80\x57\x2c\x4a\xbb\xcc\xf3\xec\xb4\x6b\x95\xcd\xd2\x21\x3e\x9f\x84\x72\xfc\xc3\x7e\x94\x3b\x57\xac\x6d\x1a\x30\xcc\x67\x70\x93\x8d\x1b\x21\x62\xf0\x4d\x4c\x52\xd3\xac\xe1\x86\x4b\x09\x72\x94\x82\x11\xf1\x35\xb7\x0c\x35\x17\xd2\xa3\xe4\x4d\x48\x3e\x12\x44\xcf\x54\xb6\x6b\x98\xb0\x8e\x4b\xe9\x5f\xff\x44\xb2\xf1\xc9\xba\x7f\x86\xa6\x2d\x75\x01\x95\x5a\x79\x9f\xde\xb2\xb5\x96\xd5\xd9\x71\x6f\xd2\x98\x1c\xdf\x54\xf4\x0e\x30\x82\x85\x3e\x26\xc5\x9e\x75\x46\xde\xd2\x46\x44\x06\xc8\xab\x8f\xd4\x00\xf4\x1b\xda\x82\x86\xce\xc4\xa7\x71\x22\x82\xf3\xd0\xa7\x66\xc8\xe4\xd8\xe8\x56\xf9\x89\xe6\xf8\x4a\x57\x4a\xa5\x4e\x23\x5f\x18\xa7\xf6\x49\xa1\x62\x4f\xfe\xae\x4f\xe0\x9b\x99\x97\xad\x94\x09\x50\x3f\xc6\xa1\x0e\x1e\x84\xe5\x3b\x33\xa9\x99\xf3\xfc\xd5\x8b\x1f\xfe\xfe\xfd\xeb\xf3\x77\x17\x3f\xbd\xf8\xfb\xf3\x37\xaf\xbf\xbb\xf8\xcb\x8f\x6f\xcf\xdf\x5d\xbc\x79\x8d\x8f\xbc\xbc\x7a\xf3\x9a\x79\x16\x2a\xb2\x91\xfe\x0e\x7c\x68\x58\xf4\xbd\x07\x18\x32\x92\x6b\xe0\x22\x1e\xfd\xf5\x77\x62\x1c\x7f\xc2\x1e\x72\x0a\x87\x6e\x29\xf8\x8d\xf1\x49\xea\xfb\x84\x27\xed\x74\xf4\xa8\xb0\x8f\xb5\xed\xa3\x12\xce\x9f\xf7\xc8\x2e\xc1\xed\x1c\x6f\xff\xbc\x72\x04\xd6\x5c\x29\x90\xb3\xc0\x55\x7b\x3a\xdc\x3f\x04\x77\x3b\xbc\x1d\x02\x55\x6e\x59\xe8\x69\xd2\xcb\xfe\xc4\x84\x3f\x4c\x44\x3e\xb5\xa1\x53\x3f\x69\x04\xe0\xef\x28\x41\x92\x12\x6f\x78\x56\xfa\xf1\xed\x85\x1d\x45\x55\xa8\xeb\xdf\x8c\x68\x05\xd6\x09\x95\x7a\x59\x3f\x3d\xb6\xd1\xf9\xfd\x5d\x28\x3b\xba\xee\x47\x90\x29\xbe\xfc\x1b\xe9\x94\x1c\xff\xbd\x08\xb5\x81\x8f\xa6\x12\xbd\x4b\xcf\xdb\xae\x5d\x70\xa7\xf1\x69\x01\xcc\xb6\x0b\x7c\x7d\x41\x62\x33\x8a\x72\x06\x69\x17\x5f\x76\x14\x6e\xd4\xe0\x5d\x8f\xf9\xc2\xe8\x6b\x30\xd9\x30\x3a\x59\x9e\x83\xa0\x98\x0e\x26\x23\x7b\xfc\x98\x13\xd9\x6b\x87\x8d\xd1\x55\x5b\xc2\xa7\xdc\x58\x0f\xff\xa5\x90\x0e\x4c\x38\xa4\x59\xe4\xcd\x7b\x15\xa7\x9f\xbf\xb6\xe1\xf5\xe0\x08\x13\x42\x83\xb6\xcf\x35\xf0\x0a\x0c\x3b\x28\x61\x16\x0c\xec\x5a\x58\xa7\xcd\xf6\xa0\x60\x57\x42\x95\x41\x91\xa2\x4e\xa7\xe9\x16\x30\x82\x5c\x1a\x19\xde\xec\xf9\x5a\x50\xeb\x8d\x37\x63\x9c\x2d\x5b\x97\xdd\x24\x93\x19\xd2\x69\x86\x54\x66\x59\x28\xba\x1d\x9d\x4e\x12\xd6\xa7\x34\x92\x8f\x51\xfb\x04\x0f\x57\xec\x59\x94\xd6\x7e\xe1\xb0\x4e\x6a\x75\x86\x6a\x99\xdf\x5f\x6e\x8e\xf4\x8a\xda\x9c\xce\xe9\xca\x0b\x7e\x63\x80\x9d\x16\xcf\xbe\x60\x1e\x96\x58\x08\x29\xdc\x96\x2d\xc5\x07\xb0\xec\x28\xf2\x79\xb6\xf9\xfe\xd6\x6d\xff\x6e\x01\xa1\xae\x67\x0b\xa1\xaa\x68\x64\xee\xbe\x5d\x8d\x92\x1b\xe1\xf1\xb1\xd6\x1d\x4e\x00\xe9\xae\x89\xce\x14\x5d\x09\x75\xfd\xe7\xf0\x4e\xf4\x5a\x8a\x77\x64\x0f\x33\x23\x36\x4a\x6b\x1f\x94\x59\x0f\x77\x25\x81\xc0\x17\x77\xdd\x63\xf7\x20\xf7\x35\xdc\x9b\x94\xfc\xae\xae\x7a\x46\x49\x97\x68\xc3\x33\xaf\xa1\x2b\xbf\xfb\x72\xde\x63\x4e\x36\xbe\xa2\x15\xee\x48\x2c\x8d\x1d\x40\xcf\x85\xc4\x80\xd4\x60\x04\x9a\x25\x8d\xfa\x1d\xb0\x95\xc6\x53\x91\x5e\xea\xa8\x0d\x37\xf6\xa5\x24\x3f\xfa\xd8\xef\xf4\x38\xfa\xda\x24\x19\x5a\x51\x79\x00\xd5\x0b\x05\x1e\xaa\x0c\x17\x0c\x1e\xe6\x53\x36\x7d\x6c\x6e\xbc\xeb\x17\x59\xc7\x83\xed\x4c\x04\x89\x29\xad\xe1\xb3\xb5\x6c\x8e\xd2\x75\x74\xe0\x9f\x3b\x93\xba\xbc\x26\xca\x3b\x90\xb8\xe3\xfa\x6c\xa1\x9d\x3d\x98\x14\x45\x31\x2f\xd8\xeb\x37\xef\x5e\x9c\x79\xdd\x10\xe8\x55\x55\xd6\x6b\x32\x4e\x3d\xfb\xb5\xf0\x53\x75\x63\xcd\x5f\xa9\x37\xcd\x97\xb9\x7b\xf3\x8a\x06\x78\x75\x72\x63\x44\xf2\xa4\x6a\xde\xd8\x30\x44\xc1\xe9\xae\xac\xb4\x6f\x03\xe8\xdf\xf9\xf2\x64\x52\xa6\x9d\x55\x18\xae\x42\x1a\x23\x59\x89\x3b\xb3\x83\x4f\x7b\x08\xee\x01\xa2\x66\x33\x59\x1b\xd4\x56\xfc\x18\x91\xc7\xa1\xd7\x9f\x53\xca\xb6\x82\x59\x85\xa7\xce\x1d\xfe\xa3\x37\xdb\x70\x6f\x45\x4b\x79\xfc\x7d\x11\x39\x86\x02\x7e\x84\x0b\xb7\xc2\x1d\x06\x83\x8a\xcb\xed\xaf\x21\x71\x15\xfc\xab\x52\x57\xbe\xa5\xa0\xaa\xfa\x63\x0a\x69\x24\x84\x14\x8f\xc7\xaa\xf3\x97\x0a\x9a\x1d\xcb\x58\x7d\xbe\xc3\xbf\x61\xce\x94\x22\xa1\x39\x59\x87\xf0\x1d\xe1\x37\xec\x9b\xeb\xea\x18\xe1\x2e\xc0\x1c\x99\xe2\x96\xf6\xc7\xdd\xc8\xa2\xe6\xcd\x1e\x51\xc5\x6b\x5e\x43\x76\x6d\x96\x7f\x2f\x6b\x2c\xcf\x38\x08\xad\xb2\x57\x41\xb8\xb3\x82\x7d\xeb\x57\x26\x01\x3b\xf8\xd7\x8c\x79\xe9\xca\x9a\x7f\x9b\xe1\x53\x07\xbd\x1b\x20\xf8\x02\xe4\xec\x1a\xf6\x19\xde\xfa\x81\x1a\xa7\x46\xf1\x10\x15\x28\x27\x96\x5b\x3f\x9c\xa3\xfd\x50\x95\x83\xce\x44\x8d\xa0\xe7\xa7\xee\xc2\x08\x9e\x36\xab\x93\x0c\xdd\x11\x1c\x29\xe9\xb2\x37\x96\x59\x8a\xe6\x13\xe0\x3a\xd4\x55\x74\x67\xce\x67\x5d\x75\x04\x14\x6f\xc4\xe3\x15\x21\xf1\x8f\xe7\x97\x17\xec\xdb\xab\x1f\xee\x1e\xf1\xa1\xc6\x9b\x34\x6a\xd1\xab\x42\x84\x44\x4c\x04\x85\x4a\x79\xd8\xe3\x98\x0f\x1c\xe8\x9b\x47\xbd\x48\xee\xcd\x4d\x77\x89\x1c\x28\x1b\xf2\xd5\x61\xb8\x8b\x36\x00\x55\x66\x24\x17\x20\xb5\x9f\x58\x1c\x9e\xc4\x02\x28\xab\x1f\xde\x40\x8b\xe0\x0c\x57\x76\x49\x19\x1b\xa5\xb4\x8b\x45\x18\x55\x0d\x6e\x42\xcd\xa1\xe8\x90\xac\xb1\x40\x1b\xcf\x96\x7e\xd2\xe9\x0a\xef\x98\xcd\xb2\x7d\x3e\xa0\xc3\x2b\x28\xb2\x9c\x48\xbe\xc1\x21\x12\xd0\xf4\x0a\xa3\x61\xad\x07\xdd\xa0\x9a\x2d\x13\x68\xbf\xbb\x42\x2a\xbc\x06\x46\x7b\x3c\x9e\x8b\x60\x3b\x11\xe2\x14\xf6\x84\xcf\xc4\x7e\x59\x91\x9f\x5b\x2b\x56\x6a\x78\xdb\x44\x07\x44\x0f\xfe\x54\xb0\x0b\xc5\x4a\x1e\x92\x6a\xe9\x39\x61\x19\x79\x3d\x6a\x35\xed\x6c\x2b\x2d\x1e\x6a\x17\xe8\x4c\x12\xfb\x52\x11\xdd\xa7\xd1\xe2\xdb\x05\x23\x0f\x3d\x54\xfc\x28\x32\x0a\xde\x94\x97\xfa\x65\x2b\x59\xb8\xcc\x0b\x3e\x38\xdb\x5d\xad\x68\xe0\xd0\x32\xa5\xd3\xb0\x77\xb8\x4b\x91\xf1\x30\x24\x3d\x72\xa7\x62\x0f\x6b\x9f\x85\xd5\xaa\xa3\x68\x6f\x06\x39\x5c\x70\x65\x69\x42\x7c\x8a\xf1\x40\xd9\x2d\x8b\x31\x61\xbd\x00\x32\x9a\x5d\xbd\x5b\xd4\xe8\x02\x1b\x58\x09\xeb\xcc\xf6\x69\x77\xf3\xfb\xf3\x98\x85\xdd\xee\xd3\x68\xbf\x73\x82\x47\x50\x37\x6e\x3b\xe9\x28\x9a\x22\xab\x11\xce\x78\xb0\x1f\xb7\xd3\xda\x5f\x81\x83\x32\xbb\x7d\x23\x1f\xc9\x12\xcb\x11\xce\x8a\x51\x5f\xd4\x9c\x47\xa2\x33\x94\xf1\xbb\xde\xf1\x63\xc0\x91\x0d\xb7\x36\x46\xd7\xe0\xd6\xd0\x3e\x78\x78\xe5\x21\xc1\xd7\x65\x5a\x25\x8e\xb6\xe4\x0d\xed\xdd\x5f\x67\x31\x0a\xcf\xb2\x5d\xdd\x45\xa2\x7e\xa0\x62\xf7\x2a\xed\x30\xd0\x32\xbf\x8a\x83\x26\x74\xd9\x7b\xfa\xfc\x4a\x2b\xe1\xb4\x99\x77\xc6\xa0\xeb\x77\xf1\x34\x8e\xf5\x74\x4f\x4a\x5b\x1a\xde\x0c\xe3\xad\xe9\x30\xe0\xca\xb6\x14\x3d\x5f\x9f\x56\xf7\x15\x48\x9b\xc6\x3f\x36\xdc\x6c\xd9\x4e\xcd\x32\x2b\xb9\x85\xf1\xdd\x82\xfd\x8c\xfb\xf8\x77\x7f\x0f\x9d\x57\x32\x11\x16\x55\x64\x02\x3c\x8f\xc2\x2b\x51\x1a\x7d\x19\x92\xf2\xaf\xfc\x63\xf1\x86\x9d\x34\x0a\x14\x99\x25\xac\x10\x6e\xb9\xd8\x05\x36\xd8\xcf\xcb\x57\xff\x41\x0f\x18\x07\xa6\x60\x3f\x9f\xbf\x7d\x7d\xf1\xfa\x2f\xe1\x66\x5c\xf2\x49\xb2\x8b\x0a\x6e\xa3\x71\x77\x9d\x0f\x25\xa2\x42\x0f\xd9\x4a\xb8\x75\xbb\x28\x4a\x5d\x9f\x94\xda\x80\xb6\x27\x1d\xff\xcd\x22\x19\x7f\xc9\x50\x79\x13\xbe\xfb\x5b\xd4\x77\x09\x3e\x35\xa8\x89\x18\xa9\x2f\x52\xc9\x0e\xaa\x82\xfd\xa7\x6e\xe9\x30\xa9\x10\x1e\xdb\xac\xeb\x88\xa2\xd3\xb1\xfd\x36\xe9\xcb\x1d\xfe\x4c\x97\x66\x08\xb7\xd6\xad\xbb\xfd\xc4\x7d\xb0\x3a\x96\x3e\x79\xd2\x51\xe6\xbe\xfd\xa0\xd9\x9e\x6f\x6b\x09\xfd\xd3\x97\x5f\xfe\x69\x4e\xbf\xbd\xe0\xaf\x23\xf5\xec\x17\xd8\x78\xf4\xea\xcd\x70\x12\x7b\x77\x50\xde\x21\xca\xa8\x7c\x93\xea\x1b\x34\x61\xdd\xb1\xf4\xc3\xdd\x9f\xdb\x31\xf0\xa0\x76\xdb\x72\x77\x19\x2f\x75\x42\x7f\x6c\x40\xf9\x2e\xe6\x41\x82\x30\xf8\x26\x91\x57\xbc\x89\xf6\xf9\x1e\x61\x1e\x78\x0b\x47\xfe\x2a\x53\x7f\xe1\x08\x85\x4e\x6e\x9e\xc1\xbc\x86\xed\xa4\xe8\x62\xfe\xfc\xf2\x4c\x09\x4b\xc7\xc8\x32\x76\xf7\x70\x4c\xd3\x2d\xe2\x61\xbe\xda\x5f\xb9\x1f\x3b\xad\x32\x94\xc6\x7d\x96\xdc\x05\xbb\x70\xf1\x8a\xce\x21\x55\xbd\xc2\x0a\xdc\x95\x99\xb1\x56\xca\x99\x9f\xba\x78\xc4\x11\x9e\xcb\x56\x4a\x76\x45\xab\x04\x3d\x61\x7d\x3e\x15\x97\x67\x7e\xf9\x74\x2d\xa9\xae\xa6\x5d\x2c\x97\xa5\x0c\x29\x0d\xe6\x8c\x80\xcd\xf0\xb6\x58\xef\x5a\xf9\x00\x4f\xa5\x0b\x79\x92\xaf\xe5\xcd\x4b\xbe\x54\x34\x58\xe9\xd6\x9d\x9a\xab\x96\xfc\x08\x6d\xe8\xa2\x30\x72\x63\xb7\xba\x3d\xdc\xf4\x2c\xce\xa0\xd7\x98\x9a\x40\xb2\x05\x3b\x8c\xe2\xd2\x71\x53\xf3\xac\x9f\x20\x5e\x5c\xee\x93\x2f\xe1\xb6\x24\x8f\x57\xe6\x7d\x13\xba\xb4\xb1\x7d\xc6\x4b\xb7\xa8\xb8\xbb\x2b\x89\x1f\x8a\x26\xd9\x75\xe1\xd0\xe5\x6f\xeb\x18\x89\x0e\xe9\x28\x42\x87\x43\x63\x28\xaf\x4a\xa3\x00\x5b\xdd\x66\x9b\xed\xdf\x98\x36\x82\x05\x6e\x8a\x02\x73\xda\xd7\xd4\xa3\xcd\x55\xd2\xcb\x5d\xe6\xf4\x49\xbb\xc7\xfe\xb4\xf6\xbe\x04\x79\xc0\x7c\xfe\xba\x63\x1d\x27\xeb\x48\xed\xe8\x8a\xc8\x99\xa9\x87\x54\x60\xea\xb9\xb9\x8e\x5f\x83\xea\xbc\xdc\x51\xb6\xea\xce\xa3\xa7\x2f\x7e\x63\x57\xcd\x60\xd2\x2e\xb9\xd1\x69\xb1\x1d\x29\x46\xbf\xdb\x47\x7a\xe8\xf3\x6c\x1b\x60\xf3\xfe\x58\x57\xa5\xcb\x6b\x30\x1e\xf0\x7b\xab\xd5\xbc\x53\x4b\xe1\x52\xe1\x47\x54\x49\x41\x13\xee\x4c\x15\xba\xec\x6f\xc9\xc1\xfc\xa7\xfc\xc9\xa2\x44\x89\x7b\x42\xa9\xdd\x0d\xfb\xd3\x3a\x32\x40\x63\x3c\xbe\xa3\x2c\x94\xef\x5a\x0b\x5d\xf3\x11\x95\x49\xf6\x38\xa3\x3b\x0f\xe2\x2d\x02\xb9\xef\xe7\x14\xdc\xc0\x85\xee\xc2\xb2\x50\x0e\x1a\x33\x86\x4f\xf9\x70\xf6\x9d\xd4\xda\x67\x40\x9e\x48\xd0\x4b\x8b\x49\x3b\xf3\xbf\x48\xb3\x6f\x1f\x0f\x1e\xc4\xbb\x1f\xae\x58\xf6\x16\xbd\x31\x65\x52\x5c\x03\x9b\x43\xb5\x82\xf9\x94\xcd\x1b\x6e\x6d\xb8\xa3\xc0\x37\x04\x1b\x00\x55\x9a\x6d\xe3\xe6\xfd\x6e\xbf\xee\x80\x76\xfb\xfd\xb2\x01\x9a\x5b\xba\xfe\x70\x03\xd9\xdc\xcf\x03\x36\x30\x9c\xe1\xa3\xf9\x9a\x4f\x8c\xd9\x7e\xc5\x82\x31\x8c\xae\x61\xfb\x68\x58\x85\xc9\xe0\x8f\x23\x19\x19\x6b\x6d\x84\xdb\xfe\x2e\x14\xcc\xba\x78\x3e\x0e\xef\xbc\x0d\xa8\x37\xd8\x0c\xdd\xaf\xa6\x44\x1f\x91\xda\x3b\x62\x35\x89\xf7\x9e\x0d\xdf\x2e\x05\xe2\x9b\xc1\x2c\x98\xaf\xd9\x79\xa7\x39\xf1\x78\x4f\x3a\x28\x2f\x29\x24\x64\x8d\xc9\x61\xe9\xaa\x57\xba\xa5\x6b\xd9\x48\x44\x8d\x9f\x46\x10\x8e\x28\xe5\x2f\xdb\x67\x34\xad\x9a\x72\xf2\x16\x4a\x94\xf4\x52\x2b\xe5\x8b\xe0\xc5\xc5\x32\x2e\x05\xb2\x4a\xf7\xef\x47\xc7\x75\xda\x29\x00\xc3\x6a\xbe\x4d\x79\xce\xd8\xad\x3b\x20\x14\x57\x55\xba\xc9\x1a\x55\x09\xf9\x22\x1b\x2e\x45\x15\x6f\xbe\x10\x6a\x45\x88\xac\x31\x58\x8d\xc5\x62\x7a\xec\x28\x7c\x2a\x54\xfc\x01\xaf\xc2\x6e\xca\xc9\x34\x0c\xd9\x84\xd6\x00\xa1\x96\x86\x5b\x67\xda\x92\xec\x45\x0c\x69\xaa\xfe\x1c\xdf\xb0\x47\xc0\x0f\x9e\x7f\x6a\x36\x13\xca\xd3\x73\x86\xea\x2b\xd7\x88\xfb\xdf\xd7\xd8\x53\xc0\xe1\xc6\xc6\x0a\xb8\x8c\xbf\x97\x18\x0e\xcc\x19\xbe\x5c\x8a\x32\xb6\x0c\x50\x8b\x0a\xea\xcb\x6f\xbd\xa1\xf0\xba\xf2\x2d\xc4\xa6\xde\xf0\xf8\x6f\xdf\xef\x20\x4c\x7f\xb8\xbf\x74\xa7\x69\xee\x0f\x15\xdd\x93\x45\x8c\x0f\xa7\xf8\x3e\xa6\x0a\x3b\xbb\xee\x27\xe2\xbd\xe1\xd2\x3e\x43\xe1\xa3\x54\x5f\x7d\x39\xd2\xa6\x57\xb2\x9b\xf4\x7f\x7b\x30\x71\xdd\xad\xe1\x90\xd8\xfd\xf5\xc2\xac\x3d\x9d\x0f\xef\x83\xed\x5a\xe2\xc3\x0d\x41\x83\x39\xa1\x27\xed\x40\x7c\x9a\x34\x39\xb5\x17\x50\x7e\x3c\x1e\x1f\x86\x6e\xb1\x4c\x15\x12\x44\x3b\xbf\xef\x38\x48\x82\xdd\xd9\xd5\x94\x78\xa8\xf7\x4b\x2e\xdc\xb2\xd7\xba\x82\x4b\x04\x14\x41\xff\x21\x0e\x3b\x3c\x96\xcb\xef\x17\x18\x77\x35\xfb\x64\x8a\xd5\x8c\xbc\x34\x18\x8a\xb3\xfa\x26\xc1\xd1\xa9\x51\x8b\x68\xd9\xa9\xba\xd4\x63\xa3\x2a\x7f\x09\x1e\x46\x18\x1b\x2e\x24\x8f\x37\xe9\x9d\x5f\x5e\xb0\x9a\x2b\xbe\x02\x3f\x37\xb7\x83\x9e\xf8\xe7\x8b\x37\x1e\xb5\x01\xc7\x96\x6b\xd8\x3b\xda\xf6\x0f\xc7\xee\x27\x1f\x48\x38\x1e\xee\x76\x8c\x87\x33\xfc\x31\xa1\xde\xcd\x03\x7b\xfd\xac\x8e\xbf\x75\xc0\xad\xb3\x1f\x74\xc3\x73\x15\x96\x35\xed\x42\xfa\x6b\x71\xb3\x3b\x4e\xfa\x4b\xec\x99\x47\xa6\x9c\x71\x07\xdf\x76\x97\x88\x8d\xff\x62\x53\x6f\x80\x36\xc1\xda\xf7\x87\x82\x46\x76\x84\x12\x35\x8b\x0d\x13\xdd\xe5\x31\xb7\x6d\x32\xb4\x82\x14\x14\xcf\x77\x91\xa2\x33\xbc\xf4\x2b\x3e\x96\x70\xbf\xf3\x2b\xec\x23\xdd\x01\xf1\x88\x54\x6e\x51\x43\x55\x1b\x57\x8a\x00\xb3\xca\x5a\xb8\x44\x30\x16\xac\xba\x4a\x76\xf8\x0d\xda\xf1\xc9\x99\xc8\xd0\x04\x2d\x15\x03\x3a\x7d\x10\x8c\x5c\xb2\x6f\xec\x28\x5c\x1d\x6c\xd9\xf1\xf1\x4b\x0e\x2b\x30\xc7\xc7\x93\x62\x64\x97\xff\xa7\x24\x04\xfd\xae\x43\x29\x5b\x2a\x11\xde\xd2\x41\x3b\x46\xff\xb1\x1a\xc7\x03\xf2\x79\x2a\xeb\x50\x8b\x32\x49\x16\x22\x0a\x85\x4d\x2b\x56\xdc\xf1\x24\x21\xb7\x36\x53\x4d\x46\x46\x26\xf6\xc4\x25\x8c\xfc\x27\xce\x0a\x68\xe5\x3c\x9c\x74\xde\x38\x87\xf6\xd8\x27\xc7\xc4\xf2\xba\x91\x60\x66\x2e\x5e\x10\xb9\x87\xee\xf5\xaf\x84\x14\x52\x54\x0c\x07\xf4\x7b\xe6\x07\x63\xb0\x1b\x6e\x78\xfd\x40\xe0\x69\x36\x80\x5e\xce\x96\x79\x76\x30\xf9\xec\x7f\x03\x00\x00\xff\xff\x12\x19\x65\x85\xb1\x7d\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x7d\x6b\x73\xdb\x48\x92\xe0\xf7\xfd\x15\x08\xef\x4d\xd8\xf2\x11\x94\xe4\xde\x7e\x8c\x6e\xbc\xb3\x6a\xdb\xdd\xe3\x6e\x3f\x74\x92\xba\x77\x2f\xfa\x3a\x1a\x20\x59\x12\x61\x81\x00\x1b\x00\x25\xb3\x37\xf6\x7e\xfb\xe5\xb3\x1e\x00\x48\x81\xb2\x35\x67\x4f\xdc\x4c\xcc\x58\x24\x81\xaa\xac\xac\xac\xac\x7c\x67\x53\xa5\x59\x53\x1f\xfd\x53\x1c\x15\xe9\xc2\x1c\x45\xe9\xc5\x45\x56\x64\xcd\xfa\x9f\xa2\x68\x99\xa7\xcd\x45\x59\x2d\x8e\xa2\x8b\x34\xaf\x0d\x7e\x53\x95\x17\x59\x6e\xe0\xf1\x28\x8a\xa3\x1f\x57\x13\x53\x15\xa6\x31\x35\x7f\x2c\xd2\x26\xbb\x36\xf4\xf7\xdb\xa5\x29\xce\xe6\xd9\x45\x03\x9f\x66\xa6\x9e\x56\xd9\xb2\xc9\xca\xe2\x28\x3a\xce\xf3\xf2\xa6\x8e\xa6\x65\x51\x37\x30\x73\x91\x15\x97\xd1\xcd\x3c\x9b\xce\xa3\xa2\x84\x07\xa3\x66\x6e\xa2\xac\x68\xcc\x65\x95\xe2\x0b\xd1\xb2\x9c\x3d\xaa\xf7\xa2\xb4\x32\x91\xc9\xb3\xcb\x6c\x92\x9b\xa8\x29\xa3\x89\x89\xea\xe9\xdc\xcc\x56\xb9\x99\x45\x65\x31\x8a\x26\x69\x4d\x7f\x45\x79\x3a\x31\x79\x8d\x7f\xe1\x50\x38\xe8\x28\x2a\xab\xe8\x26\x6b\xe6\x34\x70\x15\xc3\x90\x76\x95\x51\x5a\xc0\x87\xa2\xc9\x62\xfd\xa6\x77\x28\x78\x05\x41\x4b\x1b\x02\x24\xcd\x2b\x93\xce\xd6\x51\xb5\x2a\x08\x7e\x6f\xae\x7a\x1c\xbd\x6c\x1e\xd6\xd1\x2c\xab\xd3\x09\xc2\x36\x59\xc3\xfa\x2f\xd2\x55\xde\x8c\x19\x7f\x4b\x53\x35\x99\x62\x90\x51\x6e\x0a\x7a\x16\xbe\x89\xa2\x66\xbd\x84\x6f\x26\x65\x99\xd3\xc7\x00\x77\xcf\xd2\x02\x17\xbe\x42\xf0\x00\x07\xfc\x1a\x2e\x4e\x66\x8b\xd2\x08\x71\xda\x8c\x11\xcb\xfc\x67\x1d\xd5\x73\x04\xb9\x99\x67\x88\xf4\xc5\x02\x17\xc3\x40\xac\xc7\x1e\x08\xb0\xc0\xd8\xdb\xf9\xed\x70\x1c\xe7\x37\xe9\x1a\x87\x8b\xf3\x72\x9a\xc2\xf6\x47\x0b\x58\x5f\xb6\x04\x08\x2a\xb3\xcc\xb3\x69\x0a\x48\xbb\xe8\x6c\x65\xc6\x68\xaa\x61\x42\xc2\x55\xf4\x48\x30\x13\x3d\x26\xfa\x7a\xbc\xd7\x81\xc8\xdf\x98\x5b\xc1\x7a\x63\xae\x4d\x75\xcf\x50\xe1\x13\x16\xa2\x98\x09\xc4\x03\xec\xe1\x2f\xbf\x02\x59\x03\x4d\x3c\xec\x82\xf7\xdc\xc0\x5b\x00\x55\x1a\xd5\xa6\x41\x48\xee\x8d\xe0\x37\x6d\xec\x07\xc2\x4b\x87\xe0\x11\x0e\x9b\xaf\x61\xae\xb2\x36\xd1\x22\x6d\xa6\x73\x3c\x02\x38\x35\x8d\x0e\x0f\xe7\x66\xda\x94\xd5\x08\xb0\x9e\x13\x43\x40\xf0\xf1\xf7\x4b\xf8\xbb\x20\xb0\xea\x65\x3a\x35\x7b\x7c\xa0\xe0\x97\x9e\xe5\xd7\xf3\x72\x95\xcf\x70\xd5\x76\x3f\x67\x74\x86\xb7\x92\xc8\xe7\xb7\xc0\xa2\x6c\x6e\x59\x64\x53\x2e\xcb\xbc\xbc\x5c\xc7\xf5\x12\xb9\x4e\x7c\x65\xfc\x93\xc0\x8b\xeb\xae\xed\x1c\xc0\x81\x27\x95\xcc\x94\x48\x94\x75\xf0\x58\x1b\x69\x6f\x5a\x95\x75\x6d\x67\x8e\x66\xe5\x02\x38\x75\x3d\x8a\xcc\xf8\x72\x1c\x25\xfa\xfd\xf8\xca\xf2\xff\x71\x56\xee\xff\x51\x16\x26\x19\xbf\x29\xdd\x7b\x32\x8b\xe5\xf5\x4d\x04\x4c\x28\x9d\xcd\x70\x95\x73\xc4\x14\x2c\x1e\x50\xbf\x6d\xb5\x8b\xf4\x7d\x5c\x5f\x99\x1b\x6f\xc9\x30\xce\x17\x4f\xfa\x57\x0c\x4f\x67\x8b\xd5\x02\xf8\xe1\xc5\x85\xa9\x4c\x31\x35\x7a\xe2\x8b\xd5\x02\x60\xc5\x4f\x3d\xeb\x9d\x98\xe6\xc6\x00\x3c\x69\x01\xdb\x7e\x53\x76\x16\xee\xb1\x84\xc3\x90\x1d\xb4\xc1\xc5\x65\xc5\xab\xa2\x86\xe1\xeb\x8b\x0c\x79\xf2\x80\xbd\xfa\x5b\x79\x83\x7b\x32\x33\x69\xee\xae\xa9\x16\x88\x44\x49\xb3\xb2\x78\x08\x18\xa3\xc1\xd7\xcc\xb5\xda\x18\x86\x3d\x82\x11\x60\xa5\xc9\xf3\xf2\x4d\xd9\x9c\x09\xcb\x48\xf0\x96\x48\xf4\xd3\x71\xb1\x06\x06\x9e\xb8\x55\x05\xcf\x6e\x63\x78\xb8\x90\x01\x2b\xfa\xf7\xb9\x21\x20\x94\x21\xb9\xeb\xb6\x82\x09\x80\x2f\xd7\x44\xf5\x0b\x38\x76\x20\x5f\x6c\x22\xc3\x16\xd7\xa3\x6b\x3c\x43\x46\x07\xa7\x33\x85\x5b\xcc\xc8\x1e\x13\x22\xe4\x29\x18\xac\xca\x90\xab\xe2\xf5\x08\x63\x4f\x8d\xc3\x48\x65\x7e\x5f\x65\x95\x99\x31\x32\xf8\x7d\xfa\xe8\x10\xa1\x8f\x6c\xc3\xc1\x8d\xc9\x2e\xe7\xcd\x30\x82\xe4\x67\x95\x08\xed\x94\x3d\x48\x19\xe9\x45\x54\xa5\xc5\xa5\x89\x0e\xe3\xc3\x83\x03\x9f\xee\x0e\x0e\x7a\xae\xc7\x0f\xd8\x96\x40\x08\xfa\x2c\x77\x25\xc0\xc0\xc7\xd8\x94\x0e\x4a\xee\xb4\x27\xc1\x7d\x74\xd7\x8d\xf1\x07\xf9\x8c\x77\x27\xc0\xc5\x47\xdb\xa2\x0e\x72\x86\xed\x93\x42\x36\x59\x65\xf9\xcc\x54\x81\x7e\xd3\x54\xab\x8f\xa3\xde\x20\xf0\x32\x01\x0b\xe0\x88\x7d\x52\x3b\x8a\x34\x87\x3d\xd0\x0b\x78\x06\xc3\x56\x0b\x10\x3f\x08\xee\x89\x81\xcd\x45\x0e\x0e\xfb\xb9\xa6\x3d\xc4\x21\x48\x37\x01\xd6\x7e\x91\x5d\xae\x40\x1a\x7c\xe9\x76\xfb\x47\x10\xec\x3f\x69\x75\x02\x04\xf1\x49\x59\x9b\x5b\x41\x78\xc1\x73\xca\xe3\x11\x5c\xa5\x97\xa2\x50\x31\x06\x60\x8a\x25\x88\x15\x45\x23\xda\x57\xbd\x5a\x2e\xcb\x0a\x90\xda\x44\x8f\x48\x18\xf9\x31\x2d\xb2\x2b\xc5\x17\x50\x47\x40\x83\xf4\x6d\xdc\x64\x0b\x53\xae\x9a\x81\x42\x93\x3c\xad\xa4\xf7\x3a\x45\x91\x8e\x06\x1a\x45\x29\xca\x8a\xb3\x95\x9c\x38\x78\x02\x20\xca\x4d\x0a\x7b\x07\x30\x82\x24\x03\x7b\x35\x13\xb8\x92\xc3\x83\x45\x32\x82\x7f\xe6\x5f\xc0\x1f\x7b\x23\xb9\xc0\x99\x24\x6b\x15\x18\x79\xf4\xd6\x94\xc1\xa9\x16\x0a\xf5\x57\x05\xca\x4c\x59\x67\x20\x80\x66\x66\xa8\xa4\x7b\x1c\xe5\x59\x4d\x13\x80\xf4\x95\xe1\x77\x20\x6a\xf0\xe4\xfe\x68\x96\x14\x18\x9d\x6d\x60\xae\x32\x10\x2f\x16\xa6\xba\x14\x29\x95\x1e\x80\x95\xd7\x96\x46\x67\xaa\x85\xf4\xae\x01\xc0\xb0\xb3\xad\xa3\x29\x53\x1f\xc3\x39\xf1\x87\x4c\xb2\xd9\xd1\x11\xc8\x55\xd9\x74\x7d\x74\xb4\xaa\xf2\x04\xa4\xd7\x35\x20\x68\x04\xd2\x55\x65\x84\x49\xe2\xaf\xcc\xd9\x48\xc6\x03\x46\x85\x3b\x61\x6a\x44\x7a\x5d\xa4\x4b\x90\xaf\x9b\x9a\xb9\x16\x1c\xbc\xc4\xd9\x00\x68\x06\x18\xf5\xdf\xb2\xd9\xd3\xc5\x3a\x46\x88\xfe\xcd\x7b\x81\xa7\xf2\xf1\x9d\x15\xd3\xca\x2c\x80\x06\xd3\x3c\xce\x16\xe9\xa5\x89\x09\x3d\xb7\xd2\xf6\x4f\x35\xc3\x4a\xef\x10\xee\x91\x91\x5d\x67\xe5\xaa\x06\x46\x80\x63\x34\x5d\xf4\x12\x99\xcc\xd3\x5a\xf4\x0d\xc0\x75\xdd\xa8\x7a\x32\x33\xc0\x75\x66\xc0\xbd\x71\xab\x80\xe3\xf1\xf9\x1b\xc1\xc3\xa8\x0b\xf2\x3c\xa3\xa8\x2e\x79\x10\x62\xf9\x38\xca\x22\xab\x6b\x3c\x54\xc1\xeb\x64\xc6\x20\x49\x1c\x77\xac\x5c\x92\x64\x8c\x27\x3d\xba\x58\xc1\x61\x67\x02\x00\xf4\xc2\xc9\xc6\xbd\x13\x89\xbd\x28\xe9\x44\x02\xbc\x78\x6a\xdd\xac\xba\x99\x17\xe5\xaa\x98\x8d\xe5\x54\xfb\xb6\x8f\x51\xb4\x2a\x80\xaf\xe2\xf9\x99\xc2\x45\x56\x2e\xfc\x97\xf1\x8a\xa2\x3f\x32\x94\x62\x57\x53\xc4\x06\x43\xd8\x52\x0e\x16\x48\xb1\xf1\x22\xab\xaa\xb2\x1a\x78\x9c\xf1\x45\xc6\xfd\x99\x81\x6d\x6c\x2c\x3f\x45\x8c\xa4\x72\x06\x78\xc4\x21\xd4\x4f\x67\x1b\xaf\xdf\x34\xab\xe2\xcb\x74\xb9\x34\x80\xd0\xeb\xac\x2a\x0b\x24\x90\x7a\x4c\x73\xca\x4c\x74\x63\xc3\x74\x4d\x2a\xb7\x93\x4c\xf3\xd3\xe9\x2b\xbd\xaf\x12\xa2\x6e\xd0\xd3\x46\xcc\xeb\x01\x8b\xe5\x92\x8f\x27\x6c\x9e\xf7\x6e\x70\x4a\x81\xf9\xf1\x50\xb5\x1d\x87\x3f\xbf\xbd\xa0\xc1\xec\xd5\x47\x7c\x26\x79\x8c\xec\x07\x88\xe4\xc6\xc0\xc6\x0a\x65\x01\x80\x00\x78\x93\xa5\x9e\x4e\x98\xae\xe0\x17\xf8\x0e\xd5\x50\xe1\x4f\x02\xb1\x85\xb6\xc6\x6b\x6c\x01\xda\x04\x42\x9b\x2c\xd3\xba\xbe\x29\xab\x19\x4d\x2a\x6b\xd7\x37\xea\x0e\xa3\x60\x54\xc3\x8e\x36\x80\x7a\xb5\xc4\xdc\xc6\xeb\xf4\x4e\x1c\xb8\xdb\xf6\x0a\xd5\x35\x55\x2b\x06\x5d\x18\xb8\x95\x6a\xe0\x88\xc3\xdd\x2b\x42\x4d\x39\x4b\xd0\x6a\x17\x95\x70\x0d\x55\x99\xda\x64\x98\x0a\x74\xc4\xa1\x2c\x0e\xa1\x70\xc3\x5b\x78\x54\x04\x93\xfb\x8b\xcf\x06\xe1\xf4\xec\xc9\x4b\x42\x67\x72\xb6\x34\x53\xa0\xfe\x45\x12\x2d\x57\x13\x60\xd7\x73\x7d\x1b\xb6\xdc\x47\x09\x20\xdc\x54\xf1\x87\x22\x86\x46\xf1\xd6\x49\xdb\x54\x99\x1a\x81\xd0\xdb\xa9\x24\x64\xd1\xef\xd6\x72\x66\x8d\x1b\x8a\xcc\xa4\x06\xf1\x8f\x49\x09\x98\x6c\x1b\xe5\xb0\xea\x29\xaa\x80\xfe\x58\x88\x0c\xb1\x9c\x12\x57\x4e\x2e\xb2\x8b\x72\xd3\xbb\xf6\x53\x8d\x34\x4b\x06\x92\x89\x01\x54\x1b\x3c\x05\x73\x20\x29\xf8\x88\x64\xe5\x04\x5e\x38\x28\x35\xb0\x27\x3a\x3e\xd3\x15\x48\x8d\x45\x03\x1f\x94\x0c\x61\x8b\x9e\xfb\x87\xc3\x83\x3e\xbc\x63\xe1\xeb\xba\x89\xa7\xcb\xd5\x40\x0c\x83\x2c\x47\xa6\x87\x74\x01\x3c\x90\xd8\xf5\xb3\x93\x9f\x22\x95\x8d\x75\xbb\x55\xaa\xa1\x83\x6d\xaa\x9a\xe9\x8e\x84\xf3\xe5\x32\x17\x21\x9c\xe8\x02\xa9\xb2\x45\x83\x23\xb2\x55\xe7\xc0\xe0\x5b\x0f\x33\xf7\x6c\x8d\xad\x3b\x96\x7c\x8b\xdf\xa7\x73\x39\xb4\x2c\x30\xdd\x46\x64\xba\xfe\x85\x59\xc0\x5d\x7d\x67\x14\xf0\xeb\x9f\x2d\x16\xf2\x6c\x91\xed\x44\x03\x62\x7e\xfa\xc7\xa0\x01\x5e\xfd\x6e\x14\xd0\x41\xc0\x67\x4e\x01\x4e\xc1\xda\x59\xd2\x76\xaf\x5a\xf5\x34\x81\x7b\xf2\xe9\x75\x9a\xaf\xe0\x6a\xc0\xeb\x22\x05\x89\x02\x2f\x51\xc0\x0b\xdc\xcb\xf5\xba\x6e\xcc\xc2\x7b\x2f\xd4\x12\x68\x59\x3d\x2a\xc2\x95\xd5\x85\x92\xf8\xb9\x9b\x20\x54\x84\x40\xd8\x62\xd9\x75\xe0\x46\x32\x26\x3b\xae\x12\x96\xd2\x6a\x11\x5e\x2f\xaa\x72\x21\x22\x11\x40\x0a\x70\x5f\xc3\xe5\x29\xb7\x04\x99\xc5\xf3\x6c\x52\xa5\x24\xb2\xf8\xfb\x5f\x97\x0b\xf3\x0c\x6d\xec\x9e\x76\xd7\x77\xff\x7a\xd2\xe5\xb0\xcb\xd7\x97\xd9\x49\x4e\xf7\xe5\xc9\x9d\xf7\xef\x79\x39\xbd\x02\xe1\x37\xcb\x5b\x72\xa9\x79\x6f\xa6\xab\x26\x10\x9c\x43\x70\x47\x7a\x43\x75\xd0\x27\xc6\x6f\xd9\xad\xd3\x9f\xde\x00\xcb\x9c\x56\xe5\xac\xb8\xa0\x29\x40\xe8\x8b\xe2\x35\x62\x2d\xcd\x4a\xd8\xc0\xe8\x4d\xd9\x74\x47\x81\x3b\xb2\x46\x72\x41\x61\x0c\xd5\xcc\x83\x83\x84\xc5\x8e\x8e\xf4\x5c\x80\x42\xd3\x95\x37\x36\x89\x19\xeb\x90\xff\x5f\x02\x1a\xaa\x35\xa2\x10\x96\x5b\xdd\xae\xc9\xfb\x26\x2c\xde\x35\x1d\x83\xd6\x3d\x9d\x1a\xa2\x73\x1d\x2f\x07\x91\x37\x1b\x9b\x31\x5d\xcc\xa8\xfc\x9e\xbf\x3a\x43\x33\x40\x76\x81\xf2\x67\x86\x0e\x2e\xa4\xa9\x55\x3d\x6f\x23\x00\xe9\x9d\x26\xe8\xa1\x19\x1d\x3d\xba\xc8\xd3\x4b\xdd\x19\x0b\xc7\x40\x32\x82\x51\x85\x5c\x51\x5d\xb1\x6f\xc3\xd6\x55\x86\xbc\x22\xec\xb0\x19\x46\x92\x8a\xd0\x29\x12\xfc\xfd\x99\x9c\xf8\x3c\xb1\xc1\x69\x1a\x9a\x75\x9c\x01\x09\x50\x55\x13\x71\x00\x62\x8e\x41\x86\xb3\xef\xfd\x88\x44\x85\x56\x08\x62\x8d\xe4\xd5\x82\x77\xed\xe9\x1d\x45\x3c\xaa\xf8\xaa\xd4\xb5\x6d\xe9\x93\x7d\x5c\x40\x47\x69\xd5\xac\x96\x22\x5a\x2a\xf2\x61\x6f\x51\x65\x41\x54\x02\x5b\x8b\xe9\xb3\x6a\x01\xa2\xee\xaa\x69\x13\xd5\x5c\x35\xe4\xd1\x63\x33\x43\x46\x3e\x84\x59\xf8\x0c\x89\x71\x89\xcc\xf4\x16\x27\x7a\x74\x78\xb0\x97\xe8\x6b\x3f\xa4\xd7\x69\xf4\xfc\xec\x95\xd3\x82\x3d\x18\x58\xff\x15\xf3\x12\xc9\xa3\xa2\x64\xe2\x68\xb8\xde\xb4\xfe\xb4\x7d\xf4\xb2\x49\xb1\xec\xe3\x40\x56\x4e\x94\x17\x5f\xc5\xba\xc5\xf2\x36\x02\x07\x40\xf6\xd9\x92\x7b\x0e\x96\xda\x52\xf5\x65\x6f\xab\x3c\xb3\x64\x74\x62\xf5\xa0\x97\x96\x0e\x45\xe7\x82\x0f\xe6\x7d\x3a\xb5\x43\xc8\xf1\x4f\x0e\xc7\x5f\x8e\x0f\xd8\x3c\x83\x7e\xd8\x05\xbb\xf0\x9d\x3b\x8b\x9f\xfa\x3f\xfa\x18\x4c\xca\xd1\x22\x53\xe2\xb7\x42\x3c\xe4\xa5\x55\x53\x50\x63\xe9\x1a\x38\x49\x9a\x97\xa0\x6c\x02\x59\x64\x39\x61\x5f\x80\xb6\x6a\x4c\x4b\xd9\x34\xe9\x22\x9e\xa6\xe4\xf1\x1d\x6a\xbb\xe4\xb7\x22\x79\xcb\x51\x1e\x4c\x50\x93\x38\x52\xce\xe8\x2e\xd7\xe0\x11\x7e\xbe\x56\xf4\x90\xff\xce\x06\x2a\xe0\x0e\xd5\xc8\x81\xec\xa9\xad\xbd\xf5\x24\xb4\x97\x63\xf4\x49\x8e\x43\x60\x63\x21\xcf\xa4\x97\x70\x5a\xcf\xd6\x4b\x58\x4f\x3c\x03\x06\x87\x6e\xec\xa1\xb2\x5d\x3a\xa9\xcb\x1c\x4f\xe5\x32\x85\x33\x28\x78\xb6\x83\x44\xce\x36\x87\xd3\x98\x99\x5d\x27\x2d\xdc\xbc\x9f\x1a\x33\x13\x97\x25\xcc\x0e\x7f\xc1\xd2\xe6\x25\x1a\xb9\x2b\xf9\xce\xcc\xd0\x2e\x9e\xd5\x57\xc4\xfa\xd3\xeb\x32\x9b\xb9\x08\x9b\x95\x2f\x4d\x12\x17\x20\xe3\x58\x0b\xcb\x70\xa8\x8a\x28\x31\x8b\x65\xb3\x7e\x9e\x55\x49\x74\x0d\x10\x2f\x48\x62\x21\x81\x14\xe5\xac\x86\x01\xc2\x45\x8c\xac\x4d\xca\x3d\xa7\xa1\x3d\xfa\x3c\xd9\x5e\x9c\x11\x83\xe5\x5a\x39\xc0\xfe\x45\x11\x52\x81\x5c\x12\xb2\x29\xb7\x6e\x85\x45\xc6\x50\x6d\x3e\xfb\x03\xf7\x03\x8e\xa8\x1c\x86\x1e\xb4\x7b\x68\x8d\x2c\x5e\xc5\x34\xfd\xe4\x9b\x1f\x33\xb6\x7d\x1c\xbe\xce\x92\x6d\x0b\xd9\xb8\x0e\x04\x39\x9d\xc5\x04\x3e\x82\x13\xba\x75\x36\x70\x22\x14\x8a\x9c\x23\x9e\x87\xb0\x96\x05\x65\x31\xfc\x75\x44\x54\x22\x97\xe3\x88\xd9\xa9\x88\x30\x2f\x5e\x9e\x08\x55\xa1\xb9\xc0\xd7\xf2\x55\x18\x45\x39\x47\x46\x4f\x70\x95\x35\x68\x09\x4d\x42\x2f\x32\x67\xda\x72\xb8\xf8\x3d\x9c\x7d\x6c\x17\xd7\x7f\xaa\x7c\x14\x50\x98\xc2\x40\x34\xa8\x92\x74\x17\x4c\x10\xf8\xc4\xf2\xe4\x32\xce\xcb\x1b\x12\xba\x52\xe6\x6b\xfe\x2b\x08\xcf\x78\xf8\x6a\x71\x09\x3b\xae\xf8\xf7\x95\x59\x99\x0f\x59\x77\x5a\x5f\xd5\x11\x8d\x62\x77\x77\x2b\x19\x24\xf1\x61\xc2\xe6\xd7\x22\x5a\x15\x13\xb4\x36\xc3\x9b\x34\xc0\x8e\x2b\x75\xa0\xdf\xbe\xd4\xca\xbc\x03\x26\x67\xf0\x13\x7a\x1d\x86\x46\x74\xe0\x76\xd0\x02\xf1\x28\xc2\x06\xcd\x72\x8d\x7b\xc1\x9f\x08\x80\x01\x3b\x8e\x4c\x09\x4d\xf2\x23\xeb\xe9\x38\x9e\x80\x44\x8f\x6e\x8e\x67\xa0\x30\x98\xea\x14\xf4\x81\x64\x94\x3c\xcf\xea\x69\x5a\xcd\xde\xe6\x00\x48\xc3\x87\x5b\xbe\x4a\x76\xa1\xf9\xd6\x5a\x7d\xe4\x58\x51\x56\x35\xeb\x7b\x14\x67\xad\xf2\x7e\x8b\x48\xeb\x29\xcb\x82\x4a\xa7\xf7\xbb\x1b\xc9\x17\xcd\x6f\x32\x10\xbb\x80\x71\x10\x52\xc8\x88\x20\x8a\x6b\x6d\x87\xe5\x07\x91\xcc\xce\x4c\x75\x9d\x4d\x51\x0f\xa8\xeb\x72\x9a\x91\x58\x2c\x4a\xb9\x9d\xe7\x93\x16\x19\xd3\x55\x53\xde\x3a\xff\x83\x07\xf7\x68\xf9\xbc\x7f\xab\xe2\xfd\x59\xec\xee\xdb\x1a\xd6\x87\x1b\xb3\x9c\x9b\x85\xa9\x52\xe0\xc3\x20\x57\x0d\xb7\xd8\x74\xd1\x64\x47\x8a\x64\xa4\x2d\xeb\xba\xf3\xac\x9d\x25\x0e\x9b\xd5\xbc\x5f\x0e\x09\x0f\xe8\x3d\x19\xfb\x7a\x2c\x68\x10\x52\x6c\xb3\x34\x72\xb1\x88\x7a\x6a\xc3\x68\x94\xaa\xb9\xf5\x8e\xf2\x19\x4b\x6a\x63\x08\x1b\x7a\x59\x20\xb6\xd7\x94\x63\x33\x36\xce\x24\xf9\xe6\xe0\x9b\x83\x64\xaf\x3d\x6d\x8c\x7f\x0e\x41\xe7\xd6\xe9\xc9\x8f\xa9\xba\xda\x50\x80\xe6\x4d\xb3\x0c\x01\xaa\x19\x35\xf1\xce\xf8\xc0\x9b\xb6\x12\x69\x53\x06\x61\x30\xc2\xb9\x39\x38\x43\x8d\x24\x0a\xa2\x8f\xa2\xcd\xf0\xdc\x09\x51\x1b\xe1\x22\x84\xed\x06\x5c\x17\x5d\x43\x21\xa2\x93\x40\x1e\x79\x9d\x0b\xdf\x94\x54\x00\xfc\x73\x16\x25\xde\x25\x94\xb4\xb2\x02\x2c\x36\xe6\xab\x66\x56\xde\x14\x3d\x21\x2b\x1b\xa5\x2a\x27\x4d\x71\xfc\x49\xdd\x67\x76\xe4\xc0\x64\x54\x03\x2a\x75\x46\x67\x45\x7c\x91\x53\x90\x95\xa8\x50\x14\x41\xae\x10\x8c\x3c\x13\xa6\x98\x4f\xf0\xba\xc1\xe0\x30\xf2\xad\xc1\xd9\x46\xdf\xf7\xad\x92\x05\xab\xaa\xad\x65\x6d\x90\xb8\x28\x1e\x8a\x40\x8e\x01\x74\x24\x0a\x53\x65\xe5\x2c\x96\x75\x85\xc8\xf8\xea\x5f\xee\x8a\x0e\x0c\x21\xf3\x51\xa2\xf3\x9a\x88\x66\x45\x59\x6b\x6d\x4d\xb8\x13\x83\xda\xdc\x15\xc8\x0c\xb0\xd8\x97\xdd\x00\x1e\x5d\x9a\x8d\xe1\x21\xf9\x8e\xa3\xbe\x6a\xd3\xb0\x5b\x7f\x8b\xbc\xde\x7e\x7f\xcc\x14\x03\xcf\x92\x6b\x63\x9a\x4a\xf4\xbf\x48\x4e\x4a\xe2\x75\xdf\x19\x4a\xa7\x53\x64\xc2\xf1\x0e\x44\xab\xd1\x11\x0d\x45\x2d\xd0\x30\xc7\x3c\x4a\xc7\x83\xde\x42\xa1\xb3\xfb\xc3\x97\x05\x05\x64\x11\x67\x42\x64\xd6\x6c\x65\x54\xbe\x8f\x02\x1b\x9a\xb6\xf1\x77\x27\x10\x46\xc7\x27\x2f\x7b\x0c\x4d\x7a\x86\x65\x31\x1c\xfa\xd2\x81\x60\xdb\xf2\x3d\x10\x76\xb6\xf9\x03\x4c\xc1\x12\x68\x6d\x12\x1d\x01\xef\xcc\x32\x0e\xd1\x0f\x51\xc5\x56\xcc\x87\xce\x41\x9d\xe6\x25\x26\x35\xa1\xcd\x20\x8d\x4e\xcb\x9c\xcd\xaa\xfc\xe7\xb7\x19\x99\x20\xc9\x85\x75\x0b\x8a\xc7\xd1\x0b\x50\xc2\x3d\x78\x6c\x5c\x10\x4a\xdc\x51\xf2\xcb\x5f\xd2\x65\x06\x47\xa5\x5c\x2d\xff\x75\xff\xd7\xbf\xc0\xf9\x2b\x57\xd5\xd4\xfc\xeb\x2f\x23\xf7\xf7\xaf\x47\x7f\xc1\xd8\x3a\xfc\x8e\xfe\xfd\x35\x19\xb1\x0d\x80\x0f\xed\x22\x5d\xd6\x47\x97\x40\xa7\x88\x00\x09\x96\x5a\x2e\xeb\xfd\x99\x59\xe6\xe5\x9a\x42\x5a\xf0\x67\x71\x30\xe0\x99\x4d\xe1\x52\xe7\x38\x15\x74\xd6\xf1\xde\xfb\x18\x43\xaf\x7c\x89\xde\x7a\x90\x51\x4d\x7e\x31\x3e\x27\x03\x3c\x43\x23\x5e\x09\x62\x87\xe9\x45\x63\x3a\x86\x47\x3e\x2e\xe6\x3d\x00\x83\xc7\xce\xbd\x27\x51\x51\xd7\x46\x8e\x11\x9c\x31\x45\x76\x8f\xfd\x52\x7c\x1f\xa0\x7d\x5d\xc1\x83\x48\x5f\x6a\x8f\xb4\xd9\x16\x8b\x09\x70\x69\x3f\xe4\xac\xef\x10\xf5\xf3\xa9\x25\x30\xa5\x0a\xe3\x59\xa7\x39\x68\x05\x77\x3d\x6d\x27\x32\xca\x33\x1c\xa4\x37\xf2\x0f\xcf\x98\xda\x12\x61\x1c\x8c\xcb\xc9\xfd\x27\xc4\xc4\x63\x73\x82\x2e\xb2\xaa\x6e\x10\x7f\xcb\xca\xa0\x05\x4c\x2d\xda\x40\xde\x1a\x01\x16\x4e\x8a\x61\x18\x46\xbc\x43\x3d\x3e\x0c\x18\xac\x59\xb5\x9d\xa1\x13\x53\xc7\x43\xd5\x9a\x13\x7a\x5c\x63\xc1\x5a\xb2\x1b\x8f\xa5\xf3\xf6\x09\x2f\x94\x7c\x95\xec\xb5\xe7\x8f\xd1\x72\x37\x00\xe1\x27\x68\xa5\xc4\x73\x4b\x9e\x27\x9d\x88\x86\x88\x1e\x59\x85\x3b\xd9\x9f\x9b\x34\x6f\xe6\x9e\xb7\x8d\x2c\x84\x18\xf9\x26\x7b\x8f\x78\xa2\x44\x15\x75\xa5\xc1\x50\xbf\xaf\xd2\xea\x6a\x55\x07\x6e\x13\xf1\x69\xe0\x09\x60\x1d\xd3\xd4\xab\xdc\x5a\xc9\x7d\xcc\x5e\xa4\x59\x2e\x46\x42\xf2\x3d\x84\xe2\x38\x5c\x4b\x00\x70\xfc\x11\x16\xab\x63\xe9\xaa\xad\x95\xa1\xf4\x70\x81\x33\xec\xf1\xf9\x6e\x3d\x2f\xeb\x76\x9e\x2e\xba\xdb\x26\x25\x1d\x19\x1f\x41\xb8\xfa\x70\x40\xce\x5e\x43\x33\xec\x38\x7a\x2b\xc1\x6f\x28\x78\x08\xbe\x46\xd6\x70\x3f\x31\x2e\xf9\x0e\x88\x75\xce\xf6\xf0\xcc\x83\x82\x0c\xb5\x1a\xb6\xc6\x9b\x05\xb4\x34\x5b\x96\x92\x4e\x84\x07\x97\x09\x38\x8d\x90\xc8\x73\x7e\x25\x54\xad\x64\xc8\x8f\x81\xd4\x36\x7c\xb7\x62\xb5\xfd\xc2\xc7\x41\x6b\x1f\xc9\x90\xaf\x0c\x54\xb8\x99\xc9\xd3\xf5\xed\x71\xf6\x6f\x3a\xa2\x92\x63\xca\xee\x40\xe2\x9d\xa3\x1e\x32\x11\x8a\x42\x3a\x61\x3e\xc4\x73\x37\x6d\xdd\x52\x20\xeb\x95\x67\x77\x81\xc9\x99\xb9\x19\x1b\xe4\x27\x41\xaf\x00\xb0\xb7\x30\x06\x24\x04\xae\xff\x68\x91\x5c\x79\x3b\x30\x68\xc5\x2b\x61\x7a\x12\x13\x25\x10\xd6\xc1\x70\x97\x99\xeb\x15\xd1\x52\xaf\xc1\x7f\x03\x10\xaf\x45\xaf\x47\x9f\x18\x06\x1e\x90\x14\xc8\xc3\xc0\xd4\x56\x23\x64\xac\xa8\x6b\xba\x06\x79\x0a\x5d\xd3\xf2\x20\xc8\xb4\x82\x47\xb8\x43\x91\xf3\x20\x07\x82\xad\xda\x7d\x01\xf8\x22\xd0\xec\x87\x2e\x40\x86\xb9\x15\x7e\x86\x33\x84\x9d\xd6\x64\x66\xbb\x80\xef\x18\xc0\xdf\xeb\x88\xb4\x0e\xfd\x96\x33\xe2\x60\xfb\x3b\x1e\x92\x16\x78\x1b\x98\xe5\xfd\x1c\x93\x41\x73\x7f\xda\x07\x65\xd0\x12\x3e\xe5\xa3\xd2\x59\x80\xb3\xed\x57\xf5\x3d\x15\x7e\x20\xbb\xfe\xdb\xd3\x33\x35\xe9\xb7\xac\x06\x98\x71\x1c\xbf\xad\xb2\x4b\x10\x13\x4e\x45\xf0\x8f\xce\xe6\x29\x05\xea\x3f\xc2\x17\xf7\x54\x4c\xfe\xdb\xf9\xf9\x89\x95\x01\xea\xb6\x21\x2c\xd0\x27\xbc\x30\x10\x9b\x71\x82\xca\x28\x22\xac\xc2\x2c\x84\xaa\xbc\xc1\x38\xaa\x29\x60\x07\xc7\x12\x69\x82\x7e\xe3\x90\xe5\x92\x40\xa2\x18\xbe\x69\xbe\xa2\xf0\x11\x4c\x47\x63\xd3\x89\x18\x6d\xfb\xc3\x17\x03\x59\x9d\x80\xc4\x97\x43\xe0\x47\x4e\x05\x39\x7d\x71\x76\x8e\xb1\x2b\x91\xec\x73\xa2\x9b\x10\x93\x5d\xca\x05\xcb\x8d\xa3\x7f\xb7\xee\xe8\x40\xa8\xb2\x19\x1f\x76\x28\x87\x24\xd0\xe2\x18\xcf\xb8\x03\x20\x46\x01\xd1\xd4\x23\x2b\x62\xd8\x4c\x35\x75\xff\x10\xb5\x05\x0b\x90\x04\x64\x92\x5d\x56\x92\xd9\xa2\xf3\x78\x10\xfd\xcf\x50\x32\x1e\xb9\x49\x81\x7c\x90\x34\x3d\x04\xa9\x51\xa0\x8d\x12\x84\x8a\xb2\xe6\x28\x24\xce\x77\x9a\xb5\xe3\x1e\x35\x14\xd1\x6d\x34\xa9\x19\x9a\xaf\xcf\xcb\x02\xf1\xee\xf2\x92\x82\x7d\x3c\x15\x9e\xa2\x29\x3f\xd3\x5a\x1d\x29\x96\x50\x31\xb3\x58\x48\x73\xa0\x95\x83\x25\x7c\xb6\x73\xc8\x9b\x7e\x45\x13\x1a\xd2\x13\x77\x11\x7f\xde\x9e\xb0\xd5\x00\x29\xb1\x3e\xda\xdf\x37\xef\xd3\xc5\x32\x37\x63\x00\x92\x43\x77\x92\xc7\x89\xec\x68\x79\x83\x59\xf4\x3c\x81\xa7\xcd\x3d\x4e\x44\x1c\xf6\x49\x36\xc8\x89\xa8\x9d\x00\xcf\x6f\xf7\x2d\x79\x61\x9a\x79\x39\xbb\xcb\x92\x89\xc8\xe4\xf5\xce\xba\x75\x7d\xdf\xbf\x38\x67\x2b\xc8\xc9\xdb\xb3\xf3\x24\x90\xed\x91\x58\xe5\xf5\xbd\x3e\xc8\xe4\x4c\xdd\x15\x32\x79\xbd\xbb\x23\x88\x2d\xe1\x32\x0a\x25\x7a\x47\x81\x0f\xc4\xe7\x30\x0d\x83\x7b\xbc\x02\xc0\xaa\xec\x0f\xb6\x2e\x87\x29\x53\xef\xe3\xd0\x9d\xd3\x6b\x49\xc6\x3b\x1c\xad\x56\x14\x60\x25\x52\xc5\x48\xae\x8a\x9a\x0c\x9e\x9a\xbf\x16\x72\x3e\xc7\x53\x29\xf8\x04\x0e\x90\x70\x52\xef\x4a\xa9\x28\x54\xed\x3e\xae\x94\x87\xe7\x7c\x73\x14\x1b\xdc\xc4\x94\x69\x86\xb1\x32\x9c\x63\x8b\xb7\x22\xdc\x2b\x14\x9c\x4d\xb2\x4d\x36\x25\x19\xa9\xda\x47\x18\xa5\xa0\x8a\xcf\xf4\x80\xaf\xcd\xd1\x05\x5f\x60\xac\x36\x66\x64\xa5\x45\x18\x8a\xeb\xc2\x44\xd1\xaa\xcc\x77\x72\xca\xc5\x71\x56\x4b\x0e\xa6\xd4\x44\x17\x8c\x7a\xf6\xa6\xc5\xc0\x80\x11\x5e\xd0\xf3\x88\xf2\xf7\x30\x7e\xed\x5d\x39\xa9\x47\x3a\xa8\x8e\x36\x05\x34\xa4\x12\xb9\x84\xd9\x39\x18\x20\x1b\xcd\x61\x19\x2e\x5c\x24\x5d\xdb\xe4\xc6\xd4\x4d\x41\x22\x2e\x39\x1d\xb3\x02\x0d\xf8\xe3\xe8\x3b\x78\x8a\x66\x94\xd9\x39\x11\x2c\xc0\xde\x02\xa6\xaa\x40\x40\x56\xa4\xf9\xab\xa5\xec\x57\xcf\x80\x8b\x88\xff\xa1\x9c\x10\x9f\xc6\xa8\x05\xa2\x10\x32\x41\xa5\xd5\x2c\x72\x26\x44\xa2\x29\xc9\x37\x2a\x41\xd5\xbf\xf6\x2d\x82\xbd\xac\x7d\x56\x1a\x56\x92\x0b\x63\x66\xd6\x5d\xc3\x61\xd7\x63\x3f\xe0\x50\xb3\x82\x51\xf8\xe6\x4b\x9b\xcd\xa3\x78\x76\xf0\x12\xf0\xd2\x87\x49\x75\xc6\xd0\xf8\xd4\x8b\x86\x76\xab\x3f\x8a\x12\x22\x05\x8c\xab\xc0\x6f\xf1\x5f\xb4\xf2\x34\x7f\x88\xf1\x13\xf3\xcc\x59\x08\x5b\xd5\x9c\x3b\xd8\x83\x8a\x54\x5c\x26\x16\x82\x23\x20\x5f\x19\xf8\x88\xd7\xca\xfb\x63\xc3\xff\x6e\xaa\xac\x41\xd1\x39\xad\x19\x18\x90\x13\x30\xca\x98\xa9\xef\x05\x97\x5b\xc1\xd7\x8f\x9a\x6c\x7a\xf5\x57\x7e\xf9\xe9\x57\x07\x1c\xf5\x1d\x77\x60\x3d\x72\x08\x6d\x0d\xe7\x90\xaa\x69\x85\xaa\x3c\x3c\x12\x81\xe3\x81\x7c\xf1\x20\x5a\xa6\x95\x7a\x30\x10\xfb\x07\x7b\x0a\x0a\x8e\x79\xd4\xa4\x93\xbf\xaa\xd9\xf1\xe9\xc1\xfe\x93\xff\xf6\x9f\xcb\x7c\x55\xff\xd7\xe3\xbe\x7f\xfe\xca\xfc\x89\xa1\x3b\x92\x9b\xf8\xaf\x38\xcc\xd3\x03\x7e\x02\x06\xd8\xfa\xfe\xf8\xe1\xa7\x7c\x15\x2b\x1e\x06\x5a\x80\x95\x4e\xf4\x35\x2b\xd4\xdf\xcc\xcb\xbc\x1d\x83\x7b\xe1\xd5\xaf\x72\x2e\xb8\x99\x99\xe6\xf0\xef\x6c\xc4\x32\x2d\x59\xd1\xc8\x32\x6e\xed\x68\xad\xc1\xb3\x7a\x61\xa6\xf3\xb4\x80\x7f\x71\xf5\x37\x65\x75\x85\x62\x3e\xc6\x6d\xe6\xc1\x5a\xdc\x61\x19\xb0\x9a\x87\xc7\x84\x16\x0c\xd9\x05\x6a\x91\x78\xf1\xba\x69\xc5\xdf\xb6\xb2\xf7\xbd\xe3\x6c\x79\xf3\xcc\x71\x07\x41\x86\x03\xd3\xd2\xb2\x5d\x12\x7a\x6f\x99\x88\xd0\xa4\xfc\xde\x96\x55\x80\xf3\xec\x8e\xe3\xf8\xd8\x71\x4a\x3b\x4f\xc5\x69\x08\xca\x4d\x71\x2e\x83\xee\x15\x79\xd2\xcc\x7c\x01\xfb\x85\xa6\xf9\x72\x28\x21\x9d\x5f\xf7\x3b\x73\x4e\x3a\x0c\xb1\xfe\xe6\x4f\xe3\x66\x79\x94\x35\x0f\x1f\xa2\x92\x65\x6a\xf4\xe4\x6b\x1a\x50\x59\x5d\x8e\x53\x0a\xc0\x1f\xb3\x9b\xf4\xea\xa8\x15\xa5\x1d\xd3\xb9\x96\x10\xfc\xf5\xde\xf8\xcc\xe6\x71\xb4\x58\x9a\x8d\x7d\x3c\x72\xbc\x40\x60\xa2\x34\x27\xe5\x61\x0f\xbd\x8d\x86\x0b\x38\x9f\xa4\xd3\xab\xc1\x19\xec\x2a\x06\xf1\xae\x66\x28\xfa\x51\x3e\xbc\x96\x41\xb0\xe8\x48\x9c\x69\xf6\x91\x4e\xbd\xe7\x5f\x10\x4d\xb5\x16\xcb\xf7\x96\x9b\x06\x78\x61\x97\xb7\x86\x94\x2a\x31\x9f\xd3\xf5\xf0\x98\xbc\x87\x67\xb2\xd3\x35\x5c\x9f\x54\x70\x09\x23\x5d\x1b\x2f\x80\x54\xee\x18\x4d\x91\x48\x23\x9c\xf6\x67\x00\x71\x16\x51\x4e\x15\x61\xfc\x28\x8e\x1e\x50\x0d\xc3\x07\x22\xfb\x59\x08\x6b\xf5\xe5\xf9\x21\xa9\xff\x03\x1e\x87\x7b\x77\x92\xcd\x1e\x58\x71\x72\xef\x08\x69\x0b\xbe\xaa\xfd\xc9\x31\xaf\x07\x24\x82\xab\x6c\xb9\x44\x14\x15\x40\xdd\x34\x5a\x76\x61\xcb\x06\xd0\xe7\x79\x5a\x17\x0f\x1f\xc2\x75\x97\xc1\x91\x46\xa1\x6b\x6d\x1a\x9c\xe5\x14\x2e\xdc\x74\x6a\x1e\x60\xae\x49\x31\xc5\x62\x5f\x2e\xfb\x55\xc3\xa8\xdf\xe1\x1d\x45\x29\x1e\xf4\x6c\xcd\xce\x0a\x92\x1b\x0a\x73\x83\x11\x86\x0f\x77\x0d\x1e\x03\xd1\xb3\x84\xbd\x44\xef\x54\xbe\x96\x5b\xbf\x4f\x74\x50\xd6\x47\x67\x1a\x85\x69\xc7\xd3\x24\x43\x80\x6e\x71\x32\xba\xe0\x45\xee\x49\x32\x68\xe5\x58\x2d\xd0\x39\x44\xfa\xc2\x36\x3a\x67\x9f\x98\x1e\x96\x3d\xce\x2a\xc0\x14\x3b\x34\xa5\xb8\x71\x58\x8e\xe6\xe0\xf5\x44\x92\x53\x5a\x0f\xed\xb1\x2b\xde\x26\xae\xb1\x60\x0e\x70\x77\xc0\xaa\x5b\xfc\x97\x1f\x60\x2d\xd6\x65\x41\xf0\x45\xcc\x99\x7e\x74\x35\x5b\x9e\xa6\x05\x43\x16\x49\xef\xc3\xc9\xc1\xfe\x61\xf4\x98\xff\x9b\x8c\x6e\x48\x20\x4d\xbe\xf8\x72\xc1\x37\xeb\x97\x07\x75\x22\x9e\x4d\xaf\xc4\x8d\x5f\xea\xe1\xfe\xa2\x34\x9f\xfb\x05\x25\xb6\x15\xbb\x49\x03\x1a\x49\x67\x33\xab\x00\x06\x35\x29\x6c\x45\xc3\x36\xf9\xd8\x4c\x1e\xca\x79\x03\x05\xb3\xd1\xb3\x36\x96\xe4\x48\x7f\x1c\xcd\x19\x59\x5c\x17\x47\xc4\x69\xa7\x80\x12\xfc\xbf\x18\xd8\xe9\xd1\x21\xa5\x91\x20\xa2\xd1\x8a\xa1\x15\x20\x34\xaf\x85\x0b\x08\x01\xd6\x6d\x96\x4a\x9e\x5d\x99\x4d\x63\xfd\x02\x83\x8d\x9e\x8c\x0f\xf6\x12\x57\xbf\xc1\xbc\x47\x33\x91\x61\x79\x5f\x8a\x1c\x50\x18\x6b\x51\x67\x64\xd0\x0b\x97\x4c\x16\x23\x49\x4b\x4a\x37\x5e\xa9\x09\x79\xf9\x5f\xce\x8e\xf0\x84\x5c\xc0\xfd\xf2\x72\x96\xa8\x2d\xcf\x8e\xb7\xde\x0e\x2c\xc0\xfa\x57\x02\x8e\x84\xcb\xa7\xf8\xc0\x45\x59\x1e\xc1\xff\xf0\xe7\x11\x7e\x9e\xa4\xd5\xd1\xe3\xa4\x65\xfb\x88\x7e\xf9\xd5\xa7\x2b\x38\xde\xf7\x19\xf9\xab\x33\xf4\x6b\x74\x70\x30\x80\xdb\x67\xc8\xd2\xb8\x0a\x23\x61\xe0\x2a\x2b\xe8\x72\x99\x83\x66\x1a\xe5\xe6\xda\xe4\x56\xc1\x60\xd2\x21\x7f\x6c\x3f\x6b\xfa\xa4\x0d\x3d\xb8\xb0\x01\x37\x9b\x94\xd4\xdd\x88\x1f\x78\x98\x58\x98\x53\xc9\x18\x65\x5a\xf6\x30\x71\x3f\xa8\xfa\x13\xc3\x4d\xc1\x0c\xe6\x8a\x77\x2e\x96\x00\x89\x84\x19\x38\x45\x5f\xa8\x99\xcd\x69\x73\x28\x32\xe9\x5d\xd3\x41\x74\x48\x44\x38\xdb\xbd\xb2\x26\x5d\xaa\x67\xdb\xac\x97\x68\x2f\x9f\x88\x68\x7c\x69\x0a\x8c\x67\x51\x58\x3d\x91\xc3\x43\x94\xa3\x9f\x45\x7a\x85\x57\xcb\x96\x90\x72\x95\xef\xf0\x8c\x35\x9f\x78\x60\xf8\x8e\xf5\x43\x3c\x8c\x74\x6b\xac\xb0\x30\xc1\x16\x43\x8d\xdd\xa1\x52\xaa\x24\x5a\x88\x60\x51\xbb\xea\x2b\xa7\xa0\x1d\xc3\x33\x3f\x2d\x67\x30\x10\x53\xd9\xa9\xe1\x78\x1e\x57\x93\xb2\xf5\x54\x60\x73\xab\xf8\xa7\x78\x45\xbf\x71\xf2\xcd\xaa\xda\x39\x68\xd9\xc5\x0a\xba\xf2\xce\xc2\x70\x5c\x58\x0d\xa7\x59\xf9\xc7\x28\x7c\x8d\xab\x82\x15\x2e\x3d\x8e\x7f\x66\xc1\xc3\xc0\xa9\x00\x39\xf9\xd2\x4b\xf4\xe0\x31\xb8\xd2\xac\x5c\xfc\x8c\x82\x27\x5f\xfe\x09\x6d\xa4\x6f\xfb\x8a\x20\xb4\x30\xd6\x9b\xb1\xdd\xc5\xc9\xaa\xb0\x79\x8f\x1f\x0f\x33\xde\xa0\x58\x0a\x4d\x4f\x0f\x4f\xfb\xff\x16\x19\x96\xc1\x14\xf7\xe9\xc3\x7a\xfe\x66\x83\x0b\x0b\x7f\x40\x56\x98\xaf\x7c\xbd\xa8\x5b\xa3\xd1\x85\x4e\xd2\xd3\xd7\xe8\xd2\x23\x7d\x31\xc2\x0a\xba\xb5\x93\x76\x84\x8f\xd0\xc0\x12\x35\xa2\xf1\xa0\xf2\xe6\xd8\x42\x14\xe6\xae\xb4\x43\x96\x48\x3f\x6e\x85\x90\x5a\x3f\x0b\xa9\x1c\x2e\x92\x6e\xf1\xd9\x56\x30\x1f\xa8\x08\x2a\xca\xa4\x86\xdc\x96\x7d\xd2\x8c\xab\x67\xbc\x11\xdf\x61\x84\x1d\x25\x5e\x79\x9f\xd1\xf3\xf5\xb7\xb2\x6e\xde\x18\xfa\x49\x8a\x0b\x31\x15\xbf\xa1\x8a\xc8\xc7\x7e\x91\x40\x4a\x3c\x46\x27\x63\x15\xa4\xbd\x5b\x4b\x87\x2b\x6c\x27\x6f\xb7\xa2\xd1\xf9\xdd\xdd\x23\x5b\x5f\x9e\x68\x01\x03\x4e\x95\x42\x04\x78\xe3\x8d\xa4\x18\x9c\x56\x7e\x42\x3a\x94\xfb\x51\xdd\xa1\x4d\x88\xb6\x47\x5f\xa0\x45\x7a\x01\x2b\x6f\x05\xf4\xa7\x15\xf0\xce\x3b\x94\xdb\x80\xa1\xf9\x65\x5b\x75\x19\x09\x72\x0e\x13\x50\x8c\x65\x94\x97\xe5\xd5\x6a\xb9\x33\xa0\x8f\xbe\x52\x38\x99\xe0\x9f\x7c\xf9\x55\x34\x05\x82\x02\x21\xda\x48\x01\xb5\xb2\x49\xf3\x60\x11\x5c\x83\xed\x6e\x6b\x90\x93\x59\xe9\x20\x9e\x87\x97\xc3\x76\x71\x8a\x5f\xb8\x48\xcb\xaf\x89\xba\x74\x8a\x59\xd9\xd4\x4f\x9f\x24\xfd\xf5\x15\xb7\x2e\xd0\xf1\x3d\xaf\x12\xdd\xfd\x49\x56\xde\x24\x4e\xb4\x5a\xa9\xe7\x44\x14\x3f\xf2\x7e\xa3\x27\xd9\xf9\x03\xfc\xf7\xae\xd3\x8a\x6a\x63\xd7\x7d\xd1\x91\x36\xae\xc6\xb9\x47\x92\x37\xc7\xaf\x5f\x9c\x9d\x1c\x3f\x7b\x81\x47\xec\xe4\xed\xf3\xdf\xf0\x0b\xd6\xfc\xb9\x90\x83\x2d\x38\x40\x19\x7d\x1e\x87\xc9\xcb\x74\x66\x1d\xcd\x30\x77\x25\xa9\x82\xcf\x88\x5f\xbe\x4e\x97\x35\x8d\xc2\x25\xfb\xa8\xae\x4a\x2f\xa0\x9f\x34\xe7\xb3\x18\x43\xf7\x68\xba\x5b\xba\x9f\x8b\x03\xdf\x99\xda\x3d\x14\xde\x50\xad\x7c\x45\x2f\xc2\x8c\x78\x67\xfb\xc5\xa6\x8d\xd7\x9a\x4d\x7d\x5b\x1f\x72\x14\xda\x9a\x9d\xc1\xd3\x2d\xbd\x4f\xd8\xb4\x58\xe3\xad\x38\x3f\x2f\x73\x3a\xc1\x36\x14\x7b\x03\xfd\x75\xc2\x9f\xfb\xf7\x19\xe0\x8e\x01\xde\xdd\x91\xd2\xbf\x60\x1b\x9c\xa2\xf5\xa7\x91\x8e\x40\xba\x4a\xa3\x6d\x88\x70\x72\x8c\xd0\x35\x5a\xb6\xd0\xb1\x90\xf3\x83\x2f\x9f\xc3\xb1\x74\x86\x6b\x37\x1d\xee\x81\x3b\xc5\xa3\xd6\xf1\x7e\xf3\xf6\xf9\x0b\xfb\x0b\x3e\xf5\xf2\x04\xff\xfa\xdb\xdb\xb3\x73\xfc\x93\xac\x7d\x67\x2f\x4e\x7f\x7e\xf9\xec\xc5\x6f\xc7\xcf\x9e\xbd\xfd\xe9\xcd\x79\xe2\x78\xe0\xe5\xf4\x1e\x45\xbf\xef\x9f\x45\xe7\xc4\xf2\x2e\xd3\x6a\x82\x05\xa6\xa6\x20\x8a\x02\x97\xab\xd9\xa0\x19\xa6\x2b\x70\x16\x02\x79\xd5\x31\x1f\xcc\x60\x54\x45\x5a\x81\xda\xb4\x2c\x43\x2f\x32\x8b\xce\x9f\x36\x8b\x81\x11\xa6\x98\x48\xb1\xa6\xca\x15\xbe\x3a\x31\xde\x5f\x5e\x5d\xee\xf3\xb8\xf6\xa9\x67\xf8\xd0\xb9\xd6\x3e\x0f\x9b\x6e\xe8\x33\x12\x29\xc0\xa1\x03\x1e\x15\x39\x3d\x51\x25\x50\xdc\x7e\xac\x5f\xc1\x42\x15\xe7\xd0\x7a\xc1\x19\xfa\xcd\xde\x66\x78\xe3\xa6\xc9\x87\x24\xd3\x49\x70\x7a\x37\x04\x42\x8c\x49\xf0\x76\xad\x37\xbc\x77\x19\xdb\xd9\x28\x81\x28\xa5\xf8\x4f\xda\x05\x69\xa4\x51\xe1\x68\x53\xa4\x3f\x12\xb9\x3d\xff\x75\x2b\xd1\x0c\x65\x1c\x78\x0d\x63\x07\x2e\xe1\x8c\x8d\x9c\x5c\xe8\xa6\x60\x7c\x65\xb5\x92\x85\x87\x88\xaf\x0e\x0e\x42\x2c\xc0\xfa\xab\x55\x31\xa4\x76\x57\xa1\xc3\x8d\x5a\x26\x1d\x36\x80\x68\x33\x96\x16\xe1\x1b\x2e\xdf\x42\x66\x79\xac\xdd\x6d\x66\xea\x5e\xe0\x33\xcf\xd7\x7b\xf2\x3d\xbf\xf5\x8c\x5f\x82\x29\x9f\x57\xeb\xd3\x55\x91\xb4\xf9\x0a\x97\xa6\x66\x39\x4d\x93\x78\x40\x4e\x5b\x89\x6b\x21\x37\x4d\xb0\xdc\x6e\x86\x88\x18\x5f\x67\x31\xda\xb7\x76\xe7\x8e\x76\xa3\xe9\x75\x55\x49\x4f\xd0\x14\x5c\x63\xc4\xcd\xcf\x54\x26\xe6\x59\x9e\x66\x54\xdb\x9b\x99\x76\xb2\xe7\x55\xb1\x2a\xa8\x05\x51\x1f\xa2\x46\x95\x81\xef\x66\x54\x70\xc6\x9a\x85\xb9\x2b\xcb\xd8\x06\x3c\xea\x4f\xb5\x82\xa0\x58\x30\x68\xa4\xfe\x7d\x65\xe0\x0e\x6b\x45\x0f\xf3\x8b\x1f\x65\xc1\x2a\x8c\x3a\xe3\xd9\x18\xb3\xc1\x78\xa9\x62\xfd\x23\x63\x0d\x7a\x6e\xc6\xd7\x87\x63\x72\xe1\x8c\x81\x5b\x14\x35\xb2\xcc\x71\x26\x65\x5c\xfb\xd6\x3f\x26\x22\xa3\x9c\xc8\xee\x91\x11\x7d\x95\x8f\x3f\x49\x75\x1a\xca\x88\x90\xc2\xa6\x3b\x6c\x28\x12\xe8\xbc\xaa\xd9\x3e\xf3\x4e\xe5\x4a\x6f\x32\x9b\xae\x86\xc6\x9c\x85\xd1\xd4\x4c\xc9\xaf\xb4\x7e\xdf\x80\xcd\x21\x8d\x61\x02\xea\x4e\xca\x24\x32\x4c\x38\xaf\xa2\x3a\x92\x76\xe4\xca\xfc\x23\xd1\x86\x47\xca\x31\xb8\x6f\xd3\xe9\x15\x5a\xf6\x0b\x62\x71\xdf\x01\x1f\x90\x4f\x84\xe6\xb7\xd5\x72\x9e\x16\x3e\xa3\xf3\x9e\xf7\xa9\xbe\x5e\x17\xd3\x39\xdc\xea\xe5\xaa\xbe\xc3\x51\x97\x9d\x8a\xa6\xf6\x74\x86\x75\xbf\xbd\xd1\xf1\x14\x3a\x93\x8f\x72\xb5\xcc\xcb\xdd\xc3\xd8\x3f\x83\x15\xa0\x31\x1c\xb9\xee\x7d\x4c\x23\x35\x33\x76\xaf\x35\xba\x9b\x59\x2d\x9a\x04\x06\x61\x63\x5e\x29\xa8\xc5\xa9\xed\x93\x80\x66\xc5\x29\x5c\x19\x26\x2d\x62\xd4\x02\x89\x52\x91\xbd\x60\x60\xdc\x36\x96\x60\x2b\x7d\x0d\x3e\x1d\xae\x42\xbe\x7b\xd7\x2b\x26\x52\xb5\xce\x6a\xe8\xe8\xac\xfa\x8e\xbe\xd4\xae\x9b\x7b\x69\x8b\xe8\xf8\xb9\xcc\xcb\x09\xcc\xa2\x74\xda\x4a\xb2\x54\xf3\x80\xcd\x41\x6d\xa5\xd7\xa2\x72\x83\xc7\x18\xd1\x2e\x64\xe6\x40\x63\xc6\x5b\x7b\x85\xce\xea\x0e\x9d\x9b\xf8\xf7\x65\x7d\xb7\xc2\x3d\x7a\x4e\x88\x4e\xb4\xf1\x81\x23\x19\x89\xae\xea\x52\x96\xdb\x7d\xae\xde\xa5\xfb\x59\x4b\x60\x31\x72\x04\xd2\xd8\xf0\x75\x64\x0c\x6c\x9e\x90\x08\x2c\x94\x9f\xa9\x5c\x05\x59\xad\x50\x6e\xe9\x0b\xfa\xee\x2b\x55\xcd\xf7\xb0\xbb\x86\xdb\x03\x52\xde\x9e\x67\xf2\xba\x11\x5e\x45\x25\xa2\x0f\xfc\x23\xf8\xa4\x75\xc3\x32\x22\x27\xab\xaa\x6e\x3e\x02\x2a\x05\x7f\x54\xbd\x7f\x1a\x16\x12\x0d\x81\x55\x9b\x68\x3b\xfb\x4c\x08\xe1\x7f\x9e\x9c\xed\x59\x91\x98\x93\xe6\xee\x51\x2c\xfe\x1b\x67\xe5\xf5\xc7\xf5\x53\xc8\x88\xe4\xed\x01\x1f\x9e\x5e\xf5\x9d\x1b\x66\x1e\x37\x99\xbe\xd5\xca\xf3\xf3\x54\x32\x9b\xe4\xc3\x72\x46\x2b\xcb\xa6\xe7\x44\x3a\xbd\x8d\x83\xd4\x25\x40\x9d\x79\xdf\x6b\x2c\xbf\x7a\x22\x85\x96\x64\x19\x9a\x12\xba\x8f\x53\x49\x74\x81\x7e\x45\xb5\xe1\x12\x0f\x2e\x3c\xef\x7c\x6b\xb1\x63\xde\x9a\x6d\x74\x5f\x6c\x1c\x3c\x25\xf8\x09\x98\x1a\x3f\x6f\xb3\x4f\xed\x88\x4c\x98\xd6\xf7\x29\xf9\xca\x72\x9b\x5c\x72\x85\x55\x3b\xc7\xb4\x55\x25\x29\x09\x13\x74\xbd\xf4\xe5\xcf\xd3\xa2\xdb\xca\x85\x1d\x0c\x51\x48\x81\xad\xac\xd6\x6d\x24\xe2\x9d\x73\xb4\x99\xb5\x9c\x4e\xad\x2c\xd2\x3b\x82\xd3\x4e\x07\xbd\x3b\x3c\x14\x3f\x13\xdb\xf1\x6e\x05\xe4\x75\x7a\xd5\x81\xa1\x67\x76\x8e\x27\xd0\x30\x0c\x5b\xd4\x15\xdb\x83\xd4\x1a\xb4\xb3\x0d\x2e\xce\x93\x31\x3b\xcb\xa2\x3e\x8f\x40\xdb\x81\xa3\x26\xb9\x3f\x43\x26\x62\x75\xec\x0d\x54\xdd\x52\x09\x3e\x0a\x38\x32\x55\x2b\xad\x28\x2c\x37\x40\x9c\x4a\xab\x57\xc8\xed\xc4\xe7\xd2\x19\x29\xe6\xcb\xf4\x3e\xd9\xf1\xc9\xb1\x72\x10\x12\x36\x30\xba\xe9\x6f\x98\x1d\x80\x64\x95\x9f\x94\x33\x0c\xd9\xaa\xa7\x29\x36\xfe\x52\x89\x41\x2a\xf1\x86\x81\x3a\xf4\x4c\xb7\x80\x8a\xe7\x5b\x0f\x22\x76\xca\x89\x64\x4f\x61\x09\xad\x55\x03\x12\xdf\x1f\xae\x9c\x2c\x30\xb3\x87\x8e\x97\x71\xa9\x9c\x77\xab\x62\x2a\x0e\x74\x0c\x41\x2b\x6c\xf8\x82\x77\x3d\xda\xce\xad\x1b\x0a\x81\x7c\x9e\x9c\x0d\x04\xda\x58\x57\x36\xac\x21\x1a\xd7\x8d\x61\xe9\x47\x03\x53\x7b\xb0\xe4\x0e\xe6\x61\x78\x2a\xd1\x1f\xbc\xdb\x8c\xab\xe5\x72\xc0\x8c\x41\x05\x1f\x94\xe9\xa8\xfa\x5a\xec\x6d\xff\xb0\xd9\xf8\xdd\x28\x05\x69\x0f\x45\xc6\x16\x09\x91\x60\x68\xcd\xf8\xec\x76\x07\x08\x38\xac\x96\x4d\xb9\xbe\x83\xd9\x16\xfe\xa6\x1c\x15\xa1\xc8\x76\x11\x2a\xc7\xaf\x2e\x2b\x66\x9f\x3b\x1d\xc8\xce\x0a\x5e\xf2\x38\x1b\x03\x97\x4a\xb9\xf4\x6d\x85\x1b\x57\x52\xd0\xde\xe8\x41\xd0\x9b\x78\xb8\x56\x0d\xa6\x78\x62\x40\xb4\xb6\x69\x09\x52\x0f\x64\x5a\x39\x08\xa6\xd3\x79\x89\x64\x59\xb2\x4a\xa4\x5a\xb7\xc6\x75\x61\xed\x31\xef\x3e\x6a\x40\xd9\x5b\x5d\x86\x65\x51\x12\x5e\xd5\xde\x27\x7d\xa8\xd0\x55\x38\x24\x0e\xf8\xf1\xe3\x53\xed\x58\xf8\x78\x1c\x56\x13\x23\xd9\x13\x86\xe9\xe6\x94\x32\x92\x77\x8e\x8e\x3d\xef\x0b\x7e\xa4\x2c\x22\x26\x16\xbb\x39\xed\x6d\x58\xd5\xcc\xb7\xfd\xd4\x48\x1b\x71\x1a\xe4\x9f\xa1\x90\x98\xb6\xfd\x95\x8b\x74\xf9\x0b\x23\xe0\xd7\xad\x35\x9d\xdd\xcb\x6d\x8a\x20\xf8\x9c\x89\xdf\xe1\x48\xa3\xee\xe3\x19\xaa\x5a\x55\x34\x85\x7d\x88\x17\x69\x01\xe7\xae\xa2\x72\x3f\x12\x2b\x8d\x27\x80\xfa\x36\xf6\x51\x19\x79\x74\x51\xb4\xf6\x94\x34\x36\xdc\x24\xff\xf9\x9f\xd1\xf8\x0d\xfe\xfc\x5f\xff\x25\xd2\xb7\x7e\x43\xcf\xe1\xd7\xa1\xb8\x41\x90\x7e\x58\x35\x1e\xdd\x0e\x1a\x44\xcc\x13\xae\x31\x96\x0d\x78\xef\x41\x0d\x69\x8a\x36\x4f\xc3\x8e\x03\x57\x2d\x06\xe4\x98\xaa\xe6\xc4\x7f\xcd\x64\x6d\x45\x88\xb1\x39\x46\xda\x20\x8f\x82\xa0\x0f\x3d\xbe\x21\x68\x02\xd5\xf8\xbc\x03\xb4\xa4\xeb\x58\xeb\x57\x94\x84\xdd\x99\x95\x84\xe9\xe9\xc4\xdb\xf9\x40\xe2\x6e\xb7\xcf\x1e\x48\x47\xd2\x5d\xba\x8f\x84\xc6\xfe\x03\xd6\x62\x2e\xe5\xe1\x24\x09\xa2\xac\x2e\x13\xf1\xfa\x8b\xf5\x5c\x24\x09\x09\xaa\x15\x35\x08\xbb\xc1\xfd\xbd\x08\xcc\x91\x17\xd6\x13\xed\xad\x78\xfb\x71\xa5\xb6\x97\x30\xd1\x6d\x75\x6f\x25\xb9\x40\x7a\xe6\x48\x21\x73\x29\xb8\x40\xf1\xc6\x80\x21\x6b\x1c\xbb\x30\xd2\xb9\x5c\x1c\xa8\x94\x23\x98\xa2\x2d\xed\x92\x7d\x08\xf5\xc6\x46\x25\x4e\x01\x21\xf1\xbf\xd6\xfe\x22\x59\xe3\x4f\x4f\x3b\xe5\xa2\x1e\x6d\x47\xb1\x75\x90\xa7\xd4\xba\x98\x2c\xc3\xeb\x1b\xcd\xd5\xc4\xf9\x3c\xfc\xed\x2d\x93\xe2\xd5\x37\x74\xd2\xd2\x65\xb6\x8f\xa5\xce\xf7\xaf\x0f\xc7\x76\x43\x37\xe4\x00\xb7\xb1\x80\xba\xc3\xac\xf7\x5e\x76\x05\xe1\xdc\xee\xb8\xe4\xaf\x94\x40\xf3\xfb\x69\x70\xa6\x5f\x9e\x83\xec\xd0\x2b\x5e\x84\xa5\x2a\xd5\x7a\xeb\x75\x56\x69\x35\x23\xa4\x5e\x1c\x30\x51\x75\x89\xac\xaf\xb8\x1e\x69\xd1\x7c\xaa\xfc\x8a\xdf\x35\xd3\x40\xe0\xa4\x32\x6a\xfc\xcc\x00\xdd\x94\xeb\xea\xe3\xe1\xe6\x37\xb6\xeb\xc5\x9e\x87\x3e\xc0\x9f\xd3\xcc\xc8\x7b\xcd\xc7\xa7\x46\x91\xb0\xb7\x50\x5c\x9f\xbb\xdd\x1e\xfc\x1a\x9e\xb8\xcf\xf3\x8e\xe3\xcb\x31\x4f\x6d\x00\x77\x6f\x65\x6b\x6d\xc8\x22\x6b\xe6\x37\x55\x8c\x04\x5c\xcd\x5d\xa4\x0c\x8a\x8a\xd3\xb4\x92\xe8\x1b\xb2\x48\xa3\x2e\xbf\x6a\xa8\x56\x3a\x46\x81\x51\x86\x43\xfd\xe9\xd7\x37\x18\x70\x8b\x7b\x96\x95\x34\x7a\x44\xb9\x13\xb1\xcd\x9d\xd8\x73\x71\x2a\x2f\x9f\x9f\x02\x82\x26\x85\xb1\x5d\x84\xe7\xe4\xcf\x94\x6b\x85\xe2\x96\xa6\x66\xe9\xe5\x05\x33\x8a\x01\xb6\xf7\xeb\xe8\x51\x72\x78\x30\xa6\xff\xee\x7f\x33\x3a\xfc\xfa\xc9\xf8\xf0\x2b\xfa\x70\xf8\x64\x74\xf8\x67\xfc\xf4\x0d\x7f\xfc\xca\xaf\xea\xda\xb2\x88\xe0\x66\xdc\x8a\xd1\xef\x4a\x71\xb8\xca\x0d\x47\x14\x2b\x17\x67\x22\x1b\x3b\x26\xb2\xe4\xfb\x1c\x07\x4d\xc6\xd1\xb7\x6b\xaf\x7c\xbc\xdc\xb4\x5e\xf2\x2e\x5b\x68\x22\x36\xec\xa8\xde\x4e\x17\x63\x69\xab\x6b\x6a\x68\xa8\x2d\x9c\xac\x90\xbf\x5b\xbc\xbf\xc7\x23\xf0\xc3\xeb\xff\x90\x03\xc0\xd4\xe3\x5b\x8c\xf1\x37\x16\x2a\x09\xe0\x3e\x93\xb1\xdf\xe2\x87\x5f\x7a\xfd\xad\x49\xa5\x2e\x22\xb7\x6d\xa2\x34\x51\xcb\x2c\x74\x1d\xfc\x5c\xe0\x5b\x90\x37\xa7\x5c\x96\xb5\xe0\xcc\x7b\x69\x59\x45\xba\x27\x09\xe2\x96\x91\xbe\x2b\xf3\xf2\x2a\x13\x0a\x77\xad\x85\xab\xf4\x86\x00\x07\x3a\x08\xea\x90\x54\x66\x81\xb5\x05\xf1\x27\x38\xe0\x05\x75\x4c\x19\x49\xb9\x26\xe7\xf3\xc2\xed\x86\x23\x41\x5d\x5e\x29\x47\xb2\xcc\xc3\xb2\x2b\xda\xfc\xfa\x07\x9e\x5d\xcb\xcb\x75\xc7\x76\x85\x09\xb4\x45\xab\xdf\xdc\x95\x90\x67\x97\xe2\x9e\xc0\x0b\x80\x6b\x7f\xd0\xde\x6a\x5d\x7c\xf6\x55\x49\x84\x92\x96\x34\x26\xda\x99\x52\xfb\x2b\x1a\xe9\xcc\xef\xb9\x84\x27\x5d\x46\x92\x93\x06\x44\x8b\x4d\x85\x49\xb2\x83\xc3\xec\x3b\xc5\x38\x45\xa0\xa1\x7c\x5f\x72\x9e\x52\x06\x17\xac\x61\xb2\x56\x81\x0d\x05\xd9\x69\x93\x73\x35\x6d\xc0\xd2\x8d\x36\x35\xf8\x0c\x2d\x3f\x58\x55\x93\x7c\x99\x75\x4c\x99\x4a\x03\x95\x15\xce\x6a\x92\x53\x20\x22\x1f\x66\x79\x7a\xe3\x45\x97\x29\x35\xab\xb1\x4c\xcc\x3f\x13\x23\x0d\x65\x7e\x01\xda\x1b\x36\xcd\xf0\x63\x95\x47\xe2\xd2\xaf\x31\xdc\x5e\x7c\xcf\x17\x17\xbe\xd7\x4b\x9f\xec\x14\xc7\xc6\x5a\x8a\xa8\x0e\x0e\xd7\xb9\x28\x39\x84\x5f\x72\x85\x3a\xc8\x4e\x19\x24\x8e\xc3\x41\x7f\xdf\xc8\x41\x65\x01\x85\x63\x13\xfe\x19\x3f\xfc\x73\xab\x57\x2b\x9e\x80\xdb\xdb\x35\x91\x4a\xcf\x3c\x46\x8e\xbb\x58\x53\x7a\x8f\xd0\xb6\xc8\xd4\xed\x61\x7a\x83\x6a\xab\x6f\x3a\xb9\xf8\xb2\xb4\xbb\x41\x7e\x20\x65\x21\xbd\x26\x76\x5a\xb2\x49\x82\xc8\x1d\x24\x7f\x3e\x38\x6c\x15\x57\xc7\x33\x1f\xb3\xf4\x7f\xa7\x7a\xd0\xd4\xc5\x1a\x2b\x97\x59\x95\x12\xee\x03\x86\x7a\xec\x7a\x3f\x93\x06\xe5\x7e\xe0\x83\x9f\x30\x0f\x19\xf5\x36\x97\x26\x4e\x23\x15\x6b\xcc\x46\x06\x69\x2b\xc9\x44\x61\x22\xae\x0d\x89\xea\xdf\x36\xab\x6a\x90\xaa\xc8\x9c\x8c\x75\x8b\x65\x26\xbc\xac\x68\x89\x8d\xc1\x55\x92\x55\xdc\x92\x4b\x18\x18\x47\x96\x08\xcf\xea\x91\xcb\x1d\x4d\x60\x6e\x2a\xe5\xbf\xb4\x7b\x9d\xfe\xf0\xf3\x6b\x9f\x6d\x6e\xcb\xc9\xf0\x6e\x5e\xe6\xf1\xf7\x79\xfb\xfa\x77\x98\xad\x8c\xc0\x8e\xd5\x96\x13\x57\x1f\xa5\xf6\x7a\x70\x25\xa3\x9f\xf2\xcc\x98\x48\xcb\x41\x09\xb0\xa8\xc7\xef\x93\x46\x6e\x80\x35\xed\xcf\x9b\x45\xbe\x4f\x4f\xd7\x63\xfc\xfb\x93\x56\xe9\xd2\x18\xed\x58\x03\x8f\xc9\xc9\x8b\xd7\x30\xfb\xb4\xc4\xcb\xf1\xd9\x31\x59\xc0\x6c\xdf\x4a\x22\x39\xa9\x5a\xab\x90\x52\x5f\x4b\x17\xf0\x68\x1f\x87\x03\xe2\x95\x79\x27\xc2\x46\x1f\x6e\x53\x82\xe2\x46\x69\xe9\x5c\x70\x4b\xce\x18\x8c\x16\xd7\x75\x1e\xf3\x30\x71\x78\xa3\xf3\xe3\x24\xeb\x39\x96\xb0\x7f\x9d\x56\xfb\xa0\xa2\xef\x8b\x09\x60\x3f\x34\x09\x09\xd5\x89\xb3\x4a\x3f\xc6\xd3\x74\x3c\xad\x1a\xee\xb3\x64\x29\x28\x0c\x44\x66\x08\x96\x80\xa1\x69\xb6\x0c\xc2\x9f\x6f\x2b\x7a\x65\xdf\x79\x54\xef\x89\x04\x64\x03\x5d\xa8\x22\x3f\x9a\x80\x7a\x30\x65\xab\x8b\xd9\xfa\x64\x65\x40\x9a\x6a\x23\xbd\x5f\x84\xf2\x93\x27\xba\x86\xa7\xd3\xe2\x29\x77\xed\x3d\x5a\xa4\x28\x6d\xc6\xa4\x32\x50\x12\x6d\xf1\x74\x9e\xde\xc0\x40\x71\x59\x80\x1c\x68\xc6\xfc\x69\x5c\x5f\x4f\x65\x76\x78\xe2\x02\x21\x40\xa3\x6e\x99\x9b\x31\x7e\xe0\x9f\x37\x23\xde\x85\xb5\x0e\x3d\x33\xaf\x28\x72\x91\x65\x4b\xb4\x52\x4e\x31\x11\x49\x0b\x8a\xdd\x12\x4b\xc9\x92\x82\xa2\x87\x3c\xa1\x03\x9c\xcc\xc5\x4c\xef\xf2\x9e\x5d\x14\x76\x59\xbb\x3d\xa6\x4e\xad\x72\xd9\xea\x94\xd1\x95\x41\xe1\x0f\x1d\x41\xb5\x04\x0e\xdd\xeb\xb6\xb2\x8a\xb4\x19\xed\x03\x3d\x0b\xe4\x7c\x45\xef\x81\xd7\x2a\xd6\xd5\x64\x55\x4a\x25\x8e\xa8\x82\xf1\x04\xf3\xb0\x9b\x92\xaa\xfd\x24\x0f\xfe\xf7\xe3\x07\x2c\x7e\x3d\x10\x8d\xf3\x41\x62\x5b\x55\x8c\xdc\xa5\x5f\xd3\x6b\xec\x20\xa7\x10\x4a\x95\x9f\x49\x93\xbd\x40\x1b\xa6\x5b\xdb\x03\x18\x33\x58\x4c\x5e\x4e\xd3\x9c\xd2\xaa\x30\xc8\xf2\xd6\x0d\xfd\x36\xd3\x26\x1a\xe1\x02\x34\x1e\xa7\x2c\x97\x58\x4b\xc6\x9b\x1b\x87\xb5\xdd\x3d\x9f\x7c\x4d\x2b\x39\x4c\x42\x7d\xcd\xb9\x34\xb4\x95\x80\xa7\x71\x91\x95\x18\x65\xb3\x6c\x5b\xeb\x09\x6e\x05\xdb\xab\x1b\xf4\xc8\x67\x5b\xea\xff\xa7\x40\x3e\x25\xb6\x3f\x50\xbb\x3f\x85\x1d\xbb\x95\xc9\x6e\x06\x22\x9e\x48\x3f\x43\x03\x44\x55\xc7\xb2\x72\x5d\x5b\x1d\x6b\x93\x37\x49\x5b\x28\x51\x24\x62\x83\x13\x8d\xbe\x0f\x88\xdd\x44\x3c\x11\xeb\xf0\x84\xc9\x61\xb4\x89\x1f\xb7\x42\xa9\xf5\x8b\x70\xfe\x7d\x18\xc1\x76\x31\x1f\x0c\xfe\xad\x7d\x18\x9c\x5c\xc9\x2f\x8e\x3d\x98\xbd\x36\x9e\x1c\x65\xd1\x15\xe4\x38\x5a\xbd\xca\x1a\x91\x5c\xec\x9a\xa8\x83\x87\x52\x70\xab\xd1\x1b\xca\x52\x1c\x4d\xb8\xdd\x52\x1a\x92\xb0\x1d\x1a\x29\x46\x22\x7a\xa9\x70\x9a\xc8\xcf\x5e\x98\x44\x51\x6a\x3f\x64\x27\x2e\x92\xb9\xaa\xc0\x12\x1f\x85\x19\x2e\x1e\xee\xae\x65\xb4\x2f\x48\xee\x82\xe4\x39\xc3\xbf\xfe\xfa\x9b\x96\xfe\x22\x9c\x75\x78\xf4\x33\x3d\x2e\x0d\x85\x5d\x74\x33\x97\xad\x2d\x2b\xcb\x9d\xc3\x4e\x4b\x75\x9b\xe3\x7a\x20\x20\xe9\x0c\x9c\x9e\x4a\xc2\xb8\xec\x91\x1e\xba\x0d\xc7\xdd\x7c\x35\x0c\x6e\x71\xde\x23\xc7\x59\x7e\xbe\x11\x8a\x68\xf8\x75\x73\xd7\xf4\xd3\xd4\x45\x2e\xeb\xae\xcb\x50\xa8\x96\xb0\x01\x1f\x74\xb9\x1d\xc5\xf6\x7f\xa6\xbf\xe3\x77\xd7\x8b\x98\xcf\xcd\x2f\xa0\xd0\xc8\x25\x10\x1e\x24\x99\xcc\x95\x8b\x81\x77\xee\x2f\x11\x15\xa1\x08\x13\x50\x9b\xb6\x2b\x9f\x1e\x91\x46\xb1\xf5\x67\x55\xf9\x65\x66\x26\xab\xdb\x3b\x50\x1f\x5b\xa5\x4d\x74\x61\x7a\xed\x32\x68\x43\x9d\xca\x97\xa6\x52\x6f\x62\xda\x34\x5c\xad\x55\x45\xe8\x9f\x5f\xf3\x95\xaa\x1e\x52\xff\x2e\xe5\x73\x17\x80\x15\xd7\xab\x1a\x43\x04\x6f\x05\xef\x8c\x9f\xab\xa5\x15\x2a\x05\xf8\xe0\x96\x64\x8b\x05\xd0\x21\xc0\x4d\xd7\xbe\xf5\x40\x72\x07\x35\x75\x66\x73\x92\x66\xd8\x77\x07\xa5\x50\x66\x9b\x03\x9a\xcf\x64\x5c\x76\xd0\x58\x4e\xcb\xfb\xa4\x31\x8d\x96\x40\xb2\x76\x0b\x1a\xea\x18\xde\x8e\x70\xec\x20\x41\xa4\x82\x21\x5c\x0a\x6b\x3f\x11\xd7\x55\xb9\x10\xef\x28\x96\x0b\x39\x86\x5f\x04\x74\x8a\xb0\x32\x37\x98\x4b\x95\xae\x0a\xda\x22\x04\xd0\x2b\xa2\x7c\xf4\xe5\xc1\xc1\x97\x01\x30\x77\xe5\x15\x38\xb0\xcd\x50\xe7\x12\x54\x98\xa3\x69\xaa\x09\x1c\x8e\x85\x47\x1a\xc1\x45\xa5\x74\x92\xc4\xff\xf1\x1f\x47\xff\xfd\xa7\xda\x7c\x7f\xf8\xfd\x33\xe6\xf1\xf1\xf3\x8b\xb2\x7c\x3a\x49\xab\x64\x4c\x5e\x4a\xb9\xf7\x49\xb9\x63\x84\xb3\xc0\x16\x27\xad\xa6\x68\x5a\x8e\x14\x30\xd2\x48\xaa\x02\x50\xef\xdc\x70\xbd\xe5\xd4\xcb\xd5\x27\x33\x7b\x3e\xc3\x9e\xb6\x75\x3b\xb6\x6d\x6e\xd2\x65\x2c\x11\x60\xbb\x04\xe2\xe3\x7b\xd4\x29\x79\xd4\x0e\x22\xeb\x36\x94\x95\xee\x9d\x14\x12\x67\x31\xf1\xf5\x97\xc9\x38\x8c\xe2\xc8\xc2\x36\x71\x5f\x1e\xfc\x89\xec\xd9\x4f\xbe\xfc\x13\xab\x61\xde\x28\xb5\xdf\x0f\xee\x8b\x83\x83\xd7\x24\xee\x58\x98\xba\xbd\x62\x58\xbc\x2a\xca\x60\x14\xdb\x6c\xae\xac\xfc\xfe\x73\xda\xca\x3c\x0c\x0b\xf1\x36\xde\x59\x9b\x5a\x45\x9e\x86\x58\x9d\x2c\x9b\xee\xa0\xb6\xe5\x4d\xda\xe2\xe3\xd4\xdb\x89\x80\xde\x50\x37\x0a\xb7\xa5\x25\x07\x6d\xa8\x38\xec\x05\xc5\x79\x19\x6c\xd1\xa9\x8c\x1b\xb6\xce\xaa\xdb\x60\x52\xf4\x4a\x4d\xd1\x5a\x31\x06\xbe\x52\xdb\x01\xea\xeb\xc4\x1f\x62\xf8\xfe\x0f\x53\x95\x7b\xd1\x85\x49\x1b\x34\x8d\x8d\xa2\xc9\x0a\xd9\x08\x06\xf6\xe9\x77\x2e\x1d\x72\x61\x52\x9c\x16\x1d\x3b\xce\x62\xc9\xd1\xd3\x5c\x78\x6e\x73\x68\xd7\x27\xdd\x4d\x58\xd1\x41\x8c\x7a\x37\x27\x6d\xe3\x11\x87\x37\x94\xf0\x7c\xdb\xf7\xe8\x91\xc6\x9c\x21\xe1\x26\xf3\x65\x3a\xf6\x1e\x1e\x0b\xa9\x8e\x67\xe6\x5a\x0a\x94\x6d\x7b\xc0\xfb\x61\x6f\x7c\xea\x07\x0b\x29\x20\xb3\x72\xba\x72\xc5\x4c\xd9\x07\x47\x21\x5b\xac\xda\xb4\x02\xa4\x7c\x0c\x2c\x30\x77\x6d\xfa\x71\x50\xc0\x63\x6d\xc2\x81\x57\xef\x34\xd1\x98\x6b\x58\xf9\x74\xb9\xd2\x8f\xf7\xb9\x4e\xbe\xb9\x6f\x63\xaa\x67\x46\xae\x5b\x2d\x5c\xef\x01\xad\xee\xab\x8a\x02\x71\x3d\x16\xfb\x88\x93\x0d\x10\x03\x12\xdb\xdd\x45\xca\x9e\xab\xd5\x7b\x52\xce\xee\x67\x71\x7e\xbc\x72\xec\xe0\x1b\x72\x91\x74\x2f\x0c\x7f\x09\x22\xf5\xa8\x65\xc1\x26\x33\xa7\x99\x97\x27\x97\xda\x78\xfc\x91\xad\xc9\x77\x48\x97\xe4\xe1\xc1\xc1\x48\x05\xb9\x93\x72\xa6\x2d\xff\xd2\x9c\x93\xc4\x5d\xb3\x21\x8e\xf4\xf2\xe4\x2c\x26\x20\xa2\x9e\xaf\x0f\xa8\x56\x24\xbd\x46\xa9\xe5\x4d\xf4\xf5\xc1\x9f\x14\x5a\x7e\xfe\xa3\x10\x0d\x46\xb5\xd3\x2c\x83\x2e\x60\xe9\x75\xe3\x42\xca\x4f\x6c\xa9\x31\xa7\x4c\xe9\xa5\x80\x82\x6c\xb1\xa6\xfc\xfc\xbe\x40\x1e\x51\xa0\x1f\x3f\x46\x0e\xfd\xf8\xb1\xe7\x0c\x1e\x29\x23\xa6\x91\x7b\x5a\xe3\x0a\x36\xb9\x09\x6b\x19\xe1\x00\x7a\xc9\x36\x9e\x2e\xe7\xdf\xc1\xae\xd9\x35\xc2\xf3\x51\x30\x87\x15\xec\x86\x60\xee\xb8\x90\xb8\x7c\x8e\xe7\xe9\xc6\xe5\x9f\xb4\xeb\xb5\x55\xf6\xfa\x43\xeb\x04\x46\xa1\xe6\xbd\x18\x54\xc0\xb1\x99\x15\xde\x08\x88\x8f\x29\xc8\x21\x1c\x8a\xc2\x61\x08\x86\xc5\x79\x9b\x86\x41\x51\xad\xfc\xfa\x47\x40\x82\x2b\x6f\xe2\xb1\x8e\x61\x5d\x7f\xbb\x69\x95\x7e\x61\x65\xb5\x76\xfb\x68\x01\x81\x6b\x26\x61\x03\xca\x5a\x7a\x82\x4c\xc6\xc3\xc8\x2a\x22\xc7\x3b\x4b\x6b\x2a\x1e\x92\x29\xfa\x30\x69\x87\x70\xd6\x41\xd1\x6b\xe0\xf7\x68\xf2\xc4\x6b\xeb\x23\x20\x50\x1a\x88\xed\xd6\x30\x59\x51\x37\xb3\xb5\xe3\x0a\xaf\x75\x32\x29\x90\xda\xeb\x83\x64\x4a\xdb\x27\x06\xd3\x9d\x82\x04\x55\x2e\xaf\x69\xac\x3f\xa7\x32\x20\x12\x15\x66\xd6\x4b\x5a\xaa\xd3\x90\x2a\x20\x20\x48\x5c\xaf\x47\x6b\xf7\x45\x6a\x1f\xb5\xb2\x75\x5b\x3a\xb5\x15\xae\x6d\x45\x8b\x9a\xbc\xe8\x47\x8f\xfd\xd6\x15\x6c\xb5\xb0\xc5\x47\x65\x0c\x11\xb2\x1f\x93\x6c\xe6\x55\xfd\xdf\x50\x22\x9b\x64\x48\x96\x00\x6c\x71\xeb\x0f\x28\x79\xdd\xd6\x07\x3e\x8e\x1e\x20\xf2\x7f\x88\x4d\x71\x64\xd5\x61\x55\x3a\x7d\xc5\xeb\x18\x4c\x05\x53\xde\x49\x29\xdb\x85\x0b\xe6\xaa\xba\x62\x3d\x07\x44\x61\x03\x6f\x3b\x50\xa7\x2c\x22\x8f\xe5\x22\xf1\x9f\x1d\xbf\x7e\xf1\xea\xb7\x1f\xdf\x1c\x9f\xbf\xfc\xf9\xc5\x6f\xcf\xde\xbe\xf9\xee\xe5\xf7\x3f\x9d\xc2\xa7\xb7\x6f\xf0\x91\x1f\xce\xe0\x5f\x26\x21\x1e\x9d\xe3\x53\xdc\xf0\x52\x8c\x9f\x6b\xc0\x52\xe8\x98\x26\xf7\x12\x1c\xe1\xfc\x1d\x03\x15\xef\xb0\x9f\xf4\x9b\x6d\xcc\xe1\xe9\xa3\x13\xdb\xd3\xc0\x7c\xea\x01\xd3\x0e\x0b\x43\x04\xe6\x10\x14\xd9\xff\x34\x40\x3b\xe5\xb9\xb7\xb6\x37\xdc\x2f\x1f\x00\x60\xf7\x85\xc9\x63\xa1\xaa\x81\xd6\x92\x57\x62\x2b\x91\xb7\xc5\xca\x88\x51\xb6\x5c\x34\x05\x73\x62\xfd\x6e\x40\xbc\x99\x08\xbc\x6d\xb1\x42\xa9\x23\x3a\x00\xe7\x22\x20\x4a\x89\x36\x98\x94\x7e\x3a\x7d\x59\xf7\x82\x9a\x15\x57\x1f\x0c\x28\x3c\xd5\x60\x6f\x7b\xa9\x47\xfa\xf1\xa1\x55\xfd\xf5\xef\x82\xd9\xde\x79\xef\x80\x26\x97\xbe\xff\x41\x78\xb2\xba\xfb\x20\x44\x5d\x9b\x3b\x63\x89\xde\x95\xe2\x53\xd6\xfd\xd4\x29\x40\x8d\x09\x32\xab\x09\xbe\x3e\xa1\x63\xd3\x0b\xb2\x37\x52\x17\xde\xe8\x11\xbb\x70\xd0\xa8\xa2\xfd\x53\x26\x55\x79\x85\xd9\x48\xd9\x05\xf9\x07\xa4\xcb\xd2\x03\x61\x4c\x0f\xf6\x7a\xd6\x78\x97\x1d\x19\xb4\x42\x60\x2d\xb3\xd5\xd4\x7c\xcc\x85\x05\xf0\x73\x87\xc2\x5d\x61\x7f\x96\x97\xab\xd9\x8b\x6b\xee\xc8\xd2\xc0\xd3\x13\x2c\x7c\x2c\x63\x59\x9f\x29\xd7\xfe\xb4\xbf\x73\xfd\xcf\xa4\x55\xcd\xd4\xdd\x98\xdc\xb1\xb0\xdd\xe3\x9e\x57\xe9\xca\x08\xd1\x95\xce\x1f\x9f\x2e\xd6\x42\x5d\xd2\xaf\xca\x81\xc2\xe4\xa9\x52\x19\x19\x1c\xe3\x29\xc8\x0c\x69\x8e\xf5\x85\xf0\xe2\x07\x74\xf0\x32\xb9\xef\x09\x97\x4b\x8d\x9e\x1c\x78\x85\x52\xc7\xd1\x77\xb4\x22\xbc\x72\xe1\x62\x4a\x1a\xea\x3d\x87\x35\x53\x30\xb2\xf4\x1a\xe3\xc8\xda\x00\x86\x11\x43\x80\xa4\x98\x7e\xae\x63\xdc\x83\x58\x4a\x37\x0d\xf4\xf2\x69\xa1\x27\x6d\x2f\xe4\xe1\x5c\x77\xd4\xe6\x4d\xf6\x15\x85\xa1\xd6\xb4\x4c\x3e\x1a\xe0\x86\x22\x0f\x03\xec\xa2\x63\xb1\x39\x84\x6b\xd3\x32\x8a\x92\x83\xf1\x17\x09\xfd\xf3\x84\x8d\x4d\x18\xc9\x40\x4e\x6c\x42\xe7\x82\xda\xb6\x35\x1e\x7c\xe6\xfd\x92\xc5\x0b\x01\x41\x77\x94\xe6\xa1\x64\xac\x26\x9d\x5e\x75\x89\x4e\xf6\x2e\x56\x86\x78\x7b\x34\xab\x44\xcc\x5f\xd8\x5d\xc1\xd9\x19\x23\x41\x56\x3e\x37\x16\x8c\x1e\x60\x8d\x30\x06\x06\x2e\xeb\xa6\xac\xd6\x0f\xc6\xd1\x59\x56\x4c\xe5\xf6\xce\x6a\x49\xc0\x87\xc1\x48\x8e\xce\xe5\xcd\x40\x97\x34\x8b\xf2\x9a\x65\xa7\x14\xce\x18\x5a\x3c\xfd\x9d\x91\xc5\x8e\x3c\xa0\x3c\x71\x86\xac\xa2\xbd\xed\xde\xb2\x9a\x9d\x20\x56\xb0\x5d\xb0\x4e\x91\xa2\x19\x44\x30\x12\x06\xeb\x2d\xec\x5d\x8e\x0e\xa1\x65\xda\x0c\xc6\x97\x6e\x08\x31\x87\x33\xbe\x6d\x96\x30\x1b\x6c\xec\x97\x11\x8f\x95\x4d\xb2\x3c\x6b\xd6\xb0\x8a\xf7\x58\xea\xe2\xc6\x86\xae\xdb\xc5\x87\x4b\x0f\xfb\x41\x22\xfb\x8b\x31\x3e\x47\x69\x79\xab\x8a\xc1\x46\x71\x79\xbc\x8f\x68\xa9\x27\xe6\x15\x1d\x30\x27\xff\xc0\xbe\x5d\x7d\x2b\xef\xa8\xa8\x3c\xa6\x0a\x5a\xbe\xb6\xd9\x8b\x6b\x36\xf7\x78\xbd\x36\x71\xf8\xf1\xb6\x50\xfa\x9d\x74\x26\x46\xb3\x13\xf6\xbd\x3a\x6f\xc8\x59\x54\x70\xf4\x44\x55\xe7\x84\xc0\x02\x82\x8c\xb4\xfb\x0a\x79\x7d\xc5\x33\xf4\x57\x2a\x92\xe9\xb7\xa6\x9a\x90\x6b\x50\x5b\x04\xcc\xb9\x6c\x3a\x15\x19\xbe\x8c\xd2\xcb\x4b\xac\xf0\x07\x27\x8b\xe3\xca\x41\x6c\xd0\x36\xcc\xc8\x7b\xd2\xaa\xa6\x04\x14\x2a\x59\xf6\xbe\xc1\xb4\x2d\x8c\x6f\x13\xd9\x9f\xc4\x56\x1c\x85\x45\x57\x3c\x36\x7e\x6b\x54\xc7\x4f\x5a\xed\x75\x3f\xd7\xc2\x3e\xe5\x65\xcc\x2b\x1d\xc8\xfe\x05\x2d\xb2\x35\x88\x28\xc5\x9f\x0b\x37\x41\xb4\x32\x93\x7e\x57\x97\x41\xd9\x3c\xfa\x65\xaf\x0d\xc0\x9d\xd3\x2f\xaa\xb2\x6c\xb8\xda\x65\xe5\x4a\xc4\xbf\xfd\xee\x3b\xaa\xe1\x77\x7c\x7e\xfc\x0a\xff\x78\x71\x7a\xfa\xf6\x14\xff\xf8\xf7\xe3\xd3\x37\xf8\xef\xcb\x37\xdf\xbd\xa5\xa4\x8b\x17\xdf\xfe\xf4\x3d\xfe\x71\x7e\x8a\x05\x6f\xb9\x7d\xeb\xab\x57\x41\x46\x03\x4d\xb7\xbb\x4f\x97\x61\x92\xb7\x5d\xb4\x16\x7f\x4d\xa9\xf1\x4f\xe9\x37\x1b\xb6\x25\x12\x44\xbb\x1d\xdd\x53\x81\xd1\x8f\x76\x42\xcf\x70\x59\x23\x5b\xc4\xb6\xf3\x2a\x44\xf1\xd0\xf6\x48\x20\xaf\xbe\x24\x16\xa9\x4d\x89\xb0\x13\x4d\x17\x6d\xee\xcc\x73\xd8\xec\x7d\xb6\x87\x7d\x4d\x33\x6c\x71\x42\xf6\x31\xdd\xc0\x56\x81\x38\xa3\xa2\x24\x9e\x83\x31\x6c\x79\x33\x2b\xa9\x78\x2b\xdf\xb4\x26\xf7\x32\x2f\xad\xc1\xe6\x31\xaf\xf4\xb1\x1a\x75\xe8\x78\x63\x70\x19\x9c\x0d\x64\x0a\x64\xe1\x2a\x50\x6a\xc2\x23\xfd\xd0\x6f\x55\x18\x42\x73\xc3\x36\x06\xbd\x2e\x78\x58\xa7\x8b\xd0\xd5\x4c\x73\xe8\xee\xe2\x8d\xfa\xe8\x01\x3f\x77\x94\x97\xd3\x2b\xc2\x7c\x03\x60\xc2\x8a\x17\x47\x93\xb2\xa9\x41\x8c\x1f\x8f\x41\xae\x79\xf3\xf6\xfc\xc5\x11\x9f\x5e\xc1\x17\xba\x44\x69\xb7\xd3\xbc\x5d\x7c\xb0\x8d\x37\x5b\xd7\x44\x6a\x1f\xf9\x4d\x5f\xd1\x11\xbd\x4f\x61\x79\x5e\xc1\x71\x5b\xc2\x8d\x0a\xba\xe8\xba\xb1\x48\xdf\x62\xc1\xe1\x08\x56\x6a\x77\xea\x47\x7b\x16\x92\x12\xac\x3a\xb2\xd5\x93\xfc\x69\x77\x12\xdd\xe1\x7a\xad\xbd\xfb\xb5\x15\x81\x75\xe1\xfa\x9e\xf7\xd4\xe4\x8a\xb1\x3c\xe0\x25\xb6\x87\x69\x35\x88\x1b\x50\x33\x94\xe0\xe7\x60\x6d\xb5\x39\x71\xc1\x0a\x5b\xb0\x32\x2d\xd2\x7c\xfd\x87\xdc\xa6\xa2\xc8\x63\x8e\x84\x26\xb5\x07\x8d\xcf\xfc\x24\x19\x85\xca\x29\xe6\xe3\x17\xb6\xb6\x86\xe4\x00\x76\xe8\x57\x9a\xf5\x92\xc9\x8d\xab\x49\xc8\x77\x04\x5f\xbb\xe6\x8a\xd3\xb1\x28\xe5\xf5\x22\x00\x66\xbc\xa1\x74\xce\xb8\xaf\x4e\xfe\x80\x1b\xe3\x8d\x97\x45\x65\xdf\xf3\x3a\x49\xf9\xee\x80\x46\xcd\xe7\xb8\xb2\x71\xf4\xdc\x0b\x1c\x79\xf0\x17\x8f\x78\x89\x7d\xff\x6b\x8c\x4f\x3d\xe8\x54\xec\x88\xaf\xcc\x90\x5a\xb5\xaf\x28\x35\xb8\x17\x8e\x0c\x79\x35\xe6\xa8\x50\x87\xc3\x92\x3b\x53\x36\xc6\x89\xa5\x3d\xe0\xb5\x4b\x78\xec\x7b\xe0\xf6\xc0\x48\x1a\xef\x60\x28\x3d\xb7\xd3\x47\x80\xb5\xaf\x3a\x88\x77\x09\x21\x27\xb9\x47\xb1\x53\x8a\x1b\xf4\x55\xf4\x60\x4f\xa2\xd6\x3c\xd8\xde\x78\xc0\x15\xe3\x91\xcc\x5c\xc9\x6e\x83\x2f\xc8\x16\xcc\x6a\x5f\xbb\xf3\xae\x14\x8d\x90\x62\x0d\x59\x2d\xe0\x4d\xa4\xb9\x24\x61\x80\x0f\xeb\x11\x26\x2d\xfd\x72\x84\xbb\x83\x8d\x49\xb8\xe2\xad\x98\x17\xe8\x54\x35\xad\x0c\x41\x1b\x33\x3a\xf3\xea\xc8\x25\x38\x4a\xc2\x7e\x6d\xed\x02\x85\x5f\x79\x15\x74\x1d\x2c\x2e\x9c\x7b\xdb\xb2\xc9\x95\xc6\x06\x07\x15\xb7\x96\x98\x27\xe3\x2b\xea\x66\xb1\x6c\xd6\xcf\x33\xee\xdf\xad\x67\x8e\xa5\x2b\x0e\x8f\x17\xb3\x88\xf0\x25\xd4\x78\x2f\x8b\xb2\x12\xe3\x8a\x7b\x5d\xf7\x02\x38\xb8\xc2\x29\xf1\xea\x54\xd2\xdd\xc3\x01\x9b\x64\x78\x03\x69\x81\x14\x8d\xa4\x45\x31\x28\x75\x5d\xd2\x0d\xa9\x45\x89\xe6\x8a\xdb\x10\x73\xf3\x1e\x6b\x0e\x78\x5c\xbb\xc6\x7e\xb4\x0b\xaa\xcd\x42\x96\x82\x25\x30\xe3\x88\x1d\x36\xa0\xae\x7b\xf9\xe2\x9c\xa1\x9e\x4e\xaf\x9c\x5e\xa0\x88\xe4\xe9\x3f\x69\xe1\xbf\x5b\x12\x64\x98\x74\xab\x87\xc4\x9e\x9a\x41\xa7\x25\xc1\x42\x20\x47\x8b\x35\xc6\x2b\x65\x8b\x23\xca\x88\xc3\xaf\x12\x0a\xa0\x41\xd6\x75\xc4\x5f\xf2\xdf\x96\x0e\x1c\x77\xc0\xf2\xe6\xe9\x32\xbb\xbf\x40\x66\xfc\x11\x6b\x1d\x3f\x3f\x7b\xb5\xbd\x8b\x2a\xa5\xbf\xd9\xce\x8b\x41\x3c\x9b\xf8\x03\x75\x28\x14\xd9\xea\x2d\x7d\x3c\xcb\x86\x54\x9f\xfb\x62\x78\xf8\xe3\xb9\xc1\x9a\x59\x98\xb1\xdc\x2d\xf1\x30\xc3\x54\x66\x32\x4e\xce\xf0\xd7\x69\xbf\xda\xed\x8e\x0a\xf3\xb4\x70\x54\xaf\x1b\x77\x4f\xc6\xea\xdb\xf3\x57\x27\x54\xc4\xad\x6a\x58\x02\xad\x8d\xe4\x4d\xe3\x7c\x4c\x45\x40\xe2\xed\x21\xa9\x4e\xb5\x56\xe2\x66\xb8\x6d\x29\x85\x54\x59\x2b\x50\x0f\x6a\xa0\x5c\x7d\x89\x39\x71\x3d\x08\x4c\x6c\x05\xe2\x16\x15\x82\xa8\x69\xdd\xe1\xdb\x67\xcf\x7f\x24\x59\xc6\x69\x2b\x8b\x92\x7a\x06\x8b\x27\x3d\x6c\x7b\x1b\x66\x31\xab\x75\x8a\xfc\xc7\x1e\x3f\x12\xe7\x29\x9b\x0f\xce\x3b\x80\xd0\xe1\x75\xe1\xa6\x0a\xad\x8d\xb2\x4c\x40\x47\x78\xf5\xdb\xe3\xa4\xbf\x95\xcc\x88\x05\x7a\x2f\xb0\xa9\x0d\xfa\x67\x6a\xb2\x50\xd1\x74\xa0\xc1\xe0\xa7\xd3\x57\x4a\xd1\x8c\x5e\xd5\xcf\x3c\x12\x24\xbf\xfe\x7b\xb1\xef\x34\xa5\x32\x2c\xcc\xce\x38\xda\xdf\xc7\x23\x1a\x3b\x8a\xe4\xe2\xaa\x29\x9b\x26\x8f\xfe\xe5\x8b\xc3\xaf\x93\xb0\x49\x12\xe7\xee\xde\xb1\xfe\x9d\x6a\x55\x2d\xe8\x6c\x1f\x0f\xbc\x23\xdb\xa5\xc6\xdb\xf2\x54\x68\x05\x4d\xd1\x2d\x33\x34\x87\x47\x9e\xf6\xba\x26\x4c\xa9\xe4\xa5\xe4\xdb\xa4\x0c\x13\xe7\xe2\x4f\x51\xa9\x9c\x39\xc3\x4b\x9a\xdf\xa4\xeb\xfa\x37\xee\x08\xae\x1f\x2e\x2e\xa8\x3f\x38\xbe\x95\xcd\x08\xc6\x64\x04\x82\x09\x6a\x90\x24\x25\xfd\x16\xbc\xd5\xf7\x03\xd6\xbf\xc0\x2b\xc2\xff\x2d\x18\xcf\xb3\x2f\xf5\x0f\xdc\x87\x8f\x98\xde\x1d\x88\x15\x7a\x96\x76\x48\x58\x96\x56\x3c\x76\x48\xb0\x1d\x7c\x0f\x12\x0d\x38\x0a\x72\x09\x35\x56\x82\x46\x62\xf9\x50\x20\xf1\xec\xae\xe5\x4d\x71\x9f\x1d\x9d\xdf\xde\xb8\x7a\x76\xa6\xa8\x85\x45\x4b\x33\x75\xf5\x70\x39\x7b\x0a\x08\xff\x25\xdb\x4c\xdb\x44\xc6\x2d\x72\xf4\x0d\x62\x98\x98\x59\x71\x41\x51\x24\x7e\x21\x4b\x4c\x56\xe0\xb2\x49\x3d\xbd\xc4\x4b\x91\x1a\x40\x96\xc3\x85\x7b\x53\x7f\xd2\xfc\x47\xe2\x54\xfb\xab\x7d\xde\x96\x74\x2f\x3a\xaf\x8f\x24\xce\x98\x53\x04\x52\x9d\xbe\xe3\x82\xba\xa4\x61\xed\x22\x52\xa5\x38\x5f\x03\x38\x3d\xb9\xb9\x4c\x6d\xeb\xef\x7a\xe3\x58\xfb\x96\xbd\x28\x38\x8b\x7f\x09\xba\x41\xf6\x5e\x59\x1a\x70\x2d\x0a\xcd\x5e\xac\xc9\xc3\x52\xac\xc7\xf0\xef\xfe\xe3\xa4\x67\x81\x9d\x0a\x94\x03\xd7\x26\x1b\xfe\x21\xcb\xe2\x21\x3e\x74\x45\x36\x5d\x69\x36\xb9\x47\x09\xeb\xe4\xf9\xb7\xb7\x98\x34\x4f\xca\xd9\xf3\xac\xae\x56\xf4\xd2\xb7\xab\x19\x06\x05\xdb\x76\x3f\xea\x50\x7e\x19\x66\x56\xa3\xb2\xf8\x3e\x9d\x92\xd1\x56\xd8\x2b\xc6\xf4\xda\x86\xbf\xc2\x64\x5a\xbd\x85\x13\xbf\x93\xe9\x67\x5c\x90\x7b\xd7\x66\xc9\xad\x26\xc9\x7d\x38\x75\xe5\x18\x6d\x05\x2c\xd7\x3d\x39\xbd\x60\xc1\x2f\x32\x70\xf7\x4a\xb4\xa9\xda\x07\xc4\xa9\xd1\x6d\xa5\x1c\xb5\x7a\x29\x8f\xdf\x16\xbb\xee\x96\xfa\xaf\xfa\x1a\x20\xdd\xad\x6d\xf4\x50\x4c\xf4\xb4\x90\xbe\x0f\x24\xb4\x17\xcc\x68\x08\x51\xd3\x45\x82\x3d\xb8\x72\x64\x63\x14\xc4\xee\xf3\x08\x6b\x35\x3a\x0a\xe2\xec\x75\x49\xd2\x2f\x52\xe8\x09\x3f\x9f\xbe\x38\x3b\x27\x35\x91\x56\x14\x00\xea\x37\x25\xd9\xd0\x97\x88\x43\xc6\x51\xd0\xe4\xa0\x5b\xec\x06\x61\xfb\xd9\xbb\x2c\x37\x6e\x47\xc9\xf2\x20\x8a\x7f\xb5\x17\xe6\x20\xb0\xe0\xd7\x63\xeb\x8b\x64\x3f\xb9\x85\xd7\xf9\xf2\x39\xe7\x11\x8d\xfc\xe8\xfa\x51\x23\x50\x9f\xff\x3f\x9a\xac\x32\x0c\xaa\x56\x0d\x46\xf3\xe9\xb9\xda\x7b\xc5\x19\xf4\x0a\x26\xba\x4f\x69\xb0\x96\xd7\xc9\x32\xec\x7f\x0c\x27\xe9\xd0\x04\x7f\xc2\x4f\x9b\x5a\x6c\x81\x90\xb6\xd4\xde\xed\x19\x03\xaf\x77\xdb\x8c\x02\x8e\xb1\xb4\xdb\x7c\x20\x03\x08\xb6\xc5\xc2\x12\xb6\xc9\x91\x92\xce\x68\xa9\xd0\x5b\x14\x4b\xe5\x26\x9b\x8a\x1f\xf4\xed\x64\xe7\x90\xde\x9f\xd8\x6a\x8b\x45\x5a\x93\x4c\x4a\x12\xb4\x7c\x6e\xd7\x08\xc7\x50\xea\xcb\x82\x4b\x52\x78\x77\xaa\x1d\xa4\x6c\xfd\x04\xab\xc6\xfc\x0a\xb1\x28\xda\xe7\xd0\x26\xca\x1d\x62\x47\xce\x93\xd3\x0a\xbc\x97\x6a\x79\xa9\x8d\x0e\xd6\xb7\xa5\x8b\x9a\xe4\x22\x52\xec\x8d\xf8\xee\xd8\x8a\x84\xb9\x88\xdc\x79\x03\x37\xcb\xeb\x69\x56\x19\xda\x00\x38\x76\x3c\x83\xda\x97\xd3\x68\x0a\x77\x57\xb9\x68\x17\xcc\x90\xc3\x68\xa1\xe6\xe0\xf2\xb2\x70\x18\x0d\x7a\x20\x81\x58\xd0\x50\x70\x19\x16\xa9\x19\x61\xc4\xc9\xd4\x4d\x8b\xac\x1f\x78\x3a\xb9\x68\xbc\x02\xbf\x58\x86\xd8\x16\xbd\xfb\xb4\xfb\x0e\xf0\x7e\xc4\xb2\xda\x21\x2d\x01\x3a\x3b\xf8\x88\xec\x8e\x7b\x0e\xa3\xae\x1f\x7c\x97\x32\xc6\x1f\xdc\x84\x00\x9b\xea\x4d\x1b\x57\x8d\xdd\x37\xe5\x64\x17\x3d\x94\x65\x59\xad\x28\x5f\x8f\x32\xe7\x96\xd1\xef\x82\xed\x47\xf7\xb6\x57\x4c\x19\xd0\xb6\x40\x5d\x7e\x55\xdf\xa7\xab\xff\xc4\xce\xd2\xbd\x4e\x53\xef\xd7\x58\xe3\xbc\xbc\x20\x5e\x0a\xea\xa3\xe6\xe1\xc6\xab\x13\xd9\xb1\x46\xa6\x5e\x2b\x4c\x2a\x63\x68\x3f\xbf\xe6\xca\xad\x89\xdf\xe7\x71\x63\xc5\x23\x94\x3c\xa6\x55\xba\x6c\x7b\xf7\x47\x6d\xf7\xbe\xb7\xa4\xb0\x4d\x20\xe7\x46\xd6\xb6\x51\xc5\x35\xb6\x16\xee\x64\x53\x7a\x96\x3c\x7b\x19\x76\xbb\xa0\xe9\x58\x6a\x90\xaa\x6d\x17\xcd\xa0\x3f\xda\x6b\x7e\x0c\x9b\x16\x6c\x6f\x75\x66\x6b\xc0\x87\x83\xb5\xd6\x83\x75\x1b\xd5\xea\x08\x63\x1e\x9f\xbe\x79\xf9\xe6\x7b\xb9\x4e\xc8\xc6\xed\x3c\x23\x1b\x71\xec\xac\xb3\x14\xea\x28\x75\x4d\x2e\x01\xb2\xd5\x84\x34\x32\xac\xc3\x5e\xd6\xfb\x8e\xfe\x62\x45\xe3\x2f\x1e\x28\x6f\xe5\xbb\x5f\x95\xdf\xd9\xf1\xa9\x68\x4a\xa6\x71\x21\x13\xaf\x95\xc3\x38\xfa\x5f\xe5\x8a\x36\x93\x92\x2c\xd5\x00\xb7\x50\x10\xb1\xf4\x32\x17\x9f\xb2\xfc\xb2\x43\x9f\x58\x1f\x0c\xeb\x76\x69\xbc\xd8\xc6\x1d\x3f\xce\xc9\x1b\x80\xe7\x00\x89\x04\x2e\xed\x99\x9b\x49\x09\xca\xaf\xf7\xec\xd7\xff\x48\x40\x15\xec\x62\xae\xe6\x38\x95\x9e\xa8\x43\x92\xe1\x91\xe5\xc9\xc1\x96\x6c\xfb\x91\x05\x33\x68\x17\x6e\xe9\xba\x7d\x3e\x34\xa0\xa3\x4f\xee\x7a\xf8\x8f\x20\x78\x79\x3b\xb5\xa9\xb8\xd2\x9f\xbf\xfe\xfa\xcf\x09\xd5\x65\x48\xbe\x39\xf8\xe6\x20\x61\x24\xc9\xe1\xeb\x14\x8d\xbd\xab\xf5\x76\x23\x20\xda\x83\x41\xd9\x41\xc8\xbb\x94\x03\x89\xac\xd5\x39\x64\x9e\x81\xd3\x4e\xd0\xaa\x14\x35\x5c\x42\x24\x81\x50\xdd\xa4\x9b\x80\x1e\x0e\xd1\xbe\xf0\x2c\xae\xec\xd6\x29\x32\xb2\x1f\x1a\xc7\x69\xd8\x98\x5c\x6a\xd7\xe9\xd0\x98\x3f\x7d\xdc\xab\xd6\xb2\x01\x6c\xca\x22\x26\xc8\x55\xb0\xfd\xe2\xa0\x66\xf3\xf1\xe1\xa2\x5d\x1d\xa4\x35\x88\xf4\x80\xb5\xe9\x90\xdc\xd7\xb3\x07\x7a\x49\xee\x1c\x08\xbc\x3c\x7d\x3b\xb2\x6d\x76\xac\x82\x7e\x08\xa0\xbb\x08\x77\x4d\x18\xe0\x38\x2b\x52\x01\xf9\x35\xc5\xce\x07\xaf\x2e\xe4\x9b\x83\x6b\x70\x6d\xb9\x78\x7d\xde\xb5\xad\x51\x61\x6b\xea\xdd\x4d\x8f\x9b\x21\xe0\xa1\xba\x65\xfd\xba\xd7\x84\xad\x46\x89\x95\x5f\xd6\x2c\x81\xe0\x5b\x6b\x55\xd8\x7a\xb9\xf7\x48\x8b\x60\xfa\xf7\x80\x1b\x2a\xe0\x2b\xb3\xbb\xe0\xb6\xf7\xca\xe8\xde\x09\xed\x0b\x5a\x6c\x2d\x1b\x45\xa2\xfe\xc2\x8c\x5a\x72\xb1\xa7\x88\x60\x90\x89\xb5\xe2\xc2\x2d\x69\x3b\xe3\xf6\xae\x71\x5a\xe7\x1a\x5e\x28\xb7\x3e\xd7\xe9\x78\x9d\x2e\xdb\x95\x11\x37\x48\x2d\x2d\xb5\xe8\xd1\xaa\x90\x0e\x38\x14\x82\x82\x0d\xe3\x13\x6f\xcc\x2b\x03\x12\xb1\x0b\xa5\xb3\xa5\x3e\xb0\xd6\x95\x01\x91\x99\x54\x00\x3f\xa6\x45\x52\x4d\xa3\x4b\x53\xa0\x1c\x40\x42\xac\x75\xc3\x7a\x20\xf5\x2b\x67\x61\x16\xfb\x26\x1c\xb3\x64\x26\x17\x92\x27\xaf\xaf\xf2\xdc\x95\x95\xbc\x37\x0b\x18\x66\x69\x49\x6d\x47\x16\x88\x6a\x4e\x4d\xc0\xe9\xa5\x6d\x91\x5e\x5d\x54\xf7\xd3\x06\x41\x78\x91\xb8\x14\x5d\x8a\x4d\xc4\xaf\xdb\x86\x2c\xd6\x21\x39\x32\xa2\xb0\x6d\xcb\xac\x52\xc9\x72\xb4\x3f\x55\xdb\x26\x88\x5e\x73\x2e\xd7\x81\xed\x1a\x32\xd1\xd7\xd7\xe5\xea\xe1\x75\x20\x5a\xb7\x0a\xfd\x51\xbd\x08\x6f\x42\x07\x91\x2d\xe2\xae\xf7\xb1\x67\x21\x55\x73\x20\xc7\x34\xa2\x9b\xce\x28\x5c\x9e\x99\x81\xc0\xa5\x85\x0d\xe9\xf8\xb7\x46\x09\xd5\x7a\x05\x76\x06\x93\x84\x48\x74\x31\xd4\x35\x47\xde\xa0\x3c\xd9\xc6\x63\x26\xbe\xe2\x65\x45\xe1\xca\x54\xc9\x16\xe6\xf5\x16\x3b\x2b\x0d\x13\x1f\x99\x17\x7a\xa0\xc0\x45\x51\x44\xcb\x82\x23\xfa\xd7\x22\x58\xab\x28\xe7\x02\x92\x45\x7b\x21\xdc\xfd\x98\x16\xd9\x55\x29\x1c\xe7\xdb\x55\x96\xcf\xd2\x79\x02\x63\x4d\xf2\xac\x9e\xe3\x99\x07\x68\x2e\x29\x2e\xa2\x09\xf7\x99\xe1\x25\x4e\x1b\x64\x8b\x11\xb9\xa0\x21\x72\xe6\x45\xda\xad\xc4\x39\x44\xa6\x1f\x9f\xa2\x74\xc1\x21\x3d\xb1\xde\xb3\x30\x55\x60\x90\xb4\xa8\x50\x9a\xbe\xd8\xb6\xfd\xb6\x3a\x9a\x74\x07\x06\x1e\xd6\x6e\xe6\x36\x2b\xa7\x57\xa6\xe2\xad\xe5\x6c\x07\xaa\x84\xd4\xf7\xcc\xc5\x65\xf2\x69\x37\xc4\x20\x94\xec\x22\xfa\xfa\x47\x96\xc4\x60\x29\x93\x24\x87\x0a\x8b\x04\x21\x11\x7a\x4c\xd5\x66\xb8\x05\x56\x10\xee\xd7\xeb\x5a\xd2\xf5\xed\x86\xdb\xba\x80\xcb\x6e\x58\xc0\x07\x95\xec\x6c\x2f\xab\xee\xae\x8b\x69\x43\xa2\xe2\x2d\xa3\xdf\x48\xb7\x7c\x9e\x68\x81\x35\xe5\x30\xe4\x6d\xa2\x45\x61\x6c\x23\xd5\x26\xde\xca\x5c\x2f\x71\x86\x61\xb6\xa2\x7b\x44\xcb\x53\x48\x2c\xe5\x07\x96\xd8\x68\x79\x3f\xac\xf1\xa9\x73\x7c\xec\x95\x80\xd6\x2a\xb6\x8f\x0e\x3d\x28\xee\x8e\xfb\x9d\x2f\xbd\x7b\xbc\xdf\xd4\x7a\xdd\xee\xb0\xf0\x8f\xe3\xa3\xb0\x98\xd8\x0e\xc1\xc3\x67\xe5\x62\x99\xe5\xdd\x5c\x1b\x2e\xe3\x1c\x69\x92\xec\x7b\x33\x5d\x35\xdc\x61\x9b\xeb\x40\x51\xf7\x41\xd8\x95\x5a\x43\xe4\xa8\x2d\x6a\x8e\x35\x34\xa5\x00\xa2\xd5\x4b\xb0\xae\xe1\xa2\x9c\x99\x31\x6b\xc7\xb6\x4e\x44\x96\x8b\xf4\xa8\x96\xa2\xef\xab\x34\xcd\xb1\xe4\x29\x60\x00\xab\xd5\x57\x26\x1f\x89\x71\xc7\xb9\x25\x25\x20\x99\x4e\x95\x6f\x1e\x25\xea\x47\x4b\x3f\x65\x1c\x53\x7a\x13\x27\xab\x66\xd2\x6e\xd2\x89\xba\x01\x64\x34\xd0\x91\x37\xa6\x2a\x68\x7e\xa5\x48\x4c\x30\x34\x58\x9f\xff\x8b\x03\x74\x48\xaf\xa8\x3f\x84\xc4\x05\x26\xf4\x9a\x6a\x81\x18\xb5\x24\x8a\x5b\xcc\x88\x90\x7b\x90\x8a\x0f\xd9\xaf\xbc\x1e\x72\x7a\xe5\xd0\x30\x98\x25\xd1\x09\x47\x47\x7d\x63\x55\xc0\xd2\x9b\xb1\xd5\x7f\xed\x3e\x11\x8f\x51\x3f\x1d\x1d\xc0\x92\x7a\xc2\x27\x70\x8a\xd6\x78\xd0\xe4\x34\xe9\xbf\xf1\x02\x2d\x87\x31\xbd\x07\xc0\xae\x0a\xda\x33\x80\x20\xc1\x8b\x54\xbe\x77\x32\xf0\x06\xe8\xa4\x68\xb8\xb7\xa3\x8e\x44\xa8\x03\x75\xaa\x0d\xd3\xba\x85\x4a\x7d\xdb\xab\xbc\x8c\xe4\xb1\xad\xfa\x78\x22\x05\x91\x11\xbb\xef\x16\xef\x05\xa5\xa0\x52\x81\x7a\x8c\x79\x1a\x02\x16\x48\x14\x85\x76\xfc\x22\xa8\xa9\x20\xac\x3c\x2d\x25\x2d\x7b\x71\xff\xee\x7a\x21\x43\x04\x8d\x84\x97\xe9\xf4\x0a\xd0\x11\xe3\x09\xda\x41\xfb\x54\x0e\x22\xaf\x33\xfb\xeb\x4b\x5e\xd5\x04\x49\x3c\x46\xf1\xbb\xb4\x62\x61\x01\x79\x1a\x7d\xe2\xdd\xf6\x7e\xa5\x81\x82\xa3\x87\x2b\xbb\x32\x66\x29\xd1\xbb\x7e\x1e\x0f\x9d\x60\x6d\xba\x07\x7a\xef\x9a\x0a\xb5\x76\x1d\xd0\xb4\xe3\x14\xc3\x2e\x6c\xc0\x01\xc0\x13\xca\x32\x68\x8a\xc0\x73\x8d\x05\x80\x5a\xa1\xae\xca\x37\x24\x85\x19\x06\x19\x47\x36\x04\x20\xc0\x87\xb3\x8d\x8e\x64\xa4\x1e\x02\xb0\x3b\xe9\xd1\x49\x3f\x56\xb2\x0d\x7e\xca\xe4\x0c\xd3\xfe\xab\xd5\xa2\x23\x80\xae\xdd\x85\x43\x59\x79\x03\xae\x9b\xad\x77\x0a\x35\xea\xea\xcf\x25\x09\xe3\x7f\x7c\x1b\xba\xf3\xcb\x48\xf6\x61\x9f\x92\xf8\x0f\xd0\xda\x7b\x50\x2f\x6f\x42\x41\x10\x79\x96\xd7\x71\x83\xb9\x8d\xc5\xd0\xfa\x44\xb8\x11\xe7\xaf\xce\x22\xef\x2d\x7a\x63\x04\xbc\xe7\x0a\xa8\xc1\xcc\x88\xeb\x51\x33\x03\x69\xa7\xce\x87\xae\x32\x40\xc0\xd5\x7a\xd9\x24\x61\x15\x33\xb7\x41\xdd\x3a\x66\x9e\x88\xb8\xa9\xee\x1b\x2c\xc0\x2b\x46\xbf\xc3\x02\xda\xad\x59\x28\x61\xe8\x23\x43\x36\x2c\x37\xad\x0f\x22\xed\x51\x71\x1f\x50\x49\xc3\xa7\xbb\xa1\x4c\xbb\x97\xc1\xcd\xf5\xf7\xc0\xa0\x37\xc7\x6e\xbd\x3e\x7c\x25\x89\x79\xf8\xda\x25\x6d\x29\x7c\x2d\xac\xab\x1d\x18\xeb\xc9\xd0\xeb\xfb\x00\x02\x35\x84\x1a\xa5\xe4\xad\x4f\x9d\x2f\xca\x06\x95\xf4\x21\xa1\x4b\x06\xf7\x0f\x3c\x3e\xd4\xbf\x00\xec\x56\x32\x6c\x01\x01\xd5\x6d\xa5\x9a\x7b\x5c\x4f\x3f\x85\x75\x97\x26\xbd\xba\xb6\xaf\xec\x36\x72\x6d\x2d\xd2\x2b\x86\x75\xb7\x63\xe2\x57\xd3\x0a\xda\xa3\x99\x30\x5f\x46\x01\xb0\xb9\xb2\x69\xf0\xac\x7c\x7b\x91\x15\xe4\x42\xb0\x63\x8e\x23\xce\x48\x66\xdb\xa5\x65\xa9\x01\x33\xa6\x38\x18\x14\x35\x5c\x29\x59\xdb\xce\xd4\x4f\x4c\xa7\xe6\xd9\x74\x23\x54\x5c\x97\x1b\xae\x55\x3c\x98\x73\x03\xb8\x9c\x47\xd4\xf3\xca\x86\x91\x73\xc7\x53\x6d\x36\x48\x86\xd5\x0b\x9d\xca\xe4\x33\x2b\x1d\xa8\xfd\x70\xe4\xee\x9b\x2a\x5a\xa4\x6b\x1b\x57\xe3\x8a\x60\x06\x88\x42\xaa\xd0\x76\xee\x78\x73\x11\xa9\x5c\xa7\x79\x36\xd3\xda\x46\xb0\x60\x02\x64\x8e\xde\x3d\x4d\xda\xa0\xc7\x1e\xa9\x2d\xdc\xf6\xbb\xc7\x5e\x62\x7b\x9a\x35\x28\x51\xc2\xc0\x64\x2a\x90\x68\xaa\xd5\x94\x22\x84\xd4\xb2\x3c\x0b\x7b\x99\xb4\x2b\x20\x70\xfb\xba\x8f\xcd\xd5\xb2\x82\xf1\x19\xe3\x6d\xe9\x5f\xc0\x31\xb5\x81\x5d\xef\x7a\xdf\xcf\xcb\x1b\xce\x1d\x81\x69\x49\xa2\xd3\x09\x50\xd4\xb8\x80\xb5\xe9\xe9\xa1\xa2\x3b\x54\x8a\x83\xe5\x12\xbe\x9a\x4f\x8d\xd6\xc6\x94\xc7\x3f\x7c\xbd\x2d\x0b\x91\x93\xae\xee\xd1\xe6\x20\xe6\xf4\x13\xa7\x7d\x0c\x90\x15\x3b\x61\x2e\x9e\xf2\x72\x43\xf5\xed\xa5\x34\x2b\x67\x9f\xa4\x1c\xc5\x27\x73\x59\xcf\x21\xe7\x83\xdb\x84\xb7\xf1\x55\x7a\x71\x95\x8e\xb9\xce\x5a\xed\x45\x24\xc0\x4b\x94\xc1\x0d\xeb\xa6\x01\xaf\xcc\xb2\x89\x3c\x67\x65\x50\x54\x02\xce\x92\x64\x30\xbb\x26\x50\xdd\x0c\xe6\x5f\x8e\x38\x3c\xff\xd7\x44\x1e\x46\xe6\x1a\xf6\x31\xa5\xe4\x21\x74\xd0\xf0\x6b\xa9\x67\xd0\xc2\x11\x66\x12\x89\x8c\x6f\xe0\xcb\x56\x27\x60\x85\x8e\x4c\x67\x38\x03\xfe\xc3\xcd\x32\x46\x5c\x6b\xc3\x43\xd5\x05\xeb\x36\x1c\x18\xc8\xfd\x4b\x7a\x13\xee\x18\x0e\x29\x8f\x25\x07\x7e\x48\x6b\x52\x7f\x17\xd8\xc0\x4f\x6a\xa9\x76\xc3\x72\xbe\xa6\xcf\xc0\xe0\xbb\xbb\xa9\x54\xa8\x4d\xcb\x89\x68\xfd\x64\x8b\x7c\x2f\xae\x14\xee\x47\x22\xbe\xd8\x23\x35\x4a\xf8\xed\xfb\xe1\xa8\x9f\x6e\x93\xe0\xfc\xae\xf0\xf6\x8c\x25\x72\xf2\x7e\x8f\x2f\x4d\x85\x7b\x49\x11\xb5\xbd\x71\xe1\x0a\x90\x8d\xbb\xed\x39\x39\x68\x1d\x95\xc4\xd8\x76\xf9\x00\x2a\x9f\xba\xf6\xdd\x13\xb6\xc1\x2d\x96\xc1\xb6\x30\x9c\x89\xb3\x11\x9d\x4c\x17\xd8\x9c\x9e\x68\xb4\x2e\x31\x27\x3e\x5f\xd5\x5c\x16\xb0\xdd\xa3\x8c\x2b\xdb\x70\xd1\x7b\x82\x14\x67\x53\xec\x50\x93\x15\x3a\x55\x5c\xce\xb8\xbd\x0e\xba\x47\x95\xcd\xbc\xb3\x95\xf4\x64\x8e\xcf\xd4\x48\x0a\x67\x3f\x4e\xeb\xb8\x80\x9b\x0d\x03\xe1\x6f\x05\xe5\x94\x2d\x95\xbd\x3b\x6a\x77\x93\xcf\xc1\xaa\x60\x5e\xa6\x63\x53\x37\xb4\x9e\xb9\x5b\xfd\xd4\xb6\x94\x03\xff\xe9\xe5\x73\x8b\x04\x1c\x9e\x43\xbc\x1a\x10\xb0\x28\x68\x64\x03\xa1\x39\xb0\x82\xd2\x86\x75\x7c\x09\xd2\xcf\x72\xd8\xcc\x68\x54\xc1\xbc\x67\xaa\x3d\x48\xef\x49\xbc\x88\x2d\xdd\xa2\x15\x00\x82\x26\x80\x3d\xe0\xb4\xb8\x0d\x12\x60\x2c\x04\x38\x5c\x56\xf7\xc9\x76\xc3\xb2\x9d\x69\xed\x94\xd9\xbb\xb6\x49\x27\x81\xe2\xa7\x82\x4e\x6d\x61\x66\xad\x6e\xe5\xe9\x8c\x3a\x6f\xd2\x86\xc5\xc4\x33\xa8\x85\xec\xed\xad\x55\xa5\xe0\x91\x94\xd2\x72\x6f\xf6\x81\xe7\xe5\x73\xd4\x6e\x4e\x9f\xa7\x0d\xee\xf4\x13\xb2\xb2\x5b\xd8\x97\xdf\xf2\xe7\x96\x48\x5a\x7d\xd8\x85\x24\xca\x55\xe7\xc4\x15\xed\x08\x8a\x47\x9d\x59\x86\x04\x30\x70\x12\xe3\x23\xea\xd3\xee\xca\x20\xec\xa9\xdd\x9e\xbc\xe7\x4e\x12\xde\xe8\x29\xcf\xba\x88\xf3\x9a\x1c\xa4\xed\x62\x2a\x2e\x8b\x89\x97\xd6\xee\xe2\x33\xfe\xec\x0b\x4c\xdd\x1a\x2a\x4e\x05\x9d\x28\x46\x5c\xb7\x0f\xbd\xfa\x9a\x78\x29\xe1\x41\x81\x8b\x08\x5e\x88\x5b\x21\x95\x5b\x6b\x47\x5a\x1a\xa2\x11\xd5\x78\x07\x54\xfc\x06\x46\x3a\x91\xf8\x4a\x90\xc2\x50\x57\x99\x8d\x6c\xb9\x75\x29\x10\xe3\xc2\x6a\x38\x42\xc9\xef\x95\xd6\x32\xb0\x6f\x8d\x9f\xf3\x8c\xe9\x02\x90\x4b\x39\x7f\xc6\x97\xdf\xcb\x13\x54\x22\x14\x2a\x3e\xf4\xaf\x40\xea\xfb\x36\xcd\xb1\x92\x5b\xd5\x17\xf9\xa7\x8b\xcb\xea\xbe\x95\x59\x47\x49\x62\xb1\xc6\x61\x5d\x1c\x2c\xd5\x8b\xd6\x98\x53\xe2\x86\x04\xac\xe2\x3b\x92\x4e\x65\x13\xf6\xda\xe4\xcf\x71\x9a\x04\x8b\x0d\xc2\xda\x0e\x34\xae\xdb\x5f\xf6\xd8\x8b\x1d\xf4\x9a\xf8\x8a\xc4\xe0\x01\x51\x61\xda\xd6\xc8\x3f\x8e\x5f\x1c\xc0\x7f\xe2\x2f\x9e\x7c\xfd\xd5\xd7\xe3\xa8\xd3\x54\x0d\x1d\xf8\x94\x65\x23\x42\x81\x73\xf4\x52\xbd\x63\xfd\xc9\x4e\xd0\xaa\x61\xd0\x7b\x5b\x68\xa8\xda\xb1\x97\x19\xa8\x5d\x1b\x02\x03\x34\x90\x52\x1e\xb6\xfb\xbb\x3d\xbb\x43\x5f\x72\x14\x44\x4d\x91\x35\x8c\x5a\x31\xf2\xf2\x24\x94\xf2\x15\xdd\xcf\xdf\x9c\xb1\x6e\x8f\x0c\x32\xbf\x36\xb6\x92\xd5\xcb\x13\x34\x99\xf4\x85\x6d\x63\xd5\xa2\xf6\xac\x81\x77\xdc\x27\x5d\x92\x0e\xad\x33\x64\xc8\xce\xb6\xe2\x95\x77\x97\xe1\x79\x5b\x5a\x06\x79\x8b\x1d\x6a\xc4\x82\x87\xac\xa7\x44\x15\xbe\xf9\xcb\x11\x27\x89\x9f\xd0\xdf\xda\x77\xf6\xd7\x5f\x93\x91\x88\xfd\x1c\x13\x7c\x44\x51\xd7\x74\x1c\x2f\xab\xe5\xf4\xe8\xcf\x07\x7f\x3e\x38\xa2\xbf\xce\x9f\x9d\x88\xbb\x4b\xba\x24\x11\x1d\xea\x5d\xe3\x25\x97\xfa\x95\xae\x52\xef\x2e\xa5\x83\x41\xf1\x0f\xad\xe2\x62\x9c\x11\x19\xb4\xc3\xa5\x1a\xae\xcc\x30\x70\xde\xa0\x5a\xd5\x4f\xcf\x4f\x18\xc0\xb3\x67\xe7\x27\x14\xfa\x29\xa0\xf4\x54\x5e\xe9\x64\xec\x69\x0c\x29\xb3\x07\xf2\x3a\xfa\x5f\x85\xf1\x1a\x70\x0f\x65\x75\x58\x01\x0f\x37\xc3\x72\x9a\x94\x27\x76\x75\x5e\xf4\xe6\x64\x45\x9b\xe3\xc7\x3d\xb1\x21\x83\xef\xd2\xea\x3e\x35\x20\x9e\xa1\xdf\x6c\x41\x02\xaf\xb3\xb6\x78\xd2\x30\x15\xd7\x41\xe8\x6e\xad\x08\x95\x52\xfd\x58\xae\xde\xab\x99\xc4\x55\xf9\x5e\xe2\x01\x65\x81\xc1\xd0\x18\x2d\xe6\x23\xb0\x23\x06\x3a\xd3\x41\xbf\x08\x86\x8d\x6f\x26\x58\xa7\xc3\xed\xaf\x17\x2d\x46\x71\x99\x3a\xfe\xb3\xaa\x2c\x7e\x28\x27\x52\xac\xc3\x17\x6e\x54\x77\x02\xc5\x8d\x4c\x9a\x70\x05\x72\xd1\x7b\x98\xf6\x5d\x39\x91\x1a\x50\xd2\x1a\x03\xd3\xc4\x42\xa9\xc7\x06\x05\x6e\x58\xa1\x07\xda\x27\xde\x4a\x44\xa0\x0e\x99\xcf\xd5\x37\x14\xef\x93\x2e\x33\xca\xf9\xd9\xbf\x3e\x1c\x3f\xd3\x47\x37\x55\x8e\xe8\x22\x42\x2a\x55\x6e\x50\x2b\xd8\xb4\xe4\xf5\x03\xc5\x5b\x8e\x2c\xc8\x29\x41\x37\xf2\xf2\xfd\xb9\x6d\x67\x9e\xc3\x1c\xdb\x9b\x8a\xcb\x9b\x94\x4c\x26\x6e\x72\xed\x92\x6e\x6d\x4f\x24\x87\xa1\x2a\x01\x3b\x75\x89\xf6\xb6\xe2\x7a\xc4\xbc\x14\xfe\x6e\xa6\xee\x78\x7e\xa1\x4d\xc4\xee\xeb\x74\xf2\x04\xfd\x87\x33\x14\x1c\xf5\x16\xf4\x6b\x8e\x48\xd5\x97\xf2\xc6\x8e\x53\xda\x02\xe1\x5c\x6a\xc3\x1a\xa4\x6d\x9d\x57\x49\x55\xa7\x38\x54\x1b\x9e\x83\x56\x57\xac\x73\xc6\x65\xad\xb8\xcf\x67\x07\xbc\xec\xf3\x33\x15\xdc\x6b\x11\xd8\x7a\x3a\x37\x83\x83\x2c\xf9\x61\x8d\x35\x64\x8b\x71\x93\x4e\x9b\xa0\x5c\x54\xd8\xd0\x3d\xe8\x4b\xbc\x43\x6a\x50\xab\x3a\x24\xee\x2b\xda\x45\x39\x8e\x22\xc8\xe1\xd8\x0f\xa7\xd8\x25\x41\xde\x8d\x5f\x77\xa5\x59\x37\xc3\x37\x07\xad\x56\xcf\x76\xac\xf8\xee\x2b\xc2\x13\x15\x6b\x59\x3e\xd7\xaa\x62\xd3\x22\xa5\xe0\xe0\x98\xe2\x15\x5d\x4f\xae\xa6\xcc\x8d\xed\xa0\x74\x5f\xe7\xfb\xdc\x4e\xe2\x47\xe4\xbb\xa9\x41\xa6\xb9\xee\xb9\xea\x80\x3b\x3e\xaa\xf7\x02\x31\x76\xed\x12\x5d\x61\x7d\x2b\xee\x00\x01\x74\x84\xe2\x39\x57\xc8\xe7\xba\x12\xdc\x25\x93\x8a\xfe\x82\x24\xe8\x92\x38\x3b\x71\x9c\xf5\x3e\xf6\xf4\x33\xcb\xa6\xde\x97\x21\xb1\x7f\xa7\x96\x0d\xd9\xa7\x31\x62\x60\x17\xb1\x83\x76\xdf\x35\x82\x03\x3d\x16\x98\x07\x96\xdb\xf7\xd6\xa2\xb7\x6f\x10\xcf\xd3\x36\x68\x0a\x6f\xc6\x0a\x06\x57\x5c\xf1\x5f\xa2\x67\x16\x9f\xa9\x3d\x92\xb1\xbd\xb3\xec\xce\xaf\xd1\xdd\xc8\x28\x34\xad\xe6\x36\x3f\x9a\xf5\x2f\x4f\x7f\x46\x17\xc5\xaf\x47\x2f\x2e\x2e\xcc\x14\x84\xf4\x33\xee\x24\x88\xf5\x64\xa5\x1c\xa7\x99\x91\x97\x71\xf6\x94\x6b\x36\xbf\x29\xcf\x84\x3e\x58\x0c\x4e\x5e\xfc\xbe\x4a\xf3\xc4\x55\x95\xd6\xe4\x07\xee\xaa\x27\x75\x81\xb5\xe1\x35\x09\xbf\x2f\xde\x03\x84\x98\x6f\x87\x06\xa2\x9b\xac\x0e\x83\x7b\xdc\x76\xef\xbe\x62\xf7\x6e\xaf\x72\x82\x0e\x1b\x8e\x3a\x8c\x35\x02\x6e\x66\x5f\x4e\xc8\x96\x2d\x7d\x7e\x80\x23\x64\xd8\x0c\xc8\x8a\x02\x6c\xe8\x4e\x28\x2a\x01\x83\x06\x79\xb1\xf8\xb7\x36\x06\x4a\x0c\xa1\x50\x83\x10\x2d\x28\x82\x51\xd5\x79\x60\x84\xa7\x78\xa4\xc6\xe1\x79\x59\x15\xd4\x14\x96\x62\x69\x75\xf4\xa7\x8c\xa8\x11\x0f\xfc\xf4\x4d\xf9\x82\x82\x29\xcd\xa8\x33\xf8\x53\x50\xc4\x79\x3b\xfc\x6d\x50\x6d\x46\x76\xc8\xea\x33\xa4\xc8\xc8\x26\x8c\x6c\x21\x4b\x9e\xc5\xbe\xe4\xed\x33\xac\xed\x84\x02\x1f\xbc\xef\x70\x08\x0b\x10\x67\xcc\x4a\xe8\x7e\x29\xe5\x67\xb0\x4a\x17\x8f\x29\x3d\x33\x7a\x70\x22\x7e\x78\x12\x9d\xd0\x0b\x21\x59\xd1\x2e\x52\xd3\x4d\x21\x63\x39\xd1\x49\xea\x90\xde\x27\x6f\x95\x4a\xa7\x03\x84\x27\x0d\x22\xd4\xe2\xa8\x9e\x5b\xd9\xaf\x5c\x2a\xbf\x7a\xe5\x0c\x7a\x4b\x98\xa2\x02\x28\xd5\x00\xfb\xbb\x30\xda\xba\x8f\x38\x9a\x4d\x10\xed\x44\x43\x5b\x83\x6a\xf4\x48\x38\x26\xf6\x46\xfd\x21\x35\x97\xa6\x7a\xfc\x78\x6f\xdc\xb3\xca\xff\x2f\x83\x61\xcd\x67\x2e\xbf\x4f\x7d\x8c\xfb\x1b\xe3\xf4\xe1\xff\x5e\xca\x7b\x62\xc9\x5a\x91\x39\x6a\x3b\x23\xd6\x43\xb6\xc7\x79\x63\xbd\xf4\xbd\xbb\x57\x43\x15\x6b\x8b\xa5\x2c\xad\x8c\xea\xd1\xb0\x15\x29\xfb\x29\x34\x20\x9f\xbd\x9e\xc2\x9a\x3b\xd8\x76\xb5\xda\x28\x59\xc4\xac\xdc\xf5\x00\x5b\x82\x35\x0f\xfa\xc6\x46\xd6\xbe\xd8\x71\x70\xdb\x22\x85\x5e\xf6\xa6\x39\x7c\xe0\x89\x74\x36\xb4\xfc\x5e\xd9\x8e\x4e\xb2\x81\xf3\x80\xc2\xab\x09\xb0\xc7\x9d\x40\x20\xa6\x4c\x3b\x42\x8f\xc5\xf8\x07\xcc\xa5\xb0\xae\x65\x64\xd3\x68\x43\x3e\xf3\xaa\x3f\x71\x08\x49\x30\x32\x89\x53\xd6\x94\xeb\x92\xe9\x9e\x1d\x8b\x96\x0d\xa0\xb8\xac\xe3\xd0\x1e\x68\x53\x7c\x8f\xd8\xd2\xe5\x6a\xbc\xf3\x17\x5a\xba\x5e\xeb\x3a\xc2\x15\x59\x6f\x29\x59\x6f\x5b\x09\x9e\xbc\x78\x0d\x40\xa3\x83\x23\x8c\x87\xa2\x12\x92\x61\x58\x06\xe8\xe9\xcc\xfe\x5a\xc1\x83\x36\x32\x7d\x5a\x2e\xed\xc1\xd6\xad\xc7\x1c\x05\x87\xca\x91\x8d\x14\xa1\x72\xee\x7e\x3a\x69\x3d\x0c\xeb\x9f\xb6\x9d\x86\x03\x07\x77\x17\xba\x6c\x10\x0b\x35\x72\xd4\xa0\x0f\x2f\x21\xdb\xdf\xa6\x16\xc1\xda\x48\x24\x4b\x21\x58\xf8\x9d\x6b\xbd\x0b\x85\xc0\x17\x24\x27\xe2\xd7\xe3\x7f\xfa\xbf\x5e\x8f\xc4\x96\xfc\x26\x01\x00"),
 		},
 		"/user-cluster-role.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "user-cluster-role.yaml",
@@ -380,6 +394,8 @@ var assets = func() http.FileSystem {
 		fs["/crd-integration-kit.yaml"].(os.FileInfo),
 		fs["/crd-integration-platform.yaml"].(os.FileInfo),
 		fs["/crd-integration.yaml"].(os.FileInfo),
+		fs["/operator-cluster-role-binding-priorityclasses.yaml"].(os.FileInfo),
+		fs["/operator-cluster-role-priorityclasses.yaml"].(os.FileInfo),
 		fs["/operator-deployment.yaml"].(os.FileInfo),
 		fs["/operator-role-binding-events.yaml"].(os.FileInfo),
 		fs["/operator-role-binding-knative.yaml"].(os.FileInfo),
@@ -12,6 +12,7 @@ require (
 	github.com/fatih/structs v1.1.0
 	github.com/gertd/go-pluralize v0.1.1
 	github.com/go-logr/logr v0.1.0
+	github.com/google/go-containerregistry v0.0.0-20200220215334-221517453cf9
 	github.com/google/uuid v1.1.1
 	github.com/hashicorp/go-getter v1.4.1
 	github.com/jpillora/backoff v1.0.0
@@ -24,6 +25,7 @@ require (
 	github.com/operator-framework/operator-lifecycle-manager v0.0.0-20200321030439-57b580e57e88
 	github.com/operator-framework/operator-sdk v0.17.1
 	github.com/pkg/errors v0.9.1
+	github.com/prometheus/client_golang v1.5.1
 	github.com/radovskyb/watcher v1.0.6
 	github.com/rs/xid v1.2.1
 	github.com/scylladb/go-set v1.0.2
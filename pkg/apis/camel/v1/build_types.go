@@ -30,6 +30,27 @@ type BuildSpec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
 	// Important: Run "operator-sdk generate k8s" to regenerate code after modifying this file
 	Tasks []Task `json:"tasks,omitempty"`
+	// The strategy used to run the build, it overrides the build strategy of the integration platform
+	Strategy IntegrationPlatformBuildStrategy `json:"strategy,omitempty"`
+	// The strategy used to order the build with respect to the other builds of the namespace
+	OrderStrategy BuildOrderStrategy `json:"orderStrategy,omitempty"`
+}
+
+// BuildOrderStrategy enumerates all implemented build order strategies
+type BuildOrderStrategy string
+
+const (
+	// BuildOrderStrategySequential runs the build once no other build is running
+	BuildOrderStrategySequential BuildOrderStrategy = "sequential"
+	// BuildOrderStrategyFIFO runs the build once the builds created before it have been scheduled,
+	// possibly concurrently with them
+	BuildOrderStrategyFIFO BuildOrderStrategy = "fifo"
+)
+
+// BuildOrderStrategies --
+var BuildOrderStrategies = []BuildOrderStrategy{
+	BuildOrderStrategySequential,
+	BuildOrderStrategyFIFO,
 }
 
 // Task --
@@ -55,6 +76,8 @@ type ContainerTask struct {
 	Env             []corev1.EnvVar         `json:"env,omitempty"`
 	WorkingDir      string                  `json:"workingDir,omitempty"`
 	SecurityContext *corev1.SecurityContext `json:"securityContext,omitempty"`
+	// The compute resources required by the container
+	ResourceRequirements corev1.ResourceRequirements `json:"resourceRequirements,omitempty"`
 }
 
 // ImageTask --
@@ -75,11 +98,23 @@ type BuilderTask struct {
 	Sources      []SourceSpec      `json:"sources,omitempty"`
 	Resources    []ResourceSpec    `json:"resources,omitempty"`
 	Dependencies []string          `json:"dependencies,omitempty"`
+	Repositories []string          `json:"repositories,omitempty"`
 	Steps        []string          `json:"steps,omitempty"`
 	Maven        MavenSpec         `json:"maven,omitempty"`
+	MavenMirror  string            `json:"mavenMirror,omitempty"`
 	BuildDir     string            `json:"buildDir,omitempty"`
 	Properties   map[string]string `json:"properties,omitempty"`
 	Timeout      metav1.Duration   `json:"timeout,omitempty"`
+	// The compute resources required by the build container, when the build runs in a pod
+	ResourceRequirements corev1.ResourceRequirements `json:"resourceRequirements,omitempty"`
+	// The system properties passed to the Maven build invocation
+	MavenProperties map[string]string `json:"mavenProperties,omitempty"`
+	// The Dockerfile instructions executed on top of the base image
+	ImageInstructions []string `json:"imageInstructions,omitempty"`
+	// Whether the image registry is accessed insecurely, overriding the integration platform registry configuration
+	RegistryInsecure *bool `json:"registryInsecure,omitempty"`
+	// The secrets used to authenticate with the image registries, merged into a single Docker configuration
+	RegistrySecrets []string `json:"registrySecrets,omitempty"`
 }
 
 // BuildStatus defines the observed state of Build
@@ -97,6 +132,8 @@ type BuildStatus struct {
 	// Change to Duration / ISO 8601 when CRD uses OpenAPI spec v3
 	// https://github.com/OAI/OpenAPI-Specification/issues/845
 	Duration string `json:"duration,omitempty"`
+	// The resource version of the registry Secret at the time the build was initialized
+	RegistrySecretVersion string `json:"registrySecretVersion,omitempty"`
 }
 
 // BuildPhase --
@@ -206,6 +206,14 @@ const (
 	IntegrationConditionProbesAvailable IntegrationConditionType = "ProbesAvailable"
 	// IntegrationConditionReady --
 	IntegrationConditionReady IntegrationConditionType = "Ready"
+	// IntegrationConditionGarbageCollectionDryRun --
+	IntegrationConditionGarbageCollectionDryRun IntegrationConditionType = "GarbageCollectionDryRun"
+	// IntegrationConditionGarbageCollectionFailed --
+	IntegrationConditionGarbageCollectionFailed IntegrationConditionType = "GarbageCollectionFailed"
+	// IntegrationConditionServiceAccountAvailable --
+	IntegrationConditionServiceAccountAvailable IntegrationConditionType = "ServiceAccountAvailable"
+	// IntegrationConditionPriorityClassAvailable --
+	IntegrationConditionPriorityClassAvailable IntegrationConditionType = "PriorityClassAvailable"
 
 	// IntegrationConditionKitAvailableReason --
 	IntegrationConditionKitAvailableReason string = "IntegrationKitAvailable"
@@ -251,6 +259,18 @@ const (
 	IntegrationConditionReplicaSetReadyReason string = "ReplicaSetReady"
 	// IntegrationConditionReplicaSetNotReadyReason --
 	IntegrationConditionReplicaSetNotReadyReason string = "ReplicaSetNotReady"
+	// IntegrationConditionGarbageCollectionDryRunReason --
+	IntegrationConditionGarbageCollectionDryRunReason string = "GarbageCollectionDryRun"
+	// IntegrationConditionGarbageCollectionFailedReason --
+	IntegrationConditionGarbageCollectionFailedReason string = "GarbageCollectionFailed"
+	// IntegrationConditionServiceAccountAvailableReason --
+	IntegrationConditionServiceAccountAvailableReason string = "ServiceAccountAvailable"
+	// IntegrationConditionServiceAccountNotAvailableReason --
+	IntegrationConditionServiceAccountNotAvailableReason string = "ServiceAccountNotAvailable"
+	// IntegrationConditionPriorityClassAvailableReason --
+	IntegrationConditionPriorityClassAvailableReason string = "PriorityClassAvailable"
+	// IntegrationConditionPriorityClassNotAvailableReason --
+	IntegrationConditionPriorityClassNotAvailableReason string = "PriorityClassNotAvailable"
 )
 
 // IntegrationCondition describes the state of a resource at a certain point.
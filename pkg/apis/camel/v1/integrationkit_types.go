@@ -114,6 +114,14 @@ const (
 	IntegrationKitConditionPlatformAvailable IntegrationKitConditionType = "IntegrationPlatformAvailable"
 	// IntegrationKitConditionPlatformAvailableReason --
 	IntegrationKitConditionPlatformAvailableReason string = "IntegrationPlatformAvailable"
+	// IntegrationKitConditionBuildRunning --
+	IntegrationKitConditionBuildRunning IntegrationKitConditionType = "BuildRunning"
+	// IntegrationKitConditionBuildRunningReason --
+	IntegrationKitConditionBuildRunningReason string = "BuildRunning"
+	// IntegrationKitConditionBuildSucceededReason --
+	IntegrationKitConditionBuildSucceededReason string = "BuildSucceeded"
+	// IntegrationKitConditionBuildFailedReason --
+	IntegrationKitConditionBuildFailedReason string = "BuildFailed"
 )
 
 // IntegrationKitCondition describes the state of a resource at a certain point.
@@ -225,6 +225,11 @@ func (in *BuilderTask) DeepCopyInto(out *BuilderTask) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Repositories != nil {
+		in, out := &in.Repositories, &out.Repositories
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Steps != nil {
 		in, out := &in.Steps, &out.Steps
 		*out = make([]string, len(*in))
@@ -239,6 +244,29 @@ func (in *BuilderTask) DeepCopyInto(out *BuilderTask) {
 		}
 	}
 	out.Timeout = in.Timeout
+	in.ResourceRequirements.DeepCopyInto(&out.ResourceRequirements)
+	if in.MavenProperties != nil {
+		in, out := &in.MavenProperties, &out.MavenProperties
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ImageInstructions != nil {
+		in, out := &in.ImageInstructions, &out.ImageInstructions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RegistryInsecure != nil {
+		in, out := &in.RegistryInsecure, &out.RegistryInsecure
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RegistrySecrets != nil {
+		in, out := &in.RegistrySecrets, &out.RegistrySecrets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -555,6 +583,7 @@ func (in *ContainerTask) DeepCopyInto(out *ContainerTask) {
 		*out = new(corev1.SecurityContext)
 		(*in).DeepCopyInto(*out)
 	}
+	in.ResourceRequirements.DeepCopyInto(&out.ResourceRequirements)
 	return
 }
 
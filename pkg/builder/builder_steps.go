@@ -30,6 +30,7 @@ import (
 	"github.com/rs/xid"
 
 	"github.com/apache/camel-k/pkg/util/controller"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/selection"
 
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -38,6 +39,7 @@ import (
 	"github.com/apache/camel-k/pkg/util"
 	"github.com/apache/camel-k/pkg/util/kubernetes"
 	"github.com/apache/camel-k/pkg/util/maven"
+	"github.com/apache/camel-k/pkg/util/registry"
 )
 
 var stepsByID = make(map[string]Step)
@@ -50,9 +52,11 @@ type steps struct {
 	CleanBuildDir           Step
 	GenerateProjectSettings Step
 	InjectDependencies      Step
+	InjectRepositories      Step
 	SanitizeDependencies    Step
 	StandardImageContext    Step
 	IncrementalImageContext Step
+	GenerateRegistryAuth    Step
 }
 
 // Steps --
@@ -69,6 +73,10 @@ var Steps = steps{
 		ProjectGenerationPhase+2,
 		injectDependencies,
 	),
+	InjectRepositories: NewStep(
+		ProjectGenerationPhase+2,
+		injectRepositories,
+	),
 	SanitizeDependencies: NewStep(
 		ProjectGenerationPhase+3,
 		sanitizeDependencies,
@@ -81,6 +89,10 @@ var Steps = steps{
 		ApplicationPackagePhase,
 		incrementalImageContext,
 	),
+	GenerateRegistryAuth: NewStep(
+		ApplicationPackagePhase+1,
+		generateRegistryAuth,
+	),
 }
 
 // DefaultSteps --
@@ -88,6 +100,7 @@ var DefaultSteps = []Step{
 	Steps.CleanBuildDir,
 	Steps.GenerateProjectSettings,
 	Steps.InjectDependencies,
+	Steps.InjectRepositories,
 	Steps.SanitizeDependencies,
 	Steps.IncrementalImageContext,
 }
@@ -135,9 +148,68 @@ func generateProjectSettings(ctx *Context) error {
 		ctx.Maven.SettingsData = []byte(val)
 	}
 
+	if ctx.Build.MavenMirror != "" {
+		secret, err := kubernetes.GetSecret(ctx.C, ctx.Client, ctx.Build.MavenMirror, ctx.Namespace)
+		if err != nil {
+			return fmt.Errorf("unable to retrieve maven mirror secret %s: %v", ctx.Build.MavenMirror, err)
+		}
+
+		mirror, server, err := maven.NewMirrorFromSecret(secret)
+		if err != nil {
+			return err
+		}
+
+		// merge the mirror with the platform settings
+		data, err := maven.MergeMirror(ctx.Maven.SettingsData, mirror, server)
+		if err != nil {
+			return err
+		}
+
+		ctx.Maven.SettingsData = data
+	}
+
 	return nil
 }
 
+// generateRegistryAuth merges the registry secrets into a single Docker configuration, as the image
+// build containers can only read one
+func generateRegistryAuth(ctx *Context) error {
+	if len(ctx.Build.RegistrySecrets) == 0 {
+		return nil
+	}
+
+	configs := make([][]byte, 0, len(ctx.Build.RegistrySecrets))
+	for _, name := range ctx.Build.RegistrySecrets {
+		secret, err := kubernetes.GetSecret(ctx.C, ctx.Client, name, ctx.Namespace)
+		if err != nil {
+			return fmt.Errorf("unable to retrieve registry secret %s: %v", name, err)
+		}
+
+		if data, ok := secret.Data[corev1.DockerConfigJsonKey]; ok {
+			configs = append(configs, data)
+		} else if data, ok := secret.Data["config.json"]; ok {
+			configs = append(configs, data)
+		} else if data, ok := secret.Data[corev1.DockerConfigKey]; ok {
+			// The legacy format only contains the authentication entries
+			configs = append(configs, []byte(`{"auths":`+string(data)+`}`))
+		} else {
+			return fmt.Errorf("unsupported secret type for registry authentication: %s", name)
+		}
+	}
+
+	config, err := registry.MergeDockerConfigs(configs...)
+	if err != nil {
+		return fmt.Errorf("unable to merge registry secrets: %v", err)
+	}
+
+	dir := path.Join(ctx.Path, RegistryAuthDir)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path.Join(dir, "config.json"), config, 0600)
+}
+
 func injectDependencies(ctx *Context) error {
 	// Add dependencies from build
 	for _, d := range ctx.Build.Dependencies {
@@ -186,7 +258,12 @@ func injectDependencies(ctx *Context) error {
 			mid := strings.TrimPrefix(d, "mvn:")
 			gav := strings.Replace(mid, "/", ":", -1)
 
-			ctx.Maven.Project.AddEncodedDependencyGAV(gav)
+			dependency, err := maven.ParseDependency(gav)
+			if err != nil {
+				return fmt.Errorf("invalid dependency %s: %v", d, err)
+			}
+
+			ctx.Maven.Project.AddDependency(dependency)
 		default:
 			if dep := jitpack.ToDependency(d); dep != nil {
 				ctx.Maven.Project.AddDependency(*dep)
@@ -269,6 +346,38 @@ func injectDependencies(ctx *Context) error {
 	return nil
 }
 
+func injectRepositories(ctx *Context) error {
+	for i, r := range ctx.Build.Repositories {
+		repo, err := maven.ParseRepository(r)
+		if err != nil {
+			return err
+		}
+		if repo.ID == "" {
+			repo.ID = fmt.Sprintf("repository-%03d", i)
+		}
+
+		// The repositories are added to the project so that they are merged with
+		// the ones configured at the platform level in the Maven settings
+		if !containsRepository(ctx.Maven.Project.Repositories, repo.URL) {
+			ctx.Maven.Project.Repositories = append(ctx.Maven.Project.Repositories, repo)
+		}
+		if !containsRepository(ctx.Maven.Project.PluginRepositories, repo.URL) {
+			ctx.Maven.Project.PluginRepositories = append(ctx.Maven.Project.PluginRepositories, repo)
+		}
+	}
+
+	return nil
+}
+
+func containsRepository(repositories []maven.Repository, url string) bool {
+	for _, r := range repositories {
+		if r.URL == url {
+			return true
+		}
+	}
+	return false
+}
+
 func sanitizeDependencies(ctx *Context) error {
 	for i := 0; i < len(ctx.Maven.Project.Dependencies); i++ {
 		dep := ctx.Maven.Project.Dependencies[i]
@@ -360,9 +469,15 @@ func imageContext(ctx *Context, selector artifactsSelector) error {
 		}
 	}
 
+	instructions := ""
+	for _, i := range ctx.Build.ImageInstructions {
+		instructions += `
+		` + i
+	}
+
 	// #nosec G202
 	dockerfile := []byte(`
-		FROM ` + ctx.BaseImage + `
+		FROM ` + ctx.BaseImage + instructions + `
 		ADD . /deployments
 		USER 1000
 	`)
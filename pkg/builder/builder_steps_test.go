@@ -18,6 +18,10 @@ limitations under the License.
 package builder
 
 import (
+	"encoding/xml"
+	"io/ioutil"
+	"os"
+	"path"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -28,6 +32,7 @@ import (
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/util/camel"
 	"github.com/apache/camel-k/pkg/util/cancellable"
+	"github.com/apache/camel-k/pkg/util/maven"
 	"github.com/apache/camel-k/pkg/util/test"
 )
 
@@ -144,6 +149,229 @@ func TestMavenSettingsFromSecret(t *testing.T) {
 	assert.Equal(t, []byte("setting-data"), ctx.Maven.SettingsData)
 }
 
+func TestMavenSettingsWithMirror(t *testing.T) {
+	catalog, err := camel.DefaultCatalog()
+	assert.Nil(t, err)
+
+	c, err := test.NewFakeClient(
+		&corev1.ConfigMap{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "v1",
+				Kind:       "ConfigMap",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "maven-settings",
+			},
+			Data: map[string]string{
+				"settings.xml": "<settings><localRepository>/tmp/m2</localRepository></settings>",
+			},
+		},
+		&corev1.Secret{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "v1",
+				Kind:       "Secret",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "maven-mirror",
+			},
+			Data: map[string][]byte{
+				"url":      []byte("https://my-nexus/repository/public"),
+				"username": []byte("user"),
+				"password": []byte("pwd"),
+			},
+		},
+	)
+
+	assert.Nil(t, err)
+
+	ctx := Context{
+		Catalog:   catalog,
+		Client:    c,
+		Namespace: "ns",
+		Build: v1.BuilderTask{
+			Runtime: catalog.Runtime,
+			Maven: v1.MavenSpec{
+				Settings: v1.ValueSource{
+					ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: "maven-settings",
+						},
+						Key: "settings.xml",
+					},
+				},
+			},
+			MavenMirror: "maven-mirror",
+		},
+	}
+
+	err = Steps.GenerateProjectSettings.Execute(&ctx)
+	assert.Nil(t, err)
+
+	settings := maven.Settings{}
+	assert.Nil(t, xml.Unmarshal(ctx.Maven.SettingsData, &settings))
+	assert.Equal(t, "/tmp/m2", settings.LocalRepository)
+	assert.Len(t, settings.Mirrors, 1)
+	assert.Equal(t, "maven-mirror", settings.Mirrors[0].ID)
+	assert.Equal(t, "maven-mirror", settings.Mirrors[0].Name)
+	assert.Equal(t, "https://my-nexus/repository/public", settings.Mirrors[0].URL)
+	assert.Equal(t, "*", settings.Mirrors[0].MirrorOf)
+	assert.Len(t, settings.Servers, 1)
+	assert.Equal(t, "maven-mirror", settings.Servers[0].ID)
+	assert.Equal(t, "user", settings.Servers[0].Username)
+	assert.Equal(t, "pwd", settings.Servers[0].Password)
+}
+
+func TestMavenSettingsWithMissingMirror(t *testing.T) {
+	c, err := test.NewFakeClient()
+	assert.Nil(t, err)
+
+	ctx := Context{
+		Client:    c,
+		Namespace: "ns",
+		Build: v1.BuilderTask{
+			MavenMirror: "maven-mirror",
+		},
+	}
+
+	err = Steps.GenerateProjectSettings.Execute(&ctx)
+	assert.NotNil(t, err)
+}
+
+func TestInjectRepositories(t *testing.T) {
+	ctx := Context{
+		Build: v1.BuilderTask{
+			Repositories: []string{
+				"https://repository.apache.org/content/repositories/snapshots@id=apache-snapshots@snapshots",
+				"my-repo::releases::https://my-nexus/repository/public",
+				"https://my-nexus/repository/public",
+			},
+		},
+	}
+	ctx.Maven.Project = maven.NewProjectWithGAV("org.apache.camel.k.integration", "camel-k-integration", "1.0.0")
+
+	err := Steps.InjectRepositories.Execute(&ctx)
+	assert.Nil(t, err)
+
+	assert.Len(t, ctx.Maven.Project.Repositories, 2)
+	assert.Len(t, ctx.Maven.Project.PluginRepositories, 2)
+
+	assert.Equal(t, "apache-snapshots", ctx.Maven.Project.Repositories[0].ID)
+	assert.True(t, ctx.Maven.Project.Repositories[0].Snapshots.Enabled)
+	assert.Equal(t, "my-repo", ctx.Maven.Project.Repositories[1].ID)
+	assert.Equal(t, "https://my-nexus/repository/public", ctx.Maven.Project.Repositories[1].URL)
+	assert.False(t, ctx.Maven.Project.Repositories[1].Snapshots.Enabled)
+}
+
+func TestImageContextWithInstructions(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "image-context")
+	assert.Nil(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	ctx := Context{
+		Path:      tmpDir,
+		BaseImage: "registry.access.redhat.com/ubi8/ubi-minimal:8.2",
+		Build: v1.BuilderTask{
+			ImageInstructions: []string{
+				"USER root",
+				"RUN microdnf install -y libaio",
+			},
+		},
+	}
+
+	err = Steps.StandardImageContext.Execute(&ctx)
+	assert.Nil(t, err)
+
+	dockerfile, err := ioutil.ReadFile(path.Join(tmpDir, "context", "Dockerfile"))
+	assert.Nil(t, err)
+	assert.Regexp(t, `FROM registry.access.redhat.com/ubi8/ubi-minimal:8.2\s+USER root\s+RUN microdnf install -y libaio\s+ADD . /deployments\s+USER 1000`, string(dockerfile))
+}
+
+func TestGenerateRegistryAuth(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "registry-auth")
+	assert.Nil(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	c, err := test.NewFakeClient(
+		&corev1.Secret{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "v1",
+				Kind:       "Secret",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "platform-registry",
+			},
+			Type: corev1.SecretTypeDockerConfigJson,
+			Data: map[string][]byte{
+				corev1.DockerConfigJsonKey: []byte(`{"auths":{"my-registry:5000":{"auth":"dXNlcjpwd2Q="}}}`),
+			},
+		},
+		&corev1.Secret{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "v1",
+				Kind:       "Secret",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "base-image-registry",
+			},
+			Type: corev1.SecretTypeDockercfg,
+			Data: map[string][]byte{
+				corev1.DockerConfigKey: []byte(`{"quay.io":{"auth":"bmljOnBhc3M="}}`),
+			},
+		},
+	)
+	assert.Nil(t, err)
+
+	ctx := Context{
+		Client:    c,
+		Namespace: "ns",
+		Path:      tmpDir,
+		Build: v1.BuilderTask{
+			RegistrySecrets: []string{"platform-registry", "base-image-registry"},
+		},
+	}
+
+	err = Steps.GenerateRegistryAuth.Execute(&ctx)
+	assert.Nil(t, err)
+
+	config, err := ioutil.ReadFile(path.Join(tmpDir, RegistryAuthDir, "config.json"))
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"auths":{"my-registry:5000":{"auth":"dXNlcjpwd2Q="},"quay.io":{"auth":"bmljOnBhc3M="}}}`, string(config))
+}
+
+func TestGenerateRegistryAuthWithUnsupportedSecret(t *testing.T) {
+	c, err := test.NewFakeClient(
+		&corev1.Secret{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "v1",
+				Kind:       "Secret",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "gcr-registry",
+			},
+			Data: map[string][]byte{
+				"kaniko-secret.json": []byte("{}"),
+			},
+		},
+	)
+	assert.Nil(t, err)
+
+	ctx := Context{
+		Client:    c,
+		Namespace: "ns",
+		Build: v1.BuilderTask{
+			RegistrySecrets: []string{"gcr-registry"},
+		},
+	}
+
+	err = Steps.GenerateRegistryAuth.Execute(&ctx)
+	assert.NotNil(t, err)
+}
+
 func TestListPublishedImages(t *testing.T) {
 	catalog, err := camel.DefaultCatalog()
 	assert.Nil(t, err)
@@ -43,6 +43,10 @@ const (
 	NotifyPhase int32 = math.MaxInt32
 )
 
+// RegistryAuthDir is the directory, relative to the build directory, where the Docker configuration
+// merged from the registry secrets is generated
+const RegistryAuthDir = "registry-auth"
+
 // Builder --
 type Builder interface {
 	Run(build v1.BuilderTask) v1.BuildStatus
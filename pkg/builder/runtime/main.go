@@ -93,6 +93,7 @@ func computeDependencies(ctx *builder.Context) error {
 	mc.SettingsContent = ctx.Maven.SettingsData
 	mc.LocalRepository = ctx.Build.Maven.LocalRepository
 	mc.Timeout = ctx.Build.Maven.GetTimeout().Duration
+	mc.AddSystemProperties(ctx.Build.MavenProperties)
 	mc.AddArgumentf("org.apache.camel.k:camel-k-maven-plugin:%s:generate-dependency-list", ctx.Catalog.Runtime.Version)
 
 	if err := maven.Run(mc); err != nil {
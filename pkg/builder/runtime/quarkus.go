@@ -22,6 +22,8 @@ import (
 	"io/ioutil"
 	"os"
 	"path"
+	"path/filepath"
+	"strings"
 
 	"github.com/apache/camel-k/pkg/util/digest"
 
@@ -44,6 +46,29 @@ var QuarkusSteps = []builder.Step{
 	Steps.ComputeQuarkusDependencies,
 }
 
+// QuarkusNativeSteps --
+var QuarkusNativeSteps = []builder.Step{
+	Steps.LoadCamelQuarkusCatalog,
+	Steps.GenerateQuarkusProject,
+	Steps.BuildQuarkusNativeRunner,
+	Steps.ComputeQuarkusNativeArtifact,
+}
+
+const (
+	// QuarkusNativeProfile is the Maven profile activating the Quarkus native compilation
+	QuarkusNativeProfile = "native"
+	// QuarkusPackageTypeProperty is the Quarkus build property defining the package type
+	QuarkusPackageTypeProperty = "quarkus.package.type"
+	// QuarkusUberJarProperty is the Quarkus build property enabling the uber-jar packaging
+	QuarkusUberJarProperty = "quarkus.package.uber-jar"
+	// QuarkusFastJarPackageType --
+	QuarkusFastJarPackageType = "fast-jar"
+	// QuarkusUberJarPackageType --
+	QuarkusUberJarPackageType = "uber-jar"
+	// QuarkusFastJarRunner is the target of the fast-jar runner in the integration image
+	QuarkusFastJarRunner = "quarkus-app/quarkus-run.jar"
+)
+
 func loadCamelQuarkusCatalog(ctx *builder.Context) error {
 	catalog, err := camel.LoadCatalog(ctx.C, ctx.Client, ctx.Build.Meta.Namespace, ctx.Build.Runtime)
 	if err != nil {
@@ -97,6 +122,14 @@ func generateQuarkusProject(ctx *builder.Context) error {
 		},
 	)
 
+	// Native compilation, activated for native builds
+	native := maven.Profile{
+		ID:         QuarkusNativeProfile,
+		Properties: maven.Properties{},
+	}
+	native.Properties[QuarkusPackageTypeProperty] = "native"
+	p.Profiles = append(p.Profiles, native)
+
 	// Plugins
 	p.Build.Plugins = append(p.Build.Plugins,
 		maven.Plugin{
@@ -119,10 +152,19 @@ func generateQuarkusProject(ctx *builder.Context) error {
 }
 
 func buildQuarkusRunner(ctx *builder.Context) error {
+	return buildQuarkusProject(ctx)
+}
+
+func buildQuarkusNativeRunner(ctx *builder.Context) error {
+	return buildQuarkusProject(ctx, QuarkusNativeProfile)
+}
+
+func buildQuarkusProject(ctx *builder.Context, profiles ...string) error {
 	mc := maven.NewContext(path.Join(ctx.Path, "maven"), ctx.Maven.Project)
 	mc.SettingsContent = ctx.Maven.SettingsData
 	mc.LocalRepository = ctx.Build.Maven.LocalRepository
 	mc.Timeout = ctx.Build.Maven.GetTimeout().Duration
+	mc.AddSystemProperties(ctx.Build.MavenProperties)
 
 	resourcesPath := path.Join(mc.Path, "src", "main", "resources")
 	if err := os.MkdirAll(resourcesPath, os.ModePerm); err != nil {
@@ -141,6 +183,9 @@ func buildQuarkusRunner(ctx *builder.Context) error {
 
 	// Build the project
 	mc.AddArgument("package")
+	if len(profiles) > 0 {
+		mc.AddArgument("-P" + strings.Join(profiles, ","))
+	}
 	if err := maven.Run(mc); err != nil {
 		return errors.Wrap(err, "failure while building project")
 	}
@@ -153,6 +198,15 @@ func computeQuarkusDependencies(ctx *builder.Context) error {
 	mc.SettingsContent = ctx.Maven.SettingsData
 	mc.LocalRepository = ctx.Build.Maven.LocalRepository
 	mc.Timeout = ctx.Build.Maven.GetTimeout().Duration
+	mc.AddSystemProperties(ctx.Build.MavenProperties)
+
+	switch {
+	case ctx.Build.MavenProperties[QuarkusUberJarProperty] == "true":
+		// The uber-jar embeds the runtime dependencies
+		return addQuarkusRunner(ctx, mc)
+	case ctx.Build.MavenProperties[QuarkusPackageTypeProperty] == QuarkusFastJarPackageType:
+		return computeQuarkusFastJarArtifacts(ctx, mc)
+	}
 
 	// Retrieve the runtime dependencies
 	mc.AddArgumentf("org.apache.camel.k:camel-k-maven-plugin:%s:generate-dependency-list", ctx.Catalog.Runtime.Version)
@@ -200,6 +254,10 @@ func computeQuarkusDependencies(ctx *builder.Context) error {
 		})
 	}
 
+	return addQuarkusRunner(ctx, mc)
+}
+
+func addQuarkusRunner(ctx *builder.Context, mc maven.Context) error {
 	runner := "camel-k-integration-" + defaults.Version + "-runner.jar"
 
 	//
@@ -219,3 +277,58 @@ func computeQuarkusDependencies(ctx *builder.Context) error {
 
 	return nil
 }
+
+func computeQuarkusFastJarArtifacts(ctx *builder.Context, mc maven.Context) error {
+	appPath := path.Join(mc.Path, "target", "quarkus-app")
+
+	// The fast-jar layout is preserved, so that the dependencies in the lib directory
+	// can be shared by the integration images
+	return filepath.Walk(appPath, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return errors.Wrap(err, "failure while looking up the fast-jar artifacts")
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(appPath, filePath)
+		if err != nil {
+			return err
+		}
+
+		checksum, err := digest.ComputeSHA1(filePath)
+		if err != nil {
+			return err
+		}
+
+		target := path.Join("quarkus-app", filepath.ToSlash(relativePath))
+		ctx.Artifacts = append(ctx.Artifacts, v1.Artifact{
+			ID:       target,
+			Location: filePath,
+			Target:   target,
+			Checksum: "sha1:" + checksum,
+		})
+
+		return nil
+	})
+}
+
+func computeQuarkusNativeArtifact(ctx *builder.Context) error {
+	runner := "camel-k-integration-" + defaults.Version + "-runner"
+	location := path.Join(ctx.Path, "maven", "target", runner)
+
+	// The native executable is the only artifact of the integration image
+	checksum, err := digest.ComputeSHA1(location)
+	if err != nil {
+		return errors.Wrap(err, "failure while looking up the native executable")
+	}
+
+	ctx.Artifacts = append(ctx.Artifacts, v1.Artifact{
+		ID:       runner,
+		Location: location,
+		Target:   runner,
+		Checksum: "sha1:" + checksum,
+	})
+
+	return nil
+}
@@ -0,0 +1,49 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/builder"
+	"github.com/apache/camel-k/pkg/util/camel"
+)
+
+func TestGenerateQuarkusProject(t *testing.T) {
+	catalog, err := camel.QuarkusCatalog()
+	assert.Nil(t, err)
+
+	ctx := builder.Context{
+		Catalog: catalog,
+		Build: v1.BuilderTask{
+			Runtime: catalog.Runtime,
+		},
+	}
+
+	err = Steps.GenerateQuarkusProject.Execute(&ctx)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "false", ctx.Maven.Project.Properties["quarkus.banner.enabled"])
+	assert.Len(t, ctx.Maven.Project.Profiles, 1)
+	assert.Equal(t, QuarkusNativeProfile, ctx.Maven.Project.Profiles[0].ID)
+	assert.Equal(t, "native", ctx.Maven.Project.Profiles[0].Properties["quarkus.package.type"])
+	assert.NotContains(t, ctx.Maven.Project.Properties, "quarkus.package.type")
+}
@@ -36,6 +36,9 @@ type steps struct {
 	GenerateQuarkusProject     builder.Step
 	BuildQuarkusRunner         builder.Step
 	ComputeQuarkusDependencies builder.Step
+	// Quarkus native
+	BuildQuarkusNativeRunner     builder.Step
+	ComputeQuarkusNativeArtifact builder.Step
 }
 
 // Steps --
@@ -70,4 +73,13 @@ var Steps = steps{
 		builder.ProjectBuildPhase+1,
 		computeQuarkusDependencies,
 	),
+	// Quarkus native
+	BuildQuarkusNativeRunner: builder.NewStep(
+		builder.ProjectBuildPhase,
+		buildQuarkusNativeRunner,
+	),
+	ComputeQuarkusNativeArtifact: builder.NewStep(
+		builder.ProjectBuildPhase+1,
+		computeQuarkusNativeArtifact,
+	),
 }
@@ -61,7 +61,12 @@ func publisher(ctx *builder.Context) error {
 		target = fmt.Sprintf("%s/%s", registry, target)
 	}
 
-	pullInsecure := pl.Status.Build.Registry.Insecure // incremental build case
+	insecure := pl.Status.Build.Registry.Insecure
+	if ctx.Build.RegistryInsecure != nil {
+		insecure = *ctx.Build.RegistryInsecure
+	}
+
+	pullInsecure := insecure // incremental build case
 	if ctx.BaseImage == pl.Status.Build.BaseImage {
 		// Assuming the base image is always secure (we should add a flag)
 		pullInsecure = false
@@ -78,7 +83,7 @@ func publisher(ctx *builder.Context) error {
 
 	options := spectrum.Options{
 		PullInsecure:  pullInsecure,
-		PushInsecure:  pl.Status.Build.Registry.Insecure,
+		PushInsecure:  insecure,
 		PushConfigDir: registryConfigDir,
 		Base:          ctx.BaseImage,
 		Target:        target,
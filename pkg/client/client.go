@@ -31,6 +31,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/kubernetes"
 	clientscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
@@ -69,8 +70,14 @@ type Provider struct {
 type defaultClient struct {
 	controller.Client
 	kubernetes.Interface
-	scheme *runtime.Scheme
-	config *rest.Config
+	scheme    *runtime.Scheme
+	config    *rest.Config
+	discovery discovery.DiscoveryInterface
+}
+
+// Discovery overrides the clientset discovery client, with one that times out and retries the calls
+func (c *defaultClient) Discovery() discovery.DiscoveryInterface {
+	return c.discovery
 }
 
 func (c *defaultClient) GetScheme() *runtime.Scheme {
@@ -92,6 +99,19 @@ func NewOutOfClusterClient(kubeconfig string) (Client, error) {
 	return NewClient(true)
 }
 
+// NewOutOfClusterClientForContext creates a new k8s client that can be used from outside the cluster,
+// talking to the apiserver of the given kubeconfig context
+func NewOutOfClusterClientForContext(kubeconfig string, context string) (Client, error) {
+	initialize(kubeconfig)
+	// Get a config to talk to the apiserver of the given context
+	cfg, err := config.GetConfigWithContext(context)
+	if err != nil {
+		return nil, err
+	}
+	// using fast discovery from outside the cluster
+	return newClientForConfig(cfg, true)
+}
+
 // NewClient creates a new k8s client that can be used from outside or in the cluster
 func NewClient(fastDiscovery bool) (Client, error) {
 	// Get a config to talk to the apiserver
@@ -100,6 +120,11 @@ func NewClient(fastDiscovery bool) (Client, error) {
 		return nil, err
 	}
 
+	return newClientForConfig(cfg, fastDiscovery)
+}
+
+func newClientForConfig(cfg *rest.Config, fastDiscovery bool) (Client, error) {
+	var err error
 	scheme := clientscheme.Scheme
 
 	// Setup Scheme for all resources
@@ -112,6 +137,11 @@ func NewClient(fastDiscovery bool) (Client, error) {
 		return nil, err
 	}
 
+	discoveryClient, err := newDiscoveryClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	var mapper meta.RESTMapper
 	if fastDiscovery {
 		mapper = newFastDiscoveryRESTMapper(cfg)
@@ -132,6 +162,7 @@ func NewClient(fastDiscovery bool) (Client, error) {
 		Interface: clientset,
 		scheme:    clientOptions.Scheme,
 		config:    cfg,
+		discovery: discoveryClient,
 	}, nil
 }
 
@@ -142,11 +173,16 @@ func FromManager(manager manager.Manager) (Client, error) {
 	if clientset, err = kubernetes.NewForConfig(manager.GetConfig()); err != nil {
 		return nil, err
 	}
+	var discoveryClient discovery.DiscoveryInterface
+	if discoveryClient, err = newDiscoveryClient(manager.GetConfig()); err != nil {
+		return nil, err
+	}
 	return &defaultClient{
 		Client:    manager.GetClient(),
 		Interface: clientset,
 		scheme:    manager.GetScheme(),
 		config:    manager.GetConfig(),
+		discovery: discoveryClient,
 	}, nil
 }
 
@@ -0,0 +1,136 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"net"
+	"time"
+
+	"github.com/jpillora/backoff"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+)
+
+// DefaultDiscoveryTimeout is the default timeout of the discovery API calls
+const DefaultDiscoveryTimeout = 30 * time.Second
+
+// DiscoveryTimeout is the timeout of each discovery API call. The calls that time out, or fail
+// because of a transient server error, are retried with an exponential back-off.
+var DiscoveryTimeout = DefaultDiscoveryTimeout
+
+const discoveryAttempts = 3
+
+var discoveryBackOff = backoff.Backoff{
+	Min:    500 * time.Millisecond,
+	Max:    5 * time.Second,
+	Factor: 2,
+	Jitter: false,
+}
+
+// DiscoveryConfig returns a copy of the given config, configured with the discovery timeout
+func DiscoveryConfig(config *rest.Config) *rest.Config {
+	c := rest.CopyConfig(config)
+	c.Timeout = DiscoveryTimeout
+	return c
+}
+
+// newDiscoveryClient creates a discovery client that bounds and retries the discovery API calls,
+// so that a degraded API server, or a slow aggregated API, does not stall its callers indefinitely
+func newDiscoveryClient(config *rest.Config) (discovery.DiscoveryInterface, error) {
+	dc, err := discovery.NewDiscoveryClientForConfig(DiscoveryConfig(config))
+	if err != nil {
+		return nil, err
+	}
+	return &retryDiscoveryClient{DiscoveryInterface: dc}, nil
+}
+
+type retryDiscoveryClient struct {
+	discovery.DiscoveryInterface
+}
+
+func (c *retryDiscoveryClient) ServerGroups() (groups *metav1.APIGroupList, err error) {
+	err = retryDiscovery(func() error {
+		groups, err = c.DiscoveryInterface.ServerGroups()
+		return err
+	})
+	return groups, err
+}
+
+func (c *retryDiscoveryClient) ServerResourcesForGroupVersion(groupVersion string) (resources *metav1.APIResourceList, err error) {
+	err = retryDiscovery(func() error {
+		resources, err = c.DiscoveryInterface.ServerResourcesForGroupVersion(groupVersion)
+		return err
+	})
+	return resources, err
+}
+
+func (c *retryDiscoveryClient) ServerGroupsAndResources() (groups []*metav1.APIGroup, resources []*metav1.APIResourceList, err error) {
+	err = retryDiscovery(func() error {
+		groups, resources, err = c.DiscoveryInterface.ServerGroupsAndResources()
+		return err
+	})
+	return groups, resources, err
+}
+
+func (c *retryDiscoveryClient) ServerPreferredResources() (resources []*metav1.APIResourceList, err error) {
+	err = retryDiscovery(func() error {
+		resources, err = c.DiscoveryInterface.ServerPreferredResources()
+		return err
+	})
+	return resources, err
+}
+
+func (c *retryDiscoveryClient) ServerPreferredNamespacedResources() (resources []*metav1.APIResourceList, err error) {
+	err = retryDiscovery(func() error {
+		resources, err = c.DiscoveryInterface.ServerPreferredNamespacedResources()
+		return err
+	})
+	return resources, err
+}
+
+func retryDiscovery(call func() error) error {
+	var err error
+	for attempt := 0; attempt < discoveryAttempts; attempt++ {
+		if attempt > 0 {
+			time.Sleep(discoveryBackOff.ForAttempt(float64(attempt - 1)))
+		}
+		if err = call(); err == nil || !isRetriableDiscoveryError(err) {
+			return err
+		}
+	}
+	return err
+}
+
+// isRetriableDiscoveryError returns whether the error is transient. Partial group discovery failures
+// are not retried, as callers usually tolerate them, and missing API groups are expected to be reported.
+func isRetriableDiscoveryError(err error) bool {
+	if discovery.IsGroupDiscoveryFailedError(err) {
+		return false
+	}
+	if k8serrors.IsTimeout(err) || k8serrors.IsServerTimeout(err) || k8serrors.IsTooManyRequests(err) ||
+		k8serrors.IsServiceUnavailable(err) || k8serrors.IsInternalError(err) {
+		return true
+	}
+	if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+		return true
+	}
+	return false
+}
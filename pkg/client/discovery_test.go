@@ -0,0 +1,121 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jpillora/backoff"
+	"github.com/stretchr/testify/assert"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestRetryDiscovery(t *testing.T) {
+	defer func(b backoff.Backoff) { discoveryBackOff = b }(discoveryBackOff)
+	discoveryBackOff = backoff.Backoff{Min: time.Millisecond, Max: time.Millisecond}
+
+	transient := k8serrors.NewServiceUnavailable("unavailable")
+
+	testCases := []struct {
+		name     string
+		errors   []error
+		calls    int
+		expected error
+	}{
+		{
+			name:   "success",
+			errors: []error{nil},
+			calls:  1,
+		},
+		{
+			name:   "transient error then success",
+			errors: []error{transient, timeoutError{}, nil},
+			calls:  3,
+		},
+		{
+			name:     "non retriable error",
+			errors:   []error{k8serrors.NewForbidden(schema.GroupResource{}, "", errors.New("forbidden")), nil},
+			calls:    1,
+			expected: k8serrors.NewForbidden(schema.GroupResource{}, "", errors.New("forbidden")),
+		},
+		{
+			name:     "gives up after the final attempt",
+			errors:   []error{transient, transient, transient, nil},
+			calls:    discoveryAttempts,
+			expected: transient,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := 0
+			err := retryDiscovery(func() error {
+				err := tc.errors[calls]
+				calls++
+				return err
+			})
+
+			assert.Equal(t, tc.calls, calls)
+			assert.Equal(t, tc.expected, err)
+		})
+	}
+}
+
+func TestIsRetriableDiscoveryError(t *testing.T) {
+	testCases := []struct {
+		name      string
+		err       error
+		retriable bool
+	}{
+		{name: "timeout", err: k8serrors.NewTimeoutError("timeout", 1), retriable: true},
+		{name: "server timeout", err: k8serrors.NewServerTimeout(schema.GroupResource{}, "get", 1), retriable: true},
+		{name: "too many requests", err: k8serrors.NewTooManyRequests("throttled", 1), retriable: true},
+		{name: "service unavailable", err: k8serrors.NewServiceUnavailable("unavailable"), retriable: true},
+		{name: "internal error", err: k8serrors.NewInternalError(errors.New("internal")), retriable: true},
+		{name: "network timeout", err: timeoutError{}, retriable: true},
+		{name: "not found", err: k8serrors.NewNotFound(schema.GroupResource{}, "foo"), retriable: false},
+		{name: "unauthorized", err: k8serrors.NewUnauthorized("unauthorized"), retriable: false},
+		{name: "other error", err: errors.New("connection refused"), retriable: false},
+		{
+			name: "group discovery failure",
+			err: &discovery.ErrGroupDiscoveryFailed{
+				Groups: map[schema.GroupVersion]error{
+					{Group: "metrics.k8s.io", Version: "v1beta1"}: k8serrors.NewServiceUnavailable("unavailable"),
+				},
+			},
+			retriable: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.retriable, isRetriableDiscoveryError(tc.err))
+		})
+	}
+}
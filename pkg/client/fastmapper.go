@@ -52,7 +52,10 @@ func newFastDiscoveryRESTMapper(config *rest.Config) meta.RESTMapper {
 }
 
 func newFastDiscoveryRESTMapperWithFilter(config *rest.Config, filter func(*metav1.APIGroup) bool) (meta.RESTMapper, error) {
-	dc := discovery.NewDiscoveryClientForConfigOrDie(config)
+	dc, err := newDiscoveryClient(config)
+	if err != nil {
+		return nil, err
+	}
 	groups, err := dc.ServerGroups()
 	if err != nil {
 		return nil, err
@@ -0,0 +1,77 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	controller "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultListPageSize is the default maximum number of objects returned by each list call of ListPages
+const DefaultListPageSize = 500
+
+// ListPages lists the objects matching the given options, by chunks of at most pageSize objects (DefaultListPageSize
+// when not positive), so that the memory usage and the API server load are bounded, whatever the number of objects.
+// Each chunk is decoded into the given list object, that's handed over to the page function before the next chunk is
+// retrieved. Objects can be deleted from the page function, as the chunks are served from a consistent snapshot.
+// When the snapshot expires before all the chunks are retrieved, the objects are listed again from the start,
+// so that the page function must tolerate being handed over objects it has already processed.
+func ListPages(ctx context.Context, c controller.Reader, list runtime.Object, pageSize int64, page func() error, options ...controller.ListOption) error {
+	if pageSize <= 0 {
+		pageSize = DefaultListPageSize
+	}
+
+	accessor, err := meta.ListAccessor(list)
+	if err != nil {
+		return err
+	}
+
+	continueToken := ""
+	for {
+		opts := make([]controller.ListOption, 0, len(options)+2)
+		opts = append(opts, options...)
+		opts = append(opts, controller.Limit(pageSize))
+		if continueToken != "" {
+			opts = append(opts, controller.Continue(continueToken))
+		}
+
+		// The continue token is omitted from the last chunk, so it must not be carried over from the previous one
+		accessor.SetContinue("")
+		if err := c.List(ctx, list, opts...); err != nil {
+			if k8serrors.IsResourceExpired(err) && continueToken != "" {
+				// The continue token has expired (410 Gone), the initial snapshot is no longer available
+				continueToken = ""
+				continue
+			}
+			return err
+		}
+		if err := page(); err != nil {
+			return err
+		}
+
+		continueToken = accessor.GetContinue()
+		if continueToken == "" {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,145 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientscheme "k8s.io/client-go/kubernetes/scheme"
+
+	controller "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// pagingReader serves the objects of the fake client, that ignores the limit and continue options, by chunks
+type pagingReader struct {
+	controller.Reader
+	calls int
+	// the call that fails with an expired continue token
+	expire int
+}
+
+func (r *pagingReader) List(ctx context.Context, list runtime.Object, opts ...controller.ListOption) error {
+	r.calls++
+	options := controller.ListOptions{}
+	options.ApplyOptions(opts)
+
+	if r.calls == r.expire && options.Continue != "" {
+		return k8serrors.NewResourceExpired("the provided continue parameter is too old")
+	}
+
+	if err := r.Reader.List(ctx, list, opts...); err != nil {
+		return err
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+	// Serve the chunks from a consistent order
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].(metav1.Object).GetName() < items[j].(metav1.Object).GetName()
+	})
+	start := 0
+	if options.Continue != "" {
+		if start, err = strconv.Atoi(options.Continue); err != nil {
+			return err
+		}
+	}
+	end := len(items)
+	if options.Limit > 0 && start+int(options.Limit) < end {
+		end = start + int(options.Limit)
+	}
+
+	accessor, err := meta.ListAccessor(list)
+	if err != nil {
+		return err
+	}
+	if end < len(items) {
+		accessor.SetContinue(strconv.Itoa(end))
+	}
+	return meta.SetList(list, items[start:end])
+}
+
+func TestListPages(t *testing.T) {
+	objs := make([]runtime.Object, 0, 5)
+	for i := 0; i < 5; i++ {
+		objs = append(objs, &corev1.ConfigMap{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: corev1.SchemeGroupVersion.String(),
+				Kind:       "ConfigMap",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      fmt.Sprintf("cm-%d", i),
+			},
+		})
+	}
+
+	testCases := []struct {
+		name   string
+		expire int
+		calls  int
+		names  []string
+	}{
+		{
+			name:  "multiple pages",
+			calls: 3,
+			names: []string{"cm-0", "cm-1", "cm-2", "cm-3", "cm-4"},
+		},
+		{
+			name:   "expired continue token",
+			expire: 2,
+			calls:  5,
+			names:  []string{"cm-0", "cm-1", "cm-0", "cm-1", "cm-2", "cm-3", "cm-4"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &pagingReader{
+				Reader: fake.NewFakeClientWithScheme(clientscheme.Scheme, objs...),
+				expire: tc.expire,
+			}
+
+			names := make([]string, 0)
+			list := corev1.ConfigMapList{}
+			err := ListPages(context.TODO(), c, &list, 2, func() error {
+				for _, cm := range list.Items {
+					names = append(names, cm.Name)
+				}
+				return nil
+			}, controller.InNamespace("ns"))
+
+			assert.Nil(t, err)
+			assert.Equal(t, tc.calls, c.calls)
+			assert.Equal(t, tc.names, names)
+		})
+	}
+}
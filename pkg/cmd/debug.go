@@ -0,0 +1,252 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	corev1 "k8s.io/api/core/v1"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/client"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+)
+
+const jvmTraitID = "jvm"
+
+func newCmdDebug(rootCmdOptions *RootCmdOptions) (*cobra.Command, *debugCmdOptions) {
+	options := debugCmdOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "debug integration",
+		Short:   "Debug an integration running on Kubernetes",
+		Long:    `Set an integration running on Kubernetes in debug mode and forward the debug port to localhost, until the command is interrupted.`,
+		Args:    options.validateArgs,
+		PreRunE: decode(&options),
+		RunE:    options.run,
+	}
+
+	cmd.Flags().Uint("port", 5005, "Local port the debugger connects to")
+	cmd.Flags().Uint("remote-port", 5005, "Port on which the integration JVM listens for the debugger")
+	cmd.Flags().Bool("suspend", false, "Suspend the integration JVM until a debugger is attached")
+
+	// completion support
+	configureKnownCompletions(&cmd)
+
+	return &cmd, &options
+}
+
+type debugCmdOptions struct {
+	*RootCmdOptions
+	Port       uint `mapstructure:"port"`
+	RemotePort uint `mapstructure:"remote-port"`
+	Suspend    bool `mapstructure:"suspend"`
+}
+
+func (o *debugCmdOptions) validateArgs(_ *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return errors.New("debug expects an integration name argument")
+	}
+
+	return nil
+}
+
+func (o *debugCmdOptions) run(cmd *cobra.Command, args []string) error {
+	c, err := o.GetCmdClient()
+	if err != nil {
+		return err
+	}
+
+	name := args[0]
+
+	integration, err := o.getIntegration(c, name)
+	if err != nil {
+		return err
+	}
+
+	original, hasOriginal := integration.Spec.Traits[jvmTraitID]
+
+	if err := o.enableDebug(integration); err != nil {
+		return err
+	}
+	if err := c.Update(o.Context, integration); err != nil {
+		return errors.Wrap(err, fmt.Sprintf("could not enable debug mode on integration %s", name))
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Enabling debug mode on integration %s\n", name)
+
+	// The debug settings are reverted whenever the command terminates
+	defer func() {
+		fmt.Fprintf(cmd.OutOrStdout(), "Disabling debug mode on integration %s\n", name)
+		if err := o.disableDebug(c, name, original, hasOriginal); err != nil {
+			fmt.Fprintln(cmd.ErrOrStderr(), err.Error())
+		}
+	}()
+
+	ctx, cancel := context.WithCancel(o.Context)
+	defer cancel()
+
+	cs := make(chan os.Signal, 1)
+	signal.Notify(cs, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(cs)
+	go func() {
+		select {
+		case <-cs:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	pod, err := o.waitForDebugPod(ctx, c, name)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return err
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Forwarding the debug port of pod %s, connect the debugger to localhost:%d\n", pod.Name, o.Port)
+
+	return kubernetes.PortForward(ctx, c, o.Namespace, pod.Name, o.Port, o.RemotePort, cmd.OutOrStdout(), cmd.ErrOrStderr())
+}
+
+func (o *debugCmdOptions) getIntegration(c client.Client, name string) (*v1.Integration, error) {
+	integration := v1.NewIntegration(o.Namespace, name)
+	key := k8sclient.ObjectKey{
+		Namespace: o.Namespace,
+		Name:      name,
+	}
+	if err := c.Get(o.Context, key, &integration); err != nil {
+		return nil, err
+	}
+
+	return &integration, nil
+}
+
+// enableDebug configures the jvm trait of the integration for remote debugging,
+// preserving the other options that may have been set on the trait
+func (o *debugCmdOptions) enableDebug(integration *v1.Integration) error {
+	config := make(map[string]interface{})
+	if spec, ok := integration.Spec.Traits[jvmTraitID]; ok && len(spec.Configuration.RawMessage) > 0 {
+		if err := json.Unmarshal(spec.Configuration.RawMessage, &config); err != nil {
+			return err
+		}
+	}
+
+	config["debug"] = true
+	config["debugSuspend"] = o.Suspend
+	config["debugAddress"] = o.debugAddress()
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	if integration.Spec.Traits == nil {
+		integration.Spec.Traits = make(map[string]v1.TraitSpec)
+	}
+	integration.Spec.Traits[jvmTraitID] = v1.TraitSpec{
+		Configuration: v1.TraitConfiguration{
+			RawMessage: data,
+		},
+	}
+
+	return nil
+}
+
+func (o *debugCmdOptions) disableDebug(c client.Client, name string, original v1.TraitSpec, hasOriginal bool) error {
+	// The command context may already be done at that point
+	ctx := o.RootContext
+
+	integration := v1.NewIntegration(o.Namespace, name)
+	key := k8sclient.ObjectKey{
+		Namespace: o.Namespace,
+		Name:      name,
+	}
+	if err := c.Get(ctx, key, &integration); err != nil {
+		return errors.Wrap(err, fmt.Sprintf("could not disable debug mode on integration %s", name))
+	}
+
+	if hasOriginal {
+		integration.Spec.Traits[jvmTraitID] = original
+	} else {
+		delete(integration.Spec.Traits, jvmTraitID)
+	}
+
+	if err := c.Update(ctx, &integration); err != nil {
+		return errors.Wrap(err, fmt.Sprintf("could not disable debug mode on integration %s", name))
+	}
+
+	return nil
+}
+
+func (o *debugCmdOptions) debugAddress() string {
+	return fmt.Sprintf("*:%d", o.RemotePort)
+}
+
+// waitForDebugPod waits for a running pod of the integration, whose JVM has been started in debug mode
+func (o *debugCmdOptions) waitForDebugPod(ctx context.Context, c client.Client, name string) (*corev1.Pod, error) {
+	for {
+		pods := corev1.PodList{}
+		err := c.List(ctx, &pods,
+			k8sclient.InNamespace(o.Namespace),
+			k8sclient.MatchingLabels{
+				v1.IntegrationLabel: name,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range pods.Items {
+			pod := pods.Items[i]
+			if pod.DeletionTimestamp == nil && pod.Status.Phase == corev1.PodRunning && o.isDebugPod(pod) {
+				return &pod, nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
+func (o *debugCmdOptions) isDebugPod(pod corev1.Pod) bool {
+	for _, container := range pod.Spec.Containers {
+		args := strings.Join(append(container.Command, container.Args...), " ")
+		if strings.Contains(args, "-agentlib:jdwp=") && strings.Contains(args, "address="+o.debugAddress()) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,94 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+func TestDebugEnable(t *testing.T) {
+	options := debugCmdOptions{
+		RemotePort: 5006,
+		Suspend:    true,
+	}
+
+	integration := v1.NewIntegration("default", "my-integration")
+	integration.Spec.Traits = map[string]v1.TraitSpec{
+		"jvm": {
+			Configuration: v1.TraitConfiguration{
+				RawMessage: []byte(`{"options":["-Xss10m"]}`),
+			},
+		},
+	}
+
+	assert.Nil(t, options.enableDebug(&integration))
+
+	config := make(map[string]interface{})
+	assert.Nil(t, json.Unmarshal(integration.Spec.Traits["jvm"].Configuration.RawMessage, &config))
+	assert.Equal(t, true, config["debug"])
+	assert.Equal(t, true, config["debugSuspend"])
+	assert.Equal(t, "*:5006", config["debugAddress"])
+	assert.Equal(t, []interface{}{"-Xss10m"}, config["options"])
+}
+
+func TestDebugEnableWithoutTraits(t *testing.T) {
+	options := debugCmdOptions{
+		RemotePort: 5005,
+	}
+
+	integration := v1.NewIntegration("default", "my-integration")
+
+	assert.Nil(t, options.enableDebug(&integration))
+
+	config := make(map[string]interface{})
+	assert.Nil(t, json.Unmarshal(integration.Spec.Traits["jvm"].Configuration.RawMessage, &config))
+	assert.Equal(t, true, config["debug"])
+	assert.Equal(t, false, config["debugSuspend"])
+	assert.Equal(t, "*:5005", config["debugAddress"])
+}
+
+func TestDebugPod(t *testing.T) {
+	options := debugCmdOptions{
+		RemotePort: 5005,
+	}
+
+	pod := corev1.Pod{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Command: []string{"/bin/sh", "-c"},
+					Args:    []string{"echo exec java -agentlib:jdwp=transport=dt_socket,server=y,suspend=n,address=*:5005 -cp ./resources Main"},
+				},
+			},
+		},
+	}
+	assert.True(t, options.isDebugPod(pod))
+
+	options.RemotePort = 5006
+	assert.False(t, options.isDebugPod(pod))
+
+	pod.Spec.Containers[0].Args = []string{"echo exec java -cp ./resources Main"}
+	assert.False(t, options.isDebugPod(pod))
+}
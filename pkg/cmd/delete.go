@@ -27,6 +27,7 @@ import (
 	"github.com/spf13/cobra"
 	k8errors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -52,21 +53,35 @@ func newCmdDelete(rootCmdOptions *RootCmdOptions) (*cobra.Command, *deleteCmdOpt
 	}
 
 	cmd.Flags().Bool("all", false, "Delete all integrations")
+	cmd.Flags().StringP("selector", "l", "", "Selector (label query) of the integrations to delete, supports '=', '==', '!=', 'in', 'notin' and 'exists'. E.g. \"-l app=orders\"")
 
 	return &cmd, &options
 }
 
 type deleteCmdOptions struct {
 	*RootCmdOptions
-	DeleteAll bool `mapstructure:"all"`
+	DeleteAll bool   `mapstructure:"all"`
+	Selector  string `mapstructure:"selector"`
 }
 
 func (command *deleteCmdOptions) validate(args []string) error {
 	if command.DeleteAll && len(args) > 0 {
 		return errors.New("invalid combination: both all flag and named integrations are set")
 	}
-	if !command.DeleteAll && len(args) == 0 {
-		return errors.New("invalid combination: neither all flag nor named integrations are set")
+	if command.Selector != "" && (command.DeleteAll || len(args) > 0) {
+		return errors.New("invalid combination: selector flag is set along with all flag or named integrations")
+	}
+	if !command.DeleteAll && command.Selector == "" && len(args) == 0 {
+		return errors.New("invalid combination: neither all flag, selector flag nor named integrations are set")
+	}
+	if command.Selector != "" {
+		selector, err := labels.Parse(command.Selector)
+		if err != nil {
+			return fmt.Errorf("invalid selector %s: %v", command.Selector, err)
+		}
+		if selector.Empty() {
+			return fmt.Errorf("invalid selector %s: it must not select all the integrations", command.Selector)
+		}
 	}
 
 	return nil
@@ -91,7 +106,7 @@ func (command *deleteCmdOptions) run(args []string) error {
 				fmt.Println("Integration " + name + " deleted")
 			}
 		}
-	} else if command.DeleteAll {
+	} else if command.DeleteAll || command.Selector != "" {
 		integrationList := v1.IntegrationList{
 			TypeMeta: metav1.TypeMeta{
 				APIVersion: v1.SchemeGroupVersion.String(),
@@ -99,8 +114,21 @@ func (command *deleteCmdOptions) run(args []string) error {
 			},
 		}
 
+		options := []k8sclient.ListOption{
+			k8sclient.InNamespace(command.Namespace),
+		}
+		if command.Selector != "" {
+			selector, err := labels.Parse(command.Selector)
+			if err != nil {
+				return err
+			}
+			options = append(options, &k8sclient.ListOptions{
+				LabelSelector: selector,
+			})
+		}
+
 		//Looks like Operator SDK doesn't support deletion of all objects with one command
-		err := c.List(command.Context, &integrationList, k8sclient.InNamespace(command.Namespace))
+		err := c.List(command.Context, &integrationList, options...)
 		if err != nil {
 			return err
 		}
@@ -18,8 +18,11 @@ limitations under the License.
 package cmd
 
 import (
+	"testing"
+
 	"github.com/apache/camel-k/pkg/util/test"
 	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
 )
 
 //nolint:deadcode,unused
@@ -35,3 +38,23 @@ func addTestDeleteCmd(options RootCmdOptions, rootCmd *cobra.Command) *deleteCmd
 }
 
 //TODO: add a proper test, take inspiration by run_test.go
+
+func TestDeleteValidation(t *testing.T) {
+	options := deleteCmdOptions{}
+	assert.NotNil(t, options.validate(nil))
+	assert.Nil(t, options.validate([]string{"my-integration"}))
+
+	options = deleteCmdOptions{DeleteAll: true}
+	assert.Nil(t, options.validate(nil))
+	assert.NotNil(t, options.validate([]string{"my-integration"}))
+
+	options = deleteCmdOptions{Selector: "app=orders"}
+	assert.Nil(t, options.validate(nil))
+	assert.NotNil(t, options.validate([]string{"my-integration"}))
+
+	options = deleteCmdOptions{Selector: "app=orders", DeleteAll: true}
+	assert.NotNil(t, options.validate(nil))
+
+	options = deleteCmdOptions{Selector: "app in orders"}
+	assert.NotNil(t, options.validate(nil))
+}
@@ -0,0 +1,295 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/client"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+)
+
+const dumpRedactedValue = "<redacted>"
+
+func newCmdDump(rootCmdOptions *RootCmdOptions) (*cobra.Command, *dumpCmdOptions) {
+	options := dumpCmdOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+	cmd := cobra.Command{
+		Use:   "dump integration",
+		Short: "Dump the state of an integration into an archive",
+		Long: `Dump the state of an integration into an archive, that can be attached to bug reports.
+
+The archive contains the integration, its kit and build, its pods with their recent logs,
+the related events, and the configmaps and secrets the integration is configured with.
+The secret values are redacted.`,
+		PreRunE: decode(&options),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.validate(args); err != nil {
+				return err
+			}
+			return options.dump(cmd, args)
+		},
+	}
+
+	cmd.Flags().String("file", "", "The path of the archive (default to <integration>-dump.tar.gz)")
+	cmd.Flags().Int64("tail", 1000, "The number of recent log lines to dump for each container")
+
+	return &cmd, &options
+}
+
+type dumpCmdOptions struct {
+	*RootCmdOptions
+	File string `mapstructure:"file"`
+	Tail int64  `mapstructure:"tail"`
+}
+
+func (o *dumpCmdOptions) validate(args []string) error {
+	if len(args) != 1 {
+		return errors.New("dump expects exactly one integration name")
+	}
+	if o.Tail < 0 {
+		return errors.New("the number of log lines must not be negative")
+	}
+
+	return nil
+}
+
+func (o *dumpCmdOptions) dump(_ *cobra.Command, args []string) error {
+	c, err := o.GetCmdClient()
+	if err != nil {
+		return err
+	}
+
+	it := v1.NewIntegration(o.Namespace, args[0])
+	key := k8sclient.ObjectKey{
+		Name:      args[0],
+		Namespace: o.Namespace,
+	}
+	if err := c.Get(o.Context, key, &it); err != nil {
+		return errors.Wrap(err, fmt.Sprintf("could not find integration %s in namespace %s", it.Name, o.Namespace))
+	}
+
+	file := o.File
+	if file == "" {
+		file = it.Name + "-dump.tar.gz"
+	}
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	archive := newDumpArchive(f)
+	if err := o.dumpIntegration(c, archive, it); err != nil {
+		return err
+	}
+	if err := archive.Close(); err != nil {
+		return err
+	}
+
+	fmt.Printf("integration \"%s\" dumped into %s\n", it.Name, file)
+	return nil
+}
+
+func (o *dumpCmdOptions) dumpIntegration(c client.Client, archive *dumpArchive, it v1.Integration) error {
+	// The objects related to the integration, used to select the events
+	involved := map[string]bool{it.Name: true}
+
+	if err := archive.AddObject("integration.yaml", &it); err != nil {
+		return err
+	}
+
+	if it.Status.Kit != "" {
+		involved[it.Status.Kit] = true
+
+		kit := v1.NewIntegrationKit(o.Namespace, it.Status.Kit)
+		if err := o.dumpObject(c, archive, "kit.yaml", it.Status.Kit, &kit); err != nil {
+			return err
+		}
+		// The build is named after the kit
+		build := v1.Build{}
+		if err := o.dumpObject(c, archive, "build.yaml", it.Status.Kit, &build); err != nil {
+			return err
+		}
+	}
+
+	pods := corev1.PodList{}
+	if err := c.List(o.Context, &pods, k8sclient.InNamespace(o.Namespace), k8sclient.MatchingLabels{v1.IntegrationLabel: it.Name}); err != nil {
+		return errors.Wrap(err, fmt.Sprintf("could not list pods of integration %s", it.Name))
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		involved[pod.Name] = true
+		if err := archive.AddObject(path.Join("pods", pod.Name+".yaml"), pod); err != nil {
+			return err
+		}
+		for _, container := range pod.Spec.Containers {
+			if err := archive.Add(path.Join("logs", pod.Name, container.Name+".log"), o.logs(c, pod.Name, container.Name)); err != nil {
+				return err
+			}
+		}
+	}
+
+	events := corev1.EventList{}
+	if err := c.List(o.Context, &events, k8sclient.InNamespace(o.Namespace)); err != nil {
+		return errors.Wrap(err, fmt.Sprintf("could not list events in namespace %s", o.Namespace))
+	}
+	related := make([]corev1.Event, 0)
+	for _, event := range events.Items {
+		if involved[event.InvolvedObject.Name] {
+			related = append(related, event)
+		}
+	}
+	events.Items = related
+	if err := archive.AddObject("events.yaml", &events); err != nil {
+		return err
+	}
+
+	dumped := make(map[v1.ConfigurationSpec]bool)
+	for _, conf := range append(it.Spec.Configuration, it.Status.Configuration...) {
+		if dumped[conf] {
+			continue
+		}
+		dumped[conf] = true
+		switch conf.Type {
+		case "configmap":
+			cm := corev1.ConfigMap{}
+			if err := o.dumpObject(c, archive, path.Join("configmaps", conf.Value+".yaml"), conf.Value, &cm); err != nil {
+				return err
+			}
+		case "secret":
+			secret := corev1.Secret{}
+			if err := o.dumpObject(c, archive, path.Join("secrets", conf.Value+".yaml"), conf.Value, &secret); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// dumpObject adds the named object to the archive, unless it does not exist
+func (o *dumpCmdOptions) dumpObject(c client.Client, archive *dumpArchive, name string, objectName string, object runtime.Object) error {
+	key := k8sclient.ObjectKey{
+		Name:      objectName,
+		Namespace: o.Namespace,
+	}
+	if err := c.Get(o.Context, key, object); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return errors.Wrap(err, fmt.Sprintf("could not get %s in namespace %s", objectName, o.Namespace))
+	}
+
+	if secret, ok := object.(*corev1.Secret); ok {
+		redactSecret(secret)
+	}
+
+	return archive.AddObject(name, object)
+}
+
+// logs returns the recent logs of the given container, or the reason why they cannot be retrieved
+func (o *dumpCmdOptions) logs(c client.Client, pod string, container string) []byte {
+	options := corev1.PodLogOptions{
+		Container: container,
+		TailLines: &o.Tail,
+	}
+	stream, err := c.CoreV1().Pods(o.Namespace).GetLogs(pod, &options).Context(o.Context).Stream()
+	if err != nil {
+		return []byte(fmt.Sprintf("could not retrieve logs: %v\n", err))
+	}
+	defer stream.Close()
+
+	data, err := ioutil.ReadAll(stream)
+	if err != nil {
+		return append(data, []byte(fmt.Sprintf("\ncould not retrieve logs: %v\n", err))...)
+	}
+	return data
+}
+
+// redactSecret keeps the keys of the secret, but replaces their values
+func redactSecret(secret *corev1.Secret) {
+	for k := range secret.Data {
+		secret.Data[k] = []byte(dumpRedactedValue)
+	}
+	for k := range secret.StringData {
+		secret.StringData[k] = dumpRedactedValue
+	}
+	// The last applied configuration may contain the secret values
+	delete(secret.Annotations, corev1.LastAppliedConfigAnnotation)
+}
+
+type dumpArchive struct {
+	gzip *gzip.Writer
+	tar  *tar.Writer
+	time time.Time
+}
+
+func newDumpArchive(f *os.File) *dumpArchive {
+	gz := gzip.NewWriter(f)
+	return &dumpArchive{
+		gzip: gz,
+		tar:  tar.NewWriter(gz),
+		time: time.Now(),
+	}
+}
+
+func (a *dumpArchive) AddObject(name string, object runtime.Object) error {
+	data, err := kubernetes.ToYAML(object)
+	if err != nil {
+		return err
+	}
+	return a.Add(name, data)
+}
+
+func (a *dumpArchive) Add(name string, data []byte) error {
+	header := tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    int64(len(data)),
+		ModTime: a.time,
+	}
+	if err := a.tar.WriteHeader(&header); err != nil {
+		return err
+	}
+	_, err := a.tar.Write(data)
+	return err
+}
+
+func (a *dumpArchive) Close() error {
+	if err := a.tar.Close(); err != nil {
+		return err
+	}
+	return a.gzip.Close()
+}
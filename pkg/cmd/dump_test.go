@@ -0,0 +1,156 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/base64"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/test"
+)
+
+func TestDumpValidation(t *testing.T) {
+	options := dumpCmdOptions{}
+	assert.Nil(t, options.validate([]string{"my-integration"}))
+	assert.NotNil(t, options.validate(nil))
+
+	options.Tail = -1
+	assert.NotNil(t, options.validate([]string{"my-integration"}))
+}
+
+func TestDumpIntegration(t *testing.T) {
+	options, rootCmd := kamelTestPreAddCommandInit()
+
+	it := v1.NewIntegration("default", "my-integration")
+	it.Spec.Configuration = []v1.ConfigurationSpec{
+		{Type: "configmap", Value: "my-configmap"},
+		{Type: "secret", Value: "my-secret"},
+	}
+	it.Status = v1.IntegrationStatus{
+		Phase: v1.IntegrationPhaseError,
+		Kit:   "my-kit",
+	}
+	kit := v1.NewIntegrationKit("default", "my-kit")
+	build := v1.Build{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "my-kit",
+		},
+	}
+	cm := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "my-configmap",
+		},
+		Data: map[string]string{
+			"application.properties": "my.message=Hello",
+		},
+	}
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "my-secret",
+		},
+		Data: map[string][]byte{
+			"password": []byte("s3cr3t"),
+		},
+	}
+	event := corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "my-integration.1",
+		},
+		InvolvedObject: corev1.ObjectReference{
+			Name: "my-integration",
+		},
+		Message: "Integration my-integration in phase Error",
+	}
+	otherEvent := corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "my-other-integration.1",
+		},
+		InvolvedObject: corev1.ObjectReference{
+			Name: "my-other-integration",
+		},
+		Message: "Integration my-other-integration in phase Running",
+	}
+
+	fakeClient, err := test.NewFakeClient(&it, &kit, &build, &cm, &secret, &event, &otherEvent)
+	assert.Nil(t, err)
+	options._client = fakeClient
+
+	dumpCmd, _ := newCmdDump(options)
+	rootCmd.AddCommand(dumpCmd)
+
+	kamelTestPostAddCommandInit(t, rootCmd)
+
+	dir, err := ioutil.TempDir("", "camel-k-dump-")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	file := path.Join(dir, "dump.tar.gz")
+
+	_, err = test.ExecuteCommand(rootCmd, "dump", "my-integration", "-n", "default", "--file", file)
+	assert.Nil(t, err)
+
+	entries := readDumpArchive(t, file)
+	assert.Contains(t, entries, "integration.yaml")
+	assert.Contains(t, entries, "kit.yaml")
+	assert.Contains(t, entries, "build.yaml")
+	assert.Contains(t, entries["configmaps/my-configmap.yaml"], "my.message=Hello")
+	assert.Contains(t, entries["secrets/my-secret.yaml"], "password")
+	assert.NotContains(t, entries["secrets/my-secret.yaml"], base64.StdEncoding.EncodeToString([]byte("s3cr3t")))
+	assert.Contains(t, entries["secrets/my-secret.yaml"], base64.StdEncoding.EncodeToString([]byte(dumpRedactedValue)))
+	assert.Contains(t, entries["events.yaml"], "Integration my-integration in phase Error")
+	assert.NotContains(t, entries["events.yaml"], "my-other-integration")
+}
+
+func readDumpArchive(t *testing.T, file string) map[string]string {
+	f, err := os.Open(file)
+	assert.Nil(t, err)
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	assert.Nil(t, err)
+	archive := tar.NewReader(gz)
+
+	entries := make(map[string]string)
+	for {
+		header, err := archive.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.Nil(t, err)
+		data, err := ioutil.ReadAll(archive)
+		assert.Nil(t, err)
+		entries[header.Name] = string(data)
+	}
+	return entries
+}
@@ -22,16 +22,22 @@ import (
 	"text/tabwriter"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
 )
 
 type getCmdOptions struct {
 	*RootCmdOptions
+	OutputFormat string `mapstructure:"output"`
+	Selector     string `mapstructure:"selector"`
 }
 
 func newCmdGet(rootCmdOptions *RootCmdOptions) (*cobra.Command, *getCmdOptions) {
@@ -43,12 +49,33 @@ func newCmdGet(rootCmdOptions *RootCmdOptions) (*cobra.Command, *getCmdOptions)
 		Short:   "Get integrations deployed on Kubernetes",
 		Long:    `Get the status of integrations deployed on on Kubernetes.`,
 		PreRunE: decode(&options),
-		RunE:    options.run,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.validate(); err != nil {
+				return err
+			}
+			return options.run(cmd, args)
+		},
 	}
 
+	cmd.Flags().StringP("output", "o", "", "Output format. One of: json|yaml")
+	cmd.Flags().StringP("selector", "l", "", "Selector (label query) to filter on, supports '=', '==', '!=', 'in', 'notin' and 'exists'. E.g. \"-l app=orders\"")
+
 	return &cmd, &options
 }
 
+func (o *getCmdOptions) validate() error {
+	if _, err := labels.Parse(o.Selector); err != nil {
+		return errors.Wrapf(err, "invalid selector %s", o.Selector)
+	}
+
+	switch o.OutputFormat {
+	case "", "json", "yaml":
+		return nil
+	default:
+		return fmt.Errorf("invalid output format option '%s', should be one of: yaml|json", o.OutputFormat)
+	}
+}
+
 func (o *getCmdOptions) run(cmd *cobra.Command, args []string) error {
 	c, err := o.GetCmdClient()
 	if err != nil {
@@ -72,12 +99,29 @@ func (o *getCmdOptions) run(cmd *cobra.Command, args []string) error {
 			"metadata.name": args[0],
 		})
 	}
+	if o.Selector != "" {
+		selector, err := labels.Parse(o.Selector)
+		if err != nil {
+			return err
+		}
+		options = append(options, &k8sclient.ListOptions{
+			LabelSelector: selector,
+		})
+	}
 
 	err = c.List(o.Context, &integrationList, options...)
 	if err != nil {
 		return err
 	}
 
+	if o.OutputFormat != "" {
+		name := ""
+		if len(args) == 1 {
+			name = args[0]
+		}
+		return o.print(cmd, integrationList, name)
+	}
+
 	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 8, 1, '\t', 0)
 	fmt.Fprintln(w, "NAME\tPHASE\tKIT")
 	for _, integration := range integrationList.Items {
@@ -87,3 +131,36 @@ func (o *getCmdOptions) run(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// print outputs the integrations in the requested format, either as a list or, when
+// a single integration has been requested, as the integration itself
+func (o *getCmdOptions) print(cmd *cobra.Command, integrationList v1.IntegrationList, name string) error {
+	for i := range integrationList.Items {
+		integrationList.Items[i].APIVersion = v1.SchemeGroupVersion.String()
+		integrationList.Items[i].Kind = v1.IntegrationKind
+	}
+
+	var obj runtime.Object = &integrationList
+	if name != "" {
+		if len(integrationList.Items) == 0 {
+			return fmt.Errorf("integration %s not found", name)
+		}
+		obj = &integrationList.Items[0]
+	} else {
+		integrationList.Kind = "IntegrationList"
+	}
+
+	var data []byte
+	var err error
+	if o.OutputFormat == "json" {
+		data, err = kubernetes.ToJSON(obj)
+	} else {
+		data, err = kubernetes.ToYAML(obj)
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(cmd.OutOrStdout(), string(data))
+	return nil
+}
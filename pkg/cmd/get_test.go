@@ -0,0 +1,148 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/test"
+)
+
+func addTestGetCmd(t *testing.T, options *RootCmdOptions, rootCmd *cobra.Command) *getCmdOptions {
+	fakeClient, err := test.NewFakeClient(&v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "my-integration",
+			Labels: map[string]string{
+				"app": "orders",
+			},
+		},
+		Status: v1.IntegrationStatus{
+			Phase: v1.IntegrationPhaseRunning,
+		},
+	}, &v1.Integration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "other-integration",
+		},
+		Status: v1.IntegrationStatus{
+			Phase: v1.IntegrationPhaseBuildingKit,
+		},
+	})
+	assert.Nil(t, err)
+	options._client = fakeClient
+
+	getCmd, getCmdOptions := newCmdGet(options)
+	rootCmd.AddCommand(getCmd)
+	return getCmdOptions
+}
+
+func TestGetTable(t *testing.T) {
+	options, rootCmd := kamelTestPreAddCommandInit()
+
+	addTestGetCmd(t, options, rootCmd)
+
+	kamelTestPostAddCommandInit(t, rootCmd)
+
+	output, err := test.ExecuteCommand(rootCmd, "get", "-n", "default")
+
+	assert.Nil(t, err)
+	assert.Contains(t, output, "NAME")
+	assert.Contains(t, output, "my-integration")
+	assert.Contains(t, output, "Running")
+}
+
+func TestGetOutputYAML(t *testing.T) {
+	options, rootCmd := kamelTestPreAddCommandInit()
+
+	addTestGetCmd(t, options, rootCmd)
+
+	kamelTestPostAddCommandInit(t, rootCmd)
+
+	output, err := test.ExecuteCommand(rootCmd, "get", "-n", "default", "-o", "yaml")
+
+	assert.Nil(t, err)
+	assert.Contains(t, output, "kind: IntegrationList")
+	assert.Contains(t, output, "name: my-integration")
+}
+
+func TestGetOutputJSON(t *testing.T) {
+	options, rootCmd := kamelTestPreAddCommandInit()
+
+	addTestGetCmd(t, options, rootCmd)
+
+	kamelTestPostAddCommandInit(t, rootCmd)
+
+	output, err := test.ExecuteCommand(rootCmd, "get", "-n", "default", "-o", "json")
+
+	assert.Nil(t, err)
+	assert.Contains(t, output, `"kind":"IntegrationList"`)
+	assert.Contains(t, output, `"name":"my-integration"`)
+}
+
+func TestGetWithSelector(t *testing.T) {
+	options, rootCmd := kamelTestPreAddCommandInit()
+
+	addTestGetCmd(t, options, rootCmd)
+
+	kamelTestPostAddCommandInit(t, rootCmd)
+
+	output, err := test.ExecuteCommand(rootCmd, "get", "-n", "default", "-l", "app=orders")
+
+	assert.Nil(t, err)
+	assert.Contains(t, output, "my-integration")
+	assert.NotContains(t, output, "other-integration")
+}
+
+func TestGetWithInvalidSelector(t *testing.T) {
+	options, rootCmd := kamelTestPreAddCommandInit()
+
+	addTestGetCmd(t, options, rootCmd)
+
+	kamelTestPostAddCommandInit(t, rootCmd)
+
+	_, err := test.ExecuteCommand(rootCmd, "get", "-n", "default", "-l", "app in orders")
+
+	assert.NotNil(t, err)
+}
+
+func TestGetInvalidOutput(t *testing.T) {
+	options, rootCmd := kamelTestPreAddCommandInit()
+
+	addTestGetCmd(t, options, rootCmd)
+
+	kamelTestPostAddCommandInit(t, rootCmd)
+
+	_, err := test.ExecuteCommand(rootCmd, "get", "-n", "default", "-o", "wide")
+
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,201 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/apache/camel-k/pkg/builder"
+	"github.com/apache/camel-k/pkg/util/camel"
+	"github.com/apache/camel-k/pkg/util/defaults"
+)
+
+func newCmdLocalBuild(rootCmdOptions *RootCmdOptions) (*cobra.Command, *localBuildCmdOptions) {
+	options := localBuildCmdOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "build [files to build]",
+		Short:   "Build integration images locally",
+		Long:    `Build integration images locally, using the local Maven installation and container tool.`,
+		PreRunE: decode(&options),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.validate(args); err != nil {
+				return err
+			}
+			return options.run(cmd, args)
+		},
+		Annotations: map[string]string{
+			offlineCommandLabel: "true",
+		},
+	}
+
+	cmd.Flags().String("image", "", "The name of the image to build, e.g. \"quay.io/my-org/my-integration:1.0\"")
+	cmd.Flags().String("base-image", defaults.BaseImage, "The base image the integration image is built from")
+	cmd.Flags().String("container-tool", "docker", "The container tool used to build the image, e.g. \"docker\" or \"podman\"")
+	cmd.Flags().StringArrayP("dependency", "d", nil, "An external library that should be included. E.g. for Maven dependencies \"mvn:org.my/app:1.0\"")
+	cmd.Flags().StringArrayP("property", "p", nil, "Add a camel property")
+	cmd.Flags().StringArray("property-file", nil, "Bind a property file to the integration. E.g. \"--property-file integration.properties\"")
+	cmd.Flags().StringArray("maven-repository", nil, "Add a maven repository")
+	cmd.Flags().StringArray("source", nil, "Add source file to your integration, this is added to the list of files listed as arguments of the command")
+
+	return &cmd, &options
+}
+
+type localBuildCmdOptions struct {
+	*RootCmdOptions
+	Image         string   `mapstructure:"image"`
+	BaseImage     string   `mapstructure:"base-image"`
+	ContainerTool string   `mapstructure:"container-tool"`
+	Dependencies  []string `mapstructure:"dependencies"`
+	Properties    []string `mapstructure:"properties"`
+	PropertyFiles []string `mapstructure:"property-files"`
+	Repositories  []string `mapstructure:"maven-repositories"`
+	Sources       []string `mapstructure:"sources"`
+}
+
+func (o *localBuildCmdOptions) validate(args []string) error {
+	if len(args)+len(o.Sources) == 0 {
+		return errors.New("local build expects at least 1 source, received 0")
+	}
+
+	if o.Image == "" {
+		return errors.New("the image to build must be specified with the image option")
+	}
+
+	if o.BaseImage == "" {
+		return errors.New("the base image must not be empty")
+	}
+
+	for _, fileName := range o.PropertyFiles {
+		if !strings.HasSuffix(fileName, ".properties") {
+			return fmt.Errorf("supported property files must have a .properties extension: %s", fileName)
+		}
+	}
+
+	return nil
+}
+
+func (o *localBuildCmdOptions) run(cmd *cobra.Command, args []string) error {
+	files := make([]string, 0, len(args)+len(o.Sources))
+	files = append(files, args...)
+	files = append(files, o.Sources...)
+
+	sources, err := loadLocalSources(files)
+	if err != nil {
+		return err
+	}
+
+	catalog, err := camel.DefaultCatalog()
+	if err != nil {
+		return err
+	}
+
+	dir, err := ioutil.TempDir("", "kamel-local-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	dependencies := computeLocalDependencies(catalog, sources, o.Dependencies)
+
+	artifacts, err := resolveLocalDependencies(o.Context, catalog, dependencies, o.Repositories, dir)
+	if err != nil {
+		return err
+	}
+
+	conf := path.Join(dir, "application.properties")
+	if err := writeLocalProperties(conf, o.PropertyFiles, o.Properties); err != nil {
+		return err
+	}
+	confContent, err := ioutil.ReadFile(conf)
+	if err != nil {
+		return err
+	}
+
+	resources := []builder.Resource{
+		{
+			Target:  "application.properties",
+			Content: confContent,
+		},
+	}
+
+	routes := make([]string, 0, len(sources))
+	for i, s := range sources {
+		target := path.Join("sources", fmt.Sprintf("i-source-%03d", i), s.Name)
+		resources = append(resources, builder.Resource{
+			Target:  target,
+			Content: []byte(s.Content),
+		})
+
+		routes = append(routes, fmt.Sprintf("file:%s?language=%s", path.Join("/deployments", target), s.InferLanguage()))
+	}
+
+	// the image context is assembled the same way the builder does for integration kits
+	bc := builder.Context{
+		Catalog:   catalog,
+		Path:      dir,
+		BaseImage: o.BaseImage,
+		Artifacts: artifacts,
+		Resources: resources,
+	}
+	if err := builder.Steps.StandardImageContext.Execute(&bc); err != nil {
+		return err
+	}
+
+	// the integration image runs standalone, with the sources and the configuration
+	// it has been built with
+	contextDir := path.Join(dir, "context")
+	dockerfile, err := os.OpenFile(path.Join(contextDir, "Dockerfile"), os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(dockerfile, `
+		WORKDIR /deployments
+		ENV CAMEL_K_ROUTES=%q CAMEL_K_CONF="/deployments/application.properties"
+		ENTRYPOINT ["java", "-cp", "dependencies/*", %q]
+	`, strings.Join(routes, ","), catalog.Runtime.ApplicationClass)
+	if closeErr := dockerfile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	// nolint: gosec
+	c := exec.CommandContext(o.Context, o.ContainerTool, "build", "-t", o.Image, contextDir)
+	c.Stdout = cmd.OutOrStdout()
+	c.Stderr = cmd.ErrOrStderr()
+
+	if err := c.Run(); err != nil {
+		return errors.Wrapf(err, "unable to build image %s with %s", o.Image, o.ContainerTool)
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Image %s built\n", o.Image)
+
+	return nil
+}
@@ -0,0 +1,74 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apache/camel-k/pkg/util/defaults"
+	"github.com/apache/camel-k/pkg/util/test"
+)
+
+func addTestLocalBuildCmd(options *RootCmdOptions, rootCmd *cobra.Command) *localBuildCmdOptions {
+	//add a testing version of local build Command
+	localBuildCmd, localBuildCmdOptions := newCmdLocalBuild(options)
+	localBuildCmd.RunE = func(c *cobra.Command, args []string) error {
+		return localBuildCmdOptions.validate(args)
+	}
+	localBuildCmd.Args = test.ArbitraryArgs
+	localCmd := cobra.Command{
+		Use: "local",
+	}
+	localCmd.AddCommand(localBuildCmd)
+	rootCmd.AddCommand(&localCmd)
+	return localBuildCmdOptions
+}
+
+func TestLocalBuildFlags(t *testing.T) {
+	options, rootCmd := kamelTestPreAddCommandInit()
+
+	localBuildCmdOptions := addTestLocalBuildCmd(options, rootCmd)
+
+	kamelTestPostAddCommandInit(t, rootCmd)
+
+	_, err := test.ExecuteCommand(rootCmd, "local", "build", "route.java",
+		"--image", "quay.io/my-org/route:1.0",
+		"--container-tool", "podman",
+		"--dependency", "mvn:org.my/app:1.0")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "quay.io/my-org/route:1.0", localBuildCmdOptions.Image)
+	assert.Equal(t, defaults.BaseImage, localBuildCmdOptions.BaseImage)
+	assert.Equal(t, "podman", localBuildCmdOptions.ContainerTool)
+	assert.Equal(t, []string{"mvn:org.my/app:1.0"}, localBuildCmdOptions.Dependencies)
+}
+
+func TestLocalBuildWithoutImage(t *testing.T) {
+	options, rootCmd := kamelTestPreAddCommandInit()
+
+	addTestLocalBuildCmd(options, rootCmd)
+
+	kamelTestPostAddCommandInit(t, rootCmd)
+
+	_, err := test.ExecuteCommand(rootCmd, "local", "build", "route.java")
+
+	assert.NotNil(t, err)
+}
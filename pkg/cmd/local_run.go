@@ -0,0 +1,156 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/apache/camel-k/pkg/util/camel"
+)
+
+func newCmdLocalRun(rootCmdOptions *RootCmdOptions) (*cobra.Command, *localRunCmdOptions) {
+	options := localRunCmdOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "run [files to run]",
+		Short:   "Run integrations locally",
+		Long:    `Run integrations locally, in a JVM forked from the command, using the dependencies resolved from the local Maven installation.`,
+		PreRunE: decode(&options),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.validate(args); err != nil {
+				return err
+			}
+			return options.run(cmd, args)
+		},
+		Annotations: map[string]string{
+			offlineCommandLabel: "true",
+		},
+	}
+
+	cmd.Flags().StringArrayP("dependency", "d", nil, "An external library that should be included. E.g. for Maven dependencies \"mvn:org.my/app:1.0\"")
+	cmd.Flags().StringArrayP("property", "p", nil, "Add a camel property")
+	cmd.Flags().StringArray("property-file", nil, "Bind a property file to the integration. E.g. \"--property-file integration.properties\"")
+	cmd.Flags().StringArray("maven-repository", nil, "Add a maven repository")
+	cmd.Flags().StringArray("source", nil, "Add source file to your integration, this is added to the list of files listed as arguments of the command")
+
+	return &cmd, &options
+}
+
+type localRunCmdOptions struct {
+	*RootCmdOptions
+	Dependencies  []string `mapstructure:"dependencies"`
+	Properties    []string `mapstructure:"properties"`
+	PropertyFiles []string `mapstructure:"property-files"`
+	Repositories  []string `mapstructure:"maven-repositories"`
+	Sources       []string `mapstructure:"sources"`
+}
+
+func (o *localRunCmdOptions) validate(args []string) error {
+	if len(args)+len(o.Sources) == 0 {
+		return errors.New("local run expects at least 1 source, received 0")
+	}
+
+	for _, fileName := range o.PropertyFiles {
+		if !strings.HasSuffix(fileName, ".properties") {
+			return fmt.Errorf("supported property files must have a .properties extension: %s", fileName)
+		}
+	}
+
+	return nil
+}
+
+func (o *localRunCmdOptions) run(cmd *cobra.Command, args []string) error {
+	files := make([]string, 0, len(args)+len(o.Sources))
+	files = append(files, args...)
+	files = append(files, o.Sources...)
+
+	sources, err := loadLocalSources(files)
+	if err != nil {
+		return err
+	}
+
+	catalog, err := camel.DefaultCatalog()
+	if err != nil {
+		return err
+	}
+
+	dir, err := ioutil.TempDir("", "kamel-local-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	dependencies := computeLocalDependencies(catalog, sources, o.Dependencies)
+
+	artifacts, err := resolveLocalDependencies(o.Context, catalog, dependencies, o.Repositories, dir)
+	if err != nil {
+		return err
+	}
+
+	routes := make([]string, 0, len(sources))
+	for i, s := range sources {
+		fileName := path.Join(dir, "sources", fmt.Sprintf("i-source-%03d", i), s.Name)
+		if err := os.MkdirAll(path.Dir(fileName), 0700); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(fileName, []byte(s.Content), 0600); err != nil {
+			return err
+		}
+
+		routes = append(routes, fmt.Sprintf("file:%s?language=%s", fileName, s.InferLanguage()))
+	}
+
+	conf := path.Join(dir, "application.properties")
+	if err := writeLocalProperties(conf, o.PropertyFiles, o.Properties); err != nil {
+		return err
+	}
+
+	classpath := make([]string, 0, len(artifacts))
+	for _, a := range artifacts {
+		classpath = append(classpath, a.Location)
+	}
+
+	java := "java"
+	if home, ok := os.LookupEnv("JAVA_HOME"); ok {
+		java = filepath.Join(home, "bin", "java")
+	}
+
+	// nolint: gosec
+	c := exec.CommandContext(o.Context, java,
+		"-cp", strings.Join(classpath, string(os.PathListSeparator)),
+		catalog.Runtime.ApplicationClass)
+	c.Env = append(os.Environ(),
+		"CAMEL_K_ROUTES="+strings.Join(routes, ","),
+		"CAMEL_K_CONF="+conf)
+	c.Stdin = cmd.InOrStdin()
+	c.Stdout = cmd.OutOrStdout()
+	c.Stderr = cmd.ErrOrStderr()
+
+	return c.Run()
+}
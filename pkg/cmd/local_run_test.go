@@ -0,0 +1,74 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apache/camel-k/pkg/util/test"
+)
+
+func addTestLocalRunCmd(options *RootCmdOptions, rootCmd *cobra.Command) *localRunCmdOptions {
+	//add a testing version of local run Command
+	localRunCmd, localRunCmdOptions := newCmdLocalRun(options)
+	localRunCmd.RunE = func(c *cobra.Command, args []string) error {
+		return localRunCmdOptions.validate(args)
+	}
+	localRunCmd.Args = test.ArbitraryArgs
+	localCmd := cobra.Command{
+		Use: "local",
+	}
+	localCmd.AddCommand(localRunCmd)
+	rootCmd.AddCommand(&localCmd)
+	return localRunCmdOptions
+}
+
+func TestLocalRunFlags(t *testing.T) {
+	options, rootCmd := kamelTestPreAddCommandInit()
+
+	localRunCmdOptions := addTestLocalRunCmd(options, rootCmd)
+
+	kamelTestPostAddCommandInit(t, rootCmd)
+
+	_, err := test.ExecuteCommand(rootCmd, "local", "run", "route.java",
+		"--dependency", "mvn:org.my/app:1.0",
+		"--property", "key=value",
+		"--property-file", "integration.properties",
+		"--source", "additional-source.java")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"mvn:org.my/app:1.0"}, localRunCmdOptions.Dependencies)
+	assert.Equal(t, []string{"key=value"}, localRunCmdOptions.Properties)
+	assert.Equal(t, []string{"integration.properties"}, localRunCmdOptions.PropertyFiles)
+	assert.Equal(t, []string{"additional-source.java"}, localRunCmdOptions.Sources)
+}
+
+func TestLocalRunWithoutSources(t *testing.T) {
+	options, rootCmd := kamelTestPreAddCommandInit()
+
+	addTestLocalRunCmd(options, rootCmd)
+
+	kamelTestPostAddCommandInit(t, rootCmd)
+
+	_, err := test.ExecuteCommand(rootCmd, "local", "run")
+
+	assert.NotNil(t, err)
+}
@@ -35,7 +35,7 @@ func newCmdLog(rootCmdOptions *RootCmdOptions) (*cobra.Command, *logCmdOptions)
 	cmd := cobra.Command{
 		Use:     "log integration",
 		Short:   "Print the logs of an integration",
-		Long:    `Print the logs of an integration.`,
+		Long:    `Print the logs of all the pods of an integration, prefixing each line with the name of the pod.`,
 		Aliases: []string{"logs"},
 		Args:    options.validate,
 		PreRunE: decode(&options),
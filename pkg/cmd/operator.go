@@ -18,6 +18,7 @@ limitations under the License.
 package cmd
 
 import (
+	"github.com/apache/camel-k/pkg/client"
 	"github.com/apache/camel-k/pkg/cmd/operator"
 	"github.com/spf13/cobra"
 )
@@ -33,5 +34,8 @@ func newCmdOperator() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().DurationVar(&client.DiscoveryTimeout, "discovery-timeout", client.DefaultDiscoveryTimeout,
+		"The timeout of the Kubernetes discovery API calls, that are retried when they time out")
+
 	return &cmd
 }
@@ -0,0 +1,163 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/client"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+)
+
+func newCmdPromote(rootCmdOptions *RootCmdOptions) (*cobra.Command, *promoteCmdOptions) {
+	options := promoteCmdOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+	cmd := cobra.Command{
+		Use:   "promote integration --to namespace",
+		Short: "Promote an integration to another namespace",
+		Long: `Promote an integration to another namespace, optionally in another cluster.
+
+The integration is recreated in the target namespace with the same sources and traits,
+and runs the container image of its kit in the source namespace, so that it is not built again.
+The image must therefore be pullable from the target namespace.`,
+		PreRunE: decode(&options),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.validate(args); err != nil {
+				return err
+			}
+			return options.promote(cmd, args)
+		},
+	}
+
+	cmd.Flags().String("to", "", "The namespace the integration is promoted to")
+	cmd.Flags().String("to-context", "", "The kubeconfig context of the cluster the integration is promoted to (default to the current context)")
+
+	return &cmd, &options
+}
+
+type promoteCmdOptions struct {
+	*RootCmdOptions
+	To        string `mapstructure:"to"`
+	ToContext string `mapstructure:"to-context"`
+}
+
+func (o *promoteCmdOptions) validate(args []string) error {
+	if len(args) != 1 {
+		return errors.New("promote expects exactly one integration name")
+	}
+	if o.To == "" {
+		return errors.New("the target namespace must be set with the to flag")
+	}
+	if o.To == o.Namespace && o.ToContext == "" {
+		return errors.New("the target namespace must be different from the integration namespace")
+	}
+
+	return nil
+}
+
+func (o *promoteCmdOptions) promote(_ *cobra.Command, args []string) error {
+	c, err := o.GetCmdClient()
+	if err != nil {
+		return err
+	}
+
+	it := v1.NewIntegration(o.Namespace, args[0])
+	key := k8sclient.ObjectKey{
+		Name:      args[0],
+		Namespace: o.Namespace,
+	}
+	if err := c.Get(o.Context, key, &it); err != nil {
+		return errors.Wrap(err, fmt.Sprintf("could not find integration %s in namespace %s", it.Name, o.Namespace))
+	}
+
+	image, err := o.resolveImage(c, it)
+	if err != nil {
+		return err
+	}
+
+	target := c
+	if o.ToContext != "" {
+		if target, err = client.NewOutOfClusterClientForContext(o.KubeConfig, o.ToContext); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("could not connect to the cluster of context %s", o.ToContext))
+		}
+	}
+
+	kit := o.promotedKit(it, image)
+	if err := kubernetes.ReplaceResource(o.Context, target, &kit); err != nil {
+		return err
+	}
+
+	promoted := o.promotedIntegration(it, kit.Name)
+	if err := kubernetes.ReplaceResource(o.Context, target, &promoted); err != nil {
+		return err
+	}
+
+	fmt.Printf("integration \"%s\" promoted to namespace %s\n", it.Name, o.To)
+	return nil
+}
+
+// resolveImage returns the container image of the kit the integration runs with
+func (o *promoteCmdOptions) resolveImage(c client.Client, it v1.Integration) (string, error) {
+	if it.Status.Kit == "" {
+		return "", fmt.Errorf("integration %s has no resolved kit, it must be built before being promoted", it.Name)
+	}
+
+	kit := v1.NewIntegrationKit(o.Namespace, it.Status.Kit)
+	key := k8sclient.ObjectKey{
+		Name:      it.Status.Kit,
+		Namespace: o.Namespace,
+	}
+	if err := c.Get(o.Context, key, &kit); err != nil {
+		return "", errors.Wrap(err, fmt.Sprintf("could not find integration kit %s in namespace %s", it.Status.Kit, o.Namespace))
+	}
+	if kit.Status.Phase != v1.IntegrationKitPhaseReady || kit.Status.Image == "" {
+		return "", fmt.Errorf("integration kit %s is not ready, the integration must be built before being promoted", kit.Name)
+	}
+
+	return kit.Status.Image, nil
+}
+
+func (o *promoteCmdOptions) promotedKit(it v1.Integration, image string) v1.IntegrationKit {
+	kit := v1.NewIntegrationKit(o.To, it.Status.Kit)
+	// The kit is marked as external as the information about the classpath is not promoted,
+	// so that it cannot be used as base for other kits
+	kit.Labels = map[string]string{
+		"camel.apache.org/kit.type": v1.IntegrationKitTypeExternal,
+	}
+	kit.Spec = v1.IntegrationKitSpec{
+		Image: image,
+	}
+	return kit
+}
+
+func (o *promoteCmdOptions) promotedIntegration(it v1.Integration, kit string) v1.Integration {
+	promoted := v1.NewIntegration(o.To, it.Name)
+	promoted.Labels = it.Labels
+	promoted.Annotations = it.Annotations
+	// The status is left empty, so that the integration is initialized in the target namespace
+	promoted.Spec = *it.Spec.DeepCopy()
+	promoted.Spec.Kit = kit
+	return promoted
+}
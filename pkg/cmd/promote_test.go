@@ -0,0 +1,131 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/test"
+)
+
+func TestPromoteValidation(t *testing.T) {
+	options := promoteCmdOptions{RootCmdOptions: &RootCmdOptions{Namespace: "dev"}}
+	assert.NotNil(t, options.validate([]string{"my-integration"}))
+
+	options.To = "dev"
+	assert.NotNil(t, options.validate([]string{"my-integration"}))
+
+	options.ToContext = "prod"
+	assert.Nil(t, options.validate([]string{"my-integration"}))
+
+	options.To = "staging"
+	options.ToContext = ""
+	assert.Nil(t, options.validate([]string{"my-integration"}))
+	assert.NotNil(t, options.validate(nil))
+	assert.NotNil(t, options.validate([]string{"my-integration", "my-other-integration"}))
+}
+
+func TestPromoteIntegration(t *testing.T) {
+	options, rootCmd := kamelTestPreAddCommandInit()
+
+	it := v1.NewIntegration("dev", "my-integration")
+	it.Labels = map[string]string{
+		"app": "my-app",
+	}
+	it.Spec = v1.IntegrationSpec{
+		Sources: []v1.SourceSpec{
+			{
+				DataSpec: v1.DataSpec{
+					Name:    "routes.groovy",
+					Content: "from('timer:tick').log('Hello')",
+				},
+			},
+		},
+		Traits: map[string]v1.TraitSpec{
+			"jvm": test.TraitSpecFromMap(t, map[string]interface{}{
+				"debug": true,
+			}),
+		},
+	}
+	it.Status = v1.IntegrationStatus{
+		Phase: v1.IntegrationPhaseRunning,
+		Kit:   "my-kit",
+	}
+	kit := v1.NewIntegrationKit("dev", "my-kit")
+	kit.Labels = map[string]string{
+		"camel.apache.org/kit.type": v1.IntegrationKitTypePlatform,
+	}
+	kit.Status = v1.IntegrationKitStatus{
+		Phase: v1.IntegrationKitPhaseReady,
+		Image: "my-registry/my-kit:1",
+	}
+
+	fakeClient, err := test.NewFakeClient(&it, &kit)
+	assert.Nil(t, err)
+	options._client = fakeClient
+
+	promoteCmd, _ := newCmdPromote(options)
+	rootCmd.AddCommand(promoteCmd)
+
+	kamelTestPostAddCommandInit(t, rootCmd)
+
+	_, err = test.ExecuteCommand(rootCmd, "promote", "my-integration", "--to", "staging", "-n", "dev")
+	assert.Nil(t, err)
+
+	promoted := v1.NewIntegration("staging", "my-integration")
+	key := k8sclient.ObjectKey{Namespace: "staging", Name: "my-integration"}
+	assert.Nil(t, fakeClient.Get(context.TODO(), key, &promoted))
+	assert.Equal(t, "my-app", promoted.Labels["app"])
+	assert.Equal(t, it.Spec.Sources, promoted.Spec.Sources)
+	assert.Equal(t, it.Spec.Traits, promoted.Spec.Traits)
+	assert.Equal(t, "my-kit", promoted.Spec.Kit)
+	assert.Equal(t, v1.IntegrationStatus{}, promoted.Status)
+
+	promotedKit := v1.NewIntegrationKit("staging", "my-kit")
+	key = k8sclient.ObjectKey{Namespace: "staging", Name: "my-kit"}
+	assert.Nil(t, fakeClient.Get(context.TODO(), key, &promotedKit))
+	assert.Equal(t, v1.IntegrationKitTypeExternal, promotedKit.Labels["camel.apache.org/kit.type"])
+	assert.Equal(t, "my-registry/my-kit:1", promotedKit.Spec.Image)
+}
+
+func TestPromoteIntegrationWithoutKitFails(t *testing.T) {
+	options, rootCmd := kamelTestPreAddCommandInit()
+
+	it := v1.NewIntegration("dev", "my-integration")
+	it.Status = v1.IntegrationStatus{
+		Phase: v1.IntegrationPhaseBuildingKit,
+	}
+
+	fakeClient, err := test.NewFakeClient(&it)
+	assert.Nil(t, err)
+	options._client = fakeClient
+
+	promoteCmd, _ := newCmdPromote(options)
+	rootCmd.AddCommand(promoteCmd)
+
+	kamelTestPostAddCommandInit(t, rootCmd)
+
+	_, err = test.ExecuteCommand(rootCmd, "promote", "my-integration", "--to", "staging", "-n", "dev")
+	assert.NotNil(t, err)
+}
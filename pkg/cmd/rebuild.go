@@ -19,10 +19,12 @@ package cmd
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
@@ -36,26 +38,44 @@ func newCmdRebuild(rootCmdOptions *RootCmdOptions) (*cobra.Command, *rebuildCmdO
 	cmd := cobra.Command{
 		Use:     "rebuild [integration]",
 		Short:   "Clear the state of integrations to rebuild them",
-		Long:    `Clear the state of one or more integrations causing a rebuild.`,
+		Long:    `Clear the state of one or more integrations, and of the kits they created, forcing a fresh build.`,
 		PreRunE: decode(&options),
-		RunE:    options.rebuild,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.validate(args); err != nil {
+				return err
+			}
+			return options.rebuild(cmd, args)
+		},
 	}
 
+	cmd.Flags().Bool("all", false, "Rebuild all integrations")
+
 	return &cmd, &options
 }
 
 type rebuildCmdOptions struct {
 	*RootCmdOptions
+	RebuildAll bool `mapstructure:"all"`
+}
+
+func (o *rebuildCmdOptions) validate(args []string) error {
+	if o.RebuildAll && len(args) > 0 {
+		return errors.New("invalid combination: both all flag and named integrations are set")
+	}
+	return nil
 }
 
-func (o *rebuildCmdOptions) rebuild(_ *cobra.Command, args []string) error {
+func (o *rebuildCmdOptions) rebuild(cmd *cobra.Command, args []string) error {
 	c, err := o.GetCmdClient()
 	if err != nil {
 		return err
 	}
 
 	var integrations []v1.Integration
-	if len(args) == 0 {
+	if o.RebuildAll || len(args) == 0 {
+		if !o.RebuildAll {
+			fmt.Println("Warning: rebuilding all integrations when none is named is deprecated, use the --all flag instead")
+		}
 		if integrations, err = o.listAllIntegrations(c); err != nil {
 			return err
 		}
@@ -65,7 +85,7 @@ func (o *rebuildCmdOptions) rebuild(_ *cobra.Command, args []string) error {
 		}
 	}
 
-	if err = o.rebuildIntegrations(c, integrations); err != nil {
+	if err = o.rebuildIntegrations(cmd.OutOrStdout(), c, integrations); err != nil {
 		return err
 	}
 
@@ -97,9 +117,25 @@ func (o *rebuildCmdOptions) getIntegrations(c client.Client, names []string) ([]
 	return ints, nil
 }
 
-func (o *rebuildCmdOptions) rebuildIntegrations(c k8sclient.StatusClient, integrations []v1.Integration) error {
+func (o *rebuildCmdOptions) rebuildIntegrations(out io.Writer, c client.Client, integrations []v1.Integration) error {
+	kits := make(map[string]bool)
+	owners := make(map[string]bool, len(integrations))
+	for _, it := range integrations {
+		owners[it.Name] = true
+	}
+
 	for _, i := range integrations {
 		it := i
+
+		// The kit is reset first, so that the integration does not get associated
+		// again with the kit, before its rebuild is triggered
+		if it.Status.Kit != "" && !kits[it.Status.Kit] {
+			if err := o.rebuildKit(out, c, it.Status.Kit, owners); err != nil {
+				return err
+			}
+			kits[it.Status.Kit] = true
+		}
+
 		it.Status = v1.IntegrationStatus{}
 		if err := c.Status().Update(o.Context, &it); err != nil {
 			return errors.Wrap(err, fmt.Sprintf("could not rebuild integration %s in namespace %s", it.Name, o.Namespace))
@@ -107,3 +143,36 @@ func (o *rebuildCmdOptions) rebuildIntegrations(c k8sclient.StatusClient, integr
 	}
 	return nil
 }
+
+// rebuildKit resets the kit, if it has been created by one of the given integrations.
+// Other kits may be shared with integrations that are not rebuilt, and are left untouched,
+// the integrations being reset look up a matching kit again.
+func (o *rebuildCmdOptions) rebuildKit(out io.Writer, c client.Client, name string, owners map[string]bool) error {
+	kit := v1.NewIntegrationKit(o.Namespace, name)
+	key := k8sclient.ObjectKey{
+		Name:      name,
+		Namespace: o.Namespace,
+	}
+	if err := c.Get(o.Context, key, &kit); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return errors.Wrap(err, fmt.Sprintf("could not find integration kit %s in namespace %s", name, o.Namespace))
+	}
+
+	// Kits created from an existing image cannot be rebuilt
+	if kit.Spec.Image != "" {
+		return nil
+	}
+
+	if kit.Labels["camel.apache.org/created.by.kind"] != v1.IntegrationKind || !owners[kit.Labels["camel.apache.org/created.by.name"]] {
+		fmt.Fprintf(out, "Warning: integration kit %s is not rebuilt, as it has not been created by the rebuilt integrations, and may be matched again\n", name)
+		return nil
+	}
+
+	kit.Status = v1.IntegrationKitStatus{}
+	if err := c.Status().Update(o.Context, &kit); err != nil {
+		return errors.Wrap(err, fmt.Sprintf("could not rebuild integration kit %s in namespace %s", name, o.Namespace))
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/test"
+)
+
+func TestRebuildValidation(t *testing.T) {
+	options := rebuildCmdOptions{}
+	assert.Nil(t, options.validate(nil))
+	assert.Nil(t, options.validate([]string{"my-integration"}))
+
+	options = rebuildCmdOptions{RebuildAll: true}
+	assert.Nil(t, options.validate(nil))
+	assert.NotNil(t, options.validate([]string{"my-integration"}))
+}
+
+func TestRebuildIntegrationAndKit(t *testing.T) {
+	options, rootCmd := kamelTestPreAddCommandInit()
+
+	it := v1.NewIntegration("default", "my-integration")
+	it.Status = v1.IntegrationStatus{
+		Phase: v1.IntegrationPhaseRunning,
+		Kit:   "my-kit",
+	}
+	kit := v1.NewIntegrationKit("default", "my-kit")
+	kit.Labels = map[string]string{
+		"camel.apache.org/kit.type":        v1.IntegrationKitTypePlatform,
+		"camel.apache.org/created.by.kind": v1.IntegrationKind,
+		"camel.apache.org/created.by.name": "my-integration",
+	}
+	kit.Status = v1.IntegrationKitStatus{
+		Phase: v1.IntegrationKitPhaseReady,
+		Image: "my-registry/my-kit:1",
+	}
+
+	fakeClient, err := test.NewFakeClient(&it, &kit)
+	assert.Nil(t, err)
+	options._client = fakeClient
+
+	rebuildCmd, _ := newCmdRebuild(options)
+	rootCmd.AddCommand(rebuildCmd)
+
+	kamelTestPostAddCommandInit(t, rootCmd)
+
+	_, err = test.ExecuteCommand(rootCmd, "rebuild", "my-integration", "-n", "default")
+	assert.Nil(t, err)
+
+	key := k8sclient.ObjectKey{Namespace: "default", Name: "my-integration"}
+	assert.Nil(t, fakeClient.Get(context.TODO(), key, &it))
+	assert.Equal(t, v1.IntegrationStatus{}, it.Status)
+
+	key = k8sclient.ObjectKey{Namespace: "default", Name: "my-kit"}
+	assert.Nil(t, fakeClient.Get(context.TODO(), key, &kit))
+	assert.Equal(t, v1.IntegrationKitStatus{}, kit.Status)
+	assert.Equal(t, v1.IntegrationKitTypePlatform, kit.Labels["camel.apache.org/kit.type"])
+}
+
+func TestRebuildIntegrationWithSharedKit(t *testing.T) {
+	options, rootCmd := kamelTestPreAddCommandInit()
+
+	it := v1.NewIntegration("default", "my-integration")
+	it.Status = v1.IntegrationStatus{
+		Phase: v1.IntegrationPhaseRunning,
+		Kit:   "other-kit",
+	}
+	kit := v1.NewIntegrationKit("default", "other-kit")
+	kit.Labels = map[string]string{
+		"camel.apache.org/kit.type":        v1.IntegrationKitTypePlatform,
+		"camel.apache.org/created.by.kind": v1.IntegrationKind,
+		"camel.apache.org/created.by.name": "other-integration",
+	}
+	kit.Status = v1.IntegrationKitStatus{
+		Phase: v1.IntegrationKitPhaseReady,
+		Image: "my-registry/other-kit:1",
+	}
+
+	fakeClient, err := test.NewFakeClient(&it, &kit)
+	assert.Nil(t, err)
+	options._client = fakeClient
+
+	rebuildCmd, _ := newCmdRebuild(options)
+	rootCmd.AddCommand(rebuildCmd)
+
+	kamelTestPostAddCommandInit(t, rootCmd)
+
+	output, err := test.ExecuteCommand(rootCmd, "rebuild", "my-integration", "-n", "default")
+	assert.Nil(t, err)
+	assert.Contains(t, output, "Warning: integration kit other-kit is not rebuilt")
+
+	key := k8sclient.ObjectKey{Namespace: "default", Name: "my-integration"}
+	assert.Nil(t, fakeClient.Get(context.TODO(), key, &it))
+	assert.Equal(t, v1.IntegrationStatus{}, it.Status)
+
+	// The kit created by another integration is left untouched
+	key = k8sclient.ObjectKey{Namespace: "default", Name: "other-kit"}
+	assert.Nil(t, fakeClient.Get(context.TODO(), key, &kit))
+	assert.Equal(t, v1.IntegrationKitPhaseReady, kit.Status.Phase)
+	assert.Equal(t, "my-registry/other-kit:1", kit.Status.Image)
+}
+
+func TestRebuildWithoutArgsRebuildsAll(t *testing.T) {
+	options, rootCmd := kamelTestPreAddCommandInit()
+
+	it1 := v1.NewIntegration("default", "my-integration-1")
+	it1.Status = v1.IntegrationStatus{Phase: v1.IntegrationPhaseRunning}
+	it2 := v1.NewIntegration("default", "my-integration-2")
+	it2.Status = v1.IntegrationStatus{Phase: v1.IntegrationPhaseError}
+
+	fakeClient, err := test.NewFakeClient(&it1, &it2)
+	assert.Nil(t, err)
+	options._client = fakeClient
+
+	rebuildCmd, _ := newCmdRebuild(options)
+	rootCmd.AddCommand(rebuildCmd)
+
+	kamelTestPostAddCommandInit(t, rootCmd)
+
+	_, err = test.ExecuteCommand(rootCmd, "rebuild", "-n", "default")
+	assert.Nil(t, err)
+
+	for _, name := range []string{"my-integration-1", "my-integration-2"} {
+		it := v1.NewIntegration("default", name)
+		key := k8sclient.ObjectKey{Namespace: "default", Name: name}
+		assert.Nil(t, fakeClient.Get(context.TODO(), key, &it))
+		assert.Equal(t, v1.IntegrationStatus{}, it.Status)
+	}
+}
@@ -86,31 +86,41 @@ func (o *resetCmdOptions) reset(_ *cobra.Command, _ []string) {
 }
 
 func (o *resetCmdOptions) deleteAllIntegrations(c client.Client) (int, error) {
+	n := 0
 	list := v1.NewIntegrationList()
-	if err := c.List(o.Context, &list, k8sclient.InNamespace(o.Namespace)); err != nil {
-		return 0, errors.Wrap(err, fmt.Sprintf("could not retrieve integrations from namespace %s", o.Namespace))
-	}
-	for _, i := range list.Items {
-		it := i
-		if err := c.Delete(o.Context, &it); err != nil {
-			return 0, errors.Wrap(err, fmt.Sprintf("could not delete integration %s from namespace %s", it.Name, it.Namespace))
+	err := client.ListPages(o.Context, c, &list, 0, func() error {
+		for _, i := range list.Items {
+			it := i
+			if err := c.Delete(o.Context, &it); err != nil {
+				return errors.Wrap(err, fmt.Sprintf("could not delete integration %s from namespace %s", it.Name, it.Namespace))
+			}
+			n++
 		}
+		return nil
+	}, k8sclient.InNamespace(o.Namespace))
+	if err != nil {
+		return n, errors.Wrap(err, fmt.Sprintf("could not delete integrations from namespace %s", o.Namespace))
 	}
-	return len(list.Items), nil
+	return n, nil
 }
 
 func (o *resetCmdOptions) deleteAllIntegrationKits(c client.Client) (int, error) {
+	n := 0
 	list := v1.NewIntegrationKitList()
-	if err := c.List(o.Context, &list, k8sclient.InNamespace(o.Namespace)); err != nil {
-		return 0, errors.Wrap(err, fmt.Sprintf("could not retrieve integration Kits from namespace %s", o.Namespace))
-	}
-	for _, i := range list.Items {
-		kit := i
-		if err := c.Delete(o.Context, &kit); err != nil {
-			return 0, errors.Wrap(err, fmt.Sprintf("could not delete integration kit %s from namespace %s", kit.Name, kit.Namespace))
+	err := client.ListPages(o.Context, c, &list, 0, func() error {
+		for _, i := range list.Items {
+			kit := i
+			if err := c.Delete(o.Context, &kit); err != nil {
+				return errors.Wrap(err, fmt.Sprintf("could not delete integration kit %s from namespace %s", kit.Name, kit.Namespace))
+			}
+			n++
 		}
+		return nil
+	}, k8sclient.InNamespace(o.Namespace))
+	if err != nil {
+		return n, errors.Wrap(err, fmt.Sprintf("could not delete integration Kits from namespace %s", o.Namespace))
 	}
-	return len(list.Items), nil
+	return n, nil
 }
 
 func (o *resetCmdOptions) resetIntegrationPlatform(c client.Client) error {
@@ -19,6 +19,7 @@ package cmd
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -30,32 +31,45 @@ import (
 	"path"
 	"reflect"
 	"regexp"
+	"sort"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/magiconair/properties"
 	"github.com/mitchellh/mapstructure"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	yaml2 "gopkg.in/yaml.v2"
 
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/client"
+	"github.com/apache/camel-k/pkg/platform"
 	"github.com/apache/camel-k/pkg/trait"
 	"github.com/apache/camel-k/pkg/util"
 	"github.com/apache/camel-k/pkg/util/flow"
 	"github.com/apache/camel-k/pkg/util/gzip"
 	"github.com/apache/camel-k/pkg/util/kubernetes"
 	k8slog "github.com/apache/camel-k/pkg/util/kubernetes/log"
+	"github.com/apache/camel-k/pkg/util/registry"
 	"github.com/apache/camel-k/pkg/util/sync"
 	"github.com/apache/camel-k/pkg/util/watch"
 )
 
+const (
+	// syncInterval is the quiet period after which the changes of the watched files are synchronized
+	syncInterval = 500 * time.Millisecond
+	// reservedMetadataPrefix is the prefix of the labels and annotations managed by Camel K
+	reservedMetadataPrefix = "camel.apache.org/"
+)
+
 var (
 	traitConfigRegexp = regexp.MustCompile(`^([a-z0-9-]+)((?:\.[a-z0-9-]+)+)=(.*)$`)
 )
@@ -78,6 +92,7 @@ func newCmdRun(rootCmdOptions *RootCmdOptions) (*cobra.Command, *runCmdOptions)
 	cmd.Flags().String("name", "", "The integration name")
 	cmd.Flags().StringArrayP("dependency", "d", nil, "An external library that should be included. E.g. for Maven dependencies \"mvn:org.my/app:1.0\"")
 	cmd.Flags().BoolP("wait", "w", false, "Waits for the integration to be running")
+	cmd.Flags().String("timeout", "", "The maximum duration to wait for the integration to be running, e.g. \"5m\". Requires --wait")
 	cmd.Flags().StringP("kit", "k", "", "The kit used to run the integration")
 	cmd.Flags().StringArrayP("property", "p", nil, "Add a camel property")
 	cmd.Flags().StringArray("configmap", nil, "Add a ConfigMap")
@@ -89,6 +104,7 @@ func newCmdRun(rootCmdOptions *RootCmdOptions) (*cobra.Command, *runCmdOptions)
 	cmd.Flags().Bool("use-flows", true, "Write yaml sources as Flow objects in the integration custom resource")
 	cmd.Flags().String("profile", "", "Trait profile used for deployment")
 	cmd.Flags().StringArrayP("trait", "t", nil, "Configure a trait. E.g. \"-t service.enabled=false\"")
+	cmd.Flags().String("trait-file", "", "Load the trait configuration from a YAML file, overridden by the --trait options. E.g. \"--trait-file traits.yaml\"")
 	cmd.Flags().StringArray("logging-level", nil, "Configure the logging level. e.g. \"--logging-level org.apache.camel=DEBUG\"")
 	cmd.Flags().StringP("output", "o", "", "Output format. One of: json|yaml")
 	cmd.Flags().Bool("compression", false, "Enable store source as a compressed binary blob")
@@ -98,6 +114,7 @@ func newCmdRun(rootCmdOptions *RootCmdOptions) (*cobra.Command, *runCmdOptions)
 	cmd.Flags().StringArrayP("env", "e", nil, "Set an environment variable in the integration container. E.g \"-e MY_VAR=my-value\"")
 	cmd.Flags().StringArray("property-file", nil, "Bind a property file to the integration. E.g. \"--property-file integration.properties\"")
 	cmd.Flags().StringArray("label", nil, "Add a label to the integration. E.g. \"--label my.company=hello\"")
+	cmd.Flags().StringArray("annotation", nil, "Add an annotation to the integration. E.g. \"--annotation my.company=hello\"")
 	cmd.Flags().StringArray("source", nil, "Add source file to your integration, this is added to the list of files listed as arguments of the command")
 
 	cmd.Flags().Bool("save", false, "Save the run parameters into the default kamel configuration file (kamel-config.yaml)")
@@ -120,6 +137,7 @@ type runCmdOptions struct {
 	IntegrationKit  string   `mapstructure:"kit" yaml:",omitempty"`
 	IntegrationName string   `mapstructure:"name" yaml:",omitempty"`
 	Profile         string   `mapstructure:"profile" yaml:",omitempty"`
+	Timeout         string   `mapstructure:"timeout" yaml:",omitempty"`
 	OutputFormat    string   `mapstructure:"output" yaml:",omitempty"`
 	Resources       []string `mapstructure:"resources" yaml:",omitempty"`
 	OpenAPIs        []string `mapstructure:"open-apis" yaml:",omitempty"`
@@ -129,11 +147,13 @@ type runCmdOptions struct {
 	Secrets         []string `mapstructure:"secrets" yaml:",omitempty"`
 	Repositories    []string `mapstructure:"maven-repositories" yaml:",omitempty"`
 	Traits          []string `mapstructure:"traits" yaml:",omitempty"`
+	TraitFile       string   `mapstructure:"trait-file" yaml:",omitempty"`
 	LoggingLevels   []string `mapstructure:"logging-levels" yaml:",omitempty"`
 	Volumes         []string `mapstructure:"volumes" yaml:",omitempty"`
 	EnvVars         []string `mapstructure:"envs" yaml:",omitempty"`
 	PropertyFiles   []string `mapstructure:"property-files" yaml:",omitempty"`
 	Labels          []string `mapstructure:"labels" yaml:",omitempty"`
+	Annotations     []string `mapstructure:"annotations" yaml:",omitempty"`
 	Sources         []string `mapstructure:"sources" yaml:",omitempty"`
 }
 
@@ -212,6 +232,20 @@ func (o *runCmdOptions) validateArgs(_ *cobra.Command, args []string) error {
 			} else if err != nil {
 				return errors.Wrapf(err, "error while accessing file %s", source)
 			}
+		} else if isOCISource(source) {
+			// the artifact is only pulled once the registry credentials, if any, are resolved
+			if _, err := parseOCIReference(source); err != nil {
+				return err
+			}
+		} else if isGitSource(source) {
+			// the repository is only cloned once the credentials, if any, are resolved
+			u, err := url.Parse(source)
+			if err != nil {
+				return err
+			}
+			if _, err := parseGitSource(u); err != nil {
+				return err
+			}
 		} else {
 			_, err := loadData(source, false)
 			if err != nil {
@@ -224,6 +258,17 @@ func (o *runCmdOptions) validateArgs(_ *cobra.Command, args []string) error {
 }
 
 func (o *runCmdOptions) validate() error {
+	if o.Timeout != "" {
+		if !o.Wait && !o.Dev {
+			return errors.New("the timeout option requires the wait option")
+		}
+		if d, err := time.ParseDuration(o.Timeout); err != nil {
+			return errors.Wrapf(err, "invalid timeout %s", o.Timeout)
+		} else if d <= 0 {
+			return fmt.Errorf("invalid timeout %s, it must be a positive duration", o.Timeout)
+		}
+	}
+
 	for _, volume := range o.Volumes {
 		volumeConfig := strings.Split(volume, ":")
 		if len(volumeConfig) != 2 || len(strings.TrimSpace(volumeConfig[0])) == 0 || len(strings.TrimSpace(volumeConfig[1])) == 0 {
@@ -248,9 +293,38 @@ func (o *runCmdOptions) validate() error {
 		if len(parts) != 2 {
 			return fmt.Errorf(`invalid label specification %s. Expected "<labelkey>=<labelvalue>"`, label)
 		}
+		if errs := validation.IsQualifiedName(parts[0]); len(errs) > 0 {
+			return fmt.Errorf("invalid label key %s: %s", parts[0], strings.Join(errs, ", "))
+		}
+		if errs := validation.IsValidLabelValue(parts[1]); len(errs) > 0 {
+			return fmt.Errorf("invalid label value %s: %s", parts[1], strings.Join(errs, ", "))
+		}
+		// The Camel K labels are managed by the operator, e.g. to garbage collect resources
+		if strings.HasPrefix(parts[0], reservedMetadataPrefix) {
+			return fmt.Errorf("invalid label key %s: the %s prefix is reserved", parts[0], reservedMetadataPrefix)
+		}
 	}
 
-	return nil
+	for _, annotation := range o.Annotations {
+		parts := strings.SplitN(annotation, "=", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf(`invalid annotation specification %s. Expected "<annotationkey>=<annotationvalue>"`, annotation)
+		}
+		if errs := validation.IsQualifiedName(parts[0]); len(errs) > 0 {
+			return fmt.Errorf("invalid annotation key %s: %s", parts[0], strings.Join(errs, ", "))
+		}
+		if strings.HasPrefix(parts[0], reservedMetadataPrefix) {
+			return fmt.Errorf("invalid annotation key %s: the %s prefix is reserved", parts[0], reservedMetadataPrefix)
+		}
+	}
+
+	traits, err := o.traitOptions()
+	if err != nil {
+		return err
+	}
+
+	// Catch trait configuration mistakes before the integration gets created
+	return validateTraits(trait.NewCatalog(o.Context, nil), traits)
 }
 
 func (o *runCmdOptions) run(cmd *cobra.Command, args []string) error {
@@ -260,15 +334,6 @@ func (o *runCmdOptions) run(cmd *cobra.Command, args []string) error {
 	}
 
 	catalog := trait.NewCatalog(o.Context, c)
-	tp := catalog.ComputeTraitsProperties()
-	for _, t := range o.Traits {
-		kv := strings.SplitN(t, "=", 2)
-
-		if !util.StringSliceExists(tp, kv[0]) {
-			fmt.Printf("Error: %s is not a valid trait property\n", t)
-			return nil
-		}
-	}
 
 	integration, err := o.createIntegration(c, args, catalog)
 	if err != nil {
@@ -308,16 +373,32 @@ func (o *runCmdOptions) run(cmd *cobra.Command, args []string) error {
 		})
 	}
 	if o.Wait || o.Dev {
+		ctx := o.Context
+		if o.Timeout != "" {
+			timeout, err := time.ParseDuration(o.Timeout)
+			if err != nil {
+				return err
+			}
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(o.Context, timeout)
+			defer cancel()
+		}
+
 		for {
-			integrationPhase, err := o.waitForIntegrationReady(cmd, integration)
+			integrationPhase, err := o.waitForIntegrationReady(ctx, cmd, integration)
 			if err != nil {
 				return err
 			}
 
-			if integrationPhase == nil || *integrationPhase == v1.IntegrationPhaseError {
-				return fmt.Errorf("integration \"%s\" deployment failed", integration.Name)
-			} else if *integrationPhase == v1.IntegrationPhaseRunning {
+			if integrationPhase != nil && *integrationPhase == v1.IntegrationPhaseRunning {
 				break
+			} else if ctx.Err() != nil {
+				if o.Context.Err() != nil {
+					return o.Context.Err()
+				}
+				return fmt.Errorf("timed out waiting for integration \"%s\" to be running after %s", integration.Name, o.Timeout)
+			} else if integrationPhase == nil || *integrationPhase == v1.IntegrationPhaseError {
+				return fmt.Errorf("integration \"%s\" deployment failed", integration.Name)
 			}
 
 			// The integration watch timed out so recreate it using the latest integration resource version
@@ -372,14 +453,25 @@ func (o *runCmdOptions) postRun(cmd *cobra.Command, args []string) error {
 }
 
 // nolint:errcheck
-func (o *runCmdOptions) waitForIntegrationReady(cmd *cobra.Command, integration *v1.Integration) (*v1.IntegrationPhase, error) {
+func (o *runCmdOptions) waitForIntegrationReady(ctx context.Context, cmd *cobra.Command, integration *v1.Integration) (*v1.IntegrationPhase, error) {
+	var lastPhase v1.IntegrationPhase
+	conditions := make(map[v1.IntegrationConditionType]v1.IntegrationCondition)
+
 	handler := func(i *v1.Integration) bool {
 		//
 		// TODO when we add health checks, we should Wait until they are passed
 		//
-		if i.Status.Phase != "" {
+		if i.Status.Phase != "" && i.Status.Phase != lastPhase {
 			// TODO remove this log when we make sure that events are always created
 			fmt.Fprintf(cmd.OutOrStdout(), "Progress: integration %q in phase %s\n", integration.Name, string(i.Status.Phase))
+			lastPhase = i.Status.Phase
+		}
+		for _, c := range i.Status.Conditions {
+			if last, ok := conditions[c.Type]; ok && last.Status == c.Status && last.Reason == c.Reason {
+				continue
+			}
+			conditions[c.Type] = c
+			fmt.Fprintf(cmd.ErrOrStderr(), "Condition: integration %q %s=%s (%s) %s\n", integration.Name, c.Type, c.Status, c.Reason, c.Message)
 		}
 		if i.Status.Phase == v1.IntegrationPhaseRunning || i.Status.Phase == v1.IntegrationPhaseError {
 			return false
@@ -388,7 +480,7 @@ func (o *runCmdOptions) waitForIntegrationReady(cmd *cobra.Command, integration
 		return true
 	}
 
-	return watch.HandleIntegrationStateChanges(o.Context, integration, handler)
+	return watch.HandleIntegrationStateChanges(ctx, integration, handler)
 }
 
 func (o *runCmdOptions) syncIntegration(cmd *cobra.Command, c client.Client, sources []string, catalog *trait.Catalog) error {
@@ -399,49 +491,60 @@ func (o *runCmdOptions) syncIntegration(cmd *cobra.Command, c client.Client, sou
 	files = append(files, o.PropertyFiles...)
 	files = append(files, o.OpenAPIs...)
 
+	localFiles := make([]string, 0, len(files))
 	for _, s := range files {
 		if isLocal(s) {
-			changes, err := sync.File(o.Context, s)
-			if err != nil {
-				return err
-			}
-			go func() {
-				for {
-					select {
-					case <-o.Context.Done():
-						return
-					case <-changes:
-						// let's create a new command to parse modeline changes and update our integration
-						newCmd, _, err := createKamelWithModelineCommand(o.RootContext, os.Args[1:], make(map[string]bool))
-						newCmd.SetOut(cmd.OutOrStdout())
-						newCmd.SetErr(cmd.ErrOrStderr())
-						if err != nil {
-							fmt.Println("Unable to sync integration: ", err.Error())
-							continue
-						}
-						newCmd.Args = o.validateArgs
-						newCmd.PreRunE = o.decode
-						newCmd.RunE = func(cmd *cobra.Command, args []string) error {
-							_, err := o.updateIntegrationCode(c, sources, catalog)
-							return err
-						}
-						newCmd.PostRunE = nil
-
-						// cancel the existing command to release watchers
-						o.ContextCancel()
-						// run the new one
-						err = newCmd.Execute()
-						if err != nil {
-							fmt.Println("Unable to sync integration: ", err.Error())
-						}
-					}
-				}
-			}()
+			localFiles = append(localFiles, s)
 		} else {
 			fmt.Printf("WARNING: the following URL will not be watched for changes: %s\n", s)
 		}
 	}
 
+	if len(localFiles) == 0 {
+		return nil
+	}
+
+	// several files saved at once are synchronized with a single update
+	changes, err := sync.Files(o.Context, localFiles, syncInterval)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for {
+			select {
+			case <-o.Context.Done():
+				return
+			case file := <-changes:
+				fmt.Fprintf(cmd.OutOrStdout(), "File %s changed, synchronizing the integration\n", file)
+
+				// let's create a new command to parse modeline changes and update our integration
+				newCmd, _, err := createKamelWithModelineCommand(o.RootContext, os.Args[1:], make(map[string]bool))
+				if err != nil {
+					fmt.Println("Unable to sync integration: ", err.Error())
+					continue
+				}
+				newCmd.SetOut(cmd.OutOrStdout())
+				newCmd.SetErr(cmd.ErrOrStderr())
+				newCmd.Args = o.validateArgs
+				newCmd.PreRunE = o.decode
+				newCmd.RunE = func(cmd *cobra.Command, args []string) error {
+					_, err := o.updateIntegrationCode(c, sources, catalog)
+					return err
+				}
+				newCmd.PostRunE = nil
+
+				// cancel the existing command to release watchers
+				o.ContextCancel()
+				// run the new one
+				err = newCmd.Execute()
+				if err != nil {
+					fmt.Println("Unable to sync integration: ", err.Error())
+				}
+			}
+		}
+	}()
+
 	return nil
 }
 
@@ -487,42 +590,59 @@ func (o *runCmdOptions) updateIntegrationCode(c client.Client, sources []string,
 		}
 	}
 
+	for _, annotation := range o.Annotations {
+		parts := strings.SplitN(annotation, "=", 2)
+		if len(parts) == 2 {
+			if integration.Annotations == nil {
+				integration.Annotations = make(map[string]string)
+			}
+			integration.Annotations[parts[0]] = parts[1]
+		}
+	}
+
 	srcs := make([]string, 0, len(sources)+len(o.Sources))
 	srcs = append(srcs, sources...)
 	srcs = append(srcs, o.Sources...)
 
 	for _, source := range srcs {
-		data, err := loadData(source, o.Compression)
+		if isOCISource(source) {
+			files, err := o.pullOCISources(c, source, catalog)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, file := range files {
+				data, err := encodeData(file.content, o.Compression)
+				if err != nil {
+					return nil, err
+				}
+				if err := o.addSource(&integration, file.name, data); err != nil {
+					return nil, err
+				}
+			}
+
+			continue
+		}
+
+		data, err := o.loadSourceData(c, source)
 		if err != nil {
 			return nil, err
 		}
 
-		if o.UseFlows && (strings.HasSuffix(source, ".yaml") || strings.HasSuffix(source, ".yml")) {
-			flows, err := flow.UnmarshalString(data)
-			if err != nil {
-				return nil, err
-			}
-			integration.Spec.AddFlows(flows...)
-		} else {
-			integration.Spec.AddSources(v1.SourceSpec{
-				DataSpec: v1.DataSpec{
-					Name:        path.Base(source),
-					Content:     data,
-					Compression: o.Compression,
-				},
-			})
+		if err := o.addSource(&integration, sourceFileName(source), data); err != nil {
+			return nil, err
 		}
 	}
 
 	for _, resource := range o.Resources {
-		data, err := loadData(resource, o.Compression)
+		data, err := o.loadSourceData(c, resource)
 		if err != nil {
 			return nil, err
 		}
 
 		integration.Spec.AddResources(v1.ResourceSpec{
 			DataSpec: v1.DataSpec{
-				Name:        path.Base(resource),
+				Name:        sourceFileName(resource),
 				Content:     data,
 				Compression: o.Compression,
 			},
@@ -531,14 +651,14 @@ func (o *runCmdOptions) updateIntegrationCode(c client.Client, sources []string,
 	}
 
 	for _, resource := range o.OpenAPIs {
-		data, err := loadData(resource, o.Compression)
+		data, err := o.loadSourceData(c, resource)
 		if err != nil {
 			return nil, err
 		}
 
 		integration.Spec.AddResources(v1.ResourceSpec{
 			DataSpec: v1.DataSpec{
-				Name:        path.Base(resource),
+				Name:        sourceFileName(resource),
 				Content:     data,
 				Compression: o.Compression,
 			},
@@ -573,7 +693,11 @@ func (o *runCmdOptions) updateIntegrationCode(c client.Client, sources []string,
 		integration.Spec.AddConfiguration("env", item)
 	}
 
-	if err := o.configureTraits(&integration, o.Traits, catalog); err != nil {
+	traits, err := o.traitOptions()
+	if err != nil {
+		return nil, err
+	}
+	if err := o.configureTraits(&integration, traits, catalog); err != nil {
 		return nil, err
 	}
 
@@ -601,7 +725,7 @@ func (o *runCmdOptions) updateIntegrationCode(c client.Client, sources []string,
 	}
 
 	existed := false
-	err := c.Create(o.Context, &integration)
+	err = c.Create(o.Context, &integration)
 	if err != nil && k8serrors.IsAlreadyExists(err) {
 		existed = true
 		clone := integration.DeepCopy()
@@ -643,11 +767,35 @@ func (o *runCmdOptions) updateIntegrationCode(c client.Client, sources []string,
 	return &integration, nil
 }
 
+func (o *runCmdOptions) addSource(integration *v1.Integration, fileName string, data string) error {
+	if o.UseFlows && (strings.HasSuffix(fileName, ".yaml") || strings.HasSuffix(fileName, ".yml")) {
+		flows, err := flow.UnmarshalString(data)
+		if err != nil {
+			return err
+		}
+		integration.Spec.AddFlows(flows...)
+	} else {
+		integration.Spec.AddSources(v1.SourceSpec{
+			DataSpec: v1.DataSpec{
+				Name:        fileName,
+				Content:     data,
+				Compression: o.Compression,
+			},
+		})
+	}
+
+	return nil
+}
+
 func (o *runCmdOptions) GetIntegrationName(sources []string) string {
 	name := ""
 	if o.IntegrationName != "" {
 		name = o.IntegrationName
 		name = kubernetes.SanitizeName(name)
+	} else if len(sources) == 1 && isOCISource(sources[0]) {
+		if ref, err := parseOCIReference(sources[0]); err == nil {
+			name = kubernetes.SanitizeName(path.Base(ref.Context().RepositoryStr()))
+		}
 	} else if len(sources) == 1 {
 		name = kubernetes.SanitizeName(sources[0])
 	}
@@ -680,6 +828,10 @@ func loadData(source string, compress bool) (string, error) {
 		}
 	}
 
+	return encodeData(content, compress)
+}
+
+func encodeData(content []byte, compress bool) (string, error) {
 	if compress {
 		var b bytes.Buffer
 
@@ -693,6 +845,113 @@ func loadData(source string, compress bool) (string, error) {
 	return string(content), nil
 }
 
+// pullOCISources pulls the route files of an OCI artifact, authenticating against the registry
+// with the secrets configured on the pull-secret trait or, as the trait does by default, with
+// the registry secret of the platform
+func (o *runCmdOptions) pullOCISources(c client.Client, source string, catalog *trait.Catalog) ([]ociFile, error) {
+	config, err := o.pullSecretTraitConfig(catalog)
+	if err != nil {
+		return nil, err
+	}
+
+	secretNames := make([]string, 0, len(config.SecretNames)+1)
+	if config.SecretName != "" {
+		secretNames = append(secretNames, config.SecretName)
+	} else if config.Auto == nil || *config.Auto {
+		if pl, err := platform.GetCurrentPlatform(o.Context, c, o.Namespace); err == nil && pl.Status.Build.Registry.Secret != "" {
+			secretNames = append(secretNames, pl.Status.Build.Registry.Secret)
+		}
+	}
+	secretNames = append(secretNames, config.SecretNames...)
+
+	dockerConfigs := make([][]byte, 0, len(secretNames))
+	for _, secretName := range secretNames {
+		secret, err := kubernetes.GetSecret(o.Context, c, secretName, o.Namespace)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to get the registry credentials of oci source %s", source)
+		}
+		if secret.Type == corev1.SecretTypeDockerConfigJson {
+			dockerConfigs = append(dockerConfigs, secret.Data[corev1.DockerConfigJsonKey])
+		}
+	}
+
+	var dockerConfig []byte
+	if len(dockerConfigs) > 0 {
+		if dockerConfig, err = registry.MergeDockerConfigs(dockerConfigs...); err != nil {
+			return nil, errors.Wrapf(err, "unable to get the registry credentials of oci source %s", source)
+		}
+	}
+
+	return pullOCISources(source, dockerConfig)
+}
+
+// pullSecretTraitConfiguration holds the properties of the pull-secret trait
+// used to authenticate against the registry of OCI sources
+type pullSecretTraitConfiguration struct {
+	SecretName  string   `json:"secretName,omitempty"`
+	SecretNames []string `json:"secretNames,omitempty"`
+	Auto        *bool    `json:"auto,omitempty"`
+}
+
+// pullSecretTraitConfig decodes the pull-secret trait options the same way they are
+// set on the integration
+func (o *runCmdOptions) pullSecretTraitConfig(catalog *trait.Catalog) (pullSecretTraitConfiguration, error) {
+	config := pullSecretTraitConfiguration{}
+
+	options, err := o.traitOptions()
+	if err != nil {
+		return config, err
+	}
+	traits, err := configureTraits(options, catalog)
+	if err != nil {
+		return config, err
+	}
+
+	if spec, ok := traits["pull-secret"]; ok && len(spec.Configuration.RawMessage) > 0 {
+		if err := json.Unmarshal(spec.Configuration.RawMessage, &config); err != nil {
+			return config, err
+		}
+	}
+
+	return config, nil
+}
+
+// loadSourceData loads the given source, resolving the credentials of Git
+// sources referencing a Secret with the secret query parameter
+func (o *runCmdOptions) loadSourceData(c client.Client, source string) (string, error) {
+	if !isGitSource(source) {
+		return loadData(source, o.Compression)
+	}
+
+	u, err := url.Parse(source)
+	if err != nil {
+		return "", err
+	}
+
+	query := u.Query()
+	if name := query.Get("secret"); name != "" {
+		secret, err := kubernetes.GetSecret(o.Context, c, name, o.Namespace)
+		if err != nil {
+			return "", errors.Wrapf(err, "unable to get the credentials of git source %s", sourceFileName(source))
+		}
+
+		username, ok := secret.Data["username"]
+		if !ok {
+			return "", fmt.Errorf("secret %s does not contain the username key", name)
+		}
+		password, ok := secret.Data["password"]
+		if !ok {
+			return "", fmt.Errorf("secret %s does not contain the password key", name)
+		}
+
+		u.User = url.UserPassword(string(username), string(password))
+		query.Del("secret")
+		u.RawQuery = query.Encode()
+	}
+
+	return loadData(u.String(), o.Compression)
+}
+
 func (*runCmdOptions) configureTraits(integration *v1.Integration, options []string, catalog *trait.Catalog) error {
 	traits, err := configureTraits(options, catalog)
 	if err != nil {
@@ -704,6 +963,21 @@ func (*runCmdOptions) configureTraits(integration *v1.Integration, options []str
 	return nil
 }
 
+func isGitSource(source string) bool {
+	return strings.HasPrefix(source, "git+http://") || strings.HasPrefix(source, "git+https://")
+}
+
+// sourceFileName returns the file name of the given source, ignoring the
+// query parameters of remote ones
+func sourceFileName(source string) string {
+	if !isLocal(source) {
+		if u, err := url.Parse(source); err == nil && u.Path != "" {
+			return path.Base(u.Path)
+		}
+	}
+	return path.Base(source)
+}
+
 func isLocal(fileName string) bool {
 	info, err := os.Stat(fileName)
 	if os.IsNotExist(err) {
@@ -745,6 +1019,124 @@ func escapePropertyFileItem(item string) string {
 	return item
 }
 
+// traitOptions returns the trait options loaded from the trait file, if any, followed by
+// the ones set with the --trait flag, that take precedence over the former
+func (o *runCmdOptions) traitOptions() ([]string, error) {
+	if o.TraitFile == "" {
+		return o.Traits, nil
+	}
+
+	fromFile, err := loadTraitFile(o.TraitFile)
+	if err != nil {
+		return nil, err
+	}
+
+	overridden := make(map[string]bool)
+	for _, t := range o.Traits {
+		overridden[strings.SplitN(t, "=", 2)[0]] = true
+	}
+
+	traits := make([]string, 0, len(fromFile)+len(o.Traits))
+	for _, t := range fromFile {
+		if !overridden[strings.SplitN(t, "=", 2)[0]] {
+			traits = append(traits, t)
+		}
+	}
+
+	return append(traits, o.Traits...), nil
+}
+
+// loadTraitFile converts a structured trait configuration, e.g.:
+//
+//   jolokia:
+//     enabled: true
+//     options:
+//     - foo=bar
+//   ingress:
+//     annotations:
+//       key: value
+//
+// into the equivalent "<trait>.<prop>=<value>" options
+func loadTraitFile(fileName string) ([]string, error) {
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read trait file %s", fileName)
+	}
+
+	traits := make(map[string]map[string]interface{})
+	if err := yaml2.Unmarshal(data, &traits); err != nil {
+		return nil, errors.Wrapf(err, "unable to parse trait file %s", fileName)
+	}
+
+	options := make([]string, 0)
+	for _, id := range sortedTraitIDs(traits) {
+		for _, prop := range util.SortedMapKeys(traits[id]) {
+			key := id + "." + prop
+			switch v := traits[id][prop].(type) {
+			case nil:
+				continue
+			case []interface{}:
+				for _, item := range v {
+					options = append(options, fmt.Sprintf("%s=%v", key, item))
+				}
+			case map[interface{}]interface{}:
+				entries := make([]string, 0, len(v))
+				for k, item := range v {
+					entries = append(entries, fmt.Sprintf("%s=%v=%v", key, k, item))
+				}
+				sort.Strings(entries)
+				options = append(options, entries...)
+			default:
+				options = append(options, fmt.Sprintf("%s=%v", key, v))
+			}
+		}
+	}
+
+	return options, nil
+}
+
+func sortedTraitIDs(traits map[string]map[string]interface{}) []string {
+	ids := make([]string, 0, len(traits))
+	for id := range traits {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// validateTraits checks the given trait options against the trait catalog, suggesting
+// the closest known trait or property when there is no exact match
+func validateTraits(catalog *trait.Catalog, options []string) error {
+	ids := make([]string, 0)
+	properties := make(map[string][]string)
+	for _, tp := range catalog.ComputeTraitsProperties() {
+		parts := strings.SplitN(tp, ".", 2)
+		if _, ok := properties[parts[0]]; !ok {
+			ids = append(ids, parts[0])
+		}
+		properties[parts[0]] = append(properties[parts[0]], parts[1])
+	}
+
+	for _, option := range options {
+		parts := traitConfigRegexp.FindStringSubmatch(option)
+		if len(parts) < 4 {
+			return errors.New("unrecognized config format (expected \"<trait>.<prop>=<value>\"): " + option)
+		}
+		id := parts[1]
+		prop := parts[2][1:]
+
+		if _, ok := properties[id]; !ok {
+			return fmt.Errorf("%s is not a valid trait property: unknown trait %s%s", option, id, didYouMean(id, ids))
+		}
+		if !util.StringSliceExists(properties[id], prop) {
+			return fmt.Errorf("%s is not a valid trait property: unknown property %s of trait %s%s. Run \"kamel help trait %s\" to list the available properties",
+				option, prop, id, didYouMean(prop, properties[id]), id)
+		}
+	}
+
+	return nil
+}
+
 func configureTraits(options []string, catalog *trait.Catalog) (map[string]v1.TraitSpec, error) {
 	traits := make(map[string]map[string]interface{})
 
@@ -809,6 +1201,7 @@ func configureTrait(config map[string]interface{}, trait interface{}) error {
 			WeaklyTypedInput: true,
 			TagName:          "property",
 			Result:           &trait,
+			DecodeHook:       stringToMapHookFunc(),
 		},
 	)
 
@@ -818,3 +1211,34 @@ func configureTrait(config map[string]interface{}, trait interface{}) error {
 
 	return decoder.Decode(config)
 }
+
+// stringToMapHookFunc converts key=value strings into maps, so that map trait properties
+// can be set from the command line, e.g. `-t ingress.annotations=key=value`
+func stringToMapHookFunc() mapstructure.DecodeHookFunc {
+	return func(f reflect.Type, t reflect.Type, data interface{}) (interface{}, error) {
+		if t.Kind() != reflect.Map {
+			return data, nil
+		}
+
+		var entries []string
+		switch v := data.(type) {
+		case string:
+			entries = []string{v}
+		case []string:
+			entries = v
+		default:
+			return data, nil
+		}
+
+		m := make(map[string]string, len(entries))
+		for _, entry := range entries {
+			kv := strings.SplitN(entry, "=", 2)
+			if len(kv) != 2 || kv[0] == "" {
+				return nil, fmt.Errorf("invalid map entry: %s, expected key=value", entry)
+			}
+			m[kv[0]] = kv[1]
+		}
+
+		return m, nil
+	}
+}
@@ -18,11 +18,31 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
+	"encoding/json"
+	"io"
 	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	gcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/trait"
 	"github.com/apache/camel-k/pkg/util/test"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
@@ -79,11 +99,11 @@ func TestRunWithTraitFlag(t *testing.T) {
 
 	kamelTestPostAddCommandInit(t, rootCmd)
 
-	_, err := test.ExecuteCommand(rootCmd, "run", "--trait", "sample.enabled=true", "example.js")
+	_, err := test.ExecuteCommand(rootCmd, "run", "--trait", "jolokia.enabled=true", "example.js")
 
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(runCmdOptions.Traits))
-	assert.Equal(t, "sample.enabled=true", runCmdOptions.Traits[0])
+	assert.Equal(t, "jolokia.enabled=true", runCmdOptions.Traits[0])
 }
 
 func TestRunWithAdditionalTraitFlag(t *testing.T) {
@@ -93,12 +113,119 @@ func TestRunWithAdditionalTraitFlag(t *testing.T) {
 
 	kamelTestPostAddCommandInit(t, rootCmd)
 
-	_, err := test.ExecuteCommand(rootCmd, "run", "--trait", "sample.enabled=true", "--trait", "sample.second=true", "example.js")
+	_, err := test.ExecuteCommand(rootCmd, "run", "--trait", "jolokia.enabled=true", "--trait", "jolokia.port=8778", "example.js")
 
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(runCmdOptions.Traits))
-	assert.Equal(t, "sample.enabled=true", runCmdOptions.Traits[0])
-	assert.Equal(t, "sample.second=true", runCmdOptions.Traits[1])
+	assert.Equal(t, "jolokia.enabled=true", runCmdOptions.Traits[0])
+	assert.Equal(t, "jolokia.port=8778", runCmdOptions.Traits[1])
+}
+
+func TestRunWithInvalidTraitFlag(t *testing.T) {
+	options, rootCmd := kamelTestPreAddCommandInit()
+
+	addTestRunCmd(options, rootCmd)
+
+	kamelTestPostAddCommandInit(t, rootCmd)
+
+	_, err := test.ExecuteCommand(rootCmd, "run", "--trait", "jolokia.prot=8778", "example.js")
+
+	assert.NotNil(t, err)
+}
+
+func TestValidateTraits(t *testing.T) {
+	catalog := trait.NewCatalog(context.TODO(), nil)
+
+	assert.Nil(t, validateTraits(catalog, []string{"jolokia.enabled=true", "ingress.annotations=key=value"}))
+
+	err := validateTraits(catalog, []string{"jolokai.enabled=true"})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "unknown trait jolokai. Did you mean jolokia?")
+
+	err = validateTraits(catalog, []string{"jolokia.prot=8778"})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "unknown property prot of trait jolokia. Did you mean port?")
+
+	err = validateTraits(catalog, []string{"unknown.enabled=true"})
+	assert.NotNil(t, err)
+	assert.NotContains(t, err.Error(), "Did you mean")
+
+	assert.NotNil(t, validateTraits(catalog, []string{"jolokia"}))
+}
+
+func TestRunWithTimeoutFlag(t *testing.T) {
+	options, rootCmd := kamelTestPreAddCommandInit()
+
+	runCmdOptions := addTestRunCmd(options, rootCmd)
+
+	kamelTestPostAddCommandInit(t, rootCmd)
+
+	_, err := test.ExecuteCommand(rootCmd, "run", "--wait", "--timeout", "5m", "example.js")
+
+	assert.Nil(t, err)
+	assert.True(t, runCmdOptions.Wait)
+	assert.Equal(t, "5m", runCmdOptions.Timeout)
+}
+
+func TestRunWithTimeoutFlagWithoutWait(t *testing.T) {
+	options, rootCmd := kamelTestPreAddCommandInit()
+
+	addTestRunCmd(options, rootCmd)
+
+	kamelTestPostAddCommandInit(t, rootCmd)
+
+	_, err := test.ExecuteCommand(rootCmd, "run", "--timeout", "5m", "example.js")
+
+	assert.NotNil(t, err)
+}
+
+func TestRunWithInvalidTimeoutFlag(t *testing.T) {
+	options, rootCmd := kamelTestPreAddCommandInit()
+
+	addTestRunCmd(options, rootCmd)
+
+	kamelTestPostAddCommandInit(t, rootCmd)
+
+	_, err := test.ExecuteCommand(rootCmd, "run", "--wait", "--timeout", "forever", "example.js")
+
+	assert.NotNil(t, err)
+}
+
+func TestRunWithLabelAndAnnotationFlags(t *testing.T) {
+	options, rootCmd := kamelTestPreAddCommandInit()
+
+	runCmdOptions := addTestRunCmd(options, rootCmd)
+
+	kamelTestPostAddCommandInit(t, rootCmd)
+
+	_, err := test.ExecuteCommand(rootCmd, "run",
+		"--label", "my.company/team=orders",
+		"--annotation", "my.company/cost-center=a=1",
+		"example.js")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"my.company/team=orders"}, runCmdOptions.Labels)
+	assert.Equal(t, []string{"my.company/cost-center=a=1"}, runCmdOptions.Annotations)
+}
+
+func TestRunWithInvalidLabelAndAnnotationFlags(t *testing.T) {
+	for _, flags := range [][]string{
+		{"--label", "camel.apache.org/integration=other"},
+		{"--label", "team=not valid"},
+		{"--label", "not valid=orders"},
+		{"--annotation", "camel.apache.org/kit.type=external"},
+		{"--annotation", "cost-center"},
+	} {
+		options, rootCmd := kamelTestPreAddCommandInit()
+
+		addTestRunCmd(options, rootCmd)
+
+		kamelTestPostAddCommandInit(t, rootCmd)
+
+		_, err := test.ExecuteCommand(rootCmd, append(append([]string{"run"}, flags...), "example.js")...)
+
+		assert.NotNil(t, err, flags)
+	}
 }
 
 //
@@ -172,3 +299,190 @@ func TestRunPropertyFileFlag(t *testing.T) {
 	assert.Equal(t, `d=c\=e`, spec.Configuration[2].Value)
 	assert.Equal(t, `f=g\:h`, spec.Configuration[3].Value)
 }
+
+func TestRunIntegrationNameFromRemoteSources(t *testing.T) {
+	o := runCmdOptions{}
+
+	assert.Equal(t, "routes", o.GetIntegrationName([]string{"git+https://github.com/org/repo.git//Routes.java?ref=main"}))
+	assert.Equal(t, "my-routes", o.GetIntegrationName([]string{"oci://quay.io/org/my-routes:1.0.0"}))
+	assert.Equal(t, "my-routes", o.GetIntegrationName([]string{"oci://quay.io/org/my-routes@sha256:0000000000000000000000000000000000000000000000000000000000000000"}))
+}
+
+func TestRunSourceFileName(t *testing.T) {
+	assert.Equal(t, "Routes.java", sourceFileName("git+https://github.com/org/repo.git//routes/Routes.java?ref=main"))
+	assert.Equal(t, "routes.yaml", sourceFileName("https://example.com/routes.yaml?token=abc"))
+	assert.Equal(t, "Routes.java", sourceFileName("routes/Routes.java"))
+}
+
+func TestConfigureTraitsWithMapProperty(t *testing.T) {
+	catalog := trait.NewCatalog(context.TODO(), nil)
+
+	traits, err := configureTraits([]string{
+		"ingress.annotations=cert-manager.io/cluster-issuer=letsencrypt",
+		"ingress.annotations=external-dns.alpha.kubernetes.io/hostname={{ .Name }}.example.com",
+		"ingress.labels=app=orders",
+	}, catalog)
+
+	assert.Nil(t, err)
+
+	config := make(map[string]interface{})
+	assert.Nil(t, json.Unmarshal(traits["ingress"].Configuration.RawMessage, &config))
+	assert.Equal(t, map[string]interface{}{
+		"cert-manager.io/cluster-issuer":            "letsencrypt",
+		"external-dns.alpha.kubernetes.io/hostname": "{{ .Name }}.example.com",
+	}, config["annotations"])
+	assert.Equal(t, map[string]interface{}{
+		"app": "orders",
+	}, config["labels"])
+
+	_, err = configureTraits([]string{"ingress.labels=app"}, catalog)
+	assert.NotNil(t, err)
+}
+
+const TestTraitFileContent = `
+jolokia:
+  enabled: true
+  port: 8778
+  options:
+  - foo=bar
+  - baz=qux
+ingress:
+  annotations:
+    cert-manager.io/cluster-issuer: letsencrypt
+`
+
+func TestRunTraitFileFlag(t *testing.T) {
+	var tmpFile *os.File
+	var err error
+	if tmpFile, err = ioutil.TempFile("", "camel-k-"); err != nil {
+		t.Error(err)
+	}
+
+	assert.Nil(t, tmpFile.Close())
+	assert.Nil(t, ioutil.WriteFile(tmpFile.Name(), []byte(TestTraitFileContent), 0644))
+
+	o := runCmdOptions{
+		TraitFile: tmpFile.Name(),
+		Traits:    []string{"jolokia.port=9999"},
+	}
+
+	traits, err := o.traitOptions()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		"ingress.annotations=cert-manager.io/cluster-issuer=letsencrypt",
+		"jolokia.enabled=true",
+		"jolokia.options=foo=bar",
+		"jolokia.options=baz=qux",
+		"jolokia.port=9999",
+	}, traits)
+
+	specs, err := configureTraits(traits, trait.NewCatalog(context.TODO(), nil))
+	assert.Nil(t, err)
+
+	config := make(map[string]interface{})
+	assert.Nil(t, json.Unmarshal(specs["jolokia"].Configuration.RawMessage, &config))
+	assert.Equal(t, float64(9999), config["port"])
+	assert.Equal(t, []interface{}{"foo=bar", "baz=qux"}, config["options"])
+
+	o.TraitFile = "missing-traits.yaml"
+	_, err = o.traitOptions()
+	assert.NotNil(t, err)
+}
+
+func TestRunPullOCISourcesWithPullSecrets(t *testing.T) {
+	server := httptest.NewServer(ociTestRegistry("nic", "pass"))
+	defer server.Close()
+
+	host := strings.TrimPrefix(server.URL, "http://")
+	ref, err := name.ParseReference(host + "/org/routes:1.0.0")
+	assert.Nil(t, err)
+
+	img, err := mutate.Append(empty.Image,
+		mutate.Addendum{
+			Layer:       ociTestLayer(`from("timer:tick").log("Hello")`),
+			Annotations: map[string]string{ociTitleAnnotation: "routes.groovy"},
+		},
+		mutate.Addendum{
+			Layer:       ociTestLayer("README"),
+			Annotations: map[string]string{ociTitleAnnotation: "README.md"},
+		},
+	)
+	assert.Nil(t, err)
+	assert.Nil(t, remote.Write(ref, img, remote.WithAuth(&authn.Basic{Username: "nic", Password: "pass"})))
+
+	other := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "other-registry"},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{
+			corev1.DockerConfigJsonKey: []byte(`{"auths":{"quay.io":{"username":"foo","password":"bar"}}}`),
+		},
+	}
+	secret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "oci-registry"},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{
+			corev1.DockerConfigJsonKey: []byte(`{"auths":{"` + host + `":{"username":"nic","password":"pass"}}}`),
+		},
+	}
+	c, err := test.NewFakeClient(&other, &secret)
+	assert.Nil(t, err)
+
+	o := runCmdOptions{
+		RootCmdOptions: &RootCmdOptions{
+			Context:   context.TODO(),
+			Namespace: "default",
+		},
+	}
+	catalog := trait.NewCatalog(o.Context, c)
+
+	_, err = o.pullOCISources(c, "oci://"+ref.String(), catalog)
+	assert.NotNil(t, err)
+
+	o.Traits = []string{"pull-secret.secret-name=other-registry", "pull-secret.secret-names=oci-registry"}
+	files, err := o.pullOCISources(c, "oci://"+ref.String(), catalog)
+	assert.Nil(t, err)
+	assert.Len(t, files, 1)
+	assert.Equal(t, "routes.groovy", files[0].name)
+	assert.Equal(t, `from("timer:tick").log("Hello")`, string(files[0].content))
+}
+
+// ociTestRegistry returns an in-memory registry requiring basic authentication
+func ociTestRegistry(username string, password string) http.Handler {
+	handler := registry.New(registry.Logger(log.New(ioutil.Discard, "", 0)))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if u, p, ok := r.BasicAuth(); !ok || u != username || p != password {
+			w.Header().Set("WWW-Authenticate", `Basic realm="test"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// ociTestLayer is an artifact layer storing a plain file
+type ociTestLayer string
+
+func (l ociTestLayer) Digest() (gcrv1.Hash, error) {
+	h, _, err := gcrv1.SHA256(strings.NewReader(string(l)))
+	return h, err
+}
+
+func (l ociTestLayer) DiffID() (gcrv1.Hash, error) {
+	return l.Digest()
+}
+
+func (l ociTestLayer) Compressed() (io.ReadCloser, error) {
+	return ioutil.NopCloser(strings.NewReader(string(l))), nil
+}
+
+func (l ociTestLayer) Uncompressed() (io.ReadCloser, error) {
+	return l.Compressed()
+}
+
+func (l ociTestLayer) Size() (int64, error) {
+	return int64(len(l)), nil
+}
+
+func (l ociTestLayer) MediaType() (types.MediaType, error) {
+	return "application/vnd.oci.image.layer.v1.tar", nil
+}
@@ -237,3 +237,52 @@ func fieldByMapstructureTagName(target reflect.Value, tagName string) (reflect.S
 
 	return reflect.StructField{}, false
 }
+
+// didYouMean returns a suggestion for the candidate that is the closest to the given name,
+// or an empty string if none is close enough to be a plausible typo
+func didYouMean(name string, candidates []string) string {
+	suggestion := ""
+	best := -1
+	for _, candidate := range candidates {
+		d := levenshteinDistance(name, candidate)
+		if d <= 2 || d <= len(name)/3 || strings.HasPrefix(candidate, name) {
+			if best < 0 || d < best {
+				suggestion = candidate
+				best = d
+			}
+		}
+	}
+	if suggestion == "" {
+		return ""
+	}
+	return fmt.Sprintf(". Did you mean %s?", suggestion)
+}
+
+// levenshteinDistance computes the number of single character edits needed to turn s into t
+func levenshteinDistance(s, t string) int {
+	a := []rune(s)
+	b := []rune(t)
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = prev[j-1] + cost
+			if prev[j]+1 < curr[j] {
+				curr[j] = prev[j] + 1
+			}
+			if curr[j-1]+1 < curr[j] {
+				curr[j] = curr[j-1] + 1
+			}
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
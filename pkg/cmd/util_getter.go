@@ -22,16 +22,24 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
 	"regexp"
+	"strconv"
+	"strings"
 )
 
 var Getters map[string]Getter
 
 func init() {
 	Getters = map[string]Getter{
-		"http":   HTTPGetter{},
-		"https":  HTTPGetter{},
-		"github": GitHubGetter{},
+		"http":      HTTPGetter{},
+		"https":     HTTPGetter{},
+		"github":    GitHubGetter{},
+		"git+http":  GitGetter{},
+		"git+https": GitGetter{},
 	}
 }
 
@@ -94,3 +102,143 @@ func (g GitHubGetter) Get(u *url.URL) ([]byte, error) {
 
 	return g.HTTPGetter.doGet(srcURL)
 }
+
+// A getter that retrieves the content of an integration from a Git
+// repository, e.g. git+https://github.com/org/repo.git//path/to/Routes.java?ref=main
+//
+// The ref query parameter pins the branch, tag or commit to check out, while
+// the depth query parameter controls the depth of the (shallow) clone, 0 meaning
+// the full history is fetched.
+//
+// The credentials of the URL, if any, are not passed to git on the command line,
+// but through a credential helper reading them from the environment.
+type GitGetter struct {
+}
+
+type gitSource struct {
+	repository string
+	file       string
+	ref        string
+	depth      int
+	username   string
+	password   string
+}
+
+const (
+	gitUsernameEnvVar = "KAMEL_GIT_USERNAME"
+	gitPasswordEnvVar = "KAMEL_GIT_PASSWORD"
+)
+
+// gitCredentialHelper resets the configured credential helpers, so that the ones of
+// the user do not take precedence, and answers with the credentials of the URL
+var gitCredentialHelper = []string{
+	"-c", "credential.helper=",
+	"-c", "credential.helper=!f() { echo username=$" + gitUsernameEnvVar + "; echo password=$" + gitPasswordEnvVar + "; }; f",
+}
+
+func (g GitGetter) Get(u *url.URL) ([]byte, error) {
+	src, err := parseGitSource(u)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	dir, err := ioutil.TempDir("", "kamel-git-")
+	if err != nil {
+		return []byte{}, err
+	}
+	defer os.RemoveAll(dir)
+
+	ref := src.ref
+	if ref == "" {
+		ref = "HEAD"
+	}
+
+	fetch := []string{"fetch", "--quiet"}
+	if src.depth > 0 {
+		fetch = append(fetch, "--depth", strconv.Itoa(src.depth))
+	}
+	fetch = append(fetch, src.repository, ref)
+
+	commands := [][]string{
+		{"init", "--quiet"},
+		fetch,
+		{"checkout", "--quiet", "FETCH_HEAD"},
+	}
+
+	for _, args := range commands {
+		command := args[0]
+		env := append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+		if src.username != "" || src.password != "" {
+			args = append(append([]string{}, gitCredentialHelper...), args...)
+			env = append(env, gitUsernameEnvVar+"="+src.username, gitPasswordEnvVar+"="+src.password)
+		}
+		// nolint: gosec
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = env
+		if out, err := cmd.CombinedOutput(); err != nil {
+			output := strings.TrimSpace(string(out))
+			if src.password != "" {
+				output = strings.Replace(output, src.password, "xxxxx", -1)
+			}
+			return []byte{}, fmt.Errorf("unable to %s git repository %s: %v: %s", command, redactURL(u), err, output)
+		}
+	}
+
+	return ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(src.file)))
+}
+
+func parseGitSource(u *url.URL) (gitSource, error) {
+	src := gitSource{
+		depth: 1,
+	}
+
+	items := strings.SplitN(u.Path, "//", 2)
+	if len(items) != 2 || items[1] == "" {
+		return src, fmt.Errorf("malformed git url: %s, the file must be separated from the repository with //", redactURL(u))
+	}
+
+	file := path.Clean(items[1])
+	if path.IsAbs(file) || file == ".." || strings.HasPrefix(file, "../") {
+		return src, fmt.Errorf("malformed git url: %s, the file must be relative to the repository root", redactURL(u))
+	}
+
+	repository := url.URL{
+		Scheme: strings.TrimPrefix(u.Scheme, "git+"),
+		Host:   u.Host,
+		Path:   items[0],
+	}
+
+	if u.User != nil {
+		src.username = u.User.Username()
+		src.password, _ = u.User.Password()
+	}
+
+	src.repository = repository.String()
+	src.file = file
+	src.ref = u.Query().Get("ref")
+
+	if depth := u.Query().Get("depth"); depth != "" {
+		d, err := strconv.Atoi(depth)
+		if err != nil || d < 0 {
+			return src, fmt.Errorf("malformed git url: %s, invalid depth %s", redactURL(u), depth)
+		}
+		src.depth = d
+	}
+
+	return src, nil
+}
+
+func redactURL(u *url.URL) string {
+	if u.User == nil {
+		return u.String()
+	}
+	if _, ok := u.User.Password(); !ok {
+		return u.String()
+	}
+
+	redacted := *u
+	redacted.User = url.UserPassword(u.User.Username(), "xxxxx")
+
+	return redacted.String()
+}
@@ -0,0 +1,146 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/magiconair/properties"
+	"github.com/scylladb/go-set/strset"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/builder"
+	"github.com/apache/camel-k/pkg/builder/runtime"
+	"github.com/apache/camel-k/pkg/metadata"
+	"github.com/apache/camel-k/pkg/util/camel"
+	"github.com/apache/camel-k/pkg/util/cancellable"
+)
+
+// loadLocalSources loads the content of the given sources, either local files or URLs
+func loadLocalSources(sources []string) ([]v1.SourceSpec, error) {
+	specs := make([]v1.SourceSpec, 0, len(sources))
+
+	for _, source := range sources {
+		data, err := loadData(source, false)
+		if err != nil {
+			return nil, err
+		}
+
+		spec := v1.SourceSpec{
+			DataSpec: v1.DataSpec{
+				Name:    sourceFileName(source),
+				Content: data,
+			},
+		}
+		if spec.InferLanguage() == "" {
+			return nil, fmt.Errorf("unable to determine the language of source %s", source)
+		}
+
+		specs = append(specs, spec)
+	}
+
+	return specs, nil
+}
+
+// computeLocalDependencies computes the dependencies of the given sources, the same way the
+// dependencies trait does for the integrations running on the cluster
+func computeLocalDependencies(catalog *camel.RuntimeCatalog, sources []v1.SourceSpec, dependencies []string) []string {
+	deps := strset.New(dependencies...)
+
+	camel.AddRuntimeDependencies(deps, catalog)
+
+	for _, s := range sources {
+		meta := metadata.Extract(catalog, s)
+		deps.Merge(meta.Dependencies)
+
+		camel.AddSourceDependencies(deps, catalog, s)
+	}
+
+	items := deps.List()
+	sort.Strings(items)
+
+	return items
+}
+
+// resolveLocalDependencies resolves the given dependencies into artifacts of the local Maven repository,
+// by running the same steps the builder uses to compute the dependencies of an integration kit
+func resolveLocalDependencies(ctx context.Context, catalog *camel.RuntimeCatalog, dependencies []string, repositories []string, dir string) ([]v1.Artifact, error) {
+	bc := builder.Context{
+		C:       cancellable.NewContextWithParent(ctx),
+		Catalog: catalog,
+		Path:    dir,
+		Build: v1.BuilderTask{
+			Runtime:      catalog.Runtime,
+			Dependencies: dependencies,
+			Repositories: repositories,
+		},
+	}
+
+	steps := []builder.Step{
+		runtime.Steps.GenerateProject,
+		builder.Steps.InjectDependencies,
+		builder.Steps.InjectRepositories,
+		builder.Steps.SanitizeDependencies,
+		runtime.Steps.ComputeDependencies,
+	}
+
+	for _, step := range steps {
+		if err := step.Execute(&bc); err != nil {
+			return nil, err
+		}
+	}
+
+	return bc.Artifacts, nil
+}
+
+// writeLocalProperties writes the given property files and properties into a single
+// properties file suitable for the runtime configuration
+func writeLocalProperties(fileName string, propertyFiles []string, props []string) error {
+	p := properties.NewProperties()
+
+	for _, propertyFile := range propertyFiles {
+		pf, err := loadPropertyFile(propertyFile)
+		if err != nil {
+			return err
+		}
+		p.Merge(pf)
+	}
+
+	for _, item := range props {
+		kv := strings.SplitN(item, "=", 2)
+		if len(kv) != 2 {
+			return fmt.Errorf("invalid property %s, expected \"<key>=<value>\"", item)
+		}
+		if _, _, err := p.Set(kv[0], kv[1]); err != nil {
+			return err
+		}
+	}
+
+	f, err := os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = p.Write(f, properties.UTF8)
+	return err
+}
@@ -0,0 +1,135 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/registry"
+)
+
+const (
+	ociScheme = "oci://"
+
+	// ociTitleAnnotation is the layer annotation holding the name of the file
+	// stored in an OCI artifact
+	ociTitleAnnotation = "org.opencontainers.image.title"
+)
+
+// An ociFile is a file extracted from an OCI artifact
+type ociFile struct {
+	name    string
+	content []byte
+}
+
+func isOCISource(source string) bool {
+	return strings.HasPrefix(source, ociScheme)
+}
+
+func parseOCIReference(source string) (name.Reference, error) {
+	ref, err := name.ParseReference(strings.TrimPrefix(source, ociScheme))
+	if err != nil {
+		return nil, fmt.Errorf("malformed oci reference: %s: %v", source, err)
+	}
+	return ref, nil
+}
+
+// pullOCISources pulls the OCI artifact referenced by the given source and returns the route files
+// it contains, i.e. the layers named after a file having a known language extension
+func pullOCISources(source string, dockerConfig []byte) ([]ociFile, error) {
+	ref, err := parseOCIReference(source)
+	if err != nil {
+		return nil, err
+	}
+
+	auth := authn.Anonymous
+	if dockerConfig != nil {
+		a, err := registry.ParseDockerConfig(dockerConfig, ref.Context().RegistryStr())
+		if err != nil {
+			return nil, err
+		}
+		if a.IsSet() {
+			auth = &authn.Basic{
+				Username: a.Username,
+				Password: a.Password,
+			}
+		}
+	}
+
+	img, err := remote.Image(ref, remote.WithAuth(auth))
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, err := img.Manifest()
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]ociFile, 0, len(manifest.Layers))
+	for _, descriptor := range manifest.Layers {
+		fileName := path.Base(descriptor.Annotations[ociTitleAnnotation])
+		if fileName == "." || fileName == "/" {
+			continue
+		}
+
+		spec := v1.SourceSpec{
+			DataSpec: v1.DataSpec{
+				Name: fileName,
+			},
+		}
+		if spec.InferLanguage() == "" {
+			continue
+		}
+
+		layer, err := img.LayerByDigest(descriptor.Digest)
+		if err != nil {
+			return nil, err
+		}
+
+		// artifact layers are plain files, so the blob is read as it is stored
+		r, err := layer.Compressed()
+		if err != nil {
+			return nil, err
+		}
+		content, err := ioutil.ReadAll(r)
+		_ = r.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, ociFile{
+			name:    fileName,
+			content: content,
+		})
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no route files found in oci artifact %s", source)
+	}
+
+	return files, nil
+}
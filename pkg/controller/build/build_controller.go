@@ -26,6 +26,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -89,6 +90,50 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 		return err
 	}
 
+	// Watch for changes to the registry Secrets and requeue the failed Builds, in case the credentials have been rotated
+	err = c.Watch(&source.Kind{Type: &corev1.Secret{}}, &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(func(a handler.MapObject) []reconcile.Request {
+			var requests []reconcile.Request
+
+			list := &v1.BuildList{}
+			if err := mgr.GetClient().List(context.TODO(), list, k8sclient.InNamespace(a.Meta.GetNamespace())); err != nil {
+				Log.Error(err, "Failed to retrieve build list")
+				return requests
+			}
+
+			for _, build := range list.Items {
+				if build.Status.Phase == v1.BuildPhaseFailed && build.Status.RegistrySecretVersion != "" {
+					requests = append(requests, reconcile.Request{
+						NamespacedName: types.NamespacedName{
+							Namespace: build.Namespace,
+							Name:      build.Name,
+						},
+					})
+				}
+			}
+
+			return requests
+		}),
+	}, predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return isRegistrySecret(context.TODO(), mgr.GetClient(), e.Meta)
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			// Ignore resyncs, and the Secrets that are not used to authenticate against the registry
+			return e.MetaOld.GetResourceVersion() != e.MetaNew.GetResourceVersion() &&
+				isRegistrySecret(context.TODO(), mgr.GetClient(), e.MetaNew)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return false
+		},
+	})
+	if err != nil {
+		return err
+	}
+
 	// Watch for changes to secondary resource Pods and requeue the owner Build
 	err = c.Watch(&source.Kind{Type: &corev1.Pod{}},
 		&handler.EnqueueRequestForOwner{
@@ -180,7 +225,12 @@ func (r *ReconcileBuild) Reconcile(request reconcile.Request) (reconcile.Result,
 
 	var actions []Action
 
-	switch pl.Status.Build.BuildStrategy {
+	strategy := target.Spec.Strategy
+	if strategy == "" {
+		strategy = pl.Status.Build.BuildStrategy
+	}
+
+	switch strategy {
 	case v1.IntegrationPlatformBuildStrategyPod:
 		actions = []Action{
 			NewInitializePodAction(),
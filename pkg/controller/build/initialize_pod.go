@@ -29,6 +29,7 @@ import (
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/platform"
 )
 
 // NewInitializePodAction creates a new initialize action
@@ -62,6 +63,28 @@ func (action *initializePodAction) Handle(ctx context.Context, build *v1.Build)
 		return nil, err
 	}
 
+	if build.Spec.Strategy == v1.IntegrationPlatformBuildStrategyPod {
+		// The builder service account is only created by the platform when it uses
+		// the pod strategy, so it has to be ensured when the build overrides it
+		pl, err := platform.GetOrLookupCurrent(ctx, action.client, build.Namespace, build.Status.Platform)
+		if err != nil {
+			return nil, err
+		}
+		if pl.Status.Build.BuildStrategy != v1.IntegrationPlatformBuildStrategyPod {
+			if err := platform.CreateBuilderServiceAccount(ctx, action.client, pl); err != nil {
+				return nil, errors.Wrap(err, "cannot ensure builder service account is present")
+			}
+		}
+	}
+
+	version, err := registrySecretVersion(ctx, action.client, build)
+	if err != nil {
+		return nil, err
+	}
+
+	build.Status.RegistrySecretVersion = version
+	// Clear the error of the previous attempt, the failure keeps track of the initial reason
+	build.Status.Error = ""
 	build.Status.Phase = v1.BuildPhaseScheduling
 
 	return build, nil
@@ -44,6 +44,14 @@ func (action *initializeRoutineAction) CanHandle(build *v1.Build) bool {
 
 // Handle handles the builds
 func (action *initializeRoutineAction) Handle(ctx context.Context, build *v1.Build) (*v1.Build, error) {
+	version, err := registrySecretVersion(ctx, action.client, build)
+	if err != nil {
+		return nil, err
+	}
+
+	build.Status.RegistrySecretVersion = version
+	// Clear the error of the previous attempt, the failure keeps track of the initial reason
+	build.Status.Error = ""
 	build.Status.Phase = v1.BuildPhaseScheduling
 
 	return build, nil
@@ -19,6 +19,7 @@ package build
 
 import (
 	"context"
+	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -85,11 +86,31 @@ func (action *monitorPodAction) Handle(ctx context.Context, build *v1.Build) (*v
 	case pod.Status.Phase == corev1.PodFailed:
 		build.Status.Phase = v1.BuildPhaseFailed
 		build.Status.Duration = metav1.Now().Sub(build.Status.StartedAt.Time).String()
+		// The builder task reports its own error, while the image tasks only report their termination message
+		if build.Status.Error == "" {
+			build.Status.Error = podFailureMessage(pod)
+		}
 	}
 
 	return build, nil
 }
 
+// podFailureMessage returns the termination message of the first container of the pod that failed
+func podFailureMessage(pod *corev1.Pod) string {
+	statuses := make([]corev1.ContainerStatus, 0, len(pod.Status.InitContainerStatuses)+len(pod.Status.ContainerStatuses))
+	statuses = append(statuses, pod.Status.InitContainerStatuses...)
+	statuses = append(statuses, pod.Status.ContainerStatuses...)
+	for _, status := range statuses {
+		if t := status.State.Terminated; t != nil && t.ExitCode != 0 {
+			if t.Message != "" {
+				return fmt.Sprintf("%s: %s", status.Name, t.Message)
+			}
+			return fmt.Sprintf("%s: %s (exit code %d)", status.Name, t.Reason, t.ExitCode)
+		}
+	}
+	return ""
+}
+
 func (action *monitorPodAction) isPodScheduled(pod *corev1.Pod) bool {
 	for _, condition := range pod.Status.Conditions {
 		if condition.Type == corev1.PodScheduled && condition.Status == corev1.ConditionTrue {
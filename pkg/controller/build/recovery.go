@@ -72,6 +72,27 @@ func (action *errorRecoveryAction) Handle(ctx context.Context, build *v1.Build)
 		return build, nil
 	}
 
+	if build.Status.RegistrySecretVersion != "" && isRegistryAuthenticationFailure(build) {
+		version, err := registrySecretVersion(ctx, action.client, build)
+		if err != nil {
+			return nil, err
+		}
+		if version != "" && version != build.Status.RegistrySecretVersion {
+			// The credentials have been rotated since the build was initialized, so retry straight away,
+			// still counting the attempt so that repeated rotations cannot retry the build endlessly
+			build.Status.Phase = v1.BuildPhaseInitialization
+			build.Status.Failure.Recovery.Attempt++
+			build.Status.Failure.Recovery.AttemptTime = metav1.Now()
+
+			action.L.Infof("Registry secret updated since the build failed, recovery attempt (%d/%d)",
+				build.Status.Failure.Recovery.Attempt,
+				build.Status.Failure.Recovery.AttemptMax,
+			)
+
+			return build, nil
+		}
+	}
+
 	lastAttempt := build.Status.Failure.Recovery.AttemptTime.Time
 	if lastAttempt.IsZero() {
 		lastAttempt = build.Status.Failure.Time.Time
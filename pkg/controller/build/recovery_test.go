@@ -0,0 +1,122 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/log"
+	"github.com/apache/camel-k/pkg/util/test"
+)
+
+func TestErrorRecoveryOnRegistrySecretRotation(t *testing.T) {
+	testCases := []struct {
+		name          string
+		error         string
+		secretVersion string
+		attempt       int
+		phase         v1.BuildPhase
+		retried       bool
+	}{
+		{
+			name:          "rotated secret",
+			error:         "UNAUTHORIZED: authentication required",
+			secretVersion: "2",
+			attempt:       1,
+			phase:         v1.BuildPhaseInitialization,
+			retried:       true,
+		},
+		{
+			name:          "unchanged secret",
+			error:         "UNAUTHORIZED: authentication required",
+			secretVersion: "1",
+			attempt:       1,
+			retried:       false,
+		},
+		{
+			name:          "other error",
+			error:         "compilation failure",
+			secretVersion: "2",
+			attempt:       1,
+			retried:       false,
+		},
+		{
+			name:          "no error",
+			secretVersion: "2",
+			attempt:       1,
+			retried:       false,
+		},
+		{
+			name:          "max attempts",
+			error:         "UNAUTHORIZED: authentication required",
+			secretVersion: "2",
+			attempt:       5,
+			phase:         v1.BuildPhaseError,
+			retried:       true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := test.NewFakeClient(
+				newRegistryPlatform("registry-secret"),
+				newRegistrySecret("registry-secret", tc.secretVersion),
+			)
+			assert.Nil(t, err)
+
+			build := newRegistryBuild()
+			build.Status.Error = tc.error
+			build.Status.RegistrySecretVersion = "1"
+			build.Status.Failure = &v1.Failure{
+				Reason: tc.error,
+				Time:   metav1.Now(),
+				Recovery: v1.FailureRecovery{
+					Attempt:    tc.attempt,
+					AttemptMax: 5,
+					// The back-off delay is not elapsed yet
+					AttemptTime: metav1.Now(),
+				},
+			}
+
+			a := NewErrorRecoveryAction()
+			a.InjectLogger(log.Log)
+			a.InjectClient(c)
+
+			answer, err := a.Handle(context.TODO(), build)
+			assert.Nil(t, err)
+
+			if !tc.retried {
+				assert.Nil(t, answer)
+				return
+			}
+
+			assert.NotNil(t, answer)
+			assert.Equal(t, tc.phase, answer.Status.Phase)
+			if tc.phase == v1.BuildPhaseInitialization {
+				// The rotation retry still counts as an attempt
+				assert.Equal(t, tc.attempt+1, answer.Status.Failure.Recovery.Attempt)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"context"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/platform"
+)
+
+// The messages returned by registries, or reported by image builders, when the credentials are rejected
+var registryAuthenticationErrors = []string{
+	"unauthorized",
+	"authentication required",
+	"access denied",
+	"denied: requested access",
+}
+
+// registrySecretVersion returns the resource version of the registry Secret configured on the platform of the build,
+// or an empty string when no Secret is configured, or it cannot be found
+func registrySecretVersion(ctx context.Context, c k8sclient.Reader, build *v1.Build) (string, error) {
+	pl, err := platform.GetOrLookupCurrent(ctx, c, build.Namespace, build.Status.Platform)
+	if err != nil {
+		return "", err
+	}
+	if pl.Status.Build.Registry.Secret == "" {
+		return "", nil
+	}
+
+	secret := corev1.Secret{}
+	key := k8sclient.ObjectKey{Namespace: build.Namespace, Name: pl.Status.Build.Registry.Secret}
+	if err := c.Get(ctx, key, &secret); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return secret.ResourceVersion, nil
+}
+
+// isRegistrySecret returns whether the Secret is the registry Secret of one of the platforms in its namespace
+func isRegistrySecret(ctx context.Context, c k8sclient.Reader, secret metav1.Object) bool {
+	platforms, err := platform.ListPlatforms(ctx, c, secret.GetNamespace())
+	if err != nil {
+		Log.Error(err, "Failed to retrieve integration platform list")
+		return false
+	}
+
+	for _, pl := range platforms.Items {
+		if pl.Status.Build.Registry.Secret == secret.GetName() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isRegistryAuthenticationFailure returns whether the build failed because the registry credentials were rejected.
+// Failures without any reason are not retried, as nothing tells the registry credentials are the cause.
+func isRegistryAuthenticationFailure(build *v1.Build) bool {
+	reason := build.Status.Error
+	if reason == "" && build.Status.Failure != nil {
+		reason = build.Status.Failure.Reason
+	}
+	if reason == "" {
+		return false
+	}
+
+	reason = strings.ToLower(reason)
+	for _, e := range registryAuthenticationErrors {
+		if strings.Contains(reason, e) {
+			return true
+		}
+	}
+
+	return false
+}
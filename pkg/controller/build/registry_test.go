@@ -0,0 +1,172 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/test"
+)
+
+func TestRegistrySecretVersion(t *testing.T) {
+	testCases := []struct {
+		name    string
+		secret  string
+		objects []runtime.Object
+		version string
+	}{
+		{
+			name:    "no registry secret",
+			version: "",
+		},
+		{
+			name:    "missing registry secret",
+			secret:  "registry-secret",
+			version: "",
+		},
+		{
+			name:    "registry secret",
+			secret:  "registry-secret",
+			objects: []runtime.Object{newRegistrySecret("registry-secret", "2")},
+			version: "2",
+		},
+		{
+			name:    "other secret",
+			secret:  "registry-secret",
+			objects: []runtime.Object{newRegistrySecret("other-secret", "2")},
+			version: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := test.NewFakeClient(append(tc.objects, newRegistryPlatform(tc.secret))...)
+			assert.Nil(t, err)
+
+			version, err := registrySecretVersion(context.TODO(), c, newRegistryBuild())
+			assert.Nil(t, err)
+			assert.Equal(t, tc.version, version)
+		})
+	}
+}
+
+func TestIsRegistrySecret(t *testing.T) {
+	c, err := test.NewFakeClient(newRegistryPlatform("registry-secret"))
+	assert.Nil(t, err)
+
+	assert.True(t, isRegistrySecret(context.TODO(), c, &metav1.ObjectMeta{Namespace: "ns", Name: "registry-secret"}))
+	assert.False(t, isRegistrySecret(context.TODO(), c, &metav1.ObjectMeta{Namespace: "ns", Name: "other-secret"}))
+	assert.False(t, isRegistrySecret(context.TODO(), c, &metav1.ObjectMeta{Namespace: "other", Name: "registry-secret"}))
+}
+
+func TestIsRegistryAuthenticationFailure(t *testing.T) {
+	testCases := []struct {
+		name    string
+		error   string
+		reason  string
+		failure bool
+	}{
+		{
+			name:    "no reason",
+			failure: false,
+		},
+		{
+			name:    "unauthorized",
+			error:   "kaniko: error pushing image: UNAUTHORIZED: authentication required",
+			failure: true,
+		},
+		{
+			name:    "access denied",
+			error:   "buildah: Error: error copying image: Access Denied",
+			failure: true,
+		},
+		{
+			name:    "failure reason",
+			reason:  "denied: requested access to the resource is denied",
+			failure: true,
+		},
+		{
+			name:    "current error over failure reason",
+			error:   "compilation failure",
+			reason:  "unauthorized",
+			failure: false,
+		},
+		{
+			name:    "other error",
+			error:   "compilation failure",
+			failure: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			build := newRegistryBuild()
+			build.Status.Error = tc.error
+			if tc.reason != "" {
+				build.Status.Failure = &v1.Failure{Reason: tc.reason}
+			}
+
+			assert.Equal(t, tc.failure, isRegistryAuthenticationFailure(build))
+		})
+	}
+}
+
+func newRegistryPlatform(secret string) *v1.IntegrationPlatform {
+	pl := v1.NewIntegrationPlatform("ns", "camel-k")
+	pl.Status.Phase = v1.IntegrationPlatformPhaseReady
+	pl.Status.Build.Registry.Secret = secret
+	return &pl
+}
+
+func newRegistrySecret(name string, version string) *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "ns",
+			Name:            name,
+			ResourceVersion: version,
+		},
+	}
+}
+
+func newRegistryBuild() *v1.Build {
+	return &v1.Build{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.BuildKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-build",
+		},
+		Status: v1.BuildStatus{
+			Phase: v1.BuildPhaseFailed,
+		},
+	}
+}
@@ -0,0 +1,55 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+// canBeScheduled returns whether the given build can be scheduled with respect to the
+// other builds of the namespace, according to its order strategy
+func canBeScheduled(build *v1.Build, builds []v1.Build) bool {
+	for i := range builds {
+		b := &builds[i]
+		if b.Name == build.Name {
+			continue
+		}
+
+		running := b.Status.Phase == v1.BuildPhasePending || b.Status.Phase == v1.BuildPhaseRunning
+
+		switch build.Spec.OrderStrategy {
+		case v1.BuildOrderStrategyFIFO:
+			// Let the builds created before this one be scheduled first
+			if b.Status.Phase == v1.BuildPhaseScheduling && b.CreationTimestamp.Before(&build.CreationTimestamp) {
+				return false
+			}
+			// Builds that are not FIFO expect to run alone
+			if running && b.Spec.OrderStrategy != v1.BuildOrderStrategyFIFO {
+				return false
+			}
+		default:
+			// Emulate a serialized working queue to only allow one build to run at a given time.
+			// This is currently necessary for the incremental build to work as expected.
+			if running {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -73,13 +73,9 @@ func (action *schedulePodAction) Handle(ctx context.Context, build *v1.Build) (*
 		return nil, err
 	}
 
-	// Emulate a serialized working queue to only allow one build to run at a given time.
-	// This is currently necessary for the incremental build to work as expected.
-	for _, b := range builds.Items {
-		if b.Status.Phase == v1.BuildPhasePending || b.Status.Phase == v1.BuildPhaseRunning {
-			// Let's requeue the build in case one is already running
-			return nil, nil
-		}
+	if !canBeScheduled(build, builds.Items) {
+		// Let's requeue the build in case it has to wait for other builds
+		return nil, nil
 	}
 
 	pod, err := getBuilderPod(ctx, action.client, build)
@@ -173,6 +169,7 @@ func (action *schedulePodAction) addBuilderTaskToPod(build *v1.Build, task *v1.B
 			task.Name,
 		},
 		VolumeMounts: task.VolumeMounts,
+		Resources:    task.ResourceRequirements,
 	})
 
 	action.addBaseTaskToPod(&task.BaseTask, pod)
@@ -189,6 +186,10 @@ func (action *schedulePodAction) addImageTaskToPod(task *v1.ImageTask, pod *core
 		WorkingDir:      task.WorkingDir,
 		VolumeMounts:    task.VolumeMounts,
 		SecurityContext: task.SecurityContext,
+		Resources:       task.ResourceRequirements,
+		// The image builders do not write any termination message, so that the last
+		// logs are reported instead, to tell the cause of a failure
+		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
 	})
 
 	action.addBaseTaskToPod(&task.BaseTask, pod)
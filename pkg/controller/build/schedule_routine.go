@@ -72,13 +72,9 @@ func (action *scheduleRoutineAction) Handle(ctx context.Context, build *v1.Build
 		return nil, err
 	}
 
-	// Emulate a serialized working queue to only allow one build to run at a given time.
-	// This is currently necessary for the incremental build to work as expected.
-	for _, b := range builds.Items {
-		if b.Status.Phase == v1.BuildPhasePending || b.Status.Phase == v1.BuildPhaseRunning {
-			// Let's requeue the build in case one is already running
-			return nil, nil
-		}
+	if !canBeScheduled(build, builds.Items) {
+		// Let's requeue the build in case it has to wait for other builds
+		return nil, nil
 	}
 
 	// Transition the build to pending state
@@ -20,6 +20,7 @@ package integration
 import (
 	"context"
 	"encoding/json"
+	"reflect"
 
 	"github.com/pkg/errors"
 
@@ -97,7 +98,7 @@ func LookupKitForIntegration(ctx context.Context, c k8sclient.Reader, integratio
 		// characteristics required by the integration.
 		//
 		// A kit can be used only if it contains a subset of the traits and related configurations
-		// declared on integration.
+		// declared on integration, and the same properties determining the kit image.
 		match, err := HasMatchingTraits(&kit, integration)
 		if err != nil {
 			return nil, err
@@ -113,6 +114,15 @@ func LookupKitForIntegration(ctx context.Context, c k8sclient.Reader, integratio
 	return nil, nil
 }
 
+// kitImageTraitProperties are the trait properties that determine the content of the kit image. They must be
+// configured identically on the kit and the integration, so that an integration does not get a kit built without
+// them, while the kit trait configuration is otherwise only required to be a subset of the integration one.
+var kitImageTraitProperties = map[string][]string{
+	"builder": {"baseImage", "imageInstructions"},
+	"quarkus": {"native", "packageTypes"},
+	"cors":    {"enabled"},
+}
+
 // HasMatchingTraits compare traits defined on kit against those defined on integration.
 func HasMatchingTraits(kit *v1.IntegrationKit, integration *v1.Integration) (bool, error) {
 	for name, kitTrait := range kit.Spec.Traits {
@@ -121,21 +131,11 @@ func HasMatchingTraits(kit *v1.IntegrationKit, integration *v1.Integration) (boo
 			// skip it because trait configured on kit is not defined on integration
 			return false, nil
 		}
-		data, err := json.Marshal(intTrait.Configuration)
+		intConf, err := traitConfiguration(intTrait)
 		if err != nil {
 			return false, err
 		}
-		intConf := make(map[string]interface{})
-		err = json.Unmarshal(data, &intConf)
-		if err != nil {
-			return false, err
-		}
-		data, err = json.Marshal(kitTrait.Configuration)
-		if err != nil {
-			return false, err
-		}
-		kitConf := make(map[string]interface{})
-		err = json.Unmarshal(data, &kitConf)
+		kitConf, err := traitConfiguration(kitTrait)
 		if err != nil {
 			return false, err
 		}
@@ -146,7 +146,7 @@ func HasMatchingTraits(kit *v1.IntegrationKit, integration *v1.Integration) (boo
 				// in integration trait
 				return false, nil
 			}
-			if iv != cv {
+			if !reflect.DeepEqual(iv, cv) {
 				// skip it because trait configured on kit has a value that differs from
 				// the one configured on integration
 				return false, nil
@@ -154,5 +154,37 @@ func HasMatchingTraits(kit *v1.IntegrationKit, integration *v1.Integration) (boo
 		}
 	}
 
+	for name, properties := range kitImageTraitProperties {
+		intConf, err := traitConfiguration(integration.Spec.Traits[name])
+		if err != nil {
+			return false, err
+		}
+		kitConf, err := traitConfiguration(kit.Spec.Traits[name])
+		if err != nil {
+			return false, err
+		}
+		for _, p := range properties {
+			if !reflect.DeepEqual(intConf[p], kitConf[p]) {
+				// skip it because the kit image has not been built with the integration configuration
+				return false, nil
+			}
+		}
+	}
+
 	return true, nil
 }
+
+func traitConfiguration(trait v1.TraitSpec) (map[string]interface{}, error) {
+	conf := make(map[string]interface{})
+	if len(trait.Configuration.RawMessage) == 0 {
+		return conf, nil
+	}
+	data, err := json.Marshal(trait.Configuration)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &conf); err != nil {
+		return nil, err
+	}
+	return conf, nil
+}
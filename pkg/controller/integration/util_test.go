@@ -271,3 +271,66 @@ func TestLookupKitForIntegration_DiscardKitsWithIncompatibleTraits(t *testing.T)
 	assert.NotNil(t, i)
 	assert.Equal(t, "my-kit-4", i.Name)
 }
+
+func TestHasMatchingTraits_KitImageProperties(t *testing.T) {
+	kit := &v1.IntegrationKit{
+		Spec: v1.IntegrationKitSpec{
+			Traits: map[string]v1.TraitSpec{
+				"builder": test.TraitSpecFromMap(t, map[string]interface{}{
+					"verbose": true,
+				}),
+			},
+		},
+	}
+	integration := &v1.Integration{
+		Spec: v1.IntegrationSpec{
+			Traits: map[string]v1.TraitSpec{
+				"builder": test.TraitSpecFromMap(t, map[string]interface{}{
+					"verbose":           true,
+					"baseImage":         "my-registry/my-base:1",
+					"imageInstructions": []string{"RUN apk add curl"},
+				}),
+			},
+		},
+	}
+
+	// The kit has not been built from the integration base image
+	match, err := HasMatchingTraits(kit, integration)
+	assert.Nil(t, err)
+	assert.False(t, match)
+
+	// Neither from its image instructions
+	kit.Spec.Traits["builder"] = test.TraitSpecFromMap(t, map[string]interface{}{
+		"verbose":   true,
+		"baseImage": "my-registry/my-base:1",
+	})
+	match, err = HasMatchingTraits(kit, integration)
+	assert.Nil(t, err)
+	assert.False(t, match)
+
+	kit.Spec.Traits["builder"] = test.TraitSpecFromMap(t, map[string]interface{}{
+		"baseImage":         "my-registry/my-base:1",
+		"imageInstructions": []string{"RUN apk add curl"},
+	})
+	match, err = HasMatchingTraits(kit, integration)
+	assert.Nil(t, err)
+	assert.True(t, match)
+
+	// A default kit does not match an integration built from another base image
+	match, err = HasMatchingTraits(&v1.IntegrationKit{}, integration)
+	assert.Nil(t, err)
+	assert.False(t, match)
+
+	// Nor an integration without base image a kit built from one
+	match, err = HasMatchingTraits(kit, &v1.Integration{
+		Spec: v1.IntegrationSpec{
+			Traits: map[string]v1.TraitSpec{
+				"builder": test.TraitSpecFromMap(t, map[string]interface{}{
+					"verbose": true,
+				}),
+			},
+		},
+	})
+	assert.Nil(t, err)
+	assert.False(t, match)
+}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -93,7 +94,9 @@ func (action *buildAction) handleBuildSubmitted(ctx context.Context, kit *v1.Int
 				Labels:    kubernetes.FilterCamelCreatorLabels(kit.Labels),
 			},
 			Spec: v1.BuildSpec{
-				Tasks: env.BuildTasks,
+				Tasks:         env.BuildTasks,
+				Strategy:      env.BuildStrategy,
+				OrderStrategy: env.BuildOrderStrategy,
 			},
 		}
 
@@ -115,6 +118,8 @@ func (action *buildAction) handleBuildSubmitted(ctx context.Context, kit *v1.Int
 
 	if build.Status.Phase == v1.BuildPhaseRunning {
 		kit.Status.Phase = v1.IntegrationKitPhaseBuildRunning
+		kit.Status.SetCondition(v1.IntegrationKitConditionBuildRunning, corev1.ConditionTrue,
+			v1.IntegrationKitConditionBuildRunningReason, fmt.Sprintf("build %s is running", build.Name))
 		return kit, nil
 	}
 
@@ -158,6 +163,8 @@ func (action *buildAction) handleBuildRunning(ctx context.Context, kit *v1.Integ
 		}
 
 		kit.Status.Phase = v1.IntegrationKitPhaseReady
+		kit.Status.SetCondition(v1.IntegrationKitConditionBuildRunning, corev1.ConditionFalse,
+			v1.IntegrationKitConditionBuildSucceededReason, fmt.Sprintf("build %s succeeded in %s", build.Name, build.Status.Duration))
 		kit.Status.Artifacts = make([]v1.Artifact, 0, len(build.Status.Artifacts))
 
 		for _, a := range build.Status.Artifacts {
@@ -185,6 +192,8 @@ func (action *buildAction) handleBuildRunning(ctx context.Context, kit *v1.Integ
 		// Let's copy the build failure to the integration kit status
 		kit.Status.Failure = build.Status.Failure
 		kit.Status.Phase = v1.IntegrationKitPhaseError
+		kit.Status.SetCondition(v1.IntegrationKitConditionBuildRunning, corev1.ConditionFalse,
+			v1.IntegrationKitConditionBuildFailedReason, fmt.Sprintf("build %s failed after %s: %s", build.Name, build.Status.Duration, build.Status.Error))
 
 		return kit, nil
 	}
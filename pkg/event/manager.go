@@ -62,6 +62,11 @@ const (
 
 	// ReasonRelatedObjectChanged --
 	ReasonRelatedObjectChanged = "ReasonRelatedObjectChanged"
+
+	// ReasonGarbageCollected --
+	ReasonGarbageCollected = "GarbageCollected"
+	// ReasonGarbageCollectionError --
+	ReasonGarbageCollectionError = "GarbageCollectionError"
 )
 
 // NotifyIntegrationError automatically generates error events when the integration reconcile cycle phase has an error
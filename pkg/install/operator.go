@@ -153,6 +153,13 @@ func OperatorOrCollect(ctx context.Context, c client.Client, cfg OperatorConfigu
 		fmt.Println("Warning: the operator will not be able to create servicemonitors for metrics. Try installing as cluster-admin to allow the creation of servicemonitors.")
 	}
 
+	if errpc := installPriorityClasses(ctx, c, cfg.Namespace, customizer, collection, force); errpc != nil {
+		if k8serrors.IsAlreadyExists(errpc) {
+			return errpc
+		}
+		fmt.Println("Warning: the operator will not be able to check priority classes. Try installing as cluster-admin to allow it to read priority classes.")
+	}
+
 	return nil
 }
 
@@ -188,6 +195,20 @@ func installEvents(ctx context.Context, c client.Client, namespace string, custo
 	)
 }
 
+func installPriorityClasses(ctx context.Context, c client.Client, namespace string, customizer ResourceCustomizer, collection *kubernetes.Collection, force bool) error {
+	return ResourcesOrCollect(ctx, c, namespace, collection, force, func(o runtime.Object) runtime.Object {
+		// Cluster role bindings are not namespaced, bind the operator service account of each installation
+		if rb, ok := o.(*v1beta1.ClusterRoleBinding); ok {
+			rb.Name = fmt.Sprintf("%s-%s", rb.Name, namespace)
+			rb.Subjects[0].Namespace = namespace
+		}
+		return customizer(o)
+	},
+		"operator-cluster-role-priorityclasses.yaml",
+		"operator-cluster-role-binding-priorityclasses.yaml",
+	)
+}
+
 func installServiceMonitors(ctx context.Context, c client.Client, namespace string, customizer ResourceCustomizer, collection *kubernetes.Collection, force bool) error {
 	return ResourcesOrCollect(ctx, c, namespace, collection, force, customizer,
 		"operator-role-servicemonitors.yaml",
@@ -753,3 +753,111 @@ func TestYAMLLanguageDependencies(t *testing.T) {
 		},
 		meta.Dependencies.List())
 }
+
+func TestDataFormatDependencies(t *testing.T) {
+	code := v1.SourceSpec{
+		DataSpec: v1.DataSpec{
+			Name: "Request.java",
+			Content: `
+			    from("http:test")
+			        .unmarshal().json(JsonLibrary.Gson)
+			        .marshal().csv()
+			        .marshal().base64()
+			        .unmarshal()
+			            .zipFile()
+			        .marshal().jacksonxml()
+			        .to("log:end");
+		    `,
+		},
+		Language: v1.LanguageJavaSource,
+	}
+
+	catalog, err := camel.DefaultCatalog()
+	assert.Nil(t, err)
+
+	meta := Extract(catalog, code)
+
+	assert.ElementsMatch(
+		t,
+		[]string{
+			"camel:http",
+			"camel:gson",
+			"camel:csv",
+			"camel:base64",
+			"camel:zipfile",
+			"camel:jacksonxml",
+			"camel:log",
+		},
+		meta.Dependencies.List())
+}
+
+func TestDataFormatDependenciesQuarkus(t *testing.T) {
+	code := v1.SourceSpec{
+		DataSpec: v1.DataSpec{
+			Name: "Request.java",
+			Content: `
+			    from("http:test").marshal().yaml().unmarshal().bindy(BindyType.Csv, Order.class).to("log:end");
+		    `,
+		},
+		Language: v1.LanguageJavaSource,
+	}
+
+	catalog, err := camel.QuarkusCatalog()
+	assert.Nil(t, err)
+
+	meta := Extract(catalog, code)
+
+	assert.ElementsMatch(
+		t,
+		[]string{
+			"camel-quarkus:http",
+			"camel-quarkus:snakeyaml",
+			"camel-quarkus:bindy",
+			"camel-quarkus:log",
+		},
+		meta.Dependencies.List())
+}
+
+func TestYAMLDataFormatDependencies(t *testing.T) {
+	code := v1.SourceSpec{
+		DataSpec: v1.DataSpec{
+			Name:    "routes.yaml",
+			Content: yamlWithDataFormats,
+		},
+		Language: v1.LanguageYaml,
+	}
+
+	catalog, err := camel.DefaultCatalog()
+	assert.Nil(t, err)
+
+	meta := Extract(catalog, code)
+
+	assert.ElementsMatch(
+		t,
+		[]string{
+			"camel:direct",
+			"camel:jackson",
+			"camel:gson",
+			"camel:csv",
+			"camel:zip-deflater",
+			"camel:log",
+		},
+		meta.Dependencies.List())
+}
+
+const yamlWithDataFormats = `
+- from:
+    uri: "direct:start"
+    steps:
+        - unmarshal:
+            json: {}
+        - marshal:
+            json:
+                library: Gson
+        - marshal:
+            csv:
+                delimiter: ";"
+        - unmarshal:
+            gzip-deflater: {}
+        - to: "log:info"
+`
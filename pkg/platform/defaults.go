@@ -96,7 +96,7 @@ func ConfigureDefaults(ctx context.Context, c client.Client, p *v1.IntegrationPl
 	}
 
 	if p.Status.Build.BuildStrategy == v1.IntegrationPlatformBuildStrategyPod {
-		if err := createBuilderServiceAccount(ctx, c, p); err != nil {
+		if err := CreateBuilderServiceAccount(ctx, c, p); err != nil {
 			return errors.Wrap(err, "cannot ensure service account is present")
 		}
 	}
@@ -309,7 +309,8 @@ func createServiceCaBundleConfigMap(ctx context.Context, client client.Client, p
 	return cm, nil
 }
 
-func createBuilderServiceAccount(ctx context.Context, client client.Client, p *v1.IntegrationPlatform) error {
+// CreateBuilderServiceAccount creates the service account used by the build pods, if it does not exist yet
+func CreateBuilderServiceAccount(ctx context.Context, client client.Client, p *v1.IntegrationPlatform) error {
 	sa := corev1.ServiceAccount{}
 	key := k8sclient.ObjectKey{
 		Name:      BuilderServiceAccount,
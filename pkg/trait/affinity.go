@@ -50,8 +50,38 @@ type affinityTrait struct {
 	// Defines a set of pods (namely those matching the label selector, relative to the given namespace) that the
 	// integration pod(s) should not be co-located with.
 	PodAntiAffinityLabels []string `property:"pod-anti-affinity-labels" json:"podAntiAffinityLabels,omitempty"`
+	// The key of node labels used to spread the integration pod(s) across topology domains, e.g. `topology.kubernetes.io/zone`.
+	// No topology spread constraint is added when not set.
+	TopologySpreadKey string `property:"topology-spread-key" json:"topologySpreadKey,omitempty"`
+	// The maximum difference of the number of integration pod(s) between any two topology domains (default *1*).
+	TopologySpreadMaxSkew int32 `property:"topology-spread-max-skew" json:"topologySpreadMaxSkew,omitempty"`
+	// How to deal with integration pod(s) that don't satisfy the spread constraint, either `DoNotSchedule` or `ScheduleAnyway`
+	// (default *DoNotSchedule*).
+	TopologySpreadWhenUnsatisfiable string `property:"topology-spread-when-unsatisfiable" json:"topologySpreadWhenUnsatisfiable,omitempty"`
+	// Whether the node affinity rule must be met for the integration pod(s) to be scheduled, or is only a preference
+	// the scheduler tries to enforce, either `required` or `preferred` (default *required*).
+	NodeAffinityType string `property:"node-affinity-type" json:"nodeAffinityType,omitempty"`
+	// The weight of the preferred node affinity rule, in the range 1-100 (default *100*).
+	NodeAffinityWeight int32 `property:"node-affinity-weight" json:"nodeAffinityWeight,omitempty"`
+	// Whether the pod affinity rule must be met for the integration pod(s) to be scheduled, or is only a preference
+	// the scheduler tries to enforce, either `required` or `preferred` (default *required*).
+	PodAffinityType string `property:"pod-affinity-type" json:"podAffinityType,omitempty"`
+	// The weight of the preferred pod affinity rule, in the range 1-100 (default *100*).
+	PodAffinityWeight int32 `property:"pod-affinity-weight" json:"podAffinityWeight,omitempty"`
+	// Whether the pod anti-affinity rule must be met for the integration pod(s) to be scheduled, or is only a preference
+	// the scheduler tries to enforce, either `required` or `preferred` (default *required*).
+	PodAntiAffinityType string `property:"pod-anti-affinity-type" json:"podAntiAffinityType,omitempty"`
+	// The weight of the preferred pod anti-affinity rule, in the range 1-100 (default *100*).
+	PodAntiAffinityWeight int32 `property:"pod-anti-affinity-weight" json:"podAntiAffinityWeight,omitempty"`
 }
 
+const (
+	affinityTypeRequired  = "required"
+	affinityTypePreferred = "preferred"
+
+	defaultAffinityWeight = 100
+)
+
 func newAffinityTrait() Trait {
 	return &affinityTrait{
 		BaseTrait: NewBaseTrait("affinity", 1300),
@@ -67,6 +97,27 @@ func (t *affinityTrait) Configure(e *Environment) (bool, error) {
 		return false, fmt.Errorf("both pod affinity and pod anti-affinity can't be set simultaneously")
 	}
 
+	if t.TopologySpreadMaxSkew < 0 {
+		return false, fmt.Errorf("topology spread max skew must be positive: %d", t.TopologySpreadMaxSkew)
+	}
+
+	switch corev1.UnsatisfiableConstraintAction(t.TopologySpreadWhenUnsatisfiable) {
+	case "", corev1.DoNotSchedule, corev1.ScheduleAnyway:
+	default:
+		return false, fmt.Errorf("unsupported topology spread when unsatisfiable action: %s, must be one of %s or %s",
+			t.TopologySpreadWhenUnsatisfiable, corev1.DoNotSchedule, corev1.ScheduleAnyway)
+	}
+
+	if err := validateAffinityType("node affinity", t.NodeAffinityType, t.NodeAffinityWeight); err != nil {
+		return false, err
+	}
+	if err := validateAffinityType("pod affinity", t.PodAffinityType, t.PodAffinityWeight); err != nil {
+		return false, err
+	}
+	if err := validateAffinityType("pod anti-affinity", t.PodAntiAffinityType, t.PodAntiAffinityWeight); err != nil {
+		return false, err
+	}
+
 	return e.IntegrationInPhase(v1.IntegrationPhaseDeploying, v1.IntegrationPhaseRunning), nil
 }
 
@@ -87,11 +138,39 @@ func (t *affinityTrait) Apply(e *Environment) (err error) {
 		if err := t.addPodAntiAffinity(e, deployment); err != nil {
 			return err
 		}
+		t.addTopologySpreadConstraint(e, deployment)
 	}
 
 	return nil
 }
 
+func (t *affinityTrait) addTopologySpreadConstraint(e *Environment, deployment *appsv1.Deployment) {
+	if t.TopologySpreadKey == "" {
+		return
+	}
+
+	maxSkew := t.TopologySpreadMaxSkew
+	if maxSkew == 0 {
+		maxSkew = 1
+	}
+	whenUnsatisfiable := corev1.UnsatisfiableConstraintAction(t.TopologySpreadWhenUnsatisfiable)
+	if whenUnsatisfiable == "" {
+		whenUnsatisfiable = corev1.DoNotSchedule
+	}
+
+	deployment.Spec.Template.Spec.TopologySpreadConstraints = append(deployment.Spec.Template.Spec.TopologySpreadConstraints,
+		corev1.TopologySpreadConstraint{
+			MaxSkew:           maxSkew,
+			TopologyKey:       t.TopologySpreadKey,
+			WhenUnsatisfiable: whenUnsatisfiable,
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					v1.IntegrationLabel: e.Integration.Name,
+				},
+			},
+		})
+}
+
 func (t *affinityTrait) addNodeAffinity(_ *Environment, deployment *appsv1.Deployment) error {
 	if len(t.NodeAffinityLabels) == 0 {
 		return nil
@@ -116,14 +195,24 @@ func (t *affinityTrait) addNodeAffinity(_ *Environment, deployment *appsv1.Deplo
 		nodeSelectorRequirements = append(nodeSelectorRequirements, nodeSelectorRequirement)
 	}
 
-	nodeAffinity := &corev1.NodeAffinity{
-		RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+	nodeAffinity := &corev1.NodeAffinity{}
+	if t.NodeAffinityType == affinityTypePreferred {
+		nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution = []corev1.PreferredSchedulingTerm{
+			{
+				Weight: affinityWeight(t.NodeAffinityWeight),
+				Preference: corev1.NodeSelectorTerm{
+					MatchExpressions: nodeSelectorRequirements,
+				},
+			},
+		}
+	} else {
+		nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &corev1.NodeSelector{
 			NodeSelectorTerms: []corev1.NodeSelectorTerm{
 				{
 					MatchExpressions: nodeSelectorRequirements,
 				},
 			},
-		},
+		}
 	}
 
 	if deployment.Spec.Template.Spec.Affinity == nil {
@@ -171,16 +260,9 @@ func (t *affinityTrait) addPodAffinity(e *Environment, deployment *appsv1.Deploy
 		})
 	}
 
-	podAffinity := &corev1.PodAffinity{
-		RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{
-			{
-				LabelSelector: &metav1.LabelSelector{
-					MatchExpressions: labelSelectorRequirements,
-				},
-				TopologyKey: "kubernetes.io/hostname",
-			},
-		},
-	}
+	podAffinity := &corev1.PodAffinity{}
+	podAffinity.RequiredDuringSchedulingIgnoredDuringExecution, podAffinity.PreferredDuringSchedulingIgnoredDuringExecution =
+		podAffinityTerms(t.PodAffinityType, t.PodAffinityWeight, labelSelectorRequirements)
 
 	if deployment.Spec.Template.Spec.Affinity == nil {
 		deployment.Spec.Template.Spec.Affinity = &corev1.Affinity{}
@@ -227,16 +309,9 @@ func (t *affinityTrait) addPodAntiAffinity(e *Environment, deployment *appsv1.De
 		})
 	}
 
-	podAntiAffinity := &corev1.PodAntiAffinity{
-		RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{
-			{
-				LabelSelector: &metav1.LabelSelector{
-					MatchExpressions: labelSelectorRequirements,
-				},
-				TopologyKey: "kubernetes.io/hostname",
-			},
-		},
-	}
+	podAntiAffinity := &corev1.PodAntiAffinity{}
+	podAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution, podAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution =
+		podAffinityTerms(t.PodAntiAffinityType, t.PodAntiAffinityWeight, labelSelectorRequirements)
 
 	if deployment.Spec.Template.Spec.Affinity == nil {
 		deployment.Spec.Template.Spec.Affinity = &corev1.Affinity{}
@@ -247,6 +322,50 @@ func (t *affinityTrait) addPodAntiAffinity(e *Environment, deployment *appsv1.De
 	return nil
 }
 
+// podAffinityTerms returns either the required or the preferred terms, depending on the affinity type
+func podAffinityTerms(affinityType string, weight int32, requirements []metav1.LabelSelectorRequirement) ([]corev1.PodAffinityTerm, []corev1.WeightedPodAffinityTerm) {
+	term := corev1.PodAffinityTerm{
+		LabelSelector: &metav1.LabelSelector{
+			MatchExpressions: requirements,
+		},
+		TopologyKey: "kubernetes.io/hostname",
+	}
+
+	if affinityType == affinityTypePreferred {
+		return nil, []corev1.WeightedPodAffinityTerm{
+			{
+				Weight:          affinityWeight(weight),
+				PodAffinityTerm: term,
+			},
+		}
+	}
+
+	return []corev1.PodAffinityTerm{term}, nil
+}
+
+func affinityWeight(weight int32) int32 {
+	if weight == 0 {
+		return defaultAffinityWeight
+	}
+	return weight
+}
+
+func validateAffinityType(rule string, affinityType string, weight int32) error {
+	switch affinityType {
+	case "", affinityTypeRequired:
+		if weight != 0 {
+			return fmt.Errorf("the %s weight can only be set for a %s rule", rule, affinityTypePreferred)
+		}
+	case affinityTypePreferred:
+		if weight < 0 || weight > 100 {
+			return fmt.Errorf("invalid %s weight: %d, must be in the range 1-100", rule, weight)
+		}
+	default:
+		return fmt.Errorf("unsupported %s type: %s, must be one of %s or %s", rule, affinityType, affinityTypeRequired, affinityTypePreferred)
+	}
+	return nil
+}
+
 func operatorToNodeSelectorOperator(operator selection.Operator) (corev1.NodeSelectorOperator, error) {
 	switch operator {
 	case selection.In, selection.Equals, selection.DoubleEquals:
@@ -124,6 +124,110 @@ func TestApplyPodAffinityLabelsDoesSucceed(t *testing.T) {
 	assert.ElementsMatch(t, [1]string{"integration-name"}, integrationRequirement.Values)
 }
 
+func TestConfigureAffinityTraitWithInvalidTopologySpreadFails(t *testing.T) {
+	affinityTrait, environment, _ := createNominalAffinityTest()
+	affinityTrait.TopologySpreadKey = "topology.kubernetes.io/zone"
+	affinityTrait.TopologySpreadMaxSkew = -1
+	configured, err := affinityTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+
+	affinityTrait.TopologySpreadMaxSkew = 1
+	affinityTrait.TopologySpreadWhenUnsatisfiable = "Ignore"
+	configured, err = affinityTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestApplyTopologySpreadConstraintDoesSucceed(t *testing.T) {
+	affinityTrait, environment, deployment := createNominalAffinityTest()
+	affinityTrait.NodeAffinityLabels = []string{"criteria = value"}
+	affinityTrait.TopologySpreadKey = "topology.kubernetes.io/zone"
+	affinityTrait.TopologySpreadWhenUnsatisfiable = string(corev1.ScheduleAnyway)
+	deployment.Spec.Template.Spec.TopologySpreadConstraints = []corev1.TopologySpreadConstraint{
+		{
+			MaxSkew:           2,
+			TopologyKey:       "kubernetes.io/hostname",
+			WhenUnsatisfiable: corev1.DoNotSchedule,
+		},
+	}
+
+	err := affinityTrait.Apply(environment)
+
+	assert.Nil(t, err)
+	assert.NotNil(t, deployment.Spec.Template.Spec.Affinity.NodeAffinity)
+	constraints := deployment.Spec.Template.Spec.TopologySpreadConstraints
+	assert.Len(t, constraints, 2)
+	assert.Equal(t, "kubernetes.io/hostname", constraints[0].TopologyKey)
+	assert.Equal(t, "topology.kubernetes.io/zone", constraints[1].TopologyKey)
+	assert.Equal(t, int32(1), constraints[1].MaxSkew)
+	assert.Equal(t, corev1.ScheduleAnyway, constraints[1].WhenUnsatisfiable)
+	assert.Equal(t, "integration-name", constraints[1].LabelSelector.MatchLabels[v1.IntegrationLabel])
+}
+
+func TestConfigureAffinityTraitWithInvalidAffinityTypeFails(t *testing.T) {
+	affinityTrait, environment, _ := createNominalAffinityTest()
+	affinityTrait.NodeAffinityType = "soft"
+	configured, err := affinityTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+
+	affinityTrait.NodeAffinityType = ""
+	affinityTrait.PodAffinityWeight = 50
+	configured, err = affinityTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+
+	affinityTrait.PodAffinityWeight = 0
+	affinityTrait.PodAntiAffinityType = "preferred"
+	affinityTrait.PodAntiAffinityWeight = 101
+	configured, err = affinityTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestApplyPreferredNodeAffinityDoesSucceed(t *testing.T) {
+	affinityTrait, environment, deployment := createNominalAffinityTest()
+	affinityTrait.NodeAffinityLabels = []string{"criteria = value"}
+	affinityTrait.NodeAffinityType = "preferred"
+	affinityTrait.NodeAffinityWeight = 20
+
+	err := affinityTrait.Apply(environment)
+
+	assert.Nil(t, err)
+	nodeAffinity := deployment.Spec.Template.Spec.Affinity.NodeAffinity
+	assert.Nil(t, nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution)
+	assert.Len(t, nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution, 1)
+	assert.Equal(t, int32(20), nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution[0].Weight)
+	nodeSelectorRequirement := nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution[0].Preference.MatchExpressions[0]
+	assert.Equal(t, "criteria", nodeSelectorRequirement.Key)
+	assert.Equal(t, corev1.NodeSelectorOpIn, nodeSelectorRequirement.Operator)
+}
+
+func TestApplyPreferredPodAntiAffinityDoesSucceed(t *testing.T) {
+	affinityTrait, environment, deployment := createNominalAffinityTest()
+	affinityTrait.PodAntiAffinity = true
+	affinityTrait.PodAntiAffinityType = "preferred"
+
+	err := affinityTrait.Apply(environment)
+
+	assert.Nil(t, err)
+	podAntiAffinity := deployment.Spec.Template.Spec.Affinity.PodAntiAffinity
+	assert.Empty(t, podAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution)
+	assert.Len(t, podAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution, 1)
+	term := podAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution[0]
+	assert.Equal(t, int32(100), term.Weight)
+	assert.Equal(t, "kubernetes.io/hostname", term.PodAffinityTerm.TopologyKey)
+	integrationRequirement := term.PodAffinityTerm.LabelSelector.MatchExpressions[0]
+	assert.Equal(t, v1.IntegrationLabel, integrationRequirement.Key)
+	assert.ElementsMatch(t, [1]string{"integration-name"}, integrationRequirement.Values)
+}
+
 func createNominalAffinityTest() (*affinityTrait, *Environment, *appsv1.Deployment) {
 	trait := newAffinityTrait().(*affinityTrait)
 	enabled := true
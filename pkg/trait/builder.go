@@ -22,11 +22,15 @@ import (
 	"path"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/apache/camel-k/pkg/builder/spectrum"
+	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/pkg/errors"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -35,7 +39,10 @@ import (
 	"github.com/apache/camel-k/pkg/builder/kaniko"
 	"github.com/apache/camel-k/pkg/builder/runtime"
 	"github.com/apache/camel-k/pkg/builder/s2i"
+	"github.com/apache/camel-k/pkg/util"
 	"github.com/apache/camel-k/pkg/util/defaults"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+	"github.com/apache/camel-k/pkg/util/maven"
 )
 
 const builderDir = "/builder"
@@ -48,6 +55,56 @@ type builderTrait struct {
 	BaseTrait `property:",squash"`
 	// Enable verbose logging on build components that support it (e.g. Kaniko build pod).
 	Verbose bool `property:"verbose" json:"verbose,omitempty"`
+	// The timeout of the Maven build, as a duration of at least one second (e.g. `10m`, `1h30m`), that defaults to the Maven timeout of the integration platform.
+	BuildTimeout string `property:"build-timeout" json:"buildTimeout,omitempty"`
+	// A list of additional Maven repositories used to build the integration kit, merged with the ones
+	// configured on the integration platform. A repository can be described with the `id::policy::url` layout,
+	// where the policy is one of `releases`, `snapshots` or `all`, or with the `url@id=my-repo@snapshots` layout.
+	Repositories []string `property:"repositories" json:"repositories,omitempty"`
+	// Use the image of a previously built integration kit, that has the largest set of dependencies in common,
+	// as base image, so that only the missing dependencies are added on top of it. A full build is performed when
+	// no compatible base image can be found. Enabled by default, unless a custom base image or image instructions are set.
+	IncrementalImageBuild *bool `property:"incremental-image-build" json:"incrementalImageBuild,omitempty"`
+	// The name of a Secret configuring a Maven mirror used to build the integration kit, e.g. for air-gapped
+	// environments. The Secret must contain the mirror URL in the `url` key, and can optionally contain the
+	// repositories it mirrors in the `mirrorOf` key (defaults to `*`), as well as the credentials used to authenticate
+	// to the mirror in the `username` and `password` keys. The mirror is merged with the Maven settings of the
+	// integration platform.
+	MavenMirror string `property:"maven-mirror" json:"mavenMirror,omitempty"`
+	// The strategy used to run the build, either `routine` or `pod`. It overrides the build strategy
+	// configured on the integration platform. The `routine` strategy is only supported by the `S2I` and
+	// `Spectrum` publish strategies.
+	Strategy string `property:"strategy" json:"strategy,omitempty"`
+	// The strategy used to order the build with respect to the other builds of the namespace, either
+	// `sequential`, to run the build once no other build is running, or `fifo`, to run the build once
+	// the builds created before it have been scheduled, possibly concurrently with them. Defaults to `sequential`.
+	OrderStrategy string `property:"order-strategy" json:"orderStrategy,omitempty"`
+	// The minimum amount of CPU required by the build containers. It only applies to the `pod` build strategy,
+	// and also applies to the image build container of the `Buildah` and `Kaniko` publish strategies.
+	RequestCPU string `property:"request-cpu" json:"requestCPU,omitempty"`
+	// The minimum amount of memory required by the build containers. It only applies to the `pod` build strategy,
+	// and also applies to the image build container of the `Buildah` and `Kaniko` publish strategies.
+	RequestMemory string `property:"request-memory" json:"requestMemory,omitempty"`
+	// The maximum amount of CPU required by the build containers. It only applies to the `pod` build strategy,
+	// and also applies to the image build container of the `Buildah` and `Kaniko` publish strategies.
+	LimitCPU string `property:"limit-cpu" json:"limitCPU,omitempty"`
+	// The maximum amount of memory required by the build containers. It only applies to the `pod` build strategy,
+	// and also applies to the image build container of the `Buildah` and `Kaniko` publish strategies.
+	LimitMemory string `property:"limit-memory" json:"limitMemory,omitempty"`
+	// A list of properties, in the `key=value` format, passed as system properties to the Maven build
+	// of the integration kit (e.g. `-Dkey=value`).
+	Properties []string `property:"properties" json:"properties,omitempty"`
+	// The image the integration image is built from, e.g. to provide the native libraries required by some
+	// Camel components. It overrides the base image configured on the integration platform.
+	BaseImage string `property:"base-image" json:"baseImage,omitempty"`
+	// A list of Dockerfile instructions executed on top of the base image, before the integration is added
+	// (e.g. `RUN microdnf install -y libaio`). Note the integration runs as user `1000`. The instructions are not
+	// supported by the `Spectrum` publish strategy.
+	ImageInstructions []string `property:"image-instructions" json:"imageInstructions,omitempty"`
+	// Whether the image registry is accessed insecurely, i.e. without TLS verification, to push the integration
+	// kit image. It overrides the insecure flag of the registry configured on the integration platform.
+	// It requires a registry address to be configured on the integration platform.
+	RegistryInsecure *bool `property:"registry-insecure" json:"registryInsecure,omitempty"`
 }
 
 func newBuilderTrait() Trait {
@@ -71,12 +128,65 @@ func (t *builderTrait) Configure(e *Environment) (bool, error) {
 		return false, nil
 	}
 
-	return e.IntegrationKitInPhase(v1.IntegrationKitPhaseBuildSubmitted), nil
+	if t.BuildTimeout != "" {
+		d, err := time.ParseDuration(t.BuildTimeout)
+		if err != nil {
+			return false, fmt.Errorf("invalid build timeout: %s, must be a valid duration (e.g. 10m, 1h30m)", t.BuildTimeout)
+		}
+		if d < time.Second {
+			// The Maven timeout is set in seconds
+			return false, fmt.Errorf("invalid build timeout: %s, must be at least 1s", t.BuildTimeout)
+		}
+	}
+
+	for _, r := range t.Repositories {
+		if _, err := maven.ParseRepository(r); err != nil {
+			return false, err
+		}
+	}
+
+	if err := t.validateStrategies(e); err != nil {
+		return false, err
+	}
+
+	if _, err := t.resourceRequirements(); err != nil {
+		return false, err
+	}
+
+	if _, err := t.mavenProperties(); err != nil {
+		return false, err
+	}
+
+	if err := t.validateImage(e); err != nil {
+		return false, err
+	}
+
+	if t.RegistryInsecure != nil && e.Platform != nil && e.Platform.Status.Build.Registry.Address == "" {
+		return false, errors.New("registry-insecure requires a registry address to be configured on the integration platform")
+	}
+
+	if !e.IntegrationKitInPhase(v1.IntegrationKitPhaseBuildSubmitted) {
+		return false, nil
+	}
+
+	if t.MavenMirror != "" && e.Client != nil {
+		secret, err := kubernetes.GetSecret(e.C, e.Client, t.MavenMirror, e.IntegrationKit.Namespace)
+		if err != nil {
+			return false, errors.Wrapf(err, "unable to retrieve maven mirror secret %s", t.MavenMirror)
+		}
+		if _, _, err := maven.NewMirrorFromSecret(secret); err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
 }
 
 func (t *builderTrait) Apply(e *Environment) error {
 	builderTask := t.builderTask(e)
 	e.BuildTasks = append(e.BuildTasks, v1.Task{Builder: builderTask})
+	e.BuildStrategy = v1.IntegrationPlatformBuildStrategy(t.Strategy)
+	e.BuildOrderStrategy = v1.BuildOrderStrategy(t.OrderStrategy)
 
 	switch e.Platform.Status.Build.PublishStrategy {
 
@@ -85,6 +195,7 @@ func (t *builderTrait) Apply(e *Environment) error {
 		if err != nil {
 			return err
 		}
+		imageTask.ResourceRequirements = *builderTask.ResourceRequirements.DeepCopy()
 		t.addVolumeMounts(builderTask, imageTask)
 		e.BuildTasks = append(e.BuildTasks, v1.Task{Image: imageTask})
 
@@ -93,6 +204,7 @@ func (t *builderTrait) Apply(e *Environment) error {
 		if err != nil {
 			return err
 		}
+		imageTask.ResourceRequirements = *builderTask.ResourceRequirements.DeepCopy()
 
 		if e.Platform.Status.Build.IsKanikoCacheEnabled() {
 			// Co-locate with the Kaniko warmer pod for sharing the host path volume as the current
@@ -157,6 +269,128 @@ func (t *builderTrait) Apply(e *Environment) error {
 	return nil
 }
 
+func (t *builderTrait) validateStrategies(e *Environment) error {
+	if t.Strategy != "" {
+		found := false
+		strategies := make([]string, 0, len(v1.IntegrationPlatformBuildStrategies))
+		for _, s := range v1.IntegrationPlatformBuildStrategies {
+			strategies = append(strategies, string(s))
+			if string(s) == t.Strategy {
+				found = true
+			}
+		}
+		if !found {
+			return fmt.Errorf("unknown build strategy: %s. One of [%s] is expected", t.Strategy, strings.Join(strategies, ", "))
+		}
+
+		if e.Platform != nil && v1.IntegrationPlatformBuildStrategy(t.Strategy) == v1.IntegrationPlatformBuildStrategyRoutine {
+			switch e.Platform.Status.Build.PublishStrategy {
+			case v1.IntegrationPlatformBuildPublishStrategyBuildah, v1.IntegrationPlatformBuildPublishStrategyKaniko:
+				// The build output has to be shared via a volume
+				return fmt.Errorf("build strategy %s is not supported by publish strategy %s", t.Strategy, e.Platform.Status.Build.PublishStrategy)
+			}
+		}
+	}
+
+	if t.OrderStrategy != "" {
+		found := false
+		strategies := make([]string, 0, len(v1.BuildOrderStrategies))
+		for _, s := range v1.BuildOrderStrategies {
+			strategies = append(strategies, string(s))
+			if string(s) == t.OrderStrategy {
+				found = true
+			}
+		}
+		if !found {
+			return fmt.Errorf("unknown build order strategy: %s. One of [%s] is expected", t.OrderStrategy, strings.Join(strategies, ", "))
+		}
+	}
+
+	return nil
+}
+
+func (t *builderTrait) resourceRequirements() (corev1.ResourceRequirements, error) {
+	requirements := corev1.ResourceRequirements{}
+
+	quantities := []struct {
+		property string
+		value    string
+		list     *corev1.ResourceList
+		name     corev1.ResourceName
+	}{
+		{"request-cpu", t.RequestCPU, &requirements.Requests, corev1.ResourceCPU},
+		{"request-memory", t.RequestMemory, &requirements.Requests, corev1.ResourceMemory},
+		{"limit-cpu", t.LimitCPU, &requirements.Limits, corev1.ResourceCPU},
+		{"limit-memory", t.LimitMemory, &requirements.Limits, corev1.ResourceMemory},
+	}
+
+	for _, q := range quantities {
+		if q.value == "" {
+			continue
+		}
+		v, err := resource.ParseQuantity(q.value)
+		if err != nil {
+			return requirements, fmt.Errorf("invalid %s quantity: %s", q.property, q.value)
+		}
+		if *q.list == nil {
+			*q.list = make(corev1.ResourceList)
+		}
+		(*q.list)[q.name] = v
+	}
+
+	for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+		request, hasRequest := requirements.Requests[name]
+		limit, hasLimit := requirements.Limits[name]
+		if hasRequest && hasLimit && request.Cmp(limit) > 0 {
+			return requirements, fmt.Errorf("the %s request %s must not be greater than the limit %s", name, request.String(), limit.String())
+		}
+	}
+
+	return requirements, nil
+}
+
+func (t *builderTrait) validateImage(e *Environment) error {
+	if t.BaseImage != "" {
+		if _, err := name.ParseReference(t.BaseImage); err != nil {
+			return fmt.Errorf("invalid base image: %s, must be a valid image reference", t.BaseImage)
+		}
+	}
+
+	for _, i := range t.ImageInstructions {
+		fields := strings.Fields(i)
+		if len(fields) == 0 {
+			return errors.New("invalid image instruction: instructions must not be empty")
+		}
+		if strings.EqualFold(fields[0], "FROM") {
+			return fmt.Errorf("invalid image instruction: %s, use the base-image property to change the base image", i)
+		}
+	}
+
+	if len(t.ImageInstructions) > 0 && e.Platform != nil && e.Platform.Status.Build.PublishStrategy == v1.IntegrationPlatformBuildPublishStrategySpectrum {
+		// Spectrum adds the image layers without running a container build
+		return fmt.Errorf("image instructions are not supported by publish strategy %s", e.Platform.Status.Build.PublishStrategy)
+	}
+
+	return nil
+}
+
+func (t *builderTrait) mavenProperties() (map[string]string, error) {
+	if len(t.Properties) == 0 {
+		return nil, nil
+	}
+
+	properties := make(map[string]string, len(t.Properties))
+	for _, p := range t.Properties {
+		kv := strings.SplitN(p, "=", 2)
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" {
+			return nil, fmt.Errorf("invalid build property: %s, must be in the key=value format", p)
+		}
+		properties[strings.TrimSpace(kv[0])] = kv[1]
+	}
+
+	return properties, nil
+}
+
 func (t *builderTrait) addVolumeMounts(builderTask *v1.BuilderTask, imageTask *v1.ImageTask) {
 	mount := corev1.VolumeMount{Name: "camel-k-builder", MountPath: builderDir}
 	builderTask.VolumeMounts = append(builderTask.VolumeMounts, mount)
@@ -180,6 +414,8 @@ func (t *builderTrait) builderTask(e *Environment) *v1.BuilderTask {
 		BaseImage:    e.Platform.Status.Build.BaseImage,
 		Runtime:      e.CamelCatalog.Runtime,
 		Dependencies: e.IntegrationKit.Spec.Dependencies,
+		Repositories: t.Repositories,
+		MavenMirror:  t.MavenMirror,
 		//TODO: sort steps for easier read
 		Steps:      builder.StepIDsFor(builder.DefaultSteps...),
 		Properties: e.Platform.Status.Build.Properties,
@@ -190,6 +426,10 @@ func (t *builderTrait) builderTask(e *Environment) *v1.BuilderTask {
 	switch e.Platform.Status.Build.PublishStrategy {
 	case v1.IntegrationPlatformBuildPublishStrategyBuildah, v1.IntegrationPlatformBuildPublishStrategyKaniko:
 		task.BuildDir = path.Join(builderDir, e.IntegrationKit.Name)
+		if secrets := t.mergedRegistrySecrets(e); len(secrets) > 0 {
+			task.RegistrySecrets = secrets
+			task.Steps = append(task.Steps, builder.StepIDsFor(builder.Steps.GenerateRegistryAuth)...)
+		}
 
 	case v1.IntegrationPlatformBuildPublishStrategyS2I:
 		task.Steps = append(task.Steps, builder.StepIDsFor(s2i.S2iSteps...)...)
@@ -197,18 +437,68 @@ func (t *builderTrait) builderTask(e *Environment) *v1.BuilderTask {
 		task.Steps = append(task.Steps, builder.StepIDsFor(spectrum.SpectrumSteps...)...)
 	}
 
+	// The properties have already been validated while configuring the trait
+	task.MavenProperties, _ = t.mavenProperties()
+
 	quarkus := e.Catalog.GetTrait("quarkus").(*quarkusTrait)
 	if quarkus.isEnabled() {
 		// Add build steps for Quarkus runtime
 		quarkus.addBuildSteps(task)
+		if cors := e.Catalog.GetTrait(corsTraitID).(*corsTrait); cors.isEnabled() {
+			cors.addBuildProperties(task)
+		}
 	} else {
 		// Add build steps for default runtime
 		task.Steps = append(task.Steps, builder.StepIDsFor(runtime.MainSteps...)...)
 	}
 
+	// The quantities have already been validated while configuring the trait
+	task.ResourceRequirements, _ = t.resourceRequirements()
+
+	if t.BaseImage != "" {
+		task.BaseImage = t.BaseImage
+	}
+	task.ImageInstructions = t.ImageInstructions
+	task.RegistryInsecure = t.RegistryInsecure
+
+	if t.BuildTimeout != "" {
+		// The duration has already been validated while configuring the trait
+		d, _ := time.ParseDuration(t.BuildTimeout)
+		d = d.Truncate(time.Second)
+		task.Maven.Timeout = &metav1.Duration{Duration: d}
+		// Make sure the overall build does not time out before the Maven build does
+		if task.Timeout.Duration < d {
+			task.Timeout = metav1.Duration{Duration: d}
+		}
+	}
+
+	if !t.isIncrementalImageBuild() {
+		// Build the image from scratch, on top of the platform base image
+		for i, s := range task.Steps {
+			if s == builder.Steps.IncrementalImageContext.ID() {
+				task.Steps[i] = builder.Steps.StandardImageContext.ID()
+			}
+		}
+	}
+
 	return task
 }
 
+func (t *builderTrait) isIncrementalImageBuild() bool {
+	if t.BaseImage != "" || len(t.ImageInstructions) > 0 {
+		// Previously built images do not contain the custom layers
+		return false
+	}
+	return t.IncrementalImageBuild == nil || *t.IncrementalImageBuild
+}
+
+func (t *builderTrait) isRegistryInsecure(e *Environment) bool {
+	if t.RegistryInsecure != nil {
+		return *t.RegistryInsecure
+	}
+	return e.Platform.Status.Build.Registry.Insecure
+}
+
 func (t *builderTrait) buildahTask(e *Environment) (*v1.ImageTask, error) {
 	image := getImageName(e)
 
@@ -254,7 +544,13 @@ func (t *builderTrait) buildahTask(e *Environment) (*v1.ImageTask, error) {
 	}
 
 	var auth string
-	if e.Platform.Status.Build.Registry.Secret != "" {
+	if len(t.mergedRegistrySecrets(e)) > 0 {
+		// The registry secrets are merged into a single Docker configuration by the builder
+		env = append(env, corev1.EnvVar{
+			Name:  "REGISTRY_AUTH_FILE",
+			Value: path.Join(builderDir, e.IntegrationKit.Name, builder.RegistryAuthDir, "config.json"),
+		})
+	} else if e.Platform.Status.Build.Registry.Secret != "" {
 		secret, err := getRegistrySecretFor(e, buildahRegistrySecrets)
 		if err != nil {
 			return nil, err
@@ -268,7 +564,7 @@ func (t *builderTrait) buildahTask(e *Environment) (*v1.ImageTask, error) {
 			})
 		}
 		mountRegistrySecret(e.Platform.Status.Build.Registry.Secret, secret, &volumes, &volumeMounts, &env)
-	} else if e.Platform.Status.Build.Registry.Insecure {
+	} else if t.isRegistryInsecure(e) {
 		bud = append(bud[:2], append([]string{"--tls-verify=false"}, bud[2:]...)...)
 		push = append(push[:2], append([]string{"--tls-verify=false"}, push[2:]...)...)
 	}
@@ -334,13 +630,19 @@ func (t *builderTrait) kanikoTask(e *Environment) (*v1.ImageTask, error) {
 	volumes := make([]corev1.Volume, 0)
 	volumeMounts := make([]corev1.VolumeMount, 0)
 
-	if e.Platform.Status.Build.Registry.Secret != "" {
+	if len(t.mergedRegistrySecrets(e)) > 0 {
+		// The registry secrets are merged into a single Docker configuration by the builder
+		env = append(env, corev1.EnvVar{
+			Name:  "DOCKER_CONFIG",
+			Value: path.Join(builderDir, e.IntegrationKit.Name, builder.RegistryAuthDir),
+		})
+	} else if e.Platform.Status.Build.Registry.Secret != "" {
 		secret, err := getRegistrySecretFor(e, kanikoRegistrySecrets)
 		if err != nil {
 			return nil, err
 		}
 		mountRegistrySecret(e.Platform.Status.Build.Registry.Secret, secret, &volumes, &volumeMounts, &env)
-	} else if e.Platform.Status.Build.Registry.Insecure {
+	} else if t.isRegistryInsecure(e) {
 		args = append(args, "--insecure")
 		args = append(args, "--insecure-pull")
 	}
@@ -362,6 +664,26 @@ func (t *builderTrait) kanikoTask(e *Environment) (*v1.ImageTask, error) {
 	}, nil
 }
 
+// mergedRegistrySecrets returns the registry secrets to be merged by the builder, i.e. the platform registry
+// secret along with the pull secrets of the integration, or nil if there are no pull secrets to merge, in which
+// case the platform registry secret is mounted as is into the image build container
+func (t *builderTrait) mergedRegistrySecrets(e *Environment) []string {
+	pullSecret := e.Catalog.GetTrait("pull-secret").(*pullSecretTrait)
+	if pullSecret.Enabled != nil && !*pullSecret.Enabled {
+		return nil
+	}
+
+	secrets := make([]string, 0)
+	if e.Platform.Status.Build.Registry.Secret != "" {
+		secrets = append(secrets, e.Platform.Status.Build.Registry.Secret)
+	}
+	if !util.StringSliceUniqueConcat(&secrets, pullSecret.secretNames()) {
+		return nil
+	}
+
+	return secrets
+}
+
 type registrySecret struct {
 	fileName    string
 	mountPath   string
@@ -20,6 +20,7 @@ package trait
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -27,10 +28,12 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/builder"
 	"github.com/apache/camel-k/pkg/builder/s2i"
 	"github.com/apache/camel-k/pkg/util/camel"
 	"github.com/apache/camel-k/pkg/util/defaults"
 	"github.com/apache/camel-k/pkg/util/kubernetes"
+	"github.com/apache/camel-k/pkg/util/test"
 )
 
 func TestBuilderTraitNotAppliedBecauseOfNilKit(t *testing.T) {
@@ -109,6 +112,346 @@ func TestKanikoBuilderTrait(t *testing.T) {
 	assert.NotNil(t, env.BuildTasks[1].Image)
 }
 
+func TestBuilderTraitBuildTimeout(t *testing.T) {
+	env := createBuilderTestEnv(v1.IntegrationPlatformClusterKubernetes, v1.IntegrationPlatformBuildPublishStrategyKaniko)
+	env.Platform.Status.Build.Timeout = &metav1.Duration{Duration: 5 * time.Minute}
+
+	trait := newBuilderTrait().(*builderTrait)
+	trait.BuildTimeout = "1h30m"
+
+	enabled, err := trait.Configure(env)
+	assert.Nil(t, err)
+	assert.True(t, enabled)
+
+	err = trait.Apply(env)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, env.BuildTasks)
+	assert.NotNil(t, env.BuildTasks[0].Builder)
+	assert.Equal(t, 90*time.Minute, env.BuildTasks[0].Builder.Maven.GetTimeout().Duration)
+	assert.Equal(t, 90*time.Minute, env.BuildTasks[0].Builder.Timeout.Duration)
+	// The platform configuration must be left untouched
+	assert.Equal(t, 5*time.Minute, env.Platform.Status.Build.GetTimeout().Duration)
+}
+
+func TestBuilderTraitInvalidBuildTimeout(t *testing.T) {
+	env := createBuilderTestEnv(v1.IntegrationPlatformClusterKubernetes, v1.IntegrationPlatformBuildPublishStrategyKaniko)
+
+	trait := newBuilderTrait().(*builderTrait)
+	trait.BuildTimeout = "ten minutes"
+
+	enabled, err := trait.Configure(env)
+	assert.NotNil(t, err)
+	assert.False(t, enabled)
+
+	trait.BuildTimeout = "500ms"
+
+	enabled, err = trait.Configure(env)
+	assert.NotNil(t, err)
+	assert.False(t, enabled)
+	assert.Equal(t, "invalid build timeout: 500ms, must be at least 1s", err.Error())
+}
+
+func TestBuilderTraitRepositories(t *testing.T) {
+	env := createBuilderTestEnv(v1.IntegrationPlatformClusterKubernetes, v1.IntegrationPlatformBuildPublishStrategyKaniko)
+
+	trait := newBuilderTrait().(*builderTrait)
+	trait.Repositories = []string{"my-repo::snapshots::https://my-nexus/repository/public"}
+
+	enabled, err := trait.Configure(env)
+	assert.Nil(t, err)
+	assert.True(t, enabled)
+
+	err = trait.Apply(env)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, env.BuildTasks)
+	assert.NotNil(t, env.BuildTasks[0].Builder)
+	assert.Equal(t, trait.Repositories, env.BuildTasks[0].Builder.Repositories)
+
+	trait.Repositories = []string{"my-repo::nightly::https://my-nexus/repository/public"}
+
+	enabled, err = trait.Configure(env)
+	assert.NotNil(t, err)
+	assert.False(t, enabled)
+}
+
+func TestBuilderTraitIncrementalImageBuild(t *testing.T) {
+	env := createBuilderTestEnv(v1.IntegrationPlatformClusterKubernetes, v1.IntegrationPlatformBuildPublishStrategyKaniko)
+
+	trait := newBuilderTrait().(*builderTrait)
+	task := trait.builderTask(env)
+	assert.Contains(t, task.Steps, builder.Steps.IncrementalImageContext.ID())
+	assert.NotContains(t, task.Steps, builder.Steps.StandardImageContext.ID())
+
+	incremental := false
+	trait.IncrementalImageBuild = &incremental
+	task = trait.builderTask(env)
+	assert.NotContains(t, task.Steps, builder.Steps.IncrementalImageContext.ID())
+	assert.Contains(t, task.Steps, builder.Steps.StandardImageContext.ID())
+}
+
+func TestBuilderTraitMavenMirror(t *testing.T) {
+	env := createBuilderTestEnv(v1.IntegrationPlatformClusterKubernetes, v1.IntegrationPlatformBuildPublishStrategyKaniko)
+	env.IntegrationKit.Namespace = "ns"
+
+	client, err := test.NewFakeClient(&corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "maven-mirror",
+		},
+		Data: map[string][]byte{
+			"url": []byte("https://my-nexus/repository/public"),
+		},
+	})
+	assert.Nil(t, err)
+	env.Client = client
+
+	trait := newBuilderTrait().(*builderTrait)
+	trait.MavenMirror = "maven-mirror"
+
+	enabled, err := trait.Configure(env)
+	assert.Nil(t, err)
+	assert.True(t, enabled)
+
+	task := trait.builderTask(env)
+	assert.Equal(t, "maven-mirror", task.MavenMirror)
+
+	trait.MavenMirror = "missing-mirror"
+
+	enabled, err = trait.Configure(env)
+	assert.NotNil(t, err)
+	assert.False(t, enabled)
+}
+
+func TestBuilderTraitStrategies(t *testing.T) {
+	env := createBuilderTestEnv(v1.IntegrationPlatformClusterOpenShift, v1.IntegrationPlatformBuildPublishStrategyS2I)
+
+	trait := newBuilderTrait().(*builderTrait)
+	trait.Strategy = string(v1.IntegrationPlatformBuildStrategyRoutine)
+	trait.OrderStrategy = string(v1.BuildOrderStrategyFIFO)
+
+	enabled, err := trait.Configure(env)
+	assert.Nil(t, err)
+	assert.True(t, enabled)
+
+	err = trait.Apply(env)
+	assert.Nil(t, err)
+	assert.Equal(t, v1.IntegrationPlatformBuildStrategyRoutine, env.BuildStrategy)
+	assert.Equal(t, v1.BuildOrderStrategyFIFO, env.BuildOrderStrategy)
+
+	trait.OrderStrategy = "lifo"
+
+	enabled, err = trait.Configure(env)
+	assert.NotNil(t, err)
+	assert.False(t, enabled)
+
+	trait.OrderStrategy = ""
+	trait.Strategy = "job"
+
+	enabled, err = trait.Configure(env)
+	assert.NotNil(t, err)
+	assert.False(t, enabled)
+}
+
+func TestBuilderTraitRoutineStrategyNotSupportedByKaniko(t *testing.T) {
+	env := createBuilderTestEnv(v1.IntegrationPlatformClusterKubernetes, v1.IntegrationPlatformBuildPublishStrategyKaniko)
+
+	trait := newBuilderTrait().(*builderTrait)
+	trait.Strategy = string(v1.IntegrationPlatformBuildStrategyRoutine)
+
+	enabled, err := trait.Configure(env)
+	assert.NotNil(t, err)
+	assert.False(t, enabled)
+	assert.Equal(t, "build strategy routine is not supported by publish strategy Kaniko", err.Error())
+
+	trait.Strategy = string(v1.IntegrationPlatformBuildStrategyPod)
+
+	enabled, err = trait.Configure(env)
+	assert.Nil(t, err)
+	assert.True(t, enabled)
+}
+
+func TestBuilderTraitResources(t *testing.T) {
+	env := createBuilderTestEnv(v1.IntegrationPlatformClusterKubernetes, v1.IntegrationPlatformBuildPublishStrategyKaniko)
+
+	trait := newBuilderTrait().(*builderTrait)
+	trait.RequestCPU = "500m"
+	trait.RequestMemory = "1Gi"
+	trait.LimitMemory = "2Gi"
+
+	enabled, err := trait.Configure(env)
+	assert.Nil(t, err)
+	assert.True(t, enabled)
+
+	task := trait.builderTask(env)
+	assert.Equal(t, "500m", task.ResourceRequirements.Requests.Cpu().String())
+	assert.Equal(t, "1Gi", task.ResourceRequirements.Requests.Memory().String())
+	assert.Equal(t, "2Gi", task.ResourceRequirements.Limits.Memory().String())
+	_, ok := task.ResourceRequirements.Limits[corev1.ResourceCPU]
+	assert.False(t, ok)
+
+	err = trait.Apply(env)
+	assert.Nil(t, err)
+	assert.Len(t, env.BuildTasks, 2)
+	assert.NotNil(t, env.BuildTasks[1].Image)
+	assert.Equal(t, env.BuildTasks[0].Builder.ResourceRequirements, env.BuildTasks[1].Image.ResourceRequirements)
+	assert.Equal(t, "2Gi", env.BuildTasks[1].Image.ResourceRequirements.Limits.Memory().String())
+
+	trait.LimitMemory = "512Mi"
+
+	enabled, err = trait.Configure(env)
+	assert.NotNil(t, err)
+	assert.False(t, enabled)
+
+	trait.LimitMemory = "two gigs"
+
+	enabled, err = trait.Configure(env)
+	assert.NotNil(t, err)
+	assert.False(t, enabled)
+	assert.Equal(t, "invalid limit-memory quantity: two gigs", err.Error())
+}
+
+func TestBuilderTraitProperties(t *testing.T) {
+	env := createBuilderTestEnv(v1.IntegrationPlatformClusterKubernetes, v1.IntegrationPlatformBuildPublishStrategyKaniko)
+
+	trait := newBuilderTrait().(*builderTrait)
+	trait.Properties = []string{"foo=bar", "quarkus.package.type=uber-jar", "empty=", "eq=a=b"}
+
+	enabled, err := trait.Configure(env)
+	assert.Nil(t, err)
+	assert.True(t, enabled)
+
+	task := trait.builderTask(env)
+	assert.Len(t, task.MavenProperties, 4)
+	assert.Equal(t, "bar", task.MavenProperties["foo"])
+	assert.Equal(t, "uber-jar", task.MavenProperties["quarkus.package.type"])
+	assert.Equal(t, "", task.MavenProperties["empty"])
+	assert.Equal(t, "a=b", task.MavenProperties["eq"])
+
+	trait.Properties = []string{"foo"}
+
+	enabled, err = trait.Configure(env)
+	assert.NotNil(t, err)
+	assert.False(t, enabled)
+	assert.Equal(t, "invalid build property: foo, must be in the key=value format", err.Error())
+
+	trait.Properties = []string{"=bar"}
+
+	enabled, err = trait.Configure(env)
+	assert.NotNil(t, err)
+	assert.False(t, enabled)
+}
+
+func TestBuilderTraitBaseImage(t *testing.T) {
+	env := createBuilderTestEnv(v1.IntegrationPlatformClusterKubernetes, v1.IntegrationPlatformBuildPublishStrategyKaniko)
+
+	trait := newBuilderTrait().(*builderTrait)
+	trait.BaseImage = "registry.access.redhat.com/ubi8/openjdk-11:1.3"
+	trait.ImageInstructions = []string{"USER root", "RUN microdnf install -y libaio"}
+
+	enabled, err := trait.Configure(env)
+	assert.Nil(t, err)
+	assert.True(t, enabled)
+
+	task := trait.builderTask(env)
+	assert.Equal(t, "registry.access.redhat.com/ubi8/openjdk-11:1.3", task.BaseImage)
+	assert.Equal(t, []string{"USER root", "RUN microdnf install -y libaio"}, task.ImageInstructions)
+	assert.NotContains(t, task.Steps, builder.Steps.IncrementalImageContext.ID())
+	assert.Contains(t, task.Steps, builder.Steps.StandardImageContext.ID())
+
+	trait.BaseImage = "Invalid Image"
+
+	enabled, err = trait.Configure(env)
+	assert.NotNil(t, err)
+	assert.False(t, enabled)
+	assert.Equal(t, "invalid base image: Invalid Image, must be a valid image reference", err.Error())
+
+	trait.BaseImage = ""
+	trait.ImageInstructions = []string{"FROM busybox"}
+
+	enabled, err = trait.Configure(env)
+	assert.NotNil(t, err)
+	assert.False(t, enabled)
+}
+
+func TestBuilderTraitImageInstructionsNotSupportedBySpectrum(t *testing.T) {
+	env := createBuilderTestEnv(v1.IntegrationPlatformClusterKubernetes, v1.IntegrationPlatformBuildPublishStrategySpectrum)
+
+	trait := newBuilderTrait().(*builderTrait)
+	trait.ImageInstructions = []string{"RUN microdnf install -y libaio"}
+
+	enabled, err := trait.Configure(env)
+	assert.NotNil(t, err)
+	assert.False(t, enabled)
+	assert.Equal(t, "image instructions are not supported by publish strategy Spectrum", err.Error())
+}
+
+func TestBuilderTraitRegistryInsecure(t *testing.T) {
+	env := createBuilderTestEnv(v1.IntegrationPlatformClusterKubernetes, v1.IntegrationPlatformBuildPublishStrategyKaniko)
+
+	insecure := true
+	trait := newBuilderTrait().(*builderTrait)
+	trait.RegistryInsecure = &insecure
+
+	enabled, err := trait.Configure(env)
+	assert.Nil(t, err)
+	assert.True(t, enabled)
+
+	task := trait.builderTask(env)
+	assert.NotNil(t, task.RegistryInsecure)
+	assert.True(t, *task.RegistryInsecure)
+
+	imageTask, err := trait.kanikoTask(env)
+	assert.Nil(t, err)
+	assert.Contains(t, imageTask.Args, "--insecure")
+	assert.Contains(t, imageTask.Args, "--insecure-pull")
+
+	env.Platform.Status.Build.Registry.Address = ""
+
+	enabled, err = trait.Configure(env)
+	assert.NotNil(t, err)
+	assert.False(t, enabled)
+}
+
+func TestBuilderTraitPullSecrets(t *testing.T) {
+	env := createBuilderTestEnv(v1.IntegrationPlatformClusterKubernetes, v1.IntegrationPlatformBuildPublishStrategyKaniko)
+	env.Platform.Status.Build.Registry.Secret = "registry-secret"
+
+	trait := newBuilderTrait().(*builderTrait)
+
+	// The platform registry secret alone is mounted as is
+	task := trait.builderTask(env)
+	assert.Nil(t, task.RegistrySecrets)
+	assert.NotContains(t, task.Steps, builder.Steps.GenerateRegistryAuth.ID())
+
+	pullSecret := env.Catalog.GetTrait("pull-secret").(*pullSecretTrait)
+	pullSecret.SecretNames = []string{"base-image-secret", "registry-secret"}
+
+	task = trait.builderTask(env)
+	assert.Equal(t, []string{"registry-secret", "base-image-secret"}, task.RegistrySecrets)
+	assert.Contains(t, task.Steps, builder.Steps.GenerateRegistryAuth.ID())
+
+	imageTask, err := trait.kanikoTask(env)
+	assert.Nil(t, err)
+	assert.Empty(t, imageTask.Volumes)
+	assert.Contains(t, imageTask.Env, corev1.EnvVar{Name: "DOCKER_CONFIG", Value: "/builder/registry-auth"})
+
+	env.Platform.Status.Build.PublishStrategy = v1.IntegrationPlatformBuildPublishStrategyBuildah
+	imageTask, err = trait.buildahTask(env)
+	assert.Nil(t, err)
+	assert.Empty(t, imageTask.Volumes)
+	assert.Contains(t, imageTask.Env, corev1.EnvVar{Name: "REGISTRY_AUTH_FILE", Value: "/builder/registry-auth/config.json"})
+
+	disabled := false
+	pullSecret.Enabled = &disabled
+
+	task = trait.builderTask(env)
+	assert.Nil(t, task.RegistrySecrets)
+}
+
 func createBuilderTestEnv(cluster v1.IntegrationPlatformCluster, strategy v1.IntegrationPlatformBuildPublishStrategy) *Environment {
 	c, err := camel.DefaultCatalog()
 	if err != nil {
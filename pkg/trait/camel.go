@@ -19,10 +19,19 @@ package trait
 
 import (
 	"fmt"
+	"path"
+	"sort"
+	"strconv"
 	"strings"
 
+	"github.com/Masterminds/semver"
 	"github.com/pkg/errors"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/util/camel"
 	"github.com/apache/camel-k/pkg/util/maven"
@@ -30,13 +39,42 @@ import (
 
 // The Camel trait can be used to configure versions of Apache Camel K runtime and related libraries, it cannot be disabled.
 //
+// Note that the startup order of the routes is a per-route setting, that must be set in the route definitions,
+// e.g. with `startupOrder(10)` in the Java DSL, so that the routes depending on others are started last.
+//
 // +camel-k:trait=camel
 type camelTrait struct {
 	BaseTrait `property:",squash"`
-	// The camel-k-runtime version to use for the integration. It overrides the default version set in the Integration Platform.
+	// The camel-k-runtime version to use for the integration. It overrides the default version set in the Integration Platform. It can be either an exact version, i.e. `1.5.0`, or a semver constraint, i.e. `~1.5.0`, in which case it must match one of the Camel catalogs available in the namespace.
 	RuntimeVersion string `property:"runtime-version" json:"runtimeVersion,omitempty"`
+	// Enable stream caching, so that message bodies that are streams can be read multiple times.
+	// It configures the Camel `camel.main.stream-caching-enabled` property.
+	StreamCaching *bool `property:"stream-caching" json:"streamCaching,omitempty"`
+	// The absolute path of the directory where the cached streams that exceed the spool threshold are spooled
+	// to disk, to avoid running out of memory with large message bodies. An `emptyDir` volume is mounted at that path,
+	// unless a volume is already mounted there with the mount trait. It requires stream caching to be enabled.
+	StreamCachingSpoolDirectory string `property:"stream-caching-spool-directory" json:"streamCachingSpoolDirectory,omitempty"`
+	// The size above which the cached streams are spooled to disk, e.g. `128Ki` or `1Mi`. It requires stream caching
+	// to be enabled.
+	StreamCachingSpoolThreshold string `property:"stream-caching-spool-threshold" json:"streamCachingSpoolThreshold,omitempty"`
+	// The core number of threads of the default thread pool profile, used by the EIPs that run concurrently, e.g. the `threads`
+	// or `split` EIPs. It configures the Camel `camel.threadpool.pool-size` property.
+	ThreadPoolSize *int `property:"thread-pool-size" json:"threadPoolSize,omitempty"`
+	// The maximum number of threads of the default thread pool profile. It must not be lower than the thread pool size.
+	// It configures the Camel `camel.threadpool.max-pool-size` property.
+	ThreadPoolMaxSize *int `property:"thread-pool-max-size" json:"threadPoolMaxSize,omitempty"`
+	// The maximum number of tasks queued by the default thread pool profile, `-1` for an unbounded queue.
+	// It configures the Camel `camel.threadpool.max-queue-size` property.
+	ThreadPoolMaxQueueSize *int `property:"thread-pool-max-queue-size" json:"threadPoolMaxQueueSize,omitempty"`
+	// How the tasks are handled when the queue of the default thread pool profile is full, one of `Abort`, `CallerRuns`,
+	// `DiscardOldest` or `Discard`. It configures the Camel `camel.threadpool.rejected-policy` property.
+	ThreadPoolRejectedPolicy string `property:"thread-pool-rejected-policy" json:"threadPoolRejectedPolicy,omitempty"`
 }
 
+const streamCachingSpoolVolumeName = "stream-caching-spool"
+
+var threadPoolRejectedPolicies = []string{"Abort", "CallerRuns", "DiscardOldest", "Discard"}
+
 func newCamelTrait() Trait {
 	return &camelTrait{
 		BaseTrait: NewBaseTrait("camel", 200),
@@ -48,6 +86,20 @@ func (t *camelTrait) Configure(e *Environment) (bool, error) {
 		return false, errors.New("trait camel cannot be disabled")
 	}
 
+	if t.RuntimeVersion != "" && !exactVersionRegexp.MatchString(t.RuntimeVersion) {
+		if _, err := semver.NewConstraint(t.RuntimeVersion); err != nil {
+			return false, fmt.Errorf("invalid runtime version %s, it must be either an exact version or a semver constraint: %v", t.RuntimeVersion, err)
+		}
+	}
+
+	if err := t.validateStreamCaching(); err != nil {
+		return false, err
+	}
+
+	if err := t.validateThreadPool(); err != nil {
+		return false, err
+	}
+
 	return true, nil
 }
 
@@ -72,9 +124,154 @@ func (t *camelTrait) Apply(e *Environment) error {
 		e.IntegrationKit.Status.RuntimeProvider = e.CamelCatalog.Runtime.Provider
 	}
 
+	if e.Integration != nil && t.StreamCaching != nil {
+		t.configureStreamCaching(e)
+	}
+
+	if e.Integration != nil {
+		t.configureThreadPool(e)
+	}
+
 	return nil
 }
 
+func (t *camelTrait) validateStreamCaching() error {
+	if t.StreamCachingSpoolDirectory == "" && t.StreamCachingSpoolThreshold == "" {
+		return nil
+	}
+	if t.StreamCaching == nil || !*t.StreamCaching {
+		return errors.New("the stream caching spool properties require stream-caching to be enabled")
+	}
+	if t.StreamCachingSpoolDirectory != "" && !path.IsAbs(t.StreamCachingSpoolDirectory) {
+		return fmt.Errorf("invalid stream caching spool directory: %s, must be an absolute path", t.StreamCachingSpoolDirectory)
+	}
+	if t.StreamCachingSpoolThreshold != "" {
+		q, err := resource.ParseQuantity(t.StreamCachingSpoolThreshold)
+		if err != nil || q.Sign() <= 0 {
+			return fmt.Errorf("invalid stream caching spool threshold: %s, must be a positive quantity (e.g. 128Ki)", t.StreamCachingSpoolThreshold)
+		}
+	}
+	return nil
+}
+
+func (t *camelTrait) validateThreadPool() error {
+	if t.ThreadPoolSize != nil && *t.ThreadPoolSize < 0 {
+		return fmt.Errorf("invalid thread pool size: %d, must not be negative", *t.ThreadPoolSize)
+	}
+	if t.ThreadPoolMaxSize != nil && *t.ThreadPoolMaxSize < 1 {
+		return fmt.Errorf("invalid thread pool max size: %d, must be positive", *t.ThreadPoolMaxSize)
+	}
+	if t.ThreadPoolSize != nil && t.ThreadPoolMaxSize != nil && *t.ThreadPoolMaxSize < *t.ThreadPoolSize {
+		return fmt.Errorf("the thread pool max size %d must not be lower than the thread pool size %d", *t.ThreadPoolMaxSize, *t.ThreadPoolSize)
+	}
+	if t.ThreadPoolMaxQueueSize != nil && *t.ThreadPoolMaxQueueSize < -1 {
+		return fmt.Errorf("invalid thread pool max queue size: %d, must be positive, or -1 for an unbounded queue", *t.ThreadPoolMaxQueueSize)
+	}
+	if t.ThreadPoolRejectedPolicy != "" {
+		for _, p := range threadPoolRejectedPolicies {
+			if p == t.ThreadPoolRejectedPolicy {
+				return nil
+			}
+		}
+		return fmt.Errorf("unknown thread pool rejected policy: %s. One of [%s] is expected", t.ThreadPoolRejectedPolicy, strings.Join(threadPoolRejectedPolicies, ", "))
+	}
+	return nil
+}
+
+func (t *camelTrait) configureThreadPool(e *Environment) {
+	properties := make(map[string]string)
+	if t.ThreadPoolSize != nil {
+		properties["camel.threadpool.pool-size"] = strconv.Itoa(*t.ThreadPoolSize)
+	}
+	if t.ThreadPoolMaxSize != nil {
+		properties["camel.threadpool.max-pool-size"] = strconv.Itoa(*t.ThreadPoolMaxSize)
+	}
+	if t.ThreadPoolMaxQueueSize != nil {
+		properties["camel.threadpool.max-queue-size"] = strconv.Itoa(*t.ThreadPoolMaxQueueSize)
+	}
+	if t.ThreadPoolRejectedPolicy != "" {
+		properties["camel.threadpool.rejected-policy"] = t.ThreadPoolRejectedPolicy
+	}
+	if len(properties) == 0 {
+		return
+	}
+
+	if e.ApplicationProperties == nil {
+		e.ApplicationProperties = make(map[string]string)
+	}
+	for k, v := range properties {
+		e.ApplicationProperties[k] = v
+	}
+}
+
+func (t *camelTrait) configureStreamCaching(e *Environment) {
+	if e.ApplicationProperties == nil {
+		e.ApplicationProperties = make(map[string]string)
+	}
+	e.ApplicationProperties["camel.main.stream-caching-enabled"] = strconv.FormatBool(*t.StreamCaching)
+	if !*t.StreamCaching {
+		return
+	}
+
+	if t.StreamCachingSpoolThreshold != "" {
+		// The quantity has already been validated while configuring the trait
+		q := resource.MustParse(t.StreamCachingSpoolThreshold)
+		e.ApplicationProperties["camel.main.stream-caching-spool-threshold"] = strconv.FormatInt(q.Value(), 10)
+	}
+
+	if t.StreamCachingSpoolDirectory == "" {
+		return
+	}
+
+	e.ApplicationProperties["camel.main.stream-caching-spool-enabled"] = True
+	e.ApplicationProperties["camel.main.stream-caching-spool-directory"] = t.StreamCachingSpoolDirectory
+
+	if !e.IntegrationInPhase(v1.IntegrationPhaseDeploying, v1.IntegrationPhaseRunning) || t.isSpoolDirectoryMounted(e) {
+		return
+	}
+
+	// The volume is added once the integration container has been configured
+	e.PostProcessors = append(e.PostProcessors, func(env *Environment) error {
+		container := env.getIntegrationContainer()
+		if container == nil {
+			return nil
+		}
+
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      streamCachingSpoolVolumeName,
+			MountPath: t.StreamCachingSpoolDirectory,
+		})
+		env.Resources.VisitPodSpec(func(spec *corev1.PodSpec) {
+			spec.Volumes = append(spec.Volumes, corev1.Volume{
+				Name: streamCachingSpoolVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					EmptyDir: &corev1.EmptyDirVolumeSource{},
+				},
+			})
+		})
+
+		return nil
+	})
+}
+
+// isSpoolDirectoryMounted returns whether a volume is mounted at the spool directory with the mount trait
+func (t *camelTrait) isSpoolDirectoryMounted(e *Environment) bool {
+	mt, ok := e.Catalog.GetTrait("mount").(*mountTrait)
+	if !ok || mt.Enabled != nil && !*mt.Enabled {
+		return false
+	}
+	volumes, err := mt.parseVolumes()
+	if err != nil {
+		return false
+	}
+	for _, v := range volumes {
+		if path.Clean(v.path) == path.Clean(t.StreamCachingSpoolDirectory) {
+			return true
+		}
+	}
+	return false
+}
+
 func (t *camelTrait) loadOrCreateCatalog(e *Environment, runtimeVersion string) error {
 	ns := e.DetermineNamespace()
 	if ns == "" {
@@ -103,7 +300,9 @@ func (t *camelTrait) loadOrCreateCatalog(e *Environment, runtimeVersion string)
 		if exactVersionRegexp.MatchString(runtimeVersion) {
 			catalog, err = camel.GenerateCatalog(e.C, e.Client, ns, e.Platform.Status.Build.Maven, runtime, []maven.Dependency{})
 			if err != nil {
-				return err
+				return errors.Wrapf(err, "unable to generate catalog for runtime=%s, provider=%s, make sure the runtime version exists",
+					runtime.Version,
+					runtime.Provider)
 			}
 
 			// sanitize catalog name
@@ -127,6 +326,17 @@ func (t *camelTrait) loadOrCreateCatalog(e *Environment, runtimeVersion string)
 	}
 
 	if catalog == nil {
+		versions, err := t.availableRuntimeVersions(e, ns, runtime.Provider)
+		if err != nil {
+			return err
+		}
+		if len(versions) > 0 {
+			return fmt.Errorf("unable to find catalog matching version requirement: runtime=%s, provider=%s, available versions: %s",
+				runtime.Version,
+				runtime.Provider,
+				strings.Join(versions, ", "))
+		}
+
 		return fmt.Errorf("unable to find catalog matching version requirement: runtime=%s, provider=%s",
 			runtime.Version,
 			runtime.Provider)
@@ -137,6 +347,26 @@ func (t *camelTrait) loadOrCreateCatalog(e *Environment, runtimeVersion string)
 	return nil
 }
 
+// availableRuntimeVersions returns the runtime versions of the catalogs available in the given namespace for the given provider
+func (t *camelTrait) availableRuntimeVersions(e *Environment, namespace string, provider v1.RuntimeProvider) ([]string, error) {
+	list := v1.NewCamelCatalogList()
+	err := e.Client.List(e.C, &list, k8sclient.InNamespace(namespace), k8sclient.MatchingLabels{
+		"camel.apache.org/runtime.provider": string(provider),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]string, 0, len(list.Items))
+	for _, c := range list.Items {
+		versions = append(versions, c.Spec.Runtime.Version)
+	}
+
+	sort.Strings(versions)
+
+	return versions, nil
+}
+
 func (t *camelTrait) determineRuntimeVersion(e *Environment) string {
 	if t.RuntimeVersion != "" {
 		return t.RuntimeVersion
@@ -21,10 +21,13 @@ import (
 	"context"
 	"testing"
 
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/util/camel"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
 	"github.com/apache/camel-k/pkg/util/test"
 
 	"github.com/stretchr/testify/assert"
@@ -68,6 +71,144 @@ func TestApplyCamelTraitWithoutEnvironmentCatalogAndUnmatchableVersionFails(t *t
 	assert.Equal(t, "unable to find catalog matching version requirement: runtime=Unmatchable version, provider=main", err.Error())
 }
 
+func TestConfigureCamelTraitWithInvalidRuntimeVersionFails(t *testing.T) {
+	trait, environment := createNominalCamelTest()
+	trait.RuntimeVersion = "Invalid version"
+
+	configured, err := trait.Configure(environment)
+	assert.NotNil(t, err)
+	assert.False(t, configured)
+
+	trait.RuntimeVersion = "~1.5.0"
+
+	configured, err = trait.Configure(environment)
+	assert.Nil(t, err)
+	assert.True(t, configured)
+}
+
+func TestApplyCamelTraitWithoutEnvironmentCatalogListsAvailableVersions(t *testing.T) {
+	catalog := v1.NewCamelCatalogWithSpecs("namespace", "camel-catalog-0.0.2-main", v1.CamelCatalogSpec{
+		Runtime: v1.RuntimeSpec{
+			Version:  "0.0.2",
+			Provider: v1.RuntimeProviderMain,
+		},
+	})
+	catalog.Labels = map[string]string{
+		"camel.apache.org/runtime.provider": string(v1.RuntimeProviderMain),
+	}
+
+	client, _ := test.NewFakeClient(&catalog)
+
+	trait, environment := createNominalCamelTest()
+	environment.Client = client
+	environment.CamelCatalog = nil
+	trait.RuntimeVersion = "~1.0.0"
+
+	err := trait.Apply(environment)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable to find catalog matching version requirement: runtime=~1.0.0, provider=main, available versions: 0.0.2", err.Error())
+}
+
+func TestApplyCamelTraitWithStreamCaching(t *testing.T) {
+	trait, environment := createNominalCamelTest()
+	enabled := true
+	trait.StreamCaching = &enabled
+	trait.StreamCachingSpoolDirectory = "/tmp/camel-spool"
+	trait.StreamCachingSpoolThreshold = "128Ki"
+	environment.Integration.Status.Phase = v1.IntegrationPhaseDeploying
+	environment.Resources = kubernetes.NewCollection(&appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: defaultContainerName,
+						},
+					},
+				},
+			},
+		},
+	})
+
+	configured, err := trait.Configure(environment)
+	assert.Nil(t, err)
+	assert.True(t, configured)
+
+	err = trait.Apply(environment)
+	assert.Nil(t, err)
+	assert.Equal(t, "true", environment.ApplicationProperties["camel.main.stream-caching-enabled"])
+	assert.Equal(t, "true", environment.ApplicationProperties["camel.main.stream-caching-spool-enabled"])
+	assert.Equal(t, "/tmp/camel-spool", environment.ApplicationProperties["camel.main.stream-caching-spool-directory"])
+	assert.Equal(t, "131072", environment.ApplicationProperties["camel.main.stream-caching-spool-threshold"])
+
+	assert.Len(t, environment.PostProcessors, 1)
+	assert.Nil(t, environment.PostProcessors[0](environment))
+
+	spec := environment.Resources.GetDeployment(func(*appsv1.Deployment) bool { return true }).Spec.Template.Spec
+	assert.Len(t, spec.Volumes, 1)
+	assert.NotNil(t, spec.Volumes[0].EmptyDir)
+	assert.Equal(t, "/tmp/camel-spool", spec.Containers[0].VolumeMounts[0].MountPath)
+}
+
+func TestConfigureCamelTraitWithSpoolDirectoryButNoStreamCachingFails(t *testing.T) {
+	trait, environment := createNominalCamelTest()
+	trait.StreamCachingSpoolDirectory = "/tmp/camel-spool"
+
+	configured, err := trait.Configure(environment)
+	assert.NotNil(t, err)
+	assert.False(t, configured)
+
+	enabled := true
+	trait.StreamCaching = &enabled
+	trait.StreamCachingSpoolDirectory = "relative/spool"
+
+	configured, err = trait.Configure(environment)
+	assert.NotNil(t, err)
+	assert.False(t, configured)
+}
+
+func TestApplyCamelTraitWithThreadPool(t *testing.T) {
+	trait, environment := createNominalCamelTest()
+	poolSize := 10
+	maxPoolSize := 20
+	maxQueueSize := -1
+	trait.ThreadPoolSize = &poolSize
+	trait.ThreadPoolMaxSize = &maxPoolSize
+	trait.ThreadPoolMaxQueueSize = &maxQueueSize
+	trait.ThreadPoolRejectedPolicy = "CallerRuns"
+
+	configured, err := trait.Configure(environment)
+	assert.Nil(t, err)
+	assert.True(t, configured)
+
+	err = trait.Apply(environment)
+	assert.Nil(t, err)
+	assert.Equal(t, "10", environment.ApplicationProperties["camel.threadpool.pool-size"])
+	assert.Equal(t, "20", environment.ApplicationProperties["camel.threadpool.max-pool-size"])
+	assert.Equal(t, "-1", environment.ApplicationProperties["camel.threadpool.max-queue-size"])
+	assert.Equal(t, "CallerRuns", environment.ApplicationProperties["camel.threadpool.rejected-policy"])
+}
+
+func TestConfigureCamelTraitWithInvalidThreadPoolFails(t *testing.T) {
+	trait, environment := createNominalCamelTest()
+	trait.ThreadPoolRejectedPolicy = "Block"
+
+	configured, err := trait.Configure(environment)
+	assert.NotNil(t, err)
+	assert.False(t, configured)
+	assert.Equal(t, "unknown thread pool rejected policy: Block. One of [Abort, CallerRuns, DiscardOldest, Discard] is expected", err.Error())
+
+	poolSize := 20
+	maxPoolSize := 10
+	trait.ThreadPoolRejectedPolicy = ""
+	trait.ThreadPoolSize = &poolSize
+	trait.ThreadPoolMaxSize = &maxPoolSize
+
+	configured, err = trait.Configure(environment)
+	assert.NotNil(t, err)
+	assert.False(t, configured)
+}
+
 func createNominalCamelTest() (*camelTrait, *Environment) {
 	client, _ := test.NewFakeClient()
 
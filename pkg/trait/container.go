@@ -24,12 +24,20 @@ import (
 	"strings"
 
 	"github.com/apache/camel-k/pkg/util"
+	"github.com/pkg/errors"
 
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	serving "knative.dev/serving/pkg/apis/serving/v1"
 
@@ -63,6 +71,10 @@ type containerTrait struct {
 	LimitCPU string `property:"limit-cpu" json:"limitCPU,omitempty"`
 	// The maximum amount of memory required.
 	LimitMemory string `property:"limit-memory" json:"limitMemory,omitempty"`
+	// The minimum amount of ephemeral storage required.
+	RequestEphemeralStorage string `property:"request-ephemeral-storage" json:"requestEphemeralStorage,omitempty"`
+	// The maximum amount of ephemeral storage required.
+	LimitEphemeralStorage string `property:"limit-ephemeral-storage" json:"limitEphemeralStorage,omitempty"`
 
 	// Can be used to enable/disable exposure via kubernetes Service.
 	Expose *bool `property:"expose" json:"expose,omitempty"`
@@ -78,11 +90,38 @@ type containerTrait struct {
 	// The main container name. It's named `integration` by default.
 	Name string `property:"name" json:"name,omitempty"`
 
+	// The number of seconds the integration is given to drain the in-flight messages on shutdown,
+	// before the routes are forcibly stopped. It configures the Camel `camel.main.shutdown-timeout` property.
+	ShutdownTimeout *int `property:"shutdown-timeout" json:"shutdownTimeout,omitempty"`
+	// The number of seconds the integration pod is given to terminate gracefully, before being killed.
+	// It defaults to the shutdown timeout when it is set, and must not be lower than the shutdown timeout.
+	// It's not applicable to Knative services.
+	TerminationGracePeriodSeconds *int64 `property:"termination-grace-period-seconds" json:"terminationGracePeriodSeconds,omitempty"`
+	// The name of the ServiceAccount used to run the integration pod, e.g. to grant it the permissions required
+	// to call the Kubernetes API. It overrides the service account set on the integration.
+	ServiceAccountName string `property:"service-account-name" json:"serviceAccountName,omitempty"`
+	// A list of API permissions granted to a dedicated ServiceAccount, that's created along with a Role and a RoleBinding,
+	// and used to run the integration pod. Each permission has the form `[<api-group>/]<resource>[,<resource>]:<verb>[,<verb>]`,
+	// e.g. `configmaps:get,list` or `apps/deployments:get`. The operator can only grant permissions it holds itself.
+	// The resources are named after the integration, and existing resources that have not been created for the integration
+	// are not taken over. It cannot be combined with the `service-account-name` property.
+	ServiceAccountPermissions []string `property:"service-account-permissions" json:"serviceAccountPermissions,omitempty"`
+	// The name of the PriorityClass of the integration pod, so that critical integrations are scheduled first
+	// and preempted last. A missing PriorityClass is reported on the integration status.
+	PriorityClassName *string `property:"priority-class-name" json:"priorityClassName,omitempty"`
+
 	// ProbesEnabled enable/disable probes on the container (default `false`)
 	ProbesEnabled bool `property:"probes-enabled" json:"probesEnabled,omitempty"`
 	// Path to access on the probe ( default `/health`). Note that this property is not supported
 	// on quarkus runtime and setting it will result in the integration failing to start.
 	ProbePath string `property:"probe-path" json:"probePath,omitempty"`
+	// Path to access on the liveness probe (default to the probe path). The liveness probe is not configured
+	// when both this property and the probe path are empty. On the main runtime, it must be the same as
+	// the readiness probe path, as the health endpoint is served on a single path.
+	LivenessProbePath string `property:"liveness-probe-path" json:"livenessProbePath,omitempty"`
+	// Path to access on the readiness probe (default to the probe path). The readiness probe is not configured
+	// when both this property and the probe path are empty.
+	ReadinessProbePath string `property:"readiness-probe-path" json:"readinessProbePath,omitempty"`
 	// Number of seconds after the container has started before liveness probes are initiated.
 	LivenessInitialDelay int32 `property:"liveness-initial-delay" json:"livenessInitialDelay,omitempty"`
 	// Number of seconds after which the probe times out. Applies to the liveness probe.
@@ -131,6 +170,46 @@ func (t *containerTrait) Configure(e *Environment) (bool, error) {
 		return false, nil
 	}
 
+	if t.RequestEphemeralStorage != "" {
+		if _, err := resource.ParseQuantity(t.RequestEphemeralStorage); err != nil {
+			return false, fmt.Errorf("invalid request-ephemeral-storage quantity: %s", t.RequestEphemeralStorage)
+		}
+	}
+	if t.LimitEphemeralStorage != "" {
+		if _, err := resource.ParseQuantity(t.LimitEphemeralStorage); err != nil {
+			return false, fmt.Errorf("invalid limit-ephemeral-storage quantity: %s", t.LimitEphemeralStorage)
+		}
+	}
+
+	if err := t.validateShutdown(); err != nil {
+		return false, err
+	}
+
+	if t.ServiceAccountName != "" {
+		if errs := validation.IsDNS1123Subdomain(t.ServiceAccountName); len(errs) > 0 {
+			return false, fmt.Errorf("invalid service-account-name %s: %s", t.ServiceAccountName, strings.Join(errs, ", "))
+		}
+		if len(t.ServiceAccountPermissions) > 0 {
+			return false, fmt.Errorf("service-account-permissions cannot be combined with service-account-name %s", t.ServiceAccountName)
+		}
+	}
+	if _, err := t.policyRules(); err != nil {
+		return false, err
+	}
+
+	if t.PriorityClassName != nil {
+		if *t.PriorityClassName == "" {
+			return false, fmt.Errorf("invalid priority-class-name: must not be empty")
+		}
+		if errs := validation.IsDNS1123Subdomain(*t.PriorityClassName); len(errs) > 0 {
+			return false, fmt.Errorf("invalid priority-class-name %s: %s", *t.PriorityClassName, strings.Join(errs, ", "))
+		}
+	}
+
+	if err := t.validateProbePaths(e); err != nil {
+		return false, err
+	}
+
 	if t.Auto == nil || *t.Auto {
 		if t.Expose == nil {
 			e := e.Resources.GetServiceForIntegration(e.Integration) != nil
@@ -182,9 +261,10 @@ func (t *containerTrait) configureContainer(e *Environment) error {
 	}
 
 	container := corev1.Container{
-		Name:  t.Name,
-		Image: e.Integration.Status.Image,
-		Env:   make([]corev1.EnvVar, 0),
+		Name:    t.Name,
+		Image:   e.Integration.Status.Image,
+		Env:     make([]corev1.EnvVar, 0),
+		EnvFrom: e.EnvFrom,
 	}
 
 	// combine Environment of integration with platform, kit, integration
@@ -199,6 +279,10 @@ func (t *containerTrait) configureContainer(e *Environment) error {
 
 	t.configureResources(e, &container)
 
+	if t.ShutdownTimeout != nil {
+		e.ApplicationProperties["camel.main.shutdown-timeout"] = strconv.Itoa(*t.ShutdownTimeout)
+	}
+
 	if t.Expose != nil && *t.Expose {
 		t.configureService(e, &container)
 	}
@@ -211,7 +295,7 @@ func (t *containerTrait) configureContainer(e *Environment) error {
 	//
 	if err := e.Resources.VisitDeploymentE(func(deployment *appsv1.Deployment) error {
 		if t.ProbesEnabled && t.PortName == httpPortName {
-			if err := t.configureProbes(e, &container, t.Port, t.ProbePath); err != nil {
+			if err := t.configureProbes(e, &container, t.Port); err != nil {
 				return err
 			}
 		}
@@ -228,6 +312,10 @@ func (t *containerTrait) configureContainer(e *Environment) error {
 			&container.VolumeMounts,
 		)
 
+		if grace := t.terminationGracePeriodSeconds(); grace != nil {
+			deployment.Spec.Template.Spec.TerminationGracePeriodSeconds = grace
+		}
+
 		deployment.Spec.Template.Spec.Containers = append(deployment.Spec.Template.Spec.Containers, container)
 
 		return nil
@@ -241,7 +329,7 @@ func (t *containerTrait) configureContainer(e *Environment) error {
 	if err := e.Resources.VisitKnativeServiceE(func(service *serving.Service) error {
 		if t.ProbesEnabled && t.PortName == httpPortName {
 			// don't set the port on Knative service as it is not allowed.
-			if err := t.configureProbes(e, &container, 0, t.ProbePath); err != nil {
+			if err := t.configureProbes(e, &container, 0); err != nil {
 				return err
 			}
 		}
@@ -269,6 +357,10 @@ func (t *containerTrait) configureContainer(e *Environment) error {
 			&container.VolumeMounts,
 		)
 
+		if t.TerminationGracePeriodSeconds != nil {
+			t.L.Infof("Skipping termination grace period, not supported by Knative services")
+		}
+
 		service.Spec.ConfigurationSpec.Template.Spec.Containers = append(service.Spec.ConfigurationSpec.Template.Spec.Containers, container)
 
 		return nil
@@ -281,7 +373,7 @@ func (t *containerTrait) configureContainer(e *Environment) error {
 	//
 	if err := e.Resources.VisitCronJobE(func(cron *v1beta1.CronJob) error {
 		if t.ProbesEnabled && t.PortName == httpPortName {
-			if err := t.configureProbes(e, &container, t.Port, t.ProbePath); err != nil {
+			if err := t.configureProbes(e, &container, t.Port); err != nil {
 				return err
 			}
 		}
@@ -298,6 +390,10 @@ func (t *containerTrait) configureContainer(e *Environment) error {
 			&container.VolumeMounts,
 		)
 
+		if grace := t.terminationGracePeriodSeconds(); grace != nil {
+			cron.Spec.JobTemplate.Spec.Template.Spec.TerminationGracePeriodSeconds = grace
+		}
+
 		cron.Spec.JobTemplate.Spec.Template.Spec.Containers = append(cron.Spec.JobTemplate.Spec.Template.Spec.Containers, container)
 
 		return nil
@@ -305,6 +401,282 @@ func (t *containerTrait) configureContainer(e *Environment) error {
 		return err
 	}
 
+	if t.ServiceAccountName != "" {
+		e.Resources.VisitPodSpec(func(p *corev1.PodSpec) {
+			p.ServiceAccountName = t.ServiceAccountName
+		})
+		t.checkServiceAccount(e)
+	}
+
+	if len(t.ServiceAccountPermissions) > 0 {
+		if err := t.configureServiceAccount(e); err != nil {
+			return err
+		}
+	}
+
+	if t.PriorityClassName != nil {
+		e.Resources.VisitDeployment(func(d *appsv1.Deployment) {
+			d.Spec.Template.Spec.PriorityClassName = *t.PriorityClassName
+		})
+		e.Resources.VisitCronJob(func(c *v1beta1.CronJob) {
+			c.Spec.JobTemplate.Spec.Template.Spec.PriorityClassName = *t.PriorityClassName
+		})
+		e.Resources.VisitKnativeService(func(*serving.Service) {
+			t.L.Infof("Skipping priority class, not supported by Knative services")
+		})
+		t.checkPriorityClass(e)
+	}
+
+	return nil
+}
+
+// configureServiceAccount creates a ServiceAccount dedicated to the integration, bound to a Role granting
+// the configured permissions. They are labelled like the other integration resources, so that they are
+// garbage collected along with the integration.
+func (t *containerTrait) configureServiceAccount(e *Environment) error {
+	rules, err := t.policyRules()
+	if err != nil {
+		return err
+	}
+
+	if err := t.checkServiceAccountOwnership(e); err != nil {
+		return err
+	}
+
+	labels := map[string]string{
+		v1.IntegrationLabel: e.Integration.Name,
+	}
+
+	sa := corev1.ServiceAccount{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ServiceAccount",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      e.Integration.Name,
+			Namespace: e.Integration.Namespace,
+			Labels:    labels,
+		},
+	}
+
+	role := rbacv1.Role{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Role",
+			APIVersion: rbacv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      e.Integration.Name,
+			Namespace: e.Integration.Namespace,
+			Labels:    labels,
+		},
+		Rules: rules,
+	}
+
+	binding := rbacv1.RoleBinding{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "RoleBinding",
+			APIVersion: rbacv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      e.Integration.Name,
+			Namespace: e.Integration.Namespace,
+			Labels:    labels,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      sa.Name,
+				Namespace: sa.Namespace,
+			},
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     role.Name,
+		},
+	}
+
+	e.Resources.Add(&sa)
+	e.Resources.Add(&role)
+	e.Resources.Add(&binding)
+
+	e.Resources.VisitPodSpec(func(p *corev1.PodSpec) {
+		p.ServiceAccountName = sa.Name
+	})
+
+	return nil
+}
+
+// checkServiceAccountOwnership refuses to take over an existing ServiceAccount, Role or RoleBinding that has not
+// been created for the integration, as granting it the permissions of the integration would affect other workloads
+func (t *containerTrait) checkServiceAccountOwnership(e *Environment) error {
+	if t.Client == nil {
+		return nil
+	}
+
+	key := client.ObjectKey{Namespace: e.Integration.Namespace, Name: e.Integration.Name}
+	existing := []struct {
+		kind   string
+		object runtime.Object
+	}{
+		{"ServiceAccount", &corev1.ServiceAccount{}},
+		{"Role", &rbacv1.Role{}},
+		{"RoleBinding", &rbacv1.RoleBinding{}},
+	}
+
+	for _, r := range existing {
+		if err := t.Client.Get(t.Ctx, key, r.object); err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+			return errors.Wrapf(err, "unable to check %s %s", r.kind, key.Name)
+		}
+		if r.object.(metav1.Object).GetLabels()[v1.IntegrationLabel] != e.Integration.Name {
+			return fmt.Errorf("%s %s already exists in namespace %s, and is not managed by the integration", r.kind, key.Name, key.Namespace)
+		}
+	}
+
+	return nil
+}
+
+// policyRules parses the service account permissions, each having the form
+// [<api-group>/]<resource>[,<resource>]:<verb>[,<verb>]
+func (t *containerTrait) policyRules() ([]rbacv1.PolicyRule, error) {
+	rules := make([]rbacv1.PolicyRule, 0, len(t.ServiceAccountPermissions))
+	for _, permission := range t.ServiceAccountPermissions {
+		parts := strings.Split(permission, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid service-account-permissions %s: expected [<api-group>/]<resource>[,<resource>]:<verb>[,<verb>]", permission)
+		}
+
+		group := ""
+		resources := parts[0]
+		if i := strings.Index(resources, "/"); i >= 0 {
+			group = resources[:i]
+			resources = resources[i+1:]
+		}
+
+		rule := rbacv1.PolicyRule{
+			APIGroups: []string{group},
+			Resources: splitList(resources),
+			Verbs:     splitList(parts[1]),
+		}
+		if len(rule.Resources) == 0 || len(rule.Verbs) == 0 {
+			return nil, fmt.Errorf("invalid service-account-permissions %s: at least one resource and one verb are expected", permission)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+func splitList(list string) []string {
+	values := make([]string, 0)
+	for _, value := range strings.Split(list, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// checkServiceAccount reports a missing service account on the integration status, without failing
+// the deployment, as the service account may be created after the integration
+func (t *containerTrait) checkServiceAccount(e *Environment) {
+	if t.Client == nil {
+		return
+	}
+
+	sa := corev1.ServiceAccount{}
+	key := client.ObjectKey{Namespace: e.Integration.Namespace, Name: t.ServiceAccountName}
+	if err := t.Client.Get(t.Ctx, key, &sa); err != nil {
+		if !k8serrors.IsNotFound(err) {
+			t.L.ForIntegration(e.Integration).Errorf(err, "unable to check service account %s", t.ServiceAccountName)
+			return
+		}
+		t.L.ForIntegration(e.Integration).Infof("Service account %s not found in namespace %s", t.ServiceAccountName, e.Integration.Namespace)
+		e.Integration.Status.RemoveCondition(v1.IntegrationConditionServiceAccountAvailable)
+		e.Integration.Status.SetCondition(
+			v1.IntegrationConditionServiceAccountAvailable,
+			corev1.ConditionFalse,
+			v1.IntegrationConditionServiceAccountNotAvailableReason,
+			fmt.Sprintf("service account %s not found in namespace %s", t.ServiceAccountName, e.Integration.Namespace),
+		)
+		return
+	}
+
+	// The message carries the service account name, that is refreshed even if the reason is unchanged
+	e.Integration.Status.RemoveCondition(v1.IntegrationConditionServiceAccountAvailable)
+	e.Integration.Status.SetCondition(
+		v1.IntegrationConditionServiceAccountAvailable,
+		corev1.ConditionTrue,
+		v1.IntegrationConditionServiceAccountAvailableReason,
+		sa.Name,
+	)
+}
+
+// checkPriorityClass reports a missing priority class on the integration status, without failing
+// the deployment, as the pods are still scheduled, or get rejected by the API server
+func (t *containerTrait) checkPriorityClass(e *Environment) {
+	if t.Client == nil {
+		return
+	}
+
+	// Priority classes are cluster scoped and not watched by the operator, so they are read
+	// directly from the API server instead of the namespaced cache
+	name := *t.PriorityClassName
+	pc, err := t.Client.SchedulingV1().PriorityClasses().Get(name, metav1.GetOptions{})
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			t.L.ForIntegration(e.Integration).Errorf(err, "unable to check priority class %s", name)
+			return
+		}
+		t.L.ForIntegration(e.Integration).Infof("Priority class %s not found", name)
+		e.Integration.Status.RemoveCondition(v1.IntegrationConditionPriorityClassAvailable)
+		e.Integration.Status.SetCondition(
+			v1.IntegrationConditionPriorityClassAvailable,
+			corev1.ConditionFalse,
+			v1.IntegrationConditionPriorityClassNotAvailableReason,
+			fmt.Sprintf("priority class %s not found", name),
+		)
+		return
+	}
+
+	// The message carries the priority class value, that is refreshed even if the reason is unchanged
+	e.Integration.Status.RemoveCondition(v1.IntegrationConditionPriorityClassAvailable)
+	e.Integration.Status.SetCondition(
+		v1.IntegrationConditionPriorityClassAvailable,
+		corev1.ConditionTrue,
+		v1.IntegrationConditionPriorityClassAvailableReason,
+		fmt.Sprintf("%s (value %d)", pc.Name, pc.Value),
+	)
+}
+
+func (t *containerTrait) validateShutdown() error {
+	if t.ShutdownTimeout != nil && *t.ShutdownTimeout < 0 {
+		return fmt.Errorf("invalid shutdown-timeout: %d, must not be negative", *t.ShutdownTimeout)
+	}
+	if t.TerminationGracePeriodSeconds != nil {
+		if *t.TerminationGracePeriodSeconds < 0 {
+			return fmt.Errorf("invalid termination-grace-period-seconds: %d, must not be negative", *t.TerminationGracePeriodSeconds)
+		}
+		if t.ShutdownTimeout != nil && *t.TerminationGracePeriodSeconds < int64(*t.ShutdownTimeout) {
+			return fmt.Errorf("invalid termination-grace-period-seconds: %d, must not be lower than the shutdown-timeout %d",
+				*t.TerminationGracePeriodSeconds, *t.ShutdownTimeout)
+		}
+	}
+	return nil
+}
+
+func (t *containerTrait) terminationGracePeriodSeconds() *int64 {
+	if t.TerminationGracePeriodSeconds != nil {
+		return t.TerminationGracePeriodSeconds
+	}
+	if t.ShutdownTimeout != nil {
+		grace := int64(*t.ShutdownTimeout)
+		return &grace
+	}
 	return nil
 }
 
@@ -327,6 +699,15 @@ func (t *containerTrait) configureService(e *Environment, container *corev1.Cont
 		TargetPort: intstr.FromString(t.PortName),
 	}
 
+	// The port may have been explicitly declared with the service trait
+	declared := false
+	for _, p := range service.Spec.Ports {
+		if p.Name == servicePort.Name {
+			servicePort = p
+			declared = true
+		}
+	}
+
 	e.Integration.Status.SetCondition(
 		v1.IntegrationConditionServiceAvailable,
 		corev1.ConditionTrue,
@@ -339,7 +720,9 @@ func (t *containerTrait) configureService(e *Environment, container *corev1.Cont
 	)
 
 	container.Ports = append(container.Ports, containerPort)
-	service.Spec.Ports = append(service.Spec.Ports, servicePort)
+	if !declared {
+		service.Spec.Ports = append(service.Spec.Ports, servicePort)
+	}
 
 	// Mark the service as a user service
 	service.Labels["camel.apache.org/service.type"] = v1.ServiceTypeUser
@@ -369,6 +752,14 @@ func (t *containerTrait) configureResources(_ *Environment, container *corev1.Co
 			container.Resources.Requests[corev1.ResourceMemory] = v
 		}
 	}
+	if t.RequestEphemeralStorage != "" {
+		v, err := resource.ParseQuantity(t.RequestEphemeralStorage)
+		if err != nil {
+			t.L.Error(err, "unable to parse quantity", "request-ephemeral-storage", t.RequestEphemeralStorage)
+		} else {
+			container.Resources.Requests[corev1.ResourceEphemeralStorage] = v
+		}
+	}
 
 	//
 	// Limits
@@ -393,6 +784,14 @@ func (t *containerTrait) configureResources(_ *Environment, container *corev1.Co
 			container.Resources.Limits[corev1.ResourceMemory] = v
 		}
 	}
+	if t.LimitEphemeralStorage != "" {
+		v, err := resource.ParseQuantity(t.LimitEphemeralStorage)
+		if err != nil {
+			t.L.Error(err, "unable to parse quantity", "limit-ephemeral-storage", t.LimitEphemeralStorage)
+		} else {
+			container.Resources.Limits[corev1.ResourceEphemeralStorage] = v
+		}
+	}
 }
 
 func (t *containerTrait) configureHTTP(e *Environment) error {
@@ -430,15 +829,22 @@ func (t *containerTrait) configureCapabilities(e *Environment) error {
 	return nil
 }
 
-func (t *containerTrait) configureProbes(e *Environment, container *corev1.Container, port int, path string) error {
+func (t *containerTrait) configureProbes(e *Environment, container *corev1.Container, port int) error {
 	if err := t.configureHTTP(e); err != nil {
 		return nil
 	}
 
+	livenessPath, readinessPath := t.probePaths()
+
 	switch e.CamelCatalog.Runtime.Provider {
 	case v1.RuntimeProviderMain:
+		// The paths have already been validated while configuring the trait, so that they are equal when both set
+		healthPath := readinessPath
+		if healthPath == "" {
+			healthPath = livenessPath
+		}
 		e.ApplicationProperties["customizer.health.enabled"] = True
-		e.ApplicationProperties["customizer.health.path"] = path
+		e.ApplicationProperties["customizer.health.path"] = healthPath
 	case v1.RuntimeProviderQuarkus:
 		// Quarkus does not offer a runtime option to change the path of the health endpoint but there
 		// is a build time property:
@@ -448,19 +854,59 @@ func (t *containerTrait) configureProbes(e *Environment, container *corev1.Conta
 		// so failing in case user tries to change the path.
 		//
 		// NOTE: we could probably be more opinionated and make the path an internal detail.
-		if path != defaultProbePath {
-			return fmt.Errorf("health check root path can't be changed at runtimme on Quarkus")
+		for _, path := range []string{livenessPath, readinessPath} {
+			if path != "" && !isQuarkusProbePath(path) {
+				return fmt.Errorf("health check root path can't be changed at runtimme on Quarkus")
+			}
 		}
 	default:
 		return fmt.Errorf("unsupported runtime: %s", e.CamelCatalog.Runtime.Provider)
 	}
 
-	container.LivenessProbe = t.newLivenessProbe(port, path)
-	container.ReadinessProbe = t.newReadinessProbe(port, path)
+	if livenessPath != "" {
+		container.LivenessProbe = t.newLivenessProbe(port, livenessPath)
+	}
+	if readinessPath != "" {
+		container.ReadinessProbe = t.newReadinessProbe(port, readinessPath)
+	}
+
+	return nil
+}
+
+// probePaths returns the paths of the liveness and readiness probes, that default to the probe path
+func (t *containerTrait) probePaths() (string, string) {
+	livenessPath := t.LivenessProbePath
+	if livenessPath == "" {
+		livenessPath = t.ProbePath
+	}
+	readinessPath := t.ReadinessProbePath
+	if readinessPath == "" {
+		readinessPath = t.ProbePath
+	}
+	return livenessPath, readinessPath
+}
+
+// validateProbePaths checks the probe paths can be served by the runtime. The main runtime serves the health
+// endpoint on a single path, so that the liveness and readiness probes cannot use different paths.
+func (t *containerTrait) validateProbePaths(e *Environment) error {
+	if !t.ProbesEnabled || e.CamelCatalog == nil || e.CamelCatalog.Runtime.Provider != v1.RuntimeProviderMain {
+		return nil
+	}
+
+	livenessPath, readinessPath := t.probePaths()
+	if livenessPath != "" && readinessPath != "" && livenessPath != readinessPath {
+		return fmt.Errorf("liveness probe path %s and readiness probe path %s must be the same on the main runtime, "+
+			"that serves the health endpoint on a single path", livenessPath, readinessPath)
+	}
 
 	return nil
 }
 
+// isQuarkusProbePath checks the path is one of the health endpoints exposed by Quarkus
+func isQuarkusProbePath(path string) bool {
+	return path == defaultProbePath || path == defaultProbePath+"/live" || path == defaultProbePath+"/ready"
+}
+
 func (t *containerTrait) newLivenessProbe(port int, path string) *corev1.Probe {
 	action := corev1.HTTPGetAction{}
 	action.Path = path
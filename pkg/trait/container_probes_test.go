@@ -175,3 +175,93 @@ func TestProbesOnKnativeServiceWithNoHttpPort(t *testing.T) {
 	assert.Nil(t, target.Spec.Template.Spec.Containers[0].LivenessProbe)
 	assert.Nil(t, target.Spec.Template.Spec.Containers[0].ReadinessProbe)
 }
+
+func TestProbesWithDistinctPaths(t *testing.T) {
+	env := newTestProbesEnv(t, v1.RuntimeProviderMain)
+	env.Integration.Status.Phase = v1.IntegrationPhaseDeploying
+
+	ctr := newTestContainerTrait()
+	ctr.LivenessProbePath = "/health/live"
+	ctr.ReadinessProbePath = "/health/ready"
+
+	ok, err := ctr.Configure(&env)
+	assert.NotNil(t, err)
+	assert.False(t, ok)
+
+	// The readiness probe path defaults to the probe path
+	ctr = newTestContainerTrait()
+	ctr.LivenessProbePath = "/live"
+
+	ok, err = ctr.Configure(&env)
+	assert.NotNil(t, err)
+	assert.False(t, ok)
+}
+
+func TestProbesWithCustomPaths(t *testing.T) {
+	target := appsv1.Deployment{}
+
+	env := newTestProbesEnv(t, v1.RuntimeProviderMain)
+	env.Integration.Status.Phase = v1.IntegrationPhaseDeploying
+	env.Resources.Add(&target)
+
+	ctr := newTestContainerTrait()
+	ctr.LivenessProbePath = "/healthz"
+	ctr.LivenessInitialDelay = 10
+	ctr.ReadinessProbePath = "/healthz"
+	ctr.ReadinessInitialDelay = 60
+
+	ok, err := ctr.Configure(&env)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	err = ctr.Apply(&env)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "/healthz", target.Spec.Template.Spec.Containers[0].LivenessProbe.HTTPGet.Path)
+	assert.Equal(t, int32(10), target.Spec.Template.Spec.Containers[0].LivenessProbe.InitialDelaySeconds)
+	assert.Equal(t, "/healthz", target.Spec.Template.Spec.Containers[0].ReadinessProbe.HTTPGet.Path)
+	assert.Equal(t, int32(60), target.Spec.Template.Spec.Containers[0].ReadinessProbe.InitialDelaySeconds)
+	assert.Equal(t, "/healthz", env.ApplicationProperties["customizer.health.path"])
+}
+
+func TestProbesWithReadinessOnly(t *testing.T) {
+	target := appsv1.Deployment{}
+
+	env := newTestProbesEnv(t, v1.RuntimeProviderMain)
+	env.Integration.Status.Phase = v1.IntegrationPhaseDeploying
+	env.Resources.Add(&target)
+
+	ctr := newTestContainerTrait()
+	ctr.ProbePath = ""
+	ctr.ReadinessProbePath = "/ready"
+
+	err := ctr.Apply(&env)
+	assert.Nil(t, err)
+
+	assert.Nil(t, target.Spec.Template.Spec.Containers[0].LivenessProbe)
+	assert.Equal(t, "/ready", target.Spec.Template.Spec.Containers[0].ReadinessProbe.HTTPGet.Path)
+	assert.Equal(t, "/ready", env.ApplicationProperties["customizer.health.path"])
+}
+
+func TestProbesWithDistinctPathsOnQuarkus(t *testing.T) {
+	target := appsv1.Deployment{}
+
+	env := newTestProbesEnv(t, v1.RuntimeProviderQuarkus)
+	env.Integration.Status.Phase = v1.IntegrationPhaseDeploying
+	env.Resources.Add(&target)
+
+	ctr := newTestContainerTrait()
+	ctr.LivenessProbePath = "/health/live"
+	ctr.ReadinessProbePath = "/health/ready"
+
+	err := ctr.Apply(&env)
+	assert.Nil(t, err)
+	assert.Equal(t, "/health/live", target.Spec.Template.Spec.Containers[0].LivenessProbe.HTTPGet.Path)
+	assert.Equal(t, "/health/ready", target.Spec.Template.Spec.Containers[0].ReadinessProbe.HTTPGet.Path)
+
+	ctr = newTestContainerTrait()
+	ctr.ReadinessProbePath = "/ready"
+
+	err = ctr.Apply(&env)
+	assert.NotNil(t, err)
+}
@@ -24,7 +24,11 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/util/camel"
@@ -148,3 +152,389 @@ func TestContainerWithCustomName(t *testing.T) {
 	trait := test.TraitSpecToMap(t, environment.Integration.Spec.Traits["container"])
 	assert.Equal(t, trait["name"], d.Spec.Template.Spec.Containers[0].Name)
 }
+
+func TestContainerWithEphemeralStorage(t *testing.T) {
+	traitCatalog := NewCatalog(context.TODO(), nil)
+	environment := createContainerTestEnvironment(t, traitCatalog, map[string]v1.TraitSpec{
+		"container": test.TraitSpecFromMap(t, map[string]interface{}{
+			"requestEphemeralStorage": "500Mi",
+			"limitEphemeralStorage":   "1Gi",
+		}),
+	})
+
+	err := traitCatalog.apply(environment)
+
+	assert.Nil(t, err)
+
+	d := environment.Resources.GetDeploymentForIntegration(environment.Integration)
+
+	assert.NotNil(t, d)
+	assert.Len(t, d.Spec.Template.Spec.Containers, 1)
+
+	resources := d.Spec.Template.Spec.Containers[0].Resources
+	assert.Equal(t, resource.MustParse("500Mi"), resources.Requests[corev1.ResourceEphemeralStorage])
+	assert.Equal(t, resource.MustParse("1Gi"), resources.Limits[corev1.ResourceEphemeralStorage])
+}
+
+func TestContainerWithInvalidEphemeralStorage(t *testing.T) {
+	environment := Environment{
+		Integration: &v1.Integration{
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+		},
+		Resources: kubernetes.NewCollection(),
+	}
+
+	ctr := newContainerTrait().(*containerTrait)
+	ctr.LimitEphemeralStorage = "1 gigabyte"
+
+	ok, err := ctr.Configure(&environment)
+	assert.NotNil(t, err)
+	assert.False(t, ok)
+}
+
+func TestContainerWithShutdownTimeout(t *testing.T) {
+	traitCatalog := NewCatalog(context.TODO(), nil)
+	environment := createContainerTestEnvironment(t, traitCatalog, map[string]v1.TraitSpec{
+		"container": test.TraitSpecFromMap(t, map[string]interface{}{
+			"shutdownTimeout": 60,
+		}),
+	})
+
+	err := traitCatalog.apply(environment)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "60", environment.ApplicationProperties["camel.main.shutdown-timeout"])
+
+	d := environment.Resources.GetDeploymentForIntegration(environment.Integration)
+
+	assert.NotNil(t, d)
+	assert.NotNil(t, d.Spec.Template.Spec.TerminationGracePeriodSeconds)
+	assert.Equal(t, int64(60), *d.Spec.Template.Spec.TerminationGracePeriodSeconds)
+}
+
+func TestContainerWithServiceAccount(t *testing.T) {
+	client, err := test.NewFakeClient(&corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "my-sa",
+		},
+	}, &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "other-sa",
+		},
+	})
+	assert.Nil(t, err)
+
+	traitCatalog := NewCatalog(context.TODO(), client)
+	environment := createContainerTestEnvironment(t, traitCatalog, map[string]v1.TraitSpec{
+		"container": test.TraitSpecFromMap(t, map[string]interface{}{
+			"serviceAccountName": "my-sa",
+		}),
+	})
+	environment.Integration.Spec.ServiceAccountName = "default"
+
+	err = traitCatalog.apply(environment)
+	assert.Nil(t, err)
+
+	d := environment.Resources.GetDeploymentForIntegration(environment.Integration)
+	assert.NotNil(t, d)
+	assert.Equal(t, "my-sa", d.Spec.Template.Spec.ServiceAccountName)
+
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionServiceAccountAvailable)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Equal(t, "my-sa", condition.Message)
+
+	environment.Integration.Spec.Traits["container"] = test.TraitSpecFromMap(t, map[string]interface{}{
+		"serviceAccountName": "other-sa",
+	})
+	environment.Resources = kubernetes.NewCollection()
+	environment.ExecutedTraits = make([]Trait, 0)
+
+	err = traitCatalog.apply(environment)
+	assert.Nil(t, err)
+
+	condition = environment.Integration.Status.GetCondition(v1.IntegrationConditionServiceAccountAvailable)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Equal(t, "other-sa", condition.Message)
+
+	environment.Integration.Spec.Traits["container"] = test.TraitSpecFromMap(t, map[string]interface{}{
+		"serviceAccountName": "missing-sa",
+	})
+	environment.Resources = kubernetes.NewCollection()
+	environment.ExecutedTraits = make([]Trait, 0)
+
+	err = traitCatalog.apply(environment)
+	assert.Nil(t, err)
+
+	condition = environment.Integration.Status.GetCondition(v1.IntegrationConditionServiceAccountAvailable)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Equal(t, v1.IntegrationConditionServiceAccountNotAvailableReason, condition.Reason)
+}
+
+func TestContainerWithPriorityClass(t *testing.T) {
+	client, err := test.NewFakeClient(&schedulingv1.PriorityClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "critical",
+		},
+		Value: 1000000,
+	}, &schedulingv1.PriorityClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "high",
+		},
+		Value: 1000,
+	})
+	assert.Nil(t, err)
+
+	traitCatalog := NewCatalog(context.TODO(), client)
+	environment := createContainerTestEnvironment(t, traitCatalog, map[string]v1.TraitSpec{
+		"container": test.TraitSpecFromMap(t, map[string]interface{}{
+			"priorityClassName": "critical",
+		}),
+	})
+
+	err = traitCatalog.apply(environment)
+	assert.Nil(t, err)
+
+	d := environment.Resources.GetDeploymentForIntegration(environment.Integration)
+	assert.NotNil(t, d)
+	assert.Equal(t, "critical", d.Spec.Template.Spec.PriorityClassName)
+
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionPriorityClassAvailable)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Equal(t, "critical (value 1000000)", condition.Message)
+
+	environment.Integration.Spec.Traits["container"] = test.TraitSpecFromMap(t, map[string]interface{}{
+		"priorityClassName": "high",
+	})
+	environment.Resources = kubernetes.NewCollection()
+	environment.ExecutedTraits = make([]Trait, 0)
+
+	err = traitCatalog.apply(environment)
+	assert.Nil(t, err)
+
+	condition = environment.Integration.Status.GetCondition(v1.IntegrationConditionPriorityClassAvailable)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Equal(t, "high (value 1000)", condition.Message)
+
+	environment.Integration.Spec.Traits["container"] = test.TraitSpecFromMap(t, map[string]interface{}{
+		"priorityClassName": "missing",
+	})
+	environment.Resources = kubernetes.NewCollection()
+	environment.ExecutedTraits = make([]Trait, 0)
+
+	err = traitCatalog.apply(environment)
+	assert.Nil(t, err)
+
+	condition = environment.Integration.Status.GetCondition(v1.IntegrationConditionPriorityClassAvailable)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Equal(t, v1.IntegrationConditionPriorityClassNotAvailableReason, condition.Reason)
+
+	environment.Integration.Spec.Traits["container"] = test.TraitSpecFromMap(t, map[string]interface{}{
+		"priorityClassName": "",
+	})
+	environment.Resources = kubernetes.NewCollection()
+	environment.ExecutedTraits = make([]Trait, 0)
+
+	err = traitCatalog.apply(environment)
+	assert.NotNil(t, err)
+}
+
+func TestContainerWithServiceAccountPermissions(t *testing.T) {
+	traitCatalog := NewCatalog(context.TODO(), nil)
+	environment := createContainerTestEnvironment(t, traitCatalog, map[string]v1.TraitSpec{
+		"container": test.TraitSpecFromMap(t, map[string]interface{}{
+			"serviceAccountPermissions": []string{"configmaps,secrets:get,list", "apps/deployments:get"},
+		}),
+	})
+
+	err := traitCatalog.apply(environment)
+	assert.Nil(t, err)
+
+	var sa *corev1.ServiceAccount
+	var role *rbacv1.Role
+	var binding *rbacv1.RoleBinding
+	environment.Resources.Visit(func(object runtime.Object) {
+		switch o := object.(type) {
+		case *corev1.ServiceAccount:
+			sa = o
+		case *rbacv1.Role:
+			role = o
+		case *rbacv1.RoleBinding:
+			binding = o
+		}
+	})
+
+	assert.NotNil(t, sa)
+	assert.Equal(t, ServiceTestName, sa.Name)
+	assert.Equal(t, ServiceTestName, sa.Labels[v1.IntegrationLabel])
+
+	assert.NotNil(t, role)
+	assert.Equal(t, ServiceTestName, role.Labels[v1.IntegrationLabel])
+	assert.Equal(t, []rbacv1.PolicyRule{
+		{
+			APIGroups: []string{""},
+			Resources: []string{"configmaps", "secrets"},
+			Verbs:     []string{"get", "list"},
+		},
+		{
+			APIGroups: []string{"apps"},
+			Resources: []string{"deployments"},
+			Verbs:     []string{"get"},
+		},
+	}, role.Rules)
+
+	assert.NotNil(t, binding)
+	assert.Equal(t, ServiceTestName, binding.Labels[v1.IntegrationLabel])
+	assert.Equal(t, role.Name, binding.RoleRef.Name)
+	assert.Len(t, binding.Subjects, 1)
+	assert.Equal(t, sa.Name, binding.Subjects[0].Name)
+
+	d := environment.Resources.GetDeploymentForIntegration(environment.Integration)
+	assert.NotNil(t, d)
+	assert.Equal(t, sa.Name, d.Spec.Template.Spec.ServiceAccountName)
+}
+
+func TestContainerWithServiceAccountPermissionsDoesNotTakeOver(t *testing.T) {
+	for _, existing := range []runtime.Object{
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: ServiceTestName}},
+		&rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: ServiceTestName}},
+		&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: ServiceTestName}},
+	} {
+		client, err := test.NewFakeClient(existing)
+		assert.Nil(t, err)
+
+		traitCatalog := NewCatalog(context.TODO(), client)
+		environment := createContainerTestEnvironment(t, traitCatalog, map[string]v1.TraitSpec{
+			"container": test.TraitSpecFromMap(t, map[string]interface{}{
+				"serviceAccountPermissions": []string{"configmaps:get"},
+			}),
+		})
+
+		err = traitCatalog.apply(environment)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "not managed by the integration")
+	}
+
+	// The resources previously created for the integration are updated
+	client, err := test.NewFakeClient(&corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      ServiceTestName,
+			Labels: map[string]string{
+				v1.IntegrationLabel: ServiceTestName,
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	traitCatalog := NewCatalog(context.TODO(), client)
+	environment := createContainerTestEnvironment(t, traitCatalog, map[string]v1.TraitSpec{
+		"container": test.TraitSpecFromMap(t, map[string]interface{}{
+			"serviceAccountPermissions": []string{"configmaps:get"},
+		}),
+	})
+
+	err = traitCatalog.apply(environment)
+	assert.Nil(t, err)
+}
+
+func TestContainerWithInvalidServiceAccountPermissions(t *testing.T) {
+	environment := Environment{
+		Integration: &v1.Integration{
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+		},
+		Resources: kubernetes.NewCollection(),
+	}
+
+	ctr := newContainerTrait().(*containerTrait)
+	ctr.ServiceAccountPermissions = []string{"configmaps"}
+
+	ok, err := ctr.Configure(&environment)
+	assert.NotNil(t, err)
+	assert.False(t, ok)
+
+	ctr = newContainerTrait().(*containerTrait)
+	ctr.ServiceAccountName = "my-sa"
+	ctr.ServiceAccountPermissions = []string{"configmaps:get"}
+
+	ok, err = ctr.Configure(&environment)
+	assert.NotNil(t, err)
+	assert.False(t, ok)
+}
+
+func TestContainerWithInvalidTerminationGracePeriod(t *testing.T) {
+	environment := Environment{
+		Integration: &v1.Integration{
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+		},
+		Resources: kubernetes.NewCollection(),
+	}
+
+	shutdownTimeout := 60
+	grace := int64(30)
+
+	ctr := newContainerTrait().(*containerTrait)
+	ctr.ShutdownTimeout = &shutdownTimeout
+	ctr.TerminationGracePeriodSeconds = &grace
+
+	ok, err := ctr.Configure(&environment)
+	assert.NotNil(t, err)
+	assert.False(t, ok)
+}
+
+// createContainerTestEnvironment returns the environment of an integration being deployed, configured with the given traits
+func createContainerTestEnvironment(t *testing.T, traitCatalog *Catalog, traits map[string]v1.TraitSpec) *Environment {
+	catalog, err := camel.DefaultCatalog()
+	assert.Nil(t, err)
+
+	environment := &Environment{
+		CamelCatalog: catalog,
+		Catalog:      traitCatalog,
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ServiceTestName,
+				Namespace: "ns",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+			Spec: v1.IntegrationSpec{
+				Profile: v1.TraitProfileKubernetes,
+				Traits:  traits,
+			},
+		},
+		IntegrationKit: &v1.IntegrationKit{
+			Status: v1.IntegrationKitStatus{
+				Phase: v1.IntegrationKitPhaseReady,
+			},
+		},
+		Platform: &v1.IntegrationPlatform{
+			Spec: v1.IntegrationPlatformSpec{
+				Cluster: v1.IntegrationPlatformClusterOpenShift,
+				Build: v1.IntegrationPlatformBuildSpec{
+					PublishStrategy: v1.IntegrationPlatformBuildPublishStrategyS2I,
+					Registry:        v1.IntegrationPlatformRegistrySpec{Address: "registry"},
+				},
+			},
+		},
+		EnvVars:        make([]corev1.EnvVar, 0),
+		ExecutedTraits: make([]Trait, 0),
+		Resources:      kubernetes.NewCollection(),
+	}
+	environment.Platform.ResyncStatusFullConfig()
+
+	return environment
+}
@@ -0,0 +1,184 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util"
+)
+
+// The CORS trait configures the Cross-Origin Resource Sharing (CORS) of the HTTP endpoints exposed by the integration,
+// so that they can be called from browser clients served from other origins, including preflight requests.
+//
+// It only applies to integrations exposing HTTP endpoints, with the REST DSL or the `platform-http` component.
+// With the main runtime, only the REST DSL endpoints get the CORS headers, and the trait is rejected for integrations
+// that do not use the REST DSL. With the Quarkus runtime, the CORS filter applies to all the HTTP endpoints, and is
+// installed when the integration kit is built, so that enabling the trait triggers a dedicated build.
+//
+// It's disabled by default.
+//
+// +camel-k:trait=cors
+type corsTrait struct {
+	BaseTrait `property:",squash"`
+	// The list of origins that are allowed to access the endpoints, e.g. `https://example.com`, or `*` to allow
+	// any origin (default `*`). The main runtime only supports a single origin.
+	AllowedOrigins []string `property:"allowed-origins" json:"allowedOrigins,omitempty"`
+	// The list of HTTP methods that are allowed, e.g. `GET` or `POST` (default to all methods).
+	AllowedMethods []string `property:"allowed-methods" json:"allowedMethods,omitempty"`
+	// The list of HTTP headers that are allowed in requests, e.g. `Content-Type` or `Authorization`.
+	AllowedHeaders []string `property:"allowed-headers" json:"allowedHeaders,omitempty"`
+	// How long, in seconds, the results of a preflight request can be cached by clients.
+	MaxAge *int `property:"max-age" json:"maxAge,omitempty"`
+}
+
+const corsTraitID = "cors"
+
+var corsMethods = []string{"GET", "HEAD", "POST", "PUT", "DELETE", "PATCH", "OPTIONS", "TRACE", "CONNECT"}
+
+func newCorsTrait() Trait {
+	return &corsTrait{
+		BaseTrait: NewBaseTrait(corsTraitID, 1595),
+	}
+}
+
+func (t *corsTrait) Configure(e *Environment) (bool, error) {
+	if !t.isEnabled() {
+		return false, nil
+	}
+
+	for _, origin := range t.AllowedOrigins {
+		if err := validateCorsOrigin(origin); err != nil {
+			return false, err
+		}
+	}
+	for _, method := range t.AllowedMethods {
+		if !util.StringSliceExists(corsMethods, method) {
+			return false, fmt.Errorf("unknown CORS method: %s. One of [%s] is expected", method, strings.Join(corsMethods, ", "))
+		}
+	}
+	for _, header := range t.AllowedHeaders {
+		if header == "" || strings.ContainsAny(header, " ,:") {
+			return false, fmt.Errorf("invalid CORS header: %s", header)
+		}
+	}
+	if t.MaxAge != nil && *t.MaxAge < 0 {
+		return false, fmt.Errorf("invalid CORS max-age: %d, must not be negative", *t.MaxAge)
+	}
+
+	if !e.IntegrationInPhase(v1.IntegrationPhaseDeploying, v1.IntegrationPhaseRunning) {
+		return false, nil
+	}
+
+	// The capabilities are computed when the integration is initialized
+	if !util.StringSliceExists(e.Integration.Status.Capabilities, v1.CapabilityRest) &&
+		!util.StringSliceExists(e.Integration.Status.Capabilities, v1.CapabilityPlatformHTTP) {
+		return false, nil
+	}
+
+	if e.CamelCatalog.Runtime.Provider == v1.RuntimeProviderMain {
+		// The CORS headers are set by the REST DSL, while the platform-http endpoints are served without them
+		if !util.StringSliceExists(e.Integration.Status.Capabilities, v1.CapabilityRest) {
+			return false, fmt.Errorf("the %s runtime only supports CORS for the REST DSL endpoints", e.CamelCatalog.Runtime.Provider)
+		}
+		if len(t.AllowedOrigins) > 1 {
+			return false, fmt.Errorf("the %s runtime only supports a single CORS allowed origin", e.CamelCatalog.Runtime.Provider)
+		}
+	}
+
+	return true, nil
+}
+
+func (t *corsTrait) Apply(e *Environment) error {
+	if e.ApplicationProperties == nil {
+		e.ApplicationProperties = make(map[string]string)
+	}
+
+	origins := t.AllowedOrigins
+	if len(origins) == 0 {
+		origins = []string{"*"}
+	}
+
+	// The application properties must be set before the container trait computes them
+	switch e.CamelCatalog.Runtime.Provider {
+	case v1.RuntimeProviderMain:
+		prefix := "camel.context.rest-configuration."
+		e.ApplicationProperties[prefix+"enable-cors"] = True
+		e.ApplicationProperties[prefix+"cors-headers[Access-Control-Allow-Origin]"] = origins[0]
+		if len(t.AllowedMethods) > 0 {
+			e.ApplicationProperties[prefix+"cors-headers[Access-Control-Allow-Methods]"] = strings.Join(t.AllowedMethods, ", ")
+		}
+		if len(t.AllowedHeaders) > 0 {
+			e.ApplicationProperties[prefix+"cors-headers[Access-Control-Allow-Headers]"] = strings.Join(t.AllowedHeaders, ", ")
+		}
+		if t.MaxAge != nil {
+			e.ApplicationProperties[prefix+"cors-headers[Access-Control-Max-Age]"] = strconv.Itoa(*t.MaxAge)
+		}
+	case v1.RuntimeProviderQuarkus:
+		// The CORS filter itself is enabled when the kit is built, see addBuildProperties
+		e.ApplicationProperties["quarkus.http.cors.origins"] = strings.Join(origins, ",")
+		if len(t.AllowedMethods) > 0 {
+			e.ApplicationProperties["quarkus.http.cors.methods"] = strings.Join(t.AllowedMethods, ",")
+		}
+		if len(t.AllowedHeaders) > 0 {
+			e.ApplicationProperties["quarkus.http.cors.headers"] = strings.Join(t.AllowedHeaders, ",")
+		}
+		if t.MaxAge != nil {
+			e.ApplicationProperties["quarkus.http.cors.access-control-max-age"] = strconv.Itoa(*t.MaxAge)
+		}
+	default:
+		return fmt.Errorf("unsupported runtime: %s", e.CamelCatalog.Runtime.Provider)
+	}
+
+	return nil
+}
+
+// InfluencesKit overrides base class method
+func (t *corsTrait) InfluencesKit() bool {
+	return true
+}
+
+func (t *corsTrait) isEnabled() bool {
+	return t.Enabled != nil && *t.Enabled
+}
+
+// addBuildProperties enables the Quarkus CORS filter, that is only installed when configured at build time
+func (t *corsTrait) addBuildProperties(task *v1.BuilderTask) {
+	if task.MavenProperties == nil {
+		task.MavenProperties = make(map[string]string)
+	}
+	task.MavenProperties["quarkus.http.cors"] = True
+}
+
+func validateCorsOrigin(origin string) error {
+	if origin == "*" {
+		return nil
+	}
+	u, err := url.Parse(origin)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid CORS origin: %s, must be either * or an http or https URL", origin)
+	}
+	if (u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+		return fmt.Errorf("invalid CORS origin: %s, must only contain a scheme, a host and an optional port", origin)
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/camel"
+)
+
+func TestConfigureCorsTraitDoesSucceed(t *testing.T) {
+	corsTrait, environment := createNominalCorsTest(t, v1.RuntimeProviderQuarkus)
+	corsTrait.AllowedOrigins = []string{"https://example.com", "http://localhost:3000"}
+	corsTrait.AllowedMethods = []string{"GET", "POST"}
+	corsTrait.AllowedHeaders = []string{"Content-Type"}
+
+	configured, err := corsTrait.Configure(environment)
+
+	assert.True(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestConfigureCorsTraitWithoutHTTPDoesNotSucceed(t *testing.T) {
+	corsTrait, environment := createNominalCorsTest(t, v1.RuntimeProviderMain)
+	environment.Integration.Status.Capabilities = nil
+
+	configured, err := corsTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestConfigureCorsTraitWithInvalidOptionsFails(t *testing.T) {
+	for _, origin := range []string{"example.com", "ftp://example.com", "https://example.com/path", "https://"} {
+		corsTrait, environment := createNominalCorsTest(t, v1.RuntimeProviderMain)
+		corsTrait.AllowedOrigins = []string{origin}
+		_, err := corsTrait.Configure(environment)
+		assert.NotNil(t, err, origin)
+	}
+
+	corsTrait, environment := createNominalCorsTest(t, v1.RuntimeProviderMain)
+	corsTrait.AllowedMethods = []string{"get"}
+	_, err := corsTrait.Configure(environment)
+	assert.NotNil(t, err)
+
+	corsTrait, environment = createNominalCorsTest(t, v1.RuntimeProviderMain)
+	corsTrait.AllowedHeaders = []string{"Content-Type, Authorization"}
+	_, err = corsTrait.Configure(environment)
+	assert.NotNil(t, err)
+
+	corsTrait, environment = createNominalCorsTest(t, v1.RuntimeProviderMain)
+	maxAge := -1
+	corsTrait.MaxAge = &maxAge
+	_, err = corsTrait.Configure(environment)
+	assert.NotNil(t, err)
+
+	corsTrait, environment = createNominalCorsTest(t, v1.RuntimeProviderMain)
+	corsTrait.AllowedOrigins = []string{"https://example.com", "http://localhost:3000"}
+	_, err = corsTrait.Configure(environment)
+	assert.NotNil(t, err)
+}
+
+func TestConfigureCorsTraitOnMainWithoutRestFails(t *testing.T) {
+	corsTrait, environment := createNominalCorsTest(t, v1.RuntimeProviderMain)
+	environment.Integration.Status.Capabilities = []string{v1.CapabilityPlatformHTTP}
+
+	configured, err := corsTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+	assert.Equal(t, "the main runtime only supports CORS for the REST DSL endpoints", err.Error())
+
+	corsTrait, environment = createNominalCorsTest(t, v1.RuntimeProviderQuarkus)
+	environment.Integration.Status.Capabilities = []string{v1.CapabilityPlatformHTTP}
+
+	configured, err = corsTrait.Configure(environment)
+
+	assert.True(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestApplyCorsTraitOnMainDoesSucceed(t *testing.T) {
+	corsTrait, environment := createNominalCorsTest(t, v1.RuntimeProviderMain)
+	corsTrait.AllowedMethods = []string{"GET", "POST"}
+	corsTrait.AllowedHeaders = []string{"Content-Type", "Authorization"}
+	maxAge := 3600
+	corsTrait.MaxAge = &maxAge
+
+	err := corsTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "true", environment.ApplicationProperties["camel.context.rest-configuration.enable-cors"])
+	assert.Equal(t, "*", environment.ApplicationProperties["camel.context.rest-configuration.cors-headers[Access-Control-Allow-Origin]"])
+	assert.Equal(t, "GET, POST", environment.ApplicationProperties["camel.context.rest-configuration.cors-headers[Access-Control-Allow-Methods]"])
+	assert.Equal(t, "Content-Type, Authorization", environment.ApplicationProperties["camel.context.rest-configuration.cors-headers[Access-Control-Allow-Headers]"])
+	assert.Equal(t, "3600", environment.ApplicationProperties["camel.context.rest-configuration.cors-headers[Access-Control-Max-Age]"])
+}
+
+func TestApplyCorsTraitOnQuarkusDoesSucceed(t *testing.T) {
+	corsTrait, environment := createNominalCorsTest(t, v1.RuntimeProviderQuarkus)
+	corsTrait.AllowedOrigins = []string{"https://example.com", "http://localhost:3000"}
+	corsTrait.AllowedMethods = []string{"GET", "POST"}
+
+	err := corsTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	// The CORS filter is enabled at build time
+	assert.NotContains(t, environment.ApplicationProperties, "quarkus.http.cors")
+	assert.Equal(t, "https://example.com,http://localhost:3000", environment.ApplicationProperties["quarkus.http.cors.origins"])
+	assert.Equal(t, "GET,POST", environment.ApplicationProperties["quarkus.http.cors.methods"])
+	assert.NotContains(t, environment.ApplicationProperties, "quarkus.http.cors.headers")
+
+	task := &v1.BuilderTask{}
+	corsTrait.addBuildProperties(task)
+	assert.Equal(t, "true", task.MavenProperties["quarkus.http.cors"])
+	assert.True(t, corsTrait.InfluencesKit())
+}
+
+func createNominalCorsTest(t *testing.T, provider v1.RuntimeProvider) (*corsTrait, *Environment) {
+	trait := newCorsTrait().(*corsTrait)
+	enabled := true
+	trait.Enabled = &enabled
+
+	var catalog *camel.RuntimeCatalog
+	var err error
+	switch provider {
+	case v1.RuntimeProviderMain:
+		catalog, err = camel.DefaultCatalog()
+	case v1.RuntimeProviderQuarkus:
+		catalog, err = camel.QuarkusCatalog()
+	}
+	assert.Nil(t, err)
+
+	environment := &Environment{
+		Catalog:      NewCatalog(context.TODO(), nil),
+		CamelCatalog: catalog,
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "integration-name",
+				Namespace: "integration-namespace",
+			},
+			Status: v1.IntegrationStatus{
+				Phase:        v1.IntegrationPhaseDeploying,
+				Capabilities: []string{v1.CapabilityRest},
+			},
+		},
+	}
+
+	return trait, environment
+}
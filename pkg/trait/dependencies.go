@@ -19,6 +19,7 @@ package trait
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/apache/camel-k/pkg/metadata"
 
@@ -26,11 +27,18 @@ import (
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/util"
+	"github.com/apache/camel-k/pkg/util/camel"
+	"github.com/apache/camel-k/pkg/util/maven"
 )
 
 // The Dependencies trait is internally used to automatically add runtime dependencies based on the
 // integration that the user wants to run.
 //
+// Maven dependencies, i.e. `mvn:org.acme:acme-lib:1.0`, can use a Maven version range as version,
+// like `mvn:org.acme:acme-lib:[1.0,2.0)`, and can exclude some of their transitive dependencies by
+// appending a comma separated list of `groupId:artifactId` to the dependency, like
+// `mvn:org.acme:acme-lib:1.0?exclusions=org.foo:foo-lib,org.bar:*`.
+//
 // +camel-k:trait=dependencies
 type dependenciesTrait struct {
 	BaseTrait `property:",squash"`
@@ -58,40 +66,26 @@ func (t *dependenciesTrait) Apply(e *Environment) error {
 	dependencies := strset.New()
 
 	if e.Integration.Spec.Dependencies != nil {
+		for _, d := range e.Integration.Spec.Dependencies {
+			if err := validateDependency(d); err != nil {
+				return err
+			}
+		}
+
 		dependencies.Add(e.Integration.Spec.Dependencies...)
 	}
 
 	// add runtime specific dependencies
-	for _, d := range e.CamelCatalog.Runtime.Dependencies {
-		dependencies.Add(fmt.Sprintf("mvn:%s/%s", d.GroupID, d.ArtifactID))
-	}
+	camel.AddRuntimeDependencies(dependencies, e.CamelCatalog)
 
 	for _, s := range e.Integration.Sources() {
 		meta := metadata.Extract(e.CamelCatalog, s)
-		lang := s.InferLanguage()
 
 		// add auto-detected dependencies
 		dependencies.Merge(meta.Dependencies)
 
-		for loader, v := range e.CamelCatalog.Loaders {
-			// add loader specific dependencies
-			if s.Loader != "" && s.Loader == loader {
-				dependencies.Add(fmt.Sprintf("mvn:%s/%s", v.GroupID, v.ArtifactID))
-
-				for _, d := range v.Dependencies {
-					dependencies.Add(fmt.Sprintf("mvn:%s/%s", d.GroupID, d.ArtifactID))
-				}
-			} else if s.Loader == "" {
-				// add language specific dependencies
-				if util.StringSliceExists(v.Languages, string(lang)) {
-					dependencies.Add(fmt.Sprintf("mvn:%s/%s", v.GroupID, v.ArtifactID))
-
-					for _, d := range v.Dependencies {
-						dependencies.Add(fmt.Sprintf("mvn:%s/%s", d.GroupID, d.ArtifactID))
-					}
-				}
-			}
-		}
+		// add loader and language specific dependencies
+		camel.AddSourceDependencies(dependencies, e.CamelCatalog, s)
 
 		meta.RequiredCapabilities.Each(func(item string) bool {
 			util.StringSliceUniqueAdd(&e.Integration.Status.Capabilities, item)
@@ -108,6 +102,19 @@ func (t *dependenciesTrait) Apply(e *Environment) error {
 	return nil
 }
 
+func validateDependency(dependency string) error {
+	if !strings.HasPrefix(dependency, "mvn:") {
+		return nil
+	}
+
+	gav := strings.Replace(strings.TrimPrefix(dependency, "mvn:"), "/", ":", -1)
+	if _, err := maven.ParseDependency(gav); err != nil {
+		return fmt.Errorf("invalid dependency %s: %v", dependency, err)
+	}
+
+	return nil
+}
+
 // IsPlatformTrait overrides base class method
 func (t *dependenciesTrait) IsPlatformTrait() bool {
 	return true
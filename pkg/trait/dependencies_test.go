@@ -327,3 +327,48 @@ func TestRestDepsQuarkus(t *testing.T) {
 		},
 	)
 }
+
+func TestIntegrationMavenDepsValidation(t *testing.T) {
+	catalog, err := camel.DefaultCatalog()
+	assert.Nil(t, err)
+
+	e := &Environment{
+		Catalog:      NewEnvironmentTestCatalog(),
+		CamelCatalog: catalog,
+		Integration: &v1.Integration{
+			Spec: v1.IntegrationSpec{
+				Dependencies: []string{
+					"mvn:org.foo/bar/[1.0,2.0)?exclusions=org.baz:*",
+				},
+				Sources: []v1.SourceSpec{
+					{
+						DataSpec: v1.DataSpec{
+							Name:    "Request.java",
+							Content: `from("direct:foo").to("log:bar");`,
+						},
+						Language: v1.LanguageJavaSource,
+					},
+				},
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseInitialization,
+			},
+		},
+	}
+
+	trait := newDependenciesTrait()
+	enabled, err := trait.Configure(e)
+	assert.Nil(t, err)
+	assert.True(t, enabled)
+
+	err = trait.Apply(e)
+	assert.Nil(t, err)
+	assert.Contains(t, e.Integration.Status.Dependencies, "mvn:org.foo/bar/[1.0,2.0)?exclusions=org.baz:*")
+
+	e.Integration.Status.Dependencies = nil
+	e.Integration.Spec.Dependencies = []string{"mvn:org.foo/bar/[1.0,2.0"}
+
+	err = trait.Apply(e)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "invalid dependency mvn:org.foo/bar/[1.0,2.0")
+}
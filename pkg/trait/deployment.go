@@ -23,6 +23,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 )
@@ -33,6 +34,15 @@ import (
 // +camel-k:trait=deployment
 type deploymentTrait struct {
 	BaseTrait `property:",squash"`
+	// The deployment strategy used to replace the existing pods with new ones, either `RollingUpdate` or `Recreate`
+	// (default `RollingUpdate`).
+	Strategy string `property:"strategy" json:"strategy,omitempty"`
+	// The maximum number of pods that can be scheduled above the desired number of pods, as an absolute number
+	// or a percentage of the desired pods (e.g. `1` or `25%`). Only applies to the `RollingUpdate` strategy.
+	RollingUpdateMaxSurge string `property:"rolling-update-max-surge" json:"rollingUpdateMaxSurge,omitempty"`
+	// The maximum number of pods that can be unavailable during the update, as an absolute number
+	// or a percentage of the desired pods (e.g. `1` or `25%`). Only applies to the `RollingUpdate` strategy.
+	RollingUpdateMaxUnavailable string `property:"rolling-update-max-unavailable" json:"rollingUpdateMaxUnavailable,omitempty"`
 }
 
 var _ ControllerStrategySelector = &deploymentTrait{}
@@ -55,6 +65,10 @@ func (t *deploymentTrait) Configure(e *Environment) (bool, error) {
 		return false, nil
 	}
 
+	if err := t.validateStrategy(); err != nil {
+		return false, err
+	}
+
 	if e.IntegrationInPhase(v1.IntegrationPhaseRunning) {
 		condition := e.Integration.Status.GetCondition(v1.IntegrationConditionDeploymentAvailable)
 		return condition != nil && condition.Status == corev1.ConditionTrue, nil
@@ -132,6 +146,65 @@ func (t *deploymentTrait) Apply(e *Environment) error {
 	return nil
 }
 
+func (t *deploymentTrait) validateStrategy() error {
+	switch appsv1.DeploymentStrategyType(t.Strategy) {
+	case "", appsv1.RollingUpdateDeploymentStrategyType:
+		if err := validateIntOrPercent("rolling-update-max-surge", t.RollingUpdateMaxSurge); err != nil {
+			return err
+		}
+		if err := validateIntOrPercent("rolling-update-max-unavailable", t.RollingUpdateMaxUnavailable); err != nil {
+			return err
+		}
+	case appsv1.RecreateDeploymentStrategyType:
+		if t.RollingUpdateMaxSurge != "" || t.RollingUpdateMaxUnavailable != "" {
+			return fmt.Errorf("rolling update max surge and max unavailable can only be set with the %s strategy",
+				appsv1.RollingUpdateDeploymentStrategyType)
+		}
+	default:
+		return fmt.Errorf("unsupported deployment strategy: %s, must be one of %s or %s", t.Strategy,
+			appsv1.RollingUpdateDeploymentStrategyType, appsv1.RecreateDeploymentStrategyType)
+	}
+
+	return nil
+}
+
+func validateIntOrPercent(name string, value string) error {
+	if value == "" {
+		return nil
+	}
+	v := intstr.Parse(value)
+	if n, err := intstr.GetValueFromIntOrPercent(&v, 100, true); err != nil || n < 0 {
+		return fmt.Errorf("invalid %s value: %s, must be a positive number or percentage", name, value)
+	}
+	return nil
+}
+
+func (t *deploymentTrait) getDeploymentStrategy() appsv1.DeploymentStrategy {
+	strategy := appsv1.DeploymentStrategy{}
+
+	switch appsv1.DeploymentStrategyType(t.Strategy) {
+	case appsv1.RecreateDeploymentStrategyType:
+		strategy.Type = appsv1.RecreateDeploymentStrategyType
+	case appsv1.RollingUpdateDeploymentStrategyType:
+		strategy.Type = appsv1.RollingUpdateDeploymentStrategyType
+	}
+
+	if t.RollingUpdateMaxSurge != "" || t.RollingUpdateMaxUnavailable != "" {
+		strategy.Type = appsv1.RollingUpdateDeploymentStrategyType
+		strategy.RollingUpdate = &appsv1.RollingUpdateDeployment{}
+		if t.RollingUpdateMaxSurge != "" {
+			v := intstr.Parse(t.RollingUpdateMaxSurge)
+			strategy.RollingUpdate.MaxSurge = &v
+		}
+		if t.RollingUpdateMaxUnavailable != "" {
+			v := intstr.Parse(t.RollingUpdateMaxUnavailable)
+			strategy.RollingUpdate.MaxUnavailable = &v
+		}
+	}
+
+	return strategy
+}
+
 // IsPlatformTrait overrides base class method
 func (t *deploymentTrait) IsPlatformTrait() bool {
 	return true
@@ -161,6 +234,7 @@ func (t *deploymentTrait) getDeploymentFor(e *Environment) *appsv1.Deployment {
 		},
 		Spec: appsv1.DeploymentSpec{
 			Replicas: e.Integration.Spec.Replicas,
+			Strategy: t.getDeploymentStrategy(),
 			Selector: &metav1.LabelSelector{
 				MatchLabels: map[string]string{
 					v1.IntegrationLabel: e.Integration.Name,
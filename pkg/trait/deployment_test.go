@@ -30,6 +30,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func TestConfigureDisabledDeploymentTraitDoesNotSucceed(t *testing.T) {
@@ -210,3 +211,64 @@ func createNominalDeploymentTest() (*deploymentTrait, *Environment) {
 
 	return trait, environment
 }
+
+func TestConfigureDeploymentTraitWithInvalidStrategyDoesNotSucceed(t *testing.T) {
+	deploymentTrait, environment := createNominalDeploymentTest()
+	deploymentTrait.Strategy = "BlueGreen"
+
+	configured, err := deploymentTrait.Configure(environment)
+
+	assert.NotNil(t, err)
+	assert.False(t, configured)
+}
+
+func TestConfigureDeploymentTraitWithRollingUpdateOptionsOnRecreateDoesNotSucceed(t *testing.T) {
+	deploymentTrait, environment := createNominalDeploymentTest()
+	deploymentTrait.Strategy = string(appsv1.RecreateDeploymentStrategyType)
+	deploymentTrait.RollingUpdateMaxSurge = "1"
+
+	configured, err := deploymentTrait.Configure(environment)
+
+	assert.NotNil(t, err)
+	assert.False(t, configured)
+}
+
+func TestConfigureDeploymentTraitWithInvalidRollingUpdateOptionsDoesNotSucceed(t *testing.T) {
+	deploymentTrait, environment := createNominalDeploymentTest()
+	deploymentTrait.RollingUpdateMaxUnavailable = "-10%"
+
+	configured, err := deploymentTrait.Configure(environment)
+
+	assert.NotNil(t, err)
+	assert.False(t, configured)
+}
+
+func TestApplyDeploymentTraitWithRecreateStrategyDoesSucceed(t *testing.T) {
+	deploymentTrait, environment := createNominalDeploymentTest()
+	deploymentTrait.Strategy = string(appsv1.RecreateDeploymentStrategyType)
+
+	err := deploymentTrait.Apply(environment)
+
+	assert.Nil(t, err)
+
+	deployment := environment.Resources.GetDeployment(func(deployment *appsv1.Deployment) bool { return true })
+	assert.NotNil(t, deployment)
+	assert.Equal(t, appsv1.RecreateDeploymentStrategyType, deployment.Spec.Strategy.Type)
+	assert.Nil(t, deployment.Spec.Strategy.RollingUpdate)
+}
+
+func TestApplyDeploymentTraitWithRollingUpdateOptionsDoesSucceed(t *testing.T) {
+	deploymentTrait, environment := createNominalDeploymentTest()
+	deploymentTrait.RollingUpdateMaxSurge = "2"
+	deploymentTrait.RollingUpdateMaxUnavailable = "25%"
+
+	err := deploymentTrait.Apply(environment)
+
+	assert.Nil(t, err)
+
+	deployment := environment.Resources.GetDeployment(func(deployment *appsv1.Deployment) bool { return true })
+	assert.NotNil(t, deployment)
+	assert.Equal(t, appsv1.RollingUpdateDeploymentStrategyType, deployment.Spec.Strategy.Type)
+	assert.Equal(t, intstr.FromInt(2), *deployment.Spec.Strategy.RollingUpdate.MaxSurge)
+	assert.Equal(t, intstr.FromString("25%"), *deployment.Spec.Strategy.RollingUpdate.MaxUnavailable)
+}
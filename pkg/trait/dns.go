@@ -0,0 +1,176 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/api/batch/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	serving "knative.dev/serving/pkg/apis/serving/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+// The DNS trait configures the DNS resolution of the integration pod(s), e.g. to resolve
+// private zones that the cluster DNS is not able to resolve.
+//
+// The DNS configuration is not supported by Knative services, and is skipped for them.
+//
+// It's disabled by default.
+//
+// +camel-k:trait=dns
+type dnsTrait struct {
+	BaseTrait `property:",squash"`
+	// The DNS policy of the integration pod(s), one of `ClusterFirst`, `ClusterFirstWithHostNet`, `Default` or `None`.
+	// At least one nameserver must be set when the policy is `None`.
+	Policy string `property:"policy" json:"policy,omitempty"`
+	// A list of IP addresses of DNS nameservers, added to the ones generated from the DNS policy (3 at most).
+	Nameservers []string `property:"nameservers" json:"nameservers,omitempty"`
+	// A list of DNS search domains for host-name lookup, added to the ones generated from the DNS policy
+	// (6 at most, and 256 characters in total).
+	Searches []string `property:"searches" json:"searches,omitempty"`
+	// A list of DNS resolver options, with the form `name[=value]`, e.g. `ndots=2`, merged with the ones
+	// generated from the DNS policy.
+	Options []string `property:"options" json:"options,omitempty"`
+}
+
+const (
+	maxDNSNameservers = 3
+	maxDNSSearches    = 6
+	// The maximum length of the search list, once the domains are joined with spaces
+	maxDNSSearchesLength = 256
+)
+
+var dnsPolicies = []corev1.DNSPolicy{
+	corev1.DNSClusterFirst,
+	corev1.DNSClusterFirstWithHostNet,
+	corev1.DNSDefault,
+	corev1.DNSNone,
+}
+
+func newDNSTrait() Trait {
+	return &dnsTrait{
+		BaseTrait: NewBaseTrait("dns", 1630),
+	}
+}
+
+func (t *dnsTrait) Configure(e *Environment) (bool, error) {
+	if t.Enabled == nil || !*t.Enabled {
+		return false, nil
+	}
+
+	if t.Policy != "" && !isValidDNSPolicy(corev1.DNSPolicy(t.Policy)) {
+		names := make([]string, 0, len(dnsPolicies))
+		for _, p := range dnsPolicies {
+			names = append(names, string(p))
+		}
+		return false, fmt.Errorf("unknown DNS policy: %s. One of [%s] is expected", t.Policy, strings.Join(names, ", "))
+	}
+	if corev1.DNSPolicy(t.Policy) == corev1.DNSNone && len(t.Nameservers) == 0 {
+		return false, fmt.Errorf("at least one nameserver must be set with the %s DNS policy", corev1.DNSNone)
+	}
+	if len(t.Nameservers) > maxDNSNameservers {
+		return false, fmt.Errorf("too many nameservers: %d, at most %d are allowed", len(t.Nameservers), maxDNSNameservers)
+	}
+	for _, ns := range t.Nameservers {
+		if net.ParseIP(ns) == nil {
+			return false, fmt.Errorf("invalid nameserver: %s is not a valid IP address", ns)
+		}
+	}
+	if len(t.Searches) > maxDNSSearches {
+		return false, fmt.Errorf("too many search domains: %d, at most %d are allowed", len(t.Searches), maxDNSSearches)
+	}
+	if l := len(strings.Join(t.Searches, " ")); l > maxDNSSearchesLength {
+		return false, fmt.Errorf("search domains too long: %d characters, at most %d are allowed", l, maxDNSSearchesLength)
+	}
+	for _, s := range t.Searches {
+		if errs := validation.IsDNS1123Subdomain(strings.TrimSuffix(s, ".")); len(errs) > 0 {
+			return false, fmt.Errorf("invalid search domain %s: %s", s, strings.Join(errs, ", "))
+		}
+	}
+	for _, o := range t.Options {
+		if strings.HasPrefix(o, "=") || o == "" {
+			return false, fmt.Errorf("invalid DNS option: %q, expected name[=value]", o)
+		}
+	}
+
+	return e.IntegrationInPhase(v1.IntegrationPhaseDeploying, v1.IntegrationPhaseRunning), nil
+}
+
+func (t *dnsTrait) Apply(e *Environment) error {
+	config := t.dnsConfig()
+	configure := func(p *corev1.PodSpec) {
+		if t.Policy != "" {
+			p.DNSPolicy = corev1.DNSPolicy(t.Policy)
+		}
+		if config != nil {
+			p.DNSConfig = config
+		}
+	}
+
+	e.Resources.VisitDeployment(func(d *appsv1.Deployment) {
+		configure(&d.Spec.Template.Spec)
+	})
+	e.Resources.VisitCronJob(func(c *v1beta1.CronJob) {
+		configure(&c.Spec.JobTemplate.Spec.Template.Spec)
+	})
+	e.Resources.VisitKnativeService(func(*serving.Service) {
+		t.L.ForIntegration(e.Integration).Infof("Skipping DNS configuration, not supported by Knative services")
+	})
+
+	return nil
+}
+
+func (t *dnsTrait) dnsConfig() *corev1.PodDNSConfig {
+	if len(t.Nameservers) == 0 && len(t.Searches) == 0 && len(t.Options) == 0 {
+		return nil
+	}
+
+	config := corev1.PodDNSConfig{
+		Nameservers: t.Nameservers,
+		Searches:    t.Searches,
+	}
+	for _, o := range t.Options {
+		option := corev1.PodDNSConfigOption{}
+		if i := strings.Index(o, "="); i >= 0 {
+			value := o[i+1:]
+			option.Name = o[:i]
+			option.Value = &value
+		} else {
+			option.Name = o
+		}
+		config.Options = append(config.Options, option)
+	}
+
+	return &config
+}
+
+func isValidDNSPolicy(policy corev1.DNSPolicy) bool {
+	for _, p := range dnsPolicies {
+		if p == policy {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,194 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	serving "knative.dev/serving/pkg/apis/serving/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+)
+
+func TestConfigureDNSTraitDoesSucceed(t *testing.T) {
+	dnsTrait, environment := createNominalDNSTest()
+	configured, err := dnsTrait.Configure(environment)
+
+	assert.True(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestConfigureDisabledDNSTraitDoesNotSucceed(t *testing.T) {
+	dnsTrait, environment := createNominalDNSTest()
+	dnsTrait.Enabled = nil
+	configured, err := dnsTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestConfigureDNSTraitWithInvalidPolicyFails(t *testing.T) {
+	dnsTrait, environment := createNominalDNSTest()
+	dnsTrait.Policy = "Custom"
+	configured, err := dnsTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unknown DNS policy: Custom. One of [ClusterFirst, ClusterFirstWithHostNet, Default, None] is expected", err.Error())
+}
+
+func TestConfigureDNSTraitWithInvalidNameserverFails(t *testing.T) {
+	dnsTrait, environment := createNominalDNSTest()
+	dnsTrait.Nameservers = []string{"dns.example.com"}
+	configured, err := dnsTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestConfigureDNSTraitWithNonePolicyRequiresNameservers(t *testing.T) {
+	dnsTrait, environment := createNominalDNSTest()
+	dnsTrait.Nameservers = nil
+	configured, err := dnsTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestConfigureDNSTraitWithTooManySearchesFails(t *testing.T) {
+	dnsTrait, environment := createNominalDNSTest()
+	dnsTrait.Searches = []string{"a.example.com", "b.example.com", "c.example.com", "d.example.com", "e.example.com", "f.example.com", "g.example.com"}
+	configured, err := dnsTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestConfigureDNSTraitWithTooLongSearchesFails(t *testing.T) {
+	dnsTrait, environment := createNominalDNSTest()
+	label := strings.Repeat("a", 60)
+	dnsTrait.Searches = []string{
+		label + ".example.com",
+		label + ".example.org",
+		label + ".example.net",
+		label + ".example.io",
+	}
+	configured, err := dnsTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestConfigureDNSTraitWithInvalidSearchFails(t *testing.T) {
+	dnsTrait, environment := createNominalDNSTest()
+	dnsTrait.Searches = []string{"corp_example.com"}
+	configured, err := dnsTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestApplyDNSTraitDoesSucceed(t *testing.T) {
+	dnsTrait, environment := createNominalDNSTest()
+
+	err := dnsTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	d := environment.Resources.GetDeployment(func(deployment *appsv1.Deployment) bool { return true })
+	assert.NotNil(t, d)
+
+	spec := d.Spec.Template.Spec
+	assert.Equal(t, corev1.DNSNone, spec.DNSPolicy)
+	assert.NotNil(t, spec.DNSConfig)
+	assert.Equal(t, []string{"10.0.0.10", "fd00::10"}, spec.DNSConfig.Nameservers)
+	assert.Equal(t, []string{"corp.example.com"}, spec.DNSConfig.Searches)
+	assert.Len(t, spec.DNSConfig.Options, 2)
+	assert.Equal(t, "ndots", spec.DNSConfig.Options[0].Name)
+	assert.Equal(t, "2", *spec.DNSConfig.Options[0].Value)
+	assert.Equal(t, "edns0", spec.DNSConfig.Options[1].Name)
+	assert.Nil(t, spec.DNSConfig.Options[1].Value)
+}
+
+func TestApplyDNSTraitSkipsKnativeService(t *testing.T) {
+	dnsTrait, environment := createNominalDNSTest()
+	environment.Resources = kubernetes.NewCollection(&serving.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "integration-name",
+			Namespace: "namespace",
+		},
+	})
+
+	err := dnsTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	s := environment.Resources.GetKnativeService(func(*serving.Service) bool { return true })
+	assert.NotNil(t, s)
+	assert.Equal(t, corev1.DNSPolicy(""), s.Spec.Template.Spec.DNSPolicy)
+	assert.Nil(t, s.Spec.Template.Spec.DNSConfig)
+}
+
+func createNominalDNSTest() (*dnsTrait, *Environment) {
+	trait := newDNSTrait().(*dnsTrait)
+	enabled := true
+	trait.Enabled = &enabled
+	trait.Policy = "None"
+	trait.Nameservers = []string{"10.0.0.10", "fd00::10"}
+	trait.Searches = []string{"corp.example.com"}
+	trait.Options = []string{"ndots=2", "edns0"}
+
+	environment := &Environment{
+		Catalog: NewCatalog(context.TODO(), nil),
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "integration-name",
+				Namespace: "namespace",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+		},
+		Resources: kubernetes.NewCollection(&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "integration-name",
+				Namespace: "namespace",
+			},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name: defaultContainerName,
+							},
+						},
+					},
+				},
+			},
+		}),
+	}
+
+	return trait, environment
+}
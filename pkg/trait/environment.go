@@ -18,6 +18,12 @@ limitations under the License.
 package trait
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/util/defaults"
 	"github.com/apache/camel-k/pkg/util/envvar"
@@ -26,10 +32,22 @@ import (
 // The environment trait is used internally to inject standard environment variables in the integration container,
 // such as `NAMESPACE`, `POD_NAME` and others.
 //
+// It can also be used to load all the entries of ConfigMaps and Secrets as environment variables.
+//
 // +camel-k:trait=environment
 type environmentTrait struct {
 	BaseTrait     `property:",squash"`
 	ContainerMeta bool `property:"container-meta" json:"containerMeta,omitempty"`
+	// A list of ConfigMaps whose entries are loaded as environment variables into the integration container.
+	ConfigMaps []string `property:"configmaps" json:"configmaps,omitempty"`
+	// A list of Secrets whose entries are loaded as environment variables into the integration container.
+	Secrets []string `property:"secrets" json:"secrets,omitempty"`
+	// Tolerate missing ConfigMaps and Secrets (default `false`).
+	Optional *bool `property:"optional" json:"optional,omitempty"`
+	// A list of environment variables exposing the pod metadata to the integration container, e.g. to be used
+	// as correlation IDs. Supported variables are `POD_NAME`, `NAMESPACE`, `NODE_NAME`, `POD_IP`, `HOST_IP`
+	// and `SERVICE_ACCOUNT`.
+	ContainerEnvVars []string `property:"container-env-vars" json:"containerEnvVars,omitempty"`
 }
 
 const (
@@ -49,6 +67,16 @@ const (
 	envVarMountPathSecrets = "CAMEL_K_MOUNT_PATH_SECRETS"
 )
 
+// The pod fields exposed with the downward API, by environment variable name
+var containerEnvVarFieldPaths = map[string]string{
+	envVarPodName:     "metadata.name",
+	envVarNamespace:   "metadata.namespace",
+	"NODE_NAME":       "spec.nodeName",
+	"POD_IP":          "status.podIP",
+	"HOST_IP":         "status.hostIP",
+	"SERVICE_ACCOUNT": "spec.serviceAccountName",
+}
+
 func newEnvironmentTrait() Trait {
 	return &environmentTrait{
 		BaseTrait: NewBaseTrait("environment", 800),
@@ -59,6 +87,17 @@ func newEnvironmentTrait() Trait {
 
 func (t *environmentTrait) Configure(e *Environment) (bool, error) {
 	if t.Enabled == nil || *t.Enabled {
+		for _, name := range t.ContainerEnvVars {
+			if _, ok := containerEnvVarFieldPaths[name]; !ok {
+				names := make([]string, 0, len(containerEnvVarFieldPaths))
+				for n := range containerEnvVarFieldPaths {
+					names = append(names, n)
+				}
+				sort.Strings(names)
+				return false, fmt.Errorf("unknown container environment variable: %s. One of [%s] is expected", name, strings.Join(names, ", "))
+			}
+		}
+
 		return e.IntegrationInPhase(v1.IntegrationPhaseDeploying, v1.IntegrationPhaseRunning), nil
 	}
 
@@ -79,6 +118,27 @@ func (t *environmentTrait) Apply(e *Environment) error {
 		envvar.SetValFrom(&e.EnvVars, envVarPodName, "metadata.name")
 	}
 
+	for _, name := range t.ContainerEnvVars {
+		envvar.SetValFrom(&e.EnvVars, name, containerEnvVarFieldPaths[name])
+	}
+
+	for _, name := range t.ConfigMaps {
+		e.EnvFrom = append(e.EnvFrom, corev1.EnvFromSource{
+			ConfigMapRef: &corev1.ConfigMapEnvSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: name},
+				Optional:             t.Optional,
+			},
+		})
+	}
+	for _, name := range t.Secrets {
+		e.EnvFrom = append(e.EnvFrom, corev1.EnvFromSource{
+			SecretRef: &corev1.SecretEnvSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: name},
+				Optional:             t.Optional,
+			},
+		})
+	}
+
 	return nil
 }
 
@@ -29,6 +29,7 @@ import (
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/util/camel"
+	"github.com/apache/camel-k/pkg/util/envvar"
 	"github.com/apache/camel-k/pkg/util/kubernetes"
 	"github.com/apache/camel-k/pkg/util/test"
 )
@@ -236,6 +237,101 @@ func TestDisabledContainerMetaDataEnvVars(t *testing.T) {
 	assert.True(t, ck)
 }
 
+func TestEnvFromConfigMapsAndSecrets(t *testing.T) {
+	c, err := camel.DefaultCatalog()
+	assert.Nil(t, err)
+
+	env := Environment{
+		CamelCatalog: c,
+		Catalog:      NewCatalog(context.TODO(), nil),
+		Integration: &v1.Integration{
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+			Spec: v1.IntegrationSpec{
+				Profile: v1.TraitProfileOpenShift,
+				Traits: map[string]v1.TraitSpec{
+					"environment": test.TraitSpecFromMap(t, map[string]interface{}{
+						"configmaps": []string{"app-config"},
+						"secrets":    []string{"app-credentials"},
+						"optional":   true,
+					}),
+				},
+			},
+		},
+		IntegrationKit: &v1.IntegrationKit{
+			Status: v1.IntegrationKitStatus{
+				Phase: v1.IntegrationKitPhaseReady,
+			},
+		},
+		Platform: &v1.IntegrationPlatform{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+			},
+			Spec: v1.IntegrationPlatformSpec{
+				Cluster: v1.IntegrationPlatformClusterOpenShift,
+			},
+		},
+		EnvVars:        make([]corev1.EnvVar, 0),
+		ExecutedTraits: make([]Trait, 0),
+		Resources:      kubernetes.NewCollection(),
+	}
+	env.Platform.ResyncStatusFullConfig()
+
+	err = NewEnvironmentTestCatalog().apply(&env)
+
+	assert.Nil(t, err)
+
+	deployment := env.Resources.GetDeployment(func(deployment *appsv1.Deployment) bool { return true })
+	assert.NotNil(t, deployment)
+
+	envFrom := deployment.Spec.Template.Spec.Containers[0].EnvFrom
+	assert.Len(t, envFrom, 2)
+	assert.Equal(t, "app-config", envFrom[0].ConfigMapRef.Name)
+	assert.True(t, *envFrom[0].ConfigMapRef.Optional)
+	assert.Equal(t, "app-credentials", envFrom[1].SecretRef.Name)
+	assert.True(t, *envFrom[1].SecretRef.Optional)
+}
+
+func TestContainerEnvVars(t *testing.T) {
+	env := createEnvironmentTraitTestEnv()
+
+	trait := newEnvironmentTrait().(*environmentTrait)
+	trait.ContainerEnvVars = []string{"NODE_NAME", "POD_IP"}
+
+	enabled, err := trait.Configure(env)
+	assert.Nil(t, err)
+	assert.True(t, enabled)
+
+	err = trait.Apply(env)
+	assert.Nil(t, err)
+
+	nodeName := envvar.Get(env.EnvVars, "NODE_NAME")
+	assert.NotNil(t, nodeName)
+	assert.Equal(t, "spec.nodeName", nodeName.ValueFrom.FieldRef.FieldPath)
+	podIP := envvar.Get(env.EnvVars, "POD_IP")
+	assert.NotNil(t, podIP)
+	assert.Equal(t, "status.podIP", podIP.ValueFrom.FieldRef.FieldPath)
+
+	trait.ContainerEnvVars = []string{"POD_UID"}
+
+	enabled, err = trait.Configure(env)
+	assert.NotNil(t, err)
+	assert.False(t, enabled)
+	assert.Equal(t, "unknown container environment variable: POD_UID. One of [HOST_IP, NAMESPACE, NODE_NAME, POD_IP, POD_NAME, SERVICE_ACCOUNT] is expected", err.Error())
+}
+
+func createEnvironmentTraitTestEnv() *Environment {
+	return &Environment{
+		Integration: &v1.Integration{
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+		},
+		EnvVars: make([]corev1.EnvVar, 0),
+	}
+}
+
 func NewEnvironmentTestCatalog() *Catalog {
 	return NewCatalog(context.TODO(), nil)
 }
@@ -19,26 +19,40 @@ package trait
 
 import (
 	"context"
+	"fmt"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/multierr"
+
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/discovery/cached/disk"
 	"k8s.io/client-go/discovery/cached/memory"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/flowcontrol"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	camelclient "github.com/apache/camel-k/pkg/client"
+	"github.com/apache/camel-k/pkg/event"
 	util "github.com/apache/camel-k/pkg/util/controller"
 )
 
@@ -47,14 +61,69 @@ var (
 	diskCachedDiscoveryClient   discovery.CachedDiscoveryInterface
 	memoryCachedDiscoveryClient discovery.CachedDiscoveryInterface
 	discoveryClientLock         sync.Mutex
+	deletableTypesCache         = map[string]deletableTypesCacheEntry{}
+	deletableTypesCacheLock     sync.Mutex
+	gcEventRecorder             record.EventRecorder
+	gcEventRecorderLock         sync.Mutex
+	deleteRateLimiters          = map[deleteRateLimiterKey]flowcontrol.RateLimiter{}
+	deleteRateLimitersLock      sync.Mutex
+	// The clock used to expire the deletable types cache, so that it can be advanced in tests
+	deletableTypesCacheClock = time.Now
 )
 
+var (
+	gcResourcesDeleted = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "camel_k_gc_resources_deleted_total",
+			Help: "Number of resources deleted by the gc trait",
+		},
+		[]string{"namespace", "name"},
+	)
+	gcDeleteErrors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "camel_k_gc_delete_errors_total",
+			Help: "Number of errors while deleting resources by the gc trait",
+		},
+		[]string{"namespace", "name"},
+	)
+	gcDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "camel_k_gc_duration_seconds",
+			Help:    "Duration of the garbage collection performed by the gc trait",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"namespace", "name"},
+	)
+)
+
+func init() {
+	// The metrics are registered once, as the garbage collection is performed by a new trait instance on each reconcile
+	metrics.Registry.MustRegister(gcResourcesDeleted, gcDeleteErrors, gcDuration)
+}
+
+// deletableTypesCacheEntry holds the deletable types resolved from the discovery API of a given API server
+type deletableTypesCacheEntry struct {
+	types      map[schema.GroupVersionKind]struct{}
+	expiration time.Time
+}
+
+// deleteRateLimiterKey identifies the token bucket shared by the garbage collections of the integrations
+// of a namespace, that are configured with the same rate limiting
+type deleteRateLimiterKey struct {
+	namespace string
+	qps       int
+	burst     int
+}
+
 type discoveryCacheType string
 
 const (
 	disabledDiscoveryCache discoveryCacheType = "disabled"
 	diskDiscoveryCache     discoveryCacheType = "disk"
 	memoryDiscoveryCache   discoveryCacheType = "memory"
+
+	defaultDiscoveryCacheTTL = 600
+	defaultDeleteQPS         = 20
 )
 
 // The GC Trait garbage-collects all resources that are no longer necessary upon integration updates.
@@ -64,6 +133,37 @@ type garbageCollectorTrait struct {
 	BaseTrait `property:",squash"`
 	// Discovery client cache to be used, either `disabled`, `disk` or `memory` (default `memory`)
 	DiscoveryCache *discoveryCacheType `property:"discovery-cache" json:"discoveryCache,omitempty"`
+	// The time, in seconds, the resource types resolved from the discovery API are reused across reconciles
+	// and integrations, before being looked up again, when the discovery cache is enabled (default `600`)
+	DiscoveryCacheTTL *int `property:"discovery-cache-ttl" json:"discoveryCacheTTL,omitempty"`
+	// When enabled, the resources that would be garbage-collected are only logged and reported in the
+	// `GarbageCollectionDryRun` integration condition, and are not actually deleted (default `false`)
+	DryRun *bool `property:"dry-run" json:"dryRun,omitempty"`
+	// A list of resource kinds (e.g. `PersistentVolumeClaim`, `Secret`) that must never be garbage-collected,
+	// regardless of their labels. Resources of these kinds are not even queried.
+	ExcludedKinds []string `property:"excluded-kinds" json:"excludedKinds,omitempty"`
+	// A list of resource kinds, e.g. `Deployment.apps`, `Service` or `Route.v1.route.openshift.io`, to be garbage-collected.
+	// When set, the discovery API is not used, and only the listed kinds are queried. The version is resolved using the
+	// operator scheme when it's not specified.
+	ResourceKinds []string `property:"resource-kinds" json:"resourceKinds,omitempty"`
+	// The propagation policy used to delete the resources, either `Background`, `Foreground` or `Orphan` (default `Background`)
+	DeletionPolicy string `property:"deletion-policy" json:"deletionPolicy,omitempty"`
+	// When enabled, the garbage collection is performed synchronously during the reconciliation, and any error
+	// fails the reconciliation, so that it's retried. This is useful to get a deterministic clean-up, e.g. in tests
+	// (default `false`)
+	Synchronous *bool `property:"synchronous" json:"synchronous,omitempty"`
+	// Additional namespaces where the resources of the integration are garbage-collected, e.g. when created by a
+	// global operator. The operator must be granted the permissions to list and delete resources in these namespaces.
+	Namespaces []string `property:"namespaces" json:"namespaces,omitempty"`
+	// The maximum number of deletions per second performed by the garbage collection, so that large clean-ups
+	// do not overload the API server. The rate limit is shared by the integrations of the namespace, across
+	// reconciles. The rate limiting is disabled when set to `0` (default `20`)
+	DeleteQPS *int `property:"delete-qps" json:"deleteQPS,omitempty"`
+	// The maximum number of deletions performed at once, before the rate limiting applies (default to the delete QPS)
+	DeleteBurst *int `property:"delete-burst" json:"deleteBurst,omitempty"`
+
+	// The recorder used to report the garbage collection events, defaulting to the one shared by the trait instances
+	recorder record.EventRecorder
 }
 
 func newGarbageCollectorTrait() Trait {
@@ -82,6 +182,35 @@ func (t *garbageCollectorTrait) Configure(e *Environment) (bool, error) {
 		t.DiscoveryCache = &s
 	}
 
+	if t.DiscoveryCacheTTL == nil {
+		ttl := defaultDiscoveryCacheTTL
+		t.DiscoveryCacheTTL = &ttl
+	}
+
+	if t.DeleteQPS == nil {
+		qps := defaultDeleteQPS
+		t.DeleteQPS = &qps
+	}
+	if *t.DeleteQPS < 0 {
+		return false, fmt.Errorf("invalid delete QPS: %d, must not be negative", *t.DeleteQPS)
+	}
+	if t.DeleteBurst == nil {
+		burst := *t.DeleteQPS
+		t.DeleteBurst = &burst
+	}
+	if *t.DeleteQPS > 0 && *t.DeleteBurst < 1 {
+		return false, fmt.Errorf("invalid delete burst: %d, must be positive", *t.DeleteBurst)
+	}
+
+	switch metav1.DeletionPropagation(t.DeletionPolicy) {
+	case "":
+		t.DeletionPolicy = string(metav1.DeletePropagationBackground)
+	case metav1.DeletePropagationBackground, metav1.DeletePropagationForeground, metav1.DeletePropagationOrphan:
+	default:
+		return false, fmt.Errorf("unsupported deletion policy: %s, must be one of %s, %s or %s", t.DeletionPolicy,
+			metav1.DeletePropagationBackground, metav1.DeletePropagationForeground, metav1.DeletePropagationOrphan)
+	}
+
 	return e.IntegrationInPhase(
 			v1.IntegrationPhaseInitialization,
 			v1.IntegrationPhaseDeploying,
@@ -98,9 +227,25 @@ func (t *garbageCollectorTrait) Apply(e *Environment) error {
 		// TODO: this should be refined so that it's run when all the replicas for the newer generation
 		// are ready. This is to be added when the integration scale status is refined with ready replicas
 		e.PostActions = append(e.PostActions, func(env *Environment) error {
+			if (t.Synchronous != nil && *t.Synchronous) || t.isDryRun() {
+				// Nothing gets deleted in dry-run mode, so the collection is performed synchronously
+				// for the candidate resources to be reported on the integration status
+				err := t.garbageCollectResources(env)
+				setGarbageCollectionCondition(&env.Integration.Status, err)
+				if t.Synchronous != nil && *t.Synchronous {
+					// Fail the reconciliation, so that it's retried until the resources are collected
+					return err
+				}
+				return nil
+			}
 			// The collection and deletion are performed asynchronously to avoid blocking
 			// the reconcile loop.
-			go t.garbageCollectResources(env)
+			go func() {
+				err := t.garbageCollectResources(env)
+				// The integration status is updated concurrently with the reconcile loop,
+				// so the result is reported by patching it directly
+				t.updateGarbageCollectionCondition(env, err)
+			}()
 			return nil
 		})
 		fallthrough
@@ -124,12 +269,15 @@ func (t *garbageCollectorTrait) Apply(e *Environment) error {
 	return nil
 }
 
-func (t *garbageCollectorTrait) garbageCollectResources(e *Environment) {
+func (t *garbageCollectorTrait) garbageCollectResources(e *Environment) error {
+	timer := prometheus.NewTimer(gcDuration.WithLabelValues(e.Integration.Namespace, e.Integration.Name))
+	defer timer.ObserveDuration()
+
 	integration, _ := labels.NewRequirement(v1.IntegrationLabel, selection.Equals, []string{e.Integration.Name})
 	generation, err := labels.NewRequirement("camel.apache.org/generation", selection.LessThan, []string{strconv.FormatInt(e.Integration.GetGeneration(), 10)})
 	if err != nil {
 		t.L.ForIntegration(e.Integration).Errorf(err, "cannot determine generation requirement")
-		return
+		return errors.Wrap(err, "cannot determine generation requirement")
 	}
 	selector := labels.NewSelector().
 		Add(*integration).
@@ -138,50 +286,196 @@ func (t *garbageCollectorTrait) garbageCollectResources(e *Environment) {
 	deletableGVKs, err := t.getDeletableTypes(e)
 	if err != nil {
 		t.L.ForIntegration(e.Integration).Errorf(err, "cannot discover GVK types")
-		return
+		return errors.Wrap(err, "cannot discover GVK types")
+	}
+
+	collected, err := t.deleteEachOf(deletableGVKs, e, selector)
+
+	if t.isDryRun() {
+		t.setDryRunCondition(e, collected)
 	}
 
-	t.deleteEachOf(deletableGVKs, e, selector)
+	return err
 }
 
-func (t *garbageCollectorTrait) deleteEachOf(gvks map[schema.GroupVersionKind]struct{}, e *Environment, selector labels.Selector) {
+func (t *garbageCollectorTrait) deleteEachOf(gvks map[schema.GroupVersionKind]struct{}, e *Environment, selector labels.Selector) ([]unstructured.Unstructured, error) {
+	collected := make([]unstructured.Unstructured, 0)
+	var result error
+	limiter := t.deleteRateLimiter(e.Integration.Namespace)
 	for gvk := range gvks {
-		resources := unstructured.UnstructuredList{
-			Object: map[string]interface{}{
-				"apiVersion": gvk.GroupVersion().String(),
-				"kind":       gvk.Kind,
-			},
-		}
-		options := []client.ListOption{
-			client.InNamespace(e.Integration.Namespace),
-			util.MatchingSelector{Selector: selector},
-		}
-		if err := t.Client.List(context.TODO(), &resources, options...); err != nil {
-			if !k8serrors.IsNotFound(err) && !k8serrors.IsForbidden(err) {
-				t.L.ForIntegration(e.Integration).Errorf(err, "cannot list child resources: %v", gvk)
+		for _, namespace := range t.namespaces(e) {
+			resources := unstructured.UnstructuredList{
+				Object: map[string]interface{}{
+					"apiVersion": gvk.GroupVersion().String(),
+					"kind":       gvk.Kind + "List",
+				},
 			}
-			continue
-		}
-
-		for _, resource := range resources.Items {
-			r := resource
-			if !t.canBeDeleted(e, r) {
-				continue
+			options := []client.ListOption{
+				client.InNamespace(namespace),
+				util.MatchingSelector{Selector: selector},
 			}
-			err := t.Client.Delete(context.TODO(), &r, client.PropagationPolicy(metav1.DeletePropagationBackground))
-			if err != nil {
-				// The resource may have already been deleted
-				if !k8serrors.IsNotFound(err) {
-					t.L.ForIntegration(e.Integration).Errorf(err, "cannot delete child resource: %s/%s", resource.GetKind(), resource.GetName())
+			// The resources are listed by chunks, and deleted as they are retrieved, to bound the memory usage
+			err := camelclient.ListPages(context.TODO(), t.Client, &resources, 0, func() error {
+				for _, resource := range resources.Items {
+					r := resource
+					if !t.canBeDeleted(e, r) {
+						continue
+					}
+					if t.isDryRun() {
+						// Only the candidates of a dry-run are retained, so that the deleted resources can be released
+						collected = append(collected, r)
+						t.L.ForIntegration(e.Integration).Infof("dry-run: child resource would be deleted: %s/%s (generation %s)",
+							resource.GetKind(), resource.GetName(), resource.GetLabels()["camel.apache.org/generation"])
+						continue
+					}
+					generation := resource.GetLabels()["camel.apache.org/generation"]
+					if limiter != nil {
+						limiter.Accept()
+					}
+					err := t.Client.Delete(context.TODO(), &r, client.PropagationPolicy(metav1.DeletionPropagation(t.DeletionPolicy)))
+					if err != nil {
+						// The resource may have already been deleted
+						if !k8serrors.IsNotFound(err) {
+							t.L.ForIntegration(e.Integration).Errorf(err, "cannot delete child resource: %s/%s", resource.GetKind(), resource.GetName())
+							result = multierr.Append(result, errors.Wrapf(err, "cannot delete child resource: %s/%s", resource.GetKind(), resource.GetName()))
+							gcDeleteErrors.WithLabelValues(e.Integration.Namespace, e.Integration.Name).Inc()
+							t.eventRecorder().Eventf(e.Integration, corev1.EventTypeWarning, event.ReasonGarbageCollectionError,
+								"Cannot garbage collect child resource %s/%s (generation %s): %v", resource.GetKind(), resource.GetName(), generation, err)
+						}
+					} else {
+						t.L.ForIntegration(e.Integration).Debugf("child resource deleted: %s/%s", resource.GetKind(), resource.GetName())
+						gcResourcesDeleted.WithLabelValues(e.Integration.Namespace, e.Integration.Name).Inc()
+						t.eventRecorder().Eventf(e.Integration, corev1.EventTypeNormal, event.ReasonGarbageCollected,
+							"Garbage collected child resource %s/%s (generation %s)", resource.GetKind(), resource.GetName(), generation)
+					}
 				}
-			} else {
-				t.L.ForIntegration(e.Integration).Debugf("child resource deleted: %s/%s", resource.GetKind(), resource.GetName())
+				return nil
+			}, options...)
+			// The operator may not be granted permissions in every namespace
+			if err != nil && !k8serrors.IsNotFound(err) && !k8serrors.IsForbidden(err) {
+				t.L.ForIntegration(e.Integration).Errorf(err, "cannot list child resources: %v in namespace %s", gvk, namespace)
+				result = multierr.Append(result, errors.Wrapf(err, "cannot list child resources: %v in namespace %s", gvk, namespace))
 			}
 		}
 	}
+
+	return collected, result
+}
+
+// deleteRateLimiter returns the token bucket throttling the deletions in the given namespace, or nil when the rate
+// limiting is disabled. The token bucket is reused across reconciles, as the trait is instantiated on each of them.
+func (t *garbageCollectorTrait) deleteRateLimiter(namespace string) flowcontrol.RateLimiter {
+	if t.DeleteQPS == nil || *t.DeleteQPS == 0 {
+		return nil
+	}
+	burst := *t.DeleteQPS
+	if t.DeleteBurst != nil {
+		burst = *t.DeleteBurst
+	}
+
+	key := deleteRateLimiterKey{
+		namespace: namespace,
+		qps:       *t.DeleteQPS,
+		burst:     burst,
+	}
+
+	deleteRateLimitersLock.Lock()
+	defer deleteRateLimitersLock.Unlock()
+
+	limiter, ok := deleteRateLimiters[key]
+	if !ok {
+		limiter = flowcontrol.NewTokenBucketRateLimiter(float32(key.qps), key.burst)
+		deleteRateLimiters[key] = limiter
+	}
+
+	return limiter
+}
+
+// namespaces returns the integration namespace, followed by the additional namespaces
+func (t *garbageCollectorTrait) namespaces(e *Environment) []string {
+	namespaces := []string{e.Integration.Namespace}
+	for _, namespace := range t.Namespaces {
+		if namespace != e.Integration.Namespace {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	return namespaces
+}
+
+func (t *garbageCollectorTrait) updateGarbageCollectionCondition(e *Environment, err error) {
+	if err == nil && e.Integration.Status.GetCondition(v1.IntegrationConditionGarbageCollectionFailed) == nil {
+		return
+	}
+
+	integration := v1.NewIntegration(e.Integration.Namespace, e.Integration.Name)
+	key := client.ObjectKey{
+		Namespace: e.Integration.Namespace,
+		Name:      e.Integration.Name,
+	}
+	if err := t.Client.Get(context.TODO(), key, &integration); err != nil {
+		t.L.ForIntegration(e.Integration).Errorf(err, "cannot get integration to report garbage collection status")
+		return
+	}
+
+	target := integration.DeepCopy()
+	setGarbageCollectionCondition(&target.Status, err)
+	if err := t.Client.Status().Patch(context.TODO(), target, client.MergeFrom(&integration)); err != nil {
+		t.L.ForIntegration(e.Integration).Errorf(err, "cannot report garbage collection status")
+	}
+}
+
+// setGarbageCollectionCondition reports the garbage collection failure, or clears it when it succeeds
+func setGarbageCollectionCondition(status *v1.IntegrationStatus, err error) {
+	if err != nil {
+		status.SetCondition(
+			v1.IntegrationConditionGarbageCollectionFailed,
+			corev1.ConditionTrue,
+			v1.IntegrationConditionGarbageCollectionFailedReason,
+			err.Error(),
+		)
+	} else {
+		status.RemoveCondition(v1.IntegrationConditionGarbageCollectionFailed)
+	}
+}
+
+func (t *garbageCollectorTrait) setDryRunCondition(e *Environment, resources []unstructured.Unstructured) {
+	if len(resources) == 0 {
+		e.Integration.Status.SetCondition(
+			v1.IntegrationConditionGarbageCollectionDryRun,
+			corev1.ConditionFalse,
+			v1.IntegrationConditionGarbageCollectionDryRunReason,
+			"no resources to be garbage collected",
+		)
+		return
+	}
+
+	candidates := make([]string, 0, len(resources))
+	for _, r := range resources {
+		candidates = append(candidates, fmt.Sprintf("%s/%s (generation %s)", r.GetKind(), r.GetName(), r.GetLabels()["camel.apache.org/generation"]))
+	}
+	sort.Strings(candidates)
+
+	// The condition is removed first so that the message reflects the latest candidates,
+	// even when the status does not change
+	e.Integration.Status.RemoveCondition(v1.IntegrationConditionGarbageCollectionDryRun)
+	e.Integration.Status.SetCondition(
+		v1.IntegrationConditionGarbageCollectionDryRun,
+		corev1.ConditionTrue,
+		v1.IntegrationConditionGarbageCollectionDryRunReason,
+		fmt.Sprintf("resources to be garbage collected: %s", strings.Join(candidates, ", ")),
+	)
+}
+
+func (t *garbageCollectorTrait) isDryRun() bool {
+	return t.DryRun != nil && *t.DryRun
 }
 
 func (t *garbageCollectorTrait) canBeDeleted(e *Environment, u unstructured.Unstructured) bool {
+	if u.GetNamespace() != e.Integration.Namespace {
+		// Owner references cannot cross namespaces, so resources in other namespaces are only identified
+		// by their labels, as long as they are not managed by other controllers
+		return len(u.GetOwnerReferences()) == 0
+	}
 	// Only delete direct children of the integration, otherwise we can affect the behavior of external controllers (i.e. Knative)
 	for _, o := range u.GetOwnerReferences() {
 		if o.Kind == v1.IntegrationKind && strings.HasPrefix(o.APIVersion, v1.SchemeGroupVersion.Group) && o.Name == e.Integration.Name {
@@ -192,6 +486,68 @@ func (t *garbageCollectorTrait) canBeDeleted(e *Environment, u unstructured.Unst
 }
 
 func (t *garbageCollectorTrait) getDeletableTypes(e *Environment) (map[schema.GroupVersionKind]struct{}, error) {
+	var types map[schema.GroupVersionKind]struct{}
+	var err error
+	if len(t.ResourceKinds) > 0 {
+		types, err = t.resolveResourceKinds()
+	} else {
+		types, err = t.getCachedDeletableTypes(e)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Remove the kinds that are explicitly excluded, so that they are never queried
+	GVKs := make(map[schema.GroupVersionKind]struct{}, len(types))
+	for gvk := range types {
+		if !t.isExcludedKind(gvk.Kind) {
+			GVKs[gvk] = struct{}{}
+		}
+	}
+
+	return GVKs, nil
+}
+
+// getCachedDeletableTypes reuses the deletable types resolved for the API server until they expire,
+// so that the discovery round-trip is not performed on each reconcile
+func (t *garbageCollectorTrait) getCachedDeletableTypes(e *Environment) (map[schema.GroupVersionKind]struct{}, error) {
+	if *t.DiscoveryCache != memoryDiscoveryCache && *t.DiscoveryCache != diskDiscoveryCache {
+		return t.lookUpDeletableTypes(e)
+	}
+
+	key := ""
+	if config := t.Client.GetConfig(); config != nil {
+		key = config.Host
+	}
+
+	deletableTypesCacheLock.Lock()
+	defer deletableTypesCacheLock.Unlock()
+
+	if entry, ok := deletableTypesCache[key]; ok {
+		if deletableTypesCacheClock().Before(entry.expiration) {
+			return entry.types, nil
+		}
+		// The discovery client caches (in particular the memory one) must be refreshed as well,
+		// otherwise the same resource types would be resolved again
+		invalidateDiscoveryClients()
+	}
+
+	types, err := t.lookUpDeletableTypes(e)
+	if err != nil {
+		delete(deletableTypesCache, key)
+		invalidateDiscoveryClients()
+		return nil, err
+	}
+
+	deletableTypesCache[key] = deletableTypesCacheEntry{
+		types:      types,
+		expiration: deletableTypesCacheClock().Add(time.Duration(*t.DiscoveryCacheTTL) * time.Second),
+	}
+
+	return types, nil
+}
+
+func (t *garbageCollectorTrait) lookUpDeletableTypes(e *Environment) (map[schema.GroupVersionKind]struct{}, error) {
 	// We rely on the discovery API to retrieve all the resources GVK,
 	// that results in an unbounded set that can impact garbage collection latency when scaling up.
 	discoveryClient, err := t.discoveryClient(e)
@@ -212,6 +568,48 @@ func (t *garbageCollectorTrait) getDeletableTypes(e *Environment) (map[schema.Gr
 		nil
 }
 
+// resolveResourceKinds returns the GVKs of the allowed kinds, which bounds the garbage collection to a known set of types
+func (t *garbageCollectorTrait) resolveResourceKinds() (map[schema.GroupVersionKind]struct{}, error) {
+	scheme := t.Client.GetScheme()
+	GVKs := make(map[schema.GroupVersionKind]struct{}, len(t.ResourceKinds))
+	for _, kind := range t.ResourceKinds {
+		gvk, gk := schema.ParseKindArg(kind)
+		if gvk != nil && scheme.Recognizes(*gvk) {
+			GVKs[*gvk] = struct{}{}
+			continue
+		}
+		if v, ok := preferredVersionForKind(scheme, gk); ok {
+			GVKs[v.WithKind(gk.Kind)] = struct{}{}
+			continue
+		}
+		if gvk != nil {
+			// The type is not known by the operator, so let's rely on the specified version
+			GVKs[*gvk] = struct{}{}
+			continue
+		}
+		return nil, fmt.Errorf("cannot resolve the version of resource kind %s, it must be specified as Kind.version.group", kind)
+	}
+	return GVKs, nil
+}
+
+func preferredVersionForKind(scheme *runtime.Scheme, gk schema.GroupKind) (schema.GroupVersion, bool) {
+	for _, gv := range scheme.PrioritizedVersionsForGroup(gk.Group) {
+		if scheme.Recognizes(gv.WithKind(gk.Kind)) {
+			return gv, true
+		}
+	}
+	return schema.GroupVersion{}, false
+}
+
+func (t *garbageCollectorTrait) isExcludedKind(kind string) bool {
+	for _, k := range t.ExcludedKinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
 func groupVersionKinds(rls []*metav1.APIResourceList) map[schema.GroupVersionKind]struct{} {
 	GVKs := map[schema.GroupVersionKind]struct{}{}
 	for _, rl := range rls {
@@ -235,7 +633,7 @@ func (t *garbageCollectorTrait) discoveryClient(e *Environment) (discovery.Disco
 		httpCacheDir := filepath.Join(mustHomeDir(), ".kube", "http-cache")
 		diskCacheDir := filepath.Join(mustHomeDir(), ".kube", "cache", "discovery", toHostDir(config.Host))
 		var err error
-		diskCachedDiscoveryClient, err = disk.NewCachedDiscoveryClientForConfig(config, diskCacheDir, httpCacheDir, 10*time.Minute)
+		diskCachedDiscoveryClient, err = disk.NewCachedDiscoveryClientForConfig(camelclient.DiscoveryConfig(config), diskCacheDir, httpCacheDir, 10*time.Minute)
 		return diskCachedDiscoveryClient, err
 
 	case memoryDiscoveryCache:
@@ -253,3 +651,32 @@ func (t *garbageCollectorTrait) discoveryClient(e *Environment) (discovery.Disco
 		return t.Client.Discovery(), nil
 	}
 }
+
+func invalidateDiscoveryClients() {
+	discoveryClientLock.Lock()
+	defer discoveryClientLock.Unlock()
+
+	if memoryCachedDiscoveryClient != nil {
+		memoryCachedDiscoveryClient.Invalidate()
+	}
+	if diskCachedDiscoveryClient != nil {
+		diskCachedDiscoveryClient.Invalidate()
+	}
+}
+
+// eventRecorder lazily creates the recorder used to report garbage collection events on the integrations
+func (t *garbageCollectorTrait) eventRecorder() record.EventRecorder {
+	if t.recorder != nil {
+		return t.recorder
+	}
+
+	gcEventRecorderLock.Lock()
+	defer gcEventRecorderLock.Unlock()
+
+	if gcEventRecorder == nil {
+		broadcaster := record.NewBroadcaster()
+		broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: t.Client.CoreV1().Events("")})
+		gcEventRecorder = broadcaster.NewRecorder(t.Client.GetScheme(), corev1.EventSource{Component: "camel-k-gc-trait"})
+	}
+	return gcEventRecorder
+}
@@ -19,12 +19,27 @@ package trait
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/client"
+	"github.com/apache/camel-k/pkg/util/test"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/multierr"
 
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/tools/record"
+
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func TestConfigureGarbageCollectorTraitDoesSucceed(t *testing.T) {
@@ -45,6 +60,38 @@ func TestConfigureDisabledGarbageCollectorTraitDoesNotSucceed(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestConfigureGarbageCollectorTraitWithInvalidDeletionPolicyDoesNotSucceed(t *testing.T) {
+	gcTrait, environment := createNominalGarbageCollectorTest()
+	gcTrait.DeletionPolicy = "Invalid"
+
+	configured, err := gcTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestConfigureGarbageCollectorTraitDeleteRateLimit(t *testing.T) {
+	gcTrait, environment := createNominalGarbageCollectorTest()
+
+	configured, err := gcTrait.Configure(environment)
+	assert.True(t, configured)
+	assert.Nil(t, err)
+	assert.Equal(t, 20, *gcTrait.DeleteQPS)
+	assert.Equal(t, 20, *gcTrait.DeleteBurst)
+	assert.NotNil(t, gcTrait.deleteRateLimiter("ns"))
+	assert.Same(t, gcTrait.deleteRateLimiter("ns"), gcTrait.deleteRateLimiter("ns"))
+	assert.NotSame(t, gcTrait.deleteRateLimiter("ns"), gcTrait.deleteRateLimiter("other-ns"))
+
+	qps := 0
+	gcTrait.DeleteQPS = &qps
+	assert.Nil(t, gcTrait.deleteRateLimiter("ns"))
+
+	qps = -1
+	configured, err = gcTrait.Configure(environment)
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
 func TestApplyGarbageCollectorTraitDoesSucceed(t *testing.T) {
 	gcTrait, environment := createNominalGarbageCollectorTest()
 
@@ -66,6 +113,322 @@ func TestApplyGarbageCollectorTraitDuringInitializationPhaseSkipPostActions(t *t
 	assert.Len(t, environment.PostActions, 0)
 }
 
+func TestGarbageCollectorTraitDryRunReportsCandidates(t *testing.T) {
+	gcTrait, environment := createNominalGarbageCollectorTest()
+	dryRun := true
+	gcTrait.DryRun = &dryRun
+
+	resource := unstructured.Unstructured{}
+	resource.SetKind("Deployment")
+	resource.SetName("integration-name")
+	resource.SetLabels(map[string]string{
+		"camel.apache.org/generation": "1",
+	})
+
+	gcTrait.setDryRunCondition(environment, []unstructured.Unstructured{resource})
+
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionGarbageCollectionDryRun)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Contains(t, condition.Message, "Deployment/integration-name (generation 1)")
+}
+
+func TestGarbageCollectorTraitSkipsExcludedKinds(t *testing.T) {
+	gcTrait, environment := createNominalGarbageCollectorTest()
+	gcTrait.ExcludedKinds = []string{"ConfigMap"}
+
+	c := injectGarbageCollectorTestClient(t, gcTrait, environment)
+
+	recorder := record.NewFakeRecorder(10)
+	gcTrait.recorder = recorder
+
+	assert.Nil(t, gcTrait.garbageCollectResources(environment))
+
+	key := k8sclient.ObjectKey{Namespace: "ns", Name: "integration-name"}
+	assert.Nil(t, c.Get(context.TODO(), key, &corev1.ConfigMap{}))
+	assert.True(t, k8serrors.IsNotFound(c.Get(context.TODO(), key, &corev1.Secret{})))
+
+	assert.Len(t, recorder.Events, 1)
+	assert.Equal(t, "Normal GarbageCollected Garbage collected child resource Secret/integration-name (generation 1)", <-recorder.Events)
+}
+
+func TestGarbageCollectorTraitSynchronousPostAction(t *testing.T) {
+	gcTrait, environment := createNominalGarbageCollectorTest()
+	synchronous := true
+	gcTrait.Synchronous = &synchronous
+
+	c := injectGarbageCollectorTestClient(t, gcTrait, environment)
+	gcTrait.recorder = record.NewFakeRecorder(10)
+
+	configured, err := gcTrait.Configure(environment)
+	assert.True(t, configured)
+	assert.Nil(t, err)
+
+	assert.Nil(t, gcTrait.Apply(environment))
+	assert.Len(t, environment.PostActions, 1)
+	assert.Nil(t, environment.PostActions[0](environment))
+	assert.Nil(t, environment.Integration.Status.GetCondition(v1.IntegrationConditionGarbageCollectionFailed))
+
+	key := k8sclient.ObjectKey{Namespace: "ns", Name: "integration-name"}
+	assert.True(t, k8serrors.IsNotFound(c.Get(context.TODO(), key, &corev1.ConfigMap{})))
+	assert.True(t, k8serrors.IsNotFound(c.Get(context.TODO(), key, &corev1.Secret{})))
+}
+
+func TestGarbageCollectorTraitSynchronousPostActionError(t *testing.T) {
+	gcTrait, environment := createNominalGarbageCollectorTest()
+	synchronous := true
+	gcTrait.Synchronous = &synchronous
+
+	injectGarbageCollectorTestClient(t, gcTrait, environment)
+	gcTrait.recorder = record.NewFakeRecorder(10)
+
+	configured, err := gcTrait.Configure(environment)
+	assert.True(t, configured)
+	assert.Nil(t, err)
+
+	// The kind cannot be resolved, so that the collection fails
+	gcTrait.ResourceKinds = []string{"Unknown"}
+
+	assert.Nil(t, gcTrait.Apply(environment))
+	assert.Len(t, environment.PostActions, 1)
+
+	err = environment.PostActions[0](environment)
+	assert.NotNil(t, err)
+	assert.Equal(t, "cannot discover GVK types: cannot resolve the version of resource kind Unknown, it must be specified as Kind.version.group", err.Error())
+	assert.NotNil(t, environment.Integration.Status.GetCondition(v1.IntegrationConditionGarbageCollectionFailed))
+}
+
+func TestGarbageCollectorTraitReportsListErrors(t *testing.T) {
+	gcTrait, environment := createNominalGarbageCollectorTest()
+	gcTrait.Namespaces = []string{"forbidden-ns"}
+
+	c := injectGarbageCollectorTestClient(t, gcTrait, environment)
+	gcTrait.InjectClient(&garbageCollectorListErrorClient{Client: gcTrait.Client})
+	gcTrait.recorder = record.NewFakeRecorder(10)
+
+	configured, err := gcTrait.Configure(environment)
+	assert.True(t, configured)
+	assert.Nil(t, err)
+
+	err = gcTrait.garbageCollectResources(environment)
+	assert.NotNil(t, err)
+	// The forbidden namespace is not reported
+	assert.Len(t, multierr.Errors(err), 1)
+	assert.Contains(t, err.Error(), "cannot list child resources: /v1, Kind=Secret in namespace ns")
+
+	key := k8sclient.ObjectKey{Namespace: "ns", Name: "integration-name"}
+	assert.True(t, k8serrors.IsNotFound(c.Get(context.TODO(), key, &corev1.ConfigMap{})))
+	assert.Nil(t, c.Get(context.TODO(), key, &corev1.Secret{}))
+}
+
+func TestGarbageCollectorTraitSharesDeleteRateLimit(t *testing.T) {
+	qps := 5
+	burst := 1
+	deletions := &garbageCollectorDeletionClient{}
+
+	// Each garbage collection deletes the ConfigMap and the Secret, and is performed by a new trait instance
+	for i := 0; i < 2; i++ {
+		gcTrait, environment := createNominalGarbageCollectorTest()
+		gcTrait.DeleteQPS = &qps
+		gcTrait.DeleteBurst = &burst
+
+		injectGarbageCollectorTestClient(t, gcTrait, environment)
+		deletions.Client = gcTrait.Client
+		gcTrait.InjectClient(deletions)
+		gcTrait.recorder = record.NewFakeRecorder(10)
+
+		configured, err := gcTrait.Configure(environment)
+		assert.True(t, configured)
+		assert.Nil(t, err)
+		assert.Nil(t, gcTrait.garbageCollectResources(environment))
+	}
+
+	assert.Len(t, deletions.times, 4)
+	// The token bucket is shared across the trait instances, so that the deletions are throttled at the configured rate
+	elapsed := deletions.times[len(deletions.times)-1].Sub(deletions.times[0])
+	assert.True(t, elapsed >= 3*time.Second/time.Duration(qps)-50*time.Millisecond, "elapsed %v", elapsed)
+}
+
+func TestGarbageCollectorTraitNamespaces(t *testing.T) {
+	gcTrait, environment := createNominalGarbageCollectorTest()
+	environment.Integration.Namespace = "ns"
+	gcTrait.Namespaces = []string{"ns", "other-ns"}
+
+	assert.Equal(t, []string{"ns", "other-ns"}, gcTrait.namespaces(environment))
+}
+
+func TestGarbageCollectorTraitOtherNamespaces(t *testing.T) {
+	gcTrait, environment := createNominalGarbageCollectorTest()
+	environment.Integration.Namespace = "ns"
+	environment.Integration.Generation = 2
+	gcTrait.Namespaces = []string{"other-ns"}
+	cache := disabledDiscoveryCache
+	gcTrait.DiscoveryCache = &cache
+
+	// The resources of other namespaces cannot be owned by the integration
+	labelled := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "other-ns",
+			Name:      "labelled",
+			Labels: map[string]string{
+				v1.IntegrationLabel:           environment.Integration.Name,
+				"camel.apache.org/generation": "1",
+			},
+		},
+	}
+	unlabelled := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "other-ns",
+			Name:      "unlabelled",
+			Labels: map[string]string{
+				"camel.apache.org/generation": "1",
+			},
+		},
+	}
+	controlled := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "other-ns",
+			Name:      "controlled",
+			Labels: map[string]string{
+				v1.IntegrationLabel:           environment.Integration.Name,
+				"camel.apache.org/generation": "1",
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Name:       "external-controller",
+				},
+			},
+		},
+	}
+	for _, cm := range []*corev1.ConfigMap{labelled, unlabelled, controlled} {
+		cm.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("ConfigMap"))
+	}
+
+	c, err := test.NewFakeClient(labelled, unlabelled, controlled)
+	assert.Nil(t, err)
+	gcTrait.InjectClient(&garbageCollectorTestClient{
+		Client: c,
+		resources: []*metav1.APIResourceList{
+			{
+				GroupVersion: "v1",
+				APIResources: []metav1.APIResource{
+					{Name: "configmaps", Namespaced: true, Kind: "ConfigMap", Verbs: []string{"list", "delete"}},
+				},
+			},
+		},
+	})
+	gcTrait.recorder = record.NewFakeRecorder(10)
+
+	configured, err := gcTrait.Configure(environment)
+	assert.True(t, configured)
+	assert.Nil(t, err)
+	assert.Nil(t, gcTrait.garbageCollectResources(environment))
+
+	assert.True(t, k8serrors.IsNotFound(c.Get(context.TODO(), k8sclient.ObjectKey{Namespace: "other-ns", Name: "labelled"}, &corev1.ConfigMap{})))
+	assert.Nil(t, c.Get(context.TODO(), k8sclient.ObjectKey{Namespace: "other-ns", Name: "unlabelled"}, &corev1.ConfigMap{}))
+	assert.Nil(t, c.Get(context.TODO(), k8sclient.ObjectKey{Namespace: "other-ns", Name: "controlled"}, &corev1.ConfigMap{}))
+}
+
+func TestGarbageCollectorTraitDiscoveryCacheTTL(t *testing.T) {
+	gcTrait, environment := createNominalGarbageCollectorTest()
+	ttl := 60
+	gcTrait.DiscoveryCacheTTL = &ttl
+
+	c, err := test.NewFakeClient()
+	assert.Nil(t, err)
+	gcTrait.InjectClient(c)
+
+	d := &garbageCollectorCountingDiscovery{
+		resources: []*metav1.APIResourceList{
+			{
+				GroupVersion: "v1",
+				APIResources: []metav1.APIResource{
+					{Name: "configmaps", Namespaced: true, Kind: "ConfigMap", Verbs: []string{"list", "delete"}},
+				},
+			},
+		},
+	}
+
+	now := time.Now()
+	defer func(client discovery.CachedDiscoveryInterface, cache map[string]deletableTypesCacheEntry, clock func() time.Time) {
+		memoryCachedDiscoveryClient = client
+		deletableTypesCache = cache
+		deletableTypesCacheClock = clock
+	}(memoryCachedDiscoveryClient, deletableTypesCache, deletableTypesCacheClock)
+	memoryCachedDiscoveryClient = d
+	deletableTypesCache = map[string]deletableTypesCacheEntry{}
+	deletableTypesCacheClock = func() time.Time {
+		return now
+	}
+
+	configured, err := gcTrait.Configure(environment)
+	assert.True(t, configured)
+	assert.Nil(t, err)
+
+	types, err := gcTrait.getDeletableTypes(environment)
+	assert.Nil(t, err)
+	assert.Len(t, types, 1)
+	assert.Equal(t, 1, d.lookups)
+
+	// The deletable types are reused until the cache expires
+	d.resources[0].APIResources = append(d.resources[0].APIResources,
+		metav1.APIResource{Name: "secrets", Namespaced: true, Kind: "Secret", Verbs: []string{"list", "delete"}})
+	now = now.Add(time.Duration(ttl-1) * time.Second)
+
+	types, err = gcTrait.getDeletableTypes(environment)
+	assert.Nil(t, err)
+	assert.Len(t, types, 1)
+	assert.Equal(t, 1, d.lookups)
+	assert.Equal(t, 0, d.invalidations)
+
+	// The deletable types and the discovery client cache are refreshed once the cache has expired
+	now = now.Add(2 * time.Second)
+
+	types, err = gcTrait.getDeletableTypes(environment)
+	assert.Nil(t, err)
+	assert.Len(t, types, 2)
+	assert.Contains(t, types, corev1.SchemeGroupVersion.WithKind("Secret"))
+	assert.Equal(t, 2, d.lookups)
+	assert.Equal(t, 1, d.invalidations)
+}
+
+func TestGarbageCollectionFailedCondition(t *testing.T) {
+	_, environment := createNominalGarbageCollectorTest()
+
+	setGarbageCollectionCondition(&environment.Integration.Status, errors.New("cannot discover GVK types"))
+
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionGarbageCollectionFailed)
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Equal(t, "cannot discover GVK types", condition.Message)
+
+	setGarbageCollectionCondition(&environment.Integration.Status, nil)
+
+	assert.Nil(t, environment.Integration.Status.GetCondition(v1.IntegrationConditionGarbageCollectionFailed))
+}
+
+func TestGarbageCollectorTraitResolvesResourceKinds(t *testing.T) {
+	gcTrait, _ := createNominalGarbageCollectorTest()
+	gcTrait.ResourceKinds = []string{"Deployment.apps", "ConfigMap", "Example.v1alpha1.example.com"}
+
+	c, err := test.NewFakeClient()
+	assert.Nil(t, err)
+	gcTrait.InjectClient(c)
+
+	GVKs, err := gcTrait.resolveResourceKinds()
+	assert.Nil(t, err)
+	assert.Len(t, GVKs, 3)
+	assert.Contains(t, GVKs, appsv1.SchemeGroupVersion.WithKind("Deployment"))
+	assert.Contains(t, GVKs, corev1.SchemeGroupVersion.WithKind("ConfigMap"))
+	assert.Contains(t, GVKs, schema.GroupVersionKind{Group: "example.com", Version: "v1alpha1", Kind: "Example"})
+
+	gcTrait.ResourceKinds = []string{"Example.example"}
+	_, err = gcTrait.resolveResourceKinds()
+	assert.NotNil(t, err)
+}
+
 func createNominalGarbageCollectorTest() (*garbageCollectorTrait, *Environment) {
 	trait := newGarbageCollectorTrait().(*garbageCollectorTrait)
 	enabled := true
@@ -85,3 +448,118 @@ func createNominalGarbageCollectorTest() (*garbageCollectorTrait, *Environment)
 
 	return trait, environment
 }
+
+// injectGarbageCollectorTestClient injects a client that contains a ConfigMap and a Secret,
+// labelled with a previous generation of the integration
+func injectGarbageCollectorTestClient(t *testing.T, gcTrait *garbageCollectorTrait, environment *Environment) client.Client {
+	environment.Integration.Namespace = "ns"
+	environment.Integration.Generation = 2
+
+	cache := disabledDiscoveryCache
+	gcTrait.DiscoveryCache = &cache
+
+	c, err := test.NewFakeClient(
+		newGarbageCollectableResource(environment.Integration, &corev1.ConfigMap{}, "ConfigMap"),
+		newGarbageCollectableResource(environment.Integration, &corev1.Secret{}, "Secret"),
+	)
+	assert.Nil(t, err)
+
+	gcTrait.InjectClient(&garbageCollectorTestClient{
+		Client: c,
+		resources: []*metav1.APIResourceList{
+			{
+				GroupVersion: "v1",
+				APIResources: []metav1.APIResource{
+					{Name: "configmaps", Namespaced: true, Kind: "ConfigMap", Verbs: []string{"list", "delete"}},
+					{Name: "secrets", Namespaced: true, Kind: "Secret", Verbs: []string{"list", "delete"}},
+				},
+			},
+		},
+	})
+
+	return c
+}
+
+func newGarbageCollectableResource(integration *v1.Integration, resource metav1.Object, kind string) runtime.Object {
+	resource.SetNamespace(integration.Namespace)
+	resource.SetName(integration.Name)
+	resource.SetLabels(map[string]string{
+		v1.IntegrationLabel:           integration.Name,
+		"camel.apache.org/generation": "1",
+	})
+	resource.SetOwnerReferences([]metav1.OwnerReference{
+		{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       v1.IntegrationKind,
+			Name:       integration.Name,
+		},
+	})
+	object := resource.(runtime.Object)
+	object.GetObjectKind().SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind(kind))
+	return object
+}
+
+// garbageCollectorListErrorClient fails to list the Secrets, and the resources of the forbidden-ns namespace
+type garbageCollectorListErrorClient struct {
+	client.Client
+}
+
+func (c *garbageCollectorListErrorClient) List(ctx context.Context, list runtime.Object, opts ...k8sclient.ListOption) error {
+	options := k8sclient.ListOptions{}
+	options.ApplyOptions(opts)
+	if options.Namespace == "forbidden-ns" {
+		return k8serrors.NewForbidden(schema.GroupResource{}, "", errors.New("forbidden"))
+	}
+	if list.GetObjectKind().GroupVersionKind().Kind == "SecretList" {
+		return k8serrors.NewInternalError(errors.New("list failure"))
+	}
+	return c.Client.List(ctx, list, opts...)
+}
+
+// garbageCollectorDeletionClient records the time of the deletions
+type garbageCollectorDeletionClient struct {
+	client.Client
+	times []time.Time
+}
+
+func (c *garbageCollectorDeletionClient) Delete(ctx context.Context, obj runtime.Object, opts ...k8sclient.DeleteOption) error {
+	c.times = append(c.times, time.Now())
+	return c.Client.Delete(ctx, obj, opts...)
+}
+
+// garbageCollectorCountingDiscovery counts the lookups of the resource types, and the invalidations of the cache
+type garbageCollectorCountingDiscovery struct {
+	discovery.CachedDiscoveryInterface
+	resources     []*metav1.APIResourceList
+	lookups       int
+	invalidations int
+}
+
+func (d *garbageCollectorCountingDiscovery) ServerPreferredNamespacedResources() ([]*metav1.APIResourceList, error) {
+	d.lookups++
+	return d.resources, nil
+}
+
+func (d *garbageCollectorCountingDiscovery) Invalidate() {
+	d.invalidations++
+}
+
+// garbageCollectorTestClient overrides the discovery client of the test client,
+// that does not support resource discovery
+type garbageCollectorTestClient struct {
+	client.Client
+	resources []*metav1.APIResourceList
+}
+
+func (c *garbageCollectorTestClient) Discovery() discovery.DiscoveryInterface {
+	return &garbageCollectorTestDiscovery{resources: c.resources}
+}
+
+type garbageCollectorTestDiscovery struct {
+	discovery.DiscoveryInterface
+	resources []*metav1.APIResourceList
+}
+
+func (d *garbageCollectorTestDiscovery) ServerPreferredNamespacedResources() ([]*metav1.APIResourceList, error) {
+	return d.resources, nil
+}
@@ -0,0 +1,205 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util"
+)
+
+// The Health trait configures the Camel health checks of the integration, and wires the health endpoints
+// into the liveness and readiness probes of the integration container.
+//
+// With Quarkus, the MicroProfile Health `/health/live` and `/health/ready` endpoints are used,
+// while with the default runtime, both probes use the `/health` endpoint.
+//
+// The trait cannot be used together with the container trait `probes-enabled` property.
+//
+// It's disabled by default.
+//
+// +camel-k:trait=health
+type healthTrait struct {
+	BaseTrait `property:",squash"`
+	// Configures the liveness probe of the integration container (default `true`).
+	LivenessProbeEnabled *bool `property:"liveness-probe-enabled" json:"livenessProbeEnabled,omitempty"`
+	// Configures the readiness probe of the integration container (default `true`).
+	ReadinessProbeEnabled *bool `property:"readiness-probe-enabled" json:"readinessProbeEnabled,omitempty"`
+	// Makes the readiness of the integration depend on the startup of its routes (default `true`).
+	RoutesReadiness *bool `property:"routes-readiness" json:"routesReadiness,omitempty"`
+	// A list of health check IDs to enable, e.g. health checks that are disabled by default.
+	Include []string `property:"include" json:"include,omitempty"`
+	// A list of health check IDs to disable, so that they are not taken into account by the probes.
+	Exclude []string `property:"exclude" json:"exclude,omitempty"`
+}
+
+const (
+	healthTraitID = "health"
+
+	defaultHealthPath    = "/health"
+	quarkusLivenessPath  = "/health/live"
+	quarkusReadinessPath = "/health/ready"
+)
+
+func newHealthTrait() Trait {
+	return &healthTrait{
+		BaseTrait: NewBaseTrait(healthTraitID, 1550),
+	}
+}
+
+func (t *healthTrait) Configure(e *Environment) (bool, error) {
+	if t.Enabled == nil || !*t.Enabled {
+		return false, nil
+	}
+
+	if ct := e.Catalog.GetTrait(containerTraitID); ct != nil && ct.(*containerTrait).ProbesEnabled {
+		return false, fmt.Errorf("the health trait cannot be used together with the container trait probes-enabled property")
+	}
+
+	if err := t.validateChecks(); err != nil {
+		return false, err
+	}
+
+	return e.IntegrationInPhase(
+		v1.IntegrationPhaseInitialization,
+		v1.IntegrationPhaseDeploying,
+		v1.IntegrationPhaseRunning,
+	), nil
+}
+
+func (t *healthTrait) Apply(e *Environment) error {
+	if e.IntegrationInPhase(v1.IntegrationPhaseInitialization) {
+		if capability, ok := e.CamelCatalog.Runtime.Capabilities[v1.CapabilityHealth]; ok {
+			for _, dependency := range capability.Dependencies {
+				util.StringSliceUniqueAdd(&e.Integration.Status.Dependencies, fmt.Sprintf("mvn:%s/%s", dependency.GroupID, dependency.ArtifactID))
+			}
+
+			// sort the dependencies to get always the same list if they don't change
+			sort.Strings(e.Integration.Status.Dependencies)
+		}
+		// The health endpoints are exposed by the platform HTTP server
+		util.StringSliceUniqueAdd(&e.Integration.Status.Capabilities, v1.CapabilityPlatformHTTP)
+		return nil
+	}
+
+	if e.ApplicationProperties == nil {
+		e.ApplicationProperties = make(map[string]string)
+	}
+
+	livenessPath, readinessPath := defaultHealthPath, defaultHealthPath
+	switch e.CamelCatalog.Runtime.Provider {
+	case v1.RuntimeProviderMain:
+		e.ApplicationProperties["customizer.health.enabled"] = True
+		e.ApplicationProperties["customizer.health.path"] = defaultHealthPath
+	case v1.RuntimeProviderQuarkus:
+		livenessPath, readinessPath = quarkusLivenessPath, quarkusReadinessPath
+	default:
+		return fmt.Errorf("unsupported runtime: %s", e.CamelCatalog.Runtime.Provider)
+	}
+
+	// The application properties must be set before the container trait computes them
+	e.ApplicationProperties["camel.health.enabled"] = True
+	e.ApplicationProperties["camel.health.routes-enabled"] = strconv.FormatBool(t.RoutesReadiness == nil || *t.RoutesReadiness)
+	for _, id := range t.Include {
+		e.ApplicationProperties[fmt.Sprintf("camel.health.config[%s].enabled", id)] = True
+	}
+	for _, id := range t.Exclude {
+		e.ApplicationProperties[fmt.Sprintf("camel.health.config[%s].enabled", id)] = "false"
+	}
+
+	controller, err := e.DetermineControllerStrategy()
+	if err != nil {
+		return err
+	}
+
+	// The probes are configured once the integration container is created by the container trait
+	e.PostProcessors = append(e.PostProcessors, func(env *Environment) error {
+		container := env.getIntegrationContainer()
+		if container == nil {
+			return nil
+		}
+
+		port := defaultContainerPort
+		if ct := env.Catalog.GetTrait(containerTraitID); ct != nil {
+			port = ct.(*containerTrait).Port
+		}
+		// The probe port cannot be set on Knative services
+		if controller == ControllerStrategyKnativeService {
+			port = 0
+		}
+
+		if t.LivenessProbeEnabled == nil || *t.LivenessProbeEnabled {
+			container.LivenessProbe = newHealthProbe(port, livenessPath)
+		}
+		if t.ReadinessProbeEnabled == nil || *t.ReadinessProbeEnabled {
+			container.ReadinessProbe = newHealthProbe(port, readinessPath)
+		}
+
+		return nil
+	})
+
+	return nil
+}
+
+func (t *healthTrait) validateChecks() error {
+	excluded := make(map[string]bool, len(t.Exclude))
+	for _, id := range t.Exclude {
+		if err := validateHealthCheckID(id); err != nil {
+			return err
+		}
+		excluded[id] = true
+	}
+	for _, id := range t.Include {
+		if err := validateHealthCheckID(id); err != nil {
+			return err
+		}
+		if excluded[id] {
+			return fmt.Errorf("the health check %s cannot be both included and excluded", id)
+		}
+	}
+	return nil
+}
+
+func validateHealthCheckID(id string) error {
+	if id == "" || strings.ContainsAny(id, " \t[]=") {
+		return fmt.Errorf("invalid health check id: %q", id)
+	}
+	return nil
+}
+
+func newHealthProbe(port int, path string) *corev1.Probe {
+	action := corev1.HTTPGetAction{
+		Path: path,
+	}
+	if port > 0 {
+		action.Port = intstr.FromInt(port)
+	}
+
+	return &corev1.Probe{
+		Handler: corev1.Handler{
+			HTTPGet: &action,
+		},
+	}
+}
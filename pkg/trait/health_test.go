@@ -0,0 +1,168 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/camel"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+)
+
+func TestConfigureHealthTraitDoesSucceed(t *testing.T) {
+	healthTrait, environment, _ := createNominalHealthTest(t, v1.RuntimeProviderMain)
+	configured, err := healthTrait.Configure(environment)
+
+	assert.True(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestConfigureHealthTraitWithInvalidChecksFails(t *testing.T) {
+	healthTrait, environment, _ := createNominalHealthTest(t, v1.RuntimeProviderMain)
+	healthTrait.Include = []string{"routes:my-route"}
+	healthTrait.Exclude = []string{"routes:my-route"}
+	_, err := healthTrait.Configure(environment)
+	assert.NotNil(t, err)
+
+	healthTrait, environment, _ = createNominalHealthTest(t, v1.RuntimeProviderMain)
+	healthTrait.Exclude = []string{"invalid id"}
+	_, err = healthTrait.Configure(environment)
+	assert.NotNil(t, err)
+}
+
+func TestConfigureHealthTraitWithContainerProbesFails(t *testing.T) {
+	healthTrait, environment, _ := createNominalHealthTest(t, v1.RuntimeProviderMain)
+	environment.Catalog.GetTrait(containerTraitID).(*containerTrait).ProbesEnabled = true
+
+	_, err := healthTrait.Configure(environment)
+	assert.NotNil(t, err)
+}
+
+func TestApplyHealthTraitDependencies(t *testing.T) {
+	healthTrait, environment, _ := createNominalHealthTest(t, v1.RuntimeProviderQuarkus)
+	environment.Integration.Status.Phase = v1.IntegrationPhaseInitialization
+
+	err := healthTrait.Apply(environment)
+
+	assert.Nil(t, err)
+	assert.Contains(t, environment.Integration.Status.Dependencies, "mvn:org.apache.camel.quarkus/camel-quarkus-microprofile-health")
+	assert.Contains(t, environment.Integration.Status.Capabilities, v1.CapabilityPlatformHTTP)
+}
+
+func TestApplyHealthTraitDoesSucceed(t *testing.T) {
+	healthTrait, environment, deployment := createNominalHealthTest(t, v1.RuntimeProviderMain)
+	healthTrait.Exclude = []string{"context"}
+
+	err := healthTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "true", environment.ApplicationProperties["customizer.health.enabled"])
+	assert.Equal(t, "true", environment.ApplicationProperties["camel.health.enabled"])
+	assert.Equal(t, "true", environment.ApplicationProperties["camel.health.routes-enabled"])
+	assert.Equal(t, "false", environment.ApplicationProperties["camel.health.config[context].enabled"])
+
+	for _, processor := range environment.PostProcessors {
+		assert.Nil(t, processor(environment))
+	}
+
+	container := deployment.Spec.Template.Spec.Containers[0]
+	assert.NotNil(t, container.LivenessProbe)
+	assert.Equal(t, "/health", container.LivenessProbe.HTTPGet.Path)
+	assert.Equal(t, int32(defaultContainerPort), container.LivenessProbe.HTTPGet.Port.IntVal)
+	assert.NotNil(t, container.ReadinessProbe)
+	assert.Equal(t, "/health", container.ReadinessProbe.HTTPGet.Path)
+}
+
+func TestApplyHealthTraitOnQuarkusDoesSucceed(t *testing.T) {
+	healthTrait, environment, deployment := createNominalHealthTest(t, v1.RuntimeProviderQuarkus)
+	routesReadiness, liveness := false, false
+	healthTrait.RoutesReadiness = &routesReadiness
+	healthTrait.LivenessProbeEnabled = &liveness
+
+	err := healthTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "false", environment.ApplicationProperties["camel.health.routes-enabled"])
+
+	for _, processor := range environment.PostProcessors {
+		assert.Nil(t, processor(environment))
+	}
+
+	container := deployment.Spec.Template.Spec.Containers[0]
+	assert.Nil(t, container.LivenessProbe)
+	assert.NotNil(t, container.ReadinessProbe)
+	assert.Equal(t, "/health/ready", container.ReadinessProbe.HTTPGet.Path)
+}
+
+func createNominalHealthTest(t *testing.T, provider v1.RuntimeProvider) (*healthTrait, *Environment, *appsv1.Deployment) {
+	trait := newHealthTrait().(*healthTrait)
+	enabled := true
+	trait.Enabled = &enabled
+
+	var catalog *camel.RuntimeCatalog
+	var err error
+	switch provider {
+	case v1.RuntimeProviderMain:
+		catalog, err = camel.DefaultCatalog()
+	case v1.RuntimeProviderQuarkus:
+		catalog, err = camel.QuarkusCatalog()
+	}
+	assert.Nil(t, err)
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "integration-name",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: defaultContainerName,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	environment := &Environment{
+		Catalog:      NewCatalog(context.TODO(), nil),
+		CamelCatalog: catalog,
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "integration-name",
+				Namespace: "integration-namespace",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+		},
+		Resources: kubernetes.NewCollection(deployment),
+	}
+
+	return trait, environment, deployment
+}
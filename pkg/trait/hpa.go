@@ -0,0 +1,126 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+// The HPA trait creates a HorizontalPodAutoscaler resource, that automatically scales the integration
+// Deployment based on the observed CPU utilization.
+//
+// It can't be used in conjunction with an explicit number of replicas set on the integration.
+//
+// It's disabled by default.
+//
+// +camel-k:trait=hpa
+type hpaTrait struct {
+	BaseTrait `property:",squash"`
+	// The lower limit for the number of replicas (default `1`).
+	MinReplicas *int32 `property:"min-replicas" json:"minReplicas,omitempty"`
+	// The upper limit for the number of replicas.
+	MaxReplicas int32 `property:"max-replicas" json:"maxReplicas,omitempty"`
+	// The target average CPU utilization over all the pods, represented as a percentage
+	// of the requested CPU (default `80`).
+	TargetCPUUtilization *int32 `property:"target-cpu-utilization" json:"targetCPUUtilization,omitempty"`
+}
+
+func newHpaTrait() Trait {
+	return &hpaTrait{
+		BaseTrait: NewBaseTrait("hpa", 1150),
+	}
+}
+
+func (t *hpaTrait) Configure(e *Environment) (bool, error) {
+	if t.Enabled == nil || !*t.Enabled {
+		return false, nil
+	}
+
+	if !e.IntegrationInPhase(v1.IntegrationPhaseDeploying, v1.IntegrationPhaseRunning) {
+		return false, nil
+	}
+
+	if e.Integration.Spec.Replicas != nil {
+		return false, fmt.Errorf("the hpa trait can't be used when the integration replicas are explicitly set")
+	}
+	if t.MaxReplicas < 1 {
+		return false, fmt.Errorf("max-replicas must be greater than 0")
+	}
+	if t.MinReplicas != nil && (*t.MinReplicas < 1 || *t.MinReplicas > t.MaxReplicas) {
+		return false, fmt.Errorf("min-replicas must be greater than 0 and lower than or equal to max-replicas")
+	}
+	if t.TargetCPUUtilization != nil && *t.TargetCPUUtilization < 1 {
+		return false, fmt.Errorf("target-cpu-utilization must be greater than 0")
+	}
+
+	return true, nil
+}
+
+func (t *hpaTrait) Apply(e *Environment) error {
+	deployment := e.Resources.GetDeployment(func(d *appsv1.Deployment) bool {
+		return d.Name == e.Integration.Name
+	})
+	if deployment == nil {
+		// Only deployment based integrations can be autoscaled
+		return nil
+	}
+
+	// Let the autoscaler manage the replicas
+	deployment.Spec.Replicas = nil
+
+	e.Resources.Add(t.horizontalPodAutoscalerFor(e.Integration, deployment))
+
+	return nil
+}
+
+func (t *hpaTrait) horizontalPodAutoscalerFor(integration *v1.Integration, deployment *appsv1.Deployment) *autoscalingv1.HorizontalPodAutoscaler {
+	targetCPUUtilization := int32(80)
+	if t.TargetCPUUtilization != nil {
+		targetCPUUtilization = *t.TargetCPUUtilization
+	}
+
+	return &autoscalingv1.HorizontalPodAutoscaler{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "HorizontalPodAutoscaler",
+			APIVersion: autoscalingv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      integration.Name,
+			Namespace: integration.Namespace,
+			Labels: map[string]string{
+				v1.IntegrationLabel: integration.Name,
+			},
+		},
+		Spec: autoscalingv1.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{
+				Kind:       "Deployment",
+				Name:       deployment.Name,
+				APIVersion: appsv1.SchemeGroupVersion.String(),
+			},
+			MinReplicas:                    t.MinReplicas,
+			MaxReplicas:                    t.MaxReplicas,
+			TargetCPUUtilizationPercentage: &targetCPUUtilization,
+		},
+	}
+}
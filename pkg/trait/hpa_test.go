@@ -0,0 +1,125 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+)
+
+func TestConfigureHpaTraitDoesSucceed(t *testing.T) {
+	hpaTrait, environment, _ := createNominalHpaTest()
+	configured, err := hpaTrait.Configure(environment)
+
+	assert.True(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestConfigureHpaTraitWithExplicitReplicasFails(t *testing.T) {
+	hpaTrait, environment, _ := createNominalHpaTest()
+	replicas := int32(3)
+	environment.Integration.Spec.Replicas = &replicas
+	configured, err := hpaTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestConfigureHpaTraitWithInvalidReplicasFails(t *testing.T) {
+	hpaTrait, environment, _ := createNominalHpaTest()
+	minReplicas := int32(10)
+	hpaTrait.MinReplicas = &minReplicas
+	configured, err := hpaTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+
+	hpaTrait.MinReplicas = nil
+	hpaTrait.MaxReplicas = 0
+	configured, err = hpaTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestApplyHpaTraitDoesSucceed(t *testing.T) {
+	hpaTrait, environment, deployment := createNominalHpaTest()
+
+	err := hpaTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	assert.Nil(t, deployment.Spec.Replicas)
+
+	var hpa *autoscalingv1.HorizontalPodAutoscaler
+	for _, a := range environment.Resources.Items() {
+		if h, ok := a.(*autoscalingv1.HorizontalPodAutoscaler); ok {
+			hpa = h
+		}
+	}
+
+	assert.NotNil(t, hpa)
+	assert.Equal(t, "integration-name", hpa.Labels[v1.IntegrationLabel])
+	assert.Equal(t, "Deployment", hpa.Spec.ScaleTargetRef.Kind)
+	assert.Equal(t, "integration-name", hpa.Spec.ScaleTargetRef.Name)
+	assert.Equal(t, int32(2), *hpa.Spec.MinReplicas)
+	assert.Equal(t, int32(5), hpa.Spec.MaxReplicas)
+	assert.Equal(t, int32(80), *hpa.Spec.TargetCPUUtilizationPercentage)
+}
+
+func createNominalHpaTest() (*hpaTrait, *Environment, *appsv1.Deployment) {
+	trait := newHpaTrait().(*hpaTrait)
+	enabled := true
+	trait.Enabled = &enabled
+	minReplicas := int32(2)
+	trait.MinReplicas = &minReplicas
+	trait.MaxReplicas = 5
+
+	one := int32(1)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "integration-name",
+			Namespace: "namespace",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &one,
+		},
+	}
+
+	environment := &Environment{
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "integration-name",
+				Namespace: "namespace",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseRunning,
+			},
+		},
+		Resources: kubernetes.NewCollection(deployment),
+	}
+
+	return trait, environment, deployment
+}
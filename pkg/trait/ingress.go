@@ -18,13 +18,17 @@ limitations under the License.
 package trait
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"strings"
+	"text/template"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 
 	"k8s.io/api/extensions/v1beta1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -42,8 +46,22 @@ type ingressTrait struct {
 	Host string `property:"host" json:"host,omitempty"`
 	// To automatically add an ingress whenever the integration uses a HTTP endpoint consumer.
 	Auto *bool `property:"auto" json:"auto,omitempty"`
+	// The annotations added to the ingress, e.g. to configure external-dns or cert-manager.
+	// The values can refer to the integration name and namespace, using `{{ .Name }}` and `{{ .Namespace }}`.
+	Annotations map[string]string `property:"annotations" json:"annotations,omitempty"`
+	// The name of the ingress class that is used to implement the ingress, e.g. when multiple ingress controllers
+	// are installed in the cluster. When not set, the cluster default ingress class is used.
+	//
+	// The ingress class is set using the `kubernetes.io/ingress.class` annotation.
+	IngressClassName string `property:"ingress-class-name" json:"ingressClassName,omitempty"`
+	// The labels added to the ingress. The labels from the `camel.apache.org` domain are reserved and cannot be set.
+	// The values can refer to the integration name and namespace, using `{{ .Name }}` and `{{ .Namespace }}`.
+	Labels map[string]string `property:"labels" json:"labels,omitempty"`
 }
 
+// ingressClassAnnotation is the annotation used to select the ingress class, until the ingressClassName field is available
+const ingressClassAnnotation = "kubernetes.io/ingress.class"
+
 func newIngressTrait() Trait {
 	return &ingressTrait{
 		BaseTrait: NewBaseTrait("ingress", 2400),
@@ -88,6 +106,21 @@ func (t *ingressTrait) Configure(e *Environment) (bool, error) {
 		}
 	}
 
+	if t.IngressClassName != "" {
+		if errs := validation.IsDNS1123Subdomain(t.IngressClassName); len(errs) > 0 {
+			return false, fmt.Errorf("cannot Apply ingress trait: invalid ingress class name %q: %s", t.IngressClassName, strings.Join(errs, ", "))
+		}
+		if class, ok := t.Annotations[ingressClassAnnotation]; ok && class != t.IngressClassName {
+			return false, fmt.Errorf("cannot Apply ingress trait: ingress class name %s conflicts with the %s annotation", t.IngressClassName, ingressClassAnnotation)
+		}
+	}
+
+	for k := range t.Labels {
+		if k == "camel.apache.org" || strings.HasPrefix(k, "camel.apache.org/") {
+			return false, fmt.Errorf("cannot Apply ingress trait: reserved label %s", k)
+		}
+	}
+
 	if t.Host == "" {
 		e.Integration.Status.SetCondition(
 			v1.IntegrationConditionExposureAvailable,
@@ -108,14 +141,32 @@ func (t *ingressTrait) Apply(e *Environment) error {
 		return errors.New("cannot Apply ingress trait: no target service")
 	}
 
+	annotations, err := t.renderValues(e, t.Annotations)
+	if err != nil {
+		return err
+	}
+	labels, err := t.renderValues(e, t.Labels)
+	if err != nil {
+		return err
+	}
+
+	if t.IngressClassName != "" {
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		annotations[ingressClassAnnotation] = t.IngressClassName
+	}
+
 	ingress := v1beta1.Ingress{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "Ingress",
 			APIVersion: v1beta1.SchemeGroupVersion.String(),
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      service.Name,
-			Namespace: service.Namespace,
+			Name:        service.Name,
+			Namespace:   service.Namespace,
+			Annotations: annotations,
+			Labels:      labels,
 		},
 		Spec: v1beta1.IngressSpec{
 			Backend: &v1beta1.IngressBackend{
@@ -147,3 +198,30 @@ func (t *ingressTrait) Apply(e *Environment) error {
 
 	return nil
 }
+
+// renderValues renders the given values as templates, that can refer to the integration name and namespace
+func (t *ingressTrait) renderValues(e *Environment, values map[string]string) (map[string]string, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	data := map[string]string{
+		"Name":      e.Integration.Name,
+		"Namespace": e.Integration.Namespace,
+	}
+
+	rendered := make(map[string]string, len(values))
+	for k, v := range values {
+		tmpl, err := template.New(k).Option("missingkey=error").Parse(v)
+		if err != nil {
+			return nil, fmt.Errorf("cannot Apply ingress trait: invalid value for %s: %v", k, err)
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf("cannot Apply ingress trait: invalid value for %s: %v", k, err)
+		}
+		rendered[k] = buf.String()
+	}
+
+	return rendered, nil
+}
@@ -154,6 +154,94 @@ func TestApplyIngressTraitDoesSucceed(t *testing.T) {
 	assert.Equal(t, "service-name(hostname) -> service-name(http)", conditions[0].Message)
 }
 
+func TestConfigureIngressTraitWithReservedLabelDoesNotSucceed(t *testing.T) {
+	ingressTrait, environment := createNominalIngressTest()
+	ingressTrait.Labels = map[string]string{
+		v1.IntegrationLabel: "other",
+	}
+
+	configured, err := ingressTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestApplyIngressTraitWithAnnotationsAndLabelsDoesSucceed(t *testing.T) {
+	ingressTrait, environment := createNominalIngressTest()
+	ingressTrait.Annotations = map[string]string{
+		"cert-manager.io/cluster-issuer":            "letsencrypt",
+		"external-dns.alpha.kubernetes.io/hostname": "{{ .Name }}.example.com",
+	}
+	ingressTrait.Labels = map[string]string{
+		"app": "{{ .Name }}",
+	}
+
+	err := ingressTrait.Apply(environment)
+
+	assert.Nil(t, err)
+	environment.Resources.Visit(func(resource runtime.Object) {
+		if ingress, ok := resource.(*v1beta1.Ingress); ok {
+			assert.Equal(t, map[string]string{
+				"cert-manager.io/cluster-issuer":            "letsencrypt",
+				"external-dns.alpha.kubernetes.io/hostname": "integration-name.example.com",
+			}, ingress.Annotations)
+			assert.Equal(t, map[string]string{
+				"app": "integration-name",
+			}, ingress.Labels)
+		}
+	})
+}
+
+func TestApplyIngressTraitWithInvalidTemplateDoesNotSucceed(t *testing.T) {
+	ingressTrait, environment := createNominalIngressTest()
+	ingressTrait.Annotations = map[string]string{
+		"external-dns.alpha.kubernetes.io/hostname": "{{ .Unknown }}.example.com",
+	}
+
+	err := ingressTrait.Apply(environment)
+
+	assert.NotNil(t, err)
+}
+
+func TestConfigureIngressTraitWithInvalidIngressClassNameDoesNotSucceed(t *testing.T) {
+	for _, name := range []string{" ", "Nginx", "nginx_internal"} {
+		ingressTrait, environment := createNominalIngressTest()
+		ingressTrait.IngressClassName = name
+
+		configured, err := ingressTrait.Configure(environment)
+
+		assert.False(t, configured)
+		assert.NotNil(t, err, name)
+	}
+}
+
+func TestConfigureIngressTraitWithConflictingIngressClassDoesNotSucceed(t *testing.T) {
+	ingressTrait, environment := createNominalIngressTest()
+	ingressTrait.IngressClassName = "nginx"
+	ingressTrait.Annotations = map[string]string{
+		"kubernetes.io/ingress.class": "traefik",
+	}
+
+	configured, err := ingressTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestApplyIngressTraitWithIngressClassNameDoesSucceed(t *testing.T) {
+	ingressTrait, environment := createNominalIngressTest()
+	ingressTrait.IngressClassName = "nginx-internal"
+
+	err := ingressTrait.Apply(environment)
+
+	assert.Nil(t, err)
+	environment.Resources.Visit(func(resource runtime.Object) {
+		if ingress, ok := resource.(*v1beta1.Ingress); ok {
+			assert.Equal(t, "nginx-internal", ingress.Annotations["kubernetes.io/ingress.class"])
+		}
+	})
+}
+
 func createNominalIngressTest() (*ingressTrait, *Environment) {
 	trait := newIngressTrait().(*ingressTrait)
 	enabled := true
@@ -0,0 +1,115 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+// The Init Container trait can be used to run containers that perform some setup, e.g. fetching secrets
+// or warming caches, before the integration container starts.
+//
+// The init containers are run in the order they are declared.
+//
+// It's enabled whenever init containers are configured.
+//
+// +camel-k:trait=init-container
+type initContainerTrait struct {
+	BaseTrait `property:",squash"`
+	// The init containers to add to the integration pod. Each container must have a name, that must not collide
+	// with the integration container name, and an image. It can optionally define args, env, volume mounts, etc.
+	Containers []corev1.Container `property:"containers" json:"containers,omitempty"`
+	// Mount the volumes of the integration container into the init containers, so that data can be
+	// staged for the integration (default `false`).
+	MountVolumes bool `property:"mount-volumes" json:"mountVolumes,omitempty"`
+}
+
+func newInitContainerTrait() Trait {
+	return &initContainerTrait{
+		BaseTrait: NewBaseTrait("init-container", 1660),
+	}
+}
+
+func (t *initContainerTrait) Configure(e *Environment) (bool, error) {
+	if t.Enabled != nil && !*t.Enabled {
+		return false, nil
+	}
+
+	if len(t.Containers) == 0 {
+		return false, nil
+	}
+
+	if !e.IntegrationInPhase(v1.IntegrationPhaseDeploying, v1.IntegrationPhaseRunning) {
+		return false, nil
+	}
+
+	names := make(map[string]bool)
+	for _, c := range t.Containers {
+		if c.Name == "" {
+			return false, fmt.Errorf("init container name is required")
+		}
+		if c.Image == "" {
+			return false, fmt.Errorf("init container image is required: %s", c.Name)
+		}
+		if c.Name == e.getIntegrationContainerName() {
+			return false, fmt.Errorf("init container name collides with the integration container name: %s", c.Name)
+		}
+		if names[c.Name] {
+			return false, fmt.Errorf("duplicate init container name: %s", c.Name)
+		}
+		names[c.Name] = true
+	}
+
+	return true, nil
+}
+
+func (t *initContainerTrait) Apply(e *Environment) error {
+	var mounts []corev1.VolumeMount
+	if t.MountVolumes {
+		if container := e.getIntegrationContainer(); container != nil {
+			mounts = container.VolumeMounts
+		}
+	}
+
+	e.Resources.VisitPodSpec(func(spec *corev1.PodSpec) {
+		for _, c := range t.Containers {
+			initContainer := c.DeepCopy()
+			for _, m := range mounts {
+				if !hasVolumeMount(initContainer.VolumeMounts, m) {
+					initContainer.VolumeMounts = append(initContainer.VolumeMounts, m)
+				}
+			}
+			spec.InitContainers = append(spec.InitContainers, *initContainer)
+		}
+	})
+
+	return nil
+}
+
+func hasVolumeMount(mounts []corev1.VolumeMount, mount corev1.VolumeMount) bool {
+	for _, m := range mounts {
+		if m.Name == mount.Name || m.MountPath == mount.MountPath {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,145 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+)
+
+func TestConfigureInitContainerTraitDoesSucceed(t *testing.T) {
+	initContainerTrait, environment, _ := createNominalInitContainerTest()
+	configured, err := initContainerTrait.Configure(environment)
+
+	assert.True(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestConfigureInitContainerTraitWithoutContainersDoesNotSucceed(t *testing.T) {
+	initContainerTrait, environment, _ := createNominalInitContainerTest()
+	initContainerTrait.Containers = nil
+	configured, err := initContainerTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestConfigureInitContainerTraitWithCollidingNameFails(t *testing.T) {
+	initContainerTrait, environment, _ := createNominalInitContainerTest()
+	initContainerTrait.Containers[1].Name = defaultContainerName
+	configured, err := initContainerTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestConfigureInitContainerTraitWithDuplicateNameFails(t *testing.T) {
+	initContainerTrait, environment, _ := createNominalInitContainerTest()
+	initContainerTrait.Containers[1].Name = initContainerTrait.Containers[0].Name
+	configured, err := initContainerTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestApplyInitContainerTraitPreservesOrder(t *testing.T) {
+	initContainerTrait, environment, deployment := createNominalInitContainerTest()
+
+	err := initContainerTrait.Apply(environment)
+
+	assert.Nil(t, err)
+	assert.Len(t, deployment.Spec.Template.Spec.InitContainers, 2)
+	assert.Equal(t, "fetch-secrets", deployment.Spec.Template.Spec.InitContainers[0].Name)
+	assert.Equal(t, "warm-cache", deployment.Spec.Template.Spec.InitContainers[1].Name)
+	assert.Empty(t, deployment.Spec.Template.Spec.InitContainers[0].VolumeMounts)
+	assert.Len(t, deployment.Spec.Template.Spec.Containers, 1)
+}
+
+func TestApplyInitContainerTraitWithVolumesDoesSucceed(t *testing.T) {
+	initContainerTrait, environment, deployment := createNominalInitContainerTest()
+	initContainerTrait.MountVolumes = true
+
+	err := initContainerTrait.Apply(environment)
+
+	assert.Nil(t, err)
+	assert.Len(t, deployment.Spec.Template.Spec.InitContainers, 2)
+	assert.Equal(t, []corev1.VolumeMount{{Name: "data", MountPath: "/data"}}, deployment.Spec.Template.Spec.InitContainers[0].VolumeMounts)
+	// The mount path declared by the init container takes precedence
+	assert.Equal(t, []corev1.VolumeMount{{Name: "cache", MountPath: "/data"}}, deployment.Spec.Template.Spec.InitContainers[1].VolumeMounts)
+}
+
+func createNominalInitContainerTest() (*initContainerTrait, *Environment, *appsv1.Deployment) {
+	trait := newInitContainerTrait().(*initContainerTrait)
+	trait.Containers = []corev1.Container{
+		{
+			Name:  "fetch-secrets",
+			Image: "vault",
+		},
+		{
+			Name:  "warm-cache",
+			Image: "busybox",
+			VolumeMounts: []corev1.VolumeMount{
+				{Name: "cache", MountPath: "/data"},
+			},
+		},
+	}
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "integration-name",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: defaultContainerName,
+							VolumeMounts: []corev1.VolumeMount{
+								{Name: "data", MountPath: "/data"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	environment := &Environment{
+		Catalog: NewCatalog(context.TODO(), nil),
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "integration-name",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+		},
+		Resources: kubernetes.NewCollection(deployment),
+	}
+
+	return trait, environment, deployment
+}
@@ -0,0 +1,260 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	serving "knative.dev/serving/pkg/apis/serving/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util"
+	"github.com/apache/camel-k/pkg/util/envvar"
+)
+
+// The JMX trait enables the Camel JMX management of the integration, so that the Camel MBeans
+// are registered into the platform MBean server.
+//
+// The MBeans can then be accessed over HTTP with the jolokia trait, or with raw JMX by enabling
+// the remote JMX connector, which is useful for monitoring tools that do not support Jolokia.
+//
+// The remote JMX connector requires password authentication, with the password and access files
+// provided by a Secret. It's not secured with SSL, so that its access should still be restricted,
+// e.g. with network policies, or by using `kubectl port-forward`.
+//
+// It's disabled by default.
+//
+// +camel-k:trait=jmx
+type jmxTrait struct {
+	BaseTrait `property:",squash"`
+	// The level of the performance statistics gathered by the Camel MBeans,
+	// one of `Extended`, `Default`, `RoutesOnly` or `Off` (default `Default`).
+	StatisticsLevel string `property:"statistics-level" json:"statisticsLevel,omitempty"`
+	// The naming pattern used to create the Camel context MBean name, e.g. `#name#`.
+	NamePattern string `property:"name-pattern" json:"namePattern,omitempty"`
+	// Exposes the MBeans with a remote JMX connector in the integration container (default `false`).
+	Remote *bool `property:"remote" json:"remote,omitempty"`
+	// The remote JMX connector port, used for both the registry and the server (default `9010`).
+	Port int `property:"port" json:"port,omitempty"`
+	// The name of the Secret holding the `jmxremote.password` and `jmxremote.access` files, used to authenticate
+	// and authorize the remote JMX connector clients. It's required when the remote JMX connector is enabled.
+	// The files are copied by an init container, so that their read access is restricted to the integration
+	// container user, as required by the JVM. It's not supported by Knative services.
+	AuthSecret string `property:"auth-secret" json:"authSecret,omitempty"`
+}
+
+const (
+	jmxTraitID = "jmx"
+
+	jmxHostEnvVar         = "JMX_HOST"
+	javaToolOptionsEnvVar = "JAVA_TOOL_OPTIONS"
+
+	jmxPasswordFile     = "jmxremote.password"
+	jmxAccessFile       = "jmxremote.access"
+	jmxSecretVolumeName = "jmx-auth-secret"
+	jmxSecretMountPath  = "/etc/jmx/secret"
+	jmxConfigVolumeName = "jmx-auth"
+	jmxConfigMountPath  = "/etc/jmx/auth"
+	jmxAuthConfigScript = "cp " + jmxSecretMountPath + "/* " + jmxConfigMountPath + " && chmod 400 " + jmxConfigMountPath + "/*"
+)
+
+var jmxStatisticsLevels = []string{"Extended", "Default", "RoutesOnly", "Off"}
+
+func newJmxTrait() Trait {
+	return &jmxTrait{
+		BaseTrait: NewBaseTrait(jmxTraitID, 1560),
+		Port:      9010,
+	}
+}
+
+func (t *jmxTrait) Configure(e *Environment) (bool, error) {
+	if t.Enabled == nil || !*t.Enabled {
+		return false, nil
+	}
+
+	if t.StatisticsLevel != "" && !util.StringSliceExists(jmxStatisticsLevels, t.StatisticsLevel) {
+		return false, fmt.Errorf("unknown JMX statistics level: %s. One of [%s] is expected", t.StatisticsLevel, strings.Join(jmxStatisticsLevels, ", "))
+	}
+
+	if t.isRemote() {
+		if t.Port < 1 || t.Port > 65535 {
+			return false, fmt.Errorf("invalid JMX port: %d, must be between 1 and 65535", t.Port)
+		}
+		// An unauthenticated remote connector grants anyone reaching it the control of the JVM
+		if t.AuthSecret == "" {
+			return false, fmt.Errorf("the JMX remote connector requires the auth-secret option")
+		}
+		if errs := validation.IsDNS1123Subdomain(t.AuthSecret); len(errs) > 0 {
+			return false, fmt.Errorf("invalid JMX auth-secret %s: %s", t.AuthSecret, strings.Join(errs, ", "))
+		}
+		// The Jolokia agent exposes the same MBeans over HTTP, and can be used alongside
+		if jt := e.Catalog.GetTrait("jolokia"); jt != nil {
+			jolokia := jt.(*jolokiaTrait)
+			if jolokia.Enabled != nil && *jolokia.Enabled && jolokia.Port == t.Port {
+				return false, fmt.Errorf("the JMX port %d is already used by the jolokia trait", t.Port)
+			}
+		}
+	}
+
+	return e.IntegrationInPhase(
+		v1.IntegrationPhaseInitialization,
+		v1.IntegrationPhaseDeploying,
+		v1.IntegrationPhaseRunning,
+	), nil
+}
+
+func (t *jmxTrait) Apply(e *Environment) error {
+	if e.IntegrationInPhase(v1.IntegrationPhaseInitialization) {
+		// The Camel MBeans are only registered when Camel management is on the class path
+		util.StringSliceUniqueAdd(&e.Integration.Status.Dependencies, "mvn:org.apache.camel/camel-management")
+		return nil
+	}
+
+	if e.ApplicationProperties == nil {
+		e.ApplicationProperties = make(map[string]string)
+	}
+
+	// The application properties must be set before the container trait computes them
+	e.ApplicationProperties["camel.main.jmx-enabled"] = True
+	if t.StatisticsLevel != "" {
+		e.ApplicationProperties["camel.main.jmx-management-statistics-level"] = t.StatisticsLevel
+	}
+	if t.NamePattern != "" {
+		e.ApplicationProperties["camel.main.jmx-management-name-pattern"] = t.NamePattern
+	}
+
+	if !t.isRemote() {
+		return nil
+	}
+
+	// The remote connector is configured once the integration container is created by the container trait
+	e.PostProcessors = append(e.PostProcessors, func(env *Environment) error {
+		container := env.getIntegrationContainer()
+		if container == nil {
+			return nil
+		}
+
+		if err := t.configureAuthSecret(env, container); err != nil {
+			return err
+		}
+
+		// The JVM picks the options from the environment, so that they apply independently
+		// of the command computed by the jvm trait
+		options := []string{
+			"-Dcom.sun.management.jmxremote",
+			"-Dcom.sun.management.jmxremote.port=" + strconv.Itoa(t.Port),
+			"-Dcom.sun.management.jmxremote.rmi.port=" + strconv.Itoa(t.Port),
+			"-Dcom.sun.management.jmxremote.authenticate=true",
+			"-Dcom.sun.management.jmxremote.password.file=" + jmxConfigMountPath + "/" + jmxPasswordFile,
+			"-Dcom.sun.management.jmxremote.access.file=" + jmxConfigMountPath + "/" + jmxAccessFile,
+			"-Dcom.sun.management.jmxremote.ssl=false",
+			// The variable reference is expanded by Kubernetes when the container starts
+			"-Djava.rmi.server.hostname=$(" + jmxHostEnvVar + ")",
+		}
+		if opts := envvar.Get(container.Env, javaToolOptionsEnvVar); opts != nil && opts.Value != "" {
+			options = append([]string{opts.Value}, options...)
+		}
+		// The host variable must be declared before the variable that references it
+		envvar.Remove(&container.Env, javaToolOptionsEnvVar)
+		envvar.SetValFrom(&container.Env, jmxHostEnvVar, "status.podIP")
+		envvar.SetVal(&container.Env, javaToolOptionsEnvVar, strings.Join(options, " "))
+
+		container.Ports = append(container.Ports, corev1.ContainerPort{
+			Name:          "jmx",
+			ContainerPort: int32(t.Port),
+			Protocol:      corev1.ProtocolTCP,
+		})
+
+		return nil
+	})
+
+	return nil
+}
+
+// configureAuthSecret adds an init container that copies the password and access files from the mounted Secret,
+// restricting their read access to the container user, as the JVM refuses to start otherwise
+func (t *jmxTrait) configureAuthSecret(e *Environment, container *corev1.Container) error {
+	if e.Resources.GetKnativeService(func(*serving.Service) bool { return true }) != nil {
+		return fmt.Errorf("the JMX remote connector is not supported by Knative services")
+	}
+
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      jmxConfigVolumeName,
+		MountPath: jmxConfigMountPath,
+		ReadOnly:  true,
+	})
+
+	initContainer := corev1.Container{
+		Name:    jmxConfigVolumeName,
+		Image:   container.Image,
+		Command: []string{"/bin/sh", "-c", jmxAuthConfigScript},
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      jmxSecretVolumeName,
+				MountPath: jmxSecretMountPath,
+				ReadOnly:  true,
+			},
+			{
+				Name:      jmxConfigVolumeName,
+				MountPath: jmxConfigMountPath,
+			},
+		},
+	}
+
+	e.Resources.VisitPodSpec(func(spec *corev1.PodSpec) {
+		spec.Volumes = append(spec.Volumes,
+			corev1.Volume{
+				Name: jmxSecretVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName: t.AuthSecret,
+						Items: []corev1.KeyToPath{
+							{
+								Key:  jmxPasswordFile,
+								Path: jmxPasswordFile,
+							},
+							{
+								Key:  jmxAccessFile,
+								Path: jmxAccessFile,
+							},
+						},
+					},
+				},
+			},
+			corev1.Volume{
+				Name: jmxConfigVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					EmptyDir: &corev1.EmptyDirVolumeSource{
+						Medium: corev1.StorageMediumMemory,
+					},
+				},
+			},
+		)
+		spec.InitContainers = append(spec.InitContainers, initContainer)
+	})
+
+	return nil
+}
+
+func (t *jmxTrait) isRemote() bool {
+	return t.Remote != nil && *t.Remote
+}
@@ -0,0 +1,194 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/camel"
+	"github.com/apache/camel-k/pkg/util/envvar"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+)
+
+func TestConfigureJmxTraitDoesSucceed(t *testing.T) {
+	jmxTrait, environment, _ := createNominalJmxTest(t)
+	configured, err := jmxTrait.Configure(environment)
+
+	assert.True(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestConfigureJmxTraitWithInvalidStatisticsLevelFails(t *testing.T) {
+	jmxTrait, environment, _ := createNominalJmxTest(t)
+	jmxTrait.StatisticsLevel = "All"
+
+	_, err := jmxTrait.Configure(environment)
+	assert.NotNil(t, err)
+}
+
+func TestConfigureJmxTraitWithJolokiaPortFails(t *testing.T) {
+	jmxTrait, environment, _ := createNominalJmxTest(t)
+	remote, enabled := true, true
+	jmxTrait.Remote = &remote
+	jmxTrait.AuthSecret = "jmx-auth"
+	jmxTrait.Port = 8778
+	environment.Catalog.GetTrait("jolokia").(*jolokiaTrait).Enabled = &enabled
+
+	_, err := jmxTrait.Configure(environment)
+	assert.NotNil(t, err)
+}
+
+func TestConfigureJmxTraitWithRemoteConnectorWithoutAuthSecretFails(t *testing.T) {
+	jmxTrait, environment, _ := createNominalJmxTest(t)
+	remote := true
+	jmxTrait.Remote = &remote
+
+	_, err := jmxTrait.Configure(environment)
+	assert.NotNil(t, err)
+
+	jmxTrait.AuthSecret = "Invalid Secret"
+	_, err = jmxTrait.Configure(environment)
+	assert.NotNil(t, err)
+
+	jmxTrait.AuthSecret = "jmx-auth"
+	configured, err := jmxTrait.Configure(environment)
+	assert.Nil(t, err)
+	assert.True(t, configured)
+}
+
+func TestApplyJmxTraitDependencies(t *testing.T) {
+	jmxTrait, environment, _ := createNominalJmxTest(t)
+	environment.Integration.Status.Phase = v1.IntegrationPhaseInitialization
+
+	err := jmxTrait.Apply(environment)
+
+	assert.Nil(t, err)
+	assert.Contains(t, environment.Integration.Status.Dependencies, "mvn:org.apache.camel/camel-management")
+}
+
+func TestApplyJmxTraitDoesSucceed(t *testing.T) {
+	jmxTrait, environment, deployment := createNominalJmxTest(t)
+	jmxTrait.StatisticsLevel = "RoutesOnly"
+	jmxTrait.NamePattern = "#name#"
+
+	err := jmxTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "true", environment.ApplicationProperties["camel.main.jmx-enabled"])
+	assert.Equal(t, "RoutesOnly", environment.ApplicationProperties["camel.main.jmx-management-statistics-level"])
+	assert.Equal(t, "#name#", environment.ApplicationProperties["camel.main.jmx-management-name-pattern"])
+	assert.Empty(t, environment.PostProcessors)
+
+	container := deployment.Spec.Template.Spec.Containers[0]
+	assert.Empty(t, container.Ports)
+}
+
+func TestApplyJmxTraitWithRemoteConnector(t *testing.T) {
+	jmxTrait, environment, deployment := createNominalJmxTest(t)
+	remote := true
+	jmxTrait.Remote = &remote
+	jmxTrait.AuthSecret = "jmx-auth"
+	envvar.SetVal(&deployment.Spec.Template.Spec.Containers[0].Env, javaToolOptionsEnvVar, "-Dfoo=bar")
+
+	err := jmxTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	for _, processor := range environment.PostProcessors {
+		assert.Nil(t, processor(environment))
+	}
+
+	container := deployment.Spec.Template.Spec.Containers[0]
+	assert.Len(t, container.Ports, 1)
+	assert.Equal(t, "jmx", container.Ports[0].Name)
+	assert.Equal(t, int32(9010), container.Ports[0].ContainerPort)
+
+	assert.Len(t, container.Env, 2)
+	assert.Equal(t, jmxHostEnvVar, container.Env[0].Name)
+	assert.Equal(t, "status.podIP", container.Env[0].ValueFrom.FieldRef.FieldPath)
+	assert.Equal(t, javaToolOptionsEnvVar, container.Env[1].Name)
+	assert.Equal(t, "-Dfoo=bar"+
+		" -Dcom.sun.management.jmxremote"+
+		" -Dcom.sun.management.jmxremote.port=9010"+
+		" -Dcom.sun.management.jmxremote.rmi.port=9010"+
+		" -Dcom.sun.management.jmxremote.authenticate=true"+
+		" -Dcom.sun.management.jmxremote.password.file=/etc/jmx/auth/jmxremote.password"+
+		" -Dcom.sun.management.jmxremote.access.file=/etc/jmx/auth/jmxremote.access"+
+		" -Dcom.sun.management.jmxremote.ssl=false"+
+		" -Djava.rmi.server.hostname=$(JMX_HOST)", container.Env[1].Value)
+
+	assert.Len(t, container.VolumeMounts, 1)
+	assert.Equal(t, "jmx-auth", container.VolumeMounts[0].Name)
+	assert.True(t, container.VolumeMounts[0].ReadOnly)
+
+	spec := deployment.Spec.Template.Spec
+	assert.Len(t, spec.InitContainers, 1)
+	assert.Len(t, spec.Volumes, 2)
+	assert.Equal(t, "jmx-auth", spec.Volumes[0].Secret.SecretName)
+	assert.NotNil(t, spec.Volumes[1].EmptyDir)
+}
+
+func createNominalJmxTest(t *testing.T) (*jmxTrait, *Environment, *appsv1.Deployment) {
+	trait := newJmxTrait().(*jmxTrait)
+	enabled := true
+	trait.Enabled = &enabled
+
+	catalog, err := camel.DefaultCatalog()
+	assert.Nil(t, err)
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "integration-name",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: defaultContainerName,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	environment := &Environment{
+		Catalog:      NewCatalog(context.TODO(), nil),
+		CamelCatalog: catalog,
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "integration-name",
+				Namespace: "integration-namespace",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+		},
+		Resources: kubernetes.NewCollection(deployment),
+	}
+
+	return trait, environment, deployment
+}
@@ -19,10 +19,13 @@ package trait
 
 import (
 	"fmt"
+	"net"
 	"strconv"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	serving "knative.dev/serving/pkg/apis/serving/v1"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/util"
@@ -52,8 +55,19 @@ type jolokiaTrait struct {
 	// The Host address to which the Jolokia agent should bind to. If `"\*"` or `"0.0.0.0"` is given,
 	// the servers binds to every network interface (default `"*"`).
 	Host *string `property:"host" json:"host,omitempty"`
+	// Binds the Jolokia agent to the loopback interface only, i.e. `127.0.0.1`, so that the endpoint can only be accessed
+	// from within the integration pod, e.g. with `kubectl port-forward` (default `false`).
+	// It cannot be combined with a `host` that is not a loopback address.
+	LocalhostOnly *bool `property:"localhost-only" json:"localhostOnly,omitempty"`
 	// The password used for authentication, applicable when the `user` option is set.
 	Password *string `property:"password" json:"password,omitempty"`
+	// The Secret key containing the password used for authentication, in the `name/key` format,
+	// applicable when the `user` option is set. It cannot be combined with the `password` option.
+	//
+	// The Secret is mounted into an init container, that writes the password to a Jolokia configuration file
+	// shared with the integration container, so that the password is neither part of the Deployment nor of the
+	// container command line. It's not supported by Knative services.
+	PasswordSecret string `property:"password-secret" json:"passwordSecret,omitempty"`
 	// The Jolokia endpoint port (default `8778`).
 	Port int `property:"port" json:"port,omitempty"`
 	// The protocol to use, either `http` or `https` (default `https` for OpenShift)
@@ -74,8 +88,38 @@ func newJolokiaTrait() Trait {
 	}
 }
 
+const (
+	jolokiaLoopbackHost = "127.0.0.1"
+
+	jolokiaSecretVolumeName = "jolokia-password"
+	jolokiaSecretMountPath  = "/etc/jolokia/secret"
+	jolokiaConfigVolumeName = "jolokia-config"
+	jolokiaConfigMountPath  = "/etc/jolokia/config"
+	jolokiaConfigFile       = jolokiaConfigMountPath + "/jolokia.properties"
+
+	// The password is escaped, as the configuration file is loaded as Java properties
+	jolokiaConfigScript = `printf 'password=%s\n' "$(sed -e 's/\\/\\\\/g' ` + jolokiaSecretMountPath + `/password)" > ` + jolokiaConfigFile
+)
+
 func (t *jolokiaTrait) Configure(e *Environment) (bool, error) {
-	return t.Enabled != nil && *t.Enabled && e.IntegrationInPhase(
+	if t.Enabled == nil || !*t.Enabled {
+		return false, nil
+	}
+
+	if t.Host != nil {
+		if !isValidJolokiaHost(*t.Host) {
+			return false, fmt.Errorf("invalid Jolokia host: %s, must be *, an IP address or a host name", *t.Host)
+		}
+		if t.isLocalhostOnly() && !isLoopbackHost(*t.Host) {
+			return false, fmt.Errorf("the Jolokia host %s is not a loopback address, and cannot be used with localhost-only", *t.Host)
+		}
+	}
+
+	if err := t.validateAuthentication(); err != nil {
+		return false, err
+	}
+
+	return e.IntegrationInPhase(
 		v1.IntegrationPhaseInitialization,
 		v1.IntegrationPhaseDeploying,
 		v1.IntegrationPhaseRunning,
@@ -108,6 +152,10 @@ func (t *jolokiaTrait) Apply(e *Environment) (err error) {
 		return err
 	}
 
+	if t.isLocalhostOnly() && t.Host == nil {
+		host := jolokiaLoopbackHost
+		t.Host = &host
+	}
 	t.setDefaultJolokiaOption(options, &t.Host, "host", "*")
 	t.setDefaultJolokiaOption(options, &t.DiscoveryEnabled, "discoveryEnabled", false)
 
@@ -133,6 +181,13 @@ func (t *jolokiaTrait) Apply(e *Environment) (err error) {
 	t.addToJolokiaOptions(options, "extendedClientCheck", t.ExtendedClientCheck)
 	t.addToJolokiaOptions(options, "host", t.Host)
 	t.addToJolokiaOptions(options, "password", t.Password)
+	if t.PasswordSecret != "" {
+		if err := t.configurePasswordSecret(e, container); err != nil {
+			return err
+		}
+		// The options passed to the agent override the ones read from the configuration file
+		t.addToJolokiaOptions(options, "config", jolokiaConfigFile)
+	}
 	t.addToJolokiaOptions(options, "port", t.Port)
 	t.addToJolokiaOptions(options, "protocol", t.Protocol)
 	t.addToJolokiaOptions(options, "user", t.User)
@@ -165,6 +220,110 @@ func (t *jolokiaTrait) Apply(e *Environment) (err error) {
 	return nil
 }
 
+// configurePasswordSecret adds an init container that reads the password from the mounted Secret,
+// and writes it to the Jolokia configuration file shared with the integration container
+func (t *jolokiaTrait) configurePasswordSecret(e *Environment, container *corev1.Container) error {
+	ref, err := parseSecretKeyRef(t.PasswordSecret)
+	if err != nil {
+		return err
+	}
+
+	if e.Resources.GetKnativeService(func(*serving.Service) bool { return true }) != nil {
+		return fmt.Errorf("the Jolokia password-secret option is not supported by Knative services")
+	}
+
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      jolokiaConfigVolumeName,
+		MountPath: jolokiaConfigMountPath,
+		ReadOnly:  true,
+	})
+
+	initContainer := corev1.Container{
+		Name:    jolokiaConfigVolumeName,
+		Image:   container.Image,
+		Command: []string{"/bin/sh", "-c", jolokiaConfigScript},
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      jolokiaSecretVolumeName,
+				MountPath: jolokiaSecretMountPath,
+				ReadOnly:  true,
+			},
+			{
+				Name:      jolokiaConfigVolumeName,
+				MountPath: jolokiaConfigMountPath,
+			},
+		},
+	}
+
+	e.Resources.VisitPodSpec(func(spec *corev1.PodSpec) {
+		spec.Volumes = append(spec.Volumes,
+			corev1.Volume{
+				Name: jolokiaSecretVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName: ref.Name,
+						Items: []corev1.KeyToPath{
+							{
+								Key:  ref.Key,
+								Path: "password",
+							},
+						},
+					},
+				},
+			},
+			corev1.Volume{
+				Name: jolokiaConfigVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					EmptyDir: &corev1.EmptyDirVolumeSource{
+						Medium: corev1.StorageMediumMemory,
+					},
+				},
+			},
+		)
+		spec.InitContainers = append(spec.InitContainers, initContainer)
+	})
+
+	return nil
+}
+
+func (t *jolokiaTrait) validateAuthentication() error {
+	if t.Password != nil && t.PasswordSecret != "" {
+		return fmt.Errorf("the Jolokia password and password-secret options cannot be used together")
+	}
+	if t.PasswordSecret != "" {
+		if _, err := parseSecretKeyRef(t.PasswordSecret); err != nil {
+			return err
+		}
+	}
+	hasPassword := t.Password != nil || t.PasswordSecret != ""
+	if t.User != nil && !hasPassword {
+		return fmt.Errorf("the Jolokia user option requires either the password or password-secret option")
+	}
+	if t.User == nil && hasPassword {
+		return fmt.Errorf("the Jolokia password requires the user option")
+	}
+	return nil
+}
+
+func (t *jolokiaTrait) isLocalhostOnly() bool {
+	return t.LocalhostOnly != nil && *t.LocalhostOnly
+}
+
+func isValidJolokiaHost(host string) bool {
+	if host == "*" || net.ParseIP(host) != nil {
+		return true
+	}
+	return len(validation.IsDNS1123Subdomain(host)) == 0
+}
+
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
 func (t *jolokiaTrait) setDefaultJolokiaOption(options map[string]string, option interface{}, key string, value interface{}) {
 	// Do not override existing option
 	if _, ok := options[key]; ok {
@@ -25,6 +25,7 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	serving "knative.dev/serving/pkg/apis/serving/v1"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/util/kubernetes"
@@ -96,6 +97,153 @@ func TestApplyJolokiaTraitForOpenShiftProfileShouldSucceed(t *testing.T) {
 	assert.Equal(t, corev1.ConditionTrue, condition.Status)
 }
 
+func TestApplyJolokiaTraitWithLocalhostOnlyShouldSucceed(t *testing.T) {
+	trait, environment := createNominalJolokiaTest()
+	localhostOnly := true
+	trait.LocalhostOnly = &localhostOnly
+
+	configured, err := trait.Configure(environment)
+	assert.Nil(t, err)
+	assert.True(t, configured)
+
+	err = trait.Apply(environment)
+
+	assert.Nil(t, err)
+
+	container := environment.Resources.GetContainerByName(defaultContainerName)
+	assert.NotNil(t, container)
+
+	assert.Equal(t, container.Args, []string{
+		"-javaagent:dependencies/org.jolokia.jolokia-jvm-1.6.2-agent.jar=discoveryEnabled=false,host=127.0.0.1,port=8778",
+	})
+}
+
+func TestConfigureJolokiaTraitWithInvalidHostShouldReturnError(t *testing.T) {
+	trait, environment := createNominalJolokiaTest()
+	host := "invalid host"
+	trait.Host = &host
+
+	_, err := trait.Configure(environment)
+	assert.NotNil(t, err)
+
+	trait, environment = createNominalJolokiaTest()
+	host = "0.0.0.0"
+	localhostOnly := true
+	trait.Host = &host
+	trait.LocalhostOnly = &localhostOnly
+
+	_, err = trait.Configure(environment)
+	assert.NotNil(t, err)
+}
+
+func TestApplyJolokiaTraitWithPasswordSecretShouldSucceed(t *testing.T) {
+	trait, environment := createNominalJolokiaTest()
+	user := "admin"
+	trait.User = &user
+	trait.PasswordSecret = "jolokia-credentials/password"
+
+	configured, err := trait.Configure(environment)
+	assert.Nil(t, err)
+	assert.True(t, configured)
+
+	err = trait.Apply(environment)
+
+	assert.Nil(t, err)
+
+	container := environment.Resources.GetContainerByName(defaultContainerName)
+	assert.NotNil(t, container)
+
+	assert.Equal(t, container.Args, []string{
+		"-javaagent:dependencies/org.jolokia.jolokia-jvm-1.6.2-agent.jar=config=/etc/jolokia/config/jolokia.properties," +
+			"discoveryEnabled=false,host=*,port=8778,user=admin",
+	})
+	assert.Len(t, container.VolumeMounts, 1)
+	assert.Equal(t, "jolokia-config", container.VolumeMounts[0].Name)
+
+	d := environment.Resources.GetDeployment(func(*appsv1.Deployment) bool { return true })
+	assert.NotNil(t, d)
+
+	var secret *corev1.SecretVolumeSource
+	for _, v := range d.Spec.Template.Spec.Volumes {
+		if v.Secret != nil {
+			secret = v.Secret
+		}
+	}
+	assert.NotNil(t, secret)
+	assert.Equal(t, "jolokia-credentials", secret.SecretName)
+	assert.Equal(t, []corev1.KeyToPath{{Key: "password", Path: "password"}}, secret.Items)
+
+	assert.Len(t, d.Spec.Template.Spec.InitContainers, 1)
+
+	// The password must not be exposed in neither the command line nor the environment
+	for _, c := range append(d.Spec.Template.Spec.InitContainers, d.Spec.Template.Spec.Containers...) {
+		for _, arg := range append(c.Command, c.Args...) {
+			assert.NotContains(t, arg, "password=$(")
+			assert.NotContains(t, arg, ",password=")
+		}
+		for _, env := range c.Env {
+			assert.Nil(t, env.ValueFrom)
+			assert.NotContains(t, env.Value, "password")
+		}
+	}
+}
+
+func TestApplyJolokiaTraitWithPasswordSecretForKnativeShouldReturnError(t *testing.T) {
+	trait, environment := createNominalJolokiaTest()
+	user := "admin"
+	trait.User = &user
+	trait.PasswordSecret = "jolokia-credentials/password"
+	environment.Resources.Add(&serving.Service{
+		Spec: serving.ServiceSpec{
+			ConfigurationSpec: serving.ConfigurationSpec{
+				Template: serving.RevisionTemplateSpec{
+					Spec: serving.RevisionSpec{
+						PodSpec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name: defaultContainerName,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	err := trait.Apply(environment)
+
+	assert.NotNil(t, err)
+}
+
+func TestConfigureJolokiaTraitWithInvalidAuthenticationShouldReturnError(t *testing.T) {
+	user := "admin"
+	password := "secret"
+
+	trait, environment := createNominalJolokiaTest()
+	trait.User = &user
+	_, err := trait.Configure(environment)
+	assert.NotNil(t, err)
+
+	trait, environment = createNominalJolokiaTest()
+	trait.PasswordSecret = "jolokia-credentials/password"
+	_, err = trait.Configure(environment)
+	assert.NotNil(t, err)
+
+	trait, environment = createNominalJolokiaTest()
+	trait.User = &user
+	trait.Password = &password
+	trait.PasswordSecret = "jolokia-credentials/password"
+	_, err = trait.Configure(environment)
+	assert.NotNil(t, err)
+
+	trait, environment = createNominalJolokiaTest()
+	trait.User = &user
+	trait.PasswordSecret = "jolokia-credentials"
+	_, err = trait.Configure(environment)
+	assert.NotNil(t, err)
+}
+
 func TestApplyJolokiaTraitWithoutContainerShouldReportJolokiaUnavailable(t *testing.T) {
 	trait, environment := createNominalJolokiaTest()
 	environment.Resources = kubernetes.NewCollection()
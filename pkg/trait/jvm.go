@@ -33,9 +33,12 @@ import (
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/builder/runtime"
 	"github.com/apache/camel-k/pkg/util"
 )
 
+const quarkusFastJarMainClass = "io.quarkus.bootstrap.runner.QuarkusEntryPoint"
+
 // The JVM trait is used to configure the JVM that runs the integration.
 //
 // +camel-k:trait=jvm
@@ -49,8 +52,13 @@ type jvmTrait struct {
 	PrintCommand bool `property:"print-command" json:"printCommand,omitempty"`
 	// Transport address at which to listen for the newly launched JVM (default `*:5005`)
 	DebugAddress string `property:"debug-address" json:"debugAddress,omitempty"`
-	// A list of JVM options
+	// A list of JVM options, appended verbatim to the JVM command line, e.g., `-XX:+UseG1GC` or `-Dfoo=bar`.
+	// Each option must start with `-`, and must not contain whitespaces, shell metacharacters or wildcards.
 	Options []string `property:"options" json:"options,omitempty"`
+	// The maximum heap size, as a percentage of the container memory limit, e.g., `75`.
+	// When not set, it defaults to 50%, or 25% for memory limits lower than 300M.
+	// The heap size is not configured when no memory limit is set, or when it is already set using the JVM options.
+	MaxHeapPercent *int `property:"max-heap-percent" json:"maxHeapPercent,omitempty"`
 }
 
 func newJvmTrait() Trait {
@@ -92,12 +100,26 @@ func (t *jvmTrait) Apply(e *Environment) error {
 		return fmt.Errorf("unable to find integration kit %s", e.Integration.Status.Kit)
 	}
 
+	if err := validateJvmOptions(t.Options); err != nil {
+		return err
+	}
+	if t.MaxHeapPercent != nil && (*t.MaxHeapPercent <= 0 || *t.MaxHeapPercent > 100) {
+		return fmt.Errorf("invalid max heap percent %d: it must be between 1 and 100", *t.MaxHeapPercent)
+	}
+
 	classpath := strset.New()
 
 	classpath.Add("/etc/camel/resources")
 	classpath.Add("./resources")
 
+	mainClass := e.CamelCatalog.Runtime.ApplicationClass
 	for _, artifact := range kit.Status.Artifacts {
+		if artifact.Target == runtime.QuarkusFastJarRunner {
+			// The fast-jar runner loads the dependencies from the Quarkus application layout
+			classpath = strset.New("/etc/camel/resources", "./resources", runtime.QuarkusFastJarRunner)
+			mainClass = quarkusFastJarMainClass
+			break
+		}
 		classpath.Add(artifact.Target)
 	}
 
@@ -140,15 +162,21 @@ func (t *jvmTrait) Apply(e *Environment) error {
 	// This is configured off-container, thus is limited to explicit user configuration.
 	// We may want to inject a wrapper script into the container image, so that it can
 	// be performed in-container, based on CGroups memory resource control files.
-	if memory, hasLimit := container.Resources.Limits[corev1.ResourceMemory]; !hasHeapSizeOption && hasLimit {
+	// The container resources are set by the container trait, that is executed before.
+	memory, hasLimit := container.Resources.Limits[corev1.ResourceMemory]
+	if !hasHeapSizeOption && hasLimit {
 		// Simple heuristic that caps the maximum heap size to 50% of the memory limit
 		percentage := int64(50)
-		// Unless the memory limit is lower than 300M, in which case we leave more room for the non-heap memory
-		if resource.NewScaledQuantity(300, 6).Cmp(memory) > 0 {
+		if t.MaxHeapPercent != nil {
+			percentage = int64(*t.MaxHeapPercent)
+		} else if resource.NewScaledQuantity(300, 6).Cmp(memory) > 0 {
+			// Unless the memory limit is lower than 300M, in which case we leave more room for the non-heap memory
 			percentage = 25
 		}
 		memory.AsDec().Mul(memory.AsDec(), infp.NewDec(percentage, 2))
 		args = append(args, fmt.Sprintf("-Xmx%dM", memory.ScaledValue(resource.Mega)))
+	} else if !hasLimit && t.MaxHeapPercent != nil {
+		t.L.ForIntegration(e.Integration).Infof("No container memory limit set, skipping max heap percent %d", *t.MaxHeapPercent)
 	}
 
 	// Add mounted resources to the class path
@@ -160,7 +188,7 @@ func (t *jvmTrait) Apply(e *Environment) error {
 	sort.Strings(items)
 
 	args = append(args, "-cp", strings.Join(items, ":"))
-	args = append(args, e.CamelCatalog.Runtime.ApplicationClass)
+	args = append(args, mainClass)
 
 	if t.PrintCommand {
 		args = append([]string{"exec", "java"}, args...)
@@ -181,3 +209,19 @@ func (t *jvmTrait) Apply(e *Environment) error {
 func (t *jvmTrait) IsPlatformTrait() bool {
 	return true
 }
+
+// jvmOptionForbiddenChars contains the characters that are not allowed in JVM options,
+// as the JVM command may be interpreted by a shell, which also expands globs and tildes
+const jvmOptionForbiddenChars = " \t\r\n;&|$`\\'\"<>(){}*?[]~"
+
+func validateJvmOptions(options []string) error {
+	for _, option := range options {
+		if !strings.HasPrefix(option, "-") {
+			return fmt.Errorf("invalid JVM option %q: it must start with '-'", option)
+		}
+		if strings.ContainsAny(option, jvmOptionForbiddenChars) {
+			return fmt.Errorf("invalid JVM option %q: it must not contain whitespaces, shell metacharacters or wildcards", option)
+		}
+	}
+	return nil
+}
@@ -20,6 +20,7 @@ package trait
 import (
 	"context"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/apache/camel-k/pkg/util/camel"
@@ -29,6 +30,7 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	serving "knative.dev/serving/pkg/apis/serving/v1"
@@ -111,6 +113,39 @@ func TestApplyJvmTraitWithDeploymentResource(t *testing.T) {
 	})
 }
 
+func TestApplyJvmTraitWithQuarkusFastJar(t *testing.T) {
+	trait, environment := createNominalJvmTest()
+	environment.IntegrationKit.Status.Artifacts = []v1.Artifact{
+		{ID: "quarkus-app/lib/main/org.apache.camel.camel-core.jar", Target: "quarkus-app/lib/main/org.apache.camel.camel-core.jar"},
+		{ID: "quarkus-app/quarkus-run.jar", Target: "quarkus-app/quarkus-run.jar"},
+	}
+
+	d := appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: defaultContainerName,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	environment.Resources.Add(&d)
+
+	err := trait.Apply(environment)
+
+	assert.Nil(t, err)
+	assert.Equal(t, d.Spec.Template.Spec.Containers[0].Args, []string{
+		"-cp",
+		"./resources:/etc/camel/resources:quarkus-app/quarkus-run.jar",
+		"io.quarkus.bootstrap.runner.QuarkusEntryPoint",
+	})
+}
+
 func TestApplyJvmTraitWithKNativeResource(t *testing.T) {
 	trait, environment := createNominalJvmTest()
 
@@ -178,6 +213,118 @@ func TestApplyJvmTraitWithDebugEnabled(t *testing.T) {
 	)
 }
 
+func TestApplyJvmTraitWithOptions(t *testing.T) {
+	trait, environment := createNominalJvmTest()
+	trait.Options = []string{"-XX:+UseG1GC", "-Dfoo=bar"}
+
+	d := appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: defaultContainerName,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	environment.Resources.Add(&d)
+
+	err := trait.Apply(environment)
+
+	assert.Nil(t, err)
+	assert.Contains(t, d.Spec.Template.Spec.Containers[0].Args, "-XX:+UseG1GC")
+	assert.Contains(t, d.Spec.Template.Spec.Containers[0].Args, "-Dfoo=bar")
+}
+
+func TestApplyJvmTraitWithInvalidOptions(t *testing.T) {
+	for _, option := range []string{"Dfoo=bar", "-Dfoo=bar;rm", "-Dfoo=$(id)", "-Dfoo=bar baz", "-Dfoo=`id`",
+		"-Dfoo=/tmp/*", "-Dfoo=ba?", "-Dfoo=[ab]", "-Dfoo=~/bar"} {
+		trait, environment := createNominalJvmTest()
+		trait.Options = []string{option}
+
+		err := trait.Apply(environment)
+
+		assert.NotNil(t, err, option)
+	}
+}
+
+func TestApplyJvmTraitWithMaxHeapPercent(t *testing.T) {
+	trait, environment := createNominalJvmTest()
+	percent := 75
+	trait.MaxHeapPercent = &percent
+
+	d := appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: defaultContainerName,
+							Resources: corev1.ResourceRequirements{
+								Limits: corev1.ResourceList{
+									corev1.ResourceMemory: resource.MustParse("1G"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	environment.Resources.Add(&d)
+
+	err := trait.Apply(environment)
+
+	assert.Nil(t, err)
+	assert.Contains(t, d.Spec.Template.Spec.Containers[0].Args, "-Xmx750M")
+}
+
+func TestApplyJvmTraitWithMaxHeapPercentAndNoMemoryLimit(t *testing.T) {
+	trait, environment := createNominalJvmTest()
+	percent := 75
+	trait.MaxHeapPercent = &percent
+
+	d := appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: defaultContainerName,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	environment.Resources.Add(&d)
+
+	err := trait.Apply(environment)
+
+	assert.Nil(t, err)
+	for _, arg := range d.Spec.Template.Spec.Containers[0].Args {
+		assert.False(t, strings.HasPrefix(arg, "-Xmx"))
+	}
+}
+
+func TestApplyJvmTraitWithInvalidMaxHeapPercent(t *testing.T) {
+	for _, percent := range []int{0, -10, 101} {
+		trait, environment := createNominalJvmTest()
+		p := percent
+		trait.MaxHeapPercent = &p
+
+		err := trait.Apply(environment)
+
+		assert.NotNil(t, err)
+	}
+}
+
 func createNominalJvmTest() (*jvmTrait, *Environment) {
 	return createJvmTestWithKitType(v1.IntegrationKitTypePlatform)
 }
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"net/url"
 	"reflect"
+	"regexp"
 	"strings"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
@@ -67,6 +68,13 @@ type knativeTrait struct {
 	// List of event types that the integration will produce.
 	// Can contain simple event types or full Camel URIs (to use a specific broker).
 	EventSinks []string `property:"event-sinks" json:"eventSinks,omitempty"`
+	// List of CloudEvents attribute filters, in the form `attribute=value`, added to the Knative triggers
+	// created for the event sources, e.g. `source=my-source`. The attribute names must be lower-case
+	// alphanumeric strings of at most 20 characters. Filtering by `type` is done via the event sources.
+	Filters []string `property:"filters" json:"filters,omitempty"`
+	// The version of the CloudEvents specification used by the integration to produce and consume events,
+	// one of `1.0` (default), `0.3`, `0.2` or `0.1`. It must match the version expected by the Knative eventing stack.
+	CloudEventsSpecVersion string `property:"cloud-events-spec-version" json:"cloudEventsSpecVersion,omitempty"`
 	// Enables filtering on events based on the header "ce-knativehistory". Since this is an experimental header
 	// that can be removed in a future version of Knative, filtering is enabled only when the integration is
 	// listening from more than 1 channel.
@@ -83,8 +91,15 @@ type knativeTrait struct {
 
 const (
 	knativeHistoryHeader = "ce-knativehistory"
+
+	knativeCloudEventsSpecVersionProperty = "camel.component.knative.cloudEventsSpecVersion"
+	defaultCloudEventsSpecVersion         = "1.0"
 )
 
+var knativeCloudEventsSpecVersions = []string{"1.0", "0.3", "0.2", "0.1"}
+
+var cloudEventAttributeNameRegexp = regexp.MustCompile(`^[a-z0-9]{1,20}$`)
+
 func newKnativeTrait() Trait {
 	t := &knativeTrait{
 		BaseTrait: NewBaseTrait("knative", 400),
@@ -107,6 +122,17 @@ func (t *knativeTrait) Configure(e *Environment) (bool, error) {
 		return false, nil
 	}
 
+	if _, err := t.parseFilters(); err != nil {
+		return false, err
+	}
+
+	if t.CloudEventsSpecVersion == "" {
+		t.CloudEventsSpecVersion = defaultCloudEventsSpecVersion
+	} else if !util.StringSliceExists(knativeCloudEventsSpecVersions, t.CloudEventsSpecVersion) {
+		return false, fmt.Errorf("unsupported CloudEvents spec version: %s, must be one of %s",
+			t.CloudEventsSpecVersion, strings.Join(knativeCloudEventsSpecVersions, ", "))
+	}
+
 	if t.Auto == nil || *t.Auto {
 		if len(t.ChannelSources) == 0 {
 			items := make([]string, 0)
@@ -243,6 +269,11 @@ func (t *knativeTrait) Apply(e *Environment) error {
 		}
 
 		envvar.SetVal(&e.EnvVars, "CAMEL_KNATIVE_CONFIGURATION", conf)
+
+		if e.ApplicationProperties == nil {
+			e.ApplicationProperties = make(map[string]string)
+		}
+		e.ApplicationProperties[knativeCloudEventsSpecVersionProperty] = t.CloudEventsSpecVersion
 	}
 
 	return nil
@@ -364,12 +395,17 @@ func (t *knativeTrait) configureEndpoints(e *Environment, env *knativeapi.CamelE
 }
 
 func (t *knativeTrait) configureEvents(e *Environment, env *knativeapi.CamelEnvironment) error {
+	filters, err := t.parseFilters()
+	if err != nil {
+		return err
+	}
+
 	// Sources
-	err := t.withServiceDo(false, e, env, t.EventSources, knativeapi.CamelServiceTypeEvent, knativeapi.CamelEndpointKindSource,
+	err = t.withServiceDo(false, e, env, t.EventSources, knativeapi.CamelServiceTypeEvent, knativeapi.CamelEndpointKindSource,
 		func(ref *corev1.ObjectReference, serviceURI string, _ func() (*url.URL, error)) error {
 			// Iterate over all, without skipping duplicates
 			eventType := knativeutil.ExtractEventType(serviceURI)
-			t.createTrigger(e, ref, eventType)
+			t.createTrigger(e, ref, eventType, filters)
 
 			if !env.ContainsService(ref.Name, knativeapi.CamelEndpointKindSource, knativeapi.CamelServiceTypeEvent, ref.APIVersion, ref.Kind) {
 				svc := knativeapi.CamelServiceDefinition{
@@ -487,19 +523,41 @@ func (t *knativeTrait) configureSinkBinding(e *Environment, env *knativeapi.Came
 	return err
 }
 
-func (t *knativeTrait) createTrigger(e *Environment, ref *corev1.ObjectReference, eventType string) {
-	// TODO extend to additional filters too, to filter them at source and not at destination
+func (t *knativeTrait) createTrigger(e *Environment, ref *corev1.ObjectReference, eventType string, filters map[string]string) {
 	found := e.Resources.HasKnativeTrigger(func(trigger *eventing.Trigger) bool {
 		return trigger.Spec.Broker == ref.Name &&
 			trigger.Spec.Filter != nil &&
 			trigger.Spec.Filter.Attributes["type"] == eventType
 	})
 	if !found {
-		trigger := knativeutil.CreateTrigger(*ref, e.Integration.Name, eventType)
+		trigger := knativeutil.CreateTrigger(*ref, e.Integration.Name, eventType, filters)
 		e.Resources.Add(trigger)
 	}
 }
 
+func (t *knativeTrait) parseFilters() (map[string]string, error) {
+	filters := make(map[string]string, len(t.Filters))
+	for _, filter := range t.Filters {
+		kv := strings.SplitN(filter, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid filter: %s, must be in the form attribute=value", filter)
+		}
+		name := kv[0]
+		if !cloudEventAttributeNameRegexp.MatchString(name) {
+			return nil, fmt.Errorf("invalid filter attribute name: %s, must consist of at most 20 lower-case letters or digits", name)
+		}
+		if name == "type" {
+			return nil, fmt.Errorf("invalid filter: %s, the event type must be configured with the event sources", filter)
+		}
+		if _, ok := filters[name]; ok {
+			return nil, fmt.Errorf("duplicate filter attribute: %s", name)
+		}
+		filters[name] = kv[1]
+	}
+
+	return filters, nil
+}
+
 func (t *knativeTrait) ifServiceMissingDo(
 	e *Environment,
 	env *knativeapi.CamelEnvironment,
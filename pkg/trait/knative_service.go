@@ -37,6 +37,8 @@ const (
 	knativeServingTargetAnnotation   = "autoscaling.knative.dev/target"
 	knativeServingMinScaleAnnotation = "autoscaling.knative.dev/minScale"
 	knativeServingMaxScaleAnnotation = "autoscaling.knative.dev/maxScale"
+
+	knativeServingTargetUtilizationPercentageAnnotation = "autoscaling.knative.dev/targetUtilizationPercentage"
 )
 
 // The Knative Service trait allows to configure options when running the integration as Knative service instead of
@@ -60,6 +62,11 @@ type knativeServiceTrait struct {
 	//
 	// Refer to the Knative documentation for more information.
 	Target *int `property:"autoscaling-target" json:"autoscalingTarget,omitempty"`
+	// The percentage of the autoscaling target that is actually aimed by the autoscaler, between 1 and 100,
+	// so that Pods are scaled up before reaching the target (e.g. set `70` to scale up at 70% of the target).
+	//
+	// Refer to the Knative documentation for more information.
+	TargetUtilizationPercentage *int `property:"autoscaling-target-utilization-percentage" json:"autoscalingTargetUtilizationPercentage,omitempty"`
 	// The minimum number of Pods that should be running at any time for the integration. It's **zero** by default, meaning that
 	// the integration is scaled down to zero when not used for a configured amount of time.
 	//
@@ -70,6 +77,16 @@ type knativeServiceTrait struct {
 	//
 	// Refer to the Knative documentation for more information.
 	MaxScale *int `property:"max-scale" json:"maxScale,omitempty"`
+	// The maximum number of concurrent requests that can be handled by each Pod of the integration.
+	// It's **zero** by default, meaning that there is no limit, and `1` can be used to serialize the processing.
+	//
+	// Refer to the Knative documentation for more information.
+	ContainerConcurrency *int64 `property:"container-concurrency" json:"containerConcurrency,omitempty"`
+	// The maximum duration in seconds that a request is allowed to take before the response must be returned.
+	// Knative has its own default and maximum values that depend on the installation.
+	//
+	// Refer to the Knative documentation for more information.
+	TimeoutSeconds *int64 `property:"timeout-seconds" json:"timeoutSeconds,omitempty"`
 	// Automatically deploy the integration as Knative service when all conditions hold:
 	//
 	// * Integration is using the Knative profile
@@ -102,6 +119,10 @@ func (t *knativeServiceTrait) Configure(e *Environment) (bool, error) {
 		return false, nil
 	}
 
+	if err := t.validate(); err != nil {
+		return false, err
+	}
+
 	if e.IntegrationInPhase(v1.IntegrationPhaseRunning) {
 		condition := e.Integration.Status.GetCondition(v1.IntegrationConditionKnativeServiceAvailable)
 		return condition != nil && condition.Status == corev1.ConditionTrue, nil
@@ -167,6 +188,31 @@ func (t *knativeServiceTrait) Configure(e *Environment) (bool, error) {
 	return true, nil
 }
 
+func (t *knativeServiceTrait) validate() error {
+	if t.Target != nil && *t.Target <= 0 {
+		return fmt.Errorf("invalid autoscaling target: %d, must be greater than 0", *t.Target)
+	}
+	if t.TargetUtilizationPercentage != nil && (*t.TargetUtilizationPercentage < 1 || *t.TargetUtilizationPercentage > 100) {
+		return fmt.Errorf("invalid autoscaling target utilization percentage: %d, must be between 1 and 100", *t.TargetUtilizationPercentage)
+	}
+	if t.MinScale != nil && *t.MinScale < 0 {
+		return fmt.Errorf("invalid min scale: %d, must not be negative", *t.MinScale)
+	}
+	if t.MaxScale != nil && *t.MaxScale < 0 {
+		return fmt.Errorf("invalid max scale: %d, must not be negative", *t.MaxScale)
+	}
+	if t.MinScale != nil && t.MaxScale != nil && *t.MaxScale > 0 && *t.MinScale > *t.MaxScale {
+		return fmt.Errorf("invalid min scale: %d, must not be greater than max scale %d", *t.MinScale, *t.MaxScale)
+	}
+	if t.ContainerConcurrency != nil && *t.ContainerConcurrency < 0 {
+		return fmt.Errorf("invalid container concurrency: %d, must not be negative", *t.ContainerConcurrency)
+	}
+	if t.TimeoutSeconds != nil && *t.TimeoutSeconds <= 0 {
+		return fmt.Errorf("invalid timeout seconds: %d, must be greater than 0", *t.TimeoutSeconds)
+	}
+	return nil
+}
+
 func (t *knativeServiceTrait) Apply(e *Environment) error {
 	ksvc := t.getServiceFor(e)
 	maps := e.ComputeConfigMaps()
@@ -286,6 +332,9 @@ func (t *knativeServiceTrait) getServiceFor(e *Environment) *serving.Service {
 	if t.Target != nil {
 		annotations[knativeServingTargetAnnotation] = strconv.Itoa(*t.Target)
 	}
+	if t.TargetUtilizationPercentage != nil {
+		annotations[knativeServingTargetUtilizationPercentageAnnotation] = strconv.Itoa(*t.TargetUtilizationPercentage)
+	}
 	if t.MinScale != nil && *t.MinScale > 0 {
 		annotations[knativeServingMinScaleAnnotation] = strconv.Itoa(*t.MinScale)
 	}
@@ -315,6 +364,8 @@ func (t *knativeServiceTrait) getServiceFor(e *Environment) *serving.Service {
 						PodSpec: corev1.PodSpec{
 							ServiceAccountName: e.Integration.Spec.ServiceAccountName,
 						},
+						ContainerConcurrency: t.ContainerConcurrency,
+						TimeoutSeconds:       t.TimeoutSeconds,
 					},
 				},
 			},
@@ -317,3 +317,84 @@ func TestKnativeServiceWithResr(t *testing.T) {
 		return service.Name == KnativeServiceTestName
 	}))
 }
+
+func TestKnativeServiceAutoscalingAnnotations(t *testing.T) {
+	target, utilization, minScale, maxScale := 80, 70, 1, 5
+
+	trait := newKnativeServiceTrait().(*knativeServiceTrait)
+	trait.Class = "hpa.autoscaling.knative.dev"
+	trait.Metric = "cpu"
+	trait.Target = &target
+	trait.TargetUtilizationPercentage = &utilization
+	trait.MinScale = &minScale
+	trait.MaxScale = &maxScale
+
+	assert.Nil(t, trait.validate())
+
+	environment := Environment{
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      KnativeServiceTestName,
+				Namespace: KnativeServiceTestNamespace,
+			},
+		},
+	}
+
+	s := trait.getServiceFor(&environment)
+
+	assert.Equal(t, map[string]string{
+		"autoscaling.knative.dev/class":                       "hpa.autoscaling.knative.dev",
+		"autoscaling.knative.dev/metric":                      "cpu",
+		"autoscaling.knative.dev/target":                      "80",
+		"autoscaling.knative.dev/targetUtilizationPercentage": "70",
+		"autoscaling.knative.dev/minScale":                    "1",
+		"autoscaling.knative.dev/maxScale":                    "5",
+	}, s.Spec.Template.Annotations)
+}
+
+func TestKnativeServiceInvalidAutoscaling(t *testing.T) {
+	zero, negative, over, two, three := 0, -1, 101, 2, 3
+	zero64, negative64 := int64(0), int64(-1)
+
+	traits := []*knativeServiceTrait{
+		{Target: &zero},
+		{TargetUtilizationPercentage: &zero},
+		{TargetUtilizationPercentage: &over},
+		{MinScale: &negative},
+		{MaxScale: &negative},
+		{MinScale: &three, MaxScale: &two},
+		{ContainerConcurrency: &negative64},
+		{TimeoutSeconds: &zero64},
+	}
+
+	for _, trait := range traits {
+		assert.NotNil(t, trait.validate())
+	}
+
+	unbounded := knativeServiceTrait{MinScale: &three, MaxScale: &zero}
+	assert.Nil(t, unbounded.validate())
+}
+
+func TestKnativeServiceConcurrencyAndTimeout(t *testing.T) {
+	concurrency, timeout := int64(1), int64(900)
+
+	trait := newKnativeServiceTrait().(*knativeServiceTrait)
+	trait.ContainerConcurrency = &concurrency
+	trait.TimeoutSeconds = &timeout
+
+	assert.Nil(t, trait.validate())
+
+	environment := Environment{
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      KnativeServiceTestName,
+				Namespace: KnativeServiceTestNamespace,
+			},
+		},
+	}
+
+	s := trait.getServiceFor(&environment)
+
+	assert.Equal(t, &concurrency, s.Spec.Template.Spec.ContainerConcurrency)
+	assert.Equal(t, &timeout, s.Spec.Template.Spec.TimeoutSeconds)
+}
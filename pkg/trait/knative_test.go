@@ -352,6 +352,127 @@ func TestKnativePlatformHttpDepdencies(t *testing.T) {
 	}
 }
 
+func TestKnativeTriggerFilters(t *testing.T) {
+	source := v1.SourceSpec{
+		DataSpec: v1.DataSpec{
+			Name:    "source-event.groovy",
+			Content: `from('knative:event/event-source-1').log('${body}')`,
+		},
+		Language: v1.LanguageGroovy,
+	}
+
+	environment := NewFakeEnvironment(t, source)
+	environment.Integration.Spec.Traits["knative"] = test.TraitSpecFromMap(t, map[string]interface{}{
+		"enabled": true,
+		"filters": []string{"source=my-source", "subject=my=subject"},
+	})
+
+	c, err := NewFakeClient("ns")
+	assert.Nil(t, err)
+
+	tc := NewCatalog(context.TODO(), c)
+
+	err = tc.configure(&environment)
+	assert.Nil(t, err)
+
+	err = tc.apply(&environment)
+	assert.Nil(t, err)
+
+	triggers := 0
+	environment.Resources.VisitKnativeTrigger(func(trigger *eventing.Trigger) {
+		triggers++
+		assert.Equal(t, "default", trigger.Spec.Broker)
+		assert.Equal(t, eventing.TriggerFilterAttributes{
+			"type":    "event-source-1",
+			"source":  "my-source",
+			"subject": "my=subject",
+		}, trigger.Spec.Filter.Attributes)
+	})
+	assert.Equal(t, 1, triggers)
+}
+
+func TestKnativeInvalidTriggerFilters(t *testing.T) {
+	filters := [][]string{
+		{"source"},
+		{"Source=my-source"},
+		{"type=my-type"},
+		{"averyveryverylongattributename=value"},
+		{"source=my-source", "source=other-source"},
+	}
+
+	for _, f := range filters {
+		environment := NewFakeEnvironment(t, v1.SourceSpec{
+			DataSpec: v1.DataSpec{
+				Name:    "source-event.groovy",
+				Content: `from('knative:event/event-source-1').log('${body}')`,
+			},
+			Language: v1.LanguageGroovy,
+		})
+		environment.Integration.Spec.Traits["knative"] = test.TraitSpecFromMap(t, map[string]interface{}{
+			"enabled": true,
+			"filters": f,
+		})
+
+		tc := NewCatalog(context.TODO(), nil)
+
+		err := tc.configure(&environment)
+		assert.Nil(t, err)
+
+		_, err = tc.GetTrait("knative").Configure(&environment)
+		assert.NotNil(t, err, f)
+	}
+}
+
+func TestKnativeCloudEventsSpecVersion(t *testing.T) {
+	source := v1.SourceSpec{
+		DataSpec: v1.DataSpec{
+			Name:    "source-event.groovy",
+			Content: `from('knative:event/event-source-1').log('${body}')`,
+		},
+		Language: v1.LanguageGroovy,
+	}
+
+	versions := map[string]string{
+		"":    "1.0",
+		"0.3": "0.3",
+	}
+
+	for version, expected := range versions {
+		environment := NewFakeEnvironment(t, source)
+		environment.Integration.Spec.Traits["knative"] = test.TraitSpecFromMap(t, map[string]interface{}{
+			"enabled":                true,
+			"cloudEventsSpecVersion": version,
+		})
+
+		c, err := NewFakeClient("ns")
+		assert.Nil(t, err)
+
+		tc := NewCatalog(context.TODO(), c)
+
+		err = tc.configure(&environment)
+		assert.Nil(t, err)
+
+		err = tc.apply(&environment)
+		assert.Nil(t, err)
+
+		assert.Equal(t, expected, environment.ApplicationProperties["camel.component.knative.cloudEventsSpecVersion"])
+	}
+
+	environment := NewFakeEnvironment(t, source)
+	environment.Integration.Spec.Traits["knative"] = test.TraitSpecFromMap(t, map[string]interface{}{
+		"enabled":                true,
+		"cloudEventsSpecVersion": "2.0",
+	})
+
+	tc := NewCatalog(context.TODO(), nil)
+
+	err := tc.configure(&environment)
+	assert.Nil(t, err)
+
+	_, err = tc.GetTrait("knative").Configure(&environment)
+	assert.NotNil(t, err)
+}
+
 func NewFakeEnvironment(t *testing.T, source v1.SourceSpec) Environment {
 	catalog, err := camel.DefaultCatalog()
 	assert.Nil(t, err)
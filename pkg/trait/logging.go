@@ -0,0 +1,153 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util"
+)
+
+// The Logging trait configures the logging of the integration, so that logs can be shipped
+// to log aggregators without having to parse plain text messages.
+//
+// The JSON log format is only supported by the Quarkus runtime.
+//
+// It's disabled by default.
+//
+// +camel-k:trait=logging
+type loggingTrait struct {
+	BaseTrait `property:",squash"`
+	// The format of the log messages, either `text` or `json` (default `text`).
+	LogFormat string `property:"log-format" json:"logFormat,omitempty"`
+	// The level of the root logger, one of `OFF`, `FATAL`, `ERROR`, `WARN`, `INFO`, `DEBUG`, `TRACE` or `ALL`.
+	LogLevel string `property:"log-level" json:"logLevel,omitempty"`
+	// A list of logger levels, in the `logger-name=level` format, e.g. `org.apache.camel=DEBUG`,
+	// so that the verbosity of specific loggers can be changed independently of the root logger.
+	Levels []string `property:"levels" json:"levels,omitempty"`
+}
+
+const (
+	loggingTraitID = "logging"
+
+	loggingFormatText = "text"
+	loggingFormatJSON = "json"
+)
+
+var (
+	loggingFormats = []string{loggingFormatText, loggingFormatJSON}
+	loggingLevels  = []string{"OFF", "FATAL", "ERROR", "WARN", "INFO", "DEBUG", "TRACE", "ALL"}
+)
+
+func newLoggingTrait() Trait {
+	return &loggingTrait{
+		BaseTrait: NewBaseTrait(loggingTraitID, 1540),
+	}
+}
+
+func (t *loggingTrait) Configure(e *Environment) (bool, error) {
+	if t.Enabled == nil || !*t.Enabled {
+		return false, nil
+	}
+
+	if t.LogFormat != "" && !util.StringSliceExists(loggingFormats, t.LogFormat) {
+		return false, fmt.Errorf("unknown log format: %s. One of [%s] is expected", t.LogFormat, strings.Join(loggingFormats, ", "))
+	}
+	if t.LogLevel != "" && !util.StringSliceExists(loggingLevels, strings.ToUpper(t.LogLevel)) {
+		return false, fmt.Errorf("unknown log level: %s. One of [%s] is expected", t.LogLevel, strings.Join(loggingLevels, ", "))
+	}
+	if _, err := t.loggerLevels(); err != nil {
+		return false, err
+	}
+	if t.isJSON() && e.CamelCatalog.Runtime.Provider != v1.RuntimeProviderQuarkus {
+		return false, fmt.Errorf("the json log format is not supported by the %s runtime", e.CamelCatalog.Runtime.Provider)
+	}
+
+	return e.IntegrationInPhase(
+		v1.IntegrationPhaseInitialization,
+		v1.IntegrationPhaseDeploying,
+		v1.IntegrationPhaseRunning,
+	), nil
+}
+
+func (t *loggingTrait) Apply(e *Environment) error {
+	if e.IntegrationInPhase(v1.IntegrationPhaseInitialization) {
+		if t.isJSON() {
+			util.StringSliceUniqueAdd(&e.Integration.Status.Dependencies, "mvn:io.quarkus/quarkus-logging-json")
+		}
+		return nil
+	}
+
+	if e.ApplicationProperties == nil {
+		e.ApplicationProperties = make(map[string]string)
+	}
+
+	levels, err := t.loggerLevels()
+	if err != nil {
+		return err
+	}
+
+	// The application properties must be set before the container trait computes them
+	switch e.CamelCatalog.Runtime.Provider {
+	case v1.RuntimeProviderMain:
+		if t.LogLevel != "" {
+			e.ApplicationProperties["logging.level.root"] = strings.ToUpper(t.LogLevel)
+		}
+		for logger, level := range levels {
+			e.ApplicationProperties["logging.level."+logger] = level
+		}
+	case v1.RuntimeProviderQuarkus:
+		e.ApplicationProperties["quarkus.log.console.json"] = strconv.FormatBool(t.isJSON())
+		if t.LogLevel != "" {
+			e.ApplicationProperties["quarkus.log.level"] = strings.ToUpper(t.LogLevel)
+		}
+		for logger, level := range levels {
+			e.ApplicationProperties[fmt.Sprintf("quarkus.log.category.\"%s\".level", logger)] = level
+		}
+	default:
+		return fmt.Errorf("unsupported runtime: %s", e.CamelCatalog.Runtime.Provider)
+	}
+
+	return nil
+}
+
+func (t *loggingTrait) isJSON() bool {
+	return t.LogFormat == loggingFormatJSON
+}
+
+func (t *loggingTrait) loggerLevels() (map[string]string, error) {
+	levels := make(map[string]string, len(t.Levels))
+	for _, l := range t.Levels {
+		pair := strings.SplitN(l, "=", 2)
+		if len(pair) != 2 || pair[0] == "" || strings.ContainsAny(pair[0], " \"") {
+			return nil, fmt.Errorf("invalid logger level: %s, must be in the logger-name=level format", l)
+		}
+		level := strings.ToUpper(strings.TrimSpace(pair[1]))
+		if !util.StringSliceExists(loggingLevels, level) {
+			return nil, fmt.Errorf("unknown log level: %s for logger %s. One of [%s] is expected", pair[1], pair[0], strings.Join(loggingLevels, ", "))
+		}
+		if _, ok := levels[pair[0]]; ok {
+			return nil, fmt.Errorf("duplicate logger level: %s", pair[0])
+		}
+		levels[pair[0]] = level
+	}
+	return levels, nil
+}
@@ -0,0 +1,163 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/camel"
+)
+
+func TestConfigureLoggingTraitDoesSucceed(t *testing.T) {
+	loggingTrait, environment := createNominalLoggingTest(t, v1.RuntimeProviderQuarkus)
+	loggingTrait.LogFormat = "json"
+	loggingTrait.LogLevel = "debug"
+
+	configured, err := loggingTrait.Configure(environment)
+
+	assert.True(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestConfigureLoggingTraitWithInvalidOptionsFails(t *testing.T) {
+	loggingTrait, environment := createNominalLoggingTest(t, v1.RuntimeProviderQuarkus)
+	loggingTrait.LogFormat = "xml"
+	_, err := loggingTrait.Configure(environment)
+	assert.NotNil(t, err)
+
+	loggingTrait, environment = createNominalLoggingTest(t, v1.RuntimeProviderQuarkus)
+	loggingTrait.LogLevel = "VERBOSE"
+	_, err = loggingTrait.Configure(environment)
+	assert.NotNil(t, err)
+}
+
+func TestConfigureLoggingTraitWithInvalidLoggerLevelsFails(t *testing.T) {
+	for _, levels := range [][]string{
+		{"org.apache.camel"},
+		{"=DEBUG"},
+		{"org apache=DEBUG"},
+		{"org.apache.camel=VERBOSE"},
+		{"org.apache.camel=DEBUG", "org.apache.camel=INFO"},
+	} {
+		loggingTrait, environment := createNominalLoggingTest(t, v1.RuntimeProviderMain)
+		loggingTrait.Levels = levels
+
+		_, err := loggingTrait.Configure(environment)
+		assert.NotNil(t, err, levels)
+	}
+}
+
+func TestConfigureLoggingTraitWithJSONOnMainFails(t *testing.T) {
+	loggingTrait, environment := createNominalLoggingTest(t, v1.RuntimeProviderMain)
+	loggingTrait.LogFormat = "json"
+
+	_, err := loggingTrait.Configure(environment)
+	assert.NotNil(t, err)
+}
+
+func TestApplyLoggingTraitDependencies(t *testing.T) {
+	loggingTrait, environment := createNominalLoggingTest(t, v1.RuntimeProviderQuarkus)
+	loggingTrait.LogFormat = "json"
+	environment.Integration.Status.Phase = v1.IntegrationPhaseInitialization
+
+	err := loggingTrait.Apply(environment)
+
+	assert.Nil(t, err)
+	assert.Contains(t, environment.Integration.Status.Dependencies, "mvn:io.quarkus/quarkus-logging-json")
+}
+
+func TestApplyLoggingTraitOnQuarkusDoesSucceed(t *testing.T) {
+	loggingTrait, environment := createNominalLoggingTest(t, v1.RuntimeProviderQuarkus)
+	loggingTrait.LogFormat = "json"
+	loggingTrait.LogLevel = "debug"
+
+	err := loggingTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "true", environment.ApplicationProperties["quarkus.log.console.json"])
+	assert.Equal(t, "DEBUG", environment.ApplicationProperties["quarkus.log.level"])
+}
+
+func TestApplyLoggingTraitWithLoggerLevels(t *testing.T) {
+	loggingTrait, environment := createNominalLoggingTest(t, v1.RuntimeProviderMain)
+	loggingTrait.Levels = []string{"org.apache.camel=debug", "org.apache.camel.component.kafka=TRACE"}
+
+	err := loggingTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "DEBUG", environment.ApplicationProperties["logging.level.org.apache.camel"])
+	assert.Equal(t, "TRACE", environment.ApplicationProperties["logging.level.org.apache.camel.component.kafka"])
+
+	loggingTrait, environment = createNominalLoggingTest(t, v1.RuntimeProviderQuarkus)
+	loggingTrait.Levels = []string{"org.apache.camel=DEBUG"}
+
+	err = loggingTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "DEBUG", environment.ApplicationProperties[`quarkus.log.category."org.apache.camel".level`])
+}
+
+func TestApplyLoggingTraitOnMainDoesSucceed(t *testing.T) {
+	loggingTrait, environment := createNominalLoggingTest(t, v1.RuntimeProviderMain)
+	loggingTrait.LogFormat = "text"
+	loggingTrait.LogLevel = "WARN"
+
+	err := loggingTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "WARN", environment.ApplicationProperties["logging.level.root"])
+	assert.NotContains(t, environment.ApplicationProperties, "quarkus.log.console.json")
+}
+
+func createNominalLoggingTest(t *testing.T, provider v1.RuntimeProvider) (*loggingTrait, *Environment) {
+	trait := newLoggingTrait().(*loggingTrait)
+	enabled := true
+	trait.Enabled = &enabled
+
+	var catalog *camel.RuntimeCatalog
+	var err error
+	switch provider {
+	case v1.RuntimeProviderMain:
+		catalog, err = camel.DefaultCatalog()
+	case v1.RuntimeProviderQuarkus:
+		catalog, err = camel.QuarkusCatalog()
+	}
+	assert.Nil(t, err)
+
+	environment := &Environment{
+		Catalog:      NewCatalog(context.TODO(), nil),
+		CamelCatalog: catalog,
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "integration-name",
+				Namespace: "integration-namespace",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+		},
+	}
+
+	return trait, environment
+}
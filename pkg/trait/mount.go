@@ -0,0 +1,244 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+// The Mount trait can be used to mount volumes into the integration container, e.g. to provide files
+// to file based Camel components.
+//
+// Each volume is described as `name:source:path[:type]`, where `source` is the name of the referenced resource,
+// `path` the absolute path where the volume is mounted in the integration container, and `type` one of
+// `pvc` (default), `emptyDir`, `configmap` or `secret`. The source is ignored for `emptyDir` volumes.
+// A volume cannot reuse the name or the mount path of a volume managed by another trait, with the exception of
+// the stream caching spool directory, which is then backed by the mounted volume.
+//
+// +camel-k:trait=mount
+type mountTrait struct {
+	BaseTrait `property:",squash"`
+	// A list of volumes to mount into the integration container, e.g. `data:my-claim:/var/data`
+	// or `cache::/var/cache:emptyDir`.
+	Volumes []string `property:"volumes" json:"volumes,omitempty"`
+}
+
+const (
+	mountTypePVC       = "pvc"
+	mountTypeEmptyDir  = "emptyDir"
+	mountTypeConfigMap = "configmap"
+	mountTypeSecret    = "secret"
+)
+
+type mountVolume struct {
+	name       string
+	source     string
+	path       string
+	volumeType string
+}
+
+func newMountTrait() Trait {
+	return &mountTrait{
+		BaseTrait: NewBaseTrait("mount", 1610),
+	}
+}
+
+func (t *mountTrait) Configure(e *Environment) (bool, error) {
+	if t.Enabled != nil && !*t.Enabled {
+		return false, nil
+	}
+
+	if len(t.Volumes) == 0 {
+		return false, nil
+	}
+
+	volumes, err := t.parseVolumes()
+	if err != nil {
+		return false, err
+	}
+
+	if !e.IntegrationInPhase(v1.IntegrationPhaseDeploying, v1.IntegrationPhaseRunning) {
+		return false, nil
+	}
+
+	if err := t.checkCollisions(e, volumes); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (t *mountTrait) Apply(e *Environment) error {
+	volumes, err := t.parseVolumes()
+	if err != nil {
+		return err
+	}
+
+	container := e.getIntegrationContainer()
+	if container == nil {
+		return nil
+	}
+
+	for _, v := range volumes {
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      v.name,
+			MountPath: v.path,
+		})
+	}
+	e.Resources.VisitPodSpec(func(spec *corev1.PodSpec) {
+		for _, v := range volumes {
+			spec.Volumes = append(spec.Volumes, corev1.Volume{
+				Name:         v.name,
+				VolumeSource: v.volumeSource(),
+			})
+		}
+	})
+
+	return nil
+}
+
+func (t *mountTrait) parseVolumes() ([]mountVolume, error) {
+	volumes := make([]mountVolume, 0, len(t.Volumes))
+	names := make(map[string]bool)
+	paths := make(map[string]bool)
+
+	for _, config := range t.Volumes {
+		parts := strings.Split(config, ":")
+		if len(parts) != 3 && len(parts) != 4 {
+			return nil, fmt.Errorf("invalid volume: %s, must be in the name:source:path[:type] format", config)
+		}
+
+		v := mountVolume{
+			name:       parts[0],
+			source:     parts[1],
+			path:       parts[2],
+			volumeType: mountTypePVC,
+		}
+		if len(parts) == 4 {
+			v.volumeType = parts[3]
+		}
+
+		if v.name == "" {
+			return nil, fmt.Errorf("invalid volume: %s, the name is required", config)
+		}
+		if !path.IsAbs(v.path) {
+			return nil, fmt.Errorf("invalid volume: %s, the mount path must be absolute", config)
+		}
+
+		switch v.volumeType {
+		case mountTypePVC, mountTypeConfigMap, mountTypeSecret:
+			if v.source == "" {
+				return nil, fmt.Errorf("invalid volume: %s, the source is required", config)
+			}
+		case mountTypeEmptyDir:
+		default:
+			return nil, fmt.Errorf("invalid volume: %s, unsupported type %s, must be one of %s, %s, %s or %s",
+				config, v.volumeType, mountTypePVC, mountTypeEmptyDir, mountTypeConfigMap, mountTypeSecret)
+		}
+
+		if names[v.name] {
+			return nil, fmt.Errorf("duplicate volume name: %s", v.name)
+		}
+		names[v.name] = true
+
+		mountPath := path.Clean(v.path)
+		if paths[mountPath] {
+			return nil, fmt.Errorf("duplicate volume mount path: %s", v.path)
+		}
+		paths[mountPath] = true
+
+		volumes = append(volumes, v)
+	}
+
+	return volumes, nil
+}
+
+// checkCollisions verifies the volumes do not collide with the ones managed by other traits
+func (t *mountTrait) checkCollisions(e *Environment, volumes []mountVolume) error {
+	reserved := []corev1.VolumeMount{
+		{Name: "application-properties", MountPath: ConfPath},
+		{Name: "user-properties", MountPath: ConfdPath},
+	}
+	vols := make([]corev1.Volume, 0)
+	e.ConfigureVolumesAndMounts(&vols, &reserved)
+
+	if ct, ok := e.Catalog.GetTrait("camel").(*camelTrait); ok && ct.StreamCaching != nil && *ct.StreamCaching &&
+		ct.StreamCachingSpoolDirectory != "" {
+		// Mounting a volume at the spool directory is supported, and replaces the spool volume
+		spoolMounted := false
+		for _, v := range volumes {
+			if path.Clean(v.path) == path.Clean(ct.StreamCachingSpoolDirectory) {
+				spoolMounted = true
+			}
+		}
+		if !spoolMounted {
+			reserved = append(reserved, corev1.VolumeMount{
+				Name:      streamCachingSpoolVolumeName,
+				MountPath: ct.StreamCachingSpoolDirectory,
+			})
+		}
+	}
+
+	for _, v := range volumes {
+		for _, m := range reserved {
+			if v.name == m.Name {
+				return fmt.Errorf("volume name collides with a volume managed by another trait: %s", v.name)
+			}
+			if path.Clean(v.path) == path.Clean(m.MountPath) {
+				return fmt.Errorf("volume mount path collides with a volume managed by another trait: %s", v.path)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (v mountVolume) volumeSource() corev1.VolumeSource {
+	switch v.volumeType {
+	case mountTypeEmptyDir:
+		return corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		}
+	case mountTypeConfigMap:
+		return corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: v.source,
+				},
+			},
+		}
+	case mountTypeSecret:
+		return corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: v.source,
+			},
+		}
+	default:
+		return corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: v.source,
+			},
+		}
+	}
+}
@@ -0,0 +1,199 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+)
+
+func TestConfigureMountTraitDoesSucceed(t *testing.T) {
+	mountTrait, environment, _ := createNominalMountTest()
+	configured, err := mountTrait.Configure(environment)
+
+	assert.True(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestConfigureMountTraitWithoutVolumesDoesNotSucceed(t *testing.T) {
+	mountTrait, environment, _ := createNominalMountTest()
+	mountTrait.Volumes = nil
+	configured, err := mountTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestConfigureMountTraitWithRelativePathFails(t *testing.T) {
+	mountTrait, environment, _ := createNominalMountTest()
+	mountTrait.Volumes = []string{"data:my-claim:var/data"}
+	configured, err := mountTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestConfigureMountTraitWithDuplicatePathFails(t *testing.T) {
+	mountTrait, environment, _ := createNominalMountTest()
+	mountTrait.Volumes = []string{"data:my-claim:/var/data", "other:my-config:/var/data/:configmap"}
+	configured, err := mountTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestConfigureMountTraitWithInvalidTypeFails(t *testing.T) {
+	mountTrait, environment, _ := createNominalMountTest()
+	mountTrait.Volumes = []string{"data:my-claim:/var/data:hostPath"}
+	configured, err := mountTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestConfigureMountTraitWithoutSourceFails(t *testing.T) {
+	mountTrait, environment, _ := createNominalMountTest()
+	mountTrait.Volumes = []string{"data::/var/data"}
+	configured, err := mountTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestConfigureMountTraitWithConfigMapCollisionFails(t *testing.T) {
+	mountTrait, environment, _ := createNominalMountTest()
+	environment.Integration.Spec.Configuration = []v1.ConfigurationSpec{
+		{Type: "configmap", Value: "my-cm"},
+	}
+	mountTrait.Volumes = []string{"my-cm::/var/cache:emptyDir"}
+	configured, err := mountTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+
+	mountTrait.Volumes = []string{"cache::/etc/camel/conf.d/_configmaps/my-cm/:emptyDir"}
+	configured, err = mountTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestConfigureMountTraitWithPropertiesCollisionFails(t *testing.T) {
+	mountTrait, environment, _ := createNominalMountTest()
+	mountTrait.Volumes = []string{"conf::" + ConfPath + ":emptyDir"}
+	configured, err := mountTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestConfigureMountTraitWithStreamCachingCollisionFails(t *testing.T) {
+	mountTrait, environment, _ := createNominalMountTest()
+	enabled := true
+	ct := environment.Catalog.GetTrait("camel").(*camelTrait)
+	ct.StreamCaching = &enabled
+	ct.StreamCachingSpoolDirectory = "/tmp/spool"
+
+	mountTrait.Volumes = []string{streamCachingSpoolVolumeName + "::/var/cache:emptyDir"}
+	configured, err := mountTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+
+	// Mounting a volume at the spool directory replaces the spool volume
+	mountTrait.Volumes = []string{streamCachingSpoolVolumeName + ":my-claim:/tmp/spool"}
+	configured, err = mountTrait.Configure(environment)
+
+	assert.True(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestApplyMountTraitDoesSucceed(t *testing.T) {
+	mountTrait, environment, deployment := createNominalMountTest()
+
+	err := mountTrait.Apply(environment)
+
+	assert.Nil(t, err)
+
+	spec := deployment.Spec.Template.Spec
+	assert.Len(t, spec.Volumes, 4)
+	assert.Equal(t, "data", spec.Volumes[0].Name)
+	assert.Equal(t, "my-claim", spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+	assert.NotNil(t, spec.Volumes[1].EmptyDir)
+	assert.Equal(t, "my-config", spec.Volumes[2].ConfigMap.Name)
+	assert.Equal(t, "my-secret", spec.Volumes[3].Secret.SecretName)
+
+	mounts := spec.Containers[0].VolumeMounts
+	assert.Len(t, mounts, 4)
+	assert.Equal(t, corev1.VolumeMount{Name: "data", MountPath: "/var/data"}, mounts[0])
+	assert.Equal(t, corev1.VolumeMount{Name: "cache", MountPath: "/var/cache"}, mounts[1])
+	assert.Equal(t, corev1.VolumeMount{Name: "config", MountPath: "/etc/config"}, mounts[2])
+	assert.Equal(t, corev1.VolumeMount{Name: "keys", MountPath: "/etc/keys"}, mounts[3])
+
+	assert.Empty(t, spec.Containers[1].VolumeMounts)
+}
+
+func createNominalMountTest() (*mountTrait, *Environment, *appsv1.Deployment) {
+	trait := newMountTrait().(*mountTrait)
+	trait.Volumes = []string{
+		"data:my-claim:/var/data",
+		"cache::/var/cache:emptyDir",
+		"config:my-config:/etc/config:configmap",
+		"keys:my-secret:/etc/keys:secret",
+	}
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "integration-name",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: defaultContainerName},
+						{Name: "log-shipper"},
+					},
+				},
+			},
+		},
+	}
+
+	environment := &Environment{
+		Catalog: NewCatalog(context.TODO(), nil),
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "integration-name",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+		},
+		Resources: kubernetes.NewCollection(deployment),
+	}
+
+	return trait, environment, deployment
+}
@@ -0,0 +1,214 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util"
+	"github.com/apache/camel-k/pkg/util/camel"
+	"github.com/apache/camel-k/pkg/util/envvar"
+)
+
+// The OpenTelemetry trait enables the distributed tracing of the integration with the Camel OpenTelemetry component,
+// and configures the OTLP exporter to send the traces to an OpenTelemetry collector.
+//
+// The trait requires a Camel catalog that provides the Camel OpenTelemetry component, along with the OTLP exporter
+// and the OpenTelemetry SDK autoconfigure module as its dependencies, so that their versions are managed by the runtime.
+// The OTLP exporter is configured with the standard `OTEL_*` environment variables, read by the autoconfigure module.
+//
+// It's disabled by default.
+//
+// +camel-k:trait=otel
+type otelTrait struct {
+	BaseTrait `property:",squash"`
+	// The URL of the OTLP endpoint the traces are exported to, e.g. `http://otel-collector.observability:4317`.
+	Endpoint string `property:"endpoint" json:"endpoint,omitempty"`
+	// The name of the service the traces are reported for (default to the integration name).
+	ServiceName string `property:"service-name" json:"serviceName,omitempty"`
+	// The sampler used to decide whether a trace is recorded, one of `always_on`, `always_off`, `traceidratio`,
+	// `parentbased_always_on`, `parentbased_always_off` or `parentbased_traceidratio` (default `parentbased_always_on`).
+	Sampler string `property:"sampler" json:"sampler,omitempty"`
+	// The ratio of traces that are recorded, between `0` and `1`, applicable to the ratio based samplers.
+	SamplerRatio string `property:"sampler-ratio" json:"samplerRatio,omitempty"`
+}
+
+const (
+	otelTraitID = "otel"
+
+	otelExporterEndpointEnvVar = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	otelServiceNameEnvVar      = "OTEL_SERVICE_NAME"
+	otelTracesExporterEnvVar   = "OTEL_TRACES_EXPORTER"
+	otelTracesSamplerEnvVar    = "OTEL_TRACES_SAMPLER"
+	otelTracesSamplerArgEnvVar = "OTEL_TRACES_SAMPLER_ARG"
+)
+
+// The artifacts the Camel OpenTelemetry component must depend on, for the OTLP exporter to be configured
+// from the environment variables
+var otelRequiredDependencies = []string{
+	"opentelemetry-exporter-otlp",
+	"opentelemetry-sdk-extension-autoconfigure",
+}
+
+var otelSamplers = []string{
+	"always_on",
+	"always_off",
+	"traceidratio",
+	"parentbased_always_on",
+	"parentbased_always_off",
+	"parentbased_traceidratio",
+}
+
+func newOtelTrait() Trait {
+	return &otelTrait{
+		BaseTrait: NewBaseTrait(otelTraitID, 1570),
+	}
+}
+
+func (t *otelTrait) Configure(e *Environment) (bool, error) {
+	if t.Enabled == nil || !*t.Enabled {
+		return false, nil
+	}
+
+	if t.Endpoint != "" {
+		if err := validateOtelEndpoint(t.Endpoint); err != nil {
+			return false, err
+		}
+	}
+
+	if t.Sampler != "" && !util.StringSliceExists(otelSamplers, t.Sampler) {
+		return false, fmt.Errorf("unknown OpenTelemetry sampler: %s. One of [%s] is expected", t.Sampler, strings.Join(otelSamplers, ", "))
+	}
+
+	if t.SamplerRatio != "" {
+		if !strings.HasSuffix(t.Sampler, "traceidratio") {
+			return false, fmt.Errorf("the OpenTelemetry sampler-ratio option requires a ratio based sampler")
+		}
+		ratio, err := strconv.ParseFloat(t.SamplerRatio, 64)
+		if err != nil || ratio < 0 || ratio > 1 {
+			return false, fmt.Errorf("invalid OpenTelemetry sampler ratio: %s, must be between 0 and 1", t.SamplerRatio)
+		}
+	}
+
+	if e.CamelCatalog != nil {
+		if _, err := otelArtifact(e.CamelCatalog); err != nil {
+			return false, err
+		}
+	}
+
+	return e.IntegrationInPhase(
+		v1.IntegrationPhaseInitialization,
+		v1.IntegrationPhaseDeploying,
+		v1.IntegrationPhaseRunning,
+	), nil
+}
+
+func (t *otelTrait) Apply(e *Environment) error {
+	if e.IntegrationInPhase(v1.IntegrationPhaseInitialization) {
+		artifact, err := otelArtifact(e.CamelCatalog)
+		if err != nil {
+			return err
+		}
+		// The exporter and autoconfigure dependencies are added from the catalog when the kit is built
+		util.StringSliceUniqueAdd(&e.Integration.Status.Dependencies, fmt.Sprintf("mvn:%s/%s", artifact.GroupID, artifact.ArtifactID))
+
+		// sort the dependencies to get always the same list if they don't change
+		sort.Strings(e.Integration.Status.Dependencies)
+		return nil
+	}
+
+	if e.ApplicationProperties == nil {
+		e.ApplicationProperties = make(map[string]string)
+	}
+
+	// The application properties and environment variables must be set before the container trait computes them
+	e.ApplicationProperties["camel.opentelemetry.enabled"] = True
+
+	serviceName := t.ServiceName
+	if serviceName == "" {
+		serviceName = e.Integration.Name
+	}
+	envvar.SetVal(&e.EnvVars, otelServiceNameEnvVar, serviceName)
+	envvar.SetVal(&e.EnvVars, otelTracesExporterEnvVar, "otlp")
+	if t.Endpoint != "" {
+		envvar.SetVal(&e.EnvVars, otelExporterEndpointEnvVar, t.Endpoint)
+	}
+	if t.Sampler != "" {
+		envvar.SetVal(&e.EnvVars, otelTracesSamplerEnvVar, t.Sampler)
+	}
+	if t.SamplerRatio != "" {
+		envvar.SetVal(&e.EnvVars, otelTracesSamplerArgEnvVar, t.SamplerRatio)
+	}
+
+	return nil
+}
+
+// otelArtifact returns the Camel OpenTelemetry artifact of the catalog, checking it provides
+// the dependencies required to export the traces
+func otelArtifact(catalog *camel.RuntimeCatalog) (*v1.CamelArtifact, error) {
+	var artifactID string
+	switch catalog.Runtime.Provider {
+	case v1.RuntimeProviderMain:
+		artifactID = "camel-opentelemetry"
+	case v1.RuntimeProviderQuarkus:
+		artifactID = "camel-quarkus-opentelemetry"
+	default:
+		return nil, fmt.Errorf("unsupported runtime: %s", catalog.Runtime.Provider)
+	}
+
+	artifact, ok := catalog.Artifacts[artifactID]
+	if !ok {
+		return nil, fmt.Errorf("the OpenTelemetry trait requires the %s artifact, that is not available in the Camel catalog for runtime version %s",
+			artifactID, catalog.Runtime.Version)
+	}
+
+	for _, required := range otelRequiredDependencies {
+		found := false
+		for _, d := range artifact.Dependencies {
+			if d.ArtifactID == required {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("the OpenTelemetry trait requires the %s artifact to depend on %s, in the Camel catalog for runtime version %s",
+				artifactID, required, catalog.Runtime.Version)
+		}
+	}
+
+	return &artifact, nil
+}
+
+func validateOtelEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid OpenTelemetry endpoint: %s, %v", endpoint, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("invalid OpenTelemetry endpoint: %s, the scheme must be either http or https", endpoint)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("invalid OpenTelemetry endpoint: %s, the host is missing", endpoint)
+	}
+	return nil
+}
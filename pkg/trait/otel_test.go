@@ -0,0 +1,185 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/camel"
+	"github.com/apache/camel-k/pkg/util/envvar"
+)
+
+func TestConfigureOtelTraitDoesSucceed(t *testing.T) {
+	otelTrait, environment := createNominalOtelTest(t, v1.RuntimeProviderMain)
+	otelTrait.Endpoint = "http://otel-collector.observability:4317"
+	otelTrait.Sampler = "parentbased_traceidratio"
+	otelTrait.SamplerRatio = "0.25"
+
+	configured, err := otelTrait.Configure(environment)
+
+	assert.True(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestConfigureOtelTraitWithInvalidEndpointFails(t *testing.T) {
+	for _, endpoint := range []string{"otel-collector:4317", "ftp://otel-collector:4317", "http://", "http://%zz"} {
+		otelTrait, environment := createNominalOtelTest(t, v1.RuntimeProviderMain)
+		otelTrait.Endpoint = endpoint
+
+		_, err := otelTrait.Configure(environment)
+		assert.NotNil(t, err, endpoint)
+	}
+}
+
+func TestConfigureOtelTraitWithInvalidSamplerFails(t *testing.T) {
+	otelTrait, environment := createNominalOtelTest(t, v1.RuntimeProviderMain)
+	otelTrait.Sampler = "probabilistic"
+	_, err := otelTrait.Configure(environment)
+	assert.NotNil(t, err)
+
+	otelTrait, environment = createNominalOtelTest(t, v1.RuntimeProviderMain)
+	otelTrait.SamplerRatio = "0.5"
+	_, err = otelTrait.Configure(environment)
+	assert.NotNil(t, err)
+
+	otelTrait, environment = createNominalOtelTest(t, v1.RuntimeProviderMain)
+	otelTrait.Sampler = "traceidratio"
+	otelTrait.SamplerRatio = "2"
+	_, err = otelTrait.Configure(environment)
+	assert.NotNil(t, err)
+}
+
+func TestApplyOtelTraitDependencies(t *testing.T) {
+	otelTrait, environment := createNominalOtelTest(t, v1.RuntimeProviderQuarkus)
+	environment.Integration.Status.Phase = v1.IntegrationPhaseInitialization
+
+	err := otelTrait.Apply(environment)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"mvn:org.apache.camel.quarkus/camel-quarkus-opentelemetry"}, environment.Integration.Status.Dependencies)
+}
+
+func TestConfigureOtelTraitWithoutCatalogArtifactFails(t *testing.T) {
+	for _, provider := range []v1.RuntimeProvider{v1.RuntimeProviderMain, v1.RuntimeProviderQuarkus} {
+		otelTrait, environment := createNominalOtelTest(t, provider)
+		for id := range environment.CamelCatalog.Artifacts {
+			if strings.HasSuffix(id, "-opentelemetry") {
+				delete(environment.CamelCatalog.Artifacts, id)
+			}
+		}
+
+		_, err := otelTrait.Configure(environment)
+		assert.NotNil(t, err, provider)
+	}
+}
+
+func TestConfigureOtelTraitWithoutAutoconfigureDependencyFails(t *testing.T) {
+	otelTrait, environment := createNominalOtelTest(t, v1.RuntimeProviderMain)
+	artifact := environment.CamelCatalog.Artifacts["camel-opentelemetry"]
+	artifact.Dependencies = artifact.Dependencies[:1]
+	environment.CamelCatalog.Artifacts["camel-opentelemetry"] = artifact
+
+	_, err := otelTrait.Configure(environment)
+	assert.NotNil(t, err)
+}
+
+func TestApplyOtelTraitDoesSucceed(t *testing.T) {
+	otelTrait, environment := createNominalOtelTest(t, v1.RuntimeProviderMain)
+	otelTrait.Endpoint = "http://otel-collector.observability:4317"
+	otelTrait.Sampler = "traceidratio"
+	otelTrait.SamplerRatio = "0.1"
+
+	err := otelTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "true", environment.ApplicationProperties["camel.opentelemetry.enabled"])
+	assert.Equal(t, "integration-name", envvar.Get(environment.EnvVars, otelServiceNameEnvVar).Value)
+	assert.Equal(t, "otlp", envvar.Get(environment.EnvVars, otelTracesExporterEnvVar).Value)
+	assert.Equal(t, "http://otel-collector.observability:4317", envvar.Get(environment.EnvVars, otelExporterEndpointEnvVar).Value)
+	assert.Equal(t, "traceidratio", envvar.Get(environment.EnvVars, otelTracesSamplerEnvVar).Value)
+	assert.Equal(t, "0.1", envvar.Get(environment.EnvVars, otelTracesSamplerArgEnvVar).Value)
+}
+
+func TestApplyOtelTraitWithServiceName(t *testing.T) {
+	otelTrait, environment := createNominalOtelTest(t, v1.RuntimeProviderMain)
+	otelTrait.ServiceName = "orders"
+
+	err := otelTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "orders", envvar.Get(environment.EnvVars, otelServiceNameEnvVar).Value)
+	assert.Nil(t, envvar.Get(environment.EnvVars, otelExporterEndpointEnvVar))
+	assert.Nil(t, envvar.Get(environment.EnvVars, otelTracesSamplerEnvVar))
+}
+
+func createNominalOtelTest(t *testing.T, provider v1.RuntimeProvider) (*otelTrait, *Environment) {
+	trait := newOtelTrait().(*otelTrait)
+	enabled := true
+	trait.Enabled = &enabled
+
+	var catalog *camel.RuntimeCatalog
+	var err error
+	switch provider {
+	case v1.RuntimeProviderMain:
+		catalog, err = camel.DefaultCatalog()
+	case v1.RuntimeProviderQuarkus:
+		catalog, err = camel.QuarkusCatalog()
+	}
+	assert.Nil(t, err)
+
+	// The shipped catalogs do not provide the OpenTelemetry component yet
+	groupID, artifactID := "org.apache.camel", "camel-opentelemetry"
+	if provider == v1.RuntimeProviderQuarkus {
+		groupID, artifactID = "org.apache.camel.quarkus", "camel-quarkus-opentelemetry"
+	}
+	artifact := v1.CamelArtifact{}
+	artifact.GroupID = groupID
+	artifact.ArtifactID = artifactID
+	for _, d := range otelRequiredDependencies {
+		dependency := v1.CamelArtifactDependency{}
+		dependency.GroupID = "io.opentelemetry"
+		dependency.ArtifactID = d
+		artifact.Dependencies = append(artifact.Dependencies, dependency)
+	}
+	catalog.Artifacts[artifactID] = artifact
+
+	environment := &Environment{
+		Catalog:      NewCatalog(context.TODO(), nil),
+		CamelCatalog: catalog,
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "integration-name",
+				Namespace: "integration-namespace",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+		},
+		EnvVars: make([]corev1.EnvVar, 0),
+	}
+
+	return trait, environment
+}
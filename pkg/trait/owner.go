@@ -18,7 +18,10 @@ limitations under the License.
 package trait
 
 import (
+	"strings"
+
 	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/api/batch/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	serving "knative.dev/serving/pkg/apis/serving/v1"
 
@@ -31,9 +34,11 @@ import (
 // +camel-k:trait=owner
 type ownerTrait struct {
 	BaseTrait `property:",squash"`
-	// The set of annotations to be transferred
+	// The set of annotations to be transferred. An entry ending with `*` matches all the annotations
+	// starting with the given prefix, e.g. `billing.mycompany.com/*`.
 	TargetAnnotations []string `property:"target-annotations" json:"targetAnnotations,omitempty"`
-	// The set of labels to be transferred
+	// The set of labels to be transferred. An entry ending with `*` matches all the labels
+	// starting with the given prefix, e.g. `billing.mycompany.com/*`.
 	TargetLabels []string `property:"target-labels" json:"targetLabels,omitempty"`
 }
 
@@ -59,23 +64,8 @@ func (t *ownerTrait) Apply(e *Environment) error {
 	controller := true
 	blockOwnerDeletion := true
 
-	targetLabels := make(map[string]string)
-	if e.Integration.Labels != nil {
-		for _, k := range t.TargetLabels {
-			if v, ok := e.Integration.Labels[k]; ok {
-				targetLabels[k] = v
-			}
-		}
-	}
-
-	targetAnnotations := make(map[string]string)
-	if e.Integration.Annotations != nil {
-		for _, k := range t.TargetAnnotations {
-			if v, ok := e.Integration.Annotations[k]; ok {
-				targetAnnotations[k] = v
-			}
-		}
-	}
+	targetLabels := filterTargets(e.Integration.Labels, t.TargetLabels)
+	targetAnnotations := filterTargets(e.Integration.Annotations, t.TargetAnnotations)
 
 	e.Resources.VisitMetaObject(func(res metav1.Object) {
 		references := []metav1.OwnerReference{
@@ -102,6 +92,11 @@ func (t *ownerTrait) Apply(e *Environment) error {
 		t.propagateLabelAndAnnotations(&service.Spec.ConfigurationSpec.Template, targetLabels, targetAnnotations)
 	})
 
+	e.Resources.VisitCronJob(func(cron *v1beta1.CronJob) {
+		t.propagateLabelAndAnnotations(&cron.Spec.JobTemplate, targetLabels, targetAnnotations)
+		t.propagateLabelAndAnnotations(&cron.Spec.JobTemplate.Spec.Template, targetLabels, targetAnnotations)
+	})
+
 	return nil
 }
 
@@ -110,6 +105,21 @@ func (t *ownerTrait) IsPlatformTrait() bool {
 	return true
 }
 
+// filterTargets returns the entries whose key is one of the targets, or starts with
+// the prefix of a target ending with `*`
+func filterTargets(values map[string]string, targets []string) map[string]string {
+	filtered := make(map[string]string)
+	for k, v := range values {
+		for _, target := range targets {
+			if k == target || (strings.HasSuffix(target, "*") && strings.HasPrefix(k, strings.TrimSuffix(target, "*"))) {
+				filtered[k] = v
+				break
+			}
+		}
+	}
+	return filtered
+}
+
 func (t *ownerTrait) propagateLabelAndAnnotations(res metav1.Object, targetLabels map[string]string, targetAnnotations map[string]string) {
 	// Transfer annotations
 	annotations := res.GetAnnotations()
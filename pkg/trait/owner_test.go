@@ -23,9 +23,12 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/api/batch/v1beta1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
 	"github.com/apache/camel-k/pkg/util/test"
 )
 
@@ -93,3 +96,56 @@ func ValidateLabelsAndAnnotations(t *testing.T, res metav1.Object) {
 	assert.Contains(t, res.GetAnnotations(), "com.mycompany/myannotation2")
 	assert.Equal(t, "myannotation2", res.GetAnnotations()["com.mycompany/myannotation2"])
 }
+
+func TestOwnerWithTargetPrefixes(t *testing.T) {
+	trait := newOwnerTrait().(*ownerTrait)
+	trait.TargetLabels = []string{"billing.mycompany.com/*"}
+	trait.TargetAnnotations = []string{"billing.mycompany.com/*", "com.mycompany/myannotation1"}
+
+	env := &Environment{
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "ns",
+				Labels: map[string]string{
+					"billing.mycompany.com/team": "orders",
+					"com.mycompany/mylabel1":     "myvalue1",
+				},
+				Annotations: map[string]string{
+					"billing.mycompany.com/cost-center": "cc-42",
+					"com.mycompany/myannotation1":       "myannotation1",
+					"com.mycompany/myannotation2":       "myannotation2",
+				},
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+		},
+		Resources: kubernetes.NewCollection(
+			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "test"}},
+			&v1beta1.CronJob{ObjectMeta: metav1.ObjectMeta{Name: "test"}},
+		),
+	}
+
+	enabled, err := trait.Configure(env)
+	assert.Nil(t, err)
+	assert.True(t, enabled)
+	assert.Nil(t, trait.Apply(env))
+
+	validate := func(res metav1.Object) {
+		assert.Equal(t, map[string]string{
+			"billing.mycompany.com/team": "orders",
+		}, res.GetLabels())
+		assert.Equal(t, map[string]string{
+			"billing.mycompany.com/cost-center": "cc-42",
+			"com.mycompany/myannotation1":       "myannotation1",
+		}, res.GetAnnotations())
+	}
+
+	env.Resources.VisitMetaObject(validate)
+
+	cron := env.Resources.GetCronJob(func(*v1beta1.CronJob) bool { return true })
+	assert.NotNil(t, cron)
+	validate(&cron.Spec.JobTemplate)
+	validate(&cron.Spec.JobTemplate.Spec.Template)
+}
@@ -0,0 +1,114 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+
+	"k8s.io/api/policy/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+// The PDB trait allows to configure the PodDisruptionBudget resource for the Integration pods.
+//
+// Exactly one of `min-available` and `max-unavailable` must be set.
+//
+// It's disabled by default.
+//
+// +camel-k:trait=pdb
+type pdbTrait struct {
+	BaseTrait `property:",squash"`
+	// The number of pods for the Integration that must still be available after an eviction.
+	// It can be either an absolute number or a percentage.
+	// Only one of `min-available` and `max-unavailable` can be specified.
+	MinAvailable string `property:"min-available" json:"minAvailable,omitempty"`
+	// The number of pods for the Integration that can be unavailable after an eviction.
+	// It can be either an absolute number or a percentage.
+	// Only one of `max-unavailable` and `min-available` can be specified.
+	MaxUnavailable string `property:"max-unavailable" json:"maxUnavailable,omitempty"`
+}
+
+func newPdbTrait() Trait {
+	return &pdbTrait{
+		BaseTrait: NewBaseTrait("pdb", 1050),
+	}
+}
+
+func (t *pdbTrait) Configure(e *Environment) (bool, error) {
+	if t.Enabled == nil || !*t.Enabled {
+		return false, nil
+	}
+
+	if t.MinAvailable == "" && t.MaxUnavailable == "" {
+		return false, fmt.Errorf("one of min-available and max-unavailable must be specified")
+	}
+	if t.MinAvailable != "" && t.MaxUnavailable != "" {
+		return false, fmt.Errorf("both min-available and max-unavailable can't be set simultaneously")
+	}
+	if err := validateIntOrPercent("min-available", t.MinAvailable); err != nil {
+		return false, err
+	}
+	if err := validateIntOrPercent("max-unavailable", t.MaxUnavailable); err != nil {
+		return false, err
+	}
+
+	return e.IntegrationInPhase(v1.IntegrationPhaseDeploying), nil
+}
+
+func (t *pdbTrait) Apply(e *Environment) error {
+	e.Resources.Add(t.podDisruptionBudgetFor(e.Integration))
+
+	return nil
+}
+
+func (t *pdbTrait) podDisruptionBudgetFor(integration *v1.Integration) *v1beta1.PodDisruptionBudget {
+	pdb := &v1beta1.PodDisruptionBudget{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "PodDisruptionBudget",
+			APIVersion: v1beta1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      integration.Name,
+			Namespace: integration.Namespace,
+			Labels: map[string]string{
+				v1.IntegrationLabel: integration.Name,
+			},
+		},
+		Spec: v1beta1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					v1.IntegrationLabel: integration.Name,
+				},
+			},
+		},
+	}
+
+	if t.MinAvailable != "" {
+		v := intstr.Parse(t.MinAvailable)
+		pdb.Spec.MinAvailable = &v
+	}
+	if t.MaxUnavailable != "" {
+		v := intstr.Parse(t.MaxUnavailable)
+		pdb.Spec.MaxUnavailable = &v
+	}
+
+	return pdb
+}
@@ -0,0 +1,126 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"k8s.io/api/policy/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+)
+
+func TestConfigurePdbTraitDoesSucceed(t *testing.T) {
+	pdbTrait, environment := createNominalPdbTest()
+	configured, err := pdbTrait.Configure(environment)
+
+	assert.True(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestConfigureDisabledPdbTraitDoesNotSucceed(t *testing.T) {
+	pdbTrait, environment := createNominalPdbTest()
+	pdbTrait.Enabled = nil
+	configured, err := pdbTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestConfigurePdbTraitWithBothFieldsFails(t *testing.T) {
+	pdbTrait, environment := createNominalPdbTest()
+	pdbTrait.MaxUnavailable = "1"
+	configured, err := pdbTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestConfigurePdbTraitWithoutFieldsFails(t *testing.T) {
+	pdbTrait, environment := createNominalPdbTest()
+	pdbTrait.MinAvailable = ""
+	configured, err := pdbTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestApplyPdbTraitWithMinAvailableDoesSucceed(t *testing.T) {
+	pdbTrait, environment := createNominalPdbTest()
+
+	err := pdbTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	pdb := findPdb(environment.Resources)
+	assert.NotNil(t, pdb)
+	assert.Equal(t, "integration-name", pdb.Name)
+	assert.Equal(t, "integration-name", pdb.Labels[v1.IntegrationLabel])
+	assert.Equal(t, "integration-name", pdb.Spec.Selector.MatchLabels[v1.IntegrationLabel])
+	assert.Equal(t, intstr.FromString("50%"), *pdb.Spec.MinAvailable)
+	assert.Nil(t, pdb.Spec.MaxUnavailable)
+}
+
+func TestApplyPdbTraitWithMaxUnavailableDoesSucceed(t *testing.T) {
+	pdbTrait, environment := createNominalPdbTest()
+	pdbTrait.MinAvailable = ""
+	pdbTrait.MaxUnavailable = "2"
+
+	err := pdbTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	pdb := findPdb(environment.Resources)
+	assert.NotNil(t, pdb)
+	assert.Nil(t, pdb.Spec.MinAvailable)
+	assert.Equal(t, intstr.FromInt(2), *pdb.Spec.MaxUnavailable)
+}
+
+func findPdb(resources *kubernetes.Collection) *v1beta1.PodDisruptionBudget {
+	for _, a := range resources.Items() {
+		if pdb, ok := a.(*v1beta1.PodDisruptionBudget); ok {
+			return pdb
+		}
+	}
+	return nil
+}
+
+func createNominalPdbTest() (*pdbTrait, *Environment) {
+	trait := newPdbTrait().(*pdbTrait)
+	enabled := true
+	trait.Enabled = &enabled
+	trait.MinAvailable = "50%"
+
+	environment := &Environment{
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "integration-name",
+				Namespace: "namespace",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+		},
+		Resources: kubernetes.NewCollection(),
+	}
+
+	return trait, environment
+}
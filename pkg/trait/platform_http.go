@@ -0,0 +1,153 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util"
+)
+
+// The Platform HTTP trait configures the HTTP server the REST DSL and `platform-http` endpoints of the integration
+// are bound to, and makes sure the container and Service ports match the server port.
+//
+// Quarkus fixes the HTTP listening port and root path when the integration is built, so that neither the port
+// nor the context path can be changed with the Quarkus runtime.
+//
+// It's disabled by default.
+//
+// +camel-k:trait=platform-http
+type platformHTTPTrait struct {
+	BaseTrait `property:",squash"`
+	// The port the HTTP server binds to (default to the container trait `port`).
+	Port int `property:"port" json:"port,omitempty"`
+	// The context path the HTTP endpoints are served from, e.g. `/api`. It's not supported by the Quarkus runtime.
+	ContextPath string `property:"context-path" json:"contextPath,omitempty"`
+}
+
+const platformHTTPTraitID = "platform-http"
+
+func newPlatformHTTPTrait() Trait {
+	return &platformHTTPTrait{
+		BaseTrait: NewBaseTrait(platformHTTPTraitID, 1590),
+	}
+}
+
+func (t *platformHTTPTrait) Configure(e *Environment) (bool, error) {
+	if t.Enabled == nil || !*t.Enabled {
+		return false, nil
+	}
+
+	if t.Port != 0 {
+		if t.Port < 1 || t.Port > 65535 {
+			return false, fmt.Errorf("invalid platform-http port: %d, must be between 1 and 65535", t.Port)
+		}
+		if ct := e.Catalog.GetTrait(containerTraitID); ct != nil {
+			if port := ct.(*containerTrait).Port; port != defaultContainerPort && port != t.Port {
+				return false, fmt.Errorf("the platform-http port %d does not match the container trait port %d", t.Port, port)
+			}
+		}
+		if t.Port != defaultContainerPort && e.CamelCatalog.Runtime.Provider == v1.RuntimeProviderQuarkus {
+			return false, fmt.Errorf("the platform-http port cannot be changed with the Quarkus runtime")
+		}
+	}
+
+	if t.ContextPath != "" {
+		if !strings.HasPrefix(t.ContextPath, "/") || strings.ContainsAny(t.ContextPath, " ?#") {
+			return false, fmt.Errorf("invalid platform-http context path: %s, must be an absolute path", t.ContextPath)
+		}
+		// The quarkus.http.root-path property is only read when the application is built
+		if e.CamelCatalog.Runtime.Provider == v1.RuntimeProviderQuarkus {
+			return false, fmt.Errorf("the platform-http context path cannot be changed with the Quarkus runtime")
+		}
+	}
+
+	return e.IntegrationInPhase(
+		v1.IntegrationPhaseInitialization,
+		v1.IntegrationPhaseDeploying,
+		v1.IntegrationPhaseRunning,
+	), nil
+}
+
+func (t *platformHTTPTrait) Apply(e *Environment) error {
+	if e.IntegrationInPhase(v1.IntegrationPhaseInitialization) {
+		util.StringSliceUniqueAdd(&e.Integration.Status.Capabilities, v1.CapabilityPlatformHTTP)
+		return nil
+	}
+
+	var container *containerTrait
+	if ct := e.Catalog.GetTrait(containerTraitID); ct != nil {
+		container = ct.(*containerTrait)
+	}
+	if container == nil {
+		return nil
+	}
+
+	// The container trait binds the HTTP server, and declares the container port, from its own port,
+	// so that it's aligned before the container trait is applied
+	if t.Port != 0 {
+		container.Port = t.Port
+	}
+
+	if err := t.validateServicePort(e, container); err != nil {
+		return err
+	}
+
+	if t.ContextPath == "" {
+		return nil
+	}
+
+	if e.ApplicationProperties == nil {
+		e.ApplicationProperties = make(map[string]string)
+	}
+
+	switch e.CamelCatalog.Runtime.Provider {
+	case v1.RuntimeProviderMain:
+		e.ApplicationProperties["customizer.platform-http.path"] = t.ContextPath
+	default:
+		return fmt.Errorf("the platform-http context path is not supported by runtime: %s", e.CamelCatalog.Runtime.Provider)
+	}
+
+	return nil
+}
+
+// validateServicePort checks that a port explicitly declared with the service trait targets the HTTP server port
+func (t *platformHTTPTrait) validateServicePort(e *Environment, container *containerTrait) error {
+	service := e.Resources.GetServiceForIntegration(e.Integration)
+	if service == nil {
+		return nil
+	}
+
+	for _, p := range service.Spec.Ports {
+		if p.Name != container.ServicePortName {
+			continue
+		}
+		switch {
+		case p.TargetPort.Type == intstr.String && p.TargetPort.StrVal != container.PortName:
+			return fmt.Errorf("the service port %s targets the %s port, instead of the %s container port", p.Name, p.TargetPort.StrVal, container.PortName)
+		case p.TargetPort.Type == intstr.Int && p.TargetPort.IntValue() != container.Port:
+			return fmt.Errorf("the service port %s targets the %d port, instead of the platform-http port %d", p.Name, p.TargetPort.IntValue(), container.Port)
+		}
+	}
+
+	return nil
+}
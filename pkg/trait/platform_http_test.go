@@ -0,0 +1,168 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/camel"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+)
+
+func TestConfigurePlatformHTTPTraitDoesSucceed(t *testing.T) {
+	platformHTTPTrait, environment, _ := createNominalPlatformHTTPTest(t, v1.RuntimeProviderMain)
+	platformHTTPTrait.Port = 8081
+	platformHTTPTrait.ContextPath = "/api"
+
+	configured, err := platformHTTPTrait.Configure(environment)
+
+	assert.True(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestConfigurePlatformHTTPTraitWithInvalidOptionsFails(t *testing.T) {
+	platformHTTPTrait, environment, _ := createNominalPlatformHTTPTest(t, v1.RuntimeProviderMain)
+	platformHTTPTrait.Port = 70000
+	_, err := platformHTTPTrait.Configure(environment)
+	assert.NotNil(t, err)
+
+	platformHTTPTrait, environment, _ = createNominalPlatformHTTPTest(t, v1.RuntimeProviderMain)
+	platformHTTPTrait.ContextPath = "api"
+	_, err = platformHTTPTrait.Configure(environment)
+	assert.NotNil(t, err)
+
+	platformHTTPTrait, environment, _ = createNominalPlatformHTTPTest(t, v1.RuntimeProviderMain)
+	platformHTTPTrait.Port = 8081
+	environment.Catalog.GetTrait(containerTraitID).(*containerTrait).Port = 8082
+	_, err = platformHTTPTrait.Configure(environment)
+	assert.NotNil(t, err)
+
+	platformHTTPTrait, environment, _ = createNominalPlatformHTTPTest(t, v1.RuntimeProviderQuarkus)
+	platformHTTPTrait.Port = 8081
+	_, err = platformHTTPTrait.Configure(environment)
+	assert.NotNil(t, err)
+
+	platformHTTPTrait, environment, _ = createNominalPlatformHTTPTest(t, v1.RuntimeProviderQuarkus)
+	platformHTTPTrait.ContextPath = "/api"
+	_, err = platformHTTPTrait.Configure(environment)
+	assert.NotNil(t, err)
+	assert.Equal(t, "the platform-http context path cannot be changed with the Quarkus runtime", err.Error())
+}
+
+func TestApplyPlatformHTTPTraitCapability(t *testing.T) {
+	platformHTTPTrait, environment, _ := createNominalPlatformHTTPTest(t, v1.RuntimeProviderMain)
+	environment.Integration.Status.Phase = v1.IntegrationPhaseInitialization
+
+	err := platformHTTPTrait.Apply(environment)
+
+	assert.Nil(t, err)
+	assert.Contains(t, environment.Integration.Status.Capabilities, v1.CapabilityPlatformHTTP)
+}
+
+func TestApplyPlatformHTTPTraitDoesSucceed(t *testing.T) {
+	platformHTTPTrait, environment, _ := createNominalPlatformHTTPTest(t, v1.RuntimeProviderMain)
+	platformHTTPTrait.Port = 8081
+	platformHTTPTrait.ContextPath = "/api"
+
+	err := platformHTTPTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	assert.Equal(t, 8081, environment.Catalog.GetTrait(containerTraitID).(*containerTrait).Port)
+	assert.Equal(t, "/api", environment.ApplicationProperties["customizer.platform-http.path"])
+
+	platformHTTPTrait, environment, _ = createNominalPlatformHTTPTest(t, v1.RuntimeProviderQuarkus)
+
+	err = platformHTTPTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	assert.Equal(t, defaultContainerPort, environment.Catalog.GetTrait(containerTraitID).(*containerTrait).Port)
+	assert.NotContains(t, environment.ApplicationProperties, "quarkus.http.root-path")
+}
+
+func TestApplyPlatformHTTPTraitWithMismatchingServicePortFails(t *testing.T) {
+	platformHTTPTrait, environment, service := createNominalPlatformHTTPTest(t, v1.RuntimeProviderMain)
+	platformHTTPTrait.Port = 8081
+	service.Spec.Ports = []corev1.ServicePort{
+		{
+			Name:       httpPortName,
+			Port:       80,
+			TargetPort: intstr.FromInt(8080),
+		},
+	}
+
+	err := platformHTTPTrait.Apply(environment)
+	assert.NotNil(t, err)
+
+	service.Spec.Ports[0].TargetPort = intstr.FromInt(8081)
+	err = platformHTTPTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	service.Spec.Ports[0].TargetPort = intstr.FromString("grpc")
+	err = platformHTTPTrait.Apply(environment)
+	assert.NotNil(t, err)
+}
+
+func createNominalPlatformHTTPTest(t *testing.T, provider v1.RuntimeProvider) (*platformHTTPTrait, *Environment, *corev1.Service) {
+	trait := newPlatformHTTPTrait().(*platformHTTPTrait)
+	enabled := true
+	trait.Enabled = &enabled
+
+	var catalog *camel.RuntimeCatalog
+	var err error
+	switch provider {
+	case v1.RuntimeProviderMain:
+		catalog, err = camel.DefaultCatalog()
+	case v1.RuntimeProviderQuarkus:
+		catalog, err = camel.QuarkusCatalog()
+	}
+	assert.Nil(t, err)
+
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "integration-name",
+			Labels: map[string]string{
+				v1.IntegrationLabel: "integration-name",
+			},
+		},
+	}
+
+	environment := &Environment{
+		Catalog:      NewCatalog(context.TODO(), nil),
+		CamelCatalog: catalog,
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "integration-name",
+				Namespace: "integration-namespace",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+		},
+		Resources: kubernetes.NewCollection(service),
+	}
+
+	return trait, environment, service
+}
@@ -20,12 +20,15 @@ package trait
 import (
 	"fmt"
 	"path"
+	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
 
@@ -45,6 +48,9 @@ import (
 // custom resource definition to be installed.
 // You can set `service-monitor` to `false` for the Prometheus trait to work without the Prometheus operator.
 //
+// Alternatively, `pod-monitor` can be set to `true`, so that a `PodMonitor` resource selecting the integration pods
+// is created instead, without exposing the endpoint with a `Service`.
+//
 // It's disabled by default.
 //
 // +camel-k:trait=prometheus
@@ -52,10 +58,25 @@ type prometheusTrait struct {
 	BaseTrait `property:",squash"`
 	// The Prometheus endpoint port (default `9779`, or `8080` with Quarkus).
 	Port *int `property:"port" json:"port,omitempty"`
+	// The name of the Prometheus endpoint port declared on the `Service` and scraped by the `ServiceMonitor` (default `prometheus`).
+	PortName string `property:"port-name" json:"portName,omitempty"`
+	// The HTTP path of the Prometheus endpoint scraped by the `ServiceMonitor` (default `/metrics`).
+	// It must start with `/`.
+	Path string `property:"path" json:"path,omitempty"`
+	// The interval at which the Prometheus endpoint is scraped, e.g. `30s` or `1m`.
+	// When not set, the Prometheus global default applies.
+	ScrapeInterval string `property:"scrape-interval" json:"scrapeInterval,omitempty"`
+	// The timeout of the Prometheus endpoint scrape requests, e.g. `10s`. It must be lower than the scrape interval.
+	// When not set, the Prometheus global default applies.
+	ScrapeTimeout string `property:"scrape-timeout" json:"scrapeTimeout,omitempty"`
 	// Whether a `ServiceMonitor` resource is created (default `true`).
 	ServiceMonitor bool `property:"service-monitor" json:"serviceMonitor,omitempty"`
 	// The `ServiceMonitor` resource labels, applicable when `service-monitor` is `true`.
+	// They also apply to the `PodMonitor` resource, when `pod-monitor` is `true`.
 	ServiceMonitorLabels []string `property:"service-monitor-labels" json:"serviceMonitorLabels,omitempty"`
+	// Whether a `PodMonitor` resource is created instead of the `Service` and `ServiceMonitor` resources (default `false`).
+	// It is not applicable when the integration runs as a Knative service.
+	PodMonitor *bool `property:"pod-monitor" json:"podMonitor,omitempty"`
 	// To use a custom ConfigMap containing the Prometheus JMX exporter configuration (under the `content` ConfigMap key).
 	// When this property is left empty (default), Camel K generates a standard Prometheus configuration for the integration.
 	// It is not applicable when using Quarkus.
@@ -68,15 +89,45 @@ const (
 	prometheusPortName                   = "prometheus"
 )
 
+var (
+	prometheusDurationRegexp = regexp.MustCompile(`^([0-9]+)(ms|s|m|h|d|w|y)$`)
+	prometheusDurationUnits  = map[string]time.Duration{
+		"ms": time.Millisecond,
+		"s":  time.Second,
+		"m":  time.Minute,
+		"h":  time.Hour,
+		"d":  24 * time.Hour,
+		"w":  7 * 24 * time.Hour,
+		"y":  365 * 24 * time.Hour,
+	}
+)
+
 func newPrometheusTrait() Trait {
 	return &prometheusTrait{
 		BaseTrait:      NewBaseTrait("prometheus", 1900),
+		PortName:       prometheusPortName,
 		ServiceMonitor: true,
 	}
 }
 
 func (t *prometheusTrait) Configure(e *Environment) (bool, error) {
-	return t.Enabled != nil && *t.Enabled && e.IntegrationInPhase(
+	if t.Enabled == nil || !*t.Enabled {
+		return false, nil
+	}
+
+	if errs := validation.IsValidPortName(t.PortName); len(errs) > 0 {
+		return false, fmt.Errorf("invalid Prometheus port name: %s, %s", t.PortName, strings.Join(errs, ", "))
+	}
+
+	if t.Path != "" && !strings.HasPrefix(t.Path, "/") {
+		return false, fmt.Errorf("invalid Prometheus path: %s, must start with /", t.Path)
+	}
+
+	if err := t.validateScrapeDurations(); err != nil {
+		return false, err
+	}
+
+	return e.IntegrationInPhase(
 		v1.IntegrationPhaseInitialization,
 		v1.IntegrationPhaseDeploying,
 		v1.IntegrationPhaseRunning,
@@ -148,12 +199,26 @@ func (t *prometheusTrait) Apply(e *Environment) (err error) {
 	if err != nil {
 		return err
 	}
+	if t.isPodMonitor() && controller == ControllerStrategyKnativeService {
+		return fmt.Errorf("the Prometheus pod-monitor property cannot be used with Knative services")
+	}
 	// Skip declaring the Prometheus port when Knative is enabled, as only one container port is supported
 	if controller != ControllerStrategyKnativeService {
 		container.Ports = append(container.Ports, *containerPort)
 	}
 	condition.Message = fmt.Sprintf("%s(%d)", container.Name, containerPort.ContainerPort)
 
+	// Add the pod monitor resource, that scrapes the container port directly
+	if t.isPodMonitor() {
+		pmt, err := t.getPodMonitorFor(e)
+		if err != nil {
+			return err
+		}
+		e.Resources.Add(pmt)
+		e.Integration.Status.SetConditions(condition)
+		return nil
+	}
+
 	// Retrieve the service or create a new one if the service trait is enabled
 	serviceEnabled := false
 	service := e.Resources.GetServiceForIntegration(e.Integration)
@@ -198,17 +263,60 @@ func (t *prometheusTrait) Apply(e *Environment) (err error) {
 	return nil
 }
 
+func (t *prometheusTrait) validateScrapeDurations() error {
+	var interval, timeout time.Duration
+	var err error
+	if t.ScrapeInterval != "" {
+		if interval, err = parsePrometheusDuration(t.ScrapeInterval); err != nil {
+			return fmt.Errorf("invalid Prometheus scrape interval: %s, %v", t.ScrapeInterval, err)
+		}
+	}
+	if t.ScrapeTimeout != "" {
+		if timeout, err = parsePrometheusDuration(t.ScrapeTimeout); err != nil {
+			return fmt.Errorf("invalid Prometheus scrape timeout: %s, %v", t.ScrapeTimeout, err)
+		}
+	}
+	if interval > 0 && timeout >= interval {
+		return fmt.Errorf("invalid Prometheus scrape timeout: %s, must be lower than the scrape interval %s", t.ScrapeTimeout, t.ScrapeInterval)
+	}
+	return nil
+}
+
+// parsePrometheusDuration parses a duration in the format supported by Prometheus, i.e. a number followed by one unit
+func parsePrometheusDuration(s string) (time.Duration, error) {
+	matches := prometheusDurationRegexp.FindStringSubmatch(s)
+	if matches == nil {
+		return 0, fmt.Errorf("must be a number followed by one of the ms, s, m, h, d, w or y units (e.g. 30s)")
+	}
+	n, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return 0, err
+	}
+	if n == 0 {
+		return 0, fmt.Errorf("must be a positive duration")
+	}
+	return time.Duration(n) * prometheusDurationUnits[matches[2]], nil
+}
+
+func (t *prometheusTrait) isPodMonitor() bool {
+	return t.PodMonitor != nil && *t.PodMonitor
+}
+
 func (t *prometheusTrait) getContainerPort() *corev1.ContainerPort {
 	containerPort := corev1.ContainerPort{
 		ContainerPort: int32(*t.Port),
 		Protocol:      corev1.ProtocolTCP,
 	}
+	// The pod monitor endpoint references the container port by name
+	if t.isPodMonitor() {
+		containerPort.Name = t.PortName
+	}
 	return &containerPort
 }
 
 func (t *prometheusTrait) getServicePort() *corev1.ServicePort {
 	servicePort := corev1.ServicePort{
-		Name:     prometheusPortName,
+		Name:     t.PortName,
 		Port:     int32(*t.Port),
 		Protocol: corev1.ProtocolTCP,
 		// Avoid relying on named port, as Knative enforces specific values used for content negotiation
@@ -242,7 +350,10 @@ func (t *prometheusTrait) getServiceMonitorFor(e *Environment) (*monitoringv1.Se
 			},
 			Endpoints: []monitoringv1.Endpoint{
 				{
-					Port: prometheusPortName,
+					Port:          t.PortName,
+					Path:          t.Path,
+					Interval:      t.ScrapeInterval,
+					ScrapeTimeout: t.ScrapeTimeout,
 				},
 			},
 		},
@@ -250,6 +361,42 @@ func (t *prometheusTrait) getServiceMonitorFor(e *Environment) (*monitoringv1.Se
 	return &smt, nil
 }
 
+func (t *prometheusTrait) getPodMonitorFor(e *Environment) (*monitoringv1.PodMonitor, error) {
+	labels, err := keyValuePairArrayAsStringMap(t.ServiceMonitorLabels)
+	if err != nil {
+		return nil, err
+	}
+	labels[v1.IntegrationLabel] = e.Integration.Name
+
+	pmt := monitoringv1.PodMonitor{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "PodMonitor",
+			APIVersion: "monitoring.coreos.com/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      e.Integration.Name,
+			Namespace: e.Integration.Namespace,
+			Labels:    labels,
+		},
+		Spec: monitoringv1.PodMonitorSpec{
+			Selector: metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					v1.IntegrationLabel: e.Integration.Name,
+				},
+			},
+			PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{
+				{
+					Port:          t.PortName,
+					Path:          t.Path,
+					Interval:      t.ScrapeInterval,
+					ScrapeTimeout: t.ScrapeTimeout,
+				},
+			},
+		},
+	}
+	return &pmt, nil
+}
+
 func (t *prometheusTrait) getJmxExporterConfigMapOrAdd(e *Environment) string {
 	if t.ConfigMap != "" {
 		return t.ConfigMap
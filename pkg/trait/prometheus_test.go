@@ -168,6 +168,124 @@ func TestPrometheusTraitGetServiceMonitor(t *testing.T) {
 	assert.Equal(t, "prometheus", serviceMonitor.Spec.Endpoints[0].Port)
 }
 
+func TestPrometheusTraitGetServiceMonitorWithCustomPortNameAndPath(t *testing.T) {
+	trait, environment := createNominalPrometheusTest()
+	trait.PortName = "metrics"
+	trait.Path = "/q/metrics"
+
+	configured, err := trait.Configure(environment)
+	assert.Nil(t, err)
+	assert.True(t, configured)
+
+	serviceMonitor, err := trait.getServiceMonitorFor(environment)
+
+	assert.Nil(t, err)
+	assert.Len(t, serviceMonitor.Spec.Endpoints, 1)
+	assert.Equal(t, "metrics", serviceMonitor.Spec.Endpoints[0].Port)
+	assert.Equal(t, "/q/metrics", serviceMonitor.Spec.Endpoints[0].Path)
+
+	port := 9779
+	trait.Port = &port
+	assert.Equal(t, "metrics", trait.getServicePort().Name)
+}
+
+func TestConfigurePrometheusTraitWithInvalidPortNameOrPathDoesNotSucceed(t *testing.T) {
+	trait, environment := createNominalPrometheusTest()
+	trait.PortName = "invalid_port_name"
+
+	_, err := trait.Configure(environment)
+	assert.NotNil(t, err)
+
+	trait, environment = createNominalPrometheusTest()
+	trait.Path = "metrics"
+
+	_, err = trait.Configure(environment)
+	assert.NotNil(t, err)
+}
+
+func TestApplyPrometheusTraitWithPodMonitorDoesSucceed(t *testing.T) {
+	trait, environment := createNominalPrometheusTest()
+	podMonitor := true
+	trait.PodMonitor = &podMonitor
+	trait.ServiceMonitorLabels = []string{"team=integration"}
+
+	err := trait.Apply(environment)
+
+	assert.Nil(t, err)
+
+	container := environment.Resources.GetContainerByName(defaultContainerName)
+	assert.NotNil(t, container)
+	assert.Len(t, container.Ports, 1)
+	assert.Equal(t, "prometheus", container.Ports[0].Name)
+	assert.Equal(t, int32(9779), container.Ports[0].ContainerPort)
+
+	service := environment.Resources.GetService(func(service *corev1.Service) bool {
+		return service.Name == "integration-name-prometheus"
+	})
+	assert.Nil(t, service)
+
+	serviceMonitor := environment.Resources.GetServiceMonitor(func(service *monitoringv1.ServiceMonitor) bool {
+		return true
+	})
+	assert.Nil(t, serviceMonitor)
+
+	pm := environment.Resources.GetPodMonitor(func(podMonitor *monitoringv1.PodMonitor) bool {
+		return podMonitor.Name == "integration-name"
+	})
+	assert.NotNil(t, pm)
+	assert.Equal(t, "PodMonitor", pm.Kind)
+	assert.Equal(t, "integration-namespace", pm.Namespace)
+	assert.Equal(t, "integration-name", pm.Labels[v1.IntegrationLabel])
+	assert.Equal(t, "integration", pm.Labels["team"])
+	assert.Equal(t, "integration-name", pm.Spec.Selector.MatchLabels[v1.IntegrationLabel])
+	assert.Len(t, pm.Spec.PodMetricsEndpoints, 1)
+	assert.Equal(t, "prometheus", pm.Spec.PodMetricsEndpoints[0].Port)
+
+	assert.Len(t, environment.Integration.Status.Conditions, 1)
+	condition := environment.Integration.Status.Conditions[0]
+	assert.Equal(t, v1.IntegrationConditionPrometheusAvailable, condition.Type)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+}
+
+func TestPrometheusTraitWithScrapeIntervalAndTimeout(t *testing.T) {
+	trait, environment := createNominalPrometheusTest()
+	trait.ScrapeInterval = "1m"
+	trait.ScrapeTimeout = "45s"
+
+	configured, err := trait.Configure(environment)
+	assert.Nil(t, err)
+	assert.True(t, configured)
+
+	serviceMonitor, err := trait.getServiceMonitorFor(environment)
+	assert.Nil(t, err)
+	assert.Equal(t, "1m", serviceMonitor.Spec.Endpoints[0].Interval)
+	assert.Equal(t, "45s", serviceMonitor.Spec.Endpoints[0].ScrapeTimeout)
+
+	podMonitor, err := trait.getPodMonitorFor(environment)
+	assert.Nil(t, err)
+	assert.Equal(t, "1m", podMonitor.Spec.PodMetricsEndpoints[0].Interval)
+	assert.Equal(t, "45s", podMonitor.Spec.PodMetricsEndpoints[0].ScrapeTimeout)
+}
+
+func TestConfigurePrometheusTraitWithInvalidScrapeDurationsDoesNotSucceed(t *testing.T) {
+	durations := [][]string{
+		{"1m30s", ""},
+		{"", "10"},
+		{"0s", ""},
+		{"30s", "30s"},
+		{"30s", "1m"},
+	}
+
+	for _, d := range durations {
+		trait, environment := createNominalPrometheusTest()
+		trait.ScrapeInterval = d[0]
+		trait.ScrapeTimeout = d[1]
+
+		_, err := trait.Configure(environment)
+		assert.NotNil(t, err, d)
+	}
+}
+
 func createNominalPrometheusTest() (*prometheusTrait, *Environment) {
 	trait := newPrometheusTrait().(*prometheusTrait)
 	enabled := true
@@ -19,6 +19,7 @@ package trait
 
 import (
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util"
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -34,11 +35,19 @@ import (
 //
 // If your registry does not need authentication for pulling images, you can disable this trait.
 //
+// With the `Kaniko` and `Buildah` publish strategies, the pull secrets are also used by the image build, e.g. to pull
+// the base image from a private registry. They are merged with the registry secret of the `IntegrationPlatform`,
+// and must be of type `kubernetes.io/dockerconfigjson` or `kubernetes.io/dockercfg`.
+//
 // +camel-k:trait=pull-secret
 type pullSecretTrait struct {
 	BaseTrait `property:",squash"`
 	// The pull secret name to set on the Pod. If left empty this is automatically taken from the `IntegrationPlatform` registry configuration.
 	SecretName string `property:"secret-name" json:"secretName,omitempty"`
+	// A list of additional pull secret names to set on the Pod, and to use for the image build, e.g. to pull images
+	// from several private registries.
+	// They are merged with the `secret-name` property, and duplicates are ignored.
+	SecretNames []string `property:"secret-names" json:"secretNames,omitempty"`
 	// Automatically configures the platform registry secret on the pod if it is of type `kubernetes.io/dockerconfigjson`.
 	Auto *bool `property:"auto" json:"auto,omitempty"`
 }
@@ -49,6 +58,11 @@ func newPullSecretTrait() Trait {
 	}
 }
 
+// InfluencesKit overrides base class method
+func (t *pullSecretTrait) InfluencesKit() bool {
+	return true
+}
+
 func (t *pullSecretTrait) Configure(e *Environment) (bool, error) {
 	if t.Enabled != nil && !*t.Enabled {
 		return false, nil
@@ -74,15 +88,40 @@ func (t *pullSecretTrait) Configure(e *Environment) (bool, error) {
 		}
 	}
 
-	return t.SecretName != "", nil
+	return len(t.secretNames()) > 0, nil
 }
 
 func (t *pullSecretTrait) Apply(e *Environment) error {
+	names := t.secretNames()
 	e.Resources.VisitPodSpec(func(p *corev1.PodSpec) {
-		p.ImagePullSecrets = append(p.ImagePullSecrets, corev1.LocalObjectReference{
-			Name: t.SecretName,
-		})
+		for _, name := range names {
+			if hasPullSecret(p, name) {
+				continue
+			}
+			p.ImagePullSecrets = append(p.ImagePullSecrets, corev1.LocalObjectReference{
+				Name: name,
+			})
+		}
 	})
 
 	return nil
 }
+
+func (t *pullSecretTrait) secretNames() []string {
+	names := make([]string, 0, len(t.SecretNames)+1)
+	for _, name := range append([]string{t.SecretName}, t.SecretNames...) {
+		if name != "" && !util.StringSliceExists(names, name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func hasPullSecret(p *corev1.PodSpec, name string) bool {
+	for _, s := range p.ImagePullSecrets {
+		if s.Name == name {
+			return true
+		}
+	}
+	return false
+}
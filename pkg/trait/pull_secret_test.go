@@ -56,6 +56,43 @@ func TestPullSecret(t *testing.T) {
 	assert.Contains(t, deployment.Spec.Template.Spec.ImagePullSecrets, corev1.LocalObjectReference{Name: "xxxy"})
 }
 
+func TestPullSecretWithMultipleSecrets(t *testing.T) {
+	e := &Environment{}
+	e.Integration = &v1.Integration{
+		Status: v1.IntegrationStatus{
+			Phase: v1.IntegrationPhaseDeploying,
+		},
+	}
+
+	deployment := appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					ImagePullSecrets: []corev1.LocalObjectReference{
+						{Name: "registry-b"},
+					},
+				},
+			},
+		},
+	}
+	e.Resources = kubernetes.NewCollection(&deployment)
+
+	trait := newPullSecretTrait().(*pullSecretTrait)
+	trait.SecretName = "registry-a"
+	trait.SecretNames = []string{"registry-b", "registry-a", "registry-c"}
+	enabled, err := trait.Configure(e)
+	assert.Nil(t, err)
+	assert.True(t, enabled)
+
+	err = trait.Apply(e)
+	assert.Nil(t, err)
+	assert.Equal(t, []corev1.LocalObjectReference{
+		{Name: "registry-b"},
+		{Name: "registry-a"},
+		{Name: "registry-c"},
+	}, deployment.Spec.Template.Spec.ImagePullSecrets)
+}
+
 func TestPullSecretDoesNothingWhenNotSetOnPlatform(t *testing.T) {
 	e := &Environment{}
 	e.Integration = &v1.Integration{
@@ -18,9 +18,24 @@ limitations under the License.
 package trait
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/builder"
 	"github.com/apache/camel-k/pkg/builder/runtime"
+	"github.com/apache/camel-k/pkg/util"
+	"github.com/apache/camel-k/pkg/util/defaults"
+)
+
+const (
+	// The base image of native integration images, that does not require a JVM
+	quarkusNativeBaseImage = "quay.io/quarkus/quarkus-micro-image:1.0"
+	// Native compilation takes significantly longer than the standard build
+	quarkusNativeBuildTimeout = 30 * time.Minute
 )
 
 // The Quarkus trait activates the Quarkus runtime.
@@ -30,8 +45,25 @@ import (
 // +camel-k:trait=quarkus
 type quarkusTrait struct {
 	BaseTrait `property:",squash"`
-	// The Quarkus runtime type (reserved for future use)
+	// Compile the integration into a native executable, that starts faster and uses less memory than the JVM mode.
+	// The native compilation requires GraalVM, or Mandrel, to be available in the build environment, and
+	// significantly more time and resources than the JVM build: the build timeout defaults to at least 30 minutes,
+	// and the `build-timeout`, `request-memory` and `limit-memory` properties of the `builder` trait can be used
+	// to tune it. The integration image is built on top of `quay.io/quarkus/quarkus-micro-image`, unless the
+	// `base-image` property of the `builder` trait is set.
+	// The native executable replaces the JVM command line, so that the native mode cannot be combined with the
+	// `jolokia`, `jmx` and `truststore` traits, nor with the `debug` and `options` properties of the `jvm` trait.
 	Native bool `property:"native" json:"native,omitempty"`
+	// The Quarkus package type of the integration, either `fast-jar` or `uber-jar`. The `fast-jar` type starts faster
+	// and keeps the dependencies into separate layers of the integration image, while the `uber-jar` type packages the
+	// integration and its dependencies into a single jar. Only one package type can be set, and it cannot be combined
+	// with the native mode. The `fast-jar` type is not supported by the `Spectrum` publish strategy.
+	PackageTypes []string `property:"package-type" json:"packageTypes,omitempty"`
+}
+
+var quarkusPackageTypes = []string{
+	runtime.QuarkusFastJarPackageType,
+	runtime.QuarkusUberJarPackageType,
 }
 
 func newQuarkusTrait() Trait {
@@ -45,10 +77,102 @@ func (t *quarkusTrait) isEnabled() bool {
 }
 
 func (t *quarkusTrait) Configure(e *Environment) (bool, error) {
-	return t.isEnabled(), nil
+	if !t.isEnabled() {
+		return false, nil
+	}
+
+	if t.Native && e.Platform != nil && e.Platform.Status.Build.PublishStrategy == v1.IntegrationPlatformBuildPublishStrategySpectrum {
+		// Spectrum only adds the dependencies layer, while the native executable is the integration entry point
+		return false, fmt.Errorf("native mode is not supported by publish strategy %s", e.Platform.Status.Build.PublishStrategy)
+	}
+
+	if t.Native {
+		if err := t.validateNativeTraits(e); err != nil {
+			return false, err
+		}
+	}
+
+	if err := t.validatePackageTypes(e); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// validateNativeTraits rejects the traits that contribute to the JVM command line,
+// as it's replaced by the native executable
+func (t *quarkusTrait) validateNativeTraits(e *Environment) error {
+	if e.Catalog == nil {
+		return nil
+	}
+
+	incompatible := make([]string, 0)
+	if jolokia, ok := e.Catalog.GetTrait("jolokia").(*jolokiaTrait); ok && jolokia.Enabled != nil && *jolokia.Enabled {
+		incompatible = append(incompatible, string(jolokia.ID()))
+	}
+	if jmx, ok := e.Catalog.GetTrait(jmxTraitID).(*jmxTrait); ok && jmx.Enabled != nil && *jmx.Enabled {
+		incompatible = append(incompatible, string(jmx.ID()))
+	}
+	if truststore, ok := e.Catalog.GetTrait("truststore").(*truststoreTrait); ok && (truststore.Enabled == nil || *truststore.Enabled) && len(truststore.CACerts) > 0 {
+		incompatible = append(incompatible, string(truststore.ID()))
+	}
+	if jvm, ok := e.Catalog.GetTrait("jvm").(*jvmTrait); ok && (jvm.Enabled == nil || *jvm.Enabled) && (jvm.Debug || len(jvm.Options) > 0) {
+		incompatible = append(incompatible, string(jvm.ID()))
+	}
+
+	if len(incompatible) > 0 {
+		return fmt.Errorf("native mode cannot be combined with the JVM options of the traits [%s]", strings.Join(incompatible, ", "))
+	}
+
+	return nil
+}
+
+func (t *quarkusTrait) validatePackageTypes(e *Environment) error {
+	if len(t.PackageTypes) == 0 {
+		return nil
+	}
+	for _, packageType := range t.PackageTypes {
+		if !util.StringSliceExists(quarkusPackageTypes, packageType) {
+			return fmt.Errorf("unknown quarkus package type: %s. One of [%s] is expected", packageType, strings.Join(quarkusPackageTypes, ", "))
+		}
+	}
+	if len(t.PackageTypes) > 1 {
+		return fmt.Errorf("only one quarkus package type can be set, found [%s]", strings.Join(t.PackageTypes, ", "))
+	}
+
+	packageType := t.PackageTypes[0]
+	if t.Native {
+		return fmt.Errorf("quarkus package type %s cannot be combined with the native mode", packageType)
+	}
+
+	if packageType == runtime.QuarkusFastJarPackageType && e.Platform != nil && e.Platform.Status.Build.PublishStrategy == v1.IntegrationPlatformBuildPublishStrategySpectrum {
+		// Spectrum only adds the dependencies layer, while the fast-jar layout spans multiple directories
+		return fmt.Errorf("quarkus package type %s is not supported by publish strategy %s", packageType, e.Platform.Status.Build.PublishStrategy)
+	}
+
+	return nil
 }
 
 func (t *quarkusTrait) Apply(e *Environment) error {
+	if !t.Native || !e.IntegrationInPhase(v1.IntegrationPhaseDeploying, v1.IntegrationPhaseRunning) {
+		return nil
+	}
+
+	// The native executable replaces the Java command set by the jvm trait,
+	// once the integration container is created by the container trait
+	e.PostProcessors = append(e.PostProcessors, func(env *Environment) error {
+		container := env.getIntegrationContainer()
+		if container == nil {
+			return nil
+		}
+
+		container.Command = []string{"./camel-k-integration-" + defaults.Version + "-runner"}
+		container.Args = nil
+		container.WorkingDir = "/deployments"
+
+		return nil
+	})
+
 	return nil
 }
 
@@ -58,5 +182,43 @@ func (t *quarkusTrait) InfluencesKit() bool {
 }
 
 func (t *quarkusTrait) addBuildSteps(task *v1.BuilderTask) {
-	task.Steps = append(task.Steps, builder.StepIDsFor(runtime.QuarkusSteps...)...)
+	if !t.Native {
+		task.Steps = append(task.Steps, builder.StepIDsFor(runtime.QuarkusSteps...)...)
+		t.addPackageTypeProperties(task)
+		return
+	}
+
+	task.Steps = append(task.Steps, builder.StepIDsFor(runtime.QuarkusNativeSteps...)...)
+	task.BaseImage = quarkusNativeBaseImage
+
+	// The native executable cannot be layered on top of the JVM mode images
+	for i, s := range task.Steps {
+		if s == builder.Steps.IncrementalImageContext.ID() {
+			task.Steps[i] = builder.Steps.StandardImageContext.ID()
+		}
+	}
+
+	if d := task.Maven.GetTimeout().Duration; d > 0 && d < quarkusNativeBuildTimeout {
+		task.Maven.Timeout = &metav1.Duration{Duration: quarkusNativeBuildTimeout}
+	}
+	if d := task.Timeout.Duration; d > 0 && d < quarkusNativeBuildTimeout {
+		task.Timeout = metav1.Duration{Duration: quarkusNativeBuildTimeout}
+	}
+}
+
+func (t *quarkusTrait) addPackageTypeProperties(task *v1.BuilderTask) {
+	if len(t.PackageTypes) == 0 {
+		return
+	}
+
+	if task.MavenProperties == nil {
+		task.MavenProperties = make(map[string]string)
+	}
+
+	switch t.PackageTypes[0] {
+	case runtime.QuarkusFastJarPackageType:
+		task.MavenProperties[runtime.QuarkusPackageTypeProperty] = runtime.QuarkusFastJarPackageType
+	case runtime.QuarkusUberJarPackageType:
+		task.MavenProperties[runtime.QuarkusUberJarProperty] = "true"
+	}
 }
@@ -18,12 +18,21 @@ limitations under the License.
 package trait
 
 import (
+	"context"
 	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 
 	"github.com/apache/camel-k/pkg/builder"
+	"github.com/apache/camel-k/pkg/builder/runtime"
 	"github.com/apache/camel-k/pkg/util/camel"
+	"github.com/apache/camel-k/pkg/util/defaults"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -63,7 +72,161 @@ func TestQuarkusTraitAddBuildStepsShouldSucceed(t *testing.T) {
 
 	quarkusTrait.addBuildSteps(task)
 
-	assert.Len(t, task.Steps, 9)
+	assert.Len(t, task.Steps, 10)
+}
+
+func TestQuarkusTraitAddNativeBuildSteps(t *testing.T) {
+	quarkusTrait, _ := createNominalQuarkusTest()
+	quarkusTrait.Native = true
+
+	task := &v1.BuilderTask{
+		Steps:   builder.StepIDsFor(builder.DefaultSteps...),
+		Timeout: metav1.Duration{Duration: 5 * time.Minute},
+		Maven: v1.MavenSpec{
+			Timeout: &metav1.Duration{Duration: 3 * time.Minute},
+		},
+	}
+
+	quarkusTrait.addBuildSteps(task)
+
+	assert.Len(t, task.Steps, 10)
+	assert.Contains(t, task.Steps, runtime.Steps.BuildQuarkusNativeRunner.ID())
+	assert.NotContains(t, task.Steps, runtime.Steps.BuildQuarkusRunner.ID())
+	assert.NotContains(t, task.Steps, builder.Steps.IncrementalImageContext.ID())
+	assert.Equal(t, quarkusNativeBaseImage, task.BaseImage)
+	assert.Equal(t, 30*time.Minute, task.Maven.Timeout.Duration)
+	assert.Equal(t, 30*time.Minute, task.Timeout.Duration)
+}
+
+func TestApplyQuarkusTraitNativeCommand(t *testing.T) {
+	quarkusTrait, environment := createNominalQuarkusTest()
+	quarkusTrait.Native = true
+	environment.Catalog = NewCatalog(context.TODO(), nil)
+	environment.Integration.Status.Phase = v1.IntegrationPhaseDeploying
+	environment.Resources = kubernetes.NewCollection(&appsv1.Deployment{
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:    defaultContainerName,
+							Command: []string{"java"},
+							Args:    []string{"-cp", "./resources"},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	err := quarkusTrait.Apply(environment)
+	assert.Nil(t, err)
+	assert.Len(t, environment.PostProcessors, 1)
+
+	err = environment.PostProcessors[0](environment)
+	assert.Nil(t, err)
+
+	container := environment.getIntegrationContainer()
+	assert.NotNil(t, container)
+	assert.Equal(t, []string{"./camel-k-integration-" + defaults.Version + "-runner"}, container.Command)
+	assert.Empty(t, container.Args)
+	assert.Equal(t, "/deployments", container.WorkingDir)
+}
+
+func TestConfigureQuarkusTraitNativeNotSupportedBySpectrum(t *testing.T) {
+	quarkusTrait, environment := createNominalQuarkusTest()
+	quarkusTrait.Native = true
+	environment.Platform.Status.Build.PublishStrategy = v1.IntegrationPlatformBuildPublishStrategySpectrum
+
+	configured, err := quarkusTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestConfigureQuarkusTraitNativeWithJvmOptions(t *testing.T) {
+	quarkusTrait, environment := createNominalQuarkusTest()
+	quarkusTrait.Native = true
+	environment.Catalog = NewCatalog(context.TODO(), nil)
+
+	configured, err := quarkusTrait.Configure(environment)
+	assert.True(t, configured)
+	assert.Nil(t, err)
+
+	jvm := environment.Catalog.GetTrait("jvm").(*jvmTrait)
+	jvm.Debug = true
+	jolokia := environment.Catalog.GetTrait("jolokia").(*jolokiaTrait)
+	enabled := true
+	jolokia.Enabled = &enabled
+
+	configured, err = quarkusTrait.Configure(environment)
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+	assert.Equal(t, "native mode cannot be combined with the JVM options of the traits [jolokia, jvm]", err.Error())
+
+	jvm.Debug = false
+	jvm.Options = []string{"-XX:+UseG1GC"}
+	jolokia.Enabled = nil
+
+	configured, err = quarkusTrait.Configure(environment)
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+	assert.Equal(t, "native mode cannot be combined with the JVM options of the traits [jvm]", err.Error())
+
+	// The JVM options are only rejected in native mode
+	quarkusTrait.Native = false
+
+	configured, err = quarkusTrait.Configure(environment)
+	assert.True(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestQuarkusTraitPackageTypes(t *testing.T) {
+	quarkusTrait, environment := createNominalQuarkusTest()
+	quarkusTrait.PackageTypes = []string{"fast-jar"}
+
+	configured, err := quarkusTrait.Configure(environment)
+	assert.True(t, configured)
+	assert.Nil(t, err)
+
+	task := &v1.BuilderTask{}
+	quarkusTrait.addBuildSteps(task)
+	assert.Equal(t, "fast-jar", task.MavenProperties["quarkus.package.type"])
+
+	quarkusTrait.PackageTypes = []string{"uber-jar"}
+
+	task = &v1.BuilderTask{}
+	quarkusTrait.addBuildSteps(task)
+	assert.Equal(t, "true", task.MavenProperties["quarkus.package.uber-jar"])
+	assert.NotContains(t, task.MavenProperties, "quarkus.package.type")
+
+	quarkusTrait.PackageTypes = []string{"thin-jar"}
+
+	configured, err = quarkusTrait.Configure(environment)
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unknown quarkus package type: thin-jar. One of [fast-jar, uber-jar] is expected", err.Error())
+
+	quarkusTrait.PackageTypes = []string{"uber-jar", "thin-jar"}
+
+	configured, err = quarkusTrait.Configure(environment)
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unknown quarkus package type: thin-jar. One of [fast-jar, uber-jar] is expected", err.Error())
+
+	quarkusTrait.PackageTypes = []string{"fast-jar", "uber-jar"}
+
+	configured, err = quarkusTrait.Configure(environment)
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+	assert.Equal(t, "only one quarkus package type can be set, found [fast-jar, uber-jar]", err.Error())
+
+	quarkusTrait.PackageTypes = []string{"uber-jar"}
+	quarkusTrait.Native = true
+
+	configured, err = quarkusTrait.Configure(environment)
+	assert.False(t, configured)
+	assert.NotNil(t, err)
 }
 
 func createNominalQuarkusTest() (*quarkusTrait, *Environment) {
@@ -20,6 +20,7 @@ package trait
 import (
 	"fmt"
 	"reflect"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -28,6 +29,7 @@ import (
 	routev1 "github.com/openshift/api/route/v1"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
 )
 
 // The Route trait can be used to configure the creation of OpenShift routes for the integration.
@@ -53,6 +55,15 @@ type routeTrait struct {
 	//
 	// Refer to the OpenShift documentation for additional information.
 	TLSCACertificate string `property:"tls-ca-certificate" json:"tlsCACertificate,omitempty"`
+	// The secret name and key reference to the TLS certificate, e.g. `my-secret/tls.crt`,
+	// as an alternative to the certificate contents.
+	TLSCertificateSecret string `property:"tls-certificate-secret" json:"tlsCertificateSecret,omitempty"`
+	// The secret name and key reference to the TLS certificate key, e.g. `my-secret/tls.key`,
+	// as an alternative to the certificate key contents.
+	TLSKeySecret string `property:"tls-key-secret" json:"tlsKeySecret,omitempty"`
+	// The secret name and key reference to the TLS cert authority certificate, e.g. `my-secret/ca.crt`,
+	// as an alternative to the cert authority certificate contents.
+	TLSCACertificateSecret string `property:"tls-ca-certificate-secret" json:"tlsCACertificateSecret,omitempty"`
 	// The destination CA certificate provides the contents of the ca certificate of the final destination.  When using reencrypt
 	// termination this file should be provided in order to have routers use it for health checks on the secure connection.
 	// If this field is not specified, the router may provide its own destination CA and perform hostname validation using
@@ -96,6 +107,10 @@ func (t *routeTrait) Configure(e *Environment) (bool, error) {
 		return false, nil
 	}
 
+	if err := t.validateTLS(); err != nil {
+		return false, err
+	}
+
 	t.service = e.Resources.GetUserServiceForIntegration(e.Integration)
 	if t.service == nil {
 		e.Integration.Status.SetCondition(
@@ -118,6 +133,11 @@ func (t *routeTrait) Apply(e *Environment) error {
 		servicePortName = dt.(*containerTrait).ServicePortName
 	}
 
+	tls, err := t.getTLSConfig(e)
+	if err != nil {
+		return err
+	}
+
 	route := routev1.Route{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "Route",
@@ -139,7 +159,7 @@ func (t *routeTrait) Apply(e *Environment) error {
 				Name: t.service.Name,
 			},
 			Host: t.Host,
-			TLS:  t.getTLSConfig(),
+			TLS:  tls,
 		},
 	}
 
@@ -170,19 +190,105 @@ func (t *routeTrait) Apply(e *Environment) error {
 	return nil
 }
 
-func (t *routeTrait) getTLSConfig() *routev1.TLSConfig {
+func (t *routeTrait) validateTLS() error {
+	termination := routev1.TLSTerminationType(t.TLSTermination)
+
+	switch termination {
+	case "", routev1.TLSTerminationEdge, routev1.TLSTerminationPassthrough, routev1.TLSTerminationReencrypt:
+	default:
+		return fmt.Errorf("unsupported TLS termination: %s, must be one of %s, %s or %s",
+			t.TLSTermination, routev1.TLSTerminationEdge, routev1.TLSTerminationPassthrough, routev1.TLSTerminationReencrypt)
+	}
+
+	if t.TLSCertificate != "" && t.TLSCertificateSecret != "" {
+		return fmt.Errorf("the TLS certificate contents and secret reference cannot be both set")
+	}
+	if t.TLSKey != "" && t.TLSKeySecret != "" {
+		return fmt.Errorf("the TLS certificate key contents and secret reference cannot be both set")
+	}
+	if t.TLSCACertificate != "" && t.TLSCACertificateSecret != "" {
+		return fmt.Errorf("the TLS cert authority certificate contents and secret reference cannot be both set")
+	}
+
+	for _, ref := range []string{t.TLSCertificateSecret, t.TLSKeySecret, t.TLSCACertificateSecret} {
+		if ref == "" {
+			continue
+		}
+		if _, err := parseSecretKeyRef(ref); err != nil {
+			return err
+		}
+	}
+
+	hasCertificate := t.TLSCertificate != "" || t.TLSCertificateSecret != "" ||
+		t.TLSKey != "" || t.TLSKeySecret != "" ||
+		t.TLSCACertificate != "" || t.TLSCACertificateSecret != ""
+
+	if hasCertificate && termination != routev1.TLSTerminationEdge && termination != routev1.TLSTerminationReencrypt {
+		return fmt.Errorf("TLS certificates can only be provided for %s or %s termination",
+			routev1.TLSTerminationEdge, routev1.TLSTerminationReencrypt)
+	}
+	if t.TLSDestinationCACertificate != "" && termination != routev1.TLSTerminationReencrypt {
+		return fmt.Errorf("the TLS destination CA certificate can only be provided for %s termination",
+			routev1.TLSTerminationReencrypt)
+	}
+
+	return nil
+}
+
+func (t *routeTrait) getTLSConfig(e *Environment) (*routev1.TLSConfig, error) {
+	certificate, err := t.resolveTLSContent(e, t.TLSCertificate, t.TLSCertificateSecret)
+	if err != nil {
+		return nil, err
+	}
+	key, err := t.resolveTLSContent(e, t.TLSKey, t.TLSKeySecret)
+	if err != nil {
+		return nil, err
+	}
+	caCertificate, err := t.resolveTLSContent(e, t.TLSCACertificate, t.TLSCACertificateSecret)
+	if err != nil {
+		return nil, err
+	}
+
 	config := routev1.TLSConfig{
 		Termination:                   routev1.TLSTerminationType(t.TLSTermination),
-		Certificate:                   t.TLSCertificate,
-		Key:                           t.TLSKey,
-		CACertificate:                 t.TLSCACertificate,
+		Certificate:                   certificate,
+		Key:                           key,
+		CACertificate:                 caCertificate,
 		DestinationCACertificate:      t.TLSDestinationCACertificate,
 		InsecureEdgeTerminationPolicy: routev1.InsecureEdgeTerminationPolicyType(t.TLSInsecureEdgeTerminationPolicy),
 	}
 
 	if reflect.DeepEqual(config, routev1.TLSConfig{}) {
-		return nil
+		return nil, nil
 	}
 
-	return &config
+	return &config, nil
+}
+
+func (t *routeTrait) resolveTLSContent(e *Environment, content string, secretRef string) (string, error) {
+	if secretRef == "" {
+		return content, nil
+	}
+
+	selector, err := parseSecretKeyRef(secretRef)
+	if err != nil {
+		return "", err
+	}
+
+	return kubernetes.GetSecretRefValue(t.Ctx, t.Client, e.Integration.Namespace, selector)
+}
+
+// parseSecretKeyRef parses a secret key reference in the name/key format
+func parseSecretKeyRef(ref string) (*corev1.SecretKeySelector, error) {
+	parts := strings.Split(ref, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid secret reference: %s, must be in the name/key format", ref)
+	}
+
+	return &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{
+			Name: parts[0],
+		},
+		Key: parts[1],
+	}, nil
 }
@@ -197,3 +197,90 @@ func TestRoute_WithCustomServicePort(t *testing.T) {
 		route.Spec.Port.TargetPort.StrVal,
 	)
 }
+
+func TestRoute_TLSWithSecretReferences(t *testing.T) {
+	name := xid.New().String()
+	environment := createTestRouteEnvironment(t, name)
+
+	client, err := test.NewFakeClient(&corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-tls",
+			Namespace: "test-ns",
+		},
+		Data: map[string][]byte{
+			"tls.crt": []byte("my-certificate"),
+			"tls.key": []byte("my-key"),
+		},
+	})
+	assert.Nil(t, err)
+
+	environment.Catalog = NewCatalog(context.TODO(), client)
+	environment.Integration.Spec.Traits = map[string]v1.TraitSpec{
+		"route": test.TraitSpecFromMap(t, map[string]interface{}{
+			"tlsTermination":       string(routev1.TLSTerminationReencrypt),
+			"tlsCertificateSecret": "my-tls/tls.crt",
+			"tlsKeySecret":         "my-tls/tls.key",
+			"tlsCACertificate":     "my-ca-certificate",
+		}),
+	}
+
+	err = environment.Catalog.apply(environment)
+
+	assert.Nil(t, err)
+
+	route := environment.Resources.GetRoute(func(r *routev1.Route) bool {
+		return r.ObjectMeta.Name == name
+	})
+
+	assert.NotNil(t, route)
+	assert.NotNil(t, route.Spec.TLS)
+	assert.Equal(t, routev1.TLSTerminationReencrypt, route.Spec.TLS.Termination)
+	assert.Equal(t, "my-certificate", route.Spec.TLS.Certificate)
+	assert.Equal(t, "my-key", route.Spec.TLS.Key)
+	assert.Equal(t, "my-ca-certificate", route.Spec.TLS.CACertificate)
+}
+
+func TestRoute_InvalidTLS(t *testing.T) {
+	configurations := []map[string]interface{}{
+		{
+			"tlsTermination": "insecure",
+		},
+		{
+			"tlsTermination": string(routev1.TLSTerminationPassthrough),
+			"tlsCertificate": "my-certificate",
+		},
+		{
+			"tlsTermination":       string(routev1.TLSTerminationPassthrough),
+			"tlsCertificateSecret": "my-tls/tls.crt",
+		},
+		{
+			"tlsTermination":              string(routev1.TLSTerminationEdge),
+			"tlsDestinationCACertificate": "my-ca-certificate",
+		},
+		{
+			"tlsTermination":       string(routev1.TLSTerminationEdge),
+			"tlsCertificate":       "my-certificate",
+			"tlsCertificateSecret": "my-tls/tls.crt",
+		},
+		{
+			"tlsTermination": string(routev1.TLSTerminationEdge),
+			"tlsKeySecret":   "my-tls",
+		},
+	}
+
+	for _, configuration := range configurations {
+		name := xid.New().String()
+		environment := createTestRouteEnvironment(t, name)
+		environment.Integration.Spec.Traits = map[string]v1.TraitSpec{
+			"route": test.TraitSpecFromMap(t, configuration),
+		}
+
+		err := environment.Catalog.apply(environment)
+
+		assert.NotNil(t, err, configuration)
+	}
+}
@@ -0,0 +1,190 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+// The Secret Properties trait can be used to configure the integration with properties whose values are read from Secrets,
+// e.g. `camel.component.kafka.brokers`, so that credentials are kept out of the integration spec.
+//
+// Each Secret is described as `name[:prefix]`. Each key of the Secret is rendered as a property, named after the key
+// with the optional prefix prepended, in a properties file that is loaded by the runtime. The rendering is performed
+// by an init container, so that the values are never stored into a ConfigMap.
+//
+// +camel-k:trait=secret-properties
+type secretPropertiesTrait struct {
+	BaseTrait `property:",squash"`
+	// A list of Secrets to read the properties from, e.g. `my-kafka-credentials`
+	// or `my-kafka-credentials:camel.component.kafka.`.
+	Secrets []string `property:"secrets" json:"secrets,omitempty"`
+}
+
+const (
+	secretPropertiesVolumeName    = "secret-properties"
+	secretPropertiesSourcesPath   = "/etc/camel/secret-properties"
+	secretPropertiesContainerName = "secret-properties"
+)
+
+var secretPropertiesPrefixRegexp = regexp.MustCompile(`^[A-Za-z0-9._-]*$`)
+
+type secretPropertiesSource struct {
+	name   string
+	prefix string
+}
+
+func newSecretPropertiesTrait() Trait {
+	return &secretPropertiesTrait{
+		BaseTrait: NewBaseTrait("secret-properties", 1680),
+	}
+}
+
+func (t *secretPropertiesTrait) Configure(e *Environment) (bool, error) {
+	if t.Enabled != nil && !*t.Enabled {
+		return false, nil
+	}
+
+	if len(t.Secrets) == 0 {
+		return false, nil
+	}
+
+	if _, err := t.parseSources(); err != nil {
+		return false, err
+	}
+
+	return e.IntegrationInPhase(v1.IntegrationPhaseDeploying, v1.IntegrationPhaseRunning), nil
+}
+
+func (t *secretPropertiesTrait) Apply(e *Environment) error {
+	sources, err := t.parseSources()
+	if err != nil {
+		return err
+	}
+
+	container := e.getIntegrationContainer()
+	if container == nil {
+		return nil
+	}
+
+	e.Resources.VisitPodSpec(func(spec *corev1.PodSpec) {
+		initContainer := corev1.Container{
+			Name:    secretPropertiesContainerName,
+			Image:   container.Image,
+			Command: []string{"/bin/sh", "-c"},
+			Args:    []string{secretPropertiesRenderScript(sources)},
+			VolumeMounts: []corev1.VolumeMount{
+				{
+					Name:      secretPropertiesVolumeName,
+					MountPath: SecretPropertiesMountPath,
+				},
+			},
+		}
+
+		spec.Volumes = append(spec.Volumes, corev1.Volume{
+			Name: secretPropertiesVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{
+					Medium: corev1.StorageMediumMemory,
+				},
+			},
+		})
+
+		for i, s := range sources {
+			volumeName := secretPropertiesVolumeName + "-" + strconv.Itoa(i)
+			spec.Volumes = append(spec.Volumes, corev1.Volume{
+				Name: volumeName,
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName: s.name,
+					},
+				},
+			})
+			initContainer.VolumeMounts = append(initContainer.VolumeMounts, corev1.VolumeMount{
+				Name:      volumeName,
+				MountPath: path.Join(secretPropertiesSourcesPath, strconv.Itoa(i)),
+				ReadOnly:  true,
+			})
+		}
+
+		spec.InitContainers = append(spec.InitContainers, initContainer)
+
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      secretPropertiesVolumeName,
+			MountPath: SecretPropertiesMountPath,
+			ReadOnly:  true,
+		})
+	})
+
+	return nil
+}
+
+func (t *secretPropertiesTrait) parseSources() ([]secretPropertiesSource, error) {
+	sources := make([]secretPropertiesSource, 0, len(t.Secrets))
+	names := make(map[string]bool)
+
+	for _, config := range t.Secrets {
+		parts := strings.SplitN(config, ":", 2)
+
+		s := secretPropertiesSource{
+			name: parts[0],
+		}
+		if len(parts) == 2 {
+			s.prefix = parts[1]
+		}
+
+		if s.name == "" {
+			return nil, fmt.Errorf("invalid secret: %s, must be in the name[:prefix] format", config)
+		}
+		// The prefix is used as is in the rendering script
+		if !secretPropertiesPrefixRegexp.MatchString(s.prefix) {
+			return nil, fmt.Errorf("invalid secret: %s, the prefix can only contain alphanumeric characters, '.', '_' or '-'", config)
+		}
+		if names[s.name] {
+			return nil, fmt.Errorf("duplicate secret: %s", s.name)
+		}
+		names[s.name] = true
+
+		sources = append(sources, s)
+	}
+
+	return sources, nil
+}
+
+// secretPropertiesRenderScript returns the script that renders each key of the mounted Secrets as a property,
+// escaping the backslashes and the new lines of the values
+func secretPropertiesRenderScript(sources []secretPropertiesSource) string {
+	lines := []string{"set -e"}
+	for i, s := range sources {
+		file := fmt.Sprintf("%s/%03d-%s.properties", SecretPropertiesMountPath, i, s.name)
+		lines = append(lines,
+			fmt.Sprintf(`: > %s`, file),
+			fmt.Sprintf(`for f in %s/%d/*; do [ -f "$f" ] || continue; printf '%%s=%%s\n' "%s$(basename "$f")" "$(sed -e 's/\\/\\\\/g' "$f" | sed -e ':a' -e 'N' -e '$!ba' -e 's/\n/\\n/g')" >> %s; done`,
+				secretPropertiesSourcesPath, i, s.prefix, file),
+		)
+	}
+	return strings.Join(lines, "\n")
+}
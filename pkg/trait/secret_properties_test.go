@@ -0,0 +1,132 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+)
+
+func TestConfigureSecretPropertiesTraitDoesSucceed(t *testing.T) {
+	secretPropertiesTrait, environment, _ := createNominalSecretPropertiesTest()
+	configured, err := secretPropertiesTrait.Configure(environment)
+
+	assert.True(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestConfigureSecretPropertiesTraitWithoutSecretsDoesNotSucceed(t *testing.T) {
+	secretPropertiesTrait, environment, _ := createNominalSecretPropertiesTest()
+	secretPropertiesTrait.Secrets = nil
+	configured, err := secretPropertiesTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestConfigureSecretPropertiesTraitWithInvalidSecretFails(t *testing.T) {
+	for _, secrets := range [][]string{{":camel."}, {"my-secret:camel.$(id)"}, {"my-secret", "my-secret:camel."}} {
+		secretPropertiesTrait, environment, _ := createNominalSecretPropertiesTest()
+		secretPropertiesTrait.Secrets = secrets
+		configured, err := secretPropertiesTrait.Configure(environment)
+
+		assert.False(t, configured)
+		assert.NotNil(t, err, secrets)
+	}
+}
+
+func TestApplySecretPropertiesTraitDoesSucceed(t *testing.T) {
+	secretPropertiesTrait, environment, deployment := createNominalSecretPropertiesTest()
+
+	err := secretPropertiesTrait.Apply(environment)
+
+	assert.Nil(t, err)
+
+	spec := deployment.Spec.Template.Spec
+	assert.Len(t, spec.Volumes, 3)
+	assert.Equal(t, "secret-properties", spec.Volumes[0].Name)
+	assert.Equal(t, corev1.StorageMediumMemory, spec.Volumes[0].EmptyDir.Medium)
+	assert.Equal(t, "my-kafka", spec.Volumes[1].Secret.SecretName)
+	assert.Equal(t, "my-credentials", spec.Volumes[2].Secret.SecretName)
+
+	assert.Len(t, spec.InitContainers, 1)
+	initContainer := spec.InitContainers[0]
+	assert.Equal(t, "secret-properties", initContainer.Name)
+	assert.Equal(t, "my-image", initContainer.Image)
+	assert.Len(t, initContainer.VolumeMounts, 3)
+	assert.Equal(t, "/etc/camel/conf.d/_secret-properties", initContainer.VolumeMounts[0].MountPath)
+	assert.Equal(t, "/etc/camel/secret-properties/0", initContainer.VolumeMounts[1].MountPath)
+	assert.Equal(t, "/etc/camel/secret-properties/1", initContainer.VolumeMounts[2].MountPath)
+	assert.Contains(t, initContainer.Args[0], `"camel.component.kafka.$(basename "$f")"`)
+	assert.Contains(t, initContainer.Args[0], "/etc/camel/conf.d/_secret-properties/000-my-kafka.properties")
+	assert.Contains(t, initContainer.Args[0], `"$(basename "$f")"`)
+	assert.Contains(t, initContainer.Args[0], "/etc/camel/conf.d/_secret-properties/001-my-credentials.properties")
+
+	container := spec.Containers[0]
+	assert.Equal(t, []corev1.VolumeMount{{Name: "secret-properties", MountPath: "/etc/camel/conf.d/_secret-properties", ReadOnly: true}}, container.VolumeMounts)
+
+	assert.Empty(t, spec.Containers[1].VolumeMounts)
+}
+
+func createNominalSecretPropertiesTest() (*secretPropertiesTrait, *Environment, *appsv1.Deployment) {
+	trait := newSecretPropertiesTrait().(*secretPropertiesTrait)
+	trait.Secrets = []string{
+		"my-kafka:camel.component.kafka.",
+		"my-credentials",
+	}
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "integration-name",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: defaultContainerName, Image: "my-image"},
+						{Name: "log-shipper"},
+					},
+				},
+			},
+		},
+	}
+
+	environment := &Environment{
+		Catalog: NewCatalog(context.TODO(), nil),
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "integration-name",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+		},
+		Resources: kubernetes.NewCollection(deployment),
+	}
+
+	return trait, environment, deployment
+}
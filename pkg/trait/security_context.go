@@ -0,0 +1,153 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/api/batch/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	serving "knative.dev/serving/pkg/apis/serving/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+// The Security Context trait configures the security context of the integration pod and container,
+// e.g. to comply with the restricted Pod Security Standards enforced by some clusters.
+//
+// Knative services only get the seccomp profile, as the other security context fields are rejected by Knative.
+//
+// It's disabled by default.
+//
+// +camel-k:trait=security-context
+type securityContextTrait struct {
+	BaseTrait `property:",squash"`
+	// Require the integration pod containers to run as a non-root user.
+	RunAsNonRoot *bool `property:"run-as-non-root" json:"runAsNonRoot,omitempty"`
+	// The UID used to run the entrypoint of the integration pod containers.
+	RunAsUser *int64 `property:"run-as-user" json:"runAsUser,omitempty"`
+	// The supplemental group applied to the volumes mounted into the integration pod.
+	FSGroup *int64 `property:"fs-group" json:"fsGroup,omitempty"`
+	// The seccomp profile of the integration pod, either `RuntimeDefault` or `Unconfined`.
+	SeccompProfile string `property:"seccomp-profile" json:"seccompProfile,omitempty"`
+	// Mount the root filesystem of the integration container as read-only.
+	ReadOnlyRootFilesystem *bool `property:"read-only-root-filesystem" json:"readOnlyRootFilesystem,omitempty"`
+}
+
+const (
+	seccompProfileRuntimeDefault = "RuntimeDefault"
+	seccompProfileUnconfined     = "Unconfined"
+)
+
+// The seccomp profiles, as set with the pod annotation
+var seccompProfiles = map[string]string{
+	seccompProfileRuntimeDefault: corev1.SeccompProfileRuntimeDefault,
+	seccompProfileUnconfined:     "unconfined",
+}
+
+func newSecurityContextTrait() Trait {
+	return &securityContextTrait{
+		BaseTrait: NewBaseTrait("security-context", 1620),
+	}
+}
+
+func (t *securityContextTrait) Configure(e *Environment) (bool, error) {
+	if t.Enabled == nil || !*t.Enabled {
+		return false, nil
+	}
+
+	if t.RunAsUser != nil && *t.RunAsUser < 0 {
+		return false, fmt.Errorf("invalid run-as-user %d: it must be a positive UID", *t.RunAsUser)
+	}
+	if t.RunAsUser != nil && *t.RunAsUser == 0 && t.RunAsNonRoot != nil && *t.RunAsNonRoot {
+		return false, fmt.Errorf("run-as-user 0 cannot be combined with run-as-non-root")
+	}
+	if t.FSGroup != nil && *t.FSGroup < 0 {
+		return false, fmt.Errorf("invalid fs-group %d: it must be a positive GID", *t.FSGroup)
+	}
+	if _, ok := seccompProfiles[t.SeccompProfile]; t.SeccompProfile != "" && !ok {
+		return false, fmt.Errorf("unknown seccomp profile: %s. One of [%s, %s] is expected", t.SeccompProfile, seccompProfileRuntimeDefault, seccompProfileUnconfined)
+	}
+
+	return e.IntegrationInPhase(v1.IntegrationPhaseDeploying, v1.IntegrationPhaseRunning), nil
+}
+
+func (t *securityContextTrait) Apply(e *Environment) error {
+	if t.RunAsNonRoot != nil || t.RunAsUser != nil || t.FSGroup != nil {
+		e.Resources.VisitDeployment(func(d *appsv1.Deployment) {
+			t.setPodSecurityContext(&d.Spec.Template.Spec)
+		})
+		e.Resources.VisitCronJob(func(c *v1beta1.CronJob) {
+			t.setPodSecurityContext(&c.Spec.JobTemplate.Spec.Template.Spec)
+		})
+		// Knative rejects the pod security context, unless a feature flag is enabled on the cluster
+		e.Resources.VisitKnativeService(func(*serving.Service) {
+			t.L.ForIntegration(e.Integration).Infof("Skipping pod security context, not supported by Knative services")
+		})
+	}
+
+	if t.SeccompProfile != "" {
+		// The pod seccomp profile can only be set with an annotation on Kubernetes 1.17
+		profile := seccompProfiles[t.SeccompProfile]
+		e.Resources.VisitDeployment(func(d *appsv1.Deployment) {
+			setSeccompProfile(&d.Spec.Template.ObjectMeta, profile)
+		})
+		e.Resources.VisitKnativeService(func(s *serving.Service) {
+			setSeccompProfile(&s.Spec.Template.ObjectMeta, profile)
+		})
+		e.Resources.VisitCronJob(func(c *v1beta1.CronJob) {
+			setSeccompProfile(&c.Spec.JobTemplate.Spec.Template.ObjectMeta, profile)
+		})
+	}
+
+	if t.ReadOnlyRootFilesystem != nil {
+		if e.Resources.GetKnativeService(func(*serving.Service) bool { return true }) != nil {
+			// Knative only accepts the run-as-user field of the container security context
+			t.L.ForIntegration(e.Integration).Infof("Skipping read-only root filesystem, not supported by Knative services")
+			return nil
+		}
+		container := e.getIntegrationContainer()
+		if container != nil {
+			if container.SecurityContext == nil {
+				container.SecurityContext = &corev1.SecurityContext{}
+			}
+			container.SecurityContext.ReadOnlyRootFilesystem = t.ReadOnlyRootFilesystem
+		}
+	}
+
+	return nil
+}
+
+func (t *securityContextTrait) setPodSecurityContext(p *corev1.PodSpec) {
+	if p.SecurityContext == nil {
+		p.SecurityContext = &corev1.PodSecurityContext{}
+	}
+	p.SecurityContext.RunAsNonRoot = t.RunAsNonRoot
+	p.SecurityContext.RunAsUser = t.RunAsUser
+	p.SecurityContext.FSGroup = t.FSGroup
+}
+
+func setSeccompProfile(meta *metav1.ObjectMeta, profile string) {
+	if meta.Annotations == nil {
+		meta.Annotations = make(map[string]string)
+	}
+	meta.Annotations[corev1.SeccompPodAnnotationKey] = profile
+}
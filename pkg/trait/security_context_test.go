@@ -0,0 +1,175 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	serving "knative.dev/serving/pkg/apis/serving/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+)
+
+func TestConfigureSecurityContextTraitDoesSucceed(t *testing.T) {
+	securityContextTrait, environment := createNominalSecurityContextTest()
+	configured, err := securityContextTrait.Configure(environment)
+
+	assert.True(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestConfigureDisabledSecurityContextTraitDoesNotSucceed(t *testing.T) {
+	securityContextTrait, environment := createNominalSecurityContextTest()
+	securityContextTrait.Enabled = nil
+	configured, err := securityContextTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestConfigureSecurityContextTraitWithInvalidSeccompProfileFails(t *testing.T) {
+	securityContextTrait, environment := createNominalSecurityContextTest()
+	securityContextTrait.SeccompProfile = "Localhost"
+	configured, err := securityContextTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+	assert.Equal(t, "unknown seccomp profile: Localhost. One of [RuntimeDefault, Unconfined] is expected", err.Error())
+}
+
+func TestConfigureSecurityContextTraitWithRootUserFails(t *testing.T) {
+	securityContextTrait, environment := createNominalSecurityContextTest()
+	root := int64(0)
+	securityContextTrait.RunAsUser = &root
+	configured, err := securityContextTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestApplySecurityContextTraitDoesSucceed(t *testing.T) {
+	securityContextTrait, environment := createNominalSecurityContextTest()
+
+	err := securityContextTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	d := environment.Resources.GetDeployment(func(deployment *appsv1.Deployment) bool { return true })
+	assert.NotNil(t, d)
+
+	sc := d.Spec.Template.Spec.SecurityContext
+	assert.NotNil(t, sc)
+	assert.True(t, *sc.RunAsNonRoot)
+	assert.Equal(t, int64(1000), *sc.RunAsUser)
+	assert.Equal(t, int64(2000), *sc.FSGroup)
+	assert.Equal(t, "runtime/default", d.Spec.Template.Annotations["seccomp.security.alpha.kubernetes.io/pod"])
+
+	container := d.Spec.Template.Spec.Containers[0]
+	assert.NotNil(t, container.SecurityContext)
+	assert.True(t, *container.SecurityContext.ReadOnlyRootFilesystem)
+}
+
+func TestApplySecurityContextTraitOnKnativeService(t *testing.T) {
+	securityContextTrait, environment := createNominalSecurityContextTest()
+	environment.Resources = kubernetes.NewCollection(&serving.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "integration-name",
+			Namespace: "namespace",
+		},
+		Spec: serving.ServiceSpec{
+			ConfigurationSpec: serving.ConfigurationSpec{
+				Template: serving.RevisionTemplateSpec{
+					Spec: serving.RevisionSpec{
+						PodSpec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name: defaultContainerName,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	err := securityContextTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	s := environment.Resources.GetKnativeService(func(*serving.Service) bool { return true })
+	assert.NotNil(t, s)
+
+	// Only the seccomp profile is supported by Knative
+	assert.Nil(t, s.Spec.Template.Spec.SecurityContext)
+	assert.Nil(t, s.Spec.Template.Spec.Containers[0].SecurityContext)
+	assert.Equal(t, "runtime/default", s.Spec.Template.Annotations["seccomp.security.alpha.kubernetes.io/pod"])
+}
+
+func createNominalSecurityContextTest() (*securityContextTrait, *Environment) {
+	trait := newSecurityContextTrait().(*securityContextTrait)
+	enabled := true
+	trait.Enabled = &enabled
+	nonRoot := true
+	trait.RunAsNonRoot = &nonRoot
+	user := int64(1000)
+	trait.RunAsUser = &user
+	group := int64(2000)
+	trait.FSGroup = &group
+	trait.SeccompProfile = "RuntimeDefault"
+	readOnly := true
+	trait.ReadOnlyRootFilesystem = &readOnly
+
+	environment := &Environment{
+		Catalog: NewCatalog(context.TODO(), nil),
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "integration-name",
+				Namespace: "namespace",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+		},
+		Resources: kubernetes.NewCollection(&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "integration-name",
+				Namespace: "namespace",
+			},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name: defaultContainerName,
+							},
+						},
+					},
+				},
+			},
+		}),
+	}
+
+	return trait, environment
+}
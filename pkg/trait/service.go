@@ -18,8 +18,14 @@ limitations under the License.
 package trait
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/metadata"
@@ -36,13 +42,34 @@ type serviceTrait struct {
 	BaseTrait `property:",squash"`
 	// To automatically detect from the code if a Service needs to be created.
 	Auto *bool `property:"auto" json:"auto,omitempty"`
-	// Enable Service to be exposed as NodePort
+	// Enable Service to be exposed as NodePort (deprecated, use the `type` property instead)
 	NodePort *bool `property:"node-port" json:"nodePort,omitempty"`
+	// The type of the Service, one of `ClusterIP`, `NodePort` or `LoadBalancer`.
+	// When not set, the Service is exposed as NodePort, unless `node-port` is `false`.
+	Type string `property:"type" json:"type,omitempty"`
+	// The node port number of the integration HTTP port, when the Service is exposed as NodePort or LoadBalancer.
+	// It must be within the cluster node port range, by default 30000-32767. When not set, it is assigned by Kubernetes.
+	//
+	// The assigned node ports are reported into the integration `ServiceAvailable` condition.
+	NodePortNumber int `property:"node-port-number" json:"nodePortNumber,omitempty"`
+	// To create a headless Service, i.e. without cluster IP, so that the Service DNS name resolves to the IPs of
+	// the integration pods. A headless Service is of type `ClusterIP`, and cannot be exposed as NodePort or LoadBalancer.
+	Headless *bool `property:"headless" json:"headless,omitempty"`
+	// A list of ports exposed by the Service, each one described as `name:port[:targetPort[:protocol]]`,
+	// e.g. `metrics:9779` or `grpc:9090:grpc:TCP`. The target port can be a number or the name of a container port,
+	// and defaults to the port. The protocol is one of `TCP` (default), `UDP` or `SCTP`.
+	//
+	// The port configured with the container trait `service-port` and `service-port-name` properties
+	// is added to the list, unless a port with the same name is declared.
+	Ports []string `property:"ports" json:"ports,omitempty"`
 }
 
 const (
 	serviceTraitID = "service"
 	httpPortName   = "http"
+
+	minNodePort = 30000
+	maxNodePort = 32767
 )
 
 func newServiceTrait() Trait {
@@ -77,6 +104,33 @@ func (t *serviceTrait) Configure(e *Environment) (bool, error) {
 		return false, nil
 	}
 
+	if _, err := t.parsePorts(); err != nil {
+		return false, err
+	}
+
+	switch corev1.ServiceType(t.Type) {
+	case "", corev1.ServiceTypeClusterIP, corev1.ServiceTypeNodePort, corev1.ServiceTypeLoadBalancer:
+	default:
+		return false, fmt.Errorf("unsupported service type: %s, must be one of %s, %s or %s",
+			t.Type, corev1.ServiceTypeClusterIP, corev1.ServiceTypeNodePort, corev1.ServiceTypeLoadBalancer)
+	}
+
+	if t.isHeadless() && t.serviceType() != corev1.ServiceTypeClusterIP {
+		return false, fmt.Errorf("a headless service must be of type %s, not %s",
+			corev1.ServiceTypeClusterIP, t.serviceType())
+	}
+
+	if t.NodePortNumber != 0 {
+		if !t.hasNodePorts() {
+			return false, fmt.Errorf("the node port number can only be set for %s or %s services",
+				corev1.ServiceTypeNodePort, corev1.ServiceTypeLoadBalancer)
+		}
+		if t.NodePortNumber < minNodePort || t.NodePortNumber > maxNodePort {
+			return false, fmt.Errorf("invalid node port number: %d, must be between %d and %d",
+				t.NodePortNumber, minNodePort, maxNodePort)
+		}
+	}
+
 	if t.Auto == nil || *t.Auto {
 		sources, err := kubernetes.ResolveIntegrationSources(t.Ctx, t.Client, e.Integration, e.Resources)
 		if err != nil {
@@ -111,20 +165,186 @@ func (t *serviceTrait) isNodePort() bool {
 
 }
 
+func (t *serviceTrait) serviceType() corev1.ServiceType {
+	if t.Type != "" {
+		return corev1.ServiceType(t.Type)
+	}
+	if t.NodePort == nil && t.isHeadless() {
+		return corev1.ServiceTypeClusterIP
+	}
+	if t.isNodePort() {
+		return corev1.ServiceTypeNodePort
+	}
+	return corev1.ServiceTypeClusterIP
+}
+
+func (t *serviceTrait) isHeadless() bool {
+	return t.Headless != nil && *t.Headless
+}
+
+func (t *serviceTrait) hasNodePorts() bool {
+	serviceType := t.serviceType()
+	return serviceType == corev1.ServiceTypeNodePort || serviceType == corev1.ServiceTypeLoadBalancer
+}
+
 func (t *serviceTrait) Apply(e *Environment) error {
+	ports, err := t.parsePorts()
+	if err != nil {
+		return err
+	}
+
 	svc := e.Resources.GetServiceForIntegration(e.Integration)
 	// add a new service if not already created
 	if svc == nil {
 		svc = getServiceFor(e)
 
-		if t.isNodePort() {
-			svc.Spec.Type = corev1.ServiceTypeNodePort
+		if serviceType := t.serviceType(); serviceType != corev1.ServiceTypeClusterIP {
+			svc.Spec.Type = serviceType
+		}
+		if t.isHeadless() {
+			svc.Spec.ClusterIP = corev1.ClusterIPNone
+		}
+	}
+	for _, port := range ports {
+		if !hasServicePort(svc, port.Name) {
+			svc.Spec.Ports = append(svc.Spec.Ports, port)
 		}
 	}
 	e.Resources.Add(svc)
+
+	if t.NodePortNumber != 0 {
+		// The integration HTTP port is added to the service by the container trait
+		e.PostProcessors = append(e.PostProcessors, func(env *Environment) error {
+			portName := httpPortName
+			if ct := env.Catalog.GetTrait(containerTraitID); ct != nil {
+				portName = ct.(*containerTrait).ServicePortName
+			}
+			for i := range svc.Spec.Ports {
+				if svc.Spec.Ports[i].Name == portName {
+					svc.Spec.Ports[i].NodePort = int32(t.NodePortNumber)
+				}
+			}
+			return nil
+		})
+	}
+
+	if t.hasNodePorts() {
+		// The node ports are only known once the service is created
+		e.PostActions = append(e.PostActions, func(env *Environment) error {
+			return t.reportNodePorts(env, svc)
+		})
+	}
+
+	return nil
+}
+
+const nodePortsMessageSeparator = ", node ports: "
+
+// reportNodePorts adds the node ports assigned to the service into the integration ServiceAvailable condition
+func (t *serviceTrait) reportNodePorts(e *Environment, svc *corev1.Service) error {
+	condition := e.Integration.Status.GetCondition(v1.IntegrationConditionServiceAvailable)
+	if condition == nil || condition.Status != corev1.ConditionTrue {
+		return nil
+	}
+
+	current, err := kubernetes.GetService(e.C, e.Client, svc.Name, svc.Namespace)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	nodePorts := make([]string, 0, len(current.Spec.Ports))
+	for _, port := range current.Spec.Ports {
+		if port.NodePort != 0 {
+			nodePorts = append(nodePorts, fmt.Sprintf("%s/%d", port.Name, port.NodePort))
+		}
+	}
+	if len(nodePorts) == 0 {
+		return nil
+	}
+
+	// The node ports reported by a former reconciliation are replaced by the current ones
+	message := condition.Message
+	if i := strings.Index(message, nodePortsMessageSeparator); i >= 0 {
+		message = message[:i]
+	}
+
+	updated := *condition
+	updated.Message = fmt.Sprintf("%s%s%s", message, nodePortsMessageSeparator, strings.Join(nodePorts, ", "))
+	updated.LastUpdateTime = metav1.Now()
+
+	// The condition is removed first so that the message is updated, as the status and the reason do not change
+	e.Integration.Status.RemoveCondition(v1.IntegrationConditionServiceAvailable)
+	e.Integration.Status.SetConditions(updated)
+
 	return nil
 }
 
+func (t *serviceTrait) parsePorts() ([]corev1.ServicePort, error) {
+	ports := make([]corev1.ServicePort, 0, len(t.Ports))
+	names := make(map[string]bool)
+
+	for _, config := range t.Ports {
+		parts := strings.Split(config, ":")
+		if len(parts) < 2 || len(parts) > 4 {
+			return nil, fmt.Errorf("invalid service port: %s, must be in the name:port[:targetPort[:protocol]] format", config)
+		}
+
+		name := parts[0]
+		if name == "" {
+			return nil, fmt.Errorf("invalid service port: %s, the name is required", config)
+		}
+		if names[name] {
+			return nil, fmt.Errorf("duplicate service port name: %s", name)
+		}
+		names[name] = true
+
+		port, err := strconv.Atoi(parts[1])
+		if err != nil || port <= 0 || port > 65535 {
+			return nil, fmt.Errorf("invalid service port: %s, the port must be a number between 1 and 65535", config)
+		}
+
+		targetPort := intstr.FromInt(port)
+		if len(parts) > 2 && parts[2] != "" {
+			targetPort = intstr.Parse(parts[2])
+			if targetPort.Type == intstr.Int && (targetPort.IntVal <= 0 || targetPort.IntVal > 65535) {
+				return nil, fmt.Errorf("invalid service port: %s, the target port must be a number between 1 and 65535 or a port name", config)
+			}
+		}
+
+		protocol := corev1.ProtocolTCP
+		if len(parts) > 3 && parts[3] != "" {
+			protocol = corev1.Protocol(parts[3])
+			switch protocol {
+			case corev1.ProtocolTCP, corev1.ProtocolUDP, corev1.ProtocolSCTP:
+			default:
+				return nil, fmt.Errorf("invalid service port: %s, unsupported protocol %s, must be one of %s, %s or %s",
+					config, protocol, corev1.ProtocolTCP, corev1.ProtocolUDP, corev1.ProtocolSCTP)
+			}
+		}
+
+		ports = append(ports, corev1.ServicePort{
+			Name:       name,
+			Port:       int32(port),
+			TargetPort: targetPort,
+			Protocol:   protocol,
+		})
+	}
+
+	return ports, nil
+}
+
+func hasServicePort(service *corev1.Service, name string) bool {
+	for _, port := range service.Spec.Ports {
+		if port.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 func getServiceFor(e *Environment) *corev1.Service {
 	return &corev1.Service{
 		TypeMeta: metav1.TypeMeta{
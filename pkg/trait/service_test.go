@@ -26,6 +26,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/util/camel"
@@ -370,3 +371,288 @@ func TestServiceWithNodePort(t *testing.T) {
 
 	assert.Equal(t, corev1.ServiceTypeNodePort, s.Spec.Type)
 }
+
+func TestServiceWithPorts(t *testing.T) {
+	environment := createServiceTestEnvironment(t, map[string]v1.TraitSpec{
+		"service": test.TraitSpecFromMap(t, map[string]interface{}{
+			"enabled": true,
+			"auto":    false,
+			"ports":   []string{"metrics:9779", "grpc:9090:grpc:TCP", "dns:53:5353:UDP"},
+		}),
+	})
+
+	err := environment.Catalog.apply(environment)
+
+	assert.Nil(t, err)
+
+	s := environment.Resources.GetService(func(service *corev1.Service) bool {
+		return service.Name == ServiceTestName
+	})
+
+	assert.NotNil(t, s)
+	assert.Len(t, s.Spec.Ports, 4)
+	assert.Equal(t, corev1.ServicePort{Name: "metrics", Port: 9779, TargetPort: intstr.FromInt(9779), Protocol: corev1.ProtocolTCP}, s.Spec.Ports[0])
+	assert.Equal(t, corev1.ServicePort{Name: "grpc", Port: 9090, TargetPort: intstr.FromString("grpc"), Protocol: corev1.ProtocolTCP}, s.Spec.Ports[1])
+	assert.Equal(t, corev1.ServicePort{Name: "dns", Port: 53, TargetPort: intstr.FromInt(5353), Protocol: corev1.ProtocolUDP}, s.Spec.Ports[2])
+	assert.Equal(t, corev1.ServicePort{Name: "http", Port: 80, TargetPort: intstr.FromString("http"), Protocol: corev1.ProtocolTCP}, s.Spec.Ports[3])
+}
+
+func TestServiceWithPortsOverridingContainerPort(t *testing.T) {
+	environment := createServiceTestEnvironment(t, map[string]v1.TraitSpec{
+		"service": test.TraitSpecFromMap(t, map[string]interface{}{
+			"enabled": true,
+			"auto":    false,
+			"ports":   []string{"http:8000:http"},
+		}),
+	})
+
+	err := environment.Catalog.apply(environment)
+
+	assert.Nil(t, err)
+
+	s := environment.Resources.GetService(func(service *corev1.Service) bool {
+		return service.Name == ServiceTestName
+	})
+
+	assert.NotNil(t, s)
+	assert.Len(t, s.Spec.Ports, 1)
+	assert.Equal(t, int32(8000), s.Spec.Ports[0].Port)
+	assert.Equal(t, "http", s.Spec.Ports[0].TargetPort.String())
+}
+
+func TestServiceWithInvalidPorts(t *testing.T) {
+	for _, ports := range [][]string{{"metrics"}, {":9779"}, {"metrics:abc"}, {"metrics:9779:0"}, {"metrics:9779:9779:HTTP"}, {"metrics:9779", "metrics:9780"}} {
+		environment := createServiceTestEnvironment(t, map[string]v1.TraitSpec{
+			"service": test.TraitSpecFromMap(t, map[string]interface{}{
+				"enabled": true,
+				"auto":    false,
+				"ports":   ports,
+			}),
+		})
+
+		err := environment.Catalog.apply(environment)
+
+		assert.NotNil(t, err, ports)
+	}
+}
+
+func TestServiceWithType(t *testing.T) {
+	environment := createServiceTestEnvironment(t, map[string]v1.TraitSpec{
+		"service": test.TraitSpecFromMap(t, map[string]interface{}{
+			"enabled":        true,
+			"auto":           false,
+			"type":           string(corev1.ServiceTypeLoadBalancer),
+			"nodePortNumber": 30080,
+		}),
+	})
+
+	err := environment.Catalog.apply(environment)
+
+	assert.Nil(t, err)
+
+	s := environment.Resources.GetService(func(service *corev1.Service) bool {
+		return service.Name == ServiceTestName
+	})
+
+	assert.NotNil(t, s)
+	assert.Equal(t, corev1.ServiceTypeLoadBalancer, s.Spec.Type)
+	assert.Len(t, s.Spec.Ports, 1)
+	assert.Equal(t, int32(30080), s.Spec.Ports[0].NodePort)
+}
+
+func TestServiceWithClusterIPType(t *testing.T) {
+	environment := createServiceTestEnvironment(t, map[string]v1.TraitSpec{
+		"service": test.TraitSpecFromMap(t, map[string]interface{}{
+			"enabled": true,
+			"auto":    false,
+			"type":    string(corev1.ServiceTypeClusterIP),
+		}),
+	})
+
+	err := environment.Catalog.apply(environment)
+
+	assert.Nil(t, err)
+
+	s := environment.Resources.GetService(func(service *corev1.Service) bool {
+		return service.Name == ServiceTestName
+	})
+
+	assert.NotNil(t, s)
+	assert.Equal(t, corev1.ServiceType(""), s.Spec.Type)
+	assert.Equal(t, int32(0), s.Spec.Ports[0].NodePort)
+}
+
+func TestServiceWithInvalidNodePortNumber(t *testing.T) {
+	configurations := []map[string]interface{}{
+		{"type": "ExternalName"},
+		{"type": string(corev1.ServiceTypeClusterIP), "nodePortNumber": 30080},
+		{"type": string(corev1.ServiceTypeNodePort), "nodePortNumber": 8080},
+		{"nodePortNumber": 40000},
+	}
+
+	for _, configuration := range configurations {
+		configuration["enabled"] = true
+		configuration["auto"] = false
+		environment := createServiceTestEnvironment(t, map[string]v1.TraitSpec{
+			"service": test.TraitSpecFromMap(t, configuration),
+		})
+
+		err := environment.Catalog.apply(environment)
+
+		assert.NotNil(t, err, configuration)
+	}
+}
+
+func TestServiceHeadless(t *testing.T) {
+	environment := createServiceTestEnvironment(t, map[string]v1.TraitSpec{
+		"service": test.TraitSpecFromMap(t, map[string]interface{}{
+			"enabled":  true,
+			"auto":     false,
+			"headless": true,
+			"ports":    []string{"gossip:7800"},
+		}),
+	})
+
+	err := environment.Catalog.apply(environment)
+
+	assert.Nil(t, err)
+
+	s := environment.Resources.GetService(func(service *corev1.Service) bool {
+		return service.Name == ServiceTestName
+	})
+
+	assert.NotNil(t, s)
+	assert.Equal(t, corev1.ServiceType(""), s.Spec.Type)
+	assert.Equal(t, corev1.ClusterIPNone, s.Spec.ClusterIP)
+	assert.Len(t, s.Spec.Ports, 2)
+	assert.True(t, hasServicePort(s, "gossip"))
+	assert.True(t, hasServicePort(s, "http"))
+}
+
+func TestServiceHeadlessWithInvalidType(t *testing.T) {
+	configurations := []map[string]interface{}{
+		{"type": string(corev1.ServiceTypeLoadBalancer)},
+		{"type": string(corev1.ServiceTypeNodePort)},
+		{"nodePort": true},
+	}
+
+	for _, configuration := range configurations {
+		configuration["enabled"] = true
+		configuration["auto"] = false
+		configuration["headless"] = true
+		environment := createServiceTestEnvironment(t, map[string]v1.TraitSpec{
+			"service": test.TraitSpecFromMap(t, configuration),
+		})
+
+		err := environment.Catalog.apply(environment)
+
+		assert.NotNil(t, err, configuration)
+	}
+}
+
+func TestServiceReportNodePorts(t *testing.T) {
+	client, err := test.NewFakeClient(&corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Service",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ServiceTestName,
+			Namespace: ServiceTestNamespace,
+		},
+		Spec: corev1.ServiceSpec{
+			Type: corev1.ServiceTypeNodePort,
+			Ports: []corev1.ServicePort{
+				{Name: "http", Port: 80, NodePort: 31234},
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	environment := createServiceTestEnvironment(t, nil)
+	environment.C = context.TODO()
+	environment.Client = client
+	environment.Integration.Status.SetCondition(
+		v1.IntegrationConditionServiceAvailable,
+		corev1.ConditionTrue,
+		v1.IntegrationConditionServiceAvailableReason,
+		"test(http/80) -> integration(http/8080)",
+	)
+
+	trait := newServiceTrait().(*serviceTrait)
+	err = trait.reportNodePorts(environment, &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ServiceTestName,
+			Namespace: ServiceTestNamespace,
+		},
+	})
+
+	assert.Nil(t, err)
+	condition := environment.Integration.Status.GetCondition(v1.IntegrationConditionServiceAvailable)
+	assert.Equal(t, "test(http/80) -> integration(http/8080), node ports: http/31234", condition.Message)
+
+	// The node ports are not reported twice on the following reconciliations
+	err = trait.reportNodePorts(environment, &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ServiceTestName,
+			Namespace: ServiceTestNamespace,
+		},
+	})
+
+	assert.Nil(t, err)
+	condition = environment.Integration.Status.GetCondition(v1.IntegrationConditionServiceAvailable)
+	assert.Equal(t, "test(http/80) -> integration(http/8080), node ports: http/31234", condition.Message)
+}
+
+func createServiceTestEnvironment(t *testing.T, traits map[string]v1.TraitSpec) *Environment {
+	catalog, err := camel.DefaultCatalog()
+	assert.Nil(t, err)
+
+	environment := &Environment{
+		CamelCatalog: catalog,
+		Catalog:      NewCatalog(context.TODO(), nil),
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ServiceTestName,
+				Namespace: ServiceTestNamespace,
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+			Spec: v1.IntegrationSpec{
+				Profile: v1.TraitProfileKubernetes,
+				Sources: []v1.SourceSpec{
+					{
+						DataSpec: v1.DataSpec{
+							Name:        "routes.js",
+							Content:     `from("undertow:test").log("hello")`,
+							Compression: true,
+						},
+						Language: v1.LanguageJavaScript,
+					},
+				},
+				Traits: traits,
+			},
+		},
+		IntegrationKit: &v1.IntegrationKit{
+			Status: v1.IntegrationKitStatus{
+				Phase: v1.IntegrationKitPhaseReady,
+			},
+		},
+		Platform: &v1.IntegrationPlatform{
+			Spec: v1.IntegrationPlatformSpec{
+				Cluster: v1.IntegrationPlatformClusterOpenShift,
+				Build: v1.IntegrationPlatformBuildSpec{
+					PublishStrategy: v1.IntegrationPlatformBuildPublishStrategyS2I,
+					Registry:        v1.IntegrationPlatformRegistrySpec{Address: "registry"},
+				},
+			},
+		},
+		EnvVars:        make([]corev1.EnvVar, 0),
+		ExecutedTraits: make([]Trait, 0),
+		Resources:      kubernetes.NewCollection(),
+	}
+	environment.Platform.ResyncStatusFullConfig()
+
+	return environment
+}
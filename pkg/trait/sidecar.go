@@ -0,0 +1,111 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	serving "knative.dev/serving/pkg/apis/serving/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+// The Sidecar trait can be used to run additional containers alongside the integration container,
+// e.g. a log shipper or a proxy.
+//
+// The sidecar containers are added to the integration pod, after the integration container has been configured.
+// They are not added to CronJob based integrations, as they would prevent the jobs from completing.
+//
+// It's enabled whenever sidecar containers are configured.
+//
+// +camel-k:trait=sidecar
+type sidecarTrait struct {
+	BaseTrait `property:",squash"`
+	// The sidecar containers to add to the integration pod. Each container must have a name, that must not collide
+	// with the integration container name, and an image. It can optionally define args, env, ports, etc.
+	Containers []corev1.Container `property:"containers" json:"containers,omitempty"`
+}
+
+func newSidecarTrait() Trait {
+	return &sidecarTrait{
+		BaseTrait: NewBaseTrait("sidecar", 1650),
+	}
+}
+
+func (t *sidecarTrait) Configure(e *Environment) (bool, error) {
+	if t.Enabled != nil && !*t.Enabled {
+		return false, nil
+	}
+
+	if len(t.Containers) == 0 {
+		return false, nil
+	}
+
+	if !e.IntegrationInPhase(v1.IntegrationPhaseDeploying, v1.IntegrationPhaseRunning) {
+		return false, nil
+	}
+
+	integrationContainerName := e.getIntegrationContainerName()
+
+	names := make(map[string]bool)
+	for _, c := range t.Containers {
+		if c.Name == "" {
+			return false, fmt.Errorf("sidecar container name is required")
+		}
+		if c.Image == "" {
+			return false, fmt.Errorf("sidecar container image is required: %s", c.Name)
+		}
+		if c.Name == integrationContainerName {
+			return false, fmt.Errorf("sidecar container name collides with the integration container name: %s", c.Name)
+		}
+		if names[c.Name] {
+			return false, fmt.Errorf("duplicate sidecar container name: %s", c.Name)
+		}
+		names[c.Name] = true
+	}
+
+	return true, nil
+}
+
+func (t *sidecarTrait) Apply(e *Environment) error {
+	if err := e.Resources.VisitDeploymentE(func(deployment *appsv1.Deployment) error {
+		return t.addSidecars(&deployment.Spec.Template.Spec)
+	}); err != nil {
+		return err
+	}
+
+	return e.Resources.VisitKnativeServiceE(func(service *serving.Service) error {
+		return t.addSidecars(&service.Spec.ConfigurationSpec.Template.Spec.PodSpec)
+	})
+}
+
+func (t *sidecarTrait) addSidecars(spec *corev1.PodSpec) error {
+	for _, sidecar := range t.Containers {
+		for _, c := range spec.Containers {
+			if c.Name == sidecar.Name {
+				return fmt.Errorf("sidecar container name collides with an existing container name: %s", sidecar.Name)
+			}
+		}
+		spec.Containers = append(spec.Containers, *sidecar.DeepCopy())
+	}
+
+	return nil
+}
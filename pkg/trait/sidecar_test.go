@@ -0,0 +1,171 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	serving "knative.dev/serving/pkg/apis/serving/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+)
+
+func TestConfigureSidecarTraitDoesSucceed(t *testing.T) {
+	sidecarTrait, environment, _ := createNominalSidecarTest()
+	configured, err := sidecarTrait.Configure(environment)
+
+	assert.True(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestConfigureSidecarTraitWithoutContainersDoesNotSucceed(t *testing.T) {
+	sidecarTrait, environment, _ := createNominalSidecarTest()
+	sidecarTrait.Containers = nil
+	configured, err := sidecarTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestConfigureDisabledSidecarTraitDoesNotSucceed(t *testing.T) {
+	sidecarTrait, environment, _ := createNominalSidecarTest()
+	sidecarTrait.Enabled = new(bool)
+	configured, err := sidecarTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestConfigureSidecarTraitWithCollidingNameFails(t *testing.T) {
+	sidecarTrait, environment, _ := createNominalSidecarTest()
+	sidecarTrait.Containers = append(sidecarTrait.Containers, corev1.Container{
+		Name:  defaultContainerName,
+		Image: "envoyproxy/envoy",
+	})
+	configured, err := sidecarTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestConfigureSidecarTraitWithDuplicateNameFails(t *testing.T) {
+	sidecarTrait, environment, _ := createNominalSidecarTest()
+	sidecarTrait.Containers = append(sidecarTrait.Containers, sidecarTrait.Containers[0])
+	configured, err := sidecarTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestConfigureSidecarTraitWithoutImageFails(t *testing.T) {
+	sidecarTrait, environment, _ := createNominalSidecarTest()
+	sidecarTrait.Containers[0].Image = ""
+	configured, err := sidecarTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestApplySidecarTraitOnDeploymentDoesSucceed(t *testing.T) {
+	sidecarTrait, environment, deployment := createNominalSidecarTest()
+
+	err := sidecarTrait.Apply(environment)
+
+	assert.Nil(t, err)
+	assert.Len(t, deployment.Spec.Template.Spec.Containers, 2)
+	assert.Equal(t, defaultContainerName, deployment.Spec.Template.Spec.Containers[0].Name)
+	assert.Equal(t, "log-shipper", deployment.Spec.Template.Spec.Containers[1].Name)
+	assert.Equal(t, "fluent/fluent-bit", deployment.Spec.Template.Spec.Containers[1].Image)
+	assert.Equal(t, []string{"--quiet"}, deployment.Spec.Template.Spec.Containers[1].Args)
+	assert.Equal(t, int32(2020), deployment.Spec.Template.Spec.Containers[1].Ports[0].ContainerPort)
+}
+
+func TestApplySidecarTraitOnKnativeServiceDoesSucceed(t *testing.T) {
+	sidecarTrait, environment, _ := createNominalSidecarTest()
+
+	service := &serving.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "integration-name",
+		},
+	}
+	service.Spec.ConfigurationSpec.Template.Spec.Containers = []corev1.Container{
+		{Name: defaultContainerName},
+	}
+	environment.Resources = kubernetes.NewCollection(service)
+
+	err := sidecarTrait.Apply(environment)
+
+	assert.Nil(t, err)
+	assert.Len(t, service.Spec.ConfigurationSpec.Template.Spec.Containers, 2)
+	assert.Equal(t, "log-shipper", service.Spec.ConfigurationSpec.Template.Spec.Containers[1].Name)
+}
+
+func createNominalSidecarTest() (*sidecarTrait, *Environment, *appsv1.Deployment) {
+	trait := newSidecarTrait().(*sidecarTrait)
+	trait.Containers = []corev1.Container{
+		{
+			Name:  "log-shipper",
+			Image: "fluent/fluent-bit",
+			Args:  []string{"--quiet"},
+			Env: []corev1.EnvVar{
+				{Name: "FLUENT_ELASTICSEARCH_HOST", Value: "elasticsearch"},
+			},
+			Ports: []corev1.ContainerPort{
+				{Name: "metrics", ContainerPort: 2020},
+			},
+		},
+	}
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "integration-name",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: defaultContainerName},
+					},
+				},
+			},
+		},
+	}
+
+	environment := &Environment{
+		Catalog: NewCatalog(context.TODO(), nil),
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "integration-name",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+		},
+		Resources: kubernetes.NewCollection(deployment),
+	}
+
+	return trait, environment, deployment
+}
@@ -0,0 +1,235 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/api/batch/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+
+	serving "knative.dev/serving/pkg/apis/serving/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+// The Toleration trait sets tolerations over the integration pod(s), so that they can be scheduled onto nodes
+// with matching taints. See https://kubernetes.io/docs/concepts/scheduling-eviction/taint-and-toleration/
+// for more details.
+//
+// Tolerations are not supported by Knative services, and are skipped for them.
+//
+// It's disabled by default.
+//
+// +camel-k:trait=toleration
+type tolerationTrait struct {
+	BaseTrait `property:",squash"`
+	// A list of taints to tolerate, in the form `Key[=Value]:Effect[:Seconds]`, e.g. `dedicated=camel:NoSchedule`.
+	// The `Equal` operator is used when a value is set, and `Exists` otherwise.
+	Taints []string `property:"taints" json:"taints,omitempty"`
+	// A list of tolerations, each one described by a comma-separated list of `field=value` pairs, with the fields
+	// `key`, `operator`, `value`, `effect` and `tolerationSeconds`,
+	// e.g. `key=node.kubernetes.io/unreachable,operator=Exists,effect=NoExecute,tolerationSeconds=300`.
+	//
+	// The operator is one of `Equal` (default) or `Exists`, and the effect one of `NoSchedule`, `PreferNoSchedule`
+	// or `NoExecute`, or empty to match all effects. The `tolerationSeconds` field can only be set with the `NoExecute` effect.
+	Tolerations []string `property:"tolerations" json:"tolerations,omitempty"`
+}
+
+var tolerationTaintRegexp = regexp.MustCompile(`^([\w\-./]+)(=([\w\-./]*))?:([a-zA-Z]+)(:(\d+))?$`)
+
+func newTolerationTrait() Trait {
+	return &tolerationTrait{
+		BaseTrait: NewBaseTrait("toleration", 1640),
+	}
+}
+
+func (t *tolerationTrait) Configure(e *Environment) (bool, error) {
+	if t.Enabled == nil || !*t.Enabled {
+		return false, nil
+	}
+
+	if len(t.Taints) == 0 && len(t.Tolerations) == 0 {
+		return false, fmt.Errorf("no taint or toleration was provided")
+	}
+
+	if _, err := t.tolerations(); err != nil {
+		return false, err
+	}
+
+	return e.IntegrationInPhase(v1.IntegrationPhaseDeploying, v1.IntegrationPhaseRunning), nil
+}
+
+func (t *tolerationTrait) Apply(e *Environment) error {
+	tolerations, err := t.tolerations()
+	if err != nil {
+		return err
+	}
+
+	e.Resources.VisitDeployment(func(d *appsv1.Deployment) {
+		d.Spec.Template.Spec.Tolerations = mergeTolerations(d.Spec.Template.Spec.Tolerations, tolerations)
+	})
+	e.Resources.VisitCronJob(func(c *v1beta1.CronJob) {
+		c.Spec.JobTemplate.Spec.Template.Spec.Tolerations = mergeTolerations(c.Spec.JobTemplate.Spec.Template.Spec.Tolerations, tolerations)
+	})
+	e.Resources.VisitKnativeService(func(*serving.Service) {
+		t.L.ForIntegration(e.Integration).Infof("Skipping tolerations, not supported by Knative services")
+	})
+
+	return nil
+}
+
+// mergeTolerations appends the tolerations that are not already set, so that the pod spec is stable across reconciliations
+func mergeTolerations(existing []corev1.Toleration, tolerations []corev1.Toleration) []corev1.Toleration {
+	for _, toleration := range tolerations {
+		found := false
+		for _, e := range existing {
+			if reflect.DeepEqual(e, toleration) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			existing = append(existing, toleration)
+		}
+	}
+	return existing
+}
+
+func (t *tolerationTrait) tolerations() ([]corev1.Toleration, error) {
+	tolerations := make([]corev1.Toleration, 0, len(t.Taints)+len(t.Tolerations))
+
+	for _, taint := range t.Taints {
+		toleration, err := parseTaint(taint)
+		if err != nil {
+			return nil, err
+		}
+		tolerations = append(tolerations, toleration)
+	}
+
+	for _, spec := range t.Tolerations {
+		toleration, err := parseToleration(spec)
+		if err != nil {
+			return nil, err
+		}
+		tolerations = append(tolerations, toleration)
+	}
+
+	return tolerations, nil
+}
+
+func parseTaint(taint string) (corev1.Toleration, error) {
+	match := tolerationTaintRegexp.FindStringSubmatch(taint)
+	if match == nil {
+		return corev1.Toleration{}, fmt.Errorf("invalid taint: %s, must be in the form Key[=Value]:Effect[:Seconds]", taint)
+	}
+
+	toleration := corev1.Toleration{
+		Key:      match[1],
+		Operator: corev1.TolerationOpExists,
+		Effect:   corev1.TaintEffect(match[4]),
+	}
+	if match[2] != "" {
+		toleration.Operator = corev1.TolerationOpEqual
+		toleration.Value = match[3]
+	}
+	if match[6] != "" {
+		seconds, err := strconv.ParseInt(match[6], 10, 64)
+		if err != nil {
+			return corev1.Toleration{}, fmt.Errorf("invalid taint: %s, %v", taint, err)
+		}
+		toleration.TolerationSeconds = &seconds
+	}
+
+	if err := validateToleration(toleration); err != nil {
+		return corev1.Toleration{}, fmt.Errorf("invalid taint: %s, %v", taint, err)
+	}
+
+	return toleration, nil
+}
+
+func parseToleration(spec string) (corev1.Toleration, error) {
+	toleration := corev1.Toleration{}
+
+	for _, field := range strings.Split(spec, ",") {
+		pair := strings.SplitN(strings.TrimSpace(field), "=", 2)
+		if len(pair) != 2 {
+			return corev1.Toleration{}, fmt.Errorf("invalid toleration: %s, %q must be in the form field=value", spec, field)
+		}
+		switch pair[0] {
+		case "key":
+			toleration.Key = pair[1]
+		case "operator":
+			toleration.Operator = corev1.TolerationOperator(pair[1])
+		case "value":
+			toleration.Value = pair[1]
+		case "effect":
+			toleration.Effect = corev1.TaintEffect(pair[1])
+		case "tolerationSeconds":
+			seconds, err := strconv.ParseInt(pair[1], 10, 64)
+			if err != nil {
+				return corev1.Toleration{}, fmt.Errorf("invalid toleration: %s, tolerationSeconds must be a number", spec)
+			}
+			toleration.TolerationSeconds = &seconds
+		default:
+			return corev1.Toleration{}, fmt.Errorf("invalid toleration: %s, unknown field %s", spec, pair[0])
+		}
+	}
+
+	if err := validateToleration(toleration); err != nil {
+		return corev1.Toleration{}, fmt.Errorf("invalid toleration: %s, %v", spec, err)
+	}
+
+	return toleration, nil
+}
+
+func validateToleration(toleration corev1.Toleration) error {
+	switch toleration.Operator {
+	case "", corev1.TolerationOpEqual:
+	case corev1.TolerationOpExists:
+		if toleration.Value != "" {
+			return fmt.Errorf("the value must be empty with the %s operator", corev1.TolerationOpExists)
+		}
+	default:
+		return fmt.Errorf("unsupported operator %s, must be one of %s or %s",
+			toleration.Operator, corev1.TolerationOpEqual, corev1.TolerationOpExists)
+	}
+
+	// An empty key with the Exists operator matches all the taints
+	if toleration.Key == "" && toleration.Operator != corev1.TolerationOpExists {
+		return fmt.Errorf("the key can only be empty with the %s operator", corev1.TolerationOpExists)
+	}
+
+	switch toleration.Effect {
+	case "", corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute:
+	default:
+		return fmt.Errorf("unsupported effect %s, must be one of %s, %s or %s",
+			toleration.Effect, corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute)
+	}
+
+	if toleration.TolerationSeconds != nil && toleration.Effect != corev1.TaintEffectNoExecute {
+		return fmt.Errorf("the toleration seconds can only be set with the %s effect", corev1.TaintEffectNoExecute)
+	}
+
+	return nil
+}
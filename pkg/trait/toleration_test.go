@@ -0,0 +1,180 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	serving "knative.dev/serving/pkg/apis/serving/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+)
+
+func TestConfigureTolerationTraitDoesSucceed(t *testing.T) {
+	tolerationTrait, environment := createNominalTolerationTest()
+	configured, err := tolerationTrait.Configure(environment)
+
+	assert.True(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestConfigureTolerationTraitWithoutTaintsFails(t *testing.T) {
+	tolerationTrait, environment := createNominalTolerationTest()
+	tolerationTrait.Taints = nil
+	tolerationTrait.Tolerations = nil
+	configured, err := tolerationTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.NotNil(t, err)
+}
+
+func TestConfigureTolerationTraitWithInvalidTaintsFails(t *testing.T) {
+	for _, taint := range []string{"dedicated", "dedicated=camel", "dedicated=camel:Evict", "dedicated:NoSchedule:300"} {
+		tolerationTrait, environment := createNominalTolerationTest()
+		tolerationTrait.Taints = []string{taint}
+		configured, err := tolerationTrait.Configure(environment)
+
+		assert.False(t, configured)
+		assert.NotNil(t, err, taint)
+	}
+}
+
+func TestConfigureTolerationTraitWithInvalidTolerationsFails(t *testing.T) {
+	for _, toleration := range []string{
+		"key=dedicated,operator=Matches",
+		"key=dedicated,effect=Evict",
+		"key=dedicated,operator=Exists,value=camel",
+		"operator=Equal,value=camel",
+		"key=dedicated,effect=NoSchedule,tolerationSeconds=300",
+		"key=dedicated,effect=NoExecute,tolerationSeconds=ten",
+		"key=dedicated,priority=high",
+		"key",
+	} {
+		tolerationTrait, environment := createNominalTolerationTest()
+		tolerationTrait.Tolerations = []string{toleration}
+		configured, err := tolerationTrait.Configure(environment)
+
+		assert.False(t, configured)
+		assert.NotNil(t, err, toleration)
+	}
+}
+
+func TestApplyTolerationTraitDoesSucceed(t *testing.T) {
+	tolerationTrait, environment := createNominalTolerationTest()
+
+	err := tolerationTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	d := environment.Resources.GetDeployment(func(deployment *appsv1.Deployment) bool { return true })
+	assert.NotNil(t, d)
+
+	tolerations := d.Spec.Template.Spec.Tolerations
+	assert.Len(t, tolerations, 3)
+
+	assert.Equal(t, "dedicated", tolerations[0].Key)
+	assert.Equal(t, corev1.TolerationOpEqual, tolerations[0].Operator)
+	assert.Equal(t, "camel", tolerations[0].Value)
+	assert.Equal(t, corev1.TaintEffectNoSchedule, tolerations[0].Effect)
+	assert.Nil(t, tolerations[0].TolerationSeconds)
+
+	assert.Equal(t, "node-role.kubernetes.io/master", tolerations[1].Key)
+	assert.Equal(t, corev1.TolerationOpExists, tolerations[1].Operator)
+	assert.Equal(t, corev1.TaintEffectNoExecute, tolerations[1].Effect)
+	assert.Equal(t, int64(60), *tolerations[1].TolerationSeconds)
+
+	assert.Equal(t, "node.kubernetes.io/unreachable", tolerations[2].Key)
+	assert.Equal(t, corev1.TolerationOpExists, tolerations[2].Operator)
+	assert.Equal(t, corev1.TaintEffectNoExecute, tolerations[2].Effect)
+	assert.Equal(t, int64(300), *tolerations[2].TolerationSeconds)
+}
+
+func TestApplyTolerationTraitTwiceDoesNotDuplicate(t *testing.T) {
+	tolerationTrait, environment := createNominalTolerationTest()
+
+	assert.Nil(t, tolerationTrait.Apply(environment))
+	assert.Nil(t, tolerationTrait.Apply(environment))
+
+	d := environment.Resources.GetDeployment(func(deployment *appsv1.Deployment) bool { return true })
+	assert.NotNil(t, d)
+	assert.Len(t, d.Spec.Template.Spec.Tolerations, 3)
+}
+
+func TestApplyTolerationTraitSkipsKnativeService(t *testing.T) {
+	tolerationTrait, environment := createNominalTolerationTest()
+	environment.Resources = kubernetes.NewCollection(&serving.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "integration-name",
+			Namespace: "namespace",
+		},
+	})
+
+	err := tolerationTrait.Apply(environment)
+	assert.Nil(t, err)
+
+	s := environment.Resources.GetKnativeService(func(*serving.Service) bool { return true })
+	assert.NotNil(t, s)
+	assert.Empty(t, s.Spec.Template.Spec.Tolerations)
+}
+
+func createNominalTolerationTest() (*tolerationTrait, *Environment) {
+	trait := newTolerationTrait().(*tolerationTrait)
+	enabled := true
+	trait.Enabled = &enabled
+	trait.Taints = []string{"dedicated=camel:NoSchedule", "node-role.kubernetes.io/master:NoExecute:60"}
+	trait.Tolerations = []string{"key=node.kubernetes.io/unreachable,operator=Exists,effect=NoExecute,tolerationSeconds=300"}
+
+	environment := &Environment{
+		Catalog: NewCatalog(context.TODO(), nil),
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "integration-name",
+				Namespace: "namespace",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+		},
+		Resources: kubernetes.NewCollection(&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "integration-name",
+				Namespace: "namespace",
+			},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name: defaultContainerName,
+							},
+						},
+					},
+				},
+			},
+		}),
+	}
+
+	return trait, environment
+}
@@ -32,11 +32,27 @@ func init() {
 	AddToTraits(newDeployerTrait)
 	AddToTraits(newCronTrait)
 	AddToTraits(newDeploymentTrait)
+	AddToTraits(newPdbTrait)
+	AddToTraits(newHpaTrait)
 	AddToTraits(newGarbageCollectorTrait)
 	AddToTraits(newAffinityTrait)
 	AddToTraits(newKnativeServiceTrait)
 	AddToTraits(newServiceTrait)
+	AddToTraits(newLoggingTrait)
+	AddToTraits(newHealthTrait)
+	AddToTraits(newJmxTrait)
+	AddToTraits(newOtelTrait)
+	AddToTraits(newPlatformHTTPTrait)
+	AddToTraits(newCorsTrait)
 	AddToTraits(newContainerTrait)
+	AddToTraits(newMountTrait)
+	AddToTraits(newSecurityContextTrait)
+	AddToTraits(newDNSTrait)
+	AddToTraits(newTolerationTrait)
+	AddToTraits(newSidecarTrait)
+	AddToTraits(newInitContainerTrait)
+	AddToTraits(newTruststoreTrait)
+	AddToTraits(newSecretPropertiesTrait)
 	AddToTraits(newPullSecretTrait)
 	AddToTraits(newJolokiaTrait)
 	AddToTraits(newPrometheusTrait)
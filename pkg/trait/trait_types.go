@@ -62,6 +62,9 @@ var (
 
 	// SecretsMountPath --
 	SecretsMountPath = path.Join(ConfdPath, "_secrets")
+
+	// SecretPropertiesMountPath --
+	SecretPropertiesMountPath = path.Join(ConfdPath, "_secret-properties")
 )
 
 // Identifiable represent an identifiable type
@@ -205,9 +208,12 @@ type Environment struct {
 	PostStepProcessors    []func(*Environment) error
 	PostProcessors        []func(*Environment) error
 	BuildTasks            []v1.Task
+	BuildStrategy         v1.IntegrationPlatformBuildStrategy
+	BuildOrderStrategy    v1.BuildOrderStrategy
 	ConfiguredTraits      []Trait
 	ExecutedTraits        []Trait
 	EnvVars               []corev1.EnvVar
+	EnvFrom               []corev1.EnvFromSource
 	ApplicationProperties map[string]string
 	Interceptors          []string
 }
@@ -727,13 +733,17 @@ func (e *Environment) CollectConfigurationPairs(configurationType string) map[st
 }
 
 func (e *Environment) getIntegrationContainer() *corev1.Container {
+	return e.Resources.GetContainerByName(e.getIntegrationContainerName())
+}
+
+func (e *Environment) getIntegrationContainerName() string {
 	containerName := defaultContainerName
 	dt := e.Catalog.GetTrait(containerTraitID)
 	if dt != nil {
 		containerName = dt.(*containerTrait).Name
 	}
 
-	return e.Resources.GetContainerByName(containerName)
+	return containerName
 }
 
 func (e *Environment) getAllInterceptors() []string {
@@ -0,0 +1,196 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+)
+
+// The Truststore trait can be used to add custom CA certificates to the truststore of the integration JVM,
+// e.g. to call HTTPS endpoints whose certificates are signed by a private CA.
+//
+// Each CA source is described as `configmap:name` or `secret:name`, where all the keys of the referenced resource
+// contain PEM encoded certificates. An init container imports the certificates into a copy of the default JVM
+// truststore, that is then configured as the truststore of the integration JVM.
+//
+// +camel-k:trait=truststore
+type truststoreTrait struct {
+	BaseTrait `property:",squash"`
+	// A list of ConfigMaps or Secrets containing PEM encoded CA certificates, e.g. `configmap:my-ca`
+	// or `secret:my-other-ca`.
+	CACerts []string `property:"ca-certs" json:"caCerts,omitempty"`
+}
+
+const (
+	truststoreVolumeName    = "truststore"
+	truststoreMountPath     = "/etc/camel/truststore"
+	truststoreFile          = truststoreMountPath + "/cacerts"
+	truststorePassword      = "changeit"
+	truststoreCACertsPath   = "/etc/camel/ca-certs"
+	truststoreContainerName = "truststore"
+)
+
+type truststoreSource struct {
+	sourceType string
+	name       string
+}
+
+func newTruststoreTrait() Trait {
+	return &truststoreTrait{
+		BaseTrait: NewBaseTrait("truststore", 1670),
+	}
+}
+
+func (t *truststoreTrait) Configure(e *Environment) (bool, error) {
+	if t.Enabled != nil && !*t.Enabled {
+		return false, nil
+	}
+
+	if len(t.CACerts) == 0 {
+		return false, nil
+	}
+
+	if _, err := t.parseSources(); err != nil {
+		return false, err
+	}
+
+	return e.IntegrationInPhase(v1.IntegrationPhaseDeploying, v1.IntegrationPhaseRunning), nil
+}
+
+func (t *truststoreTrait) Apply(e *Environment) error {
+	sources, err := t.parseSources()
+	if err != nil {
+		return err
+	}
+
+	container := e.getIntegrationContainer()
+	if container == nil {
+		return nil
+	}
+
+	e.Resources.VisitPodSpec(func(spec *corev1.PodSpec) {
+		initContainer := corev1.Container{
+			Name:    truststoreContainerName,
+			Image:   container.Image,
+			Command: []string{"/bin/sh", "-c"},
+			Args:    []string{truststoreImportScript()},
+			VolumeMounts: []corev1.VolumeMount{
+				{
+					Name:      truststoreVolumeName,
+					MountPath: truststoreMountPath,
+				},
+			},
+		}
+
+		spec.Volumes = append(spec.Volumes, corev1.Volume{
+			Name: truststoreVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		})
+
+		for i, s := range sources {
+			volumeName := "ca-certs-" + strconv.Itoa(i)
+			spec.Volumes = append(spec.Volumes, corev1.Volume{
+				Name:         volumeName,
+				VolumeSource: s.volumeSource(),
+			})
+			initContainer.VolumeMounts = append(initContainer.VolumeMounts, corev1.VolumeMount{
+				Name:      volumeName,
+				MountPath: fmt.Sprintf("%s/%d-%s", truststoreCACertsPath, i, s.name),
+				ReadOnly:  true,
+			})
+		}
+
+		spec.InitContainers = append(spec.InitContainers, initContainer)
+
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      truststoreVolumeName,
+			MountPath: truststoreMountPath,
+			ReadOnly:  true,
+		})
+		// The JVM trait is executed after, and appends its own arguments to the existing ones
+		container.Args = append(container.Args,
+			"-Djavax.net.ssl.trustStore="+truststoreFile,
+			"-Djavax.net.ssl.trustStorePassword="+truststorePassword,
+		)
+	})
+
+	return nil
+}
+
+func (t *truststoreTrait) parseSources() ([]truststoreSource, error) {
+	sources := make([]truststoreSource, 0, len(t.CACerts))
+
+	for _, config := range t.CACerts {
+		parts := strings.Split(config, ":")
+		if len(parts) != 2 || parts[1] == "" {
+			return nil, fmt.Errorf("invalid CA certificates source: %s, must be in the configmap:name or secret:name format", config)
+		}
+
+		switch parts[0] {
+		case mountTypeConfigMap, mountTypeSecret:
+		default:
+			return nil, fmt.Errorf("invalid CA certificates source: %s, unsupported type %s, must be one of %s or %s",
+				config, parts[0], mountTypeConfigMap, mountTypeSecret)
+		}
+
+		sources = append(sources, truststoreSource{
+			sourceType: parts[0],
+			name:       parts[1],
+		})
+	}
+
+	return sources, nil
+}
+
+func (s truststoreSource) volumeSource() corev1.VolumeSource {
+	if s.sourceType == mountTypeSecret {
+		return corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: s.name,
+			},
+		}
+	}
+	return corev1.VolumeSource{
+		ConfigMap: &corev1.ConfigMapVolumeSource{
+			LocalObjectReference: corev1.LocalObjectReference{
+				Name: s.name,
+			},
+		},
+	}
+}
+
+// truststoreImportScript returns the script that copies the default JVM truststore,
+// and imports all the mounted CA certificates into it
+func truststoreImportScript() string {
+	return strings.Join([]string{
+		"set -e",
+		`java_home="${JAVA_HOME:-$(dirname "$(dirname "$(readlink -f "$(command -v java)")")")}"`,
+		fmt.Sprintf(`cp "${java_home}/lib/security/cacerts" %s`, truststoreFile),
+		fmt.Sprintf("chmod u+w %s", truststoreFile),
+		fmt.Sprintf(`for f in %s/*/*; do keytool -importcert -noprompt -keystore %s -storepass %s -alias "$(basename "$(dirname "$f")")-$(basename "$f")" -file "$f"; done`,
+			truststoreCACertsPath, truststoreFile, truststorePassword),
+	}, "\n")
+}
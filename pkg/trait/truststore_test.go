@@ -0,0 +1,131 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trait
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util/kubernetes"
+)
+
+func TestConfigureTruststoreTraitDoesSucceed(t *testing.T) {
+	truststoreTrait, environment, _ := createNominalTruststoreTest()
+	configured, err := truststoreTrait.Configure(environment)
+
+	assert.True(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestConfigureTruststoreTraitWithoutCACertsDoesNotSucceed(t *testing.T) {
+	truststoreTrait, environment, _ := createNominalTruststoreTest()
+	truststoreTrait.CACerts = nil
+	configured, err := truststoreTrait.Configure(environment)
+
+	assert.False(t, configured)
+	assert.Nil(t, err)
+}
+
+func TestConfigureTruststoreTraitWithInvalidSourceFails(t *testing.T) {
+	for _, source := range []string{"my-ca", "configmap:", "pvc:my-ca", "secret:my-ca:ca.crt"} {
+		truststoreTrait, environment, _ := createNominalTruststoreTest()
+		truststoreTrait.CACerts = []string{source}
+		configured, err := truststoreTrait.Configure(environment)
+
+		assert.False(t, configured)
+		assert.NotNil(t, err, source)
+	}
+}
+
+func TestApplyTruststoreTraitDoesSucceed(t *testing.T) {
+	truststoreTrait, environment, deployment := createNominalTruststoreTest()
+
+	err := truststoreTrait.Apply(environment)
+
+	assert.Nil(t, err)
+
+	spec := deployment.Spec.Template.Spec
+	assert.Len(t, spec.Volumes, 3)
+	assert.Equal(t, "truststore", spec.Volumes[0].Name)
+	assert.NotNil(t, spec.Volumes[0].EmptyDir)
+	assert.Equal(t, "my-ca", spec.Volumes[1].ConfigMap.Name)
+	assert.Equal(t, "my-other-ca", spec.Volumes[2].Secret.SecretName)
+
+	assert.Len(t, spec.InitContainers, 1)
+	initContainer := spec.InitContainers[0]
+	assert.Equal(t, "truststore", initContainer.Name)
+	assert.Equal(t, "my-image", initContainer.Image)
+	assert.Len(t, initContainer.VolumeMounts, 3)
+	assert.Equal(t, "/etc/camel/truststore", initContainer.VolumeMounts[0].MountPath)
+	assert.Equal(t, "/etc/camel/ca-certs/0-my-ca", initContainer.VolumeMounts[1].MountPath)
+	assert.Equal(t, "/etc/camel/ca-certs/1-my-other-ca", initContainer.VolumeMounts[2].MountPath)
+
+	container := spec.Containers[0]
+	assert.Equal(t, corev1.VolumeMount{Name: "truststore", MountPath: "/etc/camel/truststore", ReadOnly: true}, container.VolumeMounts[0])
+	assert.Contains(t, container.Args, "-Djavax.net.ssl.trustStore=/etc/camel/truststore/cacerts")
+	assert.Contains(t, container.Args, "-Djavax.net.ssl.trustStorePassword=changeit")
+
+	assert.Empty(t, spec.Containers[1].VolumeMounts)
+	assert.Empty(t, spec.Containers[1].Args)
+}
+
+func createNominalTruststoreTest() (*truststoreTrait, *Environment, *appsv1.Deployment) {
+	trait := newTruststoreTrait().(*truststoreTrait)
+	trait.CACerts = []string{
+		"configmap:my-ca",
+		"secret:my-other-ca",
+	}
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "integration-name",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: defaultContainerName, Image: "my-image"},
+						{Name: "log-shipper"},
+					},
+				},
+			},
+		},
+	}
+
+	environment := &Environment{
+		Catalog: NewCatalog(context.TODO(), nil),
+		Integration: &v1.Integration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "integration-name",
+			},
+			Status: v1.IntegrationStatus{
+				Phase: v1.IntegrationPhaseDeploying,
+			},
+		},
+		Resources: kubernetes.NewCollection(deployment),
+	}
+
+	return trait, environment, deployment
+}
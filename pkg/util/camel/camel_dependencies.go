@@ -0,0 +1,60 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package camel
+
+import (
+	"fmt"
+
+	"github.com/scylladb/go-set/strset"
+
+	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
+	"github.com/apache/camel-k/pkg/util"
+)
+
+// AddRuntimeDependencies adds the dependencies required by the runtime of the catalog
+func AddRuntimeDependencies(dependencies *strset.Set, catalog *RuntimeCatalog) {
+	for _, d := range catalog.Runtime.Dependencies {
+		dependencies.Add(fmt.Sprintf("mvn:%s/%s", d.GroupID, d.ArtifactID))
+	}
+}
+
+// AddSourceDependencies adds the dependencies of the loader used to interpret the given source,
+// that is the one explicitly configured on the source or else the one supporting its language
+func AddSourceDependencies(dependencies *strset.Set, catalog *RuntimeCatalog, source v1.SourceSpec) {
+	lang := source.InferLanguage()
+
+	for loader, v := range catalog.Loaders {
+		// add loader specific dependencies
+		if source.Loader != "" && source.Loader == loader {
+			dependencies.Add(fmt.Sprintf("mvn:%s/%s", v.GroupID, v.ArtifactID))
+
+			for _, d := range v.Dependencies {
+				dependencies.Add(fmt.Sprintf("mvn:%s/%s", d.GroupID, d.ArtifactID))
+			}
+		} else if source.Loader == "" {
+			// add language specific dependencies
+			if util.StringSliceExists(v.Languages, string(lang)) {
+				dependencies.Add(fmt.Sprintf("mvn:%s/%s", v.GroupID, v.ArtifactID))
+
+				for _, d := range v.Dependencies {
+					dependencies.Add(fmt.Sprintf("mvn:%s/%s", d.GroupID, d.ArtifactID))
+				}
+			}
+		}
+	}
+}
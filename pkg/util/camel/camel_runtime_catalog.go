@@ -31,6 +31,7 @@ func NewRuntimeCatalog(spec v1.CamelCatalogSpec) *RuntimeCatalog {
 	catalog.schemesByID = make(map[string]v1.CamelScheme)
 	catalog.languageDependencies = make(map[string]string)
 	catalog.javaTypeDependencies = make(map[string]string)
+	catalog.dataFormatDependencies = make(map[string]string)
 
 	for id, artifact := range catalog.Artifacts {
 		for _, scheme := range artifact.Schemes {
@@ -44,6 +45,9 @@ func NewRuntimeCatalog(spec v1.CamelCatalogSpec) *RuntimeCatalog {
 				catalog.languageDependencies[language] = getDependency(artifact, catalog.Runtime.Provider)
 			}
 		}
+		for _, dataFormat := range artifact.DataFormats {
+			catalog.dataFormatDependencies[dataFormat] = getDependency(artifact, catalog.Runtime.Provider)
+		}
 		for _, javaType := range artifact.JavaTypes {
 			// Skip types in common dependencies since they are always available to integrations
 			if artifact.ArtifactID != "camel-base" {
@@ -59,10 +63,11 @@ func NewRuntimeCatalog(spec v1.CamelCatalogSpec) *RuntimeCatalog {
 type RuntimeCatalog struct {
 	v1.CamelCatalogSpec
 
-	artifactByScheme     map[string]string
-	schemesByID          map[string]v1.CamelScheme
-	languageDependencies map[string]string
-	javaTypeDependencies map[string]string
+	artifactByScheme       map[string]string
+	schemesByID            map[string]v1.CamelScheme
+	languageDependencies   map[string]string
+	javaTypeDependencies   map[string]string
+	dataFormatDependencies map[string]string
 }
 
 // HasArtifact --
@@ -98,6 +103,12 @@ func (c *RuntimeCatalog) GetLanguageDependency(language string) (string, bool) {
 	return language, ok
 }
 
+// GetDataFormatDependency returns the maven dependency for the given data format name
+func (c *RuntimeCatalog) GetDataFormatDependency(dataFormat string) (string, bool) {
+	dependency, ok := c.dataFormatDependencies[dataFormat]
+	return dependency, ok
+}
+
 // GetJavaTypeDependency returns the maven dependency for the given type name
 func (c *RuntimeCatalog) GetJavaTypeDependency(camelType string) (string, bool) {
 	javaType, ok := c.javaTypeDependencies[camelType]
@@ -72,7 +72,14 @@ func CreateSubscription(channelReference corev1.ObjectReference, serviceName str
 }
 
 // CreateTrigger ---
-func CreateTrigger(brokerReference corev1.ObjectReference, serviceName string, eventType string) runtime.Object {
+func CreateTrigger(brokerReference corev1.ObjectReference, serviceName string, eventType string, filters map[string]string) runtime.Object {
+	attributes := eventing.TriggerFilterAttributes{
+		"type": eventType,
+	}
+	for k, v := range filters {
+		attributes[k] = v
+	}
+
 	subs := eventing.Trigger{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: eventing.SchemeGroupVersion.String(),
@@ -84,9 +91,7 @@ func CreateTrigger(brokerReference corev1.ObjectReference, serviceName string, e
 		},
 		Spec: eventing.TriggerSpec{
 			Filter: &eventing.TriggerFilter{
-				Attributes: eventing.TriggerFilterAttributes{
-					"type": eventType,
-				},
+				Attributes: attributes,
 			},
 			Broker: brokerReference.Name,
 			Subscriber: duckv1.Destination{
@@ -476,3 +476,23 @@ func (c *Collection) GetServiceMonitor(filter func(*monitoringv1.ServiceMonitor)
 	})
 	return retValue
 }
+
+// VisitPodMonitor ---
+func (c *Collection) VisitPodMonitor(visitor func(*monitoringv1.PodMonitor)) {
+	c.Visit(func(res runtime.Object) {
+		if conv, ok := res.(*monitoringv1.PodMonitor); ok {
+			visitor(conv)
+		}
+	})
+}
+
+// GetPodMonitor ---
+func (c *Collection) GetPodMonitor(filter func(*monitoringv1.PodMonitor) bool) *monitoringv1.PodMonitor {
+	var retValue *monitoringv1.PodMonitor
+	c.VisitPodMonitor(func(podMonitor *monitoringv1.PodMonitor) {
+		if filter(podMonitor) {
+			retValue = podMonitor
+		}
+	})
+	return retValue
+}
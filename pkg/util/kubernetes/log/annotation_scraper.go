@@ -33,7 +33,8 @@ import (
 	"k8s.io/client-go/kubernetes"
 )
 
-// SelectorScraper scrapes all pods with a given selector
+// SelectorScraper scrapes all pods with a given selector, prefixing each line with the
+// name of the pod. The prefixes are colored when Colored is set.
 type SelectorScraper struct {
 	client               kubernetes.Interface
 	namespace            string
@@ -41,9 +42,13 @@ type SelectorScraper struct {
 	labelSelector        string
 	podScrapers          sync.Map
 	counter              uint64
+	Colored              bool
 	L                    klog.Logger
 }
 
+// prefixColors contains the ANSI colors used to distinguish the pods prefixes
+var prefixColors = []int{32, 33, 34, 35, 36, 31}
+
 // NewSelectorScraper creates a new SelectorScraper
 func NewSelectorScraper(client kubernetes.Interface, namespace string, defaultContainerName string, labelSelector string) *SelectorScraper {
 	return &SelectorScraper{
@@ -131,7 +136,7 @@ func (s *SelectorScraper) addPodScraper(ctx context.Context, podName string, out
 	podScraper := NewPodScraper(s.client, s.namespace, podName, s.defaultContainerName)
 	podCtx, podCancel := context.WithCancel(ctx)
 	id := atomic.AddUint64(&s.counter, 1)
-	prefix := "[" + strconv.FormatUint(id, 10) + "] "
+	prefix := s.prefix(id, podName)
 	podReader := podScraper.Start(podCtx)
 	s.podScrapers.Store(podName, podCancel)
 	go func() {
@@ -161,6 +166,14 @@ func (s *SelectorScraper) addPodScraper(ctx context.Context, podName string, out
 	}()
 }
 
+func (s *SelectorScraper) prefix(id uint64, podName string) string {
+	if !s.Colored {
+		return "[" + podName + "] "
+	}
+	color := prefixColors[(id-1)%uint64(len(prefixColors))]
+	return "\x1b[" + strconv.Itoa(color) + "m[" + podName + "]\x1b[0m "
+}
+
 func (s *SelectorScraper) listPods() (*corev1.PodList, error) {
 	list, err := s.client.CoreV1().Pods(s.namespace).List(metav1.ListOptions{
 		LabelSelector: s.labelSelector,
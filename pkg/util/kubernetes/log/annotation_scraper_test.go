@@ -0,0 +1,34 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectorScraperPrefix(t *testing.T) {
+	s := NewSelectorScraper(nil, "default", "my-integration", "camel.apache.org/integration=my-integration")
+	assert.Equal(t, "[my-integration-1] ", s.prefix(1, "my-integration-1"))
+
+	s.Colored = true
+	assert.Equal(t, "\x1b[32m[my-integration-1]\x1b[0m ", s.prefix(1, "my-integration-1"))
+	assert.Equal(t, "\x1b[33m[my-integration-2]\x1b[0m ", s.prefix(2, "my-integration-2"))
+	assert.Equal(t, "\x1b[32m[my-integration-7]\x1b[0m ", s.prefix(7, "my-integration-7"))
+}
@@ -22,15 +22,18 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"os"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 
 	"k8s.io/client-go/kubernetes"
 )
 
-// Print prints integrations logs to the stdout
+// Print prints the logs of all the pods of the integration to the given writer,
+// each line being prefixed with the name of the pod it comes from
 func Print(ctx context.Context, client kubernetes.Interface, integration *v1.Integration, out io.Writer) error {
 	scraper := NewSelectorScraper(client, integration.Namespace, integration.Name, v1.IntegrationLabel+"="+integration.Name)
+	scraper.Colored = isTerminal(out)
 	reader := scraper.Start(ctx)
 
 	if _, err := io.Copy(out, ioutil.NopCloser(reader)); err != nil {
@@ -39,3 +42,15 @@ func Print(ctx context.Context, client kubernetes.Interface, integration *v1.Int
 
 	return nil
 }
+
+func isTerminal(out io.Writer) bool {
+	f, ok := out.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
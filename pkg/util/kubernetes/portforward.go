@@ -0,0 +1,63 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+
+	"github.com/apache/camel-k/pkg/client"
+)
+
+// PortForward forwards the given local port to the remote port of the pod, until the context is done
+func PortForward(ctx context.Context, c client.Client, namespace string, podName string, localPort uint, remotePort uint, stdOut io.Writer, stdErr io.Writer) error {
+	transport, upgrader, err := spdy.RoundTripperFor(c.GetConfig())
+	if err != nil {
+		return err
+	}
+
+	url := c.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(podName).
+		SubResource("portforward").
+		URL()
+
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, url)
+
+	stopChan := make(chan struct{})
+	readyChan := make(chan struct{})
+	ports := []string{fmt.Sprintf("%d:%d", localPort, remotePort)}
+
+	forwarder, err := portforward.New(dialer, ports, stopChan, readyChan, stdOut, stdErr)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		close(stopChan)
+	}()
+
+	return forwarder.ForwardPorts()
+}
@@ -80,7 +80,23 @@ func mapRequiredMeta(from runtime.Object, to runtime.Object) {
 func mapRequiredServiceData(from runtime.Object, to runtime.Object) {
 	if fromC, ok := from.(*corev1.Service); ok {
 		if toC, ok := to.(*corev1.Service); ok {
-			toC.Spec.ClusterIP = fromC.Spec.ClusterIP
+			// Keep the cluster IP assigned by Kubernetes, unless explicitly set, e.g. for headless services
+			if toC.Spec.ClusterIP == "" {
+				toC.Spec.ClusterIP = fromC.Spec.ClusterIP
+			}
+			// Keep the node ports assigned by Kubernetes, unless explicitly set
+			if toC.Spec.Type == corev1.ServiceTypeNodePort || toC.Spec.Type == corev1.ServiceTypeLoadBalancer {
+				for i := range toC.Spec.Ports {
+					if toC.Spec.Ports[i].NodePort != 0 {
+						continue
+					}
+					for _, port := range fromC.Spec.Ports {
+						if port.Name == toC.Spec.Ports[i].Name {
+							toC.Spec.Ports[i].NodePort = port.NodePort
+						}
+					}
+				}
+			}
 		}
 	}
 }
@@ -121,15 +121,26 @@ func Run(ctx Context) error {
 	cmd := exec.CommandContext(c, mvnCmd, args...)
 	cmd.Dir = ctx.Path
 	cmd.Stderr = os.Stderr
+
+	// collect the errors reported by maven so that the root cause of a
+	// failure, i.e. a dependency resolution conflict, can be surfaced
+	collector := newErrorCollector()
 	if ctx.Stdout != nil {
-		cmd.Stdout = ctx.Stdout
+		cmd.Stdout = io.MultiWriter(ctx.Stdout, collector)
 	} else {
-		cmd.Stdout = os.Stdout
+		cmd.Stdout = io.MultiWriter(os.Stdout, collector)
 	}
 
 	Log.WithValues("timeout", timeout.String()).Infof("executing: %s", strings.Join(cmd.Args, " "))
 
-	return cmd.Run()
+	if err := cmd.Run(); err != nil {
+		if cause := collector.Cause(); cause != "" {
+			return errors.Wrap(err, cause)
+		}
+		return err
+	}
+
+	return nil
 }
 
 // ParseGAV decode a maven artifact id to a dependency definition.
@@ -166,3 +177,129 @@ func ParseGAV(gav string) (Dependency, error) {
 
 	return dep, nil
 }
+
+// ParseDependency decode a maven dependency, optionally including a list of
+// transitive dependencies to exclude, to a dependency definition.
+//
+// The dependency is in the form of:
+//
+//     <groupId>:<artifactId>[:<packagingType>[:<classifier>]]:(<version>|'?')[?exclusions=<groupId>:<artifactId>[,<groupId>:<artifactId>]*]
+//
+// where the version can either be a fixed version or a maven version range,
+// like [1.0,2.0) and the group and artifact ids of an exclusion can be set
+// to '*' to match any value.
+func ParseDependency(dependency string) (Dependency, error) {
+	gav := dependency
+	exclusions := ""
+
+	if i := strings.Index(dependency, exclusionsSeparator); i >= 0 {
+		gav = dependency[:i]
+		exclusions = dependency[i+len(exclusionsSeparator):]
+
+		if exclusions == "" {
+			return Dependency{}, fmt.Errorf("no exclusions defined for dependency %s", gav)
+		}
+	}
+
+	if strings.Contains(gav, " ") {
+		return Dependency{}, fmt.Errorf("dependency %s must not contain white spaces", gav)
+	}
+
+	switch strings.Count(gav, ":") {
+	case 0:
+		return Dependency{}, fmt.Errorf("dependency %s must match <groupId>:<artifactId>[:<packagingType>[:<classifier>]]:(<version>|'?')", gav)
+	case 1:
+		// the version is managed, i.e. by a BOM
+		dep, err := ParseGAV(gav)
+		if err != nil {
+			return Dependency{}, err
+		}
+		return withExclusions(dep, exclusions)
+	}
+
+	// the version is the last segment of the GAV, it is extracted
+	// before parsing as version ranges may contain characters that
+	// are not supported by ParseGAV
+	i := strings.LastIndex(gav, ":")
+	version := gav[i+1:]
+
+	if err := validateVersion(version); err != nil {
+		return Dependency{}, errors.Wrapf(err, "invalid version for dependency %s", gav)
+	}
+
+	dep, err := ParseGAV(gav[:i] + ":?")
+	if err != nil {
+		return Dependency{}, err
+	}
+
+	dep.Version = version
+
+	return withExclusions(dep, exclusions)
+}
+
+func withExclusions(dep Dependency, exclusions string) (Dependency, error) {
+	if exclusions != "" {
+		list := make([]Exclusion, 0)
+
+		for _, exclusion := range strings.Split(exclusions, ",") {
+			ga := strings.Split(exclusion, ":")
+			if len(ga) != 2 || ga[0] == "" || ga[1] == "" {
+				return Dependency{}, fmt.Errorf("exclusion %s of dependency %s:%s must match <groupId>:<artifactId>", exclusion, dep.GroupID, dep.ArtifactID)
+			}
+
+			list = append(list, Exclusion{
+				GroupID:    ga[0],
+				ArtifactID: ga[1],
+			})
+		}
+
+		dep.Exclusions = &list
+	}
+
+	return dep, nil
+}
+
+const exclusionsSeparator = "?exclusions="
+
+var (
+	versionRangeRegexp = regexp.MustCompile(`^[\[(][^\[\](),]*(,[^\[\](),]*)?[\])]$`)
+	versionRegexp      = regexp.MustCompile(`^[^\[\](), ]+$`)
+)
+
+// validateVersion checks that the given version is either a fixed version or
+// a maven version range, possibly made of multiple comma separated ranges.
+func validateVersion(version string) error {
+	if version == "" {
+		return errors.New("version must not be empty")
+	}
+
+	if !strings.ContainsAny(version, "[(") {
+		if !versionRegexp.MatchString(version) {
+			return fmt.Errorf("version %s must not contain any of the [](), characters, unless it is a version range", version)
+		}
+		return nil
+	}
+
+	// split multiple ranges, i.e. (,1.0],[1.2,)
+	rest := version
+	for rest != "" {
+		end := strings.IndexAny(rest, "])")
+		if end < 0 {
+			return fmt.Errorf("version range %s is not terminated", version)
+		}
+
+		if !versionRangeRegexp.MatchString(rest[:end+1]) {
+			return fmt.Errorf("version range %s is invalid", version)
+		}
+
+		rest = rest[end+1:]
+		if rest != "" {
+			if !strings.HasPrefix(rest, ",") || len(rest) == 1 {
+				return fmt.Errorf("version range %s is invalid", version)
+			}
+			rest = rest[1:]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maven
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+)
+
+const errorPrefix = "[ERROR] "
+
+// resolutionErrors are the messages maven reports when the dependencies
+// of a project cannot be resolved, i.e. because of conflicting versions
+// or because no version satisfies a range
+var resolutionErrors = []string{
+	"Could not resolve dependencies",
+	"Failed to collect dependencies",
+	"No versions available",
+	"Could not find artifact",
+	"Failed to read artifact descriptor",
+}
+
+// errorCollector is an io.Writer that records the error lines
+// reported by maven.
+type errorCollector struct {
+	lock   sync.Mutex
+	buffer bytes.Buffer
+	errors []string
+}
+
+func newErrorCollector() *errorCollector {
+	return &errorCollector{
+		errors: make([]string, 0),
+	}
+}
+
+// Write --
+func (c *errorCollector) Write(p []byte) (int, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.buffer.Write(p)
+
+	for {
+		line, err := c.buffer.ReadString('\n')
+		if err != nil {
+			// keep the incomplete line for the next write
+			c.buffer.Reset()
+			c.buffer.WriteString(line)
+			break
+		}
+
+		c.collect(line)
+	}
+
+	return len(p), nil
+}
+
+func (c *errorCollector) collect(line string) {
+	line = strings.TrimSpace(line)
+	if !strings.HasPrefix(line, errorPrefix) {
+		return
+	}
+
+	line = strings.TrimSpace(strings.TrimPrefix(line, errorPrefix))
+	if line != "" {
+		c.errors = append(c.errors, line)
+	}
+}
+
+// Cause returns the most relevant error reported by maven, giving
+// precedence to dependency resolution errors.
+func (c *errorCollector) Cause() string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.buffer.Len() > 0 {
+		c.collect(c.buffer.String())
+		c.buffer.Reset()
+	}
+
+	for _, line := range c.errors {
+		for _, resolution := range resolutionErrors {
+			if strings.Contains(line, resolution) {
+				return line
+			}
+		}
+	}
+
+	if len(c.errors) > 0 {
+		return c.errors[0]
+	}
+
+	return ""
+}
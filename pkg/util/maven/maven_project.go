@@ -20,6 +20,7 @@ package maven
 import (
 	"bytes"
 	"encoding/xml"
+	"fmt"
 	"strings"
 )
 
@@ -197,3 +198,50 @@ func NewRepository(repo string) Repository {
 
 	return r
 }
+
+//
+// ParseRepository parse the given repository description and generates the related struct.
+//
+// Besides the format supported by NewRepository, the repository can be described using
+// the id::policy::url layout, as example:
+//
+//     my-repo::snapshots::http://my-nexus:8081/repository/public
+//
+// Where the policy is one of releases (default when empty), snapshots or all.
+//
+func ParseRepository(repo string) (Repository, error) {
+	if !strings.Contains(repo, "::") {
+		r := NewRepository(repo)
+		if r.URL == "" {
+			return Repository{}, fmt.Errorf("invalid repository: %s, the url is missing", repo)
+		}
+		return r, nil
+	}
+
+	parts := strings.SplitN(repo, "::", 3)
+	if len(parts) != 3 {
+		return Repository{}, fmt.Errorf("invalid repository: %s, must be in the id::policy::url format", repo)
+	}
+	if parts[0] == "" || parts[2] == "" {
+		return Repository{}, fmt.Errorf("invalid repository: %s, both the id and the url are required", repo)
+	}
+
+	r := NewRepository(parts[2])
+	r.ID = parts[0]
+
+	switch parts[1] {
+	case "", "releases":
+		r.Releases.Enabled = true
+		r.Snapshots.Enabled = false
+	case "snapshots":
+		r.Releases.Enabled = false
+		r.Snapshots.Enabled = true
+	case "all":
+		r.Releases.Enabled = true
+		r.Snapshots.Enabled = true
+	default:
+		return Repository{}, fmt.Errorf("invalid repository: %s, unsupported policy %s, must be one of releases, snapshots or all", repo, parts[1])
+	}
+
+	return r, nil
+}
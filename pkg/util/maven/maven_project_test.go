@@ -184,6 +184,95 @@ func TestParseGAVErrorNoColumn(t *testing.T) {
 	assert.Equal(t, Dependency{}, dep)
 }
 
+func TestParseDependencyWithVersionRange(t *testing.T) {
+	dep, err := ParseDependency("org.apache.camel:camel-core:[2.21,3.0)")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "org.apache.camel", dep.GroupID)
+	assert.Equal(t, "camel-core", dep.ArtifactID)
+	assert.Equal(t, "[2.21,3.0)", dep.Version)
+	assert.Nil(t, dep.Exclusions)
+
+	dep, err = ParseDependency("org.apache.camel:camel-core:jar:(,1.0],[1.2,)")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "(,1.0],[1.2,)", dep.Version)
+	assert.Equal(t, "jar", dep.Type)
+}
+
+func TestParseDependencyWithExclusions(t *testing.T) {
+	dep, err := ParseDependency("org.apache.camel:camel-core:2.21.1?exclusions=org.foo:foo-lib,org.bar:*")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "org.apache.camel", dep.GroupID)
+	assert.Equal(t, "camel-core", dep.ArtifactID)
+	assert.Equal(t, "2.21.1", dep.Version)
+	assert.NotNil(t, dep.Exclusions)
+	assert.Equal(t, []Exclusion{
+		{GroupID: "org.foo", ArtifactID: "foo-lib"},
+		{GroupID: "org.bar", ArtifactID: "*"},
+	}, *dep.Exclusions)
+
+	dep, err = ParseDependency("org.apache.camel:camel-core?exclusions=org.foo:foo-lib")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "", dep.Version)
+	assert.Len(t, *dep.Exclusions, 1)
+}
+
+func TestParseInvalidDependency(t *testing.T) {
+	invalid := []string{
+		"org.apache.camel",
+		"org.apache.camel:camel-core:[2.21,3.0",
+		"org.apache.camel:camel-core:2.21,3.0",
+		"org.apache.camel:camel-core:[2.21,3.0),",
+		"org.apache.camel:camel-core:2.21.1?exclusions=",
+		"org.apache.camel:camel-core:2.21.1?exclusions=org.foo",
+		"org.apache.camel:camel-core:2.21.1?exclusions=org.foo:",
+		"org.apache.camel:camel-core:2.21.1?exclusions=org.foo:foo:1.0",
+		"org.apache.camel:camel core:2.21.1",
+	}
+
+	for _, d := range invalid {
+		_, err := ParseDependency(d)
+		assert.NotNil(t, err, d)
+	}
+}
+
+func TestPomGenerationWithExclusions(t *testing.T) {
+	dep, err := ParseDependency("org.apache.camel:camel-core:[2.21,3.0)?exclusions=org.foo:foo-lib")
+	assert.Nil(t, err)
+
+	project := NewProjectWithGAV("org.apache.camel.k.integration", "camel-k-integration", "1.0.0")
+	project.AddDependency(dep)
+
+	pom, err := util.EncodeXML(project)
+	assert.Nil(t, err)
+	assert.Contains(t, string(pom), "<version>[2.21,3.0)</version>")
+	assert.Contains(t, string(pom), "<exclusions>")
+	assert.Contains(t, string(pom), "<artifactId>foo-lib</artifactId>")
+}
+
+func TestErrorCollector(t *testing.T) {
+	collector := newErrorCollector()
+
+	_, err := collector.Write([]byte("[INFO] BUILD FAILURE\n[ERROR] Failed to execute goal on project camel-k-integration: "))
+	assert.Nil(t, err)
+	_, err = collector.Write([]byte("Could not resolve dependencies for project org.acme:acme:jar:1.0: No versions available for org.foo:foo-lib:jar:[9.0,) within specified range\n"))
+	assert.Nil(t, err)
+	_, err = collector.Write([]byte("[ERROR] \n[ERROR] To see the full stack trace of the errors, re-run Maven with the -e switch."))
+	assert.Nil(t, err)
+
+	assert.Equal(t,
+		"Failed to execute goal on project camel-k-integration: Could not resolve dependencies for project org.acme:acme:jar:1.0: No versions available for org.foo:foo-lib:jar:[9.0,) within specified range",
+		collector.Cause())
+
+	collector = newErrorCollector()
+	_, err = collector.Write([]byte("[INFO] BUILD SUCCESS\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, "", collector.Cause())
+}
+
 func TestNewRepository(t *testing.T) {
 	r := NewRepository("http://nexus/public")
 	assert.Equal(t, "", r.ID)
@@ -233,3 +322,38 @@ func TestNewRepositoryWithChecksumPolicy(t *testing.T) {
 	assert.Equal(t, "warn", r.Releases.ChecksumPolicy)
 	assert.Equal(t, "warn", r.Snapshots.ChecksumPolicy)
 }
+
+func TestParseRepositoryWithPolicy(t *testing.T) {
+	r, err := ParseRepository("test::snapshots::http://nexus/public")
+	assert.Nil(t, err)
+	assert.Equal(t, "test", r.ID)
+	assert.Equal(t, "http://nexus/public", r.URL)
+	assert.False(t, r.Releases.Enabled)
+	assert.True(t, r.Snapshots.Enabled)
+	assert.Equal(t, "fail", r.Releases.ChecksumPolicy)
+	assert.Equal(t, "fail", r.Snapshots.ChecksumPolicy)
+
+	r, err = ParseRepository("test::all::http://nexus/public")
+	assert.Nil(t, err)
+	assert.True(t, r.Releases.Enabled)
+	assert.True(t, r.Snapshots.Enabled)
+
+	r, err = ParseRepository("test::::http://nexus/public")
+	assert.Nil(t, err)
+	assert.True(t, r.Releases.Enabled)
+	assert.False(t, r.Snapshots.Enabled)
+}
+
+func TestParseRepositoryWithInvalidLayout(t *testing.T) {
+	_, err := ParseRepository("test::http://nexus/public")
+	assert.NotNil(t, err)
+
+	_, err = ParseRepository("test::nightly::http://nexus/public")
+	assert.NotNil(t, err)
+
+	_, err = ParseRepository("::releases::http://nexus/public")
+	assert.NotNil(t, err)
+
+	_, err = ParseRepository("@id=test")
+	assert.NotNil(t, err)
+}
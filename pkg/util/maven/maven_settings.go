@@ -18,7 +18,9 @@ limitations under the License.
 package maven
 
 import (
+	"bytes"
 	"encoding/xml"
+	"fmt"
 	"strings"
 
 	"github.com/apache/camel-k/pkg/util"
@@ -96,6 +98,89 @@ func CreateSettingsConfigMap(namespace string, name string, settings Settings) (
 	return cm, nil
 }
 
+const (
+	// MirrorURLKey is the key of the mirror secret holding the URL of the mirror
+	MirrorURLKey = "url"
+	// MirrorOfKey is the key of the mirror secret holding the repositories the mirror is used for
+	MirrorOfKey = "mirrorOf"
+	// MirrorUsernameKey is the key of the mirror secret holding the username used to authenticate to the mirror
+	MirrorUsernameKey = "username"
+	// MirrorPasswordKey is the key of the mirror secret holding the password used to authenticate to the mirror
+	MirrorPasswordKey = "password"
+)
+
+// NewMirrorFromSecret creates a mirror, and the server holding its credentials if any, from
+// the given secret, that must contain the url of the mirror and can optionally contain the
+// repositories the mirror is used for, that defaults to all of them, as well as the username
+// and password used to authenticate to the mirror
+func NewMirrorFromSecret(secret *corev1.Secret) (Mirror, *Server, error) {
+	url := strings.TrimSpace(string(secret.Data[MirrorURLKey]))
+	if url == "" {
+		return Mirror{}, nil, fmt.Errorf("key %s not found in maven mirror secret %s", MirrorURLKey, secret.Name)
+	}
+
+	mirror := Mirror{
+		ID:       secret.Name,
+		Name:     secret.Name,
+		URL:      url,
+		MirrorOf: "*",
+	}
+	if mirrorOf := strings.TrimSpace(string(secret.Data[MirrorOfKey])); mirrorOf != "" {
+		mirror.MirrorOf = mirrorOf
+	}
+
+	username := string(secret.Data[MirrorUsernameKey])
+	password := string(secret.Data[MirrorPasswordKey])
+	if username == "" && password == "" {
+		return mirror, nil, nil
+	}
+	if username == "" || password == "" {
+		return Mirror{}, nil, fmt.Errorf("maven mirror secret %s must contain both the %s and %s keys", secret.Name, MirrorUsernameKey, MirrorPasswordKey)
+	}
+
+	return mirror, &Server{
+		ID:       mirror.ID,
+		Username: username,
+		Password: password,
+	}, nil
+}
+
+// MergeMirror adds the given mirror, and server if any, to the given settings. The settings are
+// decoded into the Settings type, so only the elements it models are retained
+func MergeMirror(data []byte, mirror Mirror, server *Server) ([]byte, error) {
+	settings := NewSettings()
+
+	if len(bytes.TrimSpace(data)) > 0 {
+		decoded := Settings{}
+		if err := xml.Unmarshal(data, &decoded); err != nil {
+			return nil, err
+		}
+		if decoded.XMLName.Local != "settings" {
+			return nil, fmt.Errorf("unable to add the mirror to maven settings: unexpected %s root element", decoded.XMLName.Local)
+		}
+
+		settings.LocalRepository = decoded.LocalRepository
+		settings.Profiles = decoded.Profiles
+		settings.Servers = decoded.Servers
+		settings.Mirrors = decoded.Mirrors
+	}
+
+	// the element names are given by the field tags, dropping the namespace they were decoded with
+	for i := range settings.Servers {
+		settings.Servers[i].XMLName = xml.Name{}
+	}
+	for i := range settings.Mirrors {
+		settings.Mirrors[i].XMLName = xml.Name{}
+	}
+
+	settings.Mirrors = append(settings.Mirrors, mirror)
+	if server != nil {
+		settings.Servers = append(settings.Servers, *server)
+	}
+
+	return util.EncodeXML(settings)
+}
+
 func getDefaultMavenRepositories() (repos []Repository) {
 	for _, repoDesc := range strings.Split(DefaultMavenRepositories, ",") {
 		repos = append(repos, NewRepository(repoDesc))
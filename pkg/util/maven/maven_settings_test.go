@@ -18,10 +18,15 @@ limitations under the License.
 package maven
 
 import (
+	"encoding/xml"
+	"strings"
 	"testing"
 
 	"github.com/apache/camel-k/pkg/util"
 	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const expectedSettings = `<?xml version="1.0" encoding="UTF-8"?>
@@ -237,3 +242,102 @@ func TestCreateSettingsConfigMap(t *testing.T) {
 
 	assert.Equal(t, string(content), configMap.Data["settings.xml"])
 }
+
+func TestNewMirrorFromSecret(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "mirror",
+		},
+		Data: map[string][]byte{
+			"url":      []byte("https://my-nexus/repository/public"),
+			"mirrorOf": []byte("central"),
+		},
+	}
+
+	mirror, server, err := NewMirrorFromSecret(secret)
+	assert.Nil(t, err)
+	assert.Nil(t, server)
+	assert.Equal(t, "mirror", mirror.ID)
+	assert.Equal(t, "https://my-nexus/repository/public", mirror.URL)
+	assert.Equal(t, "central", mirror.MirrorOf)
+
+	secret.Data["username"] = []byte("user")
+	_, _, err = NewMirrorFromSecret(secret)
+	assert.NotNil(t, err)
+
+	secret.Data["password"] = []byte("pwd")
+	_, server, err = NewMirrorFromSecret(secret)
+	assert.Nil(t, err)
+	assert.NotNil(t, server)
+	assert.Equal(t, "mirror", server.ID)
+	assert.Equal(t, "user", server.Username)
+	assert.Equal(t, "pwd", server.Password)
+
+	delete(secret.Data, "url")
+	_, _, err = NewMirrorFromSecret(secret)
+	assert.NotNil(t, err)
+}
+
+func TestMergeMirror(t *testing.T) {
+	settings := `<?xml version="1.0" encoding="UTF-8"?>
+<settings xmlns="http://maven.apache.org/SETTINGS/1.0.0">
+  <localRepository>/tmp/artifacts/m2</localRepository>
+  <profiles>
+    <profile>
+      <id>my-profile</id>
+      <repositories>
+        <repository>
+          <id>my-repo</id>
+          <url>https://my-repo</url>
+        </repository>
+      </repositories>
+    </profile>
+  </profiles>
+  <mirrors>
+    <mirror>
+      <id>other</id>
+      <url>https://other</url>
+      <mirrorOf>central</mirrorOf>
+    </mirror>
+  </mirrors>
+  <servers/>
+</settings>`
+	mirror := Mirror{ID: "mirror", URL: "https://my-nexus/repository/public", MirrorOf: "*"}
+	server := Server{ID: "mirror", Username: "user", Password: "pwd"}
+
+	merged, err := MergeMirror([]byte(settings), mirror, &server)
+	assert.Nil(t, err)
+
+	decoded := Settings{}
+	assert.Nil(t, xml.Unmarshal(merged, &decoded))
+	assert.Equal(t, "http://maven.apache.org/SETTINGS/1.0.0", decoded.XMLNs)
+	assert.Equal(t, "/tmp/artifacts/m2", decoded.LocalRepository)
+	assert.Len(t, decoded.Profiles, 1)
+	assert.Equal(t, "my-profile", decoded.Profiles[0].ID)
+	assert.Equal(t, "https://my-repo", decoded.Profiles[0].Repositories[0].URL)
+	assert.Len(t, decoded.Mirrors, 2)
+	assert.Equal(t, "other", decoded.Mirrors[0].ID)
+	assert.Equal(t, "central", decoded.Mirrors[0].MirrorOf)
+	assert.Equal(t, "mirror", decoded.Mirrors[1].ID)
+	assert.Equal(t, "https://my-nexus/repository/public", decoded.Mirrors[1].URL)
+	assert.Equal(t, "*", decoded.Mirrors[1].MirrorOf)
+	assert.Len(t, decoded.Servers, 1)
+	assert.Equal(t, "user", decoded.Servers[0].Username)
+	assert.Equal(t, "pwd", decoded.Servers[0].Password)
+	assert.Equal(t, 1, strings.Count(string(merged), "xmlns="))
+
+	merged, err = MergeMirror(nil, mirror, nil)
+	assert.Nil(t, err)
+
+	decoded = Settings{}
+	assert.Nil(t, xml.Unmarshal(merged, &decoded))
+	assert.Len(t, decoded.Mirrors, 1)
+	assert.Equal(t, "mirror", decoded.Mirrors[0].ID)
+	assert.Empty(t, decoded.Servers)
+
+	_, err = MergeMirror([]byte("invalid"), mirror, nil)
+	assert.NotNil(t, err)
+
+	_, err = MergeMirror([]byte("<project/>"), mirror, nil)
+	assert.NotNil(t, err)
+}
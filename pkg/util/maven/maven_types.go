@@ -22,6 +22,7 @@ import (
 	"encoding/xml"
 	"fmt"
 	"io"
+	"sort"
 	"time"
 )
 
@@ -149,6 +150,21 @@ func (c *Context) AddSystemProperty(name string, value string) {
 	c.AddArgumentf("-D%s=%s", name, value)
 }
 
+// AddSystemProperties --
+func (c *Context) AddSystemProperties(properties map[string]string) {
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+
+	// sort the properties so that the arguments are stable
+	sort.Strings(names)
+
+	for _, name := range names {
+		c.AddSystemProperty(name, properties[name])
+	}
+}
+
 // Settings represent a maven settings
 type Settings struct {
 	XMLName           xml.Name
@@ -157,6 +173,25 @@ type Settings struct {
 	XsiSchemaLocation string    `xml:"xsi:schemaLocation,attr"`
 	LocalRepository   string    `xml:"localRepository"`
 	Profiles          []Profile `xml:"profiles>profile,omitempty"`
+	Servers           []Server  `xml:"servers>server,omitempty"`
+	Mirrors           []Mirror  `xml:"mirrors>mirror,omitempty"`
+}
+
+// Server represent the authentication settings of a maven server
+type Server struct {
+	XMLName  xml.Name `xml:"server"`
+	ID       string   `xml:"id"`
+	Username string   `xml:"username,omitempty"`
+	Password string   `xml:"password,omitempty"`
+}
+
+// Mirror represent a maven mirror
+type Mirror struct {
+	XMLName  xml.Name `xml:"mirror"`
+	ID       string   `xml:"id"`
+	Name     string   `xml:"name,omitempty"`
+	URL      string   `xml:"url"`
+	MirrorOf string   `xml:"mirrorOf"`
 }
 
 // MarshalBytes --
@@ -191,6 +226,7 @@ type Project struct {
 	Repositories         []Repository          `xml:"repositories>repository,omitempty"`
 	PluginRepositories   []Repository          `xml:"pluginRepositories>pluginRepository,omitempty"`
 	Build                *Build                `xml:"build,omitempty"`
+	Profiles             []Profile             `xml:"profiles>profile,omitempty"`
 }
 
 // Exclusion represent a maven's dependency exlucsion
@@ -22,6 +22,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
+	"strings"
 )
 
 var (
@@ -45,7 +47,9 @@ type dockerConfigList struct {
 }
 
 type dockerConfig struct {
-	Auth string `json:"auth,omitempty"`
+	Auth     string `json:"auth,omitempty"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
 }
 
 // IsSet returns if information has been set on the object
@@ -76,7 +80,7 @@ func (a Auth) generateDockerConfigObject() dockerConfigList {
 	return dockerConfigList{
 		map[string]dockerConfig{
 			a.getActualServer(): {
-				a.encodedCredentials(),
+				Auth: a.encodedCredentials(),
 			},
 		},
 	}
@@ -95,3 +99,74 @@ func (a Auth) getActualServer() string {
 func (a Auth) encodedCredentials() string {
 	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", a.Username, a.Password)))
 }
+
+// ParseDockerConfig extracts the authentication information for the given registry
+// from a Docker compatible config.json file
+func ParseDockerConfig(content []byte, registry string) (Auth, error) {
+	config := dockerConfigList{}
+	if err := json.Unmarshal(content, &config); err != nil {
+		return Auth{}, err
+	}
+
+	for server, entry := range config.Auths {
+		if server != registry && serverHost(server) != registry {
+			if p, ok := knownServersByRegistry[registry]; !ok || p != server {
+				continue
+			}
+		}
+
+		a := Auth{
+			Server:   server,
+			Username: entry.Username,
+			Password: entry.Password,
+			Registry: registry,
+		}
+
+		if entry.Auth != "" {
+			credentials, err := base64.StdEncoding.DecodeString(entry.Auth)
+			if err != nil {
+				return Auth{}, err
+			}
+			parts := strings.SplitN(string(credentials), ":", 2)
+			if len(parts) != 2 {
+				return Auth{}, fmt.Errorf("malformed credentials for server %s", server)
+			}
+			a.Username = parts[0]
+			a.Password = parts[1]
+		}
+
+		return a, nil
+	}
+
+	return Auth{}, nil
+}
+
+// MergeDockerConfigs merges the authentication entries of the given Docker compatible config.json files
+// into a single one. The first entry found for a server takes precedence.
+func MergeDockerConfigs(contents ...[]byte) ([]byte, error) {
+	auths := make(map[string]json.RawMessage)
+	for _, content := range contents {
+		config := struct {
+			Auths map[string]json.RawMessage `json:"auths,omitempty"`
+		}{}
+		if err := json.Unmarshal(content, &config); err != nil {
+			return nil, err
+		}
+		for server, entry := range config.Auths {
+			if _, ok := auths[server]; !ok {
+				auths[server] = entry
+			}
+		}
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"auths": auths,
+	})
+}
+
+func serverHost(server string) string {
+	if u, err := url.Parse(server); err == nil && u.Host != "" {
+		return u.Host
+	}
+	return strings.Split(server, "/")[0]
+}
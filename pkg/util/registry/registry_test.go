@@ -66,3 +66,42 @@ func TestAuth_Validate(t *testing.T) {
 		Server:   "quay.io",
 	}.validate())
 }
+
+func TestParseDockerConfig(t *testing.T) {
+	conf := []byte(`{"auths":{"https://index.docker.io/v1/":{"auth":"bmljOnBhc3M="},"quay.io":{"username":"nic","password":"word"}}}`)
+
+	a, err := ParseDockerConfig(conf, "docker.io")
+	assert.Nil(t, err)
+	assert.Equal(t, "nic", a.Username)
+	assert.Equal(t, "pass", a.Password)
+
+	a, err = ParseDockerConfig(conf, "quay.io")
+	assert.Nil(t, err)
+	assert.Equal(t, "nic", a.Username)
+	assert.Equal(t, "word", a.Password)
+
+	a, err = ParseDockerConfig(conf, "index.docker.io")
+	assert.Nil(t, err)
+	assert.Equal(t, "nic", a.Username)
+
+	a, err = ParseDockerConfig(conf, "gcr.io")
+	assert.Nil(t, err)
+	assert.False(t, a.IsSet())
+}
+
+func TestMergeDockerConfigs(t *testing.T) {
+	first := []byte(`{"auths":{"quay.io":{"auth":"bmljOnBhc3M="}}}`)
+	second := []byte(`{"auths":{"quay.io":{"username":"other","password":"other"},"gcr.io":{"username":"nic","password":"word","email":"nic@example.com"}}}`)
+
+	conf, err := MergeDockerConfigs(first, second)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"auths":{"quay.io":{"auth":"bmljOnBhc3M="},"gcr.io":{"username":"nic","password":"word","email":"nic@example.com"}}}`, string(conf))
+
+	a, err := ParseDockerConfig(conf, "quay.io")
+	assert.Nil(t, err)
+	assert.Equal(t, "nic", a.Username)
+	assert.Equal(t, "pass", a.Password)
+
+	_, err = MergeDockerConfigs(first, []byte("not json"))
+	assert.NotNil(t, err)
+}
@@ -53,6 +53,34 @@ var (
 	xqueryRegexp            = regexp.MustCompile(`.*\.xquery\s*\(.*\).*`)
 	xpathRegexp             = regexp.MustCompile(`.*\.?xpath\s*\(.*\).*`)
 	xtokenizeRegexp         = regexp.MustCompile(`.*\.xtokenize\s*\(.*\).*`)
+	dataFormatRegexp        = regexp.MustCompile(`(?:marshal|unmarshal)\s*\(\s*\)\s*\.\s*([a-zA-Z0-9]+)\s*\(([^)]*)\)`)
+	jsonLibraryNameRegexp   = regexp.MustCompile(`JsonLibrary\.([a-zA-Z]+)`)
+
+	// dataFormatAliases maps the (lower cased, without dashes) names used by
+	// the DSLs to reference a data format to the id of the data format in
+	// the catalog, when they differ
+	dataFormatAliases = map[string]string{
+		"json":           "json-jackson",
+		"jackson":        "json-jackson",
+		"gson":           "json-gson",
+		"johnzon":        "json-johnzon",
+		"fastjson":       "json-fastjson",
+		"yaml":           "yaml-snakeyaml",
+		"snakeyaml":      "yaml-snakeyaml",
+		"bindy":          "bindy-csv",
+		"bindycsv":       "bindy-csv",
+		"bindyfixed":     "bindy-fixed",
+		"bindykvp":       "bindy-kvp",
+		"univocitycsv":   "univocity-csv",
+		"univocityfixed": "univocity-fixed",
+		"univocitytsv":   "univocity-tsv",
+		"mimemultipart":  "mime-multipart",
+		"securexml":      "secureXML",
+		"fhirjson":       "fhirJson",
+		"fhirxml":        "fhirXml",
+		"jsonapi":        "jsonApi",
+		"tidymarkup":     "tidyMarkup",
+	}
 
 	sourceCapabilities = map[*regexp.Regexp][]string{
 		circuitBreakerRegexp: {v1.CapabilityCircuitBreaker},
@@ -250,6 +278,17 @@ func (i *baseInspector) discoverDependencies(source v1.SourceSpec, meta *Metadat
 		}
 	}
 
+	for _, match := range dataFormatRegexp.FindAllStringSubmatch(source.Content, -1) {
+		if len(match) > 2 {
+			library := ""
+			if m := jsonLibraryNameRegexp.FindStringSubmatch(match[2]); len(m) > 1 {
+				library = m[1]
+			}
+
+			i.addDataFormatDependency(match[1], library, meta)
+		}
+	}
+
 	for _, match := range camelTypeRegexp.FindAllStringSubmatch(source.Content, -1) {
 		if len(match) > 1 {
 			if dependency, ok := i.catalog.GetJavaTypeDependency(match[1]); ok {
@@ -268,6 +307,22 @@ func (i *baseInspector) addDependency(dependency string, meta *Metadata) {
 	meta.Dependencies.Add(dependency)
 }
 
+// addDataFormatDependency adds the dependency of the data format with the given
+// name, as referenced in the DSL, and the given json library, if any
+func (i *baseInspector) addDataFormatDependency(name string, library string, meta *Metadata) {
+	id := strings.ToLower(strings.Replace(name, "-", "", -1))
+	if id == "json" && library != "" {
+		id = strings.ToLower(library)
+	}
+	if alias, ok := dataFormatAliases[id]; ok {
+		id = alias
+	}
+
+	if dependency, ok := i.catalog.GetDataFormatDependency(id); ok {
+		i.addDependency(dependency, meta)
+	}
+}
+
 func (i *baseInspector) decodeComponent(uri string) string {
 	uriSplit := strings.SplitN(uri, ":", 2)
 	if len(uriSplit) < 2 {
@@ -123,6 +123,21 @@ func (i YAMLInspector) parseStep(key string, content interface{}, meta *Metadata
 				}
 			}
 		}
+
+		if key == "marshal" || key == "unmarshal" {
+			for k, v := range t {
+				if s, ok := k.(string); ok {
+					library := ""
+					if options, ok := v.(map[interface{}]interface{}); ok {
+						if l, ok := options["library"].(string); ok {
+							library = l
+						}
+					}
+
+					i.addDataFormatDependency(s, library, meta)
+				}
+			}
+		}
 	}
 
 	if maybeURI != "" {
@@ -55,3 +55,60 @@ func File(ctx context.Context, path string) (<-chan bool, error) {
 
 	return out, nil
 }
+
+// Files returns a channel that signals each time the content of any of the files changes, providing the path
+// of the file that triggered the change. Changes happening within the given interval from each other are
+// coalesced into a single signal, so that saving several files at once produces a single notification.
+func Files(ctx context.Context, paths []string, interval time.Duration) (<-chan string, error) {
+	changes := make(chan string)
+	for _, path := range paths {
+		fileChanges, err := File(ctx, path)
+		if err != nil {
+			return nil, err
+		}
+
+		go func(path string, fileChanges <-chan bool) {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case _, ok := <-fileChanges:
+					if !ok {
+						return
+					}
+					select {
+					case <-ctx.Done():
+						return
+					case changes <- path:
+					}
+				}
+			}
+		}(path, fileChanges)
+	}
+
+	out := make(chan string)
+	go func() {
+		var trigger string
+		var timer <-chan time.Time
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case path := <-changes:
+				if timer == nil {
+					trigger = path
+				}
+				timer = time.After(interval)
+			case <-timer:
+				timer = nil
+				select {
+				case <-ctx.Done():
+					return
+				case out <- trigger:
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
@@ -67,3 +67,42 @@ watch:
 
 	assert.Equal(t, expectedNumChanges, numChanges)
 }
+
+func TestFiles(t *testing.T) {
+	tempdir := os.TempDir()
+	fileNames := []string{
+		path.Join(tempdir, "camel-k-test-"+strconv.FormatUint(rand.Uint64(), 10)),
+		path.Join(tempdir, "camel-k-test-"+strconv.FormatUint(rand.Uint64(), 10)),
+	}
+	for _, fileName := range fileNames {
+		_, err := os.Create(fileName)
+		assert.Nil(t, err)
+		defer os.Remove(fileName)
+	}
+
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(100*time.Second))
+	defer cancel()
+
+	changes, err := Files(ctx, fileNames, time.Second)
+	assert.Nil(t, err)
+
+	time.Sleep(100 * time.Millisecond)
+	for i, fileName := range fileNames {
+		if err := ioutil.WriteFile(fileName, []byte("data-"+strconv.Itoa(i)), 0777); err != nil {
+			t.Error(err)
+		}
+	}
+
+	select {
+	case <-ctx.Done():
+		t.Fatal("no change detected")
+	case trigger := <-changes:
+		assert.Contains(t, fileNames, trigger)
+	}
+
+	select {
+	case trigger := <-changes:
+		t.Fatalf("unexpected change detected for %s", trigger)
+	case <-time.After(2 * time.Second):
+	}
+}
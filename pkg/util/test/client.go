@@ -23,6 +23,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
 	clientscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 
@@ -41,9 +42,21 @@ func NewFakeClient(initObjs ...runtime.Object) (client.Client, error) {
 
 	c := fake.NewFakeClientWithScheme(scheme, initObjs...)
 
+	// The clientset only knows about the Kubernetes types
+	clientsetScheme := runtime.NewScheme()
+	if err := fakeclientset.AddToScheme(clientsetScheme); err != nil {
+		return nil, err
+	}
+	clientsetObjs := make([]runtime.Object, 0, len(initObjs))
+	for _, o := range initObjs {
+		if _, _, err := clientsetScheme.ObjectKinds(o); err == nil {
+			clientsetObjs = append(clientsetObjs, o)
+		}
+	}
+
 	return &FakeClient{
 		Client:    c,
-		Interface: nil,
+		Interface: fakeclientset.NewSimpleClientset(clientsetObjs...),
 	}, nil
 }
 
@@ -155,6 +155,14 @@ func CopyFile(src, dst string) (int64, error) {
 		return 0, err
 	}
 	defer destination.Close()
+
+	// Preserve the executable permission, e.g. for native executables
+	if sourceFileStat.Mode()&0111 != 0 {
+		if err := destination.Chmod(0755); err != nil {
+			return 0, err
+		}
+	}
+
 	nBytes, err := io.Copy(destination, source)
 	return nBytes, err
 }
@@ -20,6 +20,7 @@ package watch
 import (
 	"context"
 	"fmt"
+	"reflect"
 
 	v1 "github.com/apache/camel-k/pkg/apis/camel/v1"
 	"github.com/apache/camel-k/pkg/util/kubernetes"
@@ -32,7 +33,7 @@ import (
 )
 
 //
-// HandleIntegrationStateChanges watches a integration resource and invoke the given handler when its status changes.
+// HandleIntegrationStateChanges watches a integration resource and invoke the given handler when its phase or conditions change.
 //
 //     err := watch.HandleIntegrationStateChanges(ctx, integration, func(i *v1.Integration) bool {
 //         if i.Status.Phase == v1.IntegrationPhaseRunning {
@@ -63,10 +64,12 @@ func HandleIntegrationStateChanges(ctx context.Context, integration *v1.Integrat
 	events := watcher.ResultChan()
 
 	var lastObservedState *v1.IntegrationPhase
+	var lastObservedConditions []v1.IntegrationCondition
 
 	var handlerWrapper = func(it *v1.Integration) bool {
-		if lastObservedState == nil || *lastObservedState != it.Status.Phase {
+		if lastObservedState == nil || *lastObservedState != it.Status.Phase || !reflect.DeepEqual(lastObservedConditions, it.Status.Conditions) {
 			lastObservedState = &it.Status.Phase
+			lastObservedConditions = it.Status.Conditions
 			if !handler(it) {
 				return false
 			}